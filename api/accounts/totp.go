@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// TotpEnrollment contains the TOTP shared secret and the recovery codes
+// returned when an account is enrolled in TOTP. They are not returned again.
+type TotpEnrollment struct {
+	AccountId     string   `json:"account_id,omitempty"`
+	Secret        string   `json:"secret,omitempty"`
+	Uri           string   `json:"uri,omitempty"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+type TotpEnrollmentResult struct {
+	Item     *TotpEnrollment
+	Response *api.Response
+}
+
+func (n TotpEnrollmentResult) GetItem() *TotpEnrollment {
+	return n.Item
+}
+
+func (n TotpEnrollmentResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+type TotpRemoveResult struct {
+	Response *api.Response
+}
+
+// GetItem will always be nil for TotpRemoveResult
+func (n TotpRemoveResult) GetItem() any {
+	return nil
+}
+
+func (n TotpRemoveResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// EnrollTotp enrolls the account in TOTP, replacing any existing enrollment.
+func (c *Client) EnrollTotp(ctx context.Context, accountId string, opt ...Option) (*TotpEnrollmentResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into EnrollTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in EnrollTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:enroll-totp", url.PathEscape(accountId)), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating EnrollTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during EnrollTotp call: %w", err)
+	}
+
+	target := new(TotpEnrollmentResult)
+	target.Item = new(TotpEnrollment)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding EnrollTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// RemoveTotp removes the TOTP enrollment and the recovery codes of the
+// account.
+func (c *Client) RemoveTotp(ctx context.Context, accountId string, opt ...Option) (*TotpRemoveResult, error) {
+	if accountId == "" {
+		return nil, fmt.Errorf("empty accountId value passed into RemoveTotp request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client in RemoveTotp request")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", fmt.Sprintf("accounts/%s:remove-totp", url.PathEscape(accountId)), map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RemoveTotp request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveTotp call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveTotp response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &TotpRemoveResult{Response: resp}, nil
+}
@@ -935,6 +935,30 @@ func DefaultLdapAuthMethodState() Option {
 	}
 }
 
+func WithPasswordAuthMethodTotpRequired(inTotpRequired bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["totp_required"] = inTotpRequired
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPasswordAuthMethodTotpRequired() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["totp_required"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithLdapAuthMethodUpnDomain(inUpnDomain string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -119,6 +119,30 @@ func WithResourcePathOverride(path string) Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryAdditionalValidPrincipals(inAdditionalValidPrincipals []string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["additional_valid_principals"] = inAdditionalValidPrincipals
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryAdditionalValidPrincipals() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["additional_valid_principals"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryAdditionalValidPrincipals(inAdditionalValidPrincipals []string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -179,6 +203,30 @@ func DefaultCredentialType() Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryCriticalOptions(inCriticalOptions map[string]string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["critical_options"] = inCriticalOptions
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryCriticalOptions() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["critical_options"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryCriticalOptions(inCriticalOptions map[string]string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -215,6 +263,30 @@ func DefaultDescription() Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryExtensions(inExtensions map[string]string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["extensions"] = inExtensions
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryExtensions() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["extensions"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryExtensions(inExtensions map[string]string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -287,6 +359,30 @@ func DefaultVaultCredentialLibraryHttpRequestBody() Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryKeyBits(inKeyBits uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["key_bits"] = inKeyBits
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryKeyBits() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["key_bits"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryKeyBits(inKeyBits uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -311,6 +407,30 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyBits() Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryKeyId(inKeyId string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["key_id"] = inKeyId
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryKeyId() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["key_id"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryKeyId(inKeyId string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -335,6 +455,30 @@ func DefaultVaultSSHCertificateCredentialLibraryKeyId() Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryKeyType(inKeyType string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["key_type"] = inKeyType
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryKeyType() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["key_type"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryKeyType(inKeyType string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -395,6 +539,54 @@ func WithVaultSSHCertificateCredentialLibraryPath(inPath string) Option {
 	}
 }
 
+func WithVaultCredentialLibrarySecretVersion(inSecretVersion uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["secret_version"] = inSecretVersion
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultVaultCredentialLibrarySecretVersion() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["secret_version"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSshCaCertificateCredentialLibraryTtl(inTtl string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["ttl"] = inTtl
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshCaCertificateCredentialLibraryTtl() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["ttl"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryTtl(inTtl string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -419,6 +611,18 @@ func DefaultVaultSSHCertificateCredentialLibraryTtl() Option {
 	}
 }
 
+func WithSshCaCertificateCredentialLibraryUsername(inUsername string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["username"] = inUsername
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithVaultSSHCertificateCredentialLibraryUsername(inUsername string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -14,6 +14,7 @@ type VaultCredentialLibraryAttributes struct {
 	Path            string `json:"path,omitempty"`
 	HttpMethod      string `json:"http_method,omitempty"`
 	HttpRequestBody string `json:"http_request_body,omitempty"`
+	SecretVersion   uint32 `json:"secret_version,omitempty"`
 }
 
 func AttributesMapToVaultCredentialLibraryAttributes(in map[string]any) (*VaultCredentialLibraryAttributes, error) {
@@ -28,6 +28,7 @@ type Credential struct {
 	UpdatedTime       time.Time              `json:"updated_time,omitempty"`
 	Version           uint32                 `json:"version,omitempty"`
 	Type              string                 `json:"type,omitempty"`
+	CredentialVersion uint32                 `json:"credential_version,omitempty"`
 	Attributes        map[string]interface{} `json:"attributes,omitempty"`
 	AuthorizedActions []string               `json:"authorized_actions,omitempty"`
 }
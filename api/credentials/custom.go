@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// Rotate replaces the password of a username password credential and records
+// it as a new credential version. If password is empty, the controller
+// generates a random password, which requires a credential rotation command to
+// be configured on the controller.
+func (c *Client) Rotate(ctx context.Context, credentialId string, version uint32, password string, opt ...Option) (*CredentialUpdateResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credential id value passed into rotate request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	version, err := c.currentVersion(ctx, credentialId, version, opts, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]any{
+		"version": version,
+	}
+	if password != "" {
+		body["password"] = password
+	}
+	return c.doCredentialVersionRequest(ctx, "rotate", credentialId, body, apiOpts...)
+}
+
+// Rollback restores the secret a credential held at credentialVersion. The
+// restored secret is recorded as a new credential version.
+func (c *Client) Rollback(ctx context.Context, credentialId string, version, credentialVersion uint32, opt ...Option) (*CredentialUpdateResult, error) {
+	if credentialId == "" {
+		return nil, fmt.Errorf("empty credential id value passed into rollback request")
+	}
+	if credentialVersion == 0 {
+		return nil, fmt.Errorf("zero credential version passed into rollback request")
+	}
+	if c.client == nil {
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	version, err := c.currentVersion(ctx, credentialId, version, opts, opt...)
+	if err != nil {
+		return nil, err
+	}
+
+	body := map[string]any{
+		"version":            version,
+		"credential_version": credentialVersion,
+	}
+	return c.doCredentialVersionRequest(ctx, "rollback", credentialId, body, apiOpts...)
+}
+
+// currentVersion returns version, or the current version of the credential
+// if version is zero and automatic versioning is enabled.
+func (c *Client) currentVersion(ctx context.Context, credentialId string, version uint32, opts options, opt ...Option) (uint32, error) {
+	if version != 0 {
+		return version, nil
+	}
+	if !opts.withAutomaticVersioning {
+		return 0, errors.New("zero version number passed into request and automatic versioning not specified")
+	}
+	existingTarget, existingErr := c.Read(ctx, credentialId, append([]Option{WithSkipCurlOutput(true)}, opt...)...)
+	if existingErr != nil {
+		if api.AsServerError(existingErr) != nil {
+			return 0, fmt.Errorf("error from controller when performing initial check-and-set read: %w", existingErr)
+		}
+		return 0, fmt.Errorf("error performing initial check-and-set read: %w", existingErr)
+	}
+	if existingTarget == nil {
+		return 0, errors.New("nil resource response found when performing initial check-and-set read")
+	}
+	if existingTarget.Item == nil {
+		return 0, errors.New("nil resource found when performing initial check-and-set read")
+	}
+	return existingTarget.Item.Version, nil
+}
+
+func (c *Client) doCredentialVersionRequest(ctx context.Context, action, credentialId string, body map[string]any, apiOpts ...api.Option) (*CredentialUpdateResult, error) {
+	req, err := c.client.NewRequest(ctx, "POST", "credentials/"+url.PathEscape(credentialId)+":"+action, body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s request: %w", action, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s call: %w", action, err)
+	}
+
+	target := new(CredentialUpdateResult)
+	target.Item = new(Credential)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s response: %w", action, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
@@ -215,6 +215,30 @@ func DefaultSshPrivateKeyCredentialPrivateKeyPassphrase() Option {
 	}
 }
 
+func WithUsernamePasswordCredentialRotationPeriodSeconds(inRotationPeriodSeconds uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["rotation_period_seconds"] = inRotationPeriodSeconds
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultUsernamePasswordCredentialRotationPeriodSeconds() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["rotation_period_seconds"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshPrivateKeyCredentialUsername(inUsername string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
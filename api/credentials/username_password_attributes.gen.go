@@ -11,9 +11,10 @@ import (
 )
 
 type UsernamePasswordAttributes struct {
-	Username     string `json:"username,omitempty"`
-	Password     string `json:"password,omitempty"`
-	PasswordHmac string `json:"password_hmac,omitempty"`
+	Username              string `json:"username,omitempty"`
+	Password              string `json:"password,omitempty"`
+	PasswordHmac          string `json:"password_hmac,omitempty"`
+	RotationPeriodSeconds uint32 `json:"rotation_period_seconds,omitempty"`
 }
 
 func AttributesMapToUsernamePasswordAttributes(in map[string]any) (*UsernamePasswordAttributes, error) {
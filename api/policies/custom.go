@@ -6,6 +6,7 @@ const (
 	retainForField   = "retain_for"
 	daysField        = "days"
 	overridableField = "overridable"
+	legalHoldField   = "legal_hold"
 )
 
 func WithStoragePolicyDeleteAfter(inDeleteAfter map[string]any) Option {
@@ -199,3 +200,27 @@ func DefaultStoragePolicyRetainForOverridable() Option {
 		o.postMap[attributesField] = val
 	}
 }
+
+func WithStoragePolicyLegalHold(inLegalHold bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap[attributesField]
+		if !ok {
+			raw = interface{}(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val[legalHoldField] = inLegalHold
+		o.postMap[attributesField] = val
+	}
+}
+
+func DefaultStoragePolicyLegalHold() Option {
+	return func(o *options) {
+		raw, ok := o.postMap[attributesField]
+		if !ok {
+			raw = interface{}(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val[legalHoldField] = nil
+		o.postMap[attributesField] = val
+	}
+}
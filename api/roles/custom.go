@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package roles
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+)
+
+// AccessRequestResult contains an access request of a role.
+type AccessRequestResult struct {
+	Item     *AccessRequest
+	Response *api.Response
+}
+
+func (n AccessRequestResult) GetItem() *AccessRequest {
+	return n.Item
+}
+
+func (n AccessRequestResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// AccessRequestListResult contains the access requests of a role.
+type AccessRequestListResult struct {
+	Items    []*AccessRequest `json:"items,omitempty"`
+	Response *api.Response
+}
+
+func (n AccessRequestListResult) GetItems() []*AccessRequest {
+	return n.Items
+}
+
+func (n AccessRequestListResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// GrantsValidationResult contains the validation of grants proposed for a
+// role.
+type GrantsValidationResult struct {
+	Item     *GrantsValidation
+	Response *api.Response
+}
+
+func (n GrantsValidationResult) GetItem() *GrantsValidation {
+	return n.Item
+}
+
+func (n GrantsValidationResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// ValidateGrants lints the provided grants against a role without adding them
+// to it. The result reports problems with each grant and what the grants would
+// allow that the role does not allow today.
+func (c *Client) ValidateGrants(ctx context.Context, roleId string, grants []string, opt ...Option) (*GrantsValidationResult, error) {
+	switch {
+	case roleId == "":
+		return nil, fmt.Errorf("empty role id value passed into validate grants request")
+	case len(grants) == 0:
+		return nil, fmt.Errorf("empty grants passed into validate grants request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	body := map[string]any{
+		"grant_strings": grants,
+	}
+	req, err := c.client.NewRequest(ctx, "POST", "roles/"+url.PathEscape(roleId)+":validate-grants", body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating validate grants request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ValidateGrants call: %w", err)
+	}
+
+	target := new(GrantsValidationResult)
+	target.Item = new(GrantsValidation)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ValidateGrants response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// RequestAccess requests for the calling user to be a principal of a role for
+// the provided duration, which is truncated to seconds. The user is added to
+// the role once the request is approved.
+func (c *Client) RequestAccess(ctx context.Context, roleId, justification string, duration time.Duration, opt ...Option) (*AccessRequestResult, error) {
+	switch {
+	case roleId == "":
+		return nil, fmt.Errorf("empty role id value passed into request access request")
+	case justification == "":
+		return nil, fmt.Errorf("empty justification value passed into request access request")
+	case duration < time.Second:
+		return nil, fmt.Errorf("duration passed into request access request must be at least one second")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	body := map[string]any{
+		"justification":    justification,
+		"duration_seconds": uint32(duration / time.Second),
+	}
+	req, err := c.client.NewRequest(ctx, "POST", "roles/"+url.PathEscape(roleId)+":request-access", body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request access request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RequestAccess call: %w", err)
+	}
+
+	target := new(AccessRequestResult)
+	target.Item = new(AccessRequest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RequestAccess response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// ListAccessRequests returns the access requests of a role, most recent first.
+// Use WithPageSize to limit the number of requests returned.
+func (c *Client) ListAccessRequests(ctx context.Context, roleId string, opt ...Option) (*AccessRequestListResult, error) {
+	switch {
+	case roleId == "":
+		return nil, fmt.Errorf("empty role id value passed into list access requests request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "roles/"+url.PathEscape(roleId)+":list-access-requests", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating list access requests request: %w", err)
+	}
+
+	if len(opts.queryMap) > 0 {
+		q := url.Values{}
+		for k, v := range opts.queryMap {
+			q.Add(k, v)
+		}
+		req.URL.RawQuery = q.Encode()
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListAccessRequests call: %w", err)
+	}
+
+	target := new(AccessRequestListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListAccessRequests response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// ApproveAccessRequest approves a pending access request of a role. The user
+// who requested the access is a principal of the role until the duration of
+// the request has passed. The comment is optional.
+func (c *Client) ApproveAccessRequest(ctx context.Context, roleId, accessRequestId, comment string, opt ...Option) (*AccessRequestResult, error) {
+	return c.decideAccessRequest(ctx, "approve", roleId, accessRequestId, comment, opt...)
+}
+
+// DenyAccessRequest denies a pending access request of a role. The comment is
+// optional.
+func (c *Client) DenyAccessRequest(ctx context.Context, roleId, accessRequestId, comment string, opt ...Option) (*AccessRequestResult, error) {
+	return c.decideAccessRequest(ctx, "deny", roleId, accessRequestId, comment, opt...)
+}
+
+func (c *Client) decideAccessRequest(ctx context.Context, decision, roleId, accessRequestId, comment string, opt ...Option) (*AccessRequestResult, error) {
+	switch {
+	case roleId == "":
+		return nil, fmt.Errorf("empty role id value passed into %s access request request", decision)
+	case accessRequestId == "":
+		return nil, fmt.Errorf("empty access request id value passed into %s access request request", decision)
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	body := map[string]any{
+		"access_request_id": accessRequestId,
+	}
+	if comment != "" {
+		body["comment"] = comment
+	}
+	req, err := c.client.NewRequest(ctx, "POST", "roles/"+url.PathEscape(roleId)+":"+decision+"-access-request", body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s access request request: %w", decision, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during %s access request call: %w", decision, err)
+	}
+
+	target := new(AccessRequestResult)
+	target.Item = new(AccessRequest)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %s access request response: %w", decision, err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
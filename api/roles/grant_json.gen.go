@@ -5,8 +5,10 @@
 package roles
 
 type GrantJson struct {
-	Id      string   `json:"id,omitempty"`
-	Ids     []string `json:"ids,omitempty"`
-	Type    string   `json:"type,omitempty"`
-	Actions []string `json:"actions,omitempty"`
+	Id         string           `json:"id,omitempty"`
+	Ids        []string         `json:"ids,omitempty"`
+	Type       string           `json:"type,omitempty"`
+	Actions    []string         `json:"actions,omitempty"`
+	Conditions *GrantConditions `json:"conditions,omitempty"`
+	Deny       bool             `json:"deny,omitempty"`
 }
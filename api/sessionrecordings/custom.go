@@ -99,3 +99,227 @@ func (c *Client) ReApplyStoragePolicy(ctx context.Context, contentId string, opt
 	target.Response = resp
 	return target, nil
 }
+
+// SessionRecordingSearchResult contains the lines of session recordings that
+// match a search query.
+type SessionRecordingSearchResult struct {
+	Items        []*SearchMatch `json:"items,omitempty"`
+	ResponseType string         `json:"response_type,omitempty"`
+	ListToken    string         `json:"list_token,omitempty"`
+	Response     *api.Response
+}
+
+func (n SessionRecordingSearchResult) GetItems() []*SearchMatch {
+	return n.Items
+}
+
+func (n SessionRecordingSearchResult) GetResponseType() string {
+	return n.ResponseType
+}
+
+func (n SessionRecordingSearchResult) GetListToken() string {
+	return n.ListToken
+}
+
+func (n SessionRecordingSearchResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// Search returns the lines of the indexed session recordings in the provided
+// scope that match the query. Use WithRecursive to search the child scopes and
+// WithPageSize to limit the number of matches returned. If the response type
+// of the result is "delta", pass its list token with WithListToken to return
+// the next matches.
+func (c *Client) Search(ctx context.Context, scopeId, query string, opt ...Option) (*SessionRecordingSearchResult, error) {
+	switch {
+	case scopeId == "":
+		return nil, fmt.Errorf("empty scope id value passed into search request")
+	case query == "":
+		return nil, fmt.Errorf("empty query value passed into search request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "session-recordings:search", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating search request: %w", err)
+	}
+
+	opts.queryMap["scope_id"] = scopeId
+	opts.queryMap["query"] = query
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during Search call: %w", err)
+	}
+
+	target := new(SessionRecordingSearchResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding Search response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// RetentionReportListResult contains the storage policy retention reports of a
+// scope.
+type RetentionReportListResult struct {
+	Items    []*RetentionReport `json:"items,omitempty"`
+	Response *api.Response
+}
+
+func (n RetentionReportListResult) GetItems() []*RetentionReport {
+	return n.Items
+}
+
+func (n RetentionReportListResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// ListRetentionReports returns the storage policy retention reports of the
+// session recordings in the provided scope, most recent first. Use
+// WithRecursive to include the child scopes and WithPageSize to limit the
+// number of reports returned.
+func (c *Client) ListRetentionReports(ctx context.Context, scopeId string, opt ...Option) (*RetentionReportListResult, error) {
+	switch {
+	case scopeId == "":
+		return nil, fmt.Errorf("empty scope id value passed into list retention reports request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "GET", "session-recordings:retention-reports", nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating list retention reports request: %w", err)
+	}
+
+	opts.queryMap["scope_id"] = scopeId
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ListRetentionReports call: %w", err)
+	}
+
+	target := new(RetentionReportListResult)
+	apiErr, err := resp.Decode(target)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ListRetentionReports response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// LegalHoldResult contains the legal hold of a session recording.
+type LegalHoldResult struct {
+	Item     *LegalHold
+	Response *api.Response
+}
+
+func (n LegalHoldResult) GetItem() *LegalHold {
+	return n.Item
+}
+
+func (n LegalHoldResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// SetLegalHold places a legal hold on a session recording, which prevents its
+// deletion and the change of its retention. The reason is optional.
+func (c *Client) SetLegalHold(ctx context.Context, id, reason string, opt ...Option) (*LegalHoldResult, error) {
+	switch {
+	case id == "":
+		return nil, fmt.Errorf("empty id value passed into set legal hold request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	body := map[string]any{}
+	if reason != "" {
+		body["reason"] = reason
+	}
+	req, err := c.client.NewRequest(ctx, "POST", "session-recordings/"+url.PathEscape(id)+":set-legal-hold", body, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating set legal hold request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during SetLegalHold call: %w", err)
+	}
+
+	target := new(LegalHoldResult)
+	target.Item = new(LegalHold)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding SetLegalHold response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
+
+// RemoveLegalHoldResult contains the response of the removal of the legal hold
+// of a session recording.
+type RemoveLegalHoldResult struct {
+	Response *api.Response
+}
+
+func (n RemoveLegalHoldResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// RemoveLegalHold removes the legal hold of a session recording.
+func (c *Client) RemoveLegalHold(ctx context.Context, id string, opt ...Option) (*RemoveLegalHoldResult, error) {
+	switch {
+	case id == "":
+		return nil, fmt.Errorf("empty id value passed into remove legal hold request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	_, apiOpts := getOpts(opt...)
+
+	req, err := c.client.NewRequest(ctx, "POST", "session-recordings/"+url.PathEscape(id)+":remove-legal-hold", map[string]any{}, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating remove legal hold request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during RemoveLegalHold call: %w", err)
+	}
+
+	apiErr, err := resp.Decode(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding RemoveLegalHold response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return &RemoveLegalHoldResult{Response: resp}, nil
+}
@@ -36,6 +36,7 @@ type Session struct {
 	Status            string            `json:"status,omitempty"`
 	Certificate       []byte            `json:"certificate,omitempty"`
 	TerminationReason string            `json:"termination_reason,omitempty"`
+	ApproverId        string            `json:"approver_id,omitempty"`
 	AuthorizedActions []string          `json:"authorized_actions,omitempty"`
 	Connections       []*Connection     `json:"connections,omitempty"`
 }
@@ -149,6 +149,66 @@ func WithAliases(inAliases []Alias) Option {
 	}
 }
 
+func WithHttpTargetAllowPlaintextCredentials(inAllowPlaintextCredentials bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["allow_plaintext_credentials"] = inAllowPlaintextCredentials
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetAllowPlaintextCredentials() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["allow_plaintext_credentials"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithApprovalRequired(inApprovalRequired bool) Option {
+	return func(o *options) {
+		o.postMap["approval_required"] = inApprovalRequired
+	}
+}
+
+func DefaultApprovalRequired() Option {
+	return func(o *options) {
+		o.postMap["approval_required"] = nil
+	}
+}
+
+func WithApprovalTimeoutSeconds(inApprovalTimeoutSeconds uint32) Option {
+	return func(o *options) {
+		o.postMap["approval_timeout_seconds"] = inApprovalTimeoutSeconds
+	}
+}
+
+func DefaultApprovalTimeoutSeconds() Option {
+	return func(o *options) {
+		o.postMap["approval_timeout_seconds"] = nil
+	}
+}
+
+func WithApproversFilter(inApproversFilter string) Option {
+	return func(o *options) {
+		o.postMap["approvers_filter"] = inApproversFilter
+	}
+}
+
+func DefaultApproversFilter() Option {
+	return func(o *options) {
+		o.postMap["approvers_filter"] = nil
+	}
+}
+
 func WithAttributes(inAttributes map[string]interface{}) Option {
 	return func(o *options) {
 		o.postMap["attributes"] = inAttributes
@@ -173,6 +233,54 @@ func DefaultBrokeredCredentialSourceIds() Option {
 	}
 }
 
+func WithHttpTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetDefaultClientPort(inDefaultClientPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_client_port"] = inDefaultClientPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetDefaultClientPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_client_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetDefaultClientPort(inDefaultClientPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -221,6 +329,54 @@ func DefaultTcpTargetDefaultClientPort() Option {
 	}
 }
 
+func WithHttpTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetDefaultPort(inDefaultPort uint32) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_port"] = inDefaultPort
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetDefaultPort() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["default_port"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetDefaultPort(inDefaultPort uint32) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -335,6 +491,54 @@ func DefaultInjectedApplicationCredentialSourceIds() Option {
 	}
 }
 
+func WithSshTargetInsecureSkipHostKeyVerification(inInsecureSkipHostKeyVerification bool) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["insecure_skip_host_key_verification"] = inInsecureSkipHostKeyVerification
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetInsecureSkipHostKeyVerification() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["insecure_skip_host_key_verification"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithSshTargetKnownHosts(inKnownHosts string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["known_hosts"] = inKnownHosts
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultSshTargetKnownHosts() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["known_hosts"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithName(inName string) Option {
 	return func(o *options) {
 		o.postMap["name"] = inName
@@ -347,6 +551,30 @@ func DefaultName() Option {
 	}
 }
 
+func WithHttpTargetScheme(inScheme string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["scheme"] = inScheme
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetScheme() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["scheme"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithScopeId(inScopeId string) Option {
 	return func(o *options) {
 		o.postMap["scope_id"] = inScopeId
@@ -383,6 +611,30 @@ func DefaultSessionMaxSeconds() Option {
 	}
 }
 
+func WithPostgresTargetSslMode(inSslMode string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["ssl_mode"] = inSslMode
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetSslMode() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["ssl_mode"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithSshTargetStorageBucketId(inStorageBucketId string) Option {
 	return func(o *options) {
 		raw, ok := o.postMap["attributes"]
@@ -407,6 +659,102 @@ func DefaultSshTargetStorageBucketId() Option {
 	}
 }
 
+func WithHttpTargetTlsCaCert(inTlsCaCert string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_ca_cert"] = inTlsCaCert
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetTlsCaCert() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_ca_cert"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetTlsCaCert(inTlsCaCert string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_ca_cert"] = inTlsCaCert
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetTlsCaCert() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_ca_cert"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithHttpTargetTlsServerName(inTlsServerName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_server_name"] = inTlsServerName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultHttpTargetTlsServerName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_server_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
+func WithPostgresTargetTlsServerName(inTlsServerName string) Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_server_name"] = inTlsServerName
+		o.postMap["attributes"] = val
+	}
+}
+
+func DefaultPostgresTargetTlsServerName() Option {
+	return func(o *options) {
+		raw, ok := o.postMap["attributes"]
+		if !ok {
+			raw = any(map[string]any{})
+		}
+		val := raw.(map[string]any)
+		val["tls_server_name"] = nil
+		o.postMap["attributes"] = val
+	}
+}
+
 func WithWorkerFilter(inWorkerFilter string) Option {
 	return func(o *options) {
 		o.postMap["worker_filter"] = inWorkerFilter
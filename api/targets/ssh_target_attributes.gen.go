@@ -11,10 +11,12 @@ import (
 )
 
 type SshTargetAttributes struct {
-	DefaultPort            uint32 `json:"default_port,omitempty"`
-	DefaultClientPort      uint32 `json:"default_client_port,omitempty"`
-	StorageBucketId        string `json:"storage_bucket_id,omitempty"`
-	EnableSessionRecording bool   `json:"enable_session_recording,omitempty"`
+	DefaultPort                     uint32 `json:"default_port,omitempty"`
+	DefaultClientPort               uint32 `json:"default_client_port,omitempty"`
+	StorageBucketId                 string `json:"storage_bucket_id,omitempty"`
+	EnableSessionRecording          bool   `json:"enable_session_recording,omitempty"`
+	KnownHosts                      string `json:"known_hosts,omitempty"`
+	InsecureSkipHostKeyVerification bool   `json:"insecure_skip_host_key_verification,omitempty"`
 }
 
 func AttributesMapToSshTargetAttributes(in map[string]any) (*SshTargetAttributes, error) {
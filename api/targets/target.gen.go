@@ -44,6 +44,9 @@ type Target struct {
 	Address                                string                 `json:"address,omitempty"`
 	Aliases                                []*Alias               `json:"aliases,omitempty"`
 	WithAliases                            []*Alias               `json:"with_aliases,omitempty"`
+	ApprovalRequired                       bool                   `json:"approval_required,omitempty"`
+	ApproversFilter                        string                 `json:"approvers_filter,omitempty"`
+	ApprovalTimeoutSeconds                 uint32                 `json:"approval_timeout_seconds,omitempty"`
 }
 
 type TargetReadResult struct {
@@ -8,9 +8,34 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/hashicorp/boundary/api"
 	"github.com/hashicorp/boundary/api/aliases"
 )
 
+// PermissionExplanationResult contains the explanation of whether a user is
+// allowed to perform an action on a resource.
+type PermissionExplanationResult struct {
+	Item     *PermissionExplanation
+	Response *api.Response
+}
+
+func (n PermissionExplanationResult) GetItem() *PermissionExplanation {
+	return n.Item
+}
+
+func (n PermissionExplanationResult) GetResponse() *api.Response {
+	return n.Response
+}
+
+// WithExplainClientIp sets the client IP grant conditions are evaluated with
+// when explaining a permission. By default the client IP of the request is
+// used.
+func WithExplainClientIp(clientIp string) Option {
+	return func(o *options) {
+		o.queryMap["client_ip"] = clientIp
+	}
+}
+
 // ListResolvableAliases builds and sends a request to the API for listing
 // resolvable aliases for the specified user. It retrieves all remaining pages
 // and includes in the result the list token for paginating through future
@@ -64,3 +89,51 @@ func (c *Client) ListResolvableAliasesNextPage(ctx context.Context, userId strin
 		aliases.WithResourcePathOverride(fmt.Sprintf("users/%s:list-resolvable-aliases", url.PathEscape(userId))),
 	)
 }
+
+// ExplainPermission explains whether the user is allowed to perform the action
+// on the resource with the provided ID, returning the roles, principals and
+// grants of the user that were considered.
+func (c *Client) ExplainPermission(ctx context.Context, userId, resourceId, action string, opt ...Option) (*PermissionExplanationResult, error) {
+	switch {
+	case userId == "":
+		return nil, fmt.Errorf("empty userId value passed into ExplainPermission request")
+	case resourceId == "":
+		return nil, fmt.Errorf("empty resourceId value passed into ExplainPermission request")
+	case action == "":
+		return nil, fmt.Errorf("empty action value passed into ExplainPermission request")
+	case c.client == nil:
+		return nil, fmt.Errorf("nil client")
+	}
+
+	opts, apiOpts := getOpts(opt...)
+	opts.queryMap["resource_id"] = resourceId
+	opts.queryMap["action"] = action
+
+	req, err := c.client.NewRequest(ctx, "GET", fmt.Sprintf("users/%s:explain", url.PathEscape(userId)), nil, apiOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ExplainPermission request: %w", err)
+	}
+
+	q := url.Values{}
+	for k, v := range opts.queryMap {
+		q.Add(k, v)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing client request during ExplainPermission call: %w", err)
+	}
+
+	target := new(PermissionExplanationResult)
+	target.Item = new(PermissionExplanation)
+	apiErr, err := resp.Decode(target.Item)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding ExplainPermission response: %w", err)
+	}
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	target.Response = resp
+	return target, nil
+}
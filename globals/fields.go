@@ -51,6 +51,10 @@ const (
 	WorkerFilterField                           = "worker_filter"
 	EgressWorkerFilterField                     = "egress_worker_filter"
 	IngressWorkerFilterField                    = "ingress_worker_filter"
+	ApprovalRequiredField                       = "approval_required"
+	ApproversFilterField                        = "approvers_filter"
+	ApprovalTimeoutSecondsField                 = "approval_timeout_seconds"
+	ApproverIdField                             = "approver_id"
 	AccountIdsField                             = "account_ids"
 	AccountsField                               = "accounts"
 	LoginNameField                              = "login_name"
@@ -60,6 +64,7 @@ const (
 	ManagedGroupIdsField                        = "managed_group_ids"
 	FilterField                                 = "filter"
 	CredentialStoreIdField                      = "credential_store_id"
+	CredentialVersionField                      = "credential_version"
 	ApplicationCredentialSourceIdsField         = "application_credential_source_ids"
 	ApplicationCredentialSourcesField           = "application_credential_sources"
 	BrokeredCredentialSourceIdsField            = "brokered_credential_source_ids"
@@ -124,4 +129,6 @@ const (
 	WithAliasesField                            = "with_aliases"
 	LocalStorageStateField                      = "local_storage_state"
 	RemoteStorageStateField                     = "remote_storage_state"
+	BucketNameField                             = "bucket_name"
+	BucketPrefixField                           = "bucket_prefix"
 )
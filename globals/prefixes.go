@@ -72,6 +72,16 @@ const (
 	// DynamicCredentialPrefix is the prefix for Vault dynamic credentials
 	VaultDynamicCredentialPrefix = "cdvlt"
 
+	// SshCaCredentialStorePrefix is the prefix for SSH certificate authority
+	// credential stores
+	SshCaCredentialStorePrefix = "cssca"
+	// SshCaCertificateCredentialLibraryPrefix is the prefix for SSH
+	// certificate authority credential libraries
+	SshCaCertificateCredentialLibraryPrefix = "clsca"
+	// SshCaDynamicCredentialPrefix is the prefix for SSH certificates issued
+	// by an SSH certificate authority credential library
+	SshCaDynamicCredentialPrefix = "cdsca"
+
 	// UsernamePasswordCredentialPrefix is the prefix for username/password
 	// creds
 	UsernamePasswordCredentialPrefix = "credup"
@@ -111,6 +121,10 @@ const (
 	TcpTargetPrefix = "ttcp"
 	// SshTargetPrefix is the prefix for TCP targets
 	SshTargetPrefix = "tssh"
+	// PostgresTargetPrefix is the prefix for PostgreSQL targets
+	PostgresTargetPrefix = "tpg"
+	// HttpTargetPrefix is the prefix for HTTP targets
+	HttpTargetPrefix = "thttp"
 
 	// WorkerPrefix is the prefix for workers
 	WorkerPrefix = "w"
@@ -124,6 +138,8 @@ const (
 	ConnectionRecordingPrefix = "cr"
 	// ChannelRecordingPrefix is the prefix for channel recordings
 	ChannelRecordingPrefix = "chr"
+	// RetentionReportPrefix is the prefix for session recording retention reports
+	RetentionReportPrefix = "srr"
 
 	// StoragePolicyPrefix for storage policies.
 	StoragePolicyPrefix = "pst"
@@ -240,6 +256,19 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Subtype: UnknownSubtype,
 	},
 
+	SshCaCredentialStorePrefix: {
+		Type:    resource.CredentialStore,
+		Subtype: UnknownSubtype,
+	},
+	SshCaCertificateCredentialLibraryPrefix: {
+		Type:    resource.CredentialLibrary,
+		Subtype: UnknownSubtype,
+	},
+	SshCaDynamicCredentialPrefix: {
+		Type:    resource.Credential,
+		Subtype: UnknownSubtype,
+	},
+
 	UsernamePasswordCredentialPrefix: {
 		Type:    resource.Credential,
 		Subtype: UnknownSubtype,
@@ -308,6 +337,14 @@ var prefixToResourceType = map[string]ResourceInfo{
 		Type:    resource.Target,
 		Subtype: UnknownSubtype,
 	},
+	PostgresTargetPrefix: {
+		Type:    resource.Target,
+		Subtype: UnknownSubtype,
+	},
+	HttpTargetPrefix: {
+		Type:    resource.Target,
+		Subtype: UnknownSubtype,
+	},
 
 	WorkerPrefix: {
 		Type:    resource.Worker,
@@ -24,7 +24,7 @@ func splitPermissions(permissions []perms.Permission) (directIds, directScopeIds
 	// are not in global, if this matches we can actually ignore everything
 	// else.
 	for _, perm := range permissions {
-		if perm.GrantScopeId == globals.GrantScopeDescendants && perm.All {
+		if perm.GrantScopeId == globals.GrantScopeDescendants && perm.All && !perm.Deny {
 			allDescendants = true
 			return
 		}
@@ -37,6 +37,8 @@ func splitPermissions(permissions []perms.Permission) (directIds, directScopeIds
 		switch {
 		case allDescendants:
 			// See the above check; we don't need any other info
+		case perm.Deny:
+			// Denied targets are excluded separately, see deniedTargetsQuery
 		case perm.GrantScopeId == scope.Global.String() || strings.HasPrefix(perm.GrantScopeId, globals.OrgPrefix):
 			// There are no targets in global or orgs
 		case perm.RoleScopeId == scope.Global.String() && perm.GrantScopeId == globals.GrantScopeChildren:
@@ -63,6 +65,47 @@ func splitPermissions(permissions []perms.Permission) (directIds, directScopeIds
 	return
 }
 
+// deniedTargetsQuery returns a query selecting the IDs of the targets matched
+// by the permissions with Deny set, along with its arguments. The query is
+// empty if no permissions deny targets.
+func deniedTargetsQuery(permissions []perms.Permission) (string, []any) {
+	var args []any
+	var targetClauses []string
+	for i, perm := range permissions {
+		if !perm.Deny {
+			continue
+		}
+		var clauses []string
+		switch perm.GrantScopeId {
+		case globals.GrantScopeDescendants:
+			// Matches targets in all scopes
+		case globals.GrantScopeChildren:
+			clauses = append(clauses, fmt.Sprintf("project_id in (select public_id from iam_scope where parent_id = @denied_scope_id_%d)", i))
+			args = append(args, sql.Named(fmt.Sprintf("denied_scope_id_%d", i), perm.RoleScopeId))
+		default:
+			clauses = append(clauses, fmt.Sprintf("project_id = @denied_scope_id_%d", i))
+			args = append(args, sql.Named(fmt.Sprintf("denied_scope_id_%d", i), perm.GrantScopeId))
+		}
+		if !perm.All {
+			var idClauses []string
+			for j, pattern := range perm.ResourceIdLikePatterns() {
+				name := fmt.Sprintf("denied_target_id_%d_%d", i, j)
+				idClauses = append(idClauses, "public_id like @"+name)
+				args = append(args, sql.Named(name, pattern))
+			}
+			clauses = append(clauses, fmt.Sprintf("(%s)", strings.Join(idClauses, " or ")))
+		}
+		if len(clauses) == 0 {
+			clauses = append(clauses, "true")
+		}
+		targetClauses = append(targetClauses, fmt.Sprintf("(%s)", strings.Join(clauses, " and ")))
+	}
+	if len(targetClauses) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf("select public_id from target where %s", strings.Join(targetClauses, " or ")), args
+}
+
 // listResolvableAliases lists aliases which have a destination id set to that
 // of a target for which there is permission in the provided slice of permissions.
 // Only WithLimit and WithStartPageAfterItem options are supported.
@@ -121,6 +164,10 @@ func (r *Repository) listResolvableAliases(ctx context.Context, permissions []pe
 	}
 
 	whereClause := fmt.Sprintf("destination_id is not null and (%s)", strings.Join(destinationIdClauses, " or "))
+	if deniedQuery, deniedArgs := deniedTargetsQuery(permissions); deniedQuery != "" {
+		whereClause = fmt.Sprintf("%s and destination_id not in (%s)", whereClause, deniedQuery)
+		args = append(args, deniedArgs...)
+	}
 
 	if opts.withStartPageAfterItem != nil {
 		whereClause = fmt.Sprintf("(create_time, public_id) < (@last_item_create_time, @last_item_id) and %s", whereClause)
@@ -200,6 +247,10 @@ func (r *Repository) listResolvableAliasesRefresh(ctx context.Context, updatedAf
 	args = append(args,
 		sql.Named("updated_after_time", timestamp.New(updatedAfter)),
 	)
+	if deniedQuery, deniedArgs := deniedTargetsQuery(permissions); deniedQuery != "" {
+		whereClause = fmt.Sprintf("%s and destination_id not in (%s)", whereClause, deniedQuery)
+		args = append(args, deniedArgs...)
+	}
 	if opts.withStartPageAfterItem != nil {
 		whereClause = fmt.Sprintf("(update_time, public_id) < (@last_item_update_time, @last_item_id) and %s", whereClause)
 		args = append(args,
@@ -263,8 +314,13 @@ func (r *Repository) listRemovedResolvableAliasIds(ctx context.Context, since ti
 		}
 	}
 
+	notMatchingClause := strings.Join(destinationIdClauses, " and ")
+	if deniedQuery, deniedArgs := deniedTargetsQuery(permissions); deniedQuery != "" {
+		notMatchingClause = fmt.Sprintf("(%s) or destination_id in (%s)", notMatchingClause, deniedQuery)
+		args = append(args, deniedArgs...)
+	}
 	whereClause := fmt.Sprintf("update_time > @updated_after_time and (destination_id is null or (%s))",
-		strings.Join(destinationIdClauses, " and "))
+		notMatchingClause)
 	args = append(args,
 		sql.Named("updated_after_time", timestamp.New(since)),
 	)
@@ -272,6 +272,21 @@ var inputStructs = []*structInfo{
 		outFile:     "users/account.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &users.ExplainedPrincipal{},
+		outFile:     "users/explained_principal.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &users.ExplainedGrant{},
+		outFile:     "users/explained_grant.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &users.PermissionExplanation{},
+		outFile:     "users/permission_explanation.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &users.User{},
 		outFile: "users/user.gen.go",
@@ -338,6 +353,31 @@ var inputStructs = []*structInfo{
 		outFile:     "roles/grant_json.gen.go",
 		skipOptions: true,
 	},
+	{
+		inProto:     &roles.GrantConditions{},
+		outFile:     "roles/grant_conditions.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &roles.AccessRequest{},
+		outFile:     "roles/access_request.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &roles.GrantValidation{},
+		outFile:     "roles/grant_validation.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &roles.NewlyAllowedPermission{},
+		outFile:     "roles/newly_allowed_permission.gen.go",
+		skipOptions: true,
+	},
+	{
+		inProto:     &roles.GrantsValidation{},
+		outFile:     "roles/grants_validation.gen.go",
+		skipOptions: true,
+	},
 	{
 		inProto: &roles.Role{},
 		outFile: "roles/role.gen.go",
@@ -551,6 +591,15 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:        &credentialstores.SshCaCredentialStoreAttributes{},
+		outFile:        "credentialstores/ssh_ca_credential_store_attributes.gen.go",
+		subtypeName:    "SshCaCredentialStore",
+		parentTypeName: "CredentialStore",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentialstores.CredentialStore{},
 		outFile: "credentialstores/credential_store.gen.go",
@@ -641,6 +690,30 @@ var inputStructs = []*structInfo{
 			mapstructureConversionTemplate,
 		},
 	},
+	{
+		inProto:     &credentiallibraries.SshCaCertificateCredentialLibraryAttributes{},
+		outFile:     "credentiallibraries/ssh_ca_certificate_credential_library_attributes.gen.go",
+		subtypeName: "SshCaCertificateCredentialLibrary",
+		subtype:     "ssh-ca-certificate",
+		fieldOverrides: []fieldInfo{
+			{
+				Name:        "Username",
+				SkipDefault: true,
+			},
+			{
+				Name:      "CriticalOptions",
+				FieldType: "map[string]string",
+			},
+			{
+				Name:      "Extensions",
+				FieldType: "map[string]string",
+			},
+		},
+		parentTypeName: "CredentialLibrary",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &credentiallibraries.CredentialLibrary{},
 		outFile: "credentiallibraries/credential_library.gen.go",
@@ -1075,6 +1148,24 @@ var inputStructs = []*structInfo{
 			},
 		},
 	},
+	{
+		inProto:        &targets.PostgresTargetAttributes{},
+		outFile:        "targets/postgres_target_attributes.gen.go",
+		subtypeName:    "PostgresTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
+	{
+		inProto:        &targets.HttpTargetAttributes{},
+		outFile:        "targets/http_target_attributes.gen.go",
+		subtypeName:    "HttpTarget",
+		parentTypeName: "Target",
+		templates: []*template.Template{
+			mapstructureConversionTemplate,
+		},
+	},
 	{
 		inProto: &targets.Target{},
 		outFile: "targets/target.gen.go",
@@ -1327,6 +1418,22 @@ var inputStructs = []*structInfo{
 			{Name: "BytesDown", JsonTags: []string{"string"}},
 		},
 	},
+	{
+		inProto: &session_recordings.SearchMatch{},
+		outFile: "sessionrecordings/search_match.gen.go",
+	},
+	{
+		inProto: &session_recordings.RetentionReport{},
+		outFile: "sessionrecordings/retention_report.gen.go",
+	},
+	{
+		inProto: &session_recordings.RetentionReportItem{},
+		outFile: "sessionrecordings/retention_report_item.gen.go",
+	},
+	{
+		inProto: &session_recordings.LegalHold{},
+		outFile: "sessionrecordings/legal_hold.gen.go",
+	},
 	{
 		// this must be the last block of session recording blocks, otherwise
 		// the bits beyond inProto and outFile will get overwritten by
@@ -74,6 +74,7 @@ type AuthMethodListQueryResult struct {
 	PasswordConfId     string
 	MinLoginNameLength uint32
 	MinPasswordLength  uint32
+	TotpRequired       bool
 	// The subtype of the auth method.
 	Subtype string
 }
@@ -27,9 +27,9 @@ func allocAuthMethod() AuthMethod {
 }
 
 // NewAuthMethod creates a new in memory AuthMethod assigned to scopeId.
-// Name and description are the only valid options. All other options are
-// ignored.  MinLoginNameLength and MinPasswordLength are pre-set to the
-// default values of 5 and 8 respectively.
+// Name, description and WithTotpRequired are the only valid options. All
+// other options are ignored.  MinLoginNameLength and MinPasswordLength are
+// pre-set to the default values of 5 and 8 respectively.
 func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMethod, error) {
 	const op = "password.NewAuthMethod"
 	if scopeId == "" {
@@ -44,6 +44,7 @@ func NewAuthMethod(ctx context.Context, scopeId string, opt ...Option) (*AuthMet
 			Description:        opts.withDescription,
 			MinLoginNameLength: 3,
 			MinPasswordLength:  8,
+			TotpRequired:       opts.withTotpRequired,
 		},
 	}
 	return a, nil
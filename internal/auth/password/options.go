@@ -30,6 +30,8 @@ type options struct {
 	withOrderByCreateTime  bool
 	ascending              bool
 	withStartPageAfterItem pagination.Item
+	withTotpRequired       bool
+	withTotpCode           string
 }
 
 func getDefaultOptions() options {
@@ -106,3 +108,19 @@ func WithStartPageAfterItem(item pagination.Item) Option {
 		o.withStartPageAfterItem = item
 	}
 }
+
+// WithTotpRequired provides an option to require every account of an auth
+// method to provide a TOTP code to authenticate.
+func WithTotpRequired(required bool) Option {
+	return func(o *options) {
+		o.withTotpRequired = required
+	}
+}
+
+// WithTotpCode provides an optional TOTP code or recovery code to
+// authenticate an account enrolled in TOTP.
+func WithTotpCode(code string) Option {
+	return func(o *options) {
+		o.withTotpCode = code
+	}
+}
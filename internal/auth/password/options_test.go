@@ -36,6 +36,18 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withDescription = "test desc"
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithTotpRequired", func(t *testing.T) {
+		opts := GetOpts(WithTotpRequired(true))
+		testOpts := getDefaultOptions()
+		testOpts.withTotpRequired = true
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithTotpCode", func(t *testing.T) {
+		opts := GetOpts(WithTotpCode("123456"))
+		testOpts := getDefaultOptions()
+		testOpts.withTotpCode = "123456"
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithLimit", func(t *testing.T) {
 		opts := GetOpts(WithLimit(5))
 		testOpts := getDefaultOptions()
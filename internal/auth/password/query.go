@@ -23,7 +23,13 @@ select acct.name,                        -- Account.Name
        conf.iterations,                  -- Argon2Configuration.Iterations
        conf.memory,                      -- Argon2Configuration.Memory
        conf.threads,                     -- Argon2Configuration.Threads
-       meth.password_conf_id = cred.password_conf_id as is_current_conf
+       meth.password_conf_id = cred.password_conf_id as is_current_conf,
+       meth.totp_required,               -- authAccount.TotpRequired
+       exists (
+         select 1
+           from auth_password_totp totp
+          where totp.account_id = acct.public_id
+       ) as totp_enrolled                -- authAccount.TotpEnrolled
   from auth_password_argon2_cred cred,
        auth_password_argon2_conf conf,
        auth_password_account acct,
@@ -29,6 +29,7 @@ func (authMethodHooks) NewAuthMethod(ctx context.Context, result *auth.AuthMetho
 	am.PasswordConfId = result.PasswordConfId
 	am.MinLoginNameLength = result.MinLoginNameLength
 	am.MinPasswordLength = result.MinPasswordLength
+	am.TotpRequired = result.TotpRequired
 
 	return &am, nil
 }
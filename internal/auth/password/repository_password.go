@@ -22,6 +22,8 @@ type authAccount struct {
 	*Argon2Credential
 	*Argon2Configuration
 	IsCurrentConf bool
+	TotpRequired  bool
+	TotpEnrolled  bool
 }
 
 // Authenticate authenticates loginName and password match for loginName in
@@ -35,7 +37,13 @@ type authAccount struct {
 // Authenticate will update the stored values for password to the current
 // password settings for authMethodId if authentication is successful and
 // the stored values are not using the current password settings.
-func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string) (*Account, error) {
+//
+// If the account is enrolled in TOTP, WithTotpCode must contain a valid
+// TOTP code or an unused recovery code for the account. Returns nil, error
+// with code TotpCodeRequired if loginName and password match but no TOTP
+// code was provided. Returns nil, error with code TotpEnrollmentRequired if
+// authMethodId requires TOTP and the account is not enrolled in TOTP.
+func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, loginName, password string, opt ...Option) (*Account, error) {
 	const op = "password.(Repository).Authenticate"
 	if authMethodId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing authMethodId", errors.WithoutEvent())
@@ -63,6 +71,24 @@ func (r *Repository) Authenticate(ctx context.Context, scopeId, authMethodId, lo
 		return nil, nil
 	}
 
+	opts := GetOpts(opt...)
+	switch {
+	case acct.TotpEnrolled:
+		if opts.withTotpCode == "" {
+			return nil, errors.New(ctx, errors.TotpCodeRequired, op, "missing totp code", errors.WithoutEvent())
+		}
+		ok, err := r.validateTotp(ctx, scopeId, acct.PublicId, opts.withTotpCode)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if !ok {
+			// authentication failed, totp code does not match
+			return nil, nil
+		}
+	case acct.TotpRequired:
+		return nil, errors.New(ctx, errors.TotpEnrollmentRequired, op, "account not enrolled in totp", errors.WithoutEvent())
+	}
+
 	if !acct.IsCurrentConf {
 		cc, err := r.currentConfig(ctx, authMethodId)
 		if err != nil {
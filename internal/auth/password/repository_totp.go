@@ -0,0 +1,253 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// EnrollTotp enrolls accountId in TOTP with a new random secret and a new
+// set of recovery codes. An existing TOTP enrollment for accountId and its
+// recovery codes are replaced. The returned TotpEnrollment contains the
+// secret, its otpauth URI and the recovery codes. They are encrypted with
+// the database wrapper of scopeId and cannot be retrieved again.
+//
+// Returns nil, error with code RecordNotFound if the account doesn't exist.
+func (r *Repository) EnrollTotp(ctx context.Context, scopeId, accountId string) (*TotpEnrollment, error) {
+	const op = "password.(Repository).EnrollTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	acct, err := r.LookupAccount(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if acct == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("account %q not found", accountId))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+	databaseWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+
+	totp, err := newTotp(ctx, accountId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	enrollment := &TotpEnrollment{
+		AccountId: accountId,
+		Secret:    totpEncoding.EncodeToString(totp.Secret),
+		Uri:       totpUri(acct.GetLoginName(), totp.Secret),
+	}
+	if err := totp.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	codes := make([]*TotpRecoveryCode, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := newRecoveryCode(rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.GenKey))
+		}
+		rc, err := newTotpRecoveryCode(ctx, accountId, code)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if err := rc.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		codes = append(codes, rc)
+		enrollment.RecoveryCodes = append(enrollment.RecoveryCodes, code)
+	}
+
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(rr db.Reader, w db.Writer) error {
+			oldTotp := allocTotp()
+			if err := rr.LookupWhere(ctx, oldTotp, "account_id = ?", []any{accountId}); err != nil {
+				if !errors.IsNotFoundError(err) {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			if oldTotp.AccountId != "" {
+				// deleting the existing enrollment also deletes its recovery
+				// codes
+				dTotp := oldTotp.clone()
+				if _, err := w.Delete(ctx, dTotp, db.WithOplog(oplogWrapper, oldTotp.oplog(oplog.OpType_OP_TYPE_DELETE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete existing totp enrollment"))
+				}
+			}
+			newTotp := totp.clone()
+			if err := w.Create(ctx, newTotp, db.WithOplog(oplogWrapper, newTotp.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create totp enrollment"))
+			}
+			for _, rc := range codes {
+				newCode := rc.clone()
+				if err := w.Create(ctx, newCode, db.WithOplog(oplogWrapper, newCode.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create totp recovery code"))
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return enrollment, nil
+}
+
+// RemoveTotp removes the TOTP enrollment and the recovery codes of
+// accountId. The number of enrollments removed is returned, 0 if accountId
+// is not enrolled in TOTP.
+func (r *Repository) RemoveTotp(ctx context.Context, scopeId, accountId string) (int, error) {
+	const op = "password.(Repository).RemoveTotp"
+	if accountId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if scopeId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			dTotp := allocTotp()
+			dTotp.AccountId = accountId
+			var err error
+			rowsDeleted, err = w.Delete(ctx, dTotp, db.WithOplog(oplogWrapper, dTotp.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(accountId))
+	}
+	return rowsDeleted, nil
+}
+
+// validateTotp returns true if code is a valid TOTP code or an unused
+// recovery code for accountId. A TOTP code can only be used once and a
+// recovery code is deleted when it is used.
+func (r *Repository) validateTotp(ctx context.Context, scopeId, accountId, code string) (bool, error) {
+	const op = "password.(Repository).validateTotp"
+
+	totp := allocTotp()
+	if err := r.reader.LookupWhere(ctx, totp, "account_id = ?", []any{accountId}); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	totpWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(totp.GetKeyId()))
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := totp.decrypt(ctx, totpWrapper); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	if counter, ok := validateTotpCode(totp.Secret, code, time.Now(), totp.GetLastUsedCounter()); ok {
+		var rowsUpdated int
+		_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				upTotp := allocTotp()
+				upTotp.AccountId = accountId
+				upTotp.LastUsedCounter = counter
+				var err error
+				// the where clause prevents the same code from being used
+				// by concurrent requests
+				rowsUpdated, err = w.Update(ctx, upTotp, []string{"LastUsedCounter"}, nil,
+					db.WithWhere("last_used_counter < ?", counter),
+					db.WithOplog(oplogWrapper, upTotp.oplog(oplog.OpType_OP_TYPE_UPDATE)))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsUpdated > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update totp last used counter"))
+		}
+		return rowsUpdated == 1, nil
+	}
+
+	var codes []*TotpRecoveryCode
+	if err := r.reader.SearchWhere(ctx, &codes, "account_id = ?", []any{accountId}); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	code = normalizeRecoveryCode(code)
+	for _, rc := range codes {
+		if err := r.decryptRecoveryCode(ctx, scopeId, rc); err != nil {
+			return false, errors.Wrap(ctx, err, op)
+		}
+		if subtle.ConstantTimeCompare(rc.Code, []byte(code)) == 0 {
+			continue
+		}
+		var rowsDeleted int
+		_, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+			func(_ db.Reader, w db.Writer) error {
+				dCode := rc.clone()
+				var err error
+				rowsDeleted, err = w.Delete(ctx, dCode, db.WithOplog(oplogWrapper, dCode.oplog(oplog.OpType_OP_TYPE_DELETE)))
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rowsDeleted > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete used recovery code"))
+		}
+		// a concurrent request used the recovery code if it was not deleted
+		return rowsDeleted == 1, nil
+	}
+	return false, nil
+}
+
+func (r *Repository) decryptRecoveryCode(ctx context.Context, scopeId string, rc *TotpRecoveryCode) error {
+	const op = "password.(Repository).decryptRecoveryCode"
+	wrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase, kms.WithKeyId(rc.GetKeyId()))
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := rc.decrypt(ctx, wrapper); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
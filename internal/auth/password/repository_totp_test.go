@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_EnrollTotp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := TestAccount(t, conn, authMethod.PublicId, "kazmierczak")
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("missing-account-id", func(t *testing.T) {
+		got, err := repo.EnrollTotp(ctx, o.GetPublicId(), "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(t, got)
+	})
+	t.Run("missing-scope-id", func(t *testing.T) {
+		got, err := repo.EnrollTotp(ctx, "", acct.PublicId)
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(t, got)
+	})
+	t.Run("account-not-found", func(t *testing.T) {
+		got, err := repo.EnrollTotp(ctx, o.GetPublicId(), "acctpw_1234567890")
+		assert.Truef(t, errors.IsNotFoundError(err), "want not found error, got: %q", err)
+		assert.Nil(t, got)
+	})
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(acct.PublicId, got.AccountId)
+		assert.Len(got.RecoveryCodes, recoveryCodeCount)
+		u, err := url.Parse(got.Uri)
+		require.NoError(err)
+		assert.Equal(got.Secret, u.Query().Get("secret"))
+
+		var codes []*TotpRecoveryCode
+		require.NoError(rw.SearchWhere(ctx, &codes, "account_id = ?", []any{acct.PublicId}))
+		assert.Len(codes, recoveryCodeCount)
+
+		// enrolling again replaces the secret and the recovery codes
+		again, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+		require.NoError(err)
+		assert.NotEqual(got.Secret, again.Secret)
+		assert.NotEqual(got.RecoveryCodes, again.RecoveryCodes)
+		codes = nil
+		require.NoError(rw.SearchWhere(ctx, &codes, "account_id = ?", []any{acct.PublicId}))
+		assert.Len(codes, recoveryCodeCount)
+	})
+}
+
+func TestRepository_RemoveTotp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := TestAccount(t, conn, authMethod.PublicId, "kazmierczak")
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	_, err = repo.RemoveTotp(ctx, o.GetPublicId(), "")
+	assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+
+	_, err = repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(t, err)
+
+	rows, err := repo.RemoveTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rows)
+
+	var codes []*TotpRecoveryCode
+	require.NoError(t, rw.SearchWhere(ctx, &codes, "account_id = ?", []any{acct.PublicId}))
+	assert.Empty(t, codes)
+
+	rows, err = repo.RemoveTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(t, err)
+	assert.Equal(t, 0, rows)
+}
+
+func TestRepository_AuthenticateTotp(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	authMethod := TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	passwd := "12345678"
+	acct, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+		Account: &store.Account{
+			AuthMethodId: authMethod.PublicId,
+			LoginName:    "kazmierczak",
+		},
+	}, WithPassword(passwd))
+	require.NoError(t, err)
+
+	enrollment, err := repo.EnrollTotp(ctx, o.GetPublicId(), acct.PublicId)
+	require.NoError(t, err)
+	secret, err := totpEncoding.DecodeString(enrollment.Secret)
+	require.NoError(t, err)
+
+	t.Run("missing-code", func(t *testing.T) {
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd)
+		assert.Truef(t, errors.Match(errors.T(errors.TotpCodeRequired), err), "want err: %q got: %q", errors.TotpCodeRequired, err)
+		assert.Nil(t, got)
+	})
+	t.Run("wrong-password-with-code", func(t *testing.T) {
+		code := totpCode(secret, totpCounter(time.Now()))
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, "wrong password", WithTotpCode(code))
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("wrong-code", func(t *testing.T) {
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd, WithTotpCode("abcdef"))
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+	t.Run("valid-code-used-once", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		code := totpCode(secret, totpCounter(time.Now()))
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd, WithTotpCode(code))
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(acct.PublicId, got.PublicId)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd, WithTotpCode(code))
+		assert.NoError(err)
+		assert.Nil(got)
+	})
+	t.Run("recovery-code-used-once", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		code := enrollment.RecoveryCodes[0]
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd, WithTotpCode(code))
+		require.NoError(err)
+		require.NotNil(got)
+		assert.Equal(acct.PublicId, got.PublicId)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, acct.LoginName, passwd, WithTotpCode(code))
+		assert.NoError(err)
+		assert.Nil(got)
+	})
+	t.Run("totp-required-not-enrolled", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		other, err := repo.CreateAccount(ctx, o.GetPublicId(), &Account{
+			Account: &store.Account{
+				AuthMethodId: authMethod.PublicId,
+				LoginName:    "other",
+			},
+		}, WithPassword(passwd))
+		require.NoError(err)
+
+		got, err := repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, other.LoginName, passwd)
+		require.NoError(err)
+		require.NotNil(got)
+
+		upAuthMethod := authMethod.Clone()
+		upAuthMethod.TotpRequired = true
+		_, _, err = repo.UpdateAuthMethod(ctx, upAuthMethod, upAuthMethod.Version, []string{"TotpRequired"})
+		require.NoError(err)
+
+		got, err = repo.Authenticate(ctx, o.GetPublicId(), authMethod.PublicId, other.LoginName, passwd)
+		assert.Truef(errors.Match(errors.T(errors.TotpEnrollmentRequired), err), "want err: %q got: %q", errors.TotpEnrollmentRequired, err)
+		assert.Nil(got)
+	})
+}
@@ -88,3 +88,122 @@ func TestRewrap_argon2ConfigRewrapFn(t *testing.T) {
 		assert.NotEqual(t, cred.GetCtSalt(), got.GetCtSalt())
 	})
 }
+
+func TestRewrap_totpRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_oplog_schema_version" WHERE 1=1 ORDER BY "kms_oplog_schema_version"."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`SELECT \* FROM "auth_password_totp" WHERE key_id=\$1`,
+		).WillReturnError(errors.New("Query error"))
+		err := totpRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+
+		org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		aut := TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+		acct := TestAccount(t, conn, aut.PublicId, "name")
+
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		wrapper, _ = kmsCache.GetWrapper(context.Background(), org.GetPublicId(), kms.KeyPurposeDatabase)
+
+		totp, err := newTotp(ctx, acct.PublicId)
+		require.NoError(t, err)
+		require.NoError(t, totp.encrypt(ctx, wrapper))
+		require.NoError(t, rw.Create(ctx, totp))
+
+		// now things are stored in the db, we can rotate and rewrap
+		require.NoError(t, kmsCache.RotateKeys(ctx, org.Scope.GetPublicId()))
+		require.NoError(t, totpRewrapFn(ctx, totp.KeyId, org.Scope.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocTotp()
+		require.NoError(t, rw.LookupWhere(ctx, got, "account_id = ?", []any{acct.PublicId}))
+
+		kmsWrapper, err := kmsCache.GetWrapper(ctx, org.Scope.GetPublicId(), kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		require.NoError(t, err)
+		newKeyVersion, err := kmsWrapper.KeyId(ctx)
+		require.NoError(t, err)
+
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper))
+		assert.NotEqual(t, totp.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersion, got.GetKeyId())
+		assert.Equal(t, totp.GetSecret(), got.GetSecret())
+		assert.NotEqual(t, totp.GetCtSecret(), got.GetCtSecret())
+	})
+}
+
+func TestRewrap_totpRecoveryCodeRewrapFn(t *testing.T) {
+	ctx := context.Background()
+	t.Run("errors-on-query-error", func(t *testing.T) {
+		conn, mock := db.TestSetupWithMock(t)
+		wrapper := db.TestWrapper(t)
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_schema_version" WHERE 1=1 ORDER BY "kms_schema_version"\."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		mock.ExpectQuery(
+			`SELECT \* FROM "kms_oplog_schema_version" WHERE 1=1 ORDER BY "kms_oplog_schema_version"."version" LIMIT \$1`,
+		).WillReturnRows(sqlmock.NewRows([]string{"version", "create_time"}).AddRow(migrations.Version, time.Now()))
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		rw := db.New(conn)
+		mock.ExpectQuery(
+			`SELECT \* FROM "auth_password_totp_recovery_code" WHERE key_id=\$1`,
+		).WillReturnError(errors.New("Query error"))
+		err := totpRecoveryCodeRewrapFn(ctx, "some_id", "some_scope", rw, rw, kmsCache)
+		require.Error(t, err)
+	})
+	t.Run("success", func(t *testing.T) {
+		conn, _ := db.TestSetup(t, "postgres")
+
+		rw := db.New(conn)
+		wrapper := db.TestWrapper(t)
+
+		org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		aut := TestAuthMethods(t, conn, org.GetPublicId(), 1)[0]
+		acct := TestAccount(t, conn, aut.PublicId, "name")
+
+		kmsCache := kms.TestKms(t, conn, wrapper)
+		wrapper, _ = kmsCache.GetWrapper(context.Background(), org.GetPublicId(), kms.KeyPurposeDatabase)
+
+		totp, err := newTotp(ctx, acct.PublicId)
+		require.NoError(t, err)
+		require.NoError(t, totp.encrypt(ctx, wrapper))
+		require.NoError(t, rw.Create(ctx, totp))
+		code, err := newTotpRecoveryCode(ctx, acct.PublicId, "abcdefgh-ijklmnop")
+		require.NoError(t, err)
+		require.NoError(t, code.encrypt(ctx, wrapper))
+		require.NoError(t, rw.Create(ctx, code))
+
+		// now things are stored in the db, we can rotate and rewrap
+		require.NoError(t, kmsCache.RotateKeys(ctx, org.Scope.GetPublicId()))
+		require.NoError(t, totpRecoveryCodeRewrapFn(ctx, code.KeyId, org.Scope.GetPublicId(), rw, rw, kmsCache))
+
+		got := allocTotpRecoveryCode()
+		got.PrivateId = code.PrivateId
+		require.NoError(t, rw.LookupById(ctx, got))
+
+		kmsWrapper, err := kmsCache.GetWrapper(ctx, org.Scope.GetPublicId(), kms.KeyPurposeDatabase, kms.WithKeyId(got.GetKeyId()))
+		require.NoError(t, err)
+		newKeyVersion, err := kmsWrapper.KeyId(ctx)
+		require.NoError(t, err)
+
+		assert.NoError(t, got.decrypt(ctx, kmsWrapper))
+		assert.NotEqual(t, code.GetKeyId(), got.GetKeyId())
+		assert.Equal(t, newKeyVersion, got.GetKeyId())
+		assert.Equal(t, code.GetCode(), got.GetCode())
+		assert.NotEqual(t, code.GetCtCode(), got.GetCtCode())
+	})
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/auth/password/store"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	"google.golang.org/protobuf/proto"
+)
+
+// TOTP parameters. These are the defaults of RFC 6238 and the only values
+// supported by most authenticator apps.
+const (
+	totpIssuer     = "Boundary"
+	totpSecretSize = 20
+	totpDigits     = 6
+	totpPeriod     = 30 * time.Second
+
+	// totpSkew is the number of time steps before and after the current
+	// time step a TOTP code is accepted for, to allow for clock drift.
+	totpSkew = 1
+
+	recoveryCodeCount = 10
+	recoveryCodeSize  = 10
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// totpCounter returns the RFC 6238 time step counter for t.
+func totpCounter(t time.Time) int64 {
+	return t.Unix() / int64(totpPeriod/time.Second)
+}
+
+// totpCode returns the RFC 4226 HOTP code for secret and counter.
+func totpCode(secret []byte, counter int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod)
+}
+
+// validateTotpCode returns the time step counter code is valid for at time
+// now. Returns false if code is not valid for any time step within the
+// allowed skew or if code is only valid for a time step at or before
+// lastUsedCounter.
+func validateTotpCode(secret []byte, code string, now time.Time, lastUsedCounter int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+	current := totpCounter(now)
+	for c := current - totpSkew; c <= current+totpSkew; c++ {
+		if c <= lastUsedCounter {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(totpCode(secret, c)), []byte(code)) == 1 {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// totpUri returns the otpauth URI for secret which authenticator apps use
+// to enroll the account loginName, usually by scanning it as a QR code.
+func totpUri(loginName string, secret []byte) string {
+	v := url.Values{}
+	v.Set("secret", totpEncoding.EncodeToString(secret))
+	v.Set("issuer", totpIssuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(totpDigits))
+	v.Set("period", fmt.Sprint(int(totpPeriod/time.Second)))
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + totpIssuer + ":" + loginName,
+		RawQuery: v.Encode(),
+	}
+	return u.String()
+}
+
+// newRecoveryCode returns a random recovery code read from r.
+func newRecoveryCode(r io.Reader) (string, error) {
+	b := make([]byte, recoveryCodeSize)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	code := totpEncoding.EncodeToString(b)
+	return strings.ToLower(code[:8] + "-" + code[8:16]), nil
+}
+
+// normalizeRecoveryCode returns code in the format returned by
+// newRecoveryCode so codes are accepted regardless of case and surrounding
+// whitespace.
+func normalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.TrimSpace(code))
+}
+
+// A Totp contains the TOTP shared secret of an account enrolled in TOTP.
+type Totp struct {
+	*store.Totp
+	tableName string
+}
+
+// newTotp creates a new in memory Totp for accountId with a random secret.
+func newTotp(ctx context.Context, accountId string) (*Totp, error) {
+	const op = "password.newTotp"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.GenKey))
+	}
+	return &Totp{
+		Totp: &store.Totp{
+			AccountId: accountId,
+			Secret:    secret,
+		},
+	}, nil
+}
+
+func allocTotp() *Totp {
+	return &Totp{
+		Totp: &store.Totp{},
+	}
+}
+
+func (t *Totp) clone() *Totp {
+	cp := proto.Clone(t.Totp)
+	return &Totp{
+		Totp: cp.(*store.Totp),
+	}
+}
+
+// TableName returns the table name.
+func (t *Totp) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return "auth_password_totp"
+}
+
+// SetTableName sets the table name.
+func (t *Totp) SetTableName(n string) {
+	t.tableName = n
+}
+
+func (t *Totp) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	t.KeyId = keyId
+	return nil
+}
+
+func (t *Totp) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(Totp).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, t.Totp, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (t *Totp) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.AccountId},
+		"resource-type":      []string{"password totp"},
+		"op-type":            []string{op.String()},
+	}
+	return metadata
+}
+
+// A TotpRecoveryCode is a single use code an account enrolled in TOTP can
+// use instead of a TOTP code to authenticate.
+type TotpRecoveryCode struct {
+	*store.TotpRecoveryCode
+	tableName string
+}
+
+func newTotpRecoveryCode(ctx context.Context, accountId, code string) (*TotpRecoveryCode, error) {
+	const op = "password.newTotpRecoveryCode"
+	if accountId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing account id")
+	}
+	if code == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing code")
+	}
+	id, err := newTotpRecoveryCodeId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &TotpRecoveryCode{
+		TotpRecoveryCode: &store.TotpRecoveryCode{
+			PrivateId: id,
+			AccountId: accountId,
+			Code:      []byte(code),
+		},
+	}, nil
+}
+
+func allocTotpRecoveryCode() *TotpRecoveryCode {
+	return &TotpRecoveryCode{
+		TotpRecoveryCode: &store.TotpRecoveryCode{},
+	}
+}
+
+func (c *TotpRecoveryCode) clone() *TotpRecoveryCode {
+	cp := proto.Clone(c.TotpRecoveryCode)
+	return &TotpRecoveryCode{
+		TotpRecoveryCode: cp.(*store.TotpRecoveryCode),
+	}
+}
+
+// TableName returns the table name.
+func (c *TotpRecoveryCode) TableName() string {
+	if c.tableName != "" {
+		return c.tableName
+	}
+	return "auth_password_totp_recovery_code"
+}
+
+// SetTableName sets the table name.
+func (c *TotpRecoveryCode) SetTableName(n string) {
+	c.tableName = n
+}
+
+func (c *TotpRecoveryCode) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(TotpRecoveryCode).encrypt"
+	if err := structwrapping.WrapStruct(ctx, cipher, c.TotpRecoveryCode, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	c.KeyId = keyId
+	return nil
+}
+
+func (c *TotpRecoveryCode) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "password.(TotpRecoveryCode).decrypt"
+	if err := structwrapping.UnwrapStruct(ctx, cipher, c.TotpRecoveryCode, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (c *TotpRecoveryCode) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{c.AccountId},
+		"resource-type":      []string{"password totp recovery code"},
+		"op-type":            []string{op.String()},
+	}
+	return metadata
+}
+
+// A TotpEnrollment is returned when an account is enrolled in TOTP. It is
+// the only time the secret and the recovery codes are available.
+type TotpEnrollment struct {
+	// AccountId is the public id of the enrolled account.
+	AccountId string
+	// Secret is the base32 encoded TOTP shared secret.
+	Secret string
+	// Uri is the otpauth URI of the secret for authenticator apps.
+	Uri string
+	// RecoveryCodes are the single use codes which can be used instead of
+	// a TOTP code.
+	RecoveryCodes []string
+}
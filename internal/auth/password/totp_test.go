@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package password
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the SHA1 secret of the RFC 6238 test vectors.
+var rfc6238Secret = []byte("12345678901234567890")
+
+func Test_totpCode(t *testing.T) {
+	t.Parallel()
+	// The RFC 6238 test vectors are 8 digit codes, the codes below are
+	// their last 6 digits.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+		{unix: 20000000000, want: "353130"},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			got := totpCode(rfc6238Secret, totpCounter(time.Unix(tt.unix, 0)))
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_validateTotpCode(t *testing.T) {
+	t.Parallel()
+	now := time.Unix(1234567890, 0)
+	current := totpCounter(now)
+
+	tests := []struct {
+		name            string
+		code            string
+		lastUsedCounter int64
+		wantCounter     int64
+		wantOk          bool
+	}{
+		{
+			name:        "current",
+			code:        totpCode(rfc6238Secret, current),
+			wantCounter: current,
+			wantOk:      true,
+		},
+		{
+			name:        "whitespace",
+			code:        " " + totpCode(rfc6238Secret, current) + "\n",
+			wantCounter: current,
+			wantOk:      true,
+		},
+		{
+			name:        "previous",
+			code:        totpCode(rfc6238Secret, current-1),
+			wantCounter: current - 1,
+			wantOk:      true,
+		},
+		{
+			name:        "next",
+			code:        totpCode(rfc6238Secret, current+1),
+			wantCounter: current + 1,
+			wantOk:      true,
+		},
+		{
+			name: "too-old",
+			code: totpCode(rfc6238Secret, current-2),
+		},
+		{
+			name: "too-new",
+			code: totpCode(rfc6238Secret, current+2),
+		},
+		{
+			name:            "already-used",
+			code:            totpCode(rfc6238Secret, current),
+			lastUsedCounter: current,
+		},
+		{
+			name: "wrong-length",
+			code: "12345",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			counter, ok := validateTotpCode(rfc6238Secret, tt.code, now, tt.lastUsedCounter)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.wantCounter, counter)
+		})
+	}
+}
+
+func Test_totpUri(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	got := totpUri("jim smith", rfc6238Secret)
+
+	u, err := url.Parse(got)
+	require.NoError(err)
+	assert.Equal("otpauth", u.Scheme)
+	assert.Equal("totp", u.Host)
+	assert.Equal("/Boundary:jim smith", u.Path)
+	q := u.Query()
+	assert.Equal("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", q.Get("secret"))
+	assert.Equal("Boundary", q.Get("issuer"))
+	assert.Equal("SHA1", q.Get("algorithm"))
+	assert.Equal("6", q.Get("digits"))
+	assert.Equal("30", q.Get("period"))
+}
+
+func Test_newRecoveryCode(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	got, err := newRecoveryCode(bytes.NewReader(bytes.Repeat([]byte{0xff}, recoveryCodeSize)))
+	require.NoError(err)
+	assert.Equal("77777777-77777777", got)
+	assert.Equal(got, normalizeRecoveryCode(" "+strings.ToUpper(got)+" "))
+
+	_, err = newRecoveryCode(bytes.NewReader(nil))
+	assert.Error(err)
+}
+
+func Test_newTotp(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := newTotp(ctx, "acctpw_1234567890")
+		require.NoError(err)
+		assert.Equal("acctpw_1234567890", got.GetAccountId())
+		assert.Len(got.GetSecret(), totpSecretSize)
+	})
+	t.Run("missing-account-id", func(t *testing.T) {
+		got, err := newTotp(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(t, got)
+	})
+}
@@ -102,6 +102,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'ldap' as subtype
       from ldap
      union
@@ -157,6 +158,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'oidc' as subtype
       from oidc
      union
@@ -212,6 +214,7 @@ final as (
            password_conf_id,
            min_login_name_length,
            min_password_length,
+           totp_required,
            'password' as subtype
       from password
 )
@@ -297,6 +300,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'ldap' as subtype
       from ldap
      union
@@ -352,6 +356,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'oidc' as subtype
       from oidc
      union
@@ -407,6 +412,7 @@ final as (
            password_conf_id,
            min_login_name_length,
            min_password_length,
+           totp_required,
            'password' as subtype
       from password
 )
@@ -492,6 +498,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'ldap' as subtype
       from ldap
      union
@@ -547,6 +554,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'oidc' as subtype
       from oidc
      union
@@ -602,6 +610,7 @@ final as (
            password_conf_id,
            min_login_name_length,
            min_password_length,
+           totp_required,
            'password' as subtype
       from password
 )
@@ -688,6 +697,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'ldap' as subtype
       from ldap
      union
@@ -743,6 +753,7 @@ final as (
            null as password_conf_id,
            null::integer as min_login_name_length,
            null::integer as min_password_length,
+           null::boolean as totp_required,
            'oidc' as subtype
       from oidc
      union
@@ -798,6 +809,7 @@ final as (
            password_conf_id,
            min_login_name_length,
            min_password_length,
+           totp_required,
            'password' as subtype
       from password
 )
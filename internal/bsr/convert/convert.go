@@ -92,3 +92,158 @@ func ToAsciicast(ctx context.Context, session *bsr.Session, tmp storage.TempFile
 		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
 	}
 }
+
+// ToTranscript accepts a bsr.Session and will convert the underlying BSR channel file to a plaintext transcript.
+// Each line of the transcript is prefixed with its timestamp and direction: stdin for inbound data, and stdout for
+// outbound data.
+// The tempFs will be used to write the transcript to disk
+// It returns an io.Reader to the converted transcript.
+// This supports the following options:
+//   - WithChannelId to indicate this conversion should occur on a channel on a multiplexed session
+func ToTranscript(ctx context.Context, session *bsr.Session, tmp storage.TempFile, connectionId string, options ...Option) (io.ReadCloser, error) {
+	const op = "convert.ToTranscript"
+
+	switch {
+	case is.Nil(session):
+		return nil, fmt.Errorf("%s: missing session: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(session.Meta):
+		return nil, fmt.Errorf("%s: missing session meta: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(tmp):
+		return nil, fmt.Errorf("%s: missing temp file: %w", op, bsr.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("%s: missing connection id: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	opts := getOpts(options...)
+
+	switch session.Meta.Protocol {
+	case ssh.Protocol:
+		ch, closeFn, err := openSshChannel(ctx, session, connectionId, opts.withChannelId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer closeFn()
+
+		scanners, err := openScanners(ctx, ch, messageScanners...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer closeScanners(scanners)
+		return sshChannelToTranscript(ctx, scanners[0], scanners[1], tmp)
+
+	default:
+		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
+	}
+}
+
+// ToJsonLines accepts a bsr.Session and will convert the underlying BSR channel files to a JSON Lines stream.
+// Every request and message chunk of the channel is written as a JSON object on its own line, in timestamp order.
+// The tempFs will be used to write the stream to disk
+// It returns an io.Reader to the converted stream.
+// This supports the following options:
+//   - WithChannelId to indicate this conversion should occur on a channel on a multiplexed session
+func ToJsonLines(ctx context.Context, session *bsr.Session, tmp storage.TempFile, connectionId string, options ...Option) (io.ReadCloser, error) {
+	const op = "convert.ToJsonLines"
+
+	switch {
+	case is.Nil(session):
+		return nil, fmt.Errorf("%s: missing session: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(session.Meta):
+		return nil, fmt.Errorf("%s: missing session meta: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(tmp):
+		return nil, fmt.Errorf("%s: missing temp file: %w", op, bsr.ErrInvalidParameter)
+	case connectionId == "":
+		return nil, fmt.Errorf("%s: missing connection id: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	opts := getOpts(options...)
+
+	switch session.Meta.Protocol {
+	case ssh.Protocol:
+		ch, closeFn, err := openSshChannel(ctx, session, connectionId, opts.withChannelId)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer closeFn()
+
+		// Requests are scanned first so that a request is written before any
+		// message with the same timestamp.
+		scanners, err := openScanners(ctx, ch, append(requestScanners, messageScanners...)...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		defer closeScanners(scanners)
+		return sshChannelToJsonLines(ctx, scanners, tmp)
+
+	default:
+		return nil, fmt.Errorf("%s: %w", op, ErrUnsupportedProtocol)
+	}
+}
+
+// openSshChannel opens the provided channel of the connection of an ssh
+// session. The returned function closes the channel and the connection.
+func openSshChannel(ctx context.Context, session *bsr.Session, connectionId, chanId string) (*bsr.Channel, func(), error) {
+	if chanId == "" {
+		return nil, nil, fmt.Errorf("protocol %q requires channel id to convert: %w", ssh.Protocol, bsr.ErrInvalidParameter)
+	}
+
+	conn, err := session.OpenConnection(ctx, connectionId)
+	if err != nil {
+		return nil, nil, err
+	}
+	ch, err := conn.OpenChannel(ctx, chanId)
+	if err != nil {
+		conn.Close(ctx)
+		return nil, nil, err
+	}
+	if _, ok := ch.Summary.(*ssh.ChannelSummary); !ok {
+		ch.Close(ctx)
+		conn.Close(ctx)
+		return nil, nil, fmt.Errorf("unexpected error occurred with channel summary: %w", ErrMalformedBsr)
+	}
+	return ch, func() {
+		ch.Close(ctx)
+		conn.Close(ctx)
+	}, nil
+}
+
+// scannerSource identifies a file of a channel that can be scanned.
+type scannerSource struct {
+	requests  bool
+	direction bsr.Direction
+}
+
+var (
+	requestScanners = []scannerSource{{requests: true, direction: bsr.Inbound}, {requests: true, direction: bsr.Outbound}}
+	messageScanners = []scannerSource{{direction: bsr.Inbound}, {direction: bsr.Outbound}}
+)
+
+// openScanners opens a scanner for each of the provided sources, in order. If
+// any scanner fails to open, the scanners already opened are closed.
+func openScanners(ctx context.Context, ch *bsr.Channel, sources ...scannerSource) ([]*bsr.ChunkScanner, error) {
+	scanners := make([]*bsr.ChunkScanner, 0, len(sources))
+	for _, s := range sources {
+		var sc *bsr.ChunkScanner
+		var err error
+		if s.requests {
+			sc, err = ch.OpenRequestScanner(ctx, s.direction)
+		} else {
+			sc, err = ch.OpenMessageScanner(ctx, s.direction)
+		}
+		if err != nil {
+			if !is.Nil(sc) {
+				sc.Close()
+			}
+			closeScanners(scanners)
+			return nil, err
+		}
+		scanners = append(scanners, sc)
+	}
+	return scanners, nil
+}
+
+func closeScanners(scanners []*bsr.ChunkScanner) {
+	for _, s := range scanners {
+		s.Close()
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/bsr/ssh"
 	"github.com/hashicorp/boundary/internal/storage"
 	"github.com/stretchr/testify/require"
+	gossh "golang.org/x/crypto/ssh"
 )
 
 func testChunks(s string, d bsr.Direction, p bsr.Protocol) []bsr.Chunk {
@@ -563,3 +564,137 @@ func TestConvert_ToAsciicast_Scanner(t *testing.T) {
 		})
 	}
 }
+
+func TestConvert_ToTranscript_ToJsonLines(t *testing.T) {
+	ctx := context.Background()
+
+	fs := &fstest.MemFS{}
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	id := "41234567890"
+	connectionId := "test_connection"
+	channelId := "test_channel"
+
+	keys, err := kms.CreateKeys(ctx, kms.TestWrapper(t), fmt.Sprintf("s_%s", id))
+	require.NoError(t, err)
+	keyFn := func(w kms.WrappedKeys) (kms.UnwrappedKeys, error) {
+		return kms.UnwrappedKeys{
+			BsrKey:  keys.BsrKey,
+			PrivKey: keys.PrivKey,
+		}, nil
+	}
+
+	srm := &bsr.SessionRecordingMeta{
+		Id:       fmt.Sprintf("sr_%s", id),
+		Protocol: ssh.Protocol,
+	}
+	sesh, err := bsr.NewSession(ctx, srm, bsr.TestSessionMeta(fmt.Sprintf("s_%s", id)), fs, keys, bsr.WithSupportsMultiplex(true))
+	require.NoError(t, err)
+	require.NoError(t, sesh.EncodeSummary(ctx, &bsr.BaseSessionSummary{Id: srm.Id}))
+	conn, err := sesh.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: connectionId})
+	require.NoError(t, err)
+	require.NoError(t, conn.EncodeSummary(ctx, &bsr.BaseConnectionSummary{
+		Id:           connectionId,
+		ChannelCount: 1,
+	}))
+	ch, err := conn.NewChannel(ctx, &bsr.ChannelRecordingMeta{Id: channelId, Type: "session"})
+	require.NoError(t, err)
+	require.NoError(t, ch.EncodeSummary(ctx, &ssh.ChannelSummary{
+		ChannelSummary: &bsr.BaseChannelSummary{
+			Id:                    channelId,
+			ConnectionRecordingId: connectionId,
+		},
+		SessionProgram: ssh.Exec,
+	}))
+
+	withChunks := func(d bsr.Direction, chunks ...bsr.Chunk) []bsr.Chunk {
+		c := testChunks(fmt.Sprintf("s_%s", id), d, ssh.Protocol)
+		return append(append([]bsr.Chunk{c[0]}, chunks...), c[1])
+	}
+	data := func(d bsr.Direction, offset time.Duration, s string) bsr.Chunk {
+		c, err := ssh.NewDataChunk(ctx, d, bsr.NewTimestamp(ts.Add(offset)), []byte(s))
+		require.NoError(t, err)
+		return c
+	}
+	exec, err := ssh.NewExecRequest(ctx, bsr.Inbound, bsr.NewTimestamp(ts.Add(time.Millisecond)), &gossh.Request{
+		Type:      ssh.ExecRequestType,
+		WantReply: true,
+		Payload:   gossh.Marshal(struct{ Command string }{"cat"}),
+	})
+	require.NoError(t, err)
+	exitStatus, err := ssh.NewExitStatusRequest(ctx, bsr.Outbound, bsr.NewTimestamp(ts.Add(4*time.Millisecond)), &gossh.Request{
+		Type:    ssh.ExitStatusRequestType,
+		Payload: gossh.Marshal(struct{ Status uint32 }{0}),
+	})
+	require.NoError(t, err)
+
+	files := []struct {
+		newWriter func(context.Context, bsr.Direction) (storage.Writer, error)
+		chunks    []bsr.Chunk
+	}{
+		{ch.NewRequestsWriter, withChunks(bsr.Inbound, exec)},
+		{ch.NewRequestsWriter, withChunks(bsr.Outbound, exitStatus)},
+		{ch.NewMessagesWriter, withChunks(bsr.Inbound, data(bsr.Inbound, 2*time.Millisecond, "hello\n"))},
+		{ch.NewMessagesWriter, withChunks(bsr.Outbound, data(bsr.Outbound, 3*time.Millisecond, "hello\n"))},
+	}
+	for _, f := range files {
+		w, err := f.newWriter(ctx, f.chunks[0].GetDirection())
+		require.NoError(t, err)
+		require.NoError(t, writeToChannels(ctx, w, f.chunks...))
+	}
+	require.NoError(t, ch.Close(ctx))
+	require.NoError(t, conn.Close(ctx))
+	require.NoError(t, sesh.Close(ctx))
+
+	opSesh, err := bsr.OpenSession(ctx, srm.Id, fs, keyFn)
+	require.NoError(t, err)
+
+	t.Run("transcript", func(t *testing.T) {
+		tmpfile, err := fstest.NewTempFile("convert")
+		require.NoError(t, err)
+		r, err := convert.ToTranscript(ctx, opSesh, tmpfile, connectionId, convert.WithChannelId(channelId))
+		require.NoError(t, err)
+		defer r.Close()
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "2023-03-16T10:47:03.002000014Z stdin  hello\n"+
+			"2023-03-16T10:47:03.003000014Z stdout hello\n", string(got))
+	})
+	t.Run("json-lines", func(t *testing.T) {
+		tmpfile, err := fstest.NewTempFile("convert")
+		require.NoError(t, err)
+		r, err := convert.ToJsonLines(ctx, opSesh, tmpfile, connectionId, convert.WithChannelId(channelId))
+		require.NoError(t, err)
+		defer r.Close()
+		got, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, `{"timestamp":"2023-03-16T10:47:03.000000014Z","protocol":"BSSH","direction":"inbound","type":"HEAD"}
+{"timestamp":"2023-03-16T10:47:03.000000014Z","protocol":"BSSH","direction":"outbound","type":"HEAD"}
+{"timestamp":"2023-03-16T10:47:03.000000014Z","protocol":"BSSH","direction":"inbound","type":"HEAD"}
+{"timestamp":"2023-03-16T10:47:03.000000014Z","protocol":"BSSH","direction":"outbound","type":"HEAD"}
+{"timestamp":"2023-03-16T10:47:03.001000014Z","protocol":"BSSH","direction":"inbound","type":"EXEC","request":{"request_type":"exec","want_reply":true,"command":"cat"}}
+{"timestamp":"2023-03-16T10:47:03.002000014Z","protocol":"BSSH","direction":"inbound","type":"DATA","data":"aGVsbG8K"}
+{"timestamp":"2023-03-16T10:47:03.003000014Z","protocol":"BSSH","direction":"outbound","type":"DATA","data":"aGVsbG8K"}
+{"timestamp":"2023-03-16T10:47:03.004000014Z","protocol":"BSSH","direction":"outbound","type":"EXST","request":{"request_type":"exit-status","want_reply":false,"exit_status":0}}
+{"timestamp":"2023-03-16T10:47:04.000000014Z","protocol":"BSSH","direction":"inbound","type":"DONE"}
+{"timestamp":"2023-03-16T10:47:04.000000014Z","protocol":"BSSH","direction":"outbound","type":"DONE"}
+{"timestamp":"2023-03-16T10:47:04.000000014Z","protocol":"BSSH","direction":"inbound","type":"DONE"}
+{"timestamp":"2023-03-16T10:47:04.000000014Z","protocol":"BSSH","direction":"outbound","type":"DONE"}
+`, string(got))
+	})
+	t.Run("missing-channel-id", func(t *testing.T) {
+		tmpfile, err := fstest.NewTempFile("convert")
+		require.NoError(t, err)
+		_, err = convert.ToTranscript(ctx, opSesh, tmpfile, connectionId)
+		require.EqualError(t, err, "convert.ToTranscript: protocol \"BSSH\" requires channel id to convert: invalid parameter")
+		_, err = convert.ToJsonLines(ctx, opSesh, tmpfile, connectionId)
+		require.EqualError(t, err, "convert.ToJsonLines: protocol \"BSSH\" requires channel id to convert: invalid parameter")
+	})
+	t.Run("missing-session", func(t *testing.T) {
+		tmpfile, err := fstest.NewTempFile("convert")
+		require.NoError(t, err)
+		_, err = convert.ToTranscript(ctx, nil, tmpfile, connectionId, convert.WithChannelId(channelId))
+		require.EqualError(t, err, "convert.ToTranscript: missing session: invalid parameter")
+		_, err = convert.ToJsonLines(ctx, nil, tmpfile, connectionId, convert.WithChannelId(channelId))
+		require.EqualError(t, err, "convert.ToJsonLines: missing session: invalid parameter")
+	})
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package convert
+
+import (
+	"context"
+	"io"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+)
+
+// mergeWalk steps through the chunks of all the provided scanners in
+// timestamp order and calls the provided ChunkReadFunc f for each. Chunks with
+// the same timestamp are returned in the order of their scanners. The walk
+// terminates early if f or any of the scanners return an error.
+func mergeWalk(ctx context.Context, scanners []*bsr.ChunkScanner, f bsr.ChunkReadFunc) error {
+	heads := make([]bsr.Chunk, len(scanners))
+	next := func(i int) error {
+		c, err := scanners[i].Scan(ctx)
+		switch {
+		case err == io.EOF:
+			heads[i] = nil
+			return nil
+		case err != nil:
+			return err
+		}
+		heads[i] = c
+		return nil
+	}
+	for i := range scanners {
+		if err := next(i); err != nil {
+			return err
+		}
+	}
+
+	for {
+		first := -1
+		for i, c := range heads {
+			if c == nil {
+				continue
+			}
+			if first < 0 || c.GetTimestamp().AsTime().Before(heads[first].GetTimestamp().AsTime()) {
+				first = i
+			}
+		}
+		if first < 0 {
+			return nil
+		}
+		if err := f(ctx, heads[first]); err != nil {
+			return err
+		}
+		if err := next(first); err != nil {
+			return err
+		}
+	}
+}
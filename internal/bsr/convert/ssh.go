@@ -4,6 +4,7 @@
 package convert
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -14,6 +15,8 @@ import (
 	"github.com/hashicorp/boundary/internal/bsr/convert/internal/asciicast"
 	"github.com/hashicorp/boundary/internal/bsr/internal/is"
 	"github.com/hashicorp/boundary/internal/bsr/ssh"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 // sshChannelToAsciicast will convert a recording of an ssh channel from a BSR
@@ -153,3 +156,224 @@ func sshChannelToAsciicast(ctx context.Context, requestScanner *bsr.ChunkScanner
 	}
 	return r, nil
 }
+
+// sshChannelToTranscript will convert a recording of an ssh channel from a BSR
+// into a plaintext transcript. This expects two bsr.ChunkScanners, one for the
+// inbound messages and one for the outbound messages of the channel. Inbound
+// data is written as stdin and outbound data as stdout. Each line of the
+// transcript is prefixed with the time the line started and its direction.
+// This also expects a io.ReadWriteSeeker that will be used to write the
+// transcript. This is then reset and returned as a io.ReadCloser. The caller
+// should call Close on the returned io.ReadCloser after reading the
+// transcript.
+func sshChannelToTranscript(ctx context.Context, inboundScanner *bsr.ChunkScanner, outboundScanner *bsr.ChunkScanner, w io.ReadWriteSeeker) (io.ReadCloser, error) {
+	const op = "convert.sshChannelToTranscript"
+
+	switch {
+	case is.Nil(inboundScanner):
+		return nil, fmt.Errorf("%s: missing inbound message scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(outboundScanner):
+		return nil, fmt.Errorf("%s: missing outbound message scanner: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: missing read write seeker: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	t := &transcript{
+		w: w,
+		streams: map[bsr.Direction]*transcriptStream{
+			bsr.Inbound:  {name: stdinStream},
+			bsr.Outbound: {name: stdoutStream},
+		},
+	}
+	if err := mergeWalk(ctx, []*bsr.ChunkScanner{inboundScanner, outboundScanner}, func(ctx context.Context, c bsr.Chunk) error {
+		switch c.GetProtocol() {
+		case ssh.Protocol:
+			if c.GetType() != ssh.DataChunkType {
+				return nil
+			}
+			cc := c.(*ssh.DataChunk)
+			return t.write(cc.GetDirection(), cc.GetTimestamp().AsTime(), cc.Data)
+		default:
+			return ErrUnsupportedProtocol
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := t.flush(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rewind(w)
+}
+
+const (
+	stdinStream  = "stdin"
+	stdoutStream = "stdout"
+)
+
+// transcript writes the lines of the data of each direction of a channel.
+// Data is buffered until a complete line has been received.
+type transcript struct {
+	w       io.Writer
+	streams map[bsr.Direction]*transcriptStream
+}
+
+type transcriptStream struct {
+	name  string
+	start time.Time
+	line  []byte
+	// afterCR is set when the last line ended with a carriage return, so that
+	// a line feed that follows it does not end another line.
+	afterCR bool
+}
+
+// write adds the data of a chunk to the stream of the provided direction and
+// writes every line it completes. Lines end with a line feed, a carriage
+// return, or both, since terminals send a carriage return for the enter key.
+func (t *transcript) write(d bsr.Direction, ts time.Time, data []byte) error {
+	s, ok := t.streams[d]
+	if !ok {
+		return fmt.Errorf("unexpected %s data chunk: %w", d, ErrMalformedBsr)
+	}
+	for len(data) > 0 {
+		if s.afterCR && data[0] == '\n' {
+			data = data[1:]
+			s.afterCR = false
+			continue
+		}
+		s.afterCR = false
+		if len(s.line) == 0 {
+			s.start = ts
+		}
+		i := bytes.IndexAny(data, "\r\n")
+		if i < 0 {
+			s.line = append(s.line, data...)
+			return nil
+		}
+		s.line = append(s.line, data[:i]...)
+		s.afterCR = data[i] == '\r'
+		data = data[i+1:]
+		if err := t.writeLine(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// flush writes the incomplete lines of every direction, in the order they
+// started.
+func (t *transcript) flush() error {
+	in, out := t.streams[bsr.Inbound], t.streams[bsr.Outbound]
+	first, second := in, out
+	if out.start.Before(in.start) {
+		first, second = out, in
+	}
+	for _, s := range []*transcriptStream{first, second} {
+		if len(s.line) == 0 {
+			continue
+		}
+		if err := t.writeLine(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *transcript) writeLine(s *transcriptStream) error {
+	_, err := fmt.Fprintf(t.w, "%s %-6s %s\n", s.start.UTC().Format(time.RFC3339Nano), s.name, s.line)
+	s.line = s.line[:0]
+	return err
+}
+
+// sshChannelToJsonLines will convert a recording of an ssh channel from a BSR
+// into a JSON Lines stream, with one jsonChunk per line for every chunk of the
+// provided bsr.ChunkScanners, in timestamp order. In order to include every
+// chunk of the channel, it should be the inbound and outbound requests and
+// messages. This also expects a io.ReadWriteSeeker that will be used to write
+// the stream. This is then reset and returned as a io.ReadCloser. The caller
+// should call Close on the returned io.ReadCloser after reading the stream.
+func sshChannelToJsonLines(ctx context.Context, scanners []*bsr.ChunkScanner, w io.ReadWriteSeeker) (io.ReadCloser, error) {
+	const op = "convert.sshChannelToJsonLines"
+
+	switch {
+	case len(scanners) == 0:
+		return nil, fmt.Errorf("%s: missing scanners: %w", op, bsr.ErrInvalidParameter)
+	case is.Nil(w):
+		return nil, fmt.Errorf("%s: missing read write seeker: %w", op, bsr.ErrInvalidParameter)
+	}
+	for _, s := range scanners {
+		if is.Nil(s) {
+			return nil, fmt.Errorf("%s: missing scanner: %w", op, bsr.ErrInvalidParameter)
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	if err := mergeWalk(ctx, scanners, func(ctx context.Context, c bsr.Chunk) error {
+		switch c.GetProtocol() {
+		case ssh.Protocol:
+			jc, err := newJsonChunk(c)
+			if err != nil {
+				return err
+			}
+			return enc.Encode(jc)
+		default:
+			return ErrUnsupportedProtocol
+		}
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return rewind(w)
+}
+
+// jsonChunk is the JSON representation of a chunk. Requests contain the
+// fields of the recorded request, such as the command of an exec request or
+// the exit status of an exit-status request. Data contains the base64 encoded
+// data of a data chunk.
+type jsonChunk struct {
+	Timestamp time.Time       `json:"timestamp"`
+	Protocol  string          `json:"protocol"`
+	Direction string          `json:"direction"`
+	Type      string          `json:"type"`
+	Request   json.RawMessage `json:"request,omitempty"`
+	Data      []byte          `json:"data,omitempty"`
+}
+
+func newJsonChunk(c bsr.Chunk) (*jsonChunk, error) {
+	jc := &jsonChunk{
+		Timestamp: c.GetTimestamp().AsTime().UTC(),
+		Protocol:  string(c.GetProtocol()),
+		Direction: c.GetDirection().String(),
+		Type:      string(c.GetType()),
+	}
+	switch cc := c.(type) {
+	case *ssh.DataChunk:
+		jc.Data = cc.Data
+	case proto.Message:
+		// The ssh request chunks embed the proto message of the request. Unpopulated
+		// fields are included so that zero values, like an exit status of 0, are kept.
+		b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(cc)
+		if err != nil {
+			return nil, err
+		}
+		// protojson does not produce stable output, so it is compacted.
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err != nil {
+			return nil, err
+		}
+		jc.Request = buf.Bytes()
+	}
+	return jc, nil
+}
+
+// rewind resets the provided io.ReadWriteSeeker and returns it as a
+// io.ReadCloser.
+func rewind(w io.ReadWriteSeeker) (io.ReadCloser, error) {
+	if _, err := w.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if v, ok := w.(io.ReadCloser); ok {
+		return v, nil
+	}
+	return io.NopCloser(w), nil
+}
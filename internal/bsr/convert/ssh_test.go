@@ -856,3 +856,260 @@ func Test_sshChannelToAsciicast(t *testing.T) {
 		})
 	}
 }
+
+func testChunkScanner(t *testing.T, chunks ...bsr.Chunk) *bsr.ChunkScanner {
+	t.Helper()
+	ctx := context.Background()
+	buf, err := fstest.NewTempBuffer()
+	require.NoError(t, err)
+	buf.Write(bsr.Magic.Bytes())
+	enc, err := bsr.NewChunkEncoder(ctx, buf, bsr.NoCompression, bsr.NoEncryption)
+	require.NoError(t, err)
+
+	for _, c := range chunks {
+		_, err := enc.Encode(ctx, c)
+		require.NoError(t, err)
+	}
+	s, err := bsr.NewChunkScanner(ctx, bytes.NewBuffer(buf.Bytes()))
+	require.NoError(t, err)
+	return s
+}
+
+func testHeaderChunk(d bsr.Direction, ts time.Time) *bsr.HeaderChunk {
+	return &bsr.HeaderChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  ssh.Protocol,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      bsr.ChunkHeader,
+		},
+		Compression: bsr.NoCompression,
+		Encryption:  bsr.NoEncryption,
+		SessionId:   "sess_123456789",
+	}
+}
+
+func testEndChunk(d bsr.Direction, ts time.Time) *bsr.EndChunk {
+	return &bsr.EndChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  ssh.Protocol,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      bsr.ChunkEnd,
+		},
+	}
+}
+
+func testDataChunk(d bsr.Direction, ts time.Time, data string) *ssh.DataChunk {
+	return &ssh.DataChunk{
+		BaseChunk: &bsr.BaseChunk{
+			Protocol:  ssh.Protocol,
+			Direction: d,
+			Timestamp: bsr.NewTimestamp(ts),
+			Type:      ssh.DataChunkType,
+		},
+		Data: []byte(data),
+	}
+}
+
+func Test_sshChannelToTranscript(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	newW := func() io.ReadWriteSeeker {
+		f, err := os.CreateTemp("", "*.txt")
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			os.Remove(f.Name())
+		})
+		return f
+	}
+	cases := []struct {
+		name            string
+		inboundScanner  *bsr.ChunkScanner
+		outboundScanner *bsr.ChunkScanner
+		w               io.ReadWriteSeeker
+		want            string
+		wantErr         error
+	}{
+		{
+			"no-messages",
+			testChunkScanner(t, testHeaderChunk(bsr.Inbound, ts), testEndChunk(bsr.Inbound, ts.Add(time.Second))),
+			testChunkScanner(t, testHeaderChunk(bsr.Outbound, ts), testEndChunk(bsr.Outbound, ts.Add(time.Second))),
+			newW(),
+			"",
+			nil,
+		},
+		{
+			"messages",
+			testChunkScanner(t,
+				testHeaderChunk(bsr.Inbound, ts),
+				testDataChunk(bsr.Inbound, ts.Add(time.Millisecond), "l"),
+				testDataChunk(bsr.Inbound, ts.Add(2*time.Millisecond), "s\r"),
+				testDataChunk(bsr.Inbound, ts.Add(4*time.Millisecond), "exit\r\n"),
+				testEndChunk(bsr.Inbound, ts.Add(time.Second)),
+			),
+			testChunkScanner(t,
+				testHeaderChunk(bsr.Outbound, ts),
+				testDataChunk(bsr.Outbound, ts.Add(3*time.Millisecond), "file1\r\nfile2\r\n"),
+				testDataChunk(bsr.Outbound, ts.Add(5*time.Millisecond), "logout"),
+				testEndChunk(bsr.Outbound, ts.Add(time.Second)),
+			),
+			newW(),
+			"2023-03-16T10:47:03.001000014Z stdin  ls\n" +
+				"2023-03-16T10:47:03.003000014Z stdout file1\n" +
+				"2023-03-16T10:47:03.003000014Z stdout file2\n" +
+				"2023-03-16T10:47:03.004000014Z stdin  exit\n" +
+				"2023-03-16T10:47:03.005000014Z stdout logout\n",
+			nil,
+		},
+		{
+			"nil-inbound-scanner",
+			nil,
+			testChunkScanner(t, testHeaderChunk(bsr.Outbound, ts), testEndChunk(bsr.Outbound, ts.Add(time.Second))),
+			newW(),
+			"",
+			errors.New("convert.sshChannelToTranscript: missing inbound message scanner: invalid parameter"),
+		},
+		{
+			"nil-outbound-scanner",
+			testChunkScanner(t, testHeaderChunk(bsr.Inbound, ts), testEndChunk(bsr.Inbound, ts.Add(time.Second))),
+			nil,
+			newW(),
+			"",
+			errors.New("convert.sshChannelToTranscript: missing outbound message scanner: invalid parameter"),
+		},
+		{
+			"nil-writer",
+			testChunkScanner(t, testHeaderChunk(bsr.Inbound, ts), testEndChunk(bsr.Inbound, ts.Add(time.Second))),
+			testChunkScanner(t, testHeaderChunk(bsr.Outbound, ts), testEndChunk(bsr.Outbound, ts.Add(time.Second))),
+			nil,
+			"",
+			errors.New("convert.sshChannelToTranscript: missing read write seeker: invalid parameter"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := sshChannelToTranscript(ctx, tc.inboundScanner, tc.outboundScanner, tc.w)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func Test_sshChannelToJsonLines(t *testing.T) {
+	ctx := context.Background()
+
+	ts := time.Date(2023, time.March, 16, 10, 47, 3, 14, time.UTC)
+	newW := func() io.ReadWriteSeeker {
+		f, err := os.CreateTemp("", "*.jsonl")
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			os.Remove(f.Name())
+		})
+		return f
+	}
+	cases := []struct {
+		name     string
+		scanners []*bsr.ChunkScanner
+		w        io.ReadWriteSeeker
+		want     string
+		wantErr  error
+	}{
+		{
+			"requests-and-messages",
+			[]*bsr.ChunkScanner{
+				testChunkScanner(t,
+					testHeaderChunk(bsr.Inbound, ts),
+					&ssh.ExecRequest{
+						BaseChunk: &bsr.BaseChunk{
+							Protocol:  ssh.Protocol,
+							Direction: bsr.Inbound,
+							Timestamp: bsr.NewTimestamp(ts.Add(time.Millisecond)),
+							Type:      ssh.ExecReqChunkType,
+						},
+						ExecRequest: &sshv1.ExecRequest{
+							RequestType: ssh.ExecRequestType,
+							WantReply:   true,
+							Command:     "ls",
+						},
+					},
+					testEndChunk(bsr.Inbound, ts.Add(time.Second)),
+				),
+				testChunkScanner(t,
+					testHeaderChunk(bsr.Outbound, ts),
+					&ssh.ExitStatusRequest{
+						BaseChunk: &bsr.BaseChunk{
+							Protocol:  ssh.Protocol,
+							Direction: bsr.Outbound,
+							Timestamp: bsr.NewTimestamp(ts.Add(3 * time.Millisecond)),
+							Type:      ssh.ExitStatusReqChunkType,
+						},
+						ExitStatusRequest: &sshv1.ExitStatusRequest{
+							RequestType: ssh.ExitStatusRequestType,
+							ExitStatus:  1,
+						},
+					},
+					testEndChunk(bsr.Outbound, ts.Add(time.Second)),
+				),
+				testChunkScanner(t,
+					testDataChunk(bsr.Outbound, ts.Add(2*time.Millisecond), "file1\n"),
+				),
+			},
+			newW(),
+			`{"timestamp":"2023-03-16T10:47:03.000000014Z","protocol":"BSSH","direction":"inbound","type":"HEAD"}
+{"timestamp":"2023-03-16T10:47:03.000000014Z","protocol":"BSSH","direction":"outbound","type":"HEAD"}
+{"timestamp":"2023-03-16T10:47:03.001000014Z","protocol":"BSSH","direction":"inbound","type":"EXEC","request":{"request_type":"exec","want_reply":true,"command":"ls"}}
+{"timestamp":"2023-03-16T10:47:03.002000014Z","protocol":"BSSH","direction":"outbound","type":"DATA","data":"ZmlsZTEK"}
+{"timestamp":"2023-03-16T10:47:03.003000014Z","protocol":"BSSH","direction":"outbound","type":"EXST","request":{"request_type":"exit-status","want_reply":false,"exit_status":1}}
+{"timestamp":"2023-03-16T10:47:04.000000014Z","protocol":"BSSH","direction":"inbound","type":"DONE"}
+{"timestamp":"2023-03-16T10:47:04.000000014Z","protocol":"BSSH","direction":"outbound","type":"DONE"}
+`,
+			nil,
+		},
+		{
+			"no-scanners",
+			nil,
+			newW(),
+			"",
+			errors.New("convert.sshChannelToJsonLines: missing scanners: invalid parameter"),
+		},
+		{
+			"nil-scanner",
+			[]*bsr.ChunkScanner{nil},
+			newW(),
+			"",
+			errors.New("convert.sshChannelToJsonLines: missing scanner: invalid parameter"),
+		},
+		{
+			"nil-writer",
+			[]*bsr.ChunkScanner{testChunkScanner(t, testHeaderChunk(bsr.Inbound, ts), testEndChunk(bsr.Inbound, ts.Add(time.Second)))},
+			nil,
+			"",
+			errors.New("convert.sshChannelToJsonLines: missing read write seeker: invalid parameter"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := sshChannelToJsonLines(ctx, tc.scanners, tc.w)
+			if tc.wantErr != nil {
+				require.EqualError(t, err, tc.wantErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			defer r.Close()
+			got, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, string(got))
+		})
+	}
+}
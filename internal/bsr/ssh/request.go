@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/internal/is"
+	gssh "golang.org/x/crypto/ssh"
+)
+
+type requestChunkFunc func(context.Context, bsr.Direction, *bsr.Timestamp, *gssh.Request) (bsr.Chunk, error)
+
+func wrap[C bsr.Chunk](f func(context.Context, bsr.Direction, *bsr.Timestamp, *gssh.Request) (C, error)) requestChunkFunc {
+	return func(ctx context.Context, d bsr.Direction, t *bsr.Timestamp, r *gssh.Request) (bsr.Chunk, error) {
+		return f(ctx, d, t, r)
+	}
+}
+
+// requestChunkFuncs maps the type of channel and global requests to the
+// function that creates the chunk for the request.
+var requestChunkFuncs = map[string]requestChunkFunc{
+	BreakRequestType:              wrap(NewBreakRequest),
+	CancelTCPIPForwardRequestType: wrap(NewCancelTCPIPForwardRequest),
+	EnvRequestType:                wrap(NewEnvRequest),
+	ExecRequestType:               wrap(NewExecRequest),
+	ExitSignalRequestType:         wrap(NewExitSignalRequest),
+	ExitStatusRequestType:         wrap(NewExitStatusRequest),
+	PtyRequestType:                wrap(NewPtyRequest),
+	ShellRequestType:              wrap(NewShellRequest),
+	SignalRequestType:             wrap(NewSignalRequest),
+	SubsystemRequestType:          wrap(NewSubsystemRequest),
+	TCPIPForwardRequestType:       wrap(NewTCPIPForwardRequest),
+	WindowChangeRequestType:       wrap(NewWindowChangeRequest),
+	X11ForwardingRequestType:      wrap(NewX11ForwardingRequest),
+	XonXoffRequestType:            wrap(NewXonXoffRequest),
+}
+
+// NewRequest creates the chunk for a channel or global request based on the
+// request's type. Requests with a type that does not have a chunk type are
+// recorded as an UnknownRequest.
+func NewRequest(ctx context.Context, d bsr.Direction, t *bsr.Timestamp, r *gssh.Request) (bsr.Chunk, error) {
+	const op = "ssh.NewRequest"
+
+	if is.Nil(r) {
+		return nil, fmt.Errorf("%s: request cannot be nil: %w", op, bsr.ErrInvalidParameter)
+	}
+
+	f, ok := requestChunkFuncs[r.Type]
+	if !ok {
+		f = wrap(NewUnknownRequest)
+	}
+	c, err := f(ctx, d, t, r)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return c, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gssh "golang.org/x/crypto/ssh"
+)
+
+func Test_NewRequest(t *testing.T) {
+	ctx := context.Background()
+	now := bsr.NewTimestamp(time.Now())
+
+	tests := []struct {
+		name      string
+		request   *gssh.Request
+		wantType  bsr.ChunkType
+		expErrMsg string
+	}{
+		{
+			name:      "nil request",
+			expErrMsg: "ssh.NewRequest: request cannot be nil: invalid parameter",
+		},
+		{
+			name: "exec",
+			request: &gssh.Request{
+				Type:    ExecRequestType,
+				Payload: gssh.Marshal(execSigval{Command: "ls"}),
+			},
+			wantType: ExecReqChunkType,
+		},
+		{
+			name: "shell",
+			request: &gssh.Request{
+				Type: ShellRequestType,
+			},
+			wantType: ShellReqChunkType,
+		},
+		{
+			name: "unknown",
+			request: &gssh.Request{
+				Type:    "keepalive@openssh.com",
+				Payload: []byte("data"),
+			},
+			wantType: UnknownReqChunkType,
+		},
+		{
+			name: "invalid payload",
+			request: &gssh.Request{
+				Type:    ExecRequestType,
+				Payload: []byte{0x01},
+			},
+			expErrMsg: "ssh.NewRequest: ssh.NewExecRequest: unable to unmarshal payload",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewRequest(ctx, bsr.Inbound, now, tt.request)
+			if tt.expErrMsg != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.expErrMsg)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantType, got.GetType())
+			assert.Equal(bsr.Inbound, got.GetDirection())
+		})
+	}
+}
@@ -39,6 +39,7 @@ const (
 	EnabledPluginHostAzure
 	EnabledPluginMinio
 	EnabledPluginGCP
+	EnabledPluginFilesystem
 )
 
 // MinioEnabled controls if the Minio storage plugin should be initiated or not
@@ -56,6 +57,8 @@ func (e EnabledPlugin) String() string {
 		return "MinIO"
 	case EnabledPluginGCP:
 		return "GCP"
+	case EnabledPluginFilesystem:
+		return "Filesystem"
 	default:
 		return ""
 	}
@@ -112,7 +112,7 @@ func (b *Server) CreateInitialAuthenticatedUserRole(ctx context.Context, opt ...
 	grants := []string{
 		"ids=*;type=scope;actions=read",
 		"ids=*;type=auth-token;actions=list",
-		"ids={{.Account.Id}};actions=read,change-password",
+		"ids={{.Account.Id}};actions=read,change-password,enroll-totp,remove-totp",
 		"ids=*;type=session;actions=list,read:self,cancel:self",
 		"ids={{.User.Id}};type=user;actions=list-resolvable-aliases",
 	}
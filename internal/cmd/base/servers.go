@@ -29,6 +29,7 @@ import (
 	berrors "github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/internal/util"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
@@ -58,6 +59,7 @@ const (
 
 func init() {
 	metric.InitializeBuildInfo(prometheus.DefaultRegisterer)
+	event.InitializeMetrics(prometheus.DefaultRegisterer)
 }
 
 type Server struct {
@@ -128,6 +130,7 @@ type Server struct {
 
 	EnabledPlugins []EnabledPlugin
 	HostPlugins    map[string]plgpb.HostPluginServiceClient
+	StoragePlugins map[string]plgpb.StoragePluginServiceClient
 
 	DevOidcSetup oidcSetup
 	DevLdapSetup ldapSetup
@@ -241,6 +244,32 @@ func (b *Server) SetupEventing(ctx context.Context, logger hclog.Logger, seriali
 	return nil
 }
 
+// SetupTracing will setup the export of the server's spans to the collector of
+// the tracing config, and add the shutdown of the exporter to the server's
+// shutdown funcs so the remaining spans are flushed.
+func (b *Server) SetupTracing(ctx context.Context, serverName string, c *tracing.Config) error {
+	const op = "base.(Server).SetupTracing"
+	if serverName == "" {
+		return berrors.New(ctx, berrors.InvalidParameter, op, "missing server name")
+	}
+	if c == nil {
+		return berrors.New(ctx, berrors.InvalidParameter, op, "missing tracing config")
+	}
+	shutdown, err := tracing.Setup(ctx, c, tracing.WithServiceInstanceId(serverName))
+	if err != nil {
+		return berrors.Wrap(ctx, err, op, berrors.WithMsg("unable to setup tracing"))
+	}
+	b.ShutdownFuncs = append(b.ShutdownFuncs, func() error {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdown(shutdownCtx); err != nil {
+			return fmt.Errorf("Error shutting down tracing: %w", err)
+		}
+		return nil
+	})
+	return nil
+}
+
 // AddEventerToContext will add the server eventer to the context provided
 func (b *Server) AddEventerToContext(ctx context.Context) (context.Context, error) {
 	const op = "base.(Server).AddEventerToContext"
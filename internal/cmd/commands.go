@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/commands/accountscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/aliasescmd"
+	"github.com/hashicorp/boundary/internal/cmd/commands/audit"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authenticate"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authmethodscmd"
 	"github.com/hashicorp/boundary/internal/cmd/commands/authtokenscmd"
@@ -127,6 +128,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "change-password",
 			}
 		}),
+		"accounts enroll-totp": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "enroll-totp",
+			}
+		}),
+		"accounts remove-totp": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &accountscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "remove-totp",
+			}
+		}),
 		"accounts create": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &accountscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -224,6 +237,17 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 			}
 		}),
 
+		"audit": func() (cli.Command, error) {
+			return &audit.Command{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"audit verify": func() (cli.Command, error) {
+			return &audit.VerifyCommand{
+				Server: base.NewServer(base.NewCommand(ui, opts...)),
+			}, nil
+		},
+
 		"auth-methods": func() (cli.Command, error) {
 			return &authmethodscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -471,6 +495,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}
 		}),
+		"credential-libraries create ssh-ca-certificate": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &credentiallibrariescmd.SshCaCertificateCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}
+		}),
 		"credential-libraries update": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &credentiallibrariescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -495,6 +525,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}
 		}),
+		"credential-libraries update ssh-ca-certificate": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &credentiallibrariescmd.SshCaCertificateCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}
+		}),
 
 		"credential-stores": func() (cli.Command, error) {
 			return &credentialstorescmd.Command{
@@ -537,6 +573,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}
 		}),
+		"credential-stores create ssh-ca": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &credentialstorescmd.SshCaCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}
+		}),
 		"credential-stores update": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &credentialstorescmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -555,6 +597,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}
 		}),
+		"credential-stores update ssh-ca": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &credentialstorescmd.SshCaCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}
+		}),
 
 		"credentials": func() (cli.Command, error) {
 			return &credentialscmd.Command{
@@ -627,6 +675,16 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}
 		}),
+		"credentials rotate": func() (cli.Command, error) {
+			return &credentialscmd.RotateCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"credentials rollback": func() (cli.Command, error) {
+			return &credentialscmd.RollbackCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
 
 		"daemon": func() (cli.Command, error) {
 			return &unsupported.UnsupportedCommand{
@@ -1108,6 +1166,33 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-grant-scopes",
 			}
 		}),
+		"roles validate-grants": func() (cli.Command, error) {
+			return &rolescmd.ValidateGrantsCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"roles request-access": func() (cli.Command, error) {
+			return &rolescmd.RequestAccessCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"roles list-access-requests": func() (cli.Command, error) {
+			return &rolescmd.ListAccessRequestsCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
+		"roles approve-access-request": func() (cli.Command, error) {
+			return &rolescmd.DecideAccessRequestCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "approve",
+			}, nil
+		},
+		"roles deny-access-request": func() (cli.Command, error) {
+			return &rolescmd.DecideAccessRequestCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "deny",
+			}, nil
+		},
 
 		"scopes": func() (cli.Command, error) {
 			return &scopescmd.Command{
@@ -1207,6 +1292,12 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "cancel",
 			}
 		}),
+		"sessions approve": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionscmd.Command{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "approve",
+			}
+		}),
 
 		"session-recordings": func() (cli.Command, error) {
 			return &sessionrecordingscmd.Command{
@@ -1241,6 +1332,26 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Command: base.NewCommand(ui, opts...),
 			}
 		}),
+		"session-recordings search": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionrecordingscmd.SearchCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
+		"session-recordings list-retention-reports": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionrecordingscmd.ListRetentionReportsCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
+		"session-recordings set-legal-hold": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionrecordingscmd.SetLegalHoldCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
+		"session-recordings remove-legal-hold": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &sessionrecordingscmd.RemoveLegalHoldCommand{
+				Command: base.NewCommand(ui, opts...),
+			}
+		}),
 
 		"storage-buckets": func() (cli.Command, error) {
 			return &storagebucketscmd.Command{
@@ -1325,6 +1436,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "create",
 			}
 		}),
+		"targets create postgres": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.PostgresCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}
+		}),
+		"targets create http": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.HttpCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "create",
+			}
+		}),
 		"targets update": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1343,6 +1466,18 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "update",
 			}
 		}),
+		"targets update postgres": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.PostgresCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}
+		}),
+		"targets update http": wrapper.Wrap(func() wrapper.WrappableCommand {
+			return &targetscmd.HttpCommand{
+				Command: base.NewCommand(ui, opts...),
+				Func:    "update",
+			}
+		}),
 		"targets add-host-sources": wrapper.Wrap(func() wrapper.WrappableCommand {
 			return &targetscmd.Command{
 				Command: base.NewCommand(ui, opts...),
@@ -1440,6 +1575,11 @@ func initCommands(ui, serverCmdUi cli.Ui, runOpts *RunOptions) {
 				Func:    "remove-accounts",
 			}
 		}),
+		"users explain": func() (cli.Command, error) {
+			return &userscmd.ExplainCommand{
+				Command: base.NewCommand(ui, opts...),
+			}, nil
+		},
 
 		"workers": func() (cli.Command, error) {
 			return &workerscmd.Command{
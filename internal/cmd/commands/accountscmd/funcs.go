@@ -24,18 +24,23 @@ func init() {
 	extraFlagsFunc = extraFlagsFuncImpl
 	extraFlagsHandlingFunc = extraFlagsHandlingFuncImpl
 	executeExtraActions = executeExtraActionsImpl
+	printCustomActionOutput = printCustomActionOutputImpl
 }
 
 type extraCmdVars struct {
 	flagPassword        string
 	flagCurrentPassword string
 	flagNewPassword     string
+	totpEnrollment      *accounts.TotpEnrollmentResult
+	totpRemoval         *accounts.TotpRemoveResult
 }
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"change-password": {"id", "current-password", "new-password", "version"},
 		"set-password":    {"id", "password", "version"},
+		"enroll-totp":     {"id"},
+		"remove-totp":     {"id"},
 	}
 }
 
@@ -47,6 +52,12 @@ func extraSynopsisFuncImpl(c *Command) string {
 	case "set-password":
 		return "Directly set the password on an account"
 
+	case "enroll-totp":
+		return "Enroll an account in TOTP"
+
+	case "remove-totp":
+		return "Remove the TOTP enrollment of an account"
+
 	default:
 		return ""
 	}
@@ -91,6 +102,30 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			"",
 		})
+	case "enroll-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts enroll-totp [options] [args]",
+			"",
+			"  This command enrolls a password-type account in TOTP, replacing any existing enrollment. The TOTP secret and the recovery codes are only shown once. Example:",
+			"",
+			"    Enroll a password-type account in TOTP:",
+			"",
+			`      $ boundary accounts enroll-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
+	case "remove-totp":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary accounts remove-totp [options] [args]",
+			"",
+			"  This command removes the TOTP enrollment and the recovery codes of a password-type account. Example:",
+			"",
+			"    Remove the TOTP enrollment of a password-type account:",
+			"",
+			`      $ boundary accounts remove-totp -id acctpw_1234567890`,
+			"",
+			"",
+		})
 	}
 	return helpStr + c.Flags().Help()
 }
@@ -241,10 +276,68 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *accou
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "enroll-totp":
+		result, err := accountClient.EnrollTotp(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		c.totpEnrollment = result
+		return result.GetResponse(), nil, nil, err
+	case "remove-totp":
+		result, err := accountClient.RemoveTotp(c.Context, c.FlagId, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		c.totpRemoval = result
+		return result.GetResponse(), nil, nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
 
+func printCustomActionOutputImpl(c *Command) (bool, error) {
+	switch c.Func {
+	case "enroll-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			item := c.totpEnrollment.GetItem()
+			ret := []string{
+				"",
+				"TOTP enrollment information:",
+				fmt.Sprintf("  Account ID:    %s", item.AccountId),
+				fmt.Sprintf("  Secret:        %s", item.Secret),
+				fmt.Sprintf("  URI:           %s", item.Uri),
+				"",
+				"  Recovery Codes:",
+				base.WrapSlice(4, item.RecoveryCodes),
+				"",
+				"  The secret and the recovery codes will not be shown again.",
+			}
+			c.UI.Output(base.WrapForHelpText(ret))
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.totpEnrollment.GetResponse()); !ok {
+				return false, fmt.Errorf("error formatting as JSON")
+			}
+			return true, nil
+		}
+
+	case "remove-totp":
+		switch base.Format(c.UI) {
+		case "table":
+			c.UI.Output("The remove-totp operation completed successfully.")
+			return true, nil
+
+		case "json":
+			if ok := c.PrintJsonItem(c.totpRemoval.GetResponse()); !ok {
+				return false, fmt.Errorf("error formatting as JSON")
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (c *Command) printListTable(items []*accounts.Account) string {
 	if len(items) == 0 {
 		return "No accounts found"
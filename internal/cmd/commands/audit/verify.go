@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/config"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*VerifyCommand)(nil)
+	_ cli.CommandAutocomplete = (*VerifyCommand)(nil)
+)
+
+type VerifyCommand struct {
+	*base.Server
+
+	Config *config.Config
+
+	flagConfig          []string
+	flagConfigKms       string
+	flagLogLevel        string
+	flagLogFormat       string
+	flagAllowPruned     bool
+	flagAllowUnattested bool
+
+	files []string
+}
+
+func (c *VerifyCommand) Synopsis() string {
+	return "Verify the hash chain of audit log files"
+}
+
+func (c *VerifyCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary audit verify [options] [files]",
+		"",
+		"  Verify the hash chain of audit log files written by a file sink with hash_chain enabled:",
+		"",
+		"    $ boundary audit verify -config=/etc/boundary/controller.hcl /var/log/boundary/audit-1.log /var/log/boundary/audit.log",
+		"",
+		"  The files are verified as one chain in the order they are provided, so the rotated files of a sink must be provided oldest first. The hmacs of the records and the signatures of the checkpoints are verified with the global audit keys of the KMS, so the controller configuration is needed to connect to the database and decrypt them.",
+		"",
+		"  The chain must start with its first record and end with a checkpoint, which attests to the first record and to the number of records of the chain, so records removed from the start or the end of the chain are detected. Use -allow-pruned when the oldest files of the sink have been removed, and -allow-unattested to verify the file the sink is writing to.",
+		"",
+		"  The first broken link of the chain is reported, and the command exits with a non-zero code if the chain is broken.",
+	}) + c.Flags().Help()
+}
+
+func (c *VerifyCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetOutputFormat)
+
+	f := set.NewFlagSet("Command Options")
+
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "config",
+		Target: &c.flagConfig,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: "Path to the configuration file.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:   "config-kms",
+		Target: &c.flagConfigKms,
+		Completion: complete.PredictOr(
+			complete.PredictFiles("*.hcl"),
+			complete.PredictFiles("*.json"),
+		),
+		Usage: `Path to a configuration file containing a "kms" block marked for "config" purpose, to perform decryption of the main configuration file. If not set, will look for such a block in the main configuration file, which has some drawbacks; see the help output for "boundary config encrypt -h" for details.`,
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "allow-pruned",
+		Target: &c.flagAllowPruned,
+		Usage:  "Allow the chain to start after its first record, as when the oldest files of the sink have been removed. The checkpoints must then attest to the same first record.",
+	})
+
+	f.BoolVar(&base.BoolVar{
+		Name:   "allow-unattested",
+		Target: &c.flagAllowUnattested,
+		Usage:  "Allow the chain to end with records which are not attested by a checkpoint, as in the file the sink is writing to.",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "log-level",
+		Target:     &c.flagLogLevel,
+		EnvVar:     "BOUNDARY_LOG_LEVEL",
+		Completion: complete.PredictSet("trace", "debug", "info", "warn", "err"),
+		Usage: "Log verbosity level. Supported values (in order of more detail to less) are " +
+			"\"trace\", \"debug\", \"info\", \"warn\", and \"err\".",
+	})
+
+	f.StringVar(&base.StringVar{
+		Name:       "log-format",
+		Target:     &c.flagLogFormat,
+		Completion: complete.PredictSet("standard", "json"),
+		Usage:      `Log format. Supported values are "standard" and "json".`,
+	})
+
+	return set
+}
+
+func (c *VerifyCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictFiles("*")
+}
+
+func (c *VerifyCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *VerifyCommand) Run(args []string) int {
+	if result := c.ParseFlagsAndConfig(args); result > 0 {
+		return result
+	}
+
+	defer func() {
+		if err := c.RunShutdownFuncs(); err != nil {
+			c.UI.Error(fmt.Errorf("Error running shutdown tasks: %w", err).Error())
+		}
+	}()
+
+	if err := c.SetupLogging(c.flagLogLevel, c.flagLogFormat, c.Config.LogLevel, c.Config.LogFormat); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	serverName, err := os.Hostname()
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Unable to determine hostname: %w", err).Error())
+		return base.CommandCliError
+	}
+	serverName = fmt.Sprintf("%s/boundary-audit-verify", serverName)
+	if err := c.SetupEventing(c.Context, c.Logger, c.StderrLock, serverName, base.WithEventerConfig(c.Config.Eventing)); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	if err := c.SetupKMSes(c.Context, c.UI, c.Config); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandCliError
+	}
+
+	if c.RootKms == nil {
+		c.UI.Error("Root KMS not found after parsing KMS blocks")
+		return base.CommandCliError
+	}
+
+	if c.Config.Controller == nil {
+		c.UI.Error(`"controller" config block not found`)
+		return base.CommandUserError
+	}
+
+	if c.Config.Controller.Database == nil {
+		c.UI.Error(`"controller.database" config block not found`)
+		return base.CommandUserError
+	}
+
+	urlToParse := c.Config.Controller.Database.Url
+	if urlToParse == "" {
+		c.UI.Error(`"url" not specified in "database" config block`)
+		return base.CommandUserError
+	}
+	c.DatabaseUrl, err = parseutil.ParsePath(urlToParse)
+	if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+		c.UI.Error(fmt.Errorf("Error parsing database url: %w", err).Error())
+		return base.CommandUserError
+	}
+	if err := c.OpenAndSetServerDatabase(c.Context, "postgres"); err != nil {
+		c.UI.Error(fmt.Errorf("Error connecting to database: %w", err).Error())
+		return base.CommandCliError
+	}
+
+	rw := db.New(c.Database)
+	kmsCache, err := kms.New(c.Context, rw, rw)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error creating kms cache: %w", err).Error())
+		return base.CommandCliError
+	}
+	if err := kmsCache.AddExternalWrappers(c.Context, kms.WithRootWrapper(c.RootKms)); err != nil {
+		c.UI.Error(fmt.Errorf("Error adding config keys to kms: %w", err).Error())
+		return base.CommandCliError
+	}
+
+	var opts []event.Option
+	if c.flagAllowPruned {
+		opts = append(opts, event.WithAllowPruned())
+	}
+	if c.flagAllowUnattested {
+		opts = append(opts, event.WithAllowUnattested())
+	}
+	v, err := event.NewHashChainVerifier(func(ctx context.Context, keyId string) (wrapping.Wrapper, error) {
+		return kmsCache.GetWrapper(ctx, scope.Global.String(), kms.KeyPurposeAudit, kms.WithKeyId(keyId))
+	}, opts...)
+	if err != nil {
+		c.UI.Error(fmt.Errorf("Error creating hash chain verifier: %w", err).Error())
+		return base.CommandCliError
+	}
+	for _, name := range c.files {
+		ok, err := c.verifyFile(c.Context, v, name)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error verifying %q: %w", name, err).Error())
+			return base.CommandCliError
+		}
+		if !ok {
+			break
+		}
+	}
+
+	result := v.Finish()
+	switch base.Format(c.UI) {
+	case "json":
+		b, err := base.JsonFormatter{}.Format(result)
+		if err != nil {
+			c.UI.Error(fmt.Errorf("Error formatting as JSON: %w", err).Error())
+			return base.CommandCliError
+		}
+		c.UI.Output(string(b))
+	default:
+		c.UI.Output(printVerification(result))
+	}
+
+	if result.Break != nil {
+		return base.CommandCliError
+	}
+	return base.CommandSuccess
+}
+
+func (c *VerifyCommand) verifyFile(ctx context.Context, v *event.HashChainVerifier, name string) (bool, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+	return v.Verify(ctx, name, f)
+}
+
+func (c *VerifyCommand) ParseFlagsAndConfig(args []string) int {
+	var err error
+
+	f := c.Flags()
+
+	if err = f.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return base.CommandUserError
+	}
+
+	// Validation
+	switch {
+	case len(c.flagConfig) == 0:
+		c.UI.Error("Must specify a config file using -config")
+		return base.CommandUserError
+	case len(f.Args()) == 0:
+		c.UI.Error("Must specify at least one audit log file to verify")
+		return base.CommandUserError
+	}
+	c.files = f.Args()
+
+	c.Config, err = config.Load(c.Context, c.flagConfig, c.flagConfigKms)
+	if err != nil {
+		c.UI.Error("Error parsing config: " + err.Error())
+		return base.CommandUserError
+	}
+
+	return base.CommandSuccess
+}
+
+func printVerification(in *event.HashChainVerification) string {
+	nonAttributeMap := map[string]any{
+		"Records":     in.Records,
+		"Events":      in.Events,
+		"Checkpoints": in.Checkpoints,
+	}
+	if in.Unattested != 0 {
+		nonAttributeMap["Unattested Records"] = in.Unattested
+	}
+	if in.FirstSeq != 0 {
+		nonAttributeMap["First Record"] = in.FirstSeq
+	}
+	if in.LastSeq != 0 {
+		nonAttributeMap["Last Record"] = in.LastSeq
+	}
+	if in.LastCheckpoint != nil {
+		nonAttributeMap["Last Checkpoint"] = in.LastCheckpoint.Local().Format(time.RFC1123)
+	}
+
+	ret := []string{""}
+	if in.Break == nil {
+		ret = append(ret, "Audit log hash chain is intact:")
+	} else {
+		ret = append(ret, "Audit log hash chain is broken:")
+	}
+	ret = append(ret, base.WrapMap(2, 0, nonAttributeMap))
+
+	if in.Break != nil {
+		breakMap := map[string]any{
+			"File":   in.Break.File,
+			"Line":   in.Break.Line,
+			"Reason": in.Break.Reason,
+		}
+		if in.Break.Seq != 0 {
+			breakMap["Record"] = in.Break.Seq
+		}
+		ret = append(ret,
+			"",
+			"  First Broken Link:",
+			base.WrapMap(4, 0, breakMap),
+		)
+	}
+
+	return strings.Join(ret, "\n")
+}
@@ -28,6 +28,7 @@ var (
 var (
 	envPassword  = "BOUNDARY_AUTHENTICATE_PASSWORD_PASSWORD"
 	envLoginName = "BOUNDARY_AUTHENTICATE_PASSWORD_LOGIN_NAME"
+	envTotpCode  = "BOUNDARY_AUTHENTICATE_PASSWORD_TOTP"
 )
 
 type PasswordCommand struct {
@@ -35,6 +36,7 @@ type PasswordCommand struct {
 
 	flagLoginName string
 	flagPassword  string
+	flagTotpCode  string
 
 	parsedOpts base.Options
 }
@@ -73,6 +75,13 @@ func (c *PasswordCommand) Flags() *base.FlagSets {
 		Usage:  "The password associated with the login name. If blank, the command will prompt for the password to be entered interactively in a non-echoing way. Otherwise, this can refer to a file on disk (file://) from which a password will be read or an env var (env://) from which the password will be read.",
 	})
 
+	f.StringVar(&base.StringVar{
+		Name:   "totp",
+		Target: &c.flagTotpCode,
+		EnvVar: envTotpCode,
+		Usage:  "A TOTP code or a recovery code, required if the account is enrolled in TOTP. If blank and a code is required, the command will prompt for the code to be entered interactively in a non-echoing way. Otherwise, this can also refer to a file on disk (file://) from which the code will be read or an env var (env://) from which the code will be read.",
+	})
+
 	f.StringVar(&base.StringVar{
 		Name:   "auth-method-id",
 		EnvVar: "BOUNDARY_AUTH_METHOD_ID",
@@ -178,11 +187,34 @@ func (c *PasswordCommand) Run(args []string) int {
 		c.flagPassword = password
 	}
 
-	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login",
-		map[string]any{
-			"login_name": c.flagLoginName,
-			"password":   c.flagPassword,
-		})
+	if c.flagTotpCode != "" {
+		code, err := parseutil.ParsePath(c.flagTotpCode)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing totp flag: %v", err))
+			return base.CommandUserError
+		}
+		c.flagTotpCode = strings.TrimSpace(code)
+	}
+
+	attrs := map[string]any{
+		"login_name": c.flagLoginName,
+		"password":   c.flagPassword,
+	}
+	if c.flagTotpCode != "" {
+		attrs["totp_code"] = c.flagTotpCode
+	}
+	result, err := aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+	if err != nil && c.flagTotpCode == "" && totpCodeRequired(err) {
+		fmt.Print("Please enter the TOTP code or a recovery code (it will be hidden): ")
+		value, rErr := password.Read(os.Stdin)
+		fmt.Print("\n")
+		if rErr != nil {
+			c.UI.Error(fmt.Sprintf("An error occurred attempting to read the TOTP code. The raw error message is shown below but usually this is because you attempted to pipe a value into the command or you are executing outside of a terminal (TTY). The raw error was:\n\n%s", rErr.Error()))
+			return base.CommandUserError
+		}
+		attrs["totp_code"] = strings.TrimSpace(value)
+		result, err = aClient.Authenticate(c.Context, c.FlagAuthMethodId, "login", attrs)
+	}
 	if err != nil {
 		if apiErr := api.AsServerError(err); apiErr != nil {
 			c.PrintApiError(apiErr, "Error from controller when performing authentication")
@@ -194,3 +226,18 @@ func (c *PasswordCommand) Run(args []string) int {
 
 	return saveAndOrPrintToken(c.Command, result, c.Opts...)
 }
+
+// totpCodeRequired returns true if err is the error returned by the controller
+// when the account is enrolled in TOTP and no TOTP code was provided.
+func totpCodeRequired(err error) bool {
+	apiErr := api.AsServerError(err)
+	if apiErr == nil || apiErr.Details == nil {
+		return false
+	}
+	for _, f := range apiErr.Details.RequestFields {
+		if f.Name == "attributes.totp_code" {
+			return true
+		}
+	}
+	return false
+}
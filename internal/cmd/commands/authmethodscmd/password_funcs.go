@@ -20,12 +20,13 @@ func init() {
 type extraPasswordCmdVars struct {
 	flagMinLoginNameLength string
 	flagMinPasswordLength  string
+	flagTotpRequired       string
 }
 
 func extraPasswordActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"min-login-name-length", "min-password-length"},
-		"update": {"min-login-name-length", "min-password-length"},
+		"create": {"min-login-name-length", "min-password-length", "totp-required"},
+		"update": {"min-login-name-length", "min-password-length", "totp-required"},
 	}
 }
 
@@ -74,6 +75,12 @@ func extraPasswordFlagsFuncImpl(c *PasswordCommand, set *base.FlagSets, _ *base.
 				Target: &c.flagMinPasswordLength,
 				Usage:  "The minimum length of passwords",
 			})
+		case "totp-required":
+			f.StringVar(&base.StringVar{
+				Name:   "totp-required",
+				Target: &c.flagTotpRequired,
+				Usage:  "Whether accounts must be enrolled in TOTP and provide a TOTP code to authenticate (true or false)",
+			})
 		}
 	}
 }
@@ -112,6 +119,19 @@ func extraPasswordFlagHandlingFuncImpl(c *PasswordCommand, _ *base.FlagSets, opt
 		addAttribute("min_password_length", uint32(length))
 	}
 
+	switch c.flagTotpRequired {
+	case "":
+	case "null":
+		addAttribute("totp_required", nil)
+	default:
+		required, err := strconv.ParseBool(c.flagTotpRequired)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTotpRequired, err))
+			return false
+		}
+		addAttribute("totp_required", required)
+	}
+
 	if attributes != nil {
 		*opts = append(*opts, authmethods.WithAttributes(attributes))
 	}
@@ -149,7 +149,7 @@ func printItemTable(item *credentiallibraries.CredentialLibrary, resp *api.Respo
 		fallthrough
 	case "vault-generic":
 		keySubstMap = genericKeySubstMap
-	case "vault-ssh-certificate":
+	case "vault-ssh-certificate", "ssh-ca-certificate":
 		keySubstMap = sshCertKeySubstMap
 	}
 
@@ -206,6 +206,7 @@ var genericKeySubstMap = map[string]string{
 	"path":              "Path",
 	"http_method":       "HTTP Method",
 	"http_request_body": "HTTP Request Body",
+	"secret_version":    "Secret Version",
 }
 
 var sshCertKeySubstMap = map[string]string{
@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentiallibrariescmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentiallibraries"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+)
+
+func init() {
+	extraSshCaCertificateFlagsFunc = extraSshCaCertificateFlagsFuncImpl
+	extraSshCaCertificateActionsFlagsMapFunc = extraSshCaCertificateActionsFlagsMapFuncImpl
+	extraSshCaCertificateFlagsHandlingFunc = extraSshCaCertificateFlagHandlingFuncImpl
+}
+
+type extraSshCaCertificateCmdVars struct {
+	flagUsername                  string
+	flagKeyType                   string
+	flagKeyBits                   string
+	flagTtl                       string
+	flagKeyId                     string
+	flagCriticalOptions           string
+	flagCriticalOpts              []base.CombinedSliceFlagValue
+	flagExtensions                string
+	flagExtens                    []base.CombinedSliceFlagValue
+	flagAdditionalValidPrincipals []base.CombinedSliceFlagValue
+}
+
+func extraSshCaCertificateActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			usernameName,
+			keyTypeName,
+			keyBitsName,
+			ttlName,
+			keyIdName,
+			criticalOptionsName,
+			piecewiseCriticalOptionsName,
+			extensionsName,
+			piecewiseExtensionName,
+			additionalValidPrincipalsName,
+		},
+	}
+	flags["update"] = flags["create"]
+	return flags
+}
+
+func extraSshCaCertificateFlagsFuncImpl(c *SshCaCertificateCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SSH CA Certificate Credential Library Options")
+
+	for _, name := range flagsSshCaCertificateMap[c.Func] {
+		switch name {
+		case usernameName:
+			f.StringVar(&base.StringVar{
+				Name:   usernameName,
+				Target: &c.flagUsername,
+				Usage:  "The username to use with the ssh certificate.",
+			})
+		case keyTypeName:
+			f.StringVar(&base.StringVar{
+				Name:   keyTypeName,
+				Target: &c.flagKeyType,
+				Usage:  "The key type for the generated ssh private key. One of: ed25519, ecdsa, rsa.",
+			})
+		case keyBitsName:
+			f.StringVar(&base.StringVar{
+				Name:   keyBitsName,
+				Target: &c.flagKeyBits,
+				Usage:  "The number of bits when generating the ssh private key. Depends on key_type. If ed25519 this should not be set, or set to 0, if ecdsa one of 256, 384, 521, if rsa one of 2048, 3072, 4096.",
+			})
+		case ttlName:
+			f.StringVar(&base.StringVar{
+				Name:   ttlName,
+				Target: &c.flagTtl,
+				Usage:  "The time-to-live for the generated certificate. Defaults to the remaining lifetime of the session.",
+			})
+		case keyIdName:
+			f.StringVar(&base.StringVar{
+				Name:   keyIdName,
+				Target: &c.flagKeyId,
+				Usage:  "The key id that the created certificate should have.",
+			})
+		case additionalValidPrincipalsName:
+			f.CombinationSliceVar(&base.CombinationSliceVar{
+				Name:   additionalValidPrincipalsName,
+				Target: &c.flagAdditionalValidPrincipals,
+				Usage:  "Principals to be signed as \"valid_principles\" in addition to username.",
+			})
+		}
+	}
+	criticalOptsInput := common.CombinedSliceFlagValuePopulationInput{
+		FlagSet:                          f,
+		FlagNames:                        flagsSshCaCertificateMap[c.Func],
+		FullPopulationFlag:               &c.flagCriticalOptions,
+		FullPopulationInputName:          criticalOptionsName,
+		PiecewisePopulationFlag:          &c.flagCriticalOpts,
+		PiecewisePopulationInputBaseName: piecewiseCriticalOptionsName,
+		PiecewiseNoProtoCompat:           true,
+	}
+	common.PopulateCombinedSliceFlagValue(criticalOptsInput)
+
+	extensionsInput := common.CombinedSliceFlagValuePopulationInput{
+		FlagSet:                          f,
+		FlagNames:                        flagsSshCaCertificateMap[c.Func],
+		FullPopulationFlag:               &c.flagExtensions,
+		FullPopulationInputName:          extensionsName,
+		PiecewisePopulationFlag:          &c.flagExtens,
+		PiecewisePopulationInputBaseName: piecewiseExtensionName,
+		PiecewiseNoProtoCompat:           true,
+	}
+	common.PopulateCombinedSliceFlagValue(extensionsInput)
+}
+
+func extraSshCaCertificateFlagHandlingFuncImpl(c *SshCaCertificateCommand, _ *base.FlagSets, opts *[]credentiallibraries.Option) bool {
+	switch c.flagUsername {
+	case "":
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryUsername(c.flagUsername))
+	}
+	switch c.flagKeyType {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryKeyType())
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryKeyType(c.flagKeyType))
+	}
+	switch c.flagKeyBits {
+	case "":
+	case "0", "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryKeyBits())
+	default:
+		keyBits, err := strconv.ParseUint(c.flagKeyBits, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKeyBits, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryKeyBits(uint32(keyBits)))
+	}
+	switch c.flagTtl {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryTtl())
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryTtl(c.flagTtl))
+	}
+	switch c.flagKeyId {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryKeyId())
+	default:
+		*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryKeyId(c.flagKeyId))
+	}
+	switch len(c.flagAdditionalValidPrincipals) {
+	case 0:
+	case 1:
+		if len(c.flagAdditionalValidPrincipals[0].Keys) == 1 && c.flagAdditionalValidPrincipals[0].Keys[0] == "null" && c.flagAdditionalValidPrincipals[0].Value == nil {
+			*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryAdditionalValidPrincipals())
+			break
+		}
+		fallthrough
+	default:
+		avp := make([]string, len(c.flagAdditionalValidPrincipals))
+		for i, p := range c.flagAdditionalValidPrincipals {
+			avp[i] = p.Value.GetValue()
+		}
+		*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryAdditionalValidPrincipals(avp))
+	}
+
+	if err := common.HandleAttributeFlags(
+		c.Command,
+		piecewiseCriticalOptionsName,
+		c.flagCriticalOptions,
+		c.flagCriticalOpts,
+		func() {
+			*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryCriticalOptions())
+		},
+		func(in map[string]any) {
+			*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryCriticalOptions(toStringMap(in)))
+		}); err != nil {
+		return false
+	}
+	if err := common.HandleAttributeFlags(
+		c.Command,
+		piecewiseExtensionName,
+		c.flagExtensions,
+		c.flagExtens,
+		func() {
+			*opts = append(*opts, credentiallibraries.DefaultSshCaCertificateCredentialLibraryExtensions())
+		},
+		func(in map[string]any) {
+			*opts = append(*opts, credentiallibraries.WithSshCaCertificateCredentialLibraryExtensions(toStringMap(in)))
+		}); err != nil {
+		return false
+	}
+
+	return true
+}
+
+// toStringMap converts the parsed attribute flag values into the string map
+// used for critical options and extensions. Null values become empty
+// strings and non string values are dropped.
+func toStringMap(in map[string]any) map[string]string {
+	out := make(map[string]string, len(in))
+	for k, v := range in {
+		switch vv := v.(type) {
+		case nil:
+			out[k] = ""
+		case string:
+			out[k] = vv
+		}
+	}
+	return out
+}
+
+func (c *SshCaCertificateCommand) extraSshCaCertificateHelpFunc(_ map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries create ssh-ca-certificate -credential-store-id [options] [args]",
+			"",
+			"  Create a ssh-ca-certificate-type credential library. Example:",
+			"",
+			`    $ boundary credential-libraries create ssh-ca-certificate -credential-store-id cssca_1234567890 -username user -ttl 1h`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-libraries update ssh-ca-certificate [options] [args]",
+			"",
+			"  Update a ssh-ca-certificate-type credential library given its ID. Example:",
+			"",
+			`    $ boundary credential-libraries update ssh-ca-certificate -id clsca_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
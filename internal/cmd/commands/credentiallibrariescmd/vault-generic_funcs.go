@@ -4,6 +4,9 @@
 package credentiallibrariescmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -19,6 +22,7 @@ const (
 	pathFlagName              = "vault-path"
 	httpMethodFlagName        = "vault-http-method"
 	httpRequestBodyFlagName   = "vault-http-request-body"
+	secretVersionFlagName     = "vault-secret-version"
 	credentialTypeFlagName    = "credential-type"
 	credentialMappingFlagName = "credential-mapping-override"
 )
@@ -27,6 +31,7 @@ type extraVaultGenericCmdVars struct {
 	flagPath              string
 	flagHttpMethod        string
 	flagHttpRequestBody   string
+	flagSecretVersion     string
 	flagCredentialType    string
 	flagCredentialMapping []base.CombinedSliceFlagValue
 }
@@ -37,6 +42,7 @@ func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
+			secretVersionFlagName,
 			credentialTypeFlagName,
 			credentialMappingFlagName,
 		},
@@ -44,6 +50,7 @@ func extraVaultGenericActionsFlagsMapFuncImpl() map[string][]string {
 			pathFlagName,
 			httpMethodFlagName,
 			httpRequestBodyFlagName,
+			secretVersionFlagName,
 			credentialMappingFlagName,
 		},
 	}
@@ -73,6 +80,12 @@ func extraVaultGenericFlagsFuncImpl(c *VaultGenericCommand, set *base.FlagSets,
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case secretVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   secretVersionFlagName,
+				Target: &c.flagSecretVersion,
+				Usage:  "The version of a KV v2 secret the library reads from vault. If not set, the latest version is read. Can only be used with the GET http method.",
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -111,6 +124,18 @@ func extraVaultGenericFlagHandlingFuncImpl(c *VaultGenericCommand, _ *base.FlagS
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagSecretVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySecretVersion())
+	default:
+		secretVersion, err := strconv.ParseUint(c.flagSecretVersion, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSecretVersion, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySecretVersion(uint32(secretVersion)))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
@@ -4,6 +4,9 @@
 package credentiallibrariescmd
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/hashicorp/boundary/api/credentiallibraries"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-secure-stdlib/parseutil"
@@ -44,6 +47,12 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagHttpRequestBody,
 				Usage:  "The http request body the library uses to communicate with vault. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case secretVersionFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   secretVersionFlagName,
+				Target: &c.flagSecretVersion,
+				Usage:  "The version of a KV v2 secret the library reads from vault. If not set, the latest version is read. Can only be used with the GET http method.",
+			})
 		case credentialTypeFlagName:
 			f.StringVar(&base.StringVar{
 				Name:   credentialTypeFlagName,
@@ -82,6 +91,18 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, _ *base.FlagSets, opts *[]c
 		rb, _ := parseutil.ParsePath(c.flagHttpRequestBody)
 		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibraryHttpRequestBody(rb))
 	}
+	switch c.flagSecretVersion {
+	case "":
+	case "null":
+		*opts = append(*opts, credentiallibraries.DefaultVaultCredentialLibrarySecretVersion())
+	default:
+		secretVersion, err := strconv.ParseUint(c.flagSecretVersion, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSecretVersion, err))
+			return false
+		}
+		*opts = append(*opts, credentiallibraries.WithVaultCredentialLibrarySecretVersion(uint32(secretVersion)))
+	}
 	switch c.flagCredentialType {
 	case "":
 	case "null":
@@ -13,11 +13,13 @@ import (
 )
 
 const (
-	usernameFlagName             = "username"
-	passwordFlagName             = "password"
-	privateKeyFlagName           = "private-key"
-	privateKeyPassphraseFlagName = "private-key-passphrase"
-	secretFlagName               = "secret"
+	usernameFlagName              = "username"
+	passwordFlagName              = "password"
+	privateKeyFlagName            = "private-key"
+	privateKeyPassphraseFlagName  = "private-key-passphrase"
+	secretFlagName                = "secret"
+	rotationPeriodSecondsFlagName = "rotation-period-seconds"
+	credentialVersionFlagName     = "credential-version"
 )
 
 func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
@@ -127,6 +129,9 @@ func printItemTable(item *credentials.Credential, resp *api.Response) string {
 	if item.Version != 0 {
 		nonAttributeMap["Version"] = item.Version
 	}
+	if item.CredentialVersion != 0 {
+		nonAttributeMap["Credential Version"] = item.CredentialVersion
+	}
 	if !item.CreatedTime.IsZero() {
 		nonAttributeMap["Created Time"] = item.CreatedTime.Local().Format(time.RFC1123)
 	}
@@ -186,4 +191,5 @@ var keySubstMap = map[string]string{
 	"password_hmac":               "Password HMAC",
 	"private_key_hmac":            "Private Key HMAC",
 	"private_key_passphrase_hmac": "Private Key Passphrase HMAC",
+	"rotation_period_seconds":     "Rotation Period Seconds",
 }
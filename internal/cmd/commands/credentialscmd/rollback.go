@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RollbackCommand)(nil)
+	_ cli.CommandAutocomplete = (*RollbackCommand)(nil)
+)
+
+type RollbackCommand struct {
+	*base.Command
+
+	flagCredentialVersion uint64
+}
+
+func (c *RollbackCommand) Synopsis() string {
+	return wordwrap.WrapString("Roll back the secret of a credential to an earlier credential version", base.TermWidth)
+}
+
+func (c *RollbackCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials rollback [args]",
+		"",
+		"  Restore the secret a credential held at an earlier credential version given its ID. The restored secret is recorded as a new credential version. Example:",
+		"",
+		`    $ boundary credentials rollback -id credup_1234567890 -credential-version 2`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RollbackCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "credential", map[string][]string{"rollback": {"id", "version"}}, "rollback")
+	f.Uint64Var(&base.Uint64Var{
+		Name:   credentialVersionFlagName,
+		Target: &c.flagCredentialVersion,
+		Usage:  "The credential version whose secret is restored. Must be earlier than the current credential version.",
+	})
+	return set
+}
+
+func (c *RollbackCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RollbackCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RollbackCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagCredentialVersion == 0:
+		c.PrintCliError(errors.New("Credential version must be provided via -credential-version"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []credentials.Option
+	var version uint32
+	switch c.FlagVersion {
+	case 0:
+		opts = append(opts, credentials.WithAutomaticVersioning(true))
+	default:
+		version = uint32(c.FlagVersion)
+	}
+
+	cClient := credentials.NewClient(client)
+	result, err := cClient.Rollback(c.Context, c.FlagId, version, uint32(c.flagCredentialVersion), opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing rollback on credential")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to roll back credential: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
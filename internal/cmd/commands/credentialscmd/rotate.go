@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/credentials"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/cmd/common"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RotateCommand)(nil)
+	_ cli.CommandAutocomplete = (*RotateCommand)(nil)
+)
+
+type RotateCommand struct {
+	*base.Command
+
+	flagPassword string
+}
+
+func (c *RotateCommand) Synopsis() string {
+	return wordwrap.WrapString("Rotate the password of a username password credential", base.TermWidth)
+}
+
+func (c *RotateCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary credentials rotate [args]",
+		"",
+		"  Rotate the password of a username password credential given its ID. The new password is recorded as a new credential version. If a password is not provided, a random password is generated, which requires a credential rotation command to be configured on the controller. Example:",
+		"",
+		`    $ boundary credentials rotate -id credup_1234567890 -password env://NEW_PASSWORD`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RotateCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	common.PopulateCommonFlags(c.Command, f, "credential", map[string][]string{"rotate": {"id", "version"}}, "rotate")
+	f.StringVar(&base.StringVar{
+		Name:   passwordFlagName,
+		Target: &c.flagPassword,
+		Usage:  "The new password of the credential. This can be a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read. If not specified, a random password is generated.",
+	})
+	return set
+}
+
+func (c *RotateCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RotateCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RotateCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	var password string
+	if c.flagPassword != "" {
+		var err error
+		password, err = parseutil.MustParsePath(c.flagPassword)
+		switch {
+		case err == nil:
+		case errors.Is(err, parseutil.ErrNotParsed):
+			c.PrintCliError(errors.New("Password flag must be used with env:// or file:// syntax"))
+			return base.CommandUserError
+		default:
+			c.PrintCliError(fmt.Errorf("Error parsing password flag: %w", err))
+			return base.CommandUserError
+		}
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []credentials.Option
+	var version uint32
+	switch c.FlagVersion {
+	case 0:
+		opts = append(opts, credentials.WithAutomaticVersioning(true))
+	default:
+		version = uint32(c.FlagVersion)
+	}
+
+	cClient := credentials.NewClient(client)
+	result, err := cClient.Rotate(c.Context, c.FlagId, version, password, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when performing rotate on credential")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to rotate credential: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printItemTable(result.GetItem(), result.GetResponse()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
@@ -6,6 +6,8 @@ package credentialscmd
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/hashicorp/boundary/api/credentials"
 	"github.com/hashicorp/boundary/internal/cmd/base"
@@ -19,8 +21,9 @@ func init() {
 }
 
 type extraUsernamePasswordCmdVars struct {
-	flagUsername string
-	flagPassword string
+	flagUsername              string
+	flagPassword              string
+	flagRotationPeriodSeconds string
 }
 
 func extraUsernamePasswordActionsFlagsMapFuncImpl() map[string][]string {
@@ -28,6 +31,7 @@ func extraUsernamePasswordActionsFlagsMapFuncImpl() map[string][]string {
 		"create": {
 			usernameFlagName,
 			passwordFlagName,
+			rotationPeriodSecondsFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -51,6 +55,12 @@ func extraUsernamePasswordFlagsFuncImpl(c *UsernamePasswordCommand, set *base.Fl
 				Target: &c.flagPassword,
 				Usage:  "The password associated with the credential. This can be a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
 			})
+		case rotationPeriodSecondsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   rotationPeriodSecondsFlagName,
+				Target: &c.flagRotationPeriodSeconds,
+				Usage:  `The period after which the password of the credential is rotated. Can be specified as an integer number of seconds or a duration string. Scheduled rotation requires a credential rotation command to be configured on the controller.`,
+			})
 		}
 	}
 }
@@ -76,6 +86,25 @@ func extraUsernamePasswordFlagHandlingFuncImpl(c *UsernamePasswordCommand, _ *ba
 		}
 		*opts = append(*opts, credentials.WithUsernamePasswordCredentialPassword(password))
 	}
+	switch c.flagRotationPeriodSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, credentials.DefaultUsernamePasswordCredentialRotationPeriodSeconds())
+	default:
+		var final uint32
+		period, err := strconv.ParseUint(c.flagRotationPeriodSeconds, 10, 32)
+		if err == nil {
+			final = uint32(period)
+		} else {
+			dur, err := time.ParseDuration(c.flagRotationPeriodSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagRotationPeriodSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, credentials.WithUsernamePasswordCredentialRotationPeriodSeconds(final))
+	}
 
 	return true
 }
@@ -42,6 +42,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores create static -scope-id p_1234567890`,
 			"",
+			"    Create a ssh-ca-type credential store:",
+			"",
+			`      $ boundary credential-stores create ssh-ca -scope-id p_1234567890`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	case "update":
@@ -58,6 +62,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary credential-stores update static -id cs_1234567890 -name devops -description "For DevOps usage"`,
 			"",
+			"    Update a ssh-ca-type credential store:",
+			"",
+			`      $ boundary credential-stores update ssh-ca -id cssca_1234567890 -name devops -description "For DevOps usage"`,
+			"",
 			"  Please see the typed subcommand help for detailed usage information.",
 		})
 	default:
@@ -212,4 +220,7 @@ var keySubstMap = map[string]string{
 	"client_certificate":          "Client Certificate",
 	"client_certificate_key_hmac": "Client Certificate Key HMAC",
 	"worker_filter":               "Worker Filter",
+	"key_type":                    "Key Type",
+	"key_bits":                    "Key Bits",
+	"public_key":                  "Public Key",
 }
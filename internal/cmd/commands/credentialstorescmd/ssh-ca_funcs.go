@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package credentialstorescmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/boundary/api/credentialstores"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraSshCaFlagsFunc = extraSshCaFlagsFuncImpl
+	extraSshCaActionsFlagsMapFunc = extraSshCaActionsFlagsMapFuncImpl
+	extraSshCaFlagsHandlingFunc = extraSshCaFlagHandlingFuncImpl
+}
+
+const (
+	keyTypeFlagName    = "key-type"
+	keyBitsFlagName    = "key-bits"
+	privateKeyFlagName = "private-key"
+)
+
+type extraSshCaCmdVars struct {
+	flagKeyType    string
+	flagKeyBits    string
+	flagPrivateKey string
+}
+
+func extraSshCaActionsFlagsMapFuncImpl() map[string][]string {
+	flags := map[string][]string{
+		"create": {
+			keyTypeFlagName,
+			keyBitsFlagName,
+			privateKeyFlagName,
+		},
+	}
+	return flags
+}
+
+func extraSshCaFlagsFuncImpl(c *SshCaCommand, set *base.FlagSets, _ *base.FlagSet) {
+	f := set.NewFlagSet("SSH CA Credential Store Options")
+
+	for _, name := range flagsSshCaMap[c.Func] {
+		switch name {
+		case keyTypeFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   keyTypeFlagName,
+				Target: &c.flagKeyType,
+				Usage:  "The key type of the generated certificate authority key. One of: ed25519, ecdsa, rsa. Defaults to ed25519.",
+			})
+		case keyBitsFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   keyBitsFlagName,
+				Target: &c.flagKeyBits,
+				Usage:  "The number of bits of the generated certificate authority key. Depends on key-type. If ed25519 this should not be set, if ecdsa one of 256, 384, 521, if rsa one of 2048, 3072, 4096.",
+			})
+		case privateKeyFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   privateKeyFlagName,
+				Target: &c.flagPrivateKey,
+				Usage:  "An existing PEM encoded certificate authority private key to use instead of generating one. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		}
+	}
+}
+
+func extraSshCaFlagHandlingFuncImpl(c *SshCaCommand, _ *base.FlagSets, opts *[]credentialstores.Option) bool {
+	switch c.flagKeyType {
+	case "":
+	default:
+		*opts = append(*opts, credentialstores.WithSshCaCredentialStoreKeyType(c.flagKeyType))
+	}
+	switch c.flagKeyBits {
+	case "", "0":
+	default:
+		keyBits, err := strconv.ParseUint(c.flagKeyBits, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKeyBits, err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithSshCaCredentialStoreKeyBits(uint32(keyBits)))
+	}
+	switch c.flagPrivateKey {
+	case "":
+	default:
+		pk, err := parseutil.ParsePath(c.flagPrivateKey)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing private key: %s", err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithSshCaCredentialStorePrivateKey(pk))
+	}
+
+	return true
+}
+
+func (c *SshCaCommand) extraSshCaHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores create ssh-ca [options] [args]",
+			"",
+			"  Create a ssh-ca-type credential store. Example:",
+			"",
+			`    $ boundary credential-stores create ssh-ca -scope-id p_1234567890 -key-type ecdsa -key-bits 384`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary credential-stores update ssh-ca [options] [args]",
+			"",
+			"  Update a ssh-ca-type credential store given its ID. Example:",
+			"",
+			`    $ boundary credential-stores update ssh-ca -id cssca_1234567890 -name devops -description "For DevOps usage"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
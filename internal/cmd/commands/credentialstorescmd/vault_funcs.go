@@ -4,6 +4,7 @@
 package credentialstorescmd
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/hashicorp/boundary/api/credentialstores"
@@ -28,6 +29,10 @@ const (
 	clientCertificateFlagName    = "vault-client-certificate"
 	clientCertificateKeyFlagName = "vault-client-certificate-key"
 	workerFilterFlagName         = "worker-filter"
+	authMethodFlagName           = "vault-auth-method"
+	authMountPathFlagName        = "vault-auth-mount-path"
+	authRoleFlagName             = "vault-auth-role"
+	authSecretIdFlagName         = "vault-auth-secret-id"
 )
 
 type extraVaultCmdVars struct {
@@ -40,6 +45,10 @@ type extraVaultCmdVars struct {
 	flagTlsServerName string
 	flagTlsSkipVerify bool
 	flagWorkerFilter  string
+	flagAuthMethod    string
+	flagAuthMountPath string
+	flagAuthRole      string
+	flagAuthSecretId  string
 }
 
 func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
@@ -54,6 +63,10 @@ func extraVaultActionsFlagsMapFuncImpl() map[string][]string {
 			clientCertificateFlagName,
 			clientCertificateKeyFlagName,
 			workerFilterFlagName,
+			authMethodFlagName,
+			authMountPathFlagName,
+			authRoleFlagName,
+			authSecretIdFlagName,
 		},
 	}
 	flags["update"] = flags["create"]
@@ -119,6 +132,30 @@ func extraVaultFlagsFuncImpl(c *VaultCommand, set *base.FlagSets, _ *base.FlagSe
 				Target: &c.flagWorkerFilter,
 				Usage:  `A boolean expression to filter which workers can handle Vault commands for this credential store.`,
 			})
+		case authMethodFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authMethodFlagName,
+				Target: &c.flagAuthMethod,
+				Usage:  `The Vault auth method boundary uses to log in to vault for this store instead of a vault token. Must be "approle" or "jwt".`,
+			})
+		case authMountPathFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authMountPathFlagName,
+				Target: &c.flagAuthMountPath,
+				Usage:  "The path the Vault auth method is mounted at. Defaults to the name of the auth method.",
+			})
+		case authRoleFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authRoleFlagName,
+				Target: &c.flagAuthRole,
+				Usage:  "The role_id of the AppRole when using the approle auth method, or the name of the role when using the jwt auth method.",
+			})
+		case authSecretIdFlagName:
+			f.StringVar(&base.StringVar{
+				Name:   authSecretIdFlagName,
+				Target: &c.flagAuthSecretId,
+				Usage:  "The secret_id of the AppRole when using the approle auth method. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
 		}
 	}
 }
@@ -176,6 +213,39 @@ func extraVaultFlagHandlingFuncImpl(c *VaultCommand, f *base.FlagSets, opts *[]c
 		}
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreWorkerFilter(c.flagWorkerFilter))
 	}
+	switch c.flagAuthMethod {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthMethod())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthMethod(c.flagAuthMethod))
+	}
+	switch c.flagAuthMountPath {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthMountPath())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthMountPath(c.flagAuthMountPath))
+	}
+	switch c.flagAuthRole {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthRole())
+	default:
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthRole(c.flagAuthRole))
+	}
+	switch c.flagAuthSecretId {
+	case "":
+	case "null":
+		*opts = append(*opts, credentialstores.DefaultVaultCredentialStoreAuthSecretId())
+	default:
+		secretId, err := parseutil.ParsePath(c.flagAuthSecretId)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing vault auth secret id: %s", err))
+			return false
+		}
+		*opts = append(*opts, credentialstores.WithVaultCredentialStoreAuthSecretId(secretId))
+	}
 	if c.flagTlsSkipVerify {
 		*opts = append(*opts, credentialstores.WithVaultCredentialStoreTlsSkipVerify(c.flagTlsSkipVerify))
 	}
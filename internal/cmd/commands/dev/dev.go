@@ -547,6 +547,17 @@ func (c *Command) Run(args []string) int {
 			}
 			c.ShutdownFuncs = append(c.ShutdownFuncs, func() error { return os.RemoveAll(c.Config.Worker.RecordingStoragePath) })
 		}
+
+		if c.Config.Plugins.FilesystemRootDir == "" {
+			// Create a temp dir for the filesystem storage plugin
+			const pattern = "filesystemstorage"
+			c.Config.Plugins.FilesystemRootDir, err = os.MkdirTemp("", pattern)
+			if err != nil {
+				c.UI.Error(fmt.Errorf("Error creating filesystem storage temp dir: %w", err).Error())
+				return base.CommandCliError
+			}
+			c.ShutdownFuncs = append(c.ShutdownFuncs, func() error { return os.RemoveAll(c.Config.Plugins.FilesystemRootDir) })
+		}
 	}
 
 	if c.flagIdSuffix != "" {
@@ -722,6 +733,13 @@ func (c *Command) Run(args []string) int {
 		return base.CommandCliError
 	}
 
+	if c.Config.Tracing != nil {
+		if err := c.SetupTracing(c.Context, serverName, c.Config.Tracing); err != nil {
+			c.UI.Error(err.Error())
+			return base.CommandCliError
+		}
+	}
+
 	if c.flagRecoveryKey != "" {
 		c.Config.DevRecoveryKey = c.flagRecoveryKey
 	}
@@ -821,7 +839,7 @@ func (c *Command) Run(args []string) int {
 	}
 
 	{
-		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP)
+		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP, base.EnabledPluginFilesystem)
 		if base.MinioEnabled {
 			c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginMinio)
 		}
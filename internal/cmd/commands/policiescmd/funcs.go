@@ -17,6 +17,7 @@ var keySubstMap = map[string]string{
 	"delete_after": "Storage Deletion",
 	"days":         "Days",
 	"overridable":  "Overridable",
+	"legal_hold":   "Legal Hold",
 }
 
 func (c *Command) extraHelpFunc(_ map[string]func() string) string {
@@ -209,6 +210,12 @@ func printItemTable(item *policies.Policy, _ *api.Response) string {
 					"",
 				)
 			}
+			if legalHold, ok := item.Attributes[keySubstMap["legal_hold"]]; ok {
+				ret = append(ret,
+					base.WrapMap(4, maxLength, map[string]any{keySubstMap["legal_hold"]: legalHold}),
+					"",
+				)
+			}
 		}
 
 	default:
@@ -22,6 +22,7 @@ type extraStorageCmdVars struct {
 	flagRetainForOverridable   string
 	flagDeleteAfterDays        string
 	flagDeleteAfterOverridable string
+	flagLegalHold              string
 
 	flagRetainFor   map[string]string
 	flagDeleteAfter map[string]string
@@ -56,8 +57,8 @@ func (c *StorageCommand) extraStorageHelpFunc(_ map[string]func() string) string
 
 func extraStorageActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"create": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable"},
-		"update": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable"},
+		"create": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable", "legal-hold"},
+		"update": {"retain-for-days", "retain-for-overridable", "delete-after-days", "delete-after-overridable", "legal-hold"},
 	}
 }
 
@@ -90,6 +91,12 @@ func extraStorageFlagsFuncImpl(c *StorageCommand, set *base.FlagSets, _ *base.Fl
 				Target: &c.flagDeleteAfterOverridable,
 				Usage:  "Allow/Disallow this policy's deletion period to be overridden by downstream Policies (true or false)",
 			})
+		case "legal-hold":
+			fs.StringVar(&base.StringVar{
+				Name:   "legal-hold",
+				Target: &c.flagLegalHold,
+				Usage:  "Prevent/Allow the deletion of the session recordings stored in this policy's scope (true or false).",
+			})
 		}
 	}
 }
@@ -144,6 +151,18 @@ func extraStorageFlagsHandlingFuncImpl(c *StorageCommand, _ *base.FlagSets, opts
 		}
 		*opts = append(*opts, policies.WithStoragePolicyDeleteAfterOverridable(overridable))
 	}
+	switch c.flagLegalHold {
+	case "":
+	case "null":
+		*opts = append(*opts, policies.DefaultStoragePolicyLegalHold())
+	default:
+		legalHold, err := strconv.ParseBool(c.flagLegalHold)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagLegalHold, err))
+			return false
+		}
+		*opts = append(*opts, policies.WithStoragePolicyLegalHold(legalHold))
+	}
 
 	return true
 }
@@ -0,0 +1,436 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rolescmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*RequestAccessCommand)(nil)
+	_ cli.CommandAutocomplete = (*RequestAccessCommand)(nil)
+	_ cli.Command             = (*ListAccessRequestsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListAccessRequestsCommand)(nil)
+	_ cli.Command             = (*DecideAccessRequestCommand)(nil)
+	_ cli.CommandAutocomplete = (*DecideAccessRequestCommand)(nil)
+)
+
+type RequestAccessCommand struct {
+	*base.Command
+
+	flagJustification string
+	flagDuration      time.Duration
+}
+
+func (c *RequestAccessCommand) Synopsis() string {
+	return wordwrap.WrapString("Request to be a principal of a role for a bounded duration", base.TermWidth)
+}
+
+func (c *RequestAccessCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary roles request-access [args]",
+		"",
+		"  Request for the authenticated user to be a principal of a role. Once a user with the approve-requests action on the role approves the request, the user is added to the role and removed again when the duration has passed. Example:",
+		"",
+		`    $ boundary roles request-access -id r_1234567890 -justification "incident 42" -duration 2h`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RequestAccessCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the role to request access to.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "justification",
+		Target: &c.flagJustification,
+		Usage:  "The reason for the request.",
+	})
+	f.DurationVar(&base.DurationVar{
+		Name:       "duration",
+		Target:     &c.flagDuration,
+		Completion: complete.PredictAnything,
+		Usage:      "How long to be a principal of the role once the request is approved, at most 7 days (168h).",
+	})
+	return set
+}
+
+func (c *RequestAccessCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RequestAccessCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RequestAccessCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagJustification == "":
+		c.PrintCliError(errors.New("Justification must be provided via -justification"))
+		return base.CommandUserError
+	case c.flagDuration < time.Second:
+		c.PrintCliError(errors.New("A duration of at least one second must be provided via -duration"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	rClient := roles.NewClient(client)
+	result, err := rClient.RequestAccess(c.Context, c.FlagId, c.flagJustification, c.flagDuration)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when requesting access to role")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error requesting access to role: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printAccessRequestTable(result.GetItem()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+type ListAccessRequestsCommand struct {
+	*base.Command
+
+	flagPageSize uint64
+}
+
+func (c *ListAccessRequestsCommand) Synopsis() string {
+	return wordwrap.WrapString("List the access requests of a role", base.TermWidth)
+}
+
+func (c *ListAccessRequestsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary roles list-access-requests [args]",
+		"",
+		"  List the access requests of a role, most recent first. Example:",
+		"",
+		`    $ boundary roles list-access-requests -id r_1234567890`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListAccessRequestsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the role whose access requests to list.",
+	})
+	f.Uint64Var(&base.Uint64Var{
+		Name:   "page-size",
+		Target: &c.flagPageSize,
+		Usage:  "The maximum number of access requests to return.",
+	})
+	return set
+}
+
+func (c *ListAccessRequestsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListAccessRequestsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListAccessRequestsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []roles.Option
+	if c.flagPageSize != 0 {
+		opts = append(opts, roles.WithPageSize(uint32(c.flagPageSize)))
+	}
+
+	rClient := roles.NewClient(client)
+	result, err := rClient.ListAccessRequests(c.Context, c.FlagId, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing access requests of role")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error listing access requests: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printAccessRequestsListTable(result.GetItems()))
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+// DecideAccessRequestCommand approves or denies an access request, depending
+// on Func.
+type DecideAccessRequestCommand struct {
+	*base.Command
+
+	Func string
+
+	flagAccessRequestId string
+	flagComment         string
+}
+
+func (c *DecideAccessRequestCommand) Synopsis() string {
+	switch c.Func {
+	case "approve":
+		return wordwrap.WrapString("Approve an access request of a role", base.TermWidth)
+	default:
+		return wordwrap.WrapString("Deny an access request of a role", base.TermWidth)
+	}
+}
+
+func (c *DecideAccessRequestCommand) Help() string {
+	var helpStr string
+	switch c.Func {
+	case "approve":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary roles approve-access-request [args]",
+			"",
+			"  Approve a pending access request of a role. The requesting user is added to the role until the requested duration has passed. Users cannot approve their own requests. Example:",
+			"",
+			`    $ boundary roles approve-access-request -id r_1234567890 -access-request-id ar_1234567890`,
+			"",
+			"",
+		})
+	default:
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary roles deny-access-request [args]",
+			"",
+			"  Deny a pending access request of a role. Users cannot deny their own requests. Example:",
+			"",
+			`    $ boundary roles deny-access-request -id r_1234567890 -access-request-id ar_1234567890 -comment "not on call"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func (c *DecideAccessRequestCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the role the access request was made for.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "access-request-id",
+		Target: &c.flagAccessRequestId,
+		Usage:  fmt.Sprintf("The id of the access request to %s.", c.Func),
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "comment",
+		Target: &c.flagComment,
+		Usage:  "An optional comment on the decision.",
+	})
+	return set
+}
+
+func (c *DecideAccessRequestCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *DecideAccessRequestCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *DecideAccessRequestCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagAccessRequestId == "":
+		c.PrintCliError(errors.New("Access request ID must be provided via -access-request-id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	rClient := roles.NewClient(client)
+	var result *roles.AccessRequestResult
+	switch c.Func {
+	case "approve":
+		result, err = rClient.ApproveAccessRequest(c.Context, c.FlagId, c.flagAccessRequestId, c.flagComment)
+	default:
+		result, err = rClient.DenyAccessRequest(c.Context, c.FlagId, c.flagAccessRequestId, c.flagComment)
+	}
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, fmt.Sprintf("Error from controller when performing %s on access request", c.Func))
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error trying to %s access request: %w", c.Func, err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printAccessRequestTable(result.GetItem()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+func printAccessRequestTable(item *roles.AccessRequest) string {
+	nonAttributeMap := map[string]any{
+		"ID":            item.Id,
+		"Role ID":       item.RoleId,
+		"User ID":       item.UserId,
+		"Justification": item.Justification,
+		"Duration":      (time.Duration(item.DurationSeconds) * time.Second).String(),
+		"Status":        item.Status,
+		"Created Time":  item.CreatedTime.Local().Format(time.RFC1123),
+		"Updated Time":  item.UpdatedTime.Local().Format(time.RFC1123),
+	}
+	if item.ApproverId != "" {
+		nonAttributeMap["Approver ID"] = item.ApproverId
+	}
+	if item.DecisionComment != "" {
+		nonAttributeMap["Decision Comment"] = item.DecisionComment
+	}
+	if !item.DecisionTime.IsZero() {
+		nonAttributeMap["Decision Time"] = item.DecisionTime.Local().Format(time.RFC1123)
+	}
+	if !item.ExpirationTime.IsZero() {
+		nonAttributeMap["Expiration Time"] = item.ExpirationTime.Local().Format(time.RFC1123)
+	}
+	if !item.RevokeTime.IsZero() {
+		nonAttributeMap["Revoke Time"] = item.RevokeTime.Local().Format(time.RFC1123)
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Access Request information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
+
+func printAccessRequestsListTable(items []*roles.AccessRequest) string {
+	if len(items) == 0 {
+		return "No access requests found"
+	}
+	output := []string{
+		"",
+		"Access Request information:",
+	}
+	for i, r := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                %s", r.Id),
+			fmt.Sprintf("    User ID:         %s", r.UserId),
+			fmt.Sprintf("    Status:          %s", r.Status),
+			fmt.Sprintf("    Duration:        %s", time.Duration(r.DurationSeconds)*time.Second),
+			fmt.Sprintf("    Created Time:    %s", r.CreatedTime.Local().Format(time.RFC1123)),
+		)
+		if r.ApproverId != "" {
+			output = append(output, fmt.Sprintf("    Approver ID:     %s", r.ApproverId))
+		}
+		if !r.ExpirationTime.IsZero() {
+			output = append(output, fmt.Sprintf("    Expiration Time: %s", r.ExpirationTime.Local().Format(time.RFC1123)))
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
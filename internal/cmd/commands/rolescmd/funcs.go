@@ -114,6 +114,10 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`    $ boundary roles add-grants -id r_1234567890 -grant "ids=*;type=*;actions=read"`,
 			"",
+			`  Grants can have conditions, which must all be met by a request for the grant to apply: "client_cidrs" restricts the client IP, "days" and "hours" restrict the time of the request in the "timezone" (UTC by default), and "expires" is an RFC 3339 time after which the grant no longer applies. Example:`,
+			"",
+			`    $ boundary roles add-grants -id r_1234567890 -grant "ids=*;type=target;actions=authorize-session;client_cidrs=10.0.0.0/8;days=mon,tue,wed,thu,fri;hours=9-17;timezone=Europe/Berlin"`,
+			"",
 			"",
 		})
 
@@ -459,6 +463,9 @@ func printItemTable(item *roles.Role, resp *api.Response) string {
 		ret = append(ret,
 			fmt.Sprintf("    %s", grant.Canonical),
 		)
+		if grant.Json != nil && grant.Json.Conditions != nil {
+			ret = append(ret, printGrantConditions(grant.Json.Conditions)...)
+		}
 	}
 	if len(item.GrantScopeIds) > 0 {
 		ret = append(ret,
@@ -474,3 +481,25 @@ func printItemTable(item *roles.Role, resp *api.Response) string {
 
 	return base.WrapForHelpText(ret)
 }
+
+func printGrantConditions(c *roles.GrantConditions) []string {
+	ret := []string{
+		fmt.Sprintf("      Conditions:     %s", ""),
+	}
+	if len(c.ClientCidrs) > 0 {
+		ret = append(ret, fmt.Sprintf("        Client CIDRs: %s", strings.Join(c.ClientCidrs, ", ")))
+	}
+	if len(c.Days) > 0 {
+		ret = append(ret, fmt.Sprintf("        Days:         %s", strings.Join(c.Days, ", ")))
+	}
+	if c.Hours != "" {
+		ret = append(ret, fmt.Sprintf("        Hours:        %s", c.Hours))
+	}
+	if c.Timezone != "" {
+		ret = append(ret, fmt.Sprintf("        Timezone:     %s", c.Timezone))
+	}
+	if !c.Expires.IsZero() {
+		ret = append(ret, fmt.Sprintf("        Expires:      %s", c.Expires.Local().Format(time.RFC1123)))
+	}
+	return ret
+}
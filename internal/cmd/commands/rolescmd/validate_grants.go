@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package rolescmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/roles"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ValidateGrantsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ValidateGrantsCommand)(nil)
+)
+
+type ValidateGrantsCommand struct {
+	*base.Command
+
+	flagGrants []string
+}
+
+func (c *ValidateGrantsCommand) Synopsis() string {
+	return wordwrap.WrapString("Validate grants against a role without adding them", base.TermWidth)
+}
+
+func (c *ValidateGrantsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary roles validate-grants [args]",
+		"",
+		`  Validate grants against a role given its ID without adding them to the role. Grants which would be rejected, unknown resource IDs, actions that do not apply to the type of a grant, and grants that duplicate or overlap with other grants are reported, along with what the grants would allow that the role does not allow today. The "grant" flag can be specified multiple times. Example:`,
+		"",
+		`    $ boundary roles validate-grants -id r_1234567890 -grant "ids=*;type=target;actions=read,authorize-session"`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ValidateGrantsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the role to validate the grants against.",
+	})
+	f.StringSliceVar(&base.StringSliceVar{
+		Name:   "grant",
+		Target: &c.flagGrants,
+		Usage:  "The grants to validate. May be specified multiple times. Can be in compact string format or JSON (be sure to escape JSON properly).",
+	})
+	return set
+}
+
+func (c *ValidateGrantsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ValidateGrantsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ValidateGrantsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case len(c.flagGrants) == 0:
+		c.PrintCliError(errors.New("At least one grant must be provided via -grant"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	rClient := roles.NewClient(client)
+	result, err := rClient.ValidateGrants(c.Context, c.FlagId, c.flagGrants)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when validating grants")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error validating grants: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printGrantsValidationTable(result.GetItem()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+func printGrantsValidationTable(item *roles.GrantsValidation) string {
+	nonAttributeMap := map[string]any{
+		"Role ID": item.RoleId,
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Grants validation:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if len(item.Grants) > 0 {
+		ret = append(ret,
+			"",
+			"  Grants:",
+		)
+		for _, g := range item.Grants {
+			ret = append(ret, fmt.Sprintf("    Grant:             %s", g.Grant))
+			if g.CanonicalGrant != "" {
+				ret = append(ret, fmt.Sprintf("      Canonical:       %s", g.CanonicalGrant))
+			}
+			if g.Error != "" {
+				ret = append(ret, fmt.Sprintf("      Error:           %s", g.Error))
+			}
+			for _, w := range g.Warnings {
+				ret = append(ret, fmt.Sprintf("      Warning:         %s", w))
+			}
+			ret = append(ret, "")
+		}
+	}
+
+	if len(item.NewlyAllowed) > 0 {
+		ret = append(ret,
+			"",
+			"  Newly Allowed:",
+		)
+		for _, na := range item.NewlyAllowed {
+			id := na.Id
+			if id == "" {
+				id = "(collection)"
+			}
+			ret = append(ret,
+				fmt.Sprintf("    Grant Scope ID:    %s", na.GrantScopeId),
+				fmt.Sprintf("      Type:            %s", na.Type),
+				fmt.Sprintf("      ID:              %s", id),
+				fmt.Sprintf("      Actions:         %s", strings.Join(na.Actions, ", ")),
+				"",
+			)
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
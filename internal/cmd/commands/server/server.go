@@ -213,6 +213,13 @@ func (c *Command) Run(args []string) int {
 		c.UI.Error(err.Error())
 		return base.CommandUserError
 	}
+
+	if c.Config.Tracing != nil {
+		if err := c.SetupTracing(c.Context, serverName, c.Config.Tracing); err != nil {
+			c.UI.Error(err.Error())
+			return base.CommandUserError
+		}
+	}
 	c.WorkerAuthDebuggingEnabled.Store(c.Config.EnableWorkerAuthDebugging)
 
 	base.StartMemProfiler(c.Context)
@@ -492,7 +499,7 @@ func (c *Command) Run(args []string) int {
 		}
 	}
 
-	c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP)
+	c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginAws, base.EnabledPluginHostAzure, base.EnabledPluginGCP, base.EnabledPluginFilesystem)
 	if base.MinioEnabled {
 		c.EnabledPlugins = append(c.EnabledPlugins, base.EnabledPluginMinio)
 	}
@@ -38,6 +38,18 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 			`      $ boundary session-recordings download -id chr_1234567890`,
 			"",
+			"    Search the contents of session recordings:",
+			"",
+			`      $ boundary session-recordings search -scope-id global -recursive -query sudo`,
+			"",
+			"    List the storage policy retention reports:",
+			"",
+			`      $ boundary session-recordings list-retention-reports -scope-id global -recursive`,
+			"",
+			"    Place a legal hold on a session recording:",
+			"",
+			`      $ boundary session-recordings set-legal-hold -id sr_1234567890 -reason "incident 42"`,
+			"",
 
 			"  Please see the sessions subcommand help for detailed usage information.",
 		})
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*SetLegalHoldCommand)(nil)
+	_ cli.CommandAutocomplete = (*SetLegalHoldCommand)(nil)
+	_ cli.Command             = (*RemoveLegalHoldCommand)(nil)
+	_ cli.CommandAutocomplete = (*RemoveLegalHoldCommand)(nil)
+)
+
+type SetLegalHoldCommand struct {
+	*base.Command
+
+	flagReason string
+}
+
+func (c *SetLegalHoldCommand) Synopsis() string {
+	return wordwrap.WrapString("Place a legal hold on a session recording", base.TermWidth)
+}
+
+func (c *SetLegalHoldCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings set-legal-hold [args]",
+		"",
+		"  Place a legal hold on a session recording. A held session recording is not deleted and its retention is not changed when storage policies are enforced or reapplied. Example:",
+		"",
+		`    $ boundary session-recordings set-legal-hold -id sr_0123456789 -reason "incident 42"`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *SetLegalHoldCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the session recording resource to hold.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "reason",
+		Target: &c.flagReason,
+		Usage:  "The reason for the hold.",
+	})
+	return set
+}
+
+func (c *SetLegalHoldCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *SetLegalHoldCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *SetLegalHoldCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := sessionrecordings.NewClient(client)
+	result, err := sClient.SetLegalHold(c.Context, c.FlagId, c.flagReason)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when placing a legal hold on session recording")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error placing legal hold: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printLegalHoldTable(result.GetItem()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+type RemoveLegalHoldCommand struct {
+	*base.Command
+}
+
+func (c *RemoveLegalHoldCommand) Synopsis() string {
+	return wordwrap.WrapString("Remove the legal hold of a session recording", base.TermWidth)
+}
+
+func (c *RemoveLegalHoldCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings remove-legal-hold [args]",
+		"",
+		"  Remove the legal hold of a session recording. Example:",
+		"",
+		`    $ boundary session-recordings remove-legal-hold -id sr_0123456789`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *RemoveLegalHoldCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the held session recording resource.",
+	})
+	return set
+}
+
+func (c *RemoveLegalHoldCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *RemoveLegalHoldCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *RemoveLegalHoldCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagId == "" {
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	sClient := sessionrecordings.NewClient(client)
+	result, err := sClient.RemoveLegalHold(c.Context, c.FlagId)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when removing the legal hold of session recording")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error removing legal hold: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	case "table":
+		c.UI.Output("The remove legal hold operation completed successfully.")
+	}
+
+	return base.CommandSuccess
+}
+
+func printLegalHoldTable(item *sessionrecordings.LegalHold) string {
+	nonAttributeMap := map[string]any{
+		"Session Recording ID": item.SessionRecordingId,
+		"User ID":              item.UserId,
+		"Created Time":         item.CreatedTime.Local().Format(time.RFC1123),
+	}
+	if item.Reason != "" {
+		nonAttributeMap["Reason"] = item.Reason
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Legal Hold information:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+	return base.WrapForHelpText(ret)
+}
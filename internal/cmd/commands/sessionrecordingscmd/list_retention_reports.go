@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ListRetentionReportsCommand)(nil)
+	_ cli.CommandAutocomplete = (*ListRetentionReportsCommand)(nil)
+)
+
+type ListRetentionReportsCommand struct {
+	*base.Command
+
+	flagPageSize uint64
+}
+
+func (c *ListRetentionReportsCommand) Synopsis() string {
+	return wordwrap.WrapString("List the storage policy retention reports of session recordings", base.TermWidth)
+}
+
+func (c *ListRetentionReportsCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings list-retention-reports [args]",
+		"",
+		"  List the reports written each time the storage policies are enforced, most recent first. Each report lists the session recordings which were held, marked as deleted, deleted or could not be deleted. Example:",
+		"",
+		`    $ boundary session-recordings list-retention-reports -scope-id global -recursive`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ListRetentionReportsCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:       "scope-id",
+		Target:     &c.FlagScopeId,
+		EnvVar:     "BOUNDARY_SCOPE_ID",
+		Default:    scope.Global.String(),
+		Completion: complete.PredictAnything,
+		Usage:      `Scope in which to make the request.`,
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "recursive",
+		Target: &c.FlagRecursive,
+		Usage:  "If set, the reports of the child scopes are also listed.",
+	})
+	f.Uint64Var(&base.Uint64Var{
+		Name:   "page-size",
+		Target: &c.flagPageSize,
+		Usage:  "The maximum number of reports to return.",
+	})
+	return set
+}
+
+func (c *ListRetentionReportsCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ListRetentionReportsCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ListRetentionReportsCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	if c.FlagScopeId == "" {
+		c.PrintCliError(errors.New("Scope ID must be provided via -scope-id or BOUNDARY_SCOPE_ID"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []sessionrecordings.Option
+	if c.FlagRecursive {
+		opts = append(opts, sessionrecordings.WithRecursive(true))
+	}
+	if c.flagPageSize != 0 {
+		opts = append(opts, sessionrecordings.WithPageSize(uint32(c.flagPageSize)))
+	}
+
+	sClient := sessionrecordings.NewClient(client)
+	result, err := sClient.ListRetentionReports(c.Context, c.FlagScopeId, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when listing retention reports")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error listing retention reports: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printRetentionReportsTable(result.GetItems()))
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+func printRetentionReportsTable(reports []*sessionrecordings.RetentionReport) string {
+	if len(reports) == 0 {
+		return "No retention reports found"
+	}
+	output := []string{
+		"",
+		"Retention Report information:",
+	}
+	for i, r := range reports {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  ID:                     %s", r.Id),
+			fmt.Sprintf("    Scope ID:             %s", r.ScopeId),
+			fmt.Sprintf("    Created Time:         %s", r.CreatedTime.Local().Format(time.RFC1123)),
+			fmt.Sprintf("    Recordings Evaluated: %d", r.RecordingsEvaluated),
+			fmt.Sprintf("    Recordings Held:      %d", r.RecordingsHeld),
+			fmt.Sprintf("    Recordings Marked:    %d", r.RecordingsMarked),
+			fmt.Sprintf("    Recordings Deleted:   %d", r.RecordingsDeleted),
+			fmt.Sprintf("    Recordings Failed:    %d", r.RecordingsFailed),
+		)
+		if len(r.Items) > 0 {
+			output = append(output, "    Session Recordings:")
+		}
+		for _, item := range r.Items {
+			line := fmt.Sprintf("      %s: %s", item.SessionRecordingId, item.Result)
+			if item.Details != "" {
+				line = fmt.Sprintf("%s (%s)", line, item.Details)
+			}
+			output = append(output, line)
+		}
+	}
+
+	return base.WrapForHelpText(output)
+}
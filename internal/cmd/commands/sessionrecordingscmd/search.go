@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sessionrecordingscmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/sessionrecordings"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*SearchCommand)(nil)
+	_ cli.CommandAutocomplete = (*SearchCommand)(nil)
+)
+
+type SearchCommand struct {
+	*base.Command
+
+	flagQuery string
+}
+
+func (c *SearchCommand) Synopsis() string {
+	return wordwrap.WrapString("Search the contents of session recordings", base.TermWidth)
+}
+
+func (c *SearchCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary session-recordings search [args]",
+		"",
+		"  Search the indexed commands and output of session recordings. The query supports quoted phrases, \"or\" and \"-\" to exclude a word. Example:",
+		"",
+		`    $ boundary session-recordings search -scope-id global -recursive -query "sudo -apt"`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *SearchCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:       "scope-id",
+		Target:     &c.FlagScopeId,
+		EnvVar:     "BOUNDARY_SCOPE_ID",
+		Default:    scope.Global.String(),
+		Completion: complete.PredictAnything,
+		Usage:      `Scope in which to make the request.`,
+	})
+	f.BoolVar(&base.BoolVar{
+		Name:   "recursive",
+		Target: &c.FlagRecursive,
+		Usage:  "If set, the session recordings of the child scopes are also searched.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "query",
+		Target: &c.flagQuery,
+		Usage:  "The text to search for in the session recordings.",
+	})
+	return set
+}
+
+func (c *SearchCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *SearchCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *SearchCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagScopeId == "":
+		c.PrintCliError(errors.New("Scope ID must be provided via -scope-id or BOUNDARY_SCOPE_ID"))
+		return base.CommandUserError
+	case c.flagQuery == "":
+		c.PrintCliError(errors.New("Query must be provided via -query"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []sessionrecordings.Option
+	if c.FlagRecursive {
+		opts = append(opts, sessionrecordings.WithRecursive(true))
+	}
+
+	sClient := sessionrecordings.NewClient(client)
+	result, err := sClient.Search(c.Context, c.FlagScopeId, c.flagQuery, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when searching session recordings")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error searching session recordings: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(c.printSearchTable(result.GetItems()))
+	case "json":
+		if ok := c.PrintJsonItems(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+func (c *SearchCommand) printSearchTable(items []*sessionrecordings.SearchMatch) string {
+	if len(items) == 0 {
+		return "No matching session recording lines found"
+	}
+	output := []string{
+		"",
+		"Session Recording search results:",
+	}
+	for i, item := range items {
+		if i > 0 {
+			output = append(output, "")
+		}
+		output = append(output,
+			fmt.Sprintf("  Session Recording ID:      %s", item.SessionRecordingId),
+		)
+		if c.FlagRecursive && item.Scope != nil && item.Scope.Id != "" {
+			output = append(output,
+				fmt.Sprintf("    Scope ID:                %s", item.Scope.Id),
+			)
+		}
+		if item.ConnectionRecordingId != "" {
+			output = append(output,
+				fmt.Sprintf("    Connection Recording ID: %s", item.ConnectionRecordingId),
+			)
+		}
+		if item.ChannelRecordingId != "" {
+			output = append(output,
+				fmt.Sprintf("    Channel Recording ID:    %s", item.ChannelRecordingId),
+			)
+		}
+		if !item.Time.IsZero() {
+			output = append(output,
+				fmt.Sprintf("    Time:                    %s", item.Time.Local().Format(time.RFC3339)),
+			)
+		}
+		if item.Direction != "" {
+			output = append(output,
+				fmt.Sprintf("    Direction:               %s", item.Direction),
+			)
+		}
+		output = append(output,
+			fmt.Sprintf("    Line:                    %s", item.Line),
+		)
+	}
+
+	return base.WrapForHelpText(output)
+}
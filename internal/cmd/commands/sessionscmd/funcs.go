@@ -28,8 +28,9 @@ func init() {
 
 func extraActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
-		"cancel": {"id"},
-		"list":   {flagIncludeTerminated},
+		"cancel":  {"id"},
+		"approve": {"id"},
+		"list":    {flagIncludeTerminated},
 	}
 }
 
@@ -84,6 +85,17 @@ func (c *Command) extraHelpFunc(helpMap map[string]func() string) string {
 			"",
 		})
 
+	case "approve":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary sessions approve [options] [args]",
+			"",
+			"  Approve the session specified by ID, allowing it to be activated. Only sessions of targets requiring approval that are still awaiting approval can be approved, and a session cannot be approved by its own user. Example:",
+			"",
+			`    $ boundary sessions approve -id s_1234567890`,
+			"",
+			"",
+		})
+
 	default:
 		helpStr = helpMap["base"]()
 	}
@@ -99,6 +111,12 @@ func executeExtraActionsImpl(c *Command, origResp *api.Response, origItem *sessi
 			return nil, nil, nil, err
 		}
 		return result.GetResponse(), result.GetItem(), nil, err
+	case "approve":
+		result, err := sessionClient.Approve(c.Context, c.FlagId, version, opts...)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return result.GetResponse(), result.GetItem(), nil, err
 	}
 	return origResp, origItem, origItems, origError
 }
@@ -212,6 +230,9 @@ func printItemTable(item *sessions.Session, resp *api.Response) string {
 	if item.Status != "" {
 		nonAttributeMap["Status"] = item.Status
 	}
+	if item.ApproverId != "" {
+		nonAttributeMap["Approver ID"] = item.ApproverId
+	}
 	if len(strings.TrimSpace(item.TerminationReason)) > 0 {
 		nonAttributeMap["Termination Reason"] = item.TerminationReason
 	}
@@ -535,6 +535,15 @@ func printItemTable(item *targets.Target, resp *api.Response) string {
 	if item.IngressWorkerFilter != "" {
 		nonAttributeMap["Ingress Worker Filter"] = item.IngressWorkerFilter
 	}
+	if item.ApprovalRequired {
+		nonAttributeMap["Approval Required"] = item.ApprovalRequired
+	}
+	if item.ApproversFilter != "" {
+		nonAttributeMap["Approvers Filter"] = item.ApproversFilter
+	}
+	if item.ApprovalTimeoutSeconds != 0 {
+		nonAttributeMap["Approval Timeout Seconds"] = item.ApprovalTimeoutSeconds
+	}
 	if resp != nil && resp.Map != nil {
 		if resp.Map[globals.SessionConnectionLimitField] != nil {
 			nonAttributeMap["Session Connection Limit"] = item.SessionConnectionLimit
@@ -709,6 +718,9 @@ func printCustomActionOutputImpl(c *Command) (bool, error) {
 				"Type":                item.Type,
 				"Authorization Token": item.AuthorizationToken,
 			}
+			if item.Status != "" {
+				nonAttributeMap["Status"] = item.Status
+			}
 
 			maxLength := 0
 			for k := range nonAttributeMap {
@@ -0,0 +1,424 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package targetscmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/boundary/api/targets"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+func init() {
+	extraPostgresActionsFlagsMapFunc = extraPostgresActionsFlagsMapFuncImpl
+	extraPostgresFlagsFunc = extraPostgresFlagsFuncImpl
+	extraPostgresFlagsHandlingFunc = extraPostgresFlagsHandlingFuncImpl
+}
+
+func extraPostgresActionsFlagsMapFuncImpl() map[string][]string {
+	return map[string][]string{
+		"create": {
+			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
+			"egress-worker-filter", "ingress-worker-filter", "approval-required", "approvers-filter", "approval-timeout-seconds", "ssl-mode", "tls-ca-cert", "tls-server-name",
+			"with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
+		},
+		"update": {
+			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
+			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "approval-required", "approvers-filter", "approval-timeout-seconds",
+			"ssl-mode", "tls-ca-cert", "tls-server-name",
+		},
+	}
+}
+
+type extraPostgresCmdVars struct {
+	flagDefaultPort            string
+	flagDefaultClientPort      string
+	flagSessionMaxSeconds      string
+	flagSessionConnectionLimit string
+	flagWorkerFilter           string
+	flagEgressWorkerFilter     string
+	flagIngressWorkerFilter    string
+	flagApprovalRequired       string
+	flagApproversFilter        string
+	flagApprovalTimeoutSeconds string
+	flagAddress                string
+	flagSslMode                string
+	flagTlsCaCert              string
+	flagTlsServerName          string
+	flagWithAliasValue         string
+	flagWithAliasScopeId       string
+	flagWithAliasHostId        string
+}
+
+func (c *PostgresCommand) extraPostgresHelpFunc(helpMap map[string]func() string) string {
+	var helpStr string
+	switch c.Func {
+	case "create":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets create postgres [options] [args]",
+			"",
+			"  Create a postgres-type target. Example:",
+			"",
+			`    $ boundary targets create postgres -name prodops -description "Postgres target for ProdOps"`,
+			"",
+			"",
+		})
+
+	case "update":
+		helpStr = base.WrapForHelpText([]string{
+			"Usage: boundary targets update postgres [options] [args]",
+			"",
+			"  Update a postgres-type target given its ID. Example:",
+			"",
+			`    $ boundary targets update postgres -id tpg_1234567890 -name "devops" -description "Postgres target for DevOps"`,
+			"",
+			"",
+		})
+	}
+	return helpStr + c.Flags().Help()
+}
+
+func extraPostgresFlagsFuncImpl(c *PostgresCommand, set *base.FlagSets, f *base.FlagSet) {
+	fs := set.NewFlagSet("Postgres Target Options")
+
+	for _, name := range flagsPostgresMap[c.Func] {
+		switch name {
+		case "address":
+			fs.StringVar(&base.StringVar{
+				Name:   "address",
+				Target: &c.flagAddress,
+				Usage:  "Optionally, a valid network address to connect to for this target. Can not be used alongside host sources.",
+			})
+		case "default-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-port",
+				Target: &c.flagDefaultPort,
+				Usage:  "Optionally, the default port to set on the target. If not specified, it will be set to 5432.",
+			})
+		case "default-client-port":
+			fs.StringVar(&base.StringVar{
+				Name:   "default-client-port",
+				Target: &c.flagDefaultClientPort,
+				Usage:  "The default client port to set on the target.",
+			})
+		case "session-max-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-max-seconds",
+				Target: &c.flagSessionMaxSeconds,
+				Usage:  `The maximum lifetime of the session, including all connections. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "session-connection-limit":
+			fs.StringVar(&base.StringVar{
+				Name:   "session-connection-limit",
+				Target: &c.flagSessionConnectionLimit,
+				Usage:  "The maximum number of connections allowed for a session. -1 means unlimited.",
+			})
+		case "worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "worker-filter",
+				Target: &c.flagWorkerFilter,
+				Usage:  "Deprecated: use egress or ingress filters instead.",
+			})
+		case "egress-worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "egress-worker-filter",
+				Target: &c.flagEgressWorkerFilter,
+				Usage:  "A boolean expression to filter which egress workers can handle sessions for this target.",
+			})
+		case "ingress-worker-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "ingress-worker-filter",
+				Target: &c.flagIngressWorkerFilter,
+				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
+			})
+		case "approval-required":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-required",
+				Target: &c.flagApprovalRequired,
+				Usage:  "A boolean indicating if sessions for this target must be approved by another user before they can be activated.",
+			})
+		case "approvers-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "approvers-filter",
+				Target: &c.flagApproversFilter,
+				Usage:  "A boolean expression to filter which users can approve sessions for this target.",
+			})
+		case "approval-timeout-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-timeout-seconds",
+				Target: &c.flagApprovalTimeoutSeconds,
+				Usage:  `The maximum time a session for this target can await approval before it is terminated. Can be specified as an integer number of seconds or a duration string.`,
+			})
+		case "ssl-mode":
+			fs.StringVar(&base.StringVar{
+				Name:   "ssl-mode",
+				Target: &c.flagSslMode,
+				Usage:  `How the worker verifies the certificate of the database. "verify-full" verifies the certificate and the server name, "verify-ca" only verifies the certificate. If not specified, it will be set to "verify-full".`,
+			})
+		case "tls-ca-cert":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-ca-cert",
+				Target: &c.flagTlsCaCert,
+				Usage:  "The PEM encoded CA certificate used to verify the certificate of the database. If not specified, the system CA certificates of the worker are used. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case "tls-server-name":
+			fs.StringVar(&base.StringVar{
+				Name:   "tls-server-name",
+				Target: &c.flagTlsServerName,
+				Usage:  "The name expected in the certificate of the database. If not specified, the host of the target address is used.",
+			})
+		case "with-alias-value":
+			fs.StringVar(&base.StringVar{
+				Name:   "with-alias-value",
+				Target: &c.flagWithAliasValue,
+				Usage:  "The value for an alias to be created for and at the same time as this target.",
+			})
+		case "with-alias-scope-id":
+			fs.StringVar(&base.StringVar{
+				Name:    "with-alias-scope-id",
+				Target:  &c.flagWithAliasScopeId,
+				Default: "global",
+				Usage:   "The scope id for an alias to be created for and at the same time as this target.",
+			})
+		case "with-alias-authorize-session-host-id":
+			fs.StringVar(&base.StringVar{
+				Name:   "with-alias-authorize-session-host-id",
+				Target: &c.flagWithAliasHostId,
+				Usage:  "The authorize session host id flag used by an alias to be created for and at the same time as this target.",
+			})
+		}
+	}
+}
+
+func extraPostgresFlagsHandlingFuncImpl(c *PostgresCommand, _ *base.FlagSets, opts *[]targets.Option) bool {
+	switch c.flagDefaultPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetDefaultPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPostgresTargetDefaultPort(uint32(port)))
+	}
+
+	switch c.flagDefaultClientPort {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetDefaultClientPort())
+	default:
+		port, err := strconv.ParseUint(c.flagDefaultClientPort, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagDefaultClientPort, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPostgresTargetDefaultClientPort(uint32(port)))
+	}
+
+	switch c.flagSessionMaxSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionMaxSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagSessionMaxSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagSessionMaxSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionMaxSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithSessionMaxSeconds(final))
+	}
+
+	switch c.flagSessionConnectionLimit {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSessionConnectionLimit())
+	default:
+		limit, err := strconv.ParseInt(c.flagSessionConnectionLimit, 10, 32)
+		if err != nil {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagSessionConnectionLimit, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSessionConnectionLimit(int32(limit)))
+	}
+
+	switch c.flagWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse worker filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithWorkerFilter(c.flagWorkerFilter))
+	}
+
+	switch c.flagEgressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultEgressWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagEgressWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse egress filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithEgressWorkerFilter(c.flagEgressWorkerFilter))
+	}
+	switch c.flagIngressWorkerFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultIngressWorkerFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagIngressWorkerFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse ingress filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
+	}
+
+	switch c.flagApprovalRequired {
+	case "":
+	case "false":
+		*opts = append(*opts, targets.WithApprovalRequired(false))
+	case "true":
+		*opts = append(*opts, targets.WithApprovalRequired(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for approval-required %v", c.flagApprovalRequired))
+		return false
+	}
+
+	switch c.flagApproversFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApproversFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagApproversFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse approvers filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithApproversFilter(c.flagApproversFilter))
+	}
+
+	switch c.flagApprovalTimeoutSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApprovalTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagApprovalTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagApprovalTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagApprovalTimeoutSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithApprovalTimeoutSeconds(final))
+	}
+
+	switch c.flagAddress {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultAddress())
+	default:
+		*opts = append(*opts, targets.WithAddress(c.flagAddress))
+	}
+
+	switch c.flagSslMode {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetSslMode())
+	default:
+		*opts = append(*opts, targets.WithPostgresTargetSslMode(c.flagSslMode))
+	}
+
+	switch c.flagTlsCaCert {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetTlsCaCert())
+	default:
+		caCert, err := parseutil.ParsePath(c.flagTlsCaCert)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagTlsCaCert, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithPostgresTargetTlsCaCert(caCert))
+	}
+
+	switch c.flagTlsServerName {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultPostgresTargetTlsServerName())
+	default:
+		*opts = append(*opts, targets.WithPostgresTargetTlsServerName(c.flagTlsServerName))
+	}
+
+	var aliasValue string
+	switch c.flagWithAliasValue {
+	case "":
+	case "null":
+		c.UI.Error("The with-alias-value flag cannot be set to null")
+		return false
+	default:
+		aliasValue = c.flagWithAliasValue
+	}
+
+	var aliasScopeId string
+	switch c.flagWithAliasScopeId {
+	case "":
+	case "null":
+		c.UI.Error("The with-alias-scope-id flag cannot be set to null")
+		return false
+	default:
+		aliasScopeId = c.flagWithAliasScopeId
+	}
+
+	var aliasHostId string
+	switch c.flagWithAliasHostId {
+	case "":
+	case "null":
+		c.UI.Error("The with-alias-authorize-session-host-id flag cannot be set to null")
+		return false
+	default:
+		aliasHostId = c.flagWithAliasHostId
+	}
+
+	switch {
+	case aliasValue != "" && aliasScopeId == "":
+		c.UI.Error("The with-alias-value flag must be used with the with-alias-scope-id flag")
+		return false
+	case aliasValue != "" && aliasScopeId != "":
+		a := targets.Alias{
+			Value:   aliasValue,
+			ScopeId: aliasScopeId,
+		}
+		if aliasHostId != "" {
+			a.Attributes = &targets.TargetAliasAttributes{
+				AuthorizeSessionArguments: &targets.AuthorizeSessionArguments{
+					HostId: aliasHostId,
+				},
+			}
+		}
+		*opts = append(*opts, targets.WithAliases([]targets.Alias{a}))
+	}
+
+	return true
+}
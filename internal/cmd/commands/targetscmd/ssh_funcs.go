@@ -4,6 +4,7 @@
 package targetscmd
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/api/targets"
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/go-bexpr"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
 )
 
 func init() {
@@ -24,13 +26,14 @@ func extraSshActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
-			"egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id", "with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
+			"egress-worker-filter", "ingress-worker-filter", "approval-required", "approvers-filter", "approval-timeout-seconds", "enable-session-recording",
+			"storage-bucket-id", "known-hosts", "insecure-skip-host-key-verification",
+			"with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds", "session-connection-limit",
-			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "enable-session-recording",
-			"storage-bucket-id",
+			"worker-filter", "egress-worker-filter", "ingress-worker-filter", "approval-required", "approvers-filter", "approval-timeout-seconds", "enable-session-recording",
+			"storage-bucket-id", "known-hosts", "insecure-skip-host-key-verification",
 		},
 	}
 }
@@ -43,9 +46,14 @@ type extraSshCmdVars struct {
 	flagWorkerFilter           string
 	flagEgressWorkerFilter     string
 	flagIngressWorkerFilter    string
+	flagApprovalRequired       string
+	flagApproversFilter        string
+	flagApprovalTimeoutSeconds string
 	flagAddress                string
 	flagStorageBucketId        string
 	flagEnableSessionRecording string
+	flagKnownHosts             string
+	flagInsecureSkipHostKey    string
 	flagWithAliasValue         string
 	flagWithAliasScopeId       string
 	flagWithAliasHostId        string
@@ -132,6 +140,24 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "approval-required":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-required",
+				Target: &c.flagApprovalRequired,
+				Usage:  "A boolean indicating if sessions for this target must be approved by another user before they can be activated.",
+			})
+		case "approvers-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "approvers-filter",
+				Target: &c.flagApproversFilter,
+				Usage:  "A boolean expression to filter which users can approve sessions for this target.",
+			})
+		case "approval-timeout-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-timeout-seconds",
+				Target: &c.flagApprovalTimeoutSeconds,
+				Usage:  `The maximum time a session for this target can await approval before it is terminated. Can be specified as an integer number of seconds or a duration string.`,
+			})
 		case "storage-bucket-id":
 			fs.StringVar(&base.StringVar{
 				Name:   "storage-bucket-id",
@@ -144,6 +170,18 @@ func extraSshFlagsFuncImpl(c *SshCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagEnableSessionRecording,
 				Usage:  "A boolean indicating if session recording is enabled for this target.",
 			})
+		case "known-hosts":
+			fs.StringVar(&base.StringVar{
+				Name:   "known-hosts",
+				Target: &c.flagKnownHosts,
+				Usage:  "The known_hosts entries used to verify the host key of the endpoint. Entries can be host keys or @cert-authority host CA keys. This can be the value itself, refer to a file on disk (file://) from which the value will be read, or an env var (env://) from which the value will be read.",
+			})
+		case "insecure-skip-host-key-verification":
+			fs.StringVar(&base.StringVar{
+				Name:   "insecure-skip-host-key-verification",
+				Target: &c.flagInsecureSkipHostKey,
+				Usage:  "A boolean indicating if the host key of the endpoint is not verified when known-hosts is not set. Connections to the endpoint are refused if neither is set.",
+			})
 		case "with-alias-value":
 			fs.StringVar(&base.StringVar{
 				Name:   "with-alias-value",
@@ -262,6 +300,49 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagApprovalRequired {
+	case "":
+	case "false":
+		*opts = append(*opts, targets.WithApprovalRequired(false))
+	case "true":
+		*opts = append(*opts, targets.WithApprovalRequired(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for approval-required %v", c.flagApprovalRequired))
+		return false
+	}
+
+	switch c.flagApproversFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApproversFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagApproversFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse approvers filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithApproversFilter(c.flagApproversFilter))
+	}
+
+	switch c.flagApprovalTimeoutSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApprovalTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagApprovalTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagApprovalTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagApprovalTimeoutSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithApprovalTimeoutSeconds(final))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
@@ -289,6 +370,32 @@ func extraSshFlagsHandlingFuncImpl(c *SshCommand, _ *base.FlagSets, opts *[]targ
 		return false
 	}
 
+	switch c.flagKnownHosts {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetKnownHosts())
+	default:
+		knownHosts, err := parseutil.ParsePath(c.flagKnownHosts)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagKnownHosts, err))
+			return false
+		}
+		*opts = append(*opts, targets.WithSshTargetKnownHosts(knownHosts))
+	}
+
+	switch c.flagInsecureSkipHostKey {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultSshTargetInsecureSkipHostKeyVerification())
+	case "false":
+		*opts = append(*opts, targets.WithSshTargetInsecureSkipHostKeyVerification(false))
+	case "true":
+		*opts = append(*opts, targets.WithSshTargetInsecureSkipHostKeyVerification(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for insecure-skip-host-key-verification %v", c.flagInsecureSkipHostKey))
+		return false
+	}
+
 	var aliasValue string
 	switch c.flagWithAliasValue {
 	case "":
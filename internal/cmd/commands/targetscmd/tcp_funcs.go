@@ -23,13 +23,13 @@ func extraTcpActionsFlagsMapFuncImpl() map[string][]string {
 	return map[string][]string{
 		"create": {
 			"address", "default-port", "default-client-port", "session-max-seconds",
-			"session-connection-limit", "egress-worker-filter", "ingress-worker-filter",
+			"session-connection-limit", "egress-worker-filter", "ingress-worker-filter", "approval-required", "approvers-filter", "approval-timeout-seconds",
 			"with-alias-value", "with-alias-scope-id", "with-alias-authorize-session-host-id",
 		},
 		"update": {
 			"address", "default-port", "default-client-port", "session-max-seconds",
 			"session-connection-limit", "worker-filter", "egress-worker-filter",
-			"ingress-worker-filter",
+			"ingress-worker-filter", "approval-required", "approvers-filter", "approval-timeout-seconds",
 		},
 	}
 }
@@ -42,6 +42,9 @@ type extraTcpCmdVars struct {
 	flagWorkerFilter           string
 	flagEgressWorkerFilter     string
 	flagIngressWorkerFilter    string
+	flagApprovalRequired       string
+	flagApproversFilter        string
+	flagApprovalTimeoutSeconds string
 	flagAddress                string
 	flagWithAliasValue         string
 	flagWithAliasScopeId       string
@@ -129,6 +132,24 @@ func extraTcpFlagsFuncImpl(c *TcpCommand, set *base.FlagSets, f *base.FlagSet) {
 				Target: &c.flagIngressWorkerFilter,
 				Usage:  "A boolean expression to filter which ingress workers can handle sessions for this target.",
 			})
+		case "approval-required":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-required",
+				Target: &c.flagApprovalRequired,
+				Usage:  "A boolean indicating if sessions for this target must be approved by another user before they can be activated.",
+			})
+		case "approvers-filter":
+			fs.StringVar(&base.StringVar{
+				Name:   "approvers-filter",
+				Target: &c.flagApproversFilter,
+				Usage:  "A boolean expression to filter which users can approve sessions for this target.",
+			})
+		case "approval-timeout-seconds":
+			fs.StringVar(&base.StringVar{
+				Name:   "approval-timeout-seconds",
+				Target: &c.flagApprovalTimeoutSeconds,
+				Usage:  `The maximum time a session for this target can await approval before it is terminated. Can be specified as an integer number of seconds or a duration string.`,
+			})
 		case "with-alias-value":
 			fs.StringVar(&base.StringVar{
 				Name:   "with-alias-value",
@@ -247,6 +268,49 @@ func extraTcpFlagsHandlingFuncImpl(c *TcpCommand, _ *base.FlagSets, opts *[]targ
 		*opts = append(*opts, targets.WithIngressWorkerFilter(c.flagIngressWorkerFilter))
 	}
 
+	switch c.flagApprovalRequired {
+	case "":
+	case "false":
+		*opts = append(*opts, targets.WithApprovalRequired(false))
+	case "true":
+		*opts = append(*opts, targets.WithApprovalRequired(true))
+	default:
+		c.UI.Error(fmt.Sprintf("Invalid bool value for approval-required %v", c.flagApprovalRequired))
+		return false
+	}
+
+	switch c.flagApproversFilter {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApproversFilter())
+	default:
+		if _, err := bexpr.CreateEvaluator(c.flagApproversFilter); err != nil {
+			c.UI.Error(fmt.Sprintf("Unable to successfully parse approvers filter expression: %s", err))
+			return false
+		}
+		*opts = append(*opts, targets.WithApproversFilter(c.flagApproversFilter))
+	}
+
+	switch c.flagApprovalTimeoutSeconds {
+	case "":
+	case "null":
+		*opts = append(*opts, targets.DefaultApprovalTimeoutSeconds())
+	default:
+		var final uint32
+		dur, err := strconv.ParseUint(c.flagApprovalTimeoutSeconds, 10, 32)
+		if err == nil {
+			final = uint32(dur)
+		} else {
+			dur, err := time.ParseDuration(c.flagApprovalTimeoutSeconds)
+			if err != nil {
+				c.UI.Error(fmt.Sprintf("Error parsing %q: %s", c.flagApprovalTimeoutSeconds, err))
+				return false
+			}
+			final = uint32(dur.Seconds())
+		}
+		*opts = append(*opts, targets.WithApprovalTimeoutSeconds(final))
+	}
+
 	switch c.flagAddress {
 	case "":
 	case "null":
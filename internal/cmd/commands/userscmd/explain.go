@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package userscmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/boundary/api"
+	"github.com/hashicorp/boundary/api/users"
+	"github.com/hashicorp/boundary/internal/cmd/base"
+	"github.com/mitchellh/cli"
+	"github.com/mitchellh/go-wordwrap"
+	"github.com/posener/complete"
+)
+
+var (
+	_ cli.Command             = (*ExplainCommand)(nil)
+	_ cli.CommandAutocomplete = (*ExplainCommand)(nil)
+)
+
+type ExplainCommand struct {
+	*base.Command
+
+	flagResourceId string
+	flagAction     string
+	flagClientIp   string
+}
+
+func (c *ExplainCommand) Synopsis() string {
+	return wordwrap.WrapString("Explain whether a user is allowed to perform an action on a resource", base.TermWidth)
+}
+
+func (c *ExplainCommand) Help() string {
+	return base.WrapForHelpText([]string{
+		"Usage: boundary users explain [args]",
+		"",
+		"  Explain whether a user is allowed to perform an action on a resource. The output lists the principals through which the user has roles and how every grant of those roles was evaluated. Example:",
+		"",
+		`    $ boundary users explain -id u_1234567890 -resource-id ttcp_1234567890 -action authorize-session`,
+		"",
+		"",
+	}) + c.Flags().Help()
+}
+
+func (c *ExplainCommand) Flags() *base.FlagSets {
+	set := c.FlagSet(base.FlagSetHTTP | base.FlagSetClient | base.FlagSetOutputFormat)
+	f := set.NewFlagSet("Command Options")
+	f.StringVar(&base.StringVar{
+		Name:   "id",
+		Target: &c.FlagId,
+		Usage:  "The id of the user to explain the permission for.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "resource-id",
+		Target: &c.flagResourceId,
+		Usage:  "The id of the resource to explain the permission for.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "action",
+		Target: &c.flagAction,
+		Usage:  "The action to explain the permission for.",
+	})
+	f.StringVar(&base.StringVar{
+		Name:   "client-ip",
+		Target: &c.flagClientIp,
+		Usage:  "The client IP to evaluate grant conditions with. Defaults to the IP the request is made from.",
+	})
+	return set
+}
+
+func (c *ExplainCommand) AutocompleteArgs() complete.Predictor {
+	return complete.PredictAnything
+}
+
+func (c *ExplainCommand) AutocompleteFlags() complete.Flags {
+	return c.Flags().Completions()
+}
+
+func (c *ExplainCommand) Run(args []string) int {
+	f := c.Flags()
+
+	if err := f.Parse(args); err != nil {
+		c.PrintCliError(err)
+		return base.CommandUserError
+	}
+
+	switch {
+	case c.FlagId == "":
+		c.PrintCliError(errors.New("ID must be provided via -id"))
+		return base.CommandUserError
+	case c.flagResourceId == "":
+		c.PrintCliError(errors.New("Resource ID must be provided via -resource-id"))
+		return base.CommandUserError
+	case c.flagAction == "":
+		c.PrintCliError(errors.New("Action must be provided via -action"))
+		return base.CommandUserError
+	}
+
+	client, err := c.Client()
+	if c.WrapperCleanupFunc != nil {
+		defer func() {
+			if err := c.WrapperCleanupFunc(); err != nil {
+				c.PrintCliError(fmt.Errorf("Error cleaning kms wrapper: %w", err))
+			}
+		}()
+	}
+	if err != nil {
+		c.PrintCliError(fmt.Errorf("Error creating API client: %w", err))
+		return base.CommandCliError
+	}
+
+	var opts []users.Option
+	if c.flagClientIp != "" {
+		opts = append(opts, users.WithExplainClientIp(c.flagClientIp))
+	}
+
+	uClient := users.NewClient(client)
+	result, err := uClient.ExplainPermission(c.Context, c.FlagId, c.flagResourceId, c.flagAction, opts...)
+	if err != nil {
+		if apiErr := api.AsServerError(err); apiErr != nil {
+			c.PrintApiError(apiErr, "Error from controller when explaining permission")
+			return base.CommandApiError
+		}
+		c.PrintCliError(fmt.Errorf("Error explaining permission: %w", err))
+		return base.CommandCliError
+	}
+
+	switch base.Format(c.UI) {
+	case "table":
+		c.UI.Output(printExplanationTable(result.GetItem()))
+	case "json":
+		if ok := c.PrintJsonItem(result.GetResponse()); !ok {
+			return base.CommandCliError
+		}
+	}
+
+	return base.CommandSuccess
+}
+
+func printExplanationTable(item *users.PermissionExplanation) string {
+	nonAttributeMap := map[string]any{
+		"User ID":       item.UserId,
+		"Resource ID":   item.ResourceId,
+		"Resource Type": item.ResourceType,
+		"Scope ID":      item.ScopeId,
+		"Action":        item.Action,
+		"Allowed":       item.Allowed,
+	}
+	maxLength := base.MaxAttributesLength(nonAttributeMap, nil, nil)
+
+	ret := []string{
+		"",
+		"Permission explanation:",
+		base.WrapMap(2, maxLength+2, nonAttributeMap),
+	}
+
+	if len(item.Principals) > 0 {
+		ret = append(ret,
+			"",
+			"  Principals:",
+		)
+		for _, p := range item.Principals {
+			ret = append(ret,
+				fmt.Sprintf("    Role ID:           %s", p.RoleId),
+				fmt.Sprintf("      Role Scope ID:   %s", p.RoleScopeId),
+				fmt.Sprintf("      Principal ID:    %s", p.PrincipalId),
+				fmt.Sprintf("      Principal Type:  %s", p.Type),
+				"",
+			)
+		}
+	}
+
+	if len(item.Grants) > 0 {
+		ret = append(ret,
+			"",
+			"  Grants:",
+		)
+		for _, g := range item.Grants {
+			ret = append(ret,
+				fmt.Sprintf("    Grant:             %s", g.Grant),
+				fmt.Sprintf("      Role ID:         %s", g.RoleId),
+				fmt.Sprintf("      Grant Scope ID:  %s", g.GrantScopeId),
+				fmt.Sprintf("      In Scope:        %t", g.InScope),
+				fmt.Sprintf("      Conditions Met:  %t", g.ConditionsMet),
+				fmt.Sprintf("      Allowed:         %t", g.Allowed),
+				fmt.Sprintf("      Denied:          %t", g.Denied),
+			)
+			if g.ParseError != "" {
+				ret = append(ret, fmt.Sprintf("      Parse Error:     %s", g.ParseError))
+			}
+			ret = append(ret, "")
+		}
+	}
+
+	return base.WrapForHelpText(ret)
+}
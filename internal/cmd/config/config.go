@@ -14,6 +14,7 @@ import (
 	"io"
 	"net"
 	"os"
+	"path/filepath"
 	"reflect"
 	"strconv"
 	"strings"
@@ -24,6 +25,7 @@ import (
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/ratelimit"
 	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/util"
 	kms_plugin_assets "github.com/hashicorp/boundary/plugins/kms"
 	"github.com/hashicorp/boundary/sdk/wrapper"
@@ -229,6 +231,9 @@ type Config struct {
 	// Eventing configuration for the controller
 	Eventing *event.EventerConfig `hcl:"events"`
 
+	// Tracing configuration for the export of spans to an OTLP collector
+	Tracing *tracing.Config `hcl:"tracing"`
+
 	// Plugin-related options
 	Plugins Plugins `hcl:"plugins"`
 
@@ -304,6 +309,22 @@ type Controller struct {
 	ApiRateLimiterMaxQuotas int               `hcl:"api_rate_limit_max_quotas"`
 	ApiRateLimitDisable     bool              `hcl:"api_rate_limit_disable"`
 
+	// CredentialRotationCommand is the path to an executable which changes the
+	// password of a static username password credential on its host when the
+	// credential is rotated. Scheduled rotation of credentials with a rotation
+	// period is only enabled if it is set.
+	CredentialRotationCommand string `hcl:"credential_rotation_command"`
+
+	// VaultJwtSigningKey is a PEM encoded private key used to sign the JWTs
+	// Vault credential stores present to the Vault JWT auth method when
+	// logging in to Vault. It may be a file:// or env:// path. Vault
+	// credential stores can only use the JWT auth method if it is set.
+	VaultJwtSigningKey string `hcl:"vault_jwt_signing_key"`
+
+	// VaultJwtAudience is the aud claim of the JWTs signed with the
+	// VaultJwtSigningKey. It defaults to "vault".
+	VaultJwtAudience string `hcl:"vault_jwt_audience"`
+
 	// License is the license used by HCP builds
 	License string `hcl:"license"`
 
@@ -436,6 +457,11 @@ type Scheduler struct {
 
 type Plugins struct {
 	ExecutionDir string `hcl:"execution_dir"`
+
+	// FilesystemRootDir is the absolute path of the directory within which
+	// the filesystem storage plugin creates the directories of storage
+	// buckets. The filesystem storage plugin cannot be used if it is not set.
+	FilesystemRootDir string `hcl:"filesystem_root_dir"`
 }
 
 type Reporting struct {
@@ -681,6 +707,10 @@ func Parse(d string) (*Config, error) {
 		if !strutil.Printable(result.Controller.Description) {
 			return nil, errors.New("Controller description contains non-printable characters")
 		}
+		result.Controller.VaultJwtSigningKey, err = parseutil.ParsePath(result.Controller.VaultJwtSigningKey)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return nil, fmt.Errorf("Error parsing controller vault jwt signing key: %w", err)
+		}
 		if result.Controller.AuthTokenTimeToLive != "" {
 			t, err := parseutil.ParseDurationSecond(result.Controller.AuthTokenTimeToLive)
 			if err != nil {
@@ -1125,6 +1155,12 @@ func Parse(d string) (*Config, error) {
 		return nil, fmt.Errorf(`too many "events" nodes (max 1, got %d)`, len(eventList.Items))
 	}
 
+	if result.Tracing != nil {
+		if err := result.Tracing.Validate(); err != nil {
+			return nil, fmt.Errorf(`error parsing "tracing": %w`, err)
+		}
+	}
+
 	if result.Plugins.ExecutionDir != "" {
 		result.Plugins.ExecutionDir, err = parseutil.ParsePath(result.Plugins.ExecutionDir)
 		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
@@ -1132,6 +1168,16 @@ func Parse(d string) (*Config, error) {
 		}
 	}
 
+	if result.Plugins.FilesystemRootDir != "" {
+		result.Plugins.FilesystemRootDir, err = parseutil.ParsePath(result.Plugins.FilesystemRootDir)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return nil, fmt.Errorf("Error parsing plugins filesystem root dir: %w", err)
+		}
+		if !filepath.IsAbs(result.Plugins.FilesystemRootDir) {
+			return nil, errors.New("Plugins filesystem root dir must be an absolute path")
+		}
+	}
+
 	for _, f := range extraParsingFuncs {
 		if err := f(result); err != nil {
 			return nil, err
@@ -1243,6 +1289,10 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 				s.Type = event.StderrSink
 			case s.FileConfig != nil:
 				s.Type = event.FileSink
+			case s.HttpConfig != nil:
+				s.Type = event.HttpSink
+			case s.SyslogConfig != nil:
+				s.Type = event.SyslogSink
 			default:
 				return nil, fmt.Errorf("sink type could not be determined")
 			}
@@ -1264,6 +1314,28 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 			}
 		}
 
+		if s.HttpConfig != nil {
+			if err := parseHttpSinkConfig(s.HttpConfig); err != nil {
+				return nil, err
+			}
+		}
+
+		if s.SyslogConfig != nil && s.SyslogConfig.TimeoutHCL != "" {
+			var err error
+			s.SyslogConfig.Timeout, err = parseutil.ParseDurationSecond(s.SyslogConfig.TimeoutHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse syslog sink timeout %s", s.SyslogConfig.TimeoutHCL)
+			}
+		}
+
+		if s.Sampling != nil && s.Sampling.IntervalHCL != "" {
+			var err error
+			s.Sampling.Interval, err = parseutil.ParseDurationSecond(s.Sampling.IntervalHCL)
+			if err != nil {
+				return nil, fmt.Errorf("can't parse sampling interval %s", s.Sampling.IntervalHCL)
+			}
+		}
+
 		// parse map into event types
 		if s.AuditConfig != nil && s.AuditConfig.FilterOverridesHCL != nil {
 			s.AuditConfig.FilterOverrides = make(map[event.DataClassification]event.FilterOperation, len(s.AuditConfig.FilterOverridesHCL))
@@ -1285,6 +1357,40 @@ func parseEventing(eventObj *ast.ObjectItem) (*event.EventerConfig, error) {
 	return &result, nil
 }
 
+// parseHttpSinkConfig parses the duration strings of an http sink config into
+// time.Durations and resolves header values, which may be given as env:// or
+// file:// paths to keep credentials out of the config file.
+func parseHttpSinkConfig(c *event.HttpSinkTypeConfig) error {
+	durations := []struct {
+		name string
+		hcl  string
+		dur  *time.Duration
+	}{
+		{"flush interval", c.FlushIntervalHCL, &c.FlushInterval},
+		{"timeout", c.TimeoutHCL, &c.Timeout},
+		{"retry backoff", c.RetryBackoffHCL, &c.RetryBackoff},
+		{"max retry backoff", c.MaxRetryBackoffHCL, &c.MaxRetryBackoff},
+	}
+	for _, d := range durations {
+		if d.hcl == "" {
+			continue
+		}
+		var err error
+		*d.dur, err = parseutil.ParseDurationSecond(d.hcl)
+		if err != nil {
+			return fmt.Errorf("can't parse http sink %s %s", d.name, d.hcl)
+		}
+	}
+	for k, v := range c.Headers {
+		resolved, err := parseutil.ParsePath(v)
+		if err != nil && !errors.Is(err, parseutil.ErrNotAUrl) {
+			return fmt.Errorf("error parsing http sink header %s: %w", k, err)
+		}
+		c.Headers[k] = resolved
+	}
+	return nil
+}
+
 // Sanitized returns a copy of the config with all values that are considered
 // sensitive stripped. It also strips all `*Raw` values that are mainly
 // used for parsing.
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/util"
 	configutil "github.com/hashicorp/go-secure-stdlib/configutil/v2"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
@@ -1505,6 +1506,152 @@ func TestController_EventingConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "http-sink",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink "http" {
+						name = "http-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						http {
+							url = "https://events.example.com/boundary"
+							headers = {
+								Authorization = "Bearer token"
+							}
+							batch_size = 50
+							flush_interval = "10s"
+							max_retries = 5
+							retry_backoff = "500ms"
+							spool_path = "/var/spool/boundary"
+							delivery_guarantee = "enforced"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "http",
+						Name:       "http-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit"},
+						HttpConfig: &event.HttpSinkTypeConfig{
+							Url:               "https://events.example.com/boundary",
+							Headers:           map[string]string{"Authorization": "Bearer token"},
+							BatchSize:         50,
+							FlushIntervalHCL:  "10s",
+							FlushInterval:     10 * time.Second,
+							MaxRetries:        5,
+							RetryBackoffHCL:   "500ms",
+							RetryBackoff:      500 * time.Millisecond,
+							SpoolPath:         "/var/spool/boundary",
+							DeliveryGuarantee: event.Enforced,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "syslog-sink",
+			config: []string{
+				`events {
+					audit_enabled = true
+					sink {
+						name = "syslog-sink"
+						format = "cloudevents-json"
+						event_types = ["audit", "error"]
+						syslog {
+							network = "tls"
+							address = "siem.example.com:6514"
+							facility = "local4"
+							timeout = "5s"
+							tls_ca_cert = "/etc/boundary/siem-ca.pem"
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				AuditEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:       "syslog",
+						Name:       "syslog-sink",
+						Format:     "cloudevents-json",
+						EventTypes: []event.Type{"audit", "error"},
+						SyslogConfig: &event.SyslogSinkTypeConfig{
+							Network:    event.SyslogTls,
+							Address:    "siem.example.com:6514",
+							Facility:   "local4",
+							TimeoutHCL: "5s",
+							Timeout:    5 * time.Second,
+							TlsCaCert:  "/etc/boundary/siem-ca.pem",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "sampling-and-rate-limit",
+			config: []string{
+				`events {
+					observations_enabled = true
+					sink "stderr" {
+						name = "observations"
+						format = "cloudevents-json"
+						event_types = ["observation"]
+						sampling {
+							first_per_interval = 10
+							interval = "30s"
+						}
+						rate_limit {
+							events_per_second = 12.5
+							burst = 50
+						}
+					}
+				}`,
+			},
+			wantEventerConfig: &event.EventerConfig{
+				ObservationsEnabled: true,
+				Sinks: []*event.SinkConfig{
+					{
+						Type:         "stderr",
+						Name:         "observations",
+						Format:       "cloudevents-json",
+						EventTypes:   []event.Type{"observation"},
+						StderrConfig: &event.StderrSinkTypeConfig{},
+						Sampling: &event.SamplingConfig{
+							FirstPerInterval: 10,
+							IntervalHCL:      "30s",
+							Interval:         30 * time.Second,
+						},
+						RateLimit: &event.RateLimitConfig{
+							EventsPerSecond: 12.5,
+							Burst:           50,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "http-sink-invalid-duration",
+			config: []string{
+				`events {
+					sink "http" {
+						name = "http-sink"
+						format = "cloudevents-json"
+						event_types = ["audit"]
+						http {
+							url = "https://events.example.com/boundary"
+							timeout = "soon"
+						}
+					}
+				}`,
+			},
+			wantErr: `error parsing "events": can't parse http sink timeout soon`,
+		},
 		{
 			name: "audit_config",
 			config: []string{
@@ -2090,6 +2237,126 @@ func TestPluginExecutionDir(t *testing.T) {
 	}
 }
 
+func TestPluginFilesystemRootDir(t *testing.T) {
+	tests := []struct {
+		name                 string
+		in                   string
+		envRootDir           string
+		expFilesystemRootDir string
+		expErrStr            string
+	}{
+		{
+			name: "Valid filesystem root dir",
+			in: `
+			plugins {
+				filesystem_root_dir = "/var/lib/boundary/storage"
+			}`,
+			expFilesystemRootDir: "/var/lib/boundary/storage",
+		}, {
+			name: "Valid filesystem root dir from env var",
+			in: `
+			plugins {
+				filesystem_root_dir = "env://FILESYSTEM_ROOT_DIR"
+			}`,
+			envRootDir:           "/var/lib/boundary/storage",
+			expFilesystemRootDir: "/var/lib/boundary/storage",
+		}, {
+			name: "Relative filesystem root dir",
+			in: `
+			plugins {
+				filesystem_root_dir = "storage"
+			}`,
+			expErrStr: "Plugins filesystem root dir must be an absolute path",
+		}, {
+			name: "Relative filesystem root dir from env var",
+			in: `
+			plugins {
+				filesystem_root_dir = "env://FILESYSTEM_ROOT_DIR"
+			}`,
+			envRootDir: "../storage",
+			expErrStr:  "Plugins filesystem root dir must be an absolute path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("FILESYSTEM_ROOT_DIR", tt.envRootDir)
+			p, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, p)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, p)
+			require.Equal(t, tt.expFilesystemRootDir, p.Plugins.FilesystemRootDir)
+		})
+	}
+}
+
+func TestTracing(t *testing.T) {
+	ratio := 0.25
+	tests := []struct {
+		name       string
+		in         string
+		expTracing *tracing.Config
+		expErrStr  string
+	}{
+		{
+			name: "no tracing block",
+			in:   ``,
+		},
+		{
+			name: "tracing block",
+			in: `
+			tracing {
+				endpoint = "collector.example.com:4317"
+				insecure = true
+				service_name = "boundary-prod"
+				sample_ratio = 0.25
+				headers = {
+					"x-api-key" = "key"
+				}
+			}`,
+			expTracing: &tracing.Config{
+				Endpoint:    "collector.example.com:4317",
+				Insecure:    true,
+				ServiceName: "boundary-prod",
+				SampleRatio: &ratio,
+				Headers:     map[string]string{"x-api-key": "key"},
+			},
+		},
+		{
+			name: "empty tracing block",
+			in: `
+			tracing {}`,
+			expTracing: &tracing.Config{},
+		},
+		{
+			name: "invalid sample ratio",
+			in: `
+			tracing {
+				sample_ratio = 2
+			}`,
+			expErrStr: `error parsing "tracing": tracing.(Config).Validate: sample ratio must be between 0 and 1`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := Parse(tt.in)
+			if tt.expErrStr != "" {
+				require.EqualError(t, err, tt.expErrStr)
+				require.Nil(t, p)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, p)
+			require.Equal(t, tt.expTracing, p.Tracing)
+		})
+	}
+}
+
 func TestDatabaseMaxConnections(t *testing.T) {
 	tests := []struct {
 		name                  string
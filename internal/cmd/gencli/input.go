@@ -299,6 +299,22 @@ var inputStructs = map[string][]*cmdInfo{
 			NeedsSubtypeInCreate: true,
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialStore.String(),
+			Pkg:                  "credentialstores",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "ssh-ca",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			Container:            "Scope",
+			VersionedActions:     []string{"update"},
+			NeedsSubtypeInCreate: true,
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 	},
 	"credentiallibraries": {
 		{
@@ -357,6 +373,22 @@ var inputStructs = map[string][]*cmdInfo{
 			VersionedActions:     []string{"update"},
 			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
 		},
+		{
+			ResourceType:         resource.CredentialLibrary.String(),
+			Pkg:                  "credentiallibraries",
+			StdActions:           []string{"create", "update"},
+			SubActionPrefix:      "ssh-ca-certificate",
+			HasExtraCommandVars:  true,
+			SkipNormalHelp:       true,
+			HasExtraHelpFunc:     true,
+			HasId:                true,
+			HasName:              true,
+			HasDescription:       true,
+			NeedsSubtypeInCreate: true,
+			Container:            "CredentialStore",
+			VersionedActions:     []string{"update"},
+			PrefixAttributeFieldErrorsWithSubactionPrefix: true,
+		},
 	},
 	"credentials": {
 		{
@@ -642,7 +674,7 @@ var inputStructs = map[string][]*cmdInfo{
 			HasExtraCommandVars: true,
 			HasExtraHelpFunc:    true,
 			HasId:               true,
-			VersionedActions:    []string{"cancel"},
+			VersionedActions:    []string{"cancel", "approve"},
 		},
 	},
 	"sessionrecordings": {
@@ -726,6 +758,42 @@ var inputStructs = map[string][]*cmdInfo{
 			AliasFieldFlag:             "FlagId",
 			FlagNameOverwrittenByAlias: "id",
 		},
+		{
+			ResourceType:               resource.Target.String(),
+			Pkg:                        "targets",
+			StdActions:                 []string{"create", "update"},
+			SubActionPrefix:            "postgres",
+			HasExtraCommandVars:        true,
+			SkipNormalHelp:             true,
+			HasExtraHelpFunc:           true,
+			HasId:                      true,
+			HasName:                    true,
+			Container:                  "Scope",
+			HasDescription:             true,
+			VersionedActions:           []string{"update"},
+			NeedsSubtypeInCreate:       true,
+			UsesAlias:                  true,
+			AliasFieldFlag:             "FlagId",
+			FlagNameOverwrittenByAlias: "id",
+		},
+		{
+			ResourceType:               resource.Target.String(),
+			Pkg:                        "targets",
+			StdActions:                 []string{"create", "update"},
+			SubActionPrefix:            "http",
+			HasExtraCommandVars:        true,
+			SkipNormalHelp:             true,
+			HasExtraHelpFunc:           true,
+			HasId:                      true,
+			HasName:                    true,
+			Container:                  "Scope",
+			HasDescription:             true,
+			VersionedActions:           []string{"update"},
+			NeedsSubtypeInCreate:       true,
+			UsesAlias:                  true,
+			AliasFieldFlag:             "FlagId",
+			FlagNameOverwrittenByAlias: "id",
+		},
 	},
 	"users": {
 		{
@@ -7,7 +7,8 @@ const (
 	estimateCountStoresQuery = `
 select sum(reltuples::bigint) as estimate from pg_class where oid in (
 	'credential_vault_store'::regclass,
-	'credential_static_store'::regclass
+	'credential_static_store'::regclass,
+	'credential_ssh_ca_store'::regclass
 )
 `
 
@@ -19,6 +20,10 @@ select public_id
 select public_id
   from credential_static_store_deleted
  where delete_time >= @since
+ union
+select public_id
+  from credential_ssh_ca_store_deleted
+ where delete_time >= @since
 `
 
 	listStoresTemplate = `
@@ -50,11 +55,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_id_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -74,10 +93,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_id_hmac               as auth_secret_id_hmac,
+            null                              as key_type,   -- Add to make union uniform
+            null                              as key_bits,   -- Add to make union uniform
+            null                              as public_key, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_auth_methods auth on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -97,8 +124,43 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            'ssh-ca' as subtype
+       from ssh_ca_stores
 )
   select *
     from final
@@ -135,11 +197,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_id_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -159,10 +235,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_id_hmac               as auth_secret_id_hmac,
+            null                              as key_type,   -- Add to make union uniform
+            null                              as key_bits,   -- Add to make union uniform
+            null                              as public_key, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_auth_methods auth on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -182,8 +266,43 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            'ssh-ca' as subtype
+       from ssh_ca_stores
 )
   select *
     from final
@@ -220,11 +339,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_id_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -244,10 +377,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_id_hmac               as auth_secret_id_hmac,
+            null                              as key_type,   -- Add to make union uniform
+            null                              as key_bits,   -- Add to make union uniform
+            null                              as public_key, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_auth_methods auth on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -267,8 +408,43 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            'ssh-ca' as subtype
+       from ssh_ca_stores
 )
   select *
     from final
@@ -306,11 +482,25 @@ vault_client_certs as (
     from credential_vault_client_certificate
    where store_id in (select public_id from stores)
 ),
+vault_auth_methods as (
+  select store_id,
+         method,
+         mount_path,
+         role,
+         secret_id_hmac
+    from credential_vault_store_auth_method
+   where store_id in (select public_id from stores)
+),
 static_stores as (
   select *
     from credential_static_store
    where public_id in (select public_id from stores)
 ),
+ssh_ca_stores as (
+  select *
+    from credential_ssh_ca_store
+   where public_id in (select public_id from stores)
+),
 final as (
      select store.public_id,
             store.project_id,
@@ -330,10 +520,18 @@ final as (
             coalesce(token.status, 'expired') as token_status,
             cert.certificate                  as client_cert,
             cert.certificate_key_hmac         as client_cert_key_hmac,
+            auth.method                       as auth_method,
+            auth.mount_path                   as auth_mount_path,
+            auth.role                         as auth_role,
+            auth.secret_id_hmac               as auth_secret_id_hmac,
+            null                              as key_type,   -- Add to make union uniform
+            null                              as key_bits,   -- Add to make union uniform
+            null                              as public_key, -- Add to make union uniform
             'vault' as subtype
        from vault_stores store
   left join vault_tokens token      on store.public_id = token.store_id
   left join vault_client_certs cert on store.public_id = cert.store_id
+  left join vault_auth_methods auth on store.public_id = auth.store_id
       union
      select public_id,
             project_id,
@@ -353,8 +551,43 @@ final as (
             null as token_status,         -- Add to make union uniform
             null as client_cert,          -- Add to make union uniform
             null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            null as key_type,             -- Add to make union uniform
+            null as key_bits,             -- Add to make union uniform
+            null as public_key,           -- Add to make union uniform
             'static' as subtype
        from static_stores
+      union
+     select public_id,
+            project_id,
+            name,
+            description,
+            create_time,
+            update_time,
+            version,
+            null as delete_time,          -- Add to make union uniform
+            null as vault_address,        -- Add to make union uniform
+            null as namespace,            -- Add to make union uniform
+            null as ca_cert,              -- Add to make union uniform
+            null as tls_server_name,      -- Add to make union uniform
+            null as tls_skip_verify,      -- Add to make union uniform
+            null as worker_filter,        -- Add to make union uniform
+            null as token_hmac,           -- Add to make union uniform
+            null as token_status,         -- Add to make union uniform
+            null as client_cert,          -- Add to make union uniform
+            null as client_cert_key_hmac, -- Add to make union uniform
+            null as auth_method,          -- Add to make union uniform
+            null as auth_mount_path,      -- Add to make union uniform
+            null as auth_role,            -- Add to make union uniform
+            null as auth_secret_id_hmac,  -- Add to make union uniform
+            key_type,
+            key_bits,
+            public_key,
+            'ssh-ca' as subtype
+       from ssh_ca_stores
 )
   select *
     from final
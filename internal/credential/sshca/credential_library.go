@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+// CertificateCredentialLibrary is a credential library that issues ssh
+// certificates signed by the certificate authority of its credential
+// store.
+type CertificateCredentialLibrary struct {
+	*store.CertificateCredentialLibrary
+	tableName string `gorm:"-"`
+}
+
+// NewCertificateCredentialLibrary creates a new in memory
+// CertificateCredentialLibrary assigned to storeId. The SSH username field
+// must be set. Name, description, key type, key bits, ttl, key id, critical
+// options, extensions, and additional valid principals are the only valid
+// options. All other options are ignored.
+func NewCertificateCredentialLibrary(storeId string, username string, opt ...Option) (*CertificateCredentialLibrary, error) {
+	opts := getOpts(opt...)
+
+	l := &CertificateCredentialLibrary{
+		CertificateCredentialLibrary: &store.CertificateCredentialLibrary{
+			StoreId:                   storeId,
+			Name:                      opts.withName,
+			Description:               opts.withDescription,
+			Username:                  username,
+			KeyType:                   opts.withKeyType,
+			KeyBits:                   opts.withKeyBits,
+			Ttl:                       opts.withTtl,
+			KeyId:                     opts.withKeyId,
+			CriticalOptions:           opts.withCriticalOptions,
+			Extensions:                opts.withExtensions,
+			CredentialType:            string(globals.SshCertificateCredentialType),
+			AdditionalValidPrincipals: strings.Join(opts.withAdditionalValidPrincipals, ","),
+		},
+	}
+
+	return l, nil
+}
+
+func allocCertificateCredentialLibrary() *CertificateCredentialLibrary {
+	return &CertificateCredentialLibrary{
+		CertificateCredentialLibrary: &store.CertificateCredentialLibrary{},
+	}
+}
+
+func (l *CertificateCredentialLibrary) clone() *CertificateCredentialLibrary {
+	cp := proto.Clone(l.CertificateCredentialLibrary)
+	return &CertificateCredentialLibrary{
+		CertificateCredentialLibrary: cp.(*store.CertificateCredentialLibrary),
+	}
+}
+
+// TableName returns the table name.
+func (l *CertificateCredentialLibrary) TableName() string {
+	if l.tableName != "" {
+		return l.tableName
+	}
+	return "credential_ssh_ca_cert_library"
+}
+
+// SetTableName sets the table name.
+func (l *CertificateCredentialLibrary) SetTableName(n string) {
+	l.tableName = n
+}
+
+// GetResourceType returns the resource type of the CredentialLibrary
+func (l *CertificateCredentialLibrary) GetResourceType() resource.Type {
+	return resource.CredentialLibrary
+}
+
+func (l *CertificateCredentialLibrary) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{l.PublicId},
+		"resource-type":      []string{"credential-ssh-ca-cert-library"},
+		"op-type":            []string{op.String()},
+	}
+	if l.StoreId != "" {
+		metadata["store-id"] = []string{l.StoreId}
+	}
+	return metadata
+}
+
+// CredentialType returns the type of credential the library issues.
+func (l *CertificateCredentialLibrary) CredentialType() globals.CredentialType {
+	return globals.CredentialType(l.CertificateCredentialLibrary.CredentialType)
+}
+
+var _ credential.Library = (*CertificateCredentialLibrary)(nil)
+
+type deletedCertificateCredentialLibrary struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedCertificateCredentialLibrary) TableName() string {
+	return "credential_ssh_ca_cert_library_deleted"
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/credential/sshca/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+)
+
+// A CredentialStore holds the private key of an ssh certificate authority
+// which is used to sign certificates for the libraries in the store. It is
+// owned by a project.
+type CredentialStore struct {
+	*store.CredentialStore
+	tableName string `gorm:"-"`
+}
+
+// NewCredentialStore creates a new in memory ssh CA CredentialStore
+// assigned to projectId. Name, description, key type, key bits, and private
+// key are the only valid options. All other options are ignored.
+//
+// If WithPrivateKey is not provided, a new certificate authority key is
+// generated when the credential store is created in the repository using
+// the key type and key bits options.
+func NewCredentialStore(projectId string, opt ...Option) (*CredentialStore, error) {
+	opts := getOpts(opt...)
+	cs := &CredentialStore{
+		CredentialStore: &store.CredentialStore{
+			ProjectId:   projectId,
+			Name:        opts.withName,
+			Description: opts.withDescription,
+			KeyType:     opts.withKeyType,
+			KeyBits:     opts.withKeyBits,
+			PrivateKey:  opts.withPrivateKey,
+		},
+	}
+	return cs, nil
+}
+
+func allocCredentialStore() *CredentialStore {
+	return &CredentialStore{
+		CredentialStore: &store.CredentialStore{},
+	}
+}
+
+func (cs *CredentialStore) clone() *CredentialStore {
+	cp := proto.Clone(cs.CredentialStore)
+	return &CredentialStore{
+		CredentialStore: cp.(*store.CredentialStore),
+	}
+}
+
+// TableName returns the table name.
+func (cs *CredentialStore) TableName() string {
+	if cs.tableName != "" {
+		return cs.tableName
+	}
+	return "credential_ssh_ca_store"
+}
+
+// SetTableName sets the table name.
+func (cs *CredentialStore) SetTableName(n string) {
+	cs.tableName = n
+}
+
+// GetResourceType returns the resource type of the CredentialStore
+func (cs *CredentialStore) GetResourceType() resource.Type {
+	return resource.CredentialStore
+}
+
+func (cs *CredentialStore) oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{cs.PublicId},
+		"resource-type":      []string{"credential-ssh-ca-store"},
+		"op-type":            []string{op.String()},
+	}
+	if cs.ProjectId != "" {
+		metadata["project-id"] = []string{cs.ProjectId}
+	}
+	return metadata
+}
+
+func (cs *CredentialStore) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "sshca.(CredentialStore).encrypt"
+	if len(cs.PrivateKey) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "no private key defined")
+	}
+
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	cs.KeyId = keyId
+
+	blobInfo, err := cipher.Encrypt(ctx, cs.PrivateKey)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	protoBytes, err := proto.Marshal(blobInfo)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	cs.PrivateKeyEncrypted = protoBytes
+	if err := cs.hmacPrivateKey(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (cs *CredentialStore) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "sshca.(CredentialStore).decrypt"
+	if len(cs.PrivateKeyEncrypted) > 0 {
+		dec := new(wrapping.BlobInfo)
+		if err := proto.Unmarshal(cs.PrivateKeyEncrypted, dec); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+		pt, err := cipher.Decrypt(ctx, dec)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+		}
+		cs.PrivateKey = pt
+	}
+	return nil
+}
+
+func (cs *CredentialStore) hmacPrivateKey(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "sshca.(CredentialStore).hmacPrivateKey"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, cs.PrivateKey, cipher, []byte(cs.PublicId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	cs.PrivateKeyHmac = []byte(hm)
+	return nil
+}
+
+type deletedCredentialStore struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (s *deletedCredentialStore) TableName() string {
+	return "credential_ssh_ca_store_deleted"
+}
@@ -0,0 +1,9 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package sshca implements a credential store which is an SSH certificate
+// authority. The private key of the certificate authority is encrypted by
+// the database key of the project owning the store. Credential libraries in
+// the store issue short-lived SSH user certificates signed by the
+// certificate authority for each session.
+package sshca
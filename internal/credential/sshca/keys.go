@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	KeyTypeEcdsa   = "ecdsa"
+	KeyTypeEd25519 = "ed25519"
+	KeyTypeRsa     = "rsa"
+
+	KeyBitsDefault = 0
+
+	KeyBitsEcdsa256 = 256
+	KeyBitsEcdsa384 = 384
+	KeyBitsEcdsa521 = 521
+
+	KeyBitsRsa2048 = 2048
+	KeyBitsRsa3072 = 3072
+	KeyBitsRsa4096 = 4096
+)
+
+// defaultKeyBits returns the number of bits used for keyType when no
+// number of bits is specified.
+func defaultKeyBits(keyType string) uint32 {
+	switch keyType {
+	case KeyTypeEcdsa:
+		return KeyBitsEcdsa256
+	case KeyTypeRsa:
+		return KeyBitsRsa2048
+	default:
+		return KeyBitsDefault
+	}
+}
+
+// generateKey generates a new private key of keyType with keyBits.
+func generateKey(ctx context.Context, keyType string, keyBits uint32) (crypto.Signer, error) {
+	const op = "sshca.generateKey"
+	switch keyType {
+	case KeyTypeEd25519:
+		if keyBits != KeyBitsDefault {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "key bits cannot be set when key type is ed25519")
+		}
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return key, nil
+
+	case KeyTypeEcdsa:
+		var curve elliptic.Curve
+		switch keyBits {
+		case KeyBitsEcdsa256:
+			curve = elliptic.P256()
+		case KeyBitsEcdsa384:
+			curve = elliptic.P384()
+		case KeyBitsEcdsa521:
+			curve = elliptic.P521()
+		default:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid key bits, when key type is ecdsa key bits must be one of: 256, 384, or 521")
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return key, nil
+
+	case KeyTypeRsa:
+		switch keyBits {
+		case KeyBitsRsa2048, KeyBitsRsa3072, KeyBitsRsa4096:
+		default:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid key bits, when key type is rsa key bits must be one of: 2048, 3072, or 4096")
+		}
+		key, err := rsa.GenerateKey(rand.Reader, int(keyBits))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return key, nil
+
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, `invalid key type, must be one of: "rsa", "ed25519", or "ecdsa"`)
+	}
+}
+
+// parsePrivateKey parses a PEM encoded private key and returns it along
+// with its key type and number of bits.
+func parsePrivateKey(ctx context.Context, pemBytes []byte) (crypto.Signer, string, uint32, error) {
+	const op = "sshca.parsePrivateKey"
+	raw, err := ssh.ParseRawPrivateKey(pemBytes)
+	if err != nil {
+		return nil, "", 0, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	switch key := raw.(type) {
+	case ed25519.PrivateKey:
+		return key, KeyTypeEd25519, KeyBitsDefault, nil
+	case *ed25519.PrivateKey:
+		return *key, KeyTypeEd25519, KeyBitsDefault, nil
+	case *ecdsa.PrivateKey:
+		bits := uint32(key.Curve.Params().BitSize)
+		switch bits {
+		case KeyBitsEcdsa256, KeyBitsEcdsa384, KeyBitsEcdsa521:
+		default:
+			return nil, "", 0, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported ecdsa key size %d", bits))
+		}
+		return key, KeyTypeEcdsa, bits, nil
+	case *rsa.PrivateKey:
+		bits := uint32(key.N.BitLen())
+		switch bits {
+		case KeyBitsRsa2048, KeyBitsRsa3072, KeyBitsRsa4096:
+		default:
+			return nil, "", 0, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported rsa key size %d", bits))
+		}
+		return key, KeyTypeRsa, bits, nil
+	default:
+		return nil, "", 0, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported private key type %T", raw))
+	}
+}
+
+// marshalPrivateKey returns key PEM encoded in the OpenSSH private key
+// format.
+func marshalPrivateKey(ctx context.Context, key crypto.Signer) ([]byte, error) {
+	const op = "sshca.marshalPrivateKey"
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	return pem.EncodeToMemory(block), nil
+}
+
+// authorizedKey returns the public key of key in the OpenSSH authorized keys
+// format.
+func authorizedKey(ctx context.Context, key crypto.Signer) (string, error) {
+	const op = "sshca.authorizedKey"
+	pub, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pub))), nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func Test_generateKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name     string
+		keyType  string
+		keyBits  uint32
+		wantPub  string
+		wantBits uint32
+		wantErr  bool
+	}{
+		{name: "ed25519", keyType: KeyTypeEd25519, wantPub: ssh.KeyAlgoED25519},
+		{name: "ed25519-bits", keyType: KeyTypeEd25519, keyBits: KeyBitsEcdsa256, wantErr: true},
+		{name: "ecdsa-256", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa256, wantPub: ssh.KeyAlgoECDSA256, wantBits: KeyBitsEcdsa256},
+		{name: "ecdsa-384", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa384, wantPub: ssh.KeyAlgoECDSA384, wantBits: KeyBitsEcdsa384},
+		{name: "ecdsa-521", keyType: KeyTypeEcdsa, keyBits: KeyBitsEcdsa521, wantPub: ssh.KeyAlgoECDSA521, wantBits: KeyBitsEcdsa521},
+		{name: "ecdsa-bad-bits", keyType: KeyTypeEcdsa, keyBits: KeyBitsRsa2048, wantErr: true},
+		{name: "rsa-2048", keyType: KeyTypeRsa, keyBits: KeyBitsRsa2048, wantPub: ssh.KeyAlgoRSA, wantBits: KeyBitsRsa2048},
+		{name: "rsa-bad-bits", keyType: KeyTypeRsa, keyBits: 1024, wantErr: true},
+		{name: "unknown-type", keyType: "dsa", wantErr: true},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			key, err := generateKey(ctx, tt.keyType, tt.keyBits)
+			if tt.wantErr {
+				assert.Error(err)
+				assert.Nil(key)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(key)
+
+			// round trip the key through the PEM encoding stored in the
+			// database
+			pemKey, err := marshalPrivateKey(ctx, key)
+			require.NoError(err)
+			parsed, keyType, keyBits, err := parsePrivateKey(ctx, pemKey)
+			require.NoError(err)
+			assert.Equal(tt.keyType, keyType)
+			assert.Equal(tt.wantBits, keyBits)
+			assert.Equal(key.Public(), parsed.Public())
+
+			pub, err := authorizedKey(ctx, key)
+			require.NoError(err)
+			assert.True(strings.HasPrefix(pub, tt.wantPub+" "))
+			assert.False(strings.HasSuffix(pub, "\n"))
+		})
+	}
+}
+
+func Test_parsePrivateKey_invalid(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, _, _, err := parsePrivateKey(ctx, []byte("not a private key"))
+	assert.Error(t, err)
+}
+
+func Test_defaultKeyBits(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, uint32(KeyBitsDefault), defaultKeyBits(KeyTypeEd25519))
+	assert.Equal(t, uint32(KeyBitsEcdsa256), defaultKeyBits(KeyTypeEcdsa))
+	assert.Equal(t, uint32(KeyBitsRsa2048), defaultKeyBits(KeyTypeRsa))
+}
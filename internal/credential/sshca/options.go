@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withName                      string
+	withDescription               string
+	withLimit                     int
+	withKeyType                   string
+	withKeyBits                   uint32
+	withPrivateKey                []byte
+	withTtl                       string
+	withKeyId                     string
+	withCriticalOptions           string
+	withExtensions                string
+	withAdditionalValidPrincipals []string
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithDescription provides an optional description.
+func WithDescription(desc string) Option {
+	return func(o *options) {
+		o.withDescription = desc
+	}
+}
+
+// WithName provides an optional name.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.withName = name
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithKeyType provides an optional ssh key type. Must be rsa, ed25519, or
+// ecdsa.
+func WithKeyType(t string) Option {
+	return func(o *options) {
+		o.withKeyType = t
+	}
+}
+
+// WithKeyBits provides an optional number of bits used to generate an ssh
+// key.
+func WithKeyBits(b uint32) Option {
+	return func(o *options) {
+		o.withKeyBits = b
+	}
+}
+
+// WithPrivateKey provides an optional PEM encoded private key to use as the
+// certificate authority of a credential store instead of generating one.
+func WithPrivateKey(k []byte) Option {
+	return func(o *options) {
+		o.withPrivateKey = k
+	}
+}
+
+// WithTtl provides an optional time to live for an issued ssh certificate.
+// The certificate expires with its session if the session expires first.
+func WithTtl(t string) Option {
+	return func(o *options) {
+		o.withTtl = t
+	}
+}
+
+// WithKeyId provides an optional key id for an issued certificate.
+func WithKeyId(i string) Option {
+	return func(o *options) {
+		o.withKeyId = i
+	}
+}
+
+// WithCriticalOptions provides an optional map of the critical options
+// that an issued certificate should be signed for.
+func WithCriticalOptions(s string) Option {
+	return func(o *options) {
+		o.withCriticalOptions = s
+	}
+}
+
+// WithExtensions provides a optional map of the extensions
+// that an issued certificate should be signed for.
+func WithExtensions(s string) Option {
+	return func(o *options) {
+		o.withExtensions = s
+	}
+}
+
+// WithAdditionalValidPrincipals adds principals to be signed for in
+// addition to username.
+func WithAdditionalValidPrincipals(p []string) Option {
+	return func(o *options) {
+		o.withAdditionalValidPrincipals = p
+	}
+}
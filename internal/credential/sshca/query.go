@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+const (
+	credSshCaStoreRewrapQuery = `
+select public_id,
+       private_key_encrypted,
+       key_id
+  from credential_ssh_ca_store
+ where project_id = ?
+   and key_id = ?;
+`
+
+	estimateCountCertificateCredentialLibraries = `
+select reltuples::bigint as estimate
+  from pg_class
+ where oid in ('credential_ssh_ca_cert_library'::regclass)
+`
+
+	listLibrariesTemplate = `
+  select *
+    from credential_ssh_ca_cert_library
+   where store_id = @store_id
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesPageTemplate = `
+  select *
+    from credential_ssh_ca_cert_library
+   where store_id = @store_id
+     and (create_time, public_id) < (@last_item_create_time, @last_item_id)
+order by create_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshTemplate = `
+  select *
+    from credential_ssh_ca_cert_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+order by update_time desc, public_id desc
+   limit %d;
+`
+
+	listLibrariesRefreshPageTemplate = `
+  select *
+    from credential_ssh_ca_cert_library
+   where store_id = @store_id
+     and update_time > @updated_after_time
+     and (update_time, public_id) < (@last_item_update_time, @last_item_id)
+order by update_time desc, public_id desc
+   limit %d;
+`
+
+	selectLibrariesQuery = `
+select *
+  from credential_ssh_ca_cert_library_issue
+ where public_id in (?);
+`
+
+	selectSessionExpirationQuery = `
+select expiration_time
+  from session
+ where public_id = ?;
+`
+)
@@ -0,0 +1,502 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+)
+
+// validateCertificateOptions validates the options of a library which are
+// interpreted by boundary when a certificate is issued.
+func validateCertificateOptions(ctx context.Context, l *CertificateCredentialLibrary) error {
+	const op = "sshca.validateCertificateOptions"
+	if l.Ttl != "" {
+		ttl, err := parseutil.ParseDurationSecond(l.Ttl)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid ttl"))
+		}
+		if ttl <= 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "ttl must be greater than zero")
+		}
+	}
+	var m map[string]string
+	if l.CriticalOptions != "" {
+		if err := json.Unmarshal([]byte(l.CriticalOptions), &m); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid critical options"))
+		}
+	}
+	if l.Extensions != "" {
+		if err := json.Unmarshal([]byte(l.Extensions), &m); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg("invalid extensions"))
+		}
+	}
+	return nil
+}
+
+// CreateCertificateCredentialLibrary inserts l into the repository and
+// returns a new CertificateCredentialLibrary containing the credential
+// library's PublicId. l is not changed. l must contain a valid StoreId and
+// Username. l must not contain a PublicId. The PublicId is generated and
+// assigned by this method.
+//
+// l.KeyType defaults to ed25519 and l.KeyBits defaults to the default for
+// l.KeyType. If set, l.Ttl must be a positive duration and both
+// l.CriticalOptions and l.Extensions must be JSON objects with string
+// values.
+//
+// Both l.Name and l.Description are optional. If l.Name is set, it must be
+// unique within l.StoreId.
+//
+// Both l.CreateTime and l.UpdateTime are ignored.
+func (r *Repository) CreateCertificateCredentialLibrary(ctx context.Context, projectId string, l *CertificateCredentialLibrary, _ ...Option) (*CertificateCredentialLibrary, error) {
+	const op = "sshca.(Repository).CreateCertificateCredentialLibrary"
+	if l == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil CertificateCredentialLibrary")
+	}
+	if l.CertificateCredentialLibrary == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded CertificateCredentialLibrary")
+	}
+	if l.StoreId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	if l.PublicId != "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	if l.Username == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no username")
+	}
+
+	l = l.clone()
+
+	if l.KeyType == "" {
+		l.KeyType = KeyTypeEd25519
+	}
+	if l.KeyBits == KeyBitsDefault {
+		l.KeyBits = defaultKeyBits(l.KeyType)
+	}
+
+	if l.GetCredentialType() == "" {
+		l.CertificateCredentialLibrary.CredentialType = string(globals.SshCertificateCredentialType)
+	}
+	if l.GetCredentialType() != string(globals.SshCertificateCredentialType) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "invalid credential type")
+	}
+	if err := validateCertificateOptions(ctx, l); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	id, err := newCertificateCredentialLibraryId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	l.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var newLibrary *CertificateCredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newLibrary = l.clone()
+			if err := w.Create(ctx, newLibrary,
+				db.WithOplog(oplogWrapper, newLibrary.oplog(oplog.OpType_OP_TYPE_CREATE))); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s: name %s already exists", l.StoreId, l.Name)))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in credential store: %s", l.StoreId)))
+	}
+	return newLibrary, nil
+}
+
+// UpdateCertificateCredentialLibrary updates the repository entry for
+// l.PublicId with the values in l for the fields listed in fieldMaskPaths.
+// It returns a new CertificateCredentialLibrary containing the updated
+// values and a count of the number of records updated. l is not changed.
+//
+// l must contain a valid PublicId. Name, Description, Username, KeyType,
+// KeyBits, Ttl, KeyId, CriticalOptions, Extensions, and
+// AdditionalValidPrincipals can be updated. If l.Name is set to a
+// non-empty string, it must be unique within l.StoreId.
+//
+// An attribute of l will be set to NULL in the database if the attribute
+// in l is the zero value and it is included in fieldMaskPaths.
+func (r *Repository) UpdateCertificateCredentialLibrary(ctx context.Context, projectId string, l *CertificateCredentialLibrary, version uint32, fieldMaskPaths []string, _ ...Option) (*CertificateCredentialLibrary, int, error) {
+	const op = "sshca.(Repository).UpdateCertificateCredentialLibrary"
+	if l == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing CertificateCredentialLibrary")
+	}
+	if l.CertificateCredentialLibrary == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing embedded CertificateCredentialLibrary")
+	}
+	if l.PublicId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	}
+	if version == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if projectId == "" {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	l = l.clone()
+
+	var keyTypeChange, keyBitChangeDefault bool
+	for _, f := range fieldMaskPaths {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(usernameField, f):
+		case strings.EqualFold(keyTypeField, f):
+			keyTypeChange = true
+		case strings.EqualFold(keyBitsField, f):
+			keyBitChangeDefault = l.KeyBits == KeyBitsDefault
+		case strings.EqualFold(ttlField, f):
+		case strings.EqualFold(keyIdField, f):
+		case strings.EqualFold(CriticalOptionsField, f):
+		case strings.EqualFold(ExtensionsField, f):
+		case strings.EqualFold(AdditionalValidPrincipalsField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
+		}
+	}
+	if err := validateCertificateOptions(ctx, l); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	if keyTypeChange && l.KeyType == "" {
+		l.KeyType = KeyTypeEd25519
+	}
+	if keyTypeChange && keyBitChangeDefault {
+		l.KeyBits = defaultKeyBits(l.KeyType)
+	}
+
+	if keyBitChangeDefault && !keyTypeChange {
+		origLib, err := r.LookupCertificateCredentialLibrary(ctx, l.PublicId)
+		switch {
+		case err != nil:
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		case origLib == nil:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential library %s", l.PublicId))
+		}
+		l.KeyBits = defaultKeyBits(origLib.KeyType)
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:                      l.Name,
+			descriptionField:               l.Description,
+			usernameField:                  l.Username,
+			keyTypeField:                   l.KeyType,
+			keyBitsField:                   l.KeyBits,
+			ttlField:                       l.Ttl,
+			keyIdField:                     l.KeyId,
+			CriticalOptionsField:           l.CriticalOptions,
+			ExtensionsField:                l.Extensions,
+			AdditionalValidPrincipalsField: l.AdditionalValidPrincipals,
+		},
+		fieldMaskPaths,
+		[]string{keyBitsField},
+	)
+	if len(dbMask) == 0 && len(nullFields) == 0 {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt),
+			errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsUpdated int
+	var returnedLibrary *CertificateCredentialLibrary
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(rr db.Reader, w db.Writer) error {
+			ul := l.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, ul,
+				dbMask, nullFields,
+				db.WithOplog(oplogWrapper, ul.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch rowsUpdated {
+			case 1:
+			case 0:
+				return nil
+			default:
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+
+			returnedLibrary = allocCertificateCredentialLibrary()
+			returnedLibrary.PublicId = l.PublicId
+			if err := rr.LookupById(ctx, returnedLibrary); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to retrieve updated credential library"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.NotUnique, op,
+				fmt.Sprintf("name %s already exists: %s", l.Name, l.PublicId))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(l.PublicId))
+	}
+
+	return returnedLibrary, rowsUpdated, nil
+}
+
+// LookupCertificateCredentialLibrary returns the
+// CertificateCredentialLibrary for publicId. Returns nil, nil if no
+// CertificateCredentialLibrary is found for publicId.
+func (r *Repository) LookupCertificateCredentialLibrary(ctx context.Context, publicId string, _ ...Option) (*CertificateCredentialLibrary, error) {
+	const op = "sshca.(Repository).LookupCertificateCredentialLibrary"
+	if publicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	l := allocCertificateCredentialLibrary()
+	l.PublicId = publicId
+	if err := r.reader.LookupByPublicId(ctx, l); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", publicId)))
+	}
+	return l, nil
+}
+
+// DeleteCertificateCredentialLibrary deletes publicId from the repository
+// and returns the number of records deleted.
+func (r *Repository) DeleteCertificateCredentialLibrary(ctx context.Context, projectId string, publicId string, _ ...Option) (int, error) {
+	const op = "sshca.(Repository).DeleteCertificateCredentialLibrary"
+	if publicId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	if projectId == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+
+	l := allocCertificateCredentialLibrary()
+	l.PublicId = publicId
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) (err error) {
+			dl := l.clone()
+			rowsDeleted, err = w.Delete(ctx, dl, db.WithOplog(oplogWrapper, l.oplog(oplog.OpType_OP_TYPE_DELETE)))
+			if err == nil && rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 CredentialLibrary would have been deleted")
+			}
+			return err
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", l.PublicId)))
+	}
+
+	return rowsDeleted, nil
+}
+
+// ListLibraries returns a slice of certificate credential libraries for
+// the storeId. Supports the following options:
+//   - credential.WithLimit
+//   - credential.WithStartPageAfterItem
+func (r *Repository) ListLibraries(ctx context.Context, storeId string, opt ...credential.Option) ([]credential.Library, time.Time, error) {
+	const op = "sshca.(Repository).ListLibraries"
+	if storeId == "" {
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing store id")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	limit := r.defaultLimit
+	if opts.WithLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.WithLimit
+	}
+	query := fmt.Sprintf(listLibrariesTemplate, limit)
+	args := []any{sql.Named("store_id", storeId)}
+	if opts.WithStartPageAfterItem != nil {
+		query = fmt.Sprintf(listLibrariesPageTemplate, limit)
+		args = append(args,
+			sql.Named("last_item_create_time", opts.WithStartPageAfterItem.GetCreateTime()),
+			sql.Named("last_item_id", opts.WithStartPageAfterItem.GetPublicId()),
+		)
+	}
+
+	libs, transactionTimestamp, err := r.queryLibraries(ctx, query, args)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+
+	// Sort final slice to ensure correct ordering.
+	// We sort by create time descending (most recently created first).
+	slices.SortFunc(libs, func(i, j credential.Library) int {
+		return j.GetCreateTime().AsTime().Compare(i.GetCreateTime().AsTime())
+	})
+
+	return libs, transactionTimestamp, nil
+}
+
+// ListLibrariesRefresh returns a slice of certificate credential libraries
+// for the storeId which have been updated after updatedAfter. Supports the
+// following options:
+//   - credential.WithLimit
+//   - credential.WithStartPageAfterItem
+func (r *Repository) ListLibrariesRefresh(ctx context.Context, storeId string, updatedAfter time.Time, opt ...credential.Option) ([]credential.Library, time.Time, error) {
+	const op = "sshca.(Repository).ListLibrariesRefresh"
+	switch {
+	case storeId == "":
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential store ID")
+	case updatedAfter.IsZero():
+		return nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing updated after time")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+	limit := r.defaultLimit
+	if opts.WithLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.WithLimit
+	}
+
+	query := fmt.Sprintf(listLibrariesRefreshTemplate, limit)
+	args := []any{
+		sql.Named("store_id", storeId),
+		sql.Named("updated_after_time", timestamp.New(updatedAfter)),
+	}
+	if opts.WithStartPageAfterItem != nil {
+		query = fmt.Sprintf(listLibrariesRefreshPageTemplate, limit)
+		args = append(args,
+			sql.Named("last_item_update_time", opts.WithStartPageAfterItem.GetUpdateTime()),
+			sql.Named("last_item_id", opts.WithStartPageAfterItem.GetPublicId()),
+		)
+	}
+
+	libs, transactionTimestamp, err := r.queryLibraries(ctx, query, args)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(ctx, err, op)
+	}
+
+	// Sort final slice to ensure correct ordering.
+	// We sort by update time descending (most recently updated first).
+	slices.SortFunc(libs, func(i, j credential.Library) int {
+		return j.GetUpdateTime().AsTime().Compare(i.GetUpdateTime().AsTime())
+	})
+
+	return libs, transactionTimestamp, nil
+}
+
+func (r *Repository) queryLibraries(ctx context.Context, query string, args []any) ([]credential.Library, time.Time, error) {
+	const op = "sshca.(Repository).queryLibraries"
+
+	var libs []credential.Library
+	var transactionTimestamp time.Time
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(rd db.Reader, w db.Writer) error {
+		rows, err := rd.Query(ctx, query, args)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			l := allocCertificateCredentialLibrary()
+			if err := rd.ScanRows(ctx, rows, l); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			libs = append(libs, l)
+		}
+		if err := rows.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		transactionTimestamp, err = rd.Now(ctx)
+		return err
+	}); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return libs, transactionTimestamp, nil
+}
+
+// EstimatedLibraryCount returns an estimate of the number of ssh CA
+// certificate credential libraries.
+func (r *Repository) EstimatedLibraryCount(ctx context.Context) (int, error) {
+	const op = "sshca.(Repository).EstimatedLibraryCount"
+	rows, err := r.reader.Query(ctx, estimateCountCertificateCredentialLibraries, nil)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total ssh ca credential libraries"))
+	}
+	var count int
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &count); err != nil {
+			return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total ssh ca credential libraries"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total ssh ca credential libraries"))
+	}
+	return count, nil
+}
+
+// ListDeletedLibraryIds lists the public IDs of any credential libraries
+// deleted since the timestamp provided.
+func (r *Repository) ListDeletedLibraryIds(ctx context.Context, since time.Time) ([]string, time.Time, error) {
+	const op = "sshca.(Repository).ListDeletedLibraryIds"
+	var credentialLibraryIds []string
+	var transactionTimestamp time.Time
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(r db.Reader, w db.Writer) error {
+		var deletedLibraries []*deletedCertificateCredentialLibrary
+		if err := r.SearchWhere(ctx, &deletedLibraries, "delete_time >= ?", []any{since}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query deleted ssh ca certificate credential libraries"))
+		}
+		for _, cl := range deletedLibraries {
+			credentialLibraryIds = append(credentialLibraryIds, cl.PublicId)
+		}
+		var err error
+		transactionTimestamp, err = r.Now(ctx)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query transaction timestamp"))
+		}
+		return nil
+	}); err != nil {
+		return nil, time.Time{}, err
+	}
+	return credentialLibraryIds, transactionTimestamp, nil
+}
+
+var _ credential.LibraryService = (*Repository)(nil)
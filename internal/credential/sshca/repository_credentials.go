@@ -0,0 +1,374 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/util/template"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// clockSkew is subtracted from the time a certificate is issued to
+	// determine when the certificate becomes valid to account for clock
+	// skew between boundary and the ssh servers.
+	clockSkew = 30 * time.Second
+
+	// defaultTtl is the validity period of a certificate issued for a
+	// session without an expiration time by a library without a ttl.
+	defaultTtl = time.Hour
+
+	// permitPtyExtension is the extension added to certificates issued by a
+	// library without any extensions.
+	permitPtyExtension = "permit-pty"
+)
+
+var _ credential.Issuer = (*Repository)(nil)
+
+// Issue issues and returns ssh certificates from the certificate credential
+// libraries in requests for sessionId. Each certificate is signed by the
+// certificate authority of the library's credential store for a newly
+// generated key. The certificates are not stored in the repository and
+// cannot be revoked; they expire after the ttl of their library or, if the
+// library has no ttl, when the session expires.
+//
+// Supported options: credential.WithTemplateData
+func (r *Repository) Issue(ctx context.Context, sessionId string, requests []credential.Request, opt ...credential.Option) ([]credential.Dynamic, error) {
+	const op = "sshca.(Repository).Issue"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no session id")
+	}
+	if len(requests) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no requests")
+	}
+	opts, err := credential.GetOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	libs, err := r.getIssueLibraries(ctx, requests)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	sessionExpiration, err := r.getSessionExpiration(ctx, sessionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var creds []credential.Dynamic
+	for _, lib := range libs {
+		databaseWrapper, err := r.kms.GetWrapper(ctx, lib.ProjectId, kms.KeyPurposeDatabase)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+		}
+		cred, err := lib.issue(ctx, databaseWrapper, sessionId, sessionExpiration, opts.WithTemplateData)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("issuing certificate from library %s", lib.PublicId)))
+		}
+		creds = append(creds, cred)
+	}
+	return creds, nil
+}
+
+func (r *Repository) getIssueLibraries(ctx context.Context, requests []credential.Request) ([]*issuingLibrary, error) {
+	const op = "sshca.(Repository).getIssueLibraries"
+
+	purposes := make(map[string][]credential.Purpose, len(requests))
+	var libIds []string
+	for _, req := range requests {
+		ps, ok := purposes[req.SourceId]
+		if !ok {
+			libIds = append(libIds, req.SourceId)
+		}
+		for _, p := range ps {
+			if p == req.Purpose {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "duplicate library and purpose")
+			}
+		}
+		purposes[req.SourceId] = append(ps, req.Purpose)
+	}
+
+	rows, err := r.reader.Query(ctx, selectLibrariesQuery, []any{libIds})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("query failed"))
+	}
+	defer rows.Close()
+
+	var libs []*issuingLibrary
+	for rows.Next() {
+		var lib issuingLibrary
+		if err := r.reader.ScanRows(ctx, rows, &lib); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		for _, p := range purposes[lib.PublicId] {
+			cp := lib
+			cp.Purpose = p
+			libs = append(libs, &cp)
+		}
+		delete(purposes, lib.PublicId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("next row failed"))
+	}
+	if len(purposes) > 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "unknown library")
+	}
+	return libs, nil
+}
+
+func (r *Repository) getSessionExpiration(ctx context.Context, sessionId string) (time.Time, error) {
+	const op = "sshca.(Repository).getSessionExpiration"
+	rows, err := r.reader.Query(ctx, selectSessionExpirationQuery, []any{sessionId})
+	if err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, op, errors.WithMsg("query failed"))
+	}
+	defer rows.Close()
+	var expiration sql.NullTime
+	for rows.Next() {
+		if err := rows.Scan(&expiration); err != nil {
+			return time.Time{}, errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, op, errors.WithMsg("next row failed"))
+	}
+	return expiration.Time, nil
+}
+
+// issuingLibrary is a certificate credential library joined with the
+// encrypted private key of the certificate authority of its store.
+type issuingLibrary struct {
+	PublicId                  string
+	StoreId                   string
+	Name                      string
+	Description               string
+	CreateTime                *timestamp.Timestamp
+	UpdateTime                *timestamp.Timestamp
+	Version                   uint32
+	ProjectId                 string
+	Username                  string
+	KeyType                   string
+	KeyBits                   uint32
+	Ttl                       string
+	KeyId                     string
+	CriticalOptions           []byte
+	Extensions                []byte
+	AdditionalValidPrincipals string
+	CredentialType            string
+	CaPrivateKeyEncrypted     []byte
+	CaKeyId                   string
+
+	Purpose credential.Purpose `gorm:"-"`
+}
+
+// TableName returns the view name.
+func (*issuingLibrary) TableName() string { return "credential_ssh_ca_cert_library_issue" }
+
+func (lib *issuingLibrary) library() *CertificateCredentialLibrary {
+	l := allocCertificateCredentialLibrary()
+	l.PublicId = lib.PublicId
+	l.StoreId = lib.StoreId
+	l.Name = lib.Name
+	l.Description = lib.Description
+	l.CreateTime = lib.CreateTime
+	l.UpdateTime = lib.UpdateTime
+	l.Version = lib.Version
+	l.Username = lib.Username
+	l.KeyType = lib.KeyType
+	l.KeyBits = lib.KeyBits
+	l.Ttl = lib.Ttl
+	l.KeyId = lib.KeyId
+	l.CriticalOptions = string(lib.CriticalOptions)
+	l.Extensions = string(lib.Extensions)
+	l.AdditionalValidPrincipals = lib.AdditionalValidPrincipals
+	l.CertificateCredentialLibrary.CredentialType = lib.CredentialType
+	return l
+}
+
+func (lib *issuingLibrary) caSigner(ctx context.Context, cipher wrapping.Wrapper) (ssh.Signer, error) {
+	const op = "sshca.(issuingLibrary).caSigner"
+	blob := new(wrapping.BlobInfo)
+	if err := proto.Unmarshal(lib.CaPrivateKeyEncrypted, blob); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+	}
+	pt, err := cipher.Decrypt(ctx, blob)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	signer, err := ssh.ParsePrivateKey(pt)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+	}
+	return signer, nil
+}
+
+func generateTemplate(ctx context.Context, raw string, data any) (string, error) {
+	tplate, err := template.New(ctx, raw)
+	if err != nil {
+		return "", err
+	}
+	return tplate.Generate(ctx, data)
+}
+
+// issue generates a new key and returns it along with a certificate for
+// the key signed by the certificate authority of the library.
+func (lib *issuingLibrary) issue(ctx context.Context, cipher wrapping.Wrapper, sessionId string, sessionExpiration time.Time, data template.Data) (*sshCertCred, error) {
+	const op = "sshca.(issuingLibrary).issue"
+
+	username, err := generateTemplate(ctx, lib.Username, data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	keyId := lib.KeyId
+	if keyId != "" {
+		if keyId, err = generateTemplate(ctx, keyId, data); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	principals := []string{username}
+	for _, p := range strings.Split(lib.AdditionalValidPrincipals, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			principals = append(principals, p)
+		}
+	}
+
+	criticalOptions := map[string]string{}
+	if len(lib.CriticalOptions) > 0 {
+		if err := json.Unmarshal(lib.CriticalOptions, &criticalOptions); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+	}
+	extensions := map[string]string{}
+	if len(lib.Extensions) > 0 {
+		if err := json.Unmarshal(lib.Extensions, &extensions); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
+		}
+	}
+	if len(extensions) == 0 {
+		extensions[permitPtyExtension] = ""
+	}
+
+	now := time.Now()
+	validBefore := now.Add(defaultTtl)
+	switch {
+	case lib.Ttl != "":
+		ttl, err := parseutil.ParseDurationSecond(lib.Ttl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+		validBefore = now.Add(ttl)
+		// The certificate must not outlive the session it was issued for.
+		if !sessionExpiration.IsZero() && sessionExpiration.Before(validBefore) {
+			validBefore = sessionExpiration
+		}
+	case !sessionExpiration.IsZero():
+		validBefore = sessionExpiration
+	}
+
+	var serial [8]byte
+	if _, err := rand.Read(serial[:]); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	key, err := generateKey(ctx, lib.KeyType, lib.KeyBits)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	pub, err := ssh.NewPublicKey(key.Public())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	privateKey, err := marshalPrivateKey(ctx, key)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	caSigner, err := lib.caSigner(ctx, cipher)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	cert := &ssh.Certificate{
+		Key:             pub,
+		Serial:          binary.BigEndian.Uint64(serial[:]),
+		CertType:        ssh.UserCert,
+		KeyId:           keyId,
+		ValidPrincipals: principals,
+		ValidAfter:      uint64(now.Add(-clockSkew).Unix()),
+		ValidBefore:     uint64(validBefore.Unix()),
+		Permissions: ssh.Permissions{
+			CriticalOptions: criticalOptions,
+			Extensions:      extensions,
+		},
+	}
+	if err := cert.SignCert(rand.Reader, caSigner); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+
+	id, err := newCredentialId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &sshCertCred{
+		publicId:    id,
+		sessionId:   sessionId,
+		lib:         lib.library(),
+		purpose:     lib.Purpose,
+		username:    username,
+		privateKey:  privateKey,
+		certificate: ssh.MarshalAuthorizedKey(cert),
+		serial:      cert.Serial,
+	}, nil
+}
+
+var _ credential.SshCertificate = (*sshCertCred)(nil)
+
+// sshCertCred is an ssh certificate issued for a session along with the
+// private key it was issued for.
+type sshCertCred struct {
+	publicId    string
+	sessionId   string
+	lib         *CertificateCredentialLibrary
+	purpose     credential.Purpose
+	username    string
+	privateKey  credential.PrivateKey
+	certificate []byte
+	serial      uint64
+}
+
+func (c *sshCertCred) GetPublicId() string               { return c.publicId }
+func (c *sshCertCred) GetSessionId() string              { return c.sessionId }
+func (c *sshCertCred) Library() credential.Library       { return c.lib }
+func (c *sshCertCred) Purpose() credential.Purpose       { return c.purpose }
+func (c *sshCertCred) Username() string                  { return c.username }
+func (c *sshCertCred) PrivateKey() credential.PrivateKey { return c.privateKey }
+func (c *sshCertCred) PrivateKeyPassphrase() []byte      { return nil }
+func (c *sshCertCred) Certificate() []byte               { return c.certificate }
+
+// Secret returns the certificate and private key in the same shape as the
+// response of the vault ssh secrets engine.
+func (c *sshCertCred) Secret() credential.SecretData {
+	return map[string]any{
+		"username":      c.username,
+		"private_key":   string(c.privateKey),
+		"signed_key":    string(c.certificate),
+		"serial_number": fmt.Sprintf("%016x", c.serial),
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package sshca_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/host/static"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/tcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestRepository_Issue(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	repo, err := sshca.NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	in, err := sshca.NewCredentialStore(prj.GetPublicId(), sshca.WithKeyType(sshca.KeyTypeEcdsa), sshca.WithKeyBits(sshca.KeyBitsEcdsa384))
+	require.NoError(t, err)
+	cs, err := repo.CreateCredentialStore(ctx, in)
+	require.NoError(t, err)
+	require.NotNil(t, cs)
+	assert.Empty(t, cs.GetPrivateKey())
+	caKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cs.GetPublicKey()))
+	require.NoError(t, err)
+
+	libIn, err := sshca.NewCertificateCredentialLibrary(cs.GetPublicId(), "alice",
+		sshca.WithTtl("5m"),
+		sshca.WithKeyId("boundary-test"),
+		sshca.WithExtensions(`{"permit-port-forwarding":""}`),
+		sshca.WithAdditionalValidPrincipals([]string{"bob"}))
+	require.NoError(t, err)
+	lib, err := repo.CreateCertificateCredentialLibrary(ctx, prj.GetPublicId(), libIn)
+	require.NoError(t, err)
+	require.NotNil(t, lib)
+
+	// The certificates issued by longLib would outlive the session.
+	longLibIn, err := sshca.NewCertificateCredentialLibrary(cs.GetPublicId(), "alice", sshca.WithTtl("48h"))
+	require.NoError(t, err)
+	longLib, err := repo.CreateCertificateCredentialLibrary(ctx, prj.GetPublicId(), longLibIn)
+	require.NoError(t, err)
+
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+
+	tar := tcp.TestTarget(ctx, t, conn, prj.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+	target.TestCredentialLibrary(t, conn, tar.GetPublicId(), lib.GetPublicId())
+	target.TestCredentialLibrary(t, conn, tar.GetPublicId(), longLib.GetPublicId())
+
+	at := authtoken.TestAuthToken(t, conn, kms, org.GetPublicId())
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:      at.GetIamUserId(),
+		HostId:      h.GetPublicId(),
+		TargetId:    tar.GetPublicId(),
+		HostSetId:   hs.GetPublicId(),
+		AuthTokenId: at.GetPublicId(),
+		ProjectId:   prj.GetPublicId(),
+		Endpoint:    "tcp://127.0.0.1:22",
+	})
+
+	t.Run("unknown-library", func(t *testing.T) {
+		_, err := repo.Issue(ctx, sess.GetPublicId(), []credential.Request{
+			{SourceId: "clsca_doesnotexist", Purpose: credential.BrokeredPurpose},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.Issue(ctx, sess.GetPublicId(), []credential.Request{
+			{SourceId: lib.GetPublicId(), Purpose: credential.BrokeredPurpose},
+		})
+		require.NoError(err)
+		require.Len(got, 1)
+
+		cred, ok := got[0].(credential.SshCertificate)
+		require.True(ok)
+		assert.Equal("alice", cred.Username())
+		assert.Equal(credential.BrokeredPurpose, got[0].Purpose())
+		assert.Equal(lib.GetPublicId(), got[0].Library().GetPublicId())
+
+		pub, _, _, _, err := ssh.ParseAuthorizedKey(cred.Certificate())
+		require.NoError(err)
+		cert, ok := pub.(*ssh.Certificate)
+		require.True(ok)
+		assert.Equal(uint32(ssh.UserCert), cert.CertType)
+		assert.Equal("boundary-test", cert.KeyId)
+		assert.ElementsMatch([]string{"alice", "bob"}, cert.ValidPrincipals)
+		assert.Contains(cert.Extensions, "permit-port-forwarding")
+		assert.Equal(caKey.Marshal(), cert.SignatureKey.Marshal())
+		lifetime := time.Unix(int64(cert.ValidBefore), 0).Sub(time.Unix(int64(cert.ValidAfter), 0))
+		assert.LessOrEqual(lifetime, 6*time.Minute)
+
+		checker := &ssh.CertChecker{
+			IsUserAuthority: func(auth ssh.PublicKey) bool {
+				return string(auth.Marshal()) == string(caKey.Marshal())
+			},
+		}
+		_, err = checker.Authenticate(connMetadata("alice"), cert)
+		assert.NoError(err)
+
+		signer, err := ssh.ParsePrivateKey(cred.PrivateKey())
+		require.NoError(err)
+		assert.Equal(signer.PublicKey().Marshal(), cert.Key.Marshal())
+	})
+
+	t.Run("ttl-longer-than-session", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.Issue(ctx, sess.GetPublicId(), []credential.Request{
+			{SourceId: longLib.GetPublicId(), Purpose: credential.BrokeredPurpose},
+		})
+		require.NoError(err)
+		require.Len(got, 1)
+
+		cred, ok := got[0].(credential.SshCertificate)
+		require.True(ok)
+		pub, _, _, _, err := ssh.ParseAuthorizedKey(cred.Certificate())
+		require.NoError(err)
+		cert, ok := pub.(*ssh.Certificate)
+		require.True(ok)
+		assert.Equal(uint64(sess.ExpirationTime.AsTime().Unix()), cert.ValidBefore)
+	})
+}
+
+// connMetadata is a minimal ssh.ConnMetadata used to check certificates.
+type connMetadata string
+
+func (c connMetadata) User() string        { return string(c) }
+func (connMetadata) SessionID() []byte     { return nil }
+func (connMetadata) ClientVersion() []byte { return nil }
+func (connMetadata) ServerVersion() []byte { return nil }
+func (connMetadata) RemoteAddr() net.Addr  { return nil }
+func (connMetadata) LocalAddr() net.Addr   { return nil }
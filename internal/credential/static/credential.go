@@ -29,6 +29,9 @@ type listCredentialResult struct {
 	UpdateTime  *timestamp.Timestamp
 	Version     int
 	Type        string
+
+	CredentialVersion     int
+	RotationPeriodSeconds int
 }
 
 func (c *listCredentialResult) toCredential(ctx context.Context) (credential.Static, error) {
@@ -45,6 +48,8 @@ func (c *listCredentialResult) toCredential(ctx context.Context) (credential.Sta
 				UpdateTime:  c.UpdateTime,
 				Version:     uint32(c.Version),
 				KeyId:       c.KeyId,
+
+				CredentialVersion: uint32(c.CredentialVersion),
 			},
 		}
 		// Assign byte slices only if the string isn't empty
@@ -64,6 +69,9 @@ func (c *listCredentialResult) toCredential(ctx context.Context) (credential.Sta
 				Version:     uint32(c.Version),
 				Username:    c.Username,
 				KeyId:       c.KeyId,
+
+				CredentialVersion:     uint32(c.CredentialVersion),
+				RotationPeriodSeconds: uint32(c.RotationPeriodSeconds),
 			},
 		}
 		// Assign byte slices only if the string isn't empty
@@ -83,6 +91,8 @@ func (c *listCredentialResult) toCredential(ctx context.Context) (credential.Sta
 				Version:     uint32(c.Version),
 				Username:    c.Username,
 				KeyId:       c.KeyId,
+
+				CredentialVersion: uint32(c.CredentialVersion),
 			},
 		}
 		// Assign byte slices only if the string isn't empty
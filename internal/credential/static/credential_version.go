@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"github.com/hashicorp/boundary/internal/credential/static/store"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+)
+
+// credentialVersion contains the encrypted secret of one version of a static
+// credential. Versions are recorded by the database each time the secret of
+// a credential changes. Only the fields of the subtype of the credential are
+// set.
+type credentialVersion struct {
+	CredentialId                  string `gorm:"primary_key"`
+	CredentialVersion             uint32 `gorm:"primary_key"`
+	Username                      string `gorm:"default:null"`
+	PasswordEncrypted             []byte `gorm:"default:null"`
+	PasswordHmac                  []byte `gorm:"default:null"`
+	PrivateKeyEncrypted           []byte `gorm:"default:null"`
+	PrivateKeyHmac                []byte `gorm:"default:null"`
+	PrivateKeyPassphraseEncrypted []byte `gorm:"default:null"`
+	PrivateKeyPassphraseHmac      []byte `gorm:"default:null"`
+	ObjectEncrypted               []byte `gorm:"default:null"`
+	ObjectHmac                    []byte `gorm:"default:null"`
+	KeyId                         string `gorm:"not_null"`
+	CreateTime                    *timestamp.Timestamp
+}
+
+func allocCredentialVersion() *credentialVersion {
+	return &credentialVersion{}
+}
+
+// TableName returns the table name.
+func (v *credentialVersion) TableName() string {
+	return "credential_static_version"
+}
+
+// toUsernamePasswordCredential returns a UsernamePasswordCredential holding
+// the secret of v.
+func (v *credentialVersion) toUsernamePasswordCredential() *UsernamePasswordCredential {
+	return &UsernamePasswordCredential{
+		UsernamePasswordCredential: &store.UsernamePasswordCredential{
+			PublicId:     v.CredentialId,
+			Username:     v.Username,
+			CtPassword:   v.PasswordEncrypted,
+			PasswordHmac: v.PasswordHmac,
+			KeyId:        v.KeyId,
+		},
+	}
+}
+
+// toSshPrivateKeyCredential returns a SshPrivateKeyCredential holding the
+// secret of v.
+func (v *credentialVersion) toSshPrivateKeyCredential() *SshPrivateKeyCredential {
+	return &SshPrivateKeyCredential{
+		SshPrivateKeyCredential: &store.SshPrivateKeyCredential{
+			PublicId:                      v.CredentialId,
+			Username:                      v.Username,
+			PrivateKeyEncrypted:           v.PrivateKeyEncrypted,
+			PrivateKeyHmac:                v.PrivateKeyHmac,
+			PrivateKeyPassphraseEncrypted: v.PrivateKeyPassphraseEncrypted,
+			PrivateKeyPassphraseHmac:      v.PrivateKeyPassphraseHmac,
+			KeyId:                         v.KeyId,
+		},
+	}
+}
+
+// toJsonCredential returns a JsonCredential holding the secret of v.
+func (v *credentialVersion) toJsonCredential() *JsonCredential {
+	return &JsonCredential{
+		JsonCredential: &store.JsonCredential{
+			PublicId:        v.CredentialId,
+			ObjectEncrypted: v.ObjectEncrypted,
+			ObjectHmac:      v.ObjectHmac,
+			KeyId:           v.KeyId,
+		},
+	}
+}
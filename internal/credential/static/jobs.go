@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	ua "go.uber.org/atomic"
+)
+
+const (
+	credentialRotationJobName = "static_credential_rotation"
+
+	defaultNextRunIn = 5 * time.Minute
+
+	// rotationBatchSize is the maximum number of credentials rotated in a
+	// single run of the credential rotation job.
+	rotationBatchSize = 100
+)
+
+// RegisterJobs registers the static credential related jobs with the
+// scheduler. Scheduled rotation changes passwords on hosts through the
+// rotation hook, so no jobs are registered if hook is nil.
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, hook RotationHook) error {
+	const op = "static.RegisterJobs"
+	if hook == nil {
+		return nil
+	}
+	rotation, err := newCredentialRotationJob(ctx, r, w, kms, hook)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err = scheduler.RegisterJob(ctx, rotation); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("credential rotation job"))
+	}
+	return nil
+}
+
+// CredentialRotationJob is the recurring job that rotates the passwords of
+// username password credentials which have a rotation period and whose
+// current password is older than that period.
+// The CredentialRotationJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
+type CredentialRotationJob struct {
+	reader db.Reader
+	writer db.Writer
+	kms    *kms.Kms
+	hook   RotationHook
+
+	running      ua.Bool
+	numCreds     int
+	numProcessed int
+}
+
+// newCredentialRotationJob creates a new in-memory CredentialRotationJob.
+//
+// No options are supported.
+func newCredentialRotationJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, hook RotationHook) (*CredentialRotationJob, error) {
+	const op = "static.newCredentialRotationJob"
+	switch {
+	case r == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kms == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	case hook == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing rotation hook")
+	}
+
+	return &CredentialRotationJob{
+		reader: r,
+		writer: w,
+		kms:    kms,
+		hook:   hook,
+	}, nil
+}
+
+// Status returns the current status of the credential rotation job.
+func (j *CredentialRotationJob) Status() scheduler.JobStatus {
+	return scheduler.JobStatus{
+		Completed: j.numProcessed,
+		Total:     j.numCreds,
+	}
+}
+
+// Run rotates the passwords of the username password credentials that are
+// due for rotation. A credential which fails to rotate is retried on the
+// next run. Can not be run in parallel, if Run is invoked while already
+// running an error with code JobAlreadyRunning will be returned.
+func (j *CredentialRotationJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "static.(CredentialRotationJob).Run"
+	if !j.running.CompareAndSwap(j.running.Load(), true) {
+		return errors.New(ctx, errors.JobAlreadyRunning, op, "job already running")
+	}
+	defer j.running.Store(false)
+
+	// Verify context is not done before running
+	if err := ctx.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	type dueCredential struct {
+		PublicId  string
+		Version   uint32
+		ProjectId string
+	}
+	var creds []dueCredential
+	rows, err := j.reader.Query(ctx, credentialsDueForRotationQuery, []any{rotationBatchSize})
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var c dueCredential
+		if err := j.reader.ScanRows(ctx, rows, &c); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("scan row failed"))
+		}
+		creds = append(creds, c)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	// Set numProcessed and numCreds for status report
+	j.numProcessed, j.numCreds = 0, len(creds)
+	if len(creds) == 0 {
+		return nil
+	}
+
+	repo, err := NewRepository(ctx, j.reader, j.writer, j.kms, WithRotationHook(j.hook))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	for _, c := range creds {
+		// Verify context is not done before rotating the next credential
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		if _, _, err := repo.RotateUsernamePasswordCredential(ctx, c.ProjectId, c.PublicId, c.Version); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error rotating credential", "credential id", c.PublicId))
+		}
+		j.numProcessed++
+	}
+	return nil
+}
+
+// NextRunIn determine when the next credential rotation job should run.
+func (j *CredentialRotationJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	return defaultNextRunIn, nil
+}
+
+// Name is the unique name of the job.
+func (j *CredentialRotationJob) Name() string {
+	return credentialRotationJobName
+}
+
+// Description is the human readable description of the job.
+func (j *CredentialRotationJob) Description() string {
+	return "Periodically rotates the passwords of static username password credentials which have a rotation period."
+}
@@ -3,6 +3,8 @@
 
 package static
 
+import "github.com/hashicorp/boundary/internal/credential"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -22,6 +24,10 @@ type options struct {
 	withLimit                int
 	withPublicId             string
 	withPrivateKeyPassphrase []byte
+
+	withRotationPeriodSeconds uint32
+	withPassword              credential.Password
+	withRotationHook          RotationHook
 }
 
 func getDefaultOptions() options {
@@ -64,3 +70,28 @@ func WithPrivateKeyPassphrase(with []byte) Option {
 		o.withPrivateKeyPassphrase = with
 	}
 }
+
+// WithRotationPeriodSeconds provides an optional number of seconds after
+// which the password of a username password credential is rotated.
+func WithRotationPeriodSeconds(s uint32) Option {
+	return func(o *options) {
+		o.withRotationPeriodSeconds = s
+	}
+}
+
+// WithPassword provides an optional new password to use when rotating a
+// username password credential.
+func WithPassword(p credential.Password) Option {
+	return func(o *options) {
+		o.withPassword = p
+	}
+}
+
+// WithRotationHook provides an optional rotation hook which is called to
+// change the password of a username password credential on its host before
+// a rotated version of the credential is committed.
+func WithRotationHook(h RotationHook) Option {
+	return func(o *options) {
+		o.withRotationHook = h
+	}
+}
@@ -36,4 +36,23 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withPrivateKeyPassphrase = []byte("my-pass")
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithRotationPeriodSeconds", func(t *testing.T) {
+		opts := getOpts(WithRotationPeriodSeconds(3600))
+		testOpts := getDefaultOptions()
+		testOpts.withRotationPeriodSeconds = 3600
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithPassword", func(t *testing.T) {
+		opts := getOpts(WithPassword("new-password"))
+		testOpts := getDefaultOptions()
+		testOpts.withPassword = "new-password"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithRotationHook", func(t *testing.T) {
+		hook := NewCommandRotationHook("/usr/local/bin/rotate")
+		opts := getOpts(WithRotationHook(hook))
+		testOpts := getDefaultOptions()
+		testOpts.withRotationHook = hook
+		assert.Equal(t, opts, testOpts)
+	})
 }
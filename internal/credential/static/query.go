@@ -41,6 +41,38 @@ where store.project_id = ?
   and json.key_id = ?;
 `
 
+	credStaticVersionRewrapQuery = `
+select distinct
+  version.credential_id,
+  version.credential_version,
+  version.password_encrypted,
+  version.private_key_encrypted,
+  version.private_key_passphrase_encrypted,
+  version.object_encrypted,
+  version.key_id
+from credential_static_version version
+  inner join credential_static cred
+    on cred.public_id = version.credential_id
+where cred.project_id = ?
+  and version.key_id = ?;
+`
+
+	credentialsDueForRotationQuery = `
+select upw.public_id,
+       upw.version,
+       store.project_id
+  from credential_static_username_password_credential upw
+  join credential_static_store store
+    on store.public_id = upw.store_id
+  join credential_static_version version
+    on version.credential_id = upw.public_id
+   and version.credential_version = upw.credential_version
+ where upw.rotation_period_seconds is not null
+   and version.create_time + make_interval(secs => upw.rotation_period_seconds) <= current_timestamp
+ order by version.create_time
+ limit ?;
+`
+
 	estimateCountCredentials = `
 select sum(reltuples::bigint) as estimate
   from pg_class
@@ -87,6 +119,8 @@ final as (
          key_id,
          object_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'json' as type
     from json_creds
    union
@@ -102,6 +136,8 @@ final as (
          key_id,
          password_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         rotation_period_seconds,
          'upw' as type
     from upw_creds
    union
@@ -117,6 +153,8 @@ final as (
          key_id,
          private_key_hmac as hmac1,
          private_key_passphrase_hmac as hmac2,
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'ssh' as type
     from ssh_creds
 )
@@ -162,6 +200,8 @@ final as (
          key_id,
          object_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'json' as type
     from json_creds
    union
@@ -177,6 +217,8 @@ final as (
          key_id,
          password_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         rotation_period_seconds,
          'upw' as type
     from upw_creds
    union
@@ -192,6 +234,8 @@ final as (
          key_id,
          private_key_hmac as hmac1,
          private_key_passphrase_hmac as hmac2,
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'ssh' as type
     from ssh_creds
 )
@@ -237,6 +281,8 @@ final as (
          key_id,
          object_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'json' as type
     from json_creds
    union
@@ -252,6 +298,8 @@ final as (
          key_id,
          password_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         rotation_period_seconds,
          'upw' as type
     from upw_creds
    union
@@ -267,6 +315,8 @@ final as (
          key_id,
          private_key_hmac as hmac1,
          private_key_passphrase_hmac as hmac2,
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'ssh' as type
     from ssh_creds
 )
@@ -313,6 +363,8 @@ final as (
          key_id,
          object_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'json' as type
     from json_creds
    union
@@ -328,6 +380,8 @@ final as (
          key_id,
          password_hmac as hmac1,
          null::bytea as hmac2, -- Add this to make the union uniform
+         credential_version,
+         rotation_period_seconds,
          'upw' as type
     from upw_creds
    union
@@ -343,6 +397,8 @@ final as (
          key_id,
          private_key_hmac as hmac1,
          private_key_passphrase_hmac as hmac2,
+         credential_version,
+         null::integer as rotation_period_seconds, -- Add this to make the union uniform
          'ssh' as type
     from ssh_creds
 )
@@ -20,12 +20,17 @@ type Repository struct {
 	// defaultLimit provides a default for limiting the number of results
 	// returned from the repo
 	defaultLimit int
+	// rotationHook changes the password of a username password credential
+	// on its host when the credential is rotated
+	rotationHook RotationHook
 }
 
 // NewRepository creates a new Repository. The returned repository should
 // only be used for one transaction and it is not safe for concurrent go
 // routines to access it. WithLimit option is used as a repo wide default
-// limit applied to all ListX methods.
+// limit applied to all ListX methods. WithRotationHook option is used to
+// change the password of username password credentials on their hosts when
+// they are rotated.
 func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*Repository, error) {
 	const op = "static.NewRepository"
 	switch {
@@ -48,5 +53,6 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		writer:       w,
 		kms:          kms,
 		defaultLimit: opts.withLimit,
+		rotationHook: opts.withRotationHook,
 	}, nil
 }
@@ -348,8 +348,9 @@ func (r *Repository) LookupCredential(ctx context.Context, publicId string, _ ..
 // new UsernamePasswordCredential containing the updated values and a count of the
 // number of records updated. c is not changed.
 //
-// c must contain a valid PublicId. Only Name, Description, Username and Password can be
-// changed. If c.Name is set to a non-empty string, it must be unique within c.ProjectId.
+// c must contain a valid PublicId. Only Name, Description, Username, Password
+// and RotationPeriodSeconds can be changed. If c.Name is set to a non-empty
+// string, it must be unique within c.ProjectId.
 //
 // An attribute of c will be set to NULL in the database if the attribute
 // in c is the zero value and it is included in fieldMaskPaths.
@@ -387,16 +388,18 @@ func (r *Repository) UpdateUsernamePasswordCredential(ctx context.Context,
 		case strings.EqualFold(descriptionField, f):
 		case strings.EqualFold(usernameField, f):
 		case strings.EqualFold(passwordField, f):
+		case strings.EqualFold(rotationPeriodField, f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, f)
 		}
 	}
 	dbMask, nullFields := dbw.BuildUpdatePaths(
 		map[string]any{
-			nameField:        c.Name,
-			descriptionField: c.Description,
-			usernameField:    c.Username,
-			passwordField:    c.Password,
+			nameField:           c.Name,
+			descriptionField:    c.Description,
+			usernameField:       c.Username,
+			passwordField:       c.Password,
+			rotationPeriodField: c.RotationPeriodSeconds,
 		},
 		fieldMaskPaths,
 		nil,
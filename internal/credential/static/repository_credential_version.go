@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+)
+
+// RotateUsernamePasswordCredential replaces the password of the username
+// password credential for id with a new password and returns the
+// credential with its new CredentialVersion and a count of the number of
+// records updated.
+//
+// The new password is provided with the WithPassword option. If it is not
+// provided and the repository has a rotation hook, a random password is
+// generated. If the repository has a rotation hook, the hook is called with
+// the current and new passwords before the new password is committed and
+// the credential is not changed if the hook returns an error. If committing
+// the new password fails after the hook succeeded, the password on the host
+// no longer matches the credential and an error event naming the credential
+// is written.
+//
+// Only the PasswordHmac is returned, the plain-text and encrypted password is
+// not returned.
+func (r *Repository) RotateUsernamePasswordCredential(
+	ctx context.Context,
+	projectId, id string,
+	version uint32,
+	opt ...Option,
+) (*UsernamePasswordCredential, int, error) {
+	const op = "static.(Repository).RotateUsernamePasswordCredential"
+	switch {
+	case projectId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case id == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	opts := getOpts(opt...)
+	newPassword := opts.withPassword
+	if newPassword == "" {
+		if r.rotationHook == nil {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing password and no rotation hook configured to generate one")
+		}
+		var err error
+		if newPassword, err = generatePassword(ctx); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	cur := allocUsernamePasswordCredential()
+	cur.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, cur); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential %s not found", id))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	if cur.Version != version {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("credential %s is at version %d", id, cur.Version))
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := cur.decrypt(ctx, databaseWrapper); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if string(cur.Password) == string(newPassword) {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "new password matches the current password")
+	}
+
+	c := cur.clone()
+	c.Password = []byte(newPassword)
+	if err := c.encrypt(ctx, databaseWrapper); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	if r.rotationHook != nil {
+		if err := r.rotate(ctx, projectId, cur, string(newPassword)); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	var rowsUpdated int
+	var returnedCredential *UsernamePasswordCredential
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedCredential = c.clone()
+			var err error
+			rowsUpdated, err = w.Update(ctx, returnedCredential,
+				[]string{"PasswordHmac", "CtPassword", "KeyId"}, nil,
+				db.WithOplog(oplogWrapper, returnedCredential.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated == 0 {
+				return errors.New(ctx, errors.VersionMismatch, op, "invalid credential version")
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if r.rotationHook != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("password changed by rotation hook but not committed", "credential id", id))
+		}
+		return nil, db.NoRowsAffected, err
+	}
+
+	// Clear password fields, only PasswordHmac should be returned
+	returnedCredential.CtPassword = nil
+	returnedCredential.Password = nil
+
+	return returnedCredential, rowsUpdated, nil
+}
+
+// RollbackCredential restores the secret that the static credential for id
+// held at credentialVersion. The restored secret is recorded as a new
+// version of the credential. It returns the credential with its new
+// CredentialVersion and a count of the number of records updated.
+// credentialVersion must be lower than the current CredentialVersion of the
+// credential.
+//
+// If the credential is a username password credential and the repository
+// has a rotation hook, the hook is called to change the password on the host
+// back to the restored password before it is committed. The credential is not
+// changed if the hook returns an error. A restored version with a different
+// username than the current version cannot be rolled back with a rotation
+// hook.
+//
+// Only the hmac values of the secret are returned, the plain-text and
+// encrypted secret is not returned.
+func (r *Repository) RollbackCredential(
+	ctx context.Context,
+	projectId, id string,
+	version, credentialVersion uint32,
+	_ ...Option,
+) (credential.Static, int, error) {
+	const op = "static.(Repository).RollbackCredential"
+	switch {
+	case projectId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	case id == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidPublicId, op, "missing public id")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	case credentialVersion == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing credential version")
+	}
+
+	cur, err := r.LookupCredential(ctx, id)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if cur == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential %s not found", id))
+	}
+	var currentVersion uint32
+	switch c := cur.(type) {
+	case *UsernamePasswordCredential:
+		currentVersion = c.GetCredentialVersion()
+	case *SshPrivateKeyCredential:
+		currentVersion = c.GetCredentialVersion()
+	case *JsonCredential:
+		currentVersion = c.GetCredentialVersion()
+	}
+	if credentialVersion >= currentVersion {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op,
+			fmt.Sprintf("credential version %d is not earlier than the current credential version %d", credentialVersion, currentVersion))
+	}
+
+	v := allocCredentialVersion()
+	if err := r.reader.LookupWhere(ctx, v, "credential_id = ? and credential_version = ?", []any{id, credentialVersion}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("credential version %d of %s not found", credentialVersion, id))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	hooked := false
+	if _, ok := cur.(*UsernamePasswordCredential); ok && r.rotationHook != nil {
+		if hooked, err = r.rollbackPassword(ctx, projectId, id, v); err != nil {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		}
+	}
+
+	var rowsUpdated int
+	var returnedCredential credential.Static
+	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			switch globals.ResourceInfoFromPrefix(id).Subtype {
+			case credential.UsernamePasswordSubtype:
+				c := v.toUsernamePasswordCredential()
+				c.StoreId = cur.GetStoreId()
+				rowsUpdated, err = w.Update(ctx, c,
+					[]string{"Username", "PasswordHmac", "CtPassword", "KeyId"}, nil,
+					db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+					db.WithVersion(&version))
+				c.CtPassword = nil
+				c.Password = nil
+				returnedCredential = c
+			case credential.SshPrivateKeySubtype:
+				c := v.toSshPrivateKeyCredential()
+				c.StoreId = cur.GetStoreId()
+				dbMask := []string{"Username", "PrivateKeyHmac", "PrivateKeyEncrypted", "KeyId"}
+				var nullFields []string
+				if len(c.PrivateKeyPassphraseEncrypted) > 0 {
+					dbMask = append(dbMask, "PrivateKeyPassphraseHmac", "PrivateKeyPassphraseEncrypted")
+				} else {
+					nullFields = append(nullFields, "PrivateKeyPassphraseHmac", "PrivateKeyPassphraseEncrypted")
+				}
+				rowsUpdated, err = w.Update(ctx, c, dbMask, nullFields,
+					db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+					db.WithVersion(&version))
+				c.PrivateKeyEncrypted = nil
+				c.PrivateKey = nil
+				c.PrivateKeyPassphraseEncrypted = nil
+				c.PrivateKeyPassphrase = nil
+				returnedCredential = c
+			case credential.JsonSubtype:
+				c := v.toJsonCredential()
+				c.StoreId = cur.GetStoreId()
+				rowsUpdated, err = w.Update(ctx, c,
+					[]string{"ObjectHmac", "ObjectEncrypted", "KeyId"}, nil,
+					db.WithOplog(oplogWrapper, c.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+					db.WithVersion(&version))
+				c.ObjectEncrypted = nil
+				c.Object = nil
+				returnedCredential = c
+			default:
+				return errors.New(ctx, errors.InvalidPublicId, op, fmt.Sprintf("unsupported credential type for: %s", id))
+			}
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated == 0 {
+				return errors.New(ctx, errors.VersionMismatch, op, "invalid credential version")
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if hooked {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("password changed by rotation hook but not committed", "credential id", id))
+		}
+		return nil, db.NoRowsAffected, err
+	}
+
+	return returnedCredential, rowsUpdated, nil
+}
+
+// rollbackPassword calls the rotation hook of r to change the password of
+// the username password credential for id back to the password of v. It
+// reports whether the hook was called, the hook is not called if the
+// password of v is the current password.
+func (r *Repository) rollbackPassword(ctx context.Context, projectId, id string, v *credentialVersion) (bool, error) {
+	const op = "static.(Repository).rollbackPassword"
+	cur := allocUsernamePasswordCredential()
+	cur.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, cur); err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	if cur.Username != v.Username {
+		return false, errors.New(ctx, errors.InvalidParameter, op,
+			fmt.Sprintf("credential version %d has a different username, it cannot be restored with a rotation hook", v.CredentialVersion))
+	}
+
+	databaseWrapper, err := r.kms.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get database wrapper"))
+	}
+	if err := cur.decrypt(ctx, databaseWrapper); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	restored := v.toUsernamePasswordCredential()
+	if err := restored.decrypt(ctx, databaseWrapper); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if string(cur.Password) == string(restored.Password) {
+		return false, nil
+	}
+	if err := r.rotate(ctx, projectId, cur, string(restored.Password)); err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return true, nil
+}
+
+// rotate calls the rotation hook of r to change the password of cur to
+// newPassword. The password of cur must be decrypted.
+func (r *Repository) rotate(ctx context.Context, projectId string, cur *UsernamePasswordCredential, newPassword string) error {
+	const op = "static.(Repository).rotate"
+	req := &RotationRequest{
+		CredentialId:      cur.PublicId,
+		CredentialStoreId: cur.StoreId,
+		ProjectId:         projectId,
+		Username:          cur.Username,
+		CurrentPassword:   string(cur.Password),
+		NewPassword:       newPassword,
+	}
+	if err := r.rotationHook.Rotate(ctx, req); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("rotation hook failed for: %s", cur.PublicId)))
+	}
+	return nil
+}
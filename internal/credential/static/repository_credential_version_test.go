@@ -0,0 +1,505 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRotationHook struct {
+	requests []*RotationRequest
+	err      error
+	// onRotate is called with each request if it is set.
+	onRotate func(req *RotationRequest)
+}
+
+func (h *testRotationHook) Rotate(_ context.Context, req *RotationRequest) error {
+	h.requests = append(h.requests, req)
+	if h.onRotate != nil {
+		h.onRotate(req)
+	}
+	return h.err
+}
+
+func testLookupPassword(t *testing.T, rw *db.Db, kmsCache *kms.Kms, projectId, id string) *UsernamePasswordCredential {
+	t.Helper()
+	ctx := context.Background()
+	got := allocUsernamePasswordCredential()
+	got.PublicId = id
+	require.NoError(t, rw.LookupByPublicId(ctx, got))
+	databaseWrapper, err := kmsCache.GetWrapper(ctx, projectId, kms.KeyPurposeDatabase)
+	require.NoError(t, err)
+	require.NoError(t, got.decrypt(ctx, databaseWrapper))
+	return got
+}
+
+func TestRepository_RotateUsernamePasswordCredential(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		repo, err := NewRepository(ctx, rw, rw, kmsCache)
+		require.NoError(t, err)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+
+		tests := []struct {
+			name      string
+			projectId string
+			id        string
+			version   uint32
+			opts      []Option
+			wantCode  errors.Code
+		}{
+			{
+				name:     "missing-project-id",
+				id:       cred.PublicId,
+				version:  cred.Version,
+				opts:     []Option{WithPassword("new-pass")},
+				wantCode: errors.InvalidParameter,
+			},
+			{
+				name:      "missing-id",
+				projectId: prj.PublicId,
+				version:   cred.Version,
+				opts:      []Option{WithPassword("new-pass")},
+				wantCode:  errors.InvalidPublicId,
+			},
+			{
+				name:      "missing-version",
+				projectId: prj.PublicId,
+				id:        cred.PublicId,
+				opts:      []Option{WithPassword("new-pass")},
+				wantCode:  errors.InvalidParameter,
+			},
+			{
+				name:      "missing-password-without-hook",
+				projectId: prj.PublicId,
+				id:        cred.PublicId,
+				version:   cred.Version,
+				wantCode:  errors.InvalidParameter,
+			},
+			{
+				name:      "same-password",
+				projectId: prj.PublicId,
+				id:        cred.PublicId,
+				version:   cred.Version,
+				opts:      []Option{WithPassword("pass")},
+				wantCode:  errors.InvalidParameter,
+			},
+			{
+				name:      "wrong-version",
+				projectId: prj.PublicId,
+				id:        cred.PublicId,
+				version:   cred.Version + 1,
+				opts:      []Option{WithPassword("new-pass")},
+				wantCode:  errors.VersionMismatch,
+			},
+			{
+				name:      "not-found",
+				projectId: prj.PublicId,
+				id:        "credup_doesnotexist",
+				version:   1,
+				opts:      []Option{WithPassword("new-pass")},
+				wantCode:  errors.RecordNotFound,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert := assert.New(t)
+				got, n, err := repo.RotateUsernamePasswordCredential(ctx, tt.projectId, tt.id, tt.version, tt.opts...)
+				assert.Truef(errors.Match(errors.T(tt.wantCode), err), "want err code: %q got: %q", tt.wantCode, err)
+				assert.Nil(got)
+				assert.Equal(db.NoRowsAffected, n)
+			})
+		}
+	})
+
+	t.Run("with-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		repo, err := NewRepository(ctx, rw, rw, kmsCache)
+		require.NoError(err)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		assert.Equal(uint32(1), cred.CredentialVersion)
+
+		got, n, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version, WithPassword("new-pass"))
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal(cred.Version+1, got.Version)
+		assert.Equal(uint32(2), got.CredentialVersion)
+		assert.Empty(got.Password)
+		assert.Empty(got.CtPassword)
+		assert.NotEqual(cred.PasswordHmac, got.PasswordHmac)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal("new-pass", string(stored.Password))
+		assert.Equal("user", stored.Username)
+	})
+
+	t.Run("with-hook", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hook := &testRotationHook{}
+		repo, err := NewRepository(ctx, rw, rw, kmsCache, WithRotationHook(hook))
+		require.NoError(err)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+
+		got, n, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version)
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal(uint32(2), got.CredentialVersion)
+
+		require.Len(hook.requests, 1)
+		req := hook.requests[0]
+		assert.Equal(cred.PublicId, req.CredentialId)
+		assert.Equal(cs.PublicId, req.CredentialStoreId)
+		assert.Equal(prj.PublicId, req.ProjectId)
+		assert.Equal("user", req.Username)
+		assert.Equal("pass", req.CurrentPassword)
+		assert.Len(req.NewPassword, rotatedPasswordLength)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal(req.NewPassword, string(stored.Password))
+	})
+
+	t.Run("hook-fails", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hook := &testRotationHook{err: errors.New(ctx, errors.Unknown, "test", "host unreachable")}
+		repo, err := NewRepository(ctx, rw, rw, kmsCache, WithRotationHook(hook))
+		require.NoError(err)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+
+		got, n, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version, WithPassword("new-pass"))
+		require.Error(err)
+		assert.Contains(err.Error(), "host unreachable")
+		assert.Nil(got)
+		assert.Equal(db.NoRowsAffected, n)
+		assert.Len(hook.requests, 1)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal("pass", string(stored.Password))
+		assert.Equal(cred.Version, stored.Version)
+		assert.Equal(uint32(1), stored.CredentialVersion)
+	})
+
+	t.Run("updated-during-hook", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		// The credential is changed while the hook runs, the new password
+		// cannot be committed.
+		hook := &testRotationHook{
+			onRotate: func(req *RotationRequest) {
+				_, err := rw.Exec(ctx, "update credential_static_username_password_credential set description = 'changed' where public_id = ?", []any{req.CredentialId})
+				require.NoError(err)
+			},
+		}
+		repo, err := NewRepository(ctx, rw, rw, kmsCache, WithRotationHook(hook))
+		require.NoError(err)
+
+		got, n, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version, WithPassword("new-pass"))
+		require.Error(err)
+		assert.Truef(errors.Match(errors.T(errors.VersionMismatch), err), "want err code: %q got: %q", errors.VersionMismatch, err)
+		assert.Nil(got)
+		assert.Equal(db.NoRowsAffected, n)
+		assert.Len(hook.requests, 1)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal("pass", string(stored.Password))
+		assert.Equal(uint32(1), stored.CredentialVersion)
+	})
+}
+
+func TestRepository_RollbackCredential(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	t.Run("invalid-parameters", func(t *testing.T) {
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+
+		tests := []struct {
+			name              string
+			projectId         string
+			id                string
+			version           uint32
+			credentialVersion uint32
+			wantCode          errors.Code
+		}{
+			{
+				name:              "missing-project-id",
+				id:                cred.PublicId,
+				version:           cred.Version,
+				credentialVersion: 1,
+				wantCode:          errors.InvalidParameter,
+			},
+			{
+				name:              "missing-id",
+				projectId:         prj.PublicId,
+				version:           cred.Version,
+				credentialVersion: 1,
+				wantCode:          errors.InvalidPublicId,
+			},
+			{
+				name:              "missing-version",
+				projectId:         prj.PublicId,
+				id:                cred.PublicId,
+				credentialVersion: 1,
+				wantCode:          errors.InvalidParameter,
+			},
+			{
+				name:      "missing-credential-version",
+				projectId: prj.PublicId,
+				id:        cred.PublicId,
+				version:   cred.Version,
+				wantCode:  errors.InvalidParameter,
+			},
+			{
+				name:              "current-credential-version",
+				projectId:         prj.PublicId,
+				id:                cred.PublicId,
+				version:           cred.Version,
+				credentialVersion: 1,
+				wantCode:          errors.InvalidParameter,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert := assert.New(t)
+				got, n, err := repo.RollbackCredential(ctx, tt.projectId, tt.id, tt.version, tt.credentialVersion)
+				assert.Truef(errors.Match(errors.T(tt.wantCode), err), "want err code: %q got: %q", tt.wantCode, err)
+				assert.Nil(got)
+				assert.Equal(db.NoRowsAffected, n)
+			})
+		}
+	})
+
+	t.Run("username-password", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		rotated, _, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version, WithPassword("new-pass"))
+		require.NoError(err)
+
+		got, n, err := repo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, rotated.Version, 1)
+		require.NoError(err)
+		assert.Equal(1, n)
+		up, ok := got.(*UsernamePasswordCredential)
+		require.True(ok)
+		assert.Equal(uint32(3), up.CredentialVersion)
+		assert.Equal(cred.PasswordHmac, up.PasswordHmac)
+		assert.Empty(up.Password)
+		assert.Empty(up.CtPassword)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal("pass", string(stored.Password))
+		assert.Equal(uint32(3), stored.CredentialVersion)
+	})
+
+	t.Run("ssh-private-key", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cred := TestSshPrivateKeyCredential(t, conn, wrapper, "user", TestSshPrivateKeyPem, cs.PublicId, prj.PublicId)
+		assert.Equal(uint32(1), cred.CredentialVersion)
+
+		upd := cred.clone()
+		upd.Username = "other-user"
+		updated, _, err := repo.UpdateSshPrivateKeyCredential(ctx, prj.PublicId, upd, cred.Version, []string{usernameField})
+		require.NoError(err)
+		assert.Equal(uint32(2), updated.CredentialVersion)
+
+		got, n, err := repo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, updated.Version, 1)
+		require.NoError(err)
+		assert.Equal(1, n)
+		spk, ok := got.(*SshPrivateKeyCredential)
+		require.True(ok)
+		assert.Equal(uint32(3), spk.CredentialVersion)
+		assert.Equal("user", spk.Username)
+		assert.Equal(cred.PrivateKeyHmac, spk.PrivateKeyHmac)
+		assert.Empty(spk.PrivateKey)
+		assert.Empty(spk.PrivateKeyEncrypted)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		obj, _ := TestJsonObject(t)
+		cred := TestJsonCredential(t, conn, wrapper, cs.PublicId, prj.PublicId, obj)
+		assert.Equal(uint32(1), cred.CredentialVersion)
+
+		newObj, _ := TestJsonObject(t)
+		newObj.Struct.Fields["new-field"] = newObj.Struct.Fields["username"]
+		upd, err := NewJsonCredential(ctx, cs.PublicId, newObj)
+		require.NoError(err)
+		upd.PublicId = cred.PublicId
+		updated, _, err := repo.UpdateJsonCredential(ctx, prj.PublicId, upd, cred.Version, []string{objectField})
+		require.NoError(err)
+		assert.Equal(uint32(2), updated.CredentialVersion)
+
+		got, n, err := repo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, updated.Version, 1)
+		require.NoError(err)
+		assert.Equal(1, n)
+		j, ok := got.(*JsonCredential)
+		require.True(ok)
+		assert.Equal(uint32(3), j.CredentialVersion)
+		assert.Equal(cred.ObjectHmac, j.ObjectHmac)
+		assert.Empty(j.ObjectEncrypted)
+	})
+
+	t.Run("username-password-with-hook", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hook := &testRotationHook{}
+		repo, err := NewRepository(ctx, rw, rw, kmsCache, WithRotationHook(hook))
+		require.NoError(err)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		rotated, _, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version, WithPassword("new-pass"))
+		require.NoError(err)
+
+		got, n, err := repo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, rotated.Version, 1)
+		require.NoError(err)
+		assert.Equal(1, n)
+		assert.Equal(uint32(3), got.(*UsernamePasswordCredential).CredentialVersion)
+
+		require.Len(hook.requests, 2)
+		req := hook.requests[1]
+		assert.Equal(cred.PublicId, req.CredentialId)
+		assert.Equal("user", req.Username)
+		assert.Equal("new-pass", req.CurrentPassword)
+		assert.Equal("pass", req.NewPassword)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal("pass", string(stored.Password))
+	})
+
+	t.Run("username-password-hook-fails", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		rotated, _, err := repo.RotateUsernamePasswordCredential(ctx, prj.PublicId, cred.PublicId, cred.Version, WithPassword("new-pass"))
+		require.NoError(err)
+
+		hook := &testRotationHook{err: errors.New(ctx, errors.Unknown, "test", "host unreachable")}
+		hookRepo, err := NewRepository(ctx, rw, rw, kmsCache, WithRotationHook(hook))
+		require.NoError(err)
+		got, n, err := hookRepo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, rotated.Version, 1)
+		require.Error(err)
+		assert.Contains(err.Error(), "host unreachable")
+		assert.Nil(got)
+		assert.Equal(db.NoRowsAffected, n)
+		assert.Len(hook.requests, 1)
+
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, cred.PublicId)
+		assert.Equal("new-pass", string(stored.Password))
+		assert.Equal(uint32(2), stored.CredentialVersion)
+	})
+
+	t.Run("username-password-changed-username-with-hook", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		cred := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+		upd := cred.clone()
+		upd.Username = "other-user"
+		upd.Password = []byte("other-pass")
+		updated, _, err := repo.UpdateUsernamePasswordCredential(ctx, prj.PublicId, upd, cred.Version, []string{usernameField, passwordField})
+		require.NoError(err)
+
+		hook := &testRotationHook{}
+		hookRepo, err := NewRepository(ctx, rw, rw, kmsCache, WithRotationHook(hook))
+		require.NoError(err)
+		got, n, err := hookRepo.RollbackCredential(ctx, prj.PublicId, cred.PublicId, updated.Version, 1)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+		assert.Nil(got)
+		assert.Equal(db.NoRowsAffected, n)
+		assert.Empty(hook.requests)
+	})
+}
+
+func TestCredentialRotationJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	cs := TestCredentialStore(t, conn, wrapper, prj.PublicId)
+
+	due := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId, WithRotationPeriodSeconds(1))
+	notDue := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId, WithRotationPeriodSeconds(3600))
+	noPeriod := TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.PublicId, prj.PublicId)
+
+	hook := &testRotationHook{}
+	job, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, hook)
+	require.NoError(err)
+
+	// Wait for the rotation period of the due credential to elapse.
+	time.Sleep(2 * time.Second)
+	require.NoError(job.Run(ctx, 0))
+	assert.Equal(1, job.Status().Total)
+	assert.Equal(1, job.Status().Completed)
+	require.Len(hook.requests, 1)
+	assert.Equal(due.PublicId, hook.requests[0].CredentialId)
+
+	for _, c := range []*UsernamePasswordCredential{due, notDue, noPeriod} {
+		stored := testLookupPassword(t, rw, kmsCache, prj.PublicId, c.PublicId)
+		switch c.PublicId {
+		case due.PublicId:
+			assert.Equal(uint32(2), stored.CredentialVersion)
+			assert.Equal(hook.requests[0].NewPassword, string(stored.Password))
+		default:
+			assert.Equal(uint32(1), stored.CredentialVersion)
+			assert.Equal("pass", string(stored.Password))
+		}
+	}
+}
+
+func TestCredentialRotationJob_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	hook := &testRotationHook{}
+
+	tests := []struct {
+		name string
+		r    db.Reader
+		w    db.Writer
+		kms  *kms.Kms
+		hook RotationHook
+	}{
+		{name: "nil-reader", w: rw, kms: kmsCache, hook: hook},
+		{name: "nil-writer", r: rw, kms: kmsCache, hook: hook},
+		{name: "nil-kms", r: rw, w: rw, hook: hook},
+		{name: "nil-hook", r: rw, w: rw, kms: kmsCache},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			got, err := newCredentialRotationJob(ctx, tt.r, tt.w, tt.kms, tt.hook)
+			assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+			assert.Nil(got)
+		})
+	}
+
+	got, err := newCredentialRotationJob(ctx, rw, rw, kmsCache, hook)
+	require.NoError(t, err)
+	assert.Equal(t, credentialRotationJobName, got.Name())
+}
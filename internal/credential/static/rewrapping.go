@@ -5,6 +5,7 @@ package static
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
@@ -16,6 +17,7 @@ func init() {
 	kms.RegisterTableRewrapFn("credential_static_username_password_credential", credStaticUsernamePasswordRewrapFn)
 	kms.RegisterTableRewrapFn("credential_static_ssh_private_key_credential", credStaticSshPrivKeyRewrapFn)
 	kms.RegisterTableRewrapFn("credential_static_json_credential", credStaticJsonRewrapFn)
+	kms.RegisterTableRewrapFn("credential_static_version", credStaticVersionRewrapFn)
 }
 
 func rewrapParameterChecks(ctx context.Context, dataKeyVersionId string, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) string {
@@ -172,3 +174,79 @@ func credStaticJsonRewrapFn(ctx context.Context, dataKeyVersionId, scopeId strin
 	}
 	return nil
 }
+
+func credStaticVersionRewrapFn(ctx context.Context, dataKeyVersionId, scopeId string, reader db.Reader, writer db.Writer, kmsRepo kms.GetWrapperer) error {
+	const op = "static.credStaticVersionRewrapFn"
+	if errStr := rewrapParameterChecks(ctx, dataKeyVersionId, scopeId, reader, writer, kmsRepo); errStr != "" {
+		return errors.New(ctx, errors.InvalidParameter, op, errStr)
+	}
+	var versions []*credentialVersion
+	rows, err := reader.Query(ctx, credStaticVersionRewrapQuery, []any{scopeId, dataKeyVersionId})
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query sql for rows that need rewrapping"))
+	}
+	defer rows.Close()
+	for rows.Next() {
+		v := allocCredentialVersion()
+		if err := rows.Scan(
+			&v.CredentialId,
+			&v.CredentialVersion,
+			&v.PasswordEncrypted,
+			&v.PrivateKeyEncrypted,
+			&v.PrivateKeyPassphraseEncrypted,
+			&v.ObjectEncrypted,
+			&v.KeyId,
+		); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to failed to scan row"))
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to iterate over retrieved rows"))
+	}
+	wrapper, err := kmsRepo.GetWrapper(ctx, scopeId, kms.KeyPurposeDatabase)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("failed to fetch kms wrapper for rewrapping"))
+	}
+	for _, v := range versions {
+		var fields []string
+		switch {
+		case len(v.PasswordEncrypted) > 0:
+			cred := v.toUsernamePasswordCredential()
+			if err := cred.decrypt(ctx, wrapper); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt username/password credential version"))
+			}
+			if err := cred.encrypt(ctx, wrapper); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt username/password credential version"))
+			}
+			v.PasswordEncrypted, v.KeyId = cred.CtPassword, cred.KeyId
+			fields = []string{"PasswordEncrypted", "KeyId"}
+		case len(v.PrivateKeyEncrypted) > 0:
+			cred := v.toSshPrivateKeyCredential()
+			if err := cred.decrypt(ctx, wrapper); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt ssh private key credential version"))
+			}
+			if err := cred.encrypt(ctx, wrapper); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt ssh private key credential version"))
+			}
+			v.PrivateKeyEncrypted, v.PrivateKeyPassphraseEncrypted, v.KeyId = cred.PrivateKeyEncrypted, cred.PrivateKeyPassphraseEncrypted, cred.KeyId
+			fields = []string{"PrivateKeyEncrypted", "PrivateKeyPassphraseEncrypted", "KeyId"}
+		case len(v.ObjectEncrypted) > 0:
+			cred := v.toJsonCredential()
+			if err := cred.decrypt(ctx, wrapper); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to decrypt json credential version"))
+			}
+			if err := cred.encrypt(ctx, wrapper); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("failed to re-encrypt json credential version"))
+			}
+			v.ObjectEncrypted, v.KeyId = cred.ObjectEncrypted, cred.KeyId
+			fields = []string{"ObjectEncrypted", "KeyId"}
+		default:
+			return errors.New(ctx, errors.Internal, op, fmt.Sprintf("credential version %d of %s has no secret", v.CredentialVersion, v.CredentialId))
+		}
+		if _, err := writer.Update(ctx, v, fields, nil); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to update credential version row with rewrapped fields"))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/go-secure-stdlib/base62"
+)
+
+// rotatedPasswordLength is the length of the passwords generated when a
+// username password credential is rotated without a new password.
+const rotatedPasswordLength = 32
+
+// maxRotationCommandOutput is the maximum number of bytes of the standard
+// error of a rotation command included in the error returned when the
+// command fails.
+const maxRotationCommandOutput = 1024
+
+// A RotationRequest contains the username password credential being rotated
+// and its current and new passwords. The passwords are plain strings since a
+// credential.Password is redacted when it is marshaled.
+type RotationRequest struct {
+	CredentialId      string `json:"credential_id"`
+	CredentialStoreId string `json:"credential_store_id"`
+	ProjectId         string `json:"project_id"`
+	Username          string `json:"username"`
+	CurrentPassword   string `json:"current_password"`
+	NewPassword       string `json:"new_password"`
+}
+
+// A RotationHook changes the password of a username password credential on
+// the host the credential is used with. The new version of the credential is
+// only committed if Rotate returns nil.
+type RotationHook interface {
+	Rotate(ctx context.Context, req *RotationRequest) error
+}
+
+// A CommandRotationHook is a RotationHook which runs an executable for each
+// rotation. The RotationRequest is written to the standard input of the
+// executable as a JSON object. The rotation fails if the executable exits
+// with a non-zero status.
+type CommandRotationHook struct {
+	path string
+}
+
+var _ RotationHook = (*CommandRotationHook)(nil)
+
+// NewCommandRotationHook creates a CommandRotationHook which runs the
+// executable at path.
+func NewCommandRotationHook(path string) *CommandRotationHook {
+	return &CommandRotationHook{path: path}
+}
+
+// Rotate runs the executable of h with req written to its standard input.
+// The executable is killed if ctx is done before it exits.
+func (h *CommandRotationHook) Rotate(ctx context.Context, req *RotationRequest) error {
+	const op = "static.(CommandRotationHook).Rotate"
+	switch {
+	case h.path == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing command")
+	case req == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing request")
+	}
+
+	in, err := json.Marshal(req)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encode))
+	}
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.path)
+	cmd.Stdin = bytes.NewReader(in)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if len(msg) > maxRotationCommandOutput {
+			msg = msg[:maxRotationCommandOutput]
+		}
+		if msg != "" {
+			return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("rotation command failed: %s", msg)))
+		}
+		return errors.Wrap(ctx, err, op, errors.WithMsg("rotation command failed"))
+	}
+	return nil
+}
+
+// generatePassword returns a random password for a rotated username
+// password credential.
+func generatePassword(ctx context.Context) (credential.Password, error) {
+	const op = "static.generatePassword"
+	p, err := base62.Random(rotatedPasswordLength)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Io))
+	}
+	return credential.Password(p), nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package static
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRotationCommand(t *testing.T, script string) string {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("rotation command tests require a shell")
+	}
+	path := filepath.Join(t.TempDir(), "rotate.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0o700))
+	return path
+}
+
+func TestCommandRotationHook_Rotate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	req := &RotationRequest{
+		CredentialId:      "credup_1234567890",
+		CredentialStoreId: "csst_1234567890",
+		ProjectId:         "p_1234567890",
+		Username:          "user",
+		CurrentPassword:   "current-password",
+		NewPassword:       "new-password",
+	}
+
+	t.Run("success", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		out := filepath.Join(t.TempDir(), "request.json")
+		hook := NewCommandRotationHook(testRotationCommand(t, "cat > "+out+"\n"))
+		require.NoError(hook.Rotate(ctx, req))
+
+		b, err := os.ReadFile(out)
+		require.NoError(err)
+		got := &RotationRequest{}
+		require.NoError(json.Unmarshal(b, got))
+		assert.Equal(req, got)
+	})
+	t.Run("command-fails", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hook := NewCommandRotationHook(testRotationCommand(t, "echo 'host unreachable' >&2\nexit 1\n"))
+		err := hook.Rotate(ctx, req)
+		require.Error(err)
+		assert.Contains(err.Error(), "host unreachable")
+	})
+	t.Run("command-output-truncated", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		hook := NewCommandRotationHook(testRotationCommand(t, "printf '%02000d' 0 >&2\nexit 1\n"))
+		err := hook.Rotate(ctx, req)
+		require.Error(err)
+		assert.Contains(err.Error(), strings.Repeat("0", maxRotationCommandOutput))
+		assert.NotContains(err.Error(), strings.Repeat("0", maxRotationCommandOutput+1))
+	})
+	t.Run("missing-command", func(t *testing.T) {
+		assert := assert.New(t)
+		err := NewCommandRotationHook("").Rotate(ctx, req)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+	t.Run("missing-request", func(t *testing.T) {
+		assert := assert.New(t)
+		err := NewCommandRotationHook("/bin/true").Rotate(ctx, nil)
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err code: %q got: %q", errors.InvalidParameter, err)
+	})
+}
+
+func TestGeneratePassword(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	p1, err := generatePassword(ctx)
+	require.NoError(err)
+	assert.Len(p1, rotatedPasswordLength)
+
+	p2, err := generatePassword(ctx)
+	require.NoError(err)
+	assert.NotEqual(p1, p2)
+}
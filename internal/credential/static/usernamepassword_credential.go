@@ -28,8 +28,8 @@ type UsernamePasswordCredential struct {
 }
 
 // NewUsernamePasswordCredential creates a new in memory static Credential containing a
-// username and password that is assigned to storeId. Name and description are the only
-// valid options. All other options are ignored.
+// username and password that is assigned to storeId. Name, description, and
+// rotation period are the only valid options. All other options are ignored.
 func NewUsernamePasswordCredential(
 	storeId string,
 	username string,
@@ -44,6 +44,8 @@ func NewUsernamePasswordCredential(
 			Description: opts.withDescription,
 			Username:    username,
 			Password:    []byte(password),
+
+			RotationPeriodSeconds: opts.withRotationPeriodSeconds,
 		},
 	}
 	return l, nil
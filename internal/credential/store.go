@@ -52,6 +52,20 @@ type StoreListQueryResult struct {
 	ClientCert []byte
 	// Optional client cert key HMAC of the credential store.
 	ClientCertKeyHmac []byte
+	// Optional Vault auth method of the credential store.
+	AuthMethod string
+	// Optional mount path of the Vault auth method of the credential store.
+	AuthMountPath string
+	// Optional role of the Vault auth method of the credential store.
+	AuthRole string
+	// Optional AppRole secret id HMAC of the credential store.
+	AuthSecretIdHmac []byte
+	// Optional key type of the certificate authority of the credential store.
+	KeyType string
+	// Optional key bits of the certificate authority of the credential store.
+	KeyBits uint32
+	// Optional public key of the certificate authority of the credential store.
+	PublicKey string
 	// The subtype of the credential store.
 	Subtype string
 }
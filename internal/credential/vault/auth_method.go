@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/boundary/internal/credential/vault/store"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/libs/crypto"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/hashicorp/go-kms-wrapping/v2/extras/structwrapping"
+	vault "github.com/hashicorp/vault/api"
+	"google.golang.org/protobuf/proto"
+)
+
+// AuthMethodType is the type of Vault auth method a credential store uses
+// to log in to Vault.
+type AuthMethodType string
+
+// Vault auth methods.
+const (
+	AppRoleAuthMethod AuthMethodType = "approle"
+	JwtAuthMethod     AuthMethodType = "jwt"
+)
+
+func (t AuthMethodType) isValid() bool {
+	switch t {
+	case AppRoleAuthMethod, JwtAuthMethod:
+		return true
+	}
+	return false
+}
+
+// AuthMethod contains the Vault auth method a credential store uses to log
+// in to Vault to obtain a Vault token. It is owned by a credential store.
+type AuthMethod struct {
+	*store.AuthMethod
+	tableName string `gorm:"-"`
+}
+
+// NewAuthMethod creates a new in memory AuthMethod for logging in to Vault
+// with the Vault auth method of type method. For the AppRole auth method,
+// role is the role_id of the AppRole and secretId is its secret_id. For the
+// JWT auth method, role is the name of the JWT role and secretId must be
+// empty, the JWT presented to Vault is signed by the controller.
+// WithAuthMountPath is the only valid option, the mount path defaults to
+// the name of the auth method type. All other options are ignored.
+func NewAuthMethod(method AuthMethodType, role string, secretId SecretIdSecret, opt ...Option) *AuthMethod {
+	opts := getOpts(opt...)
+	var secretIdCopy SecretIdSecret
+	if len(secretId) > 0 {
+		secretIdCopy = make(SecretIdSecret, len(secretId))
+		copy(secretIdCopy, secretId)
+	}
+	return &AuthMethod{
+		AuthMethod: &store.AuthMethod{
+			Method:    string(method),
+			MountPath: opts.withAuthMountPath,
+			Role:      role,
+			SecretId:  secretIdCopy,
+		},
+	}
+}
+
+func allocAuthMethod() *AuthMethod {
+	return &AuthMethod{
+		AuthMethod: &store.AuthMethod{},
+	}
+}
+
+func (am *AuthMethod) clone() *AuthMethod {
+	cp := proto.Clone(am.AuthMethod)
+	return &AuthMethod{
+		AuthMethod: cp.(*store.AuthMethod),
+	}
+}
+
+// TableName returns the table name.
+func (am *AuthMethod) TableName() string {
+	if am.tableName != "" {
+		return am.tableName
+	}
+	return "credential_vault_store_auth_method"
+}
+
+// SetTableName sets the table name.
+func (am *AuthMethod) SetTableName(n string) {
+	am.tableName = n
+}
+
+// validate checks the auth method contains the values required by its
+// method type. An empty mount path is set to the default mount path of the
+// method type.
+func (am *AuthMethod) validate(ctx context.Context) error {
+	const op = "vault.(AuthMethod).validate"
+	if !AuthMethodType(am.Method).isValid() {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown auth method: %q", am.Method))
+	}
+	if am.Role == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "no role")
+	}
+	switch AuthMethodType(am.Method) {
+	case AppRoleAuthMethod:
+		if len(am.SecretId) == 0 && len(am.CtSecretId) == 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "no secret id for approle auth method")
+		}
+	case JwtAuthMethod:
+		if len(am.SecretId) > 0 {
+			return errors.New(ctx, errors.InvalidParameter, op, "secret id not allowed for jwt auth method")
+		}
+		am.CtSecretId, am.SecretIdHmac, am.KeyId = nil, nil, ""
+	}
+	if am.MountPath == "" {
+		am.MountPath = am.Method
+	}
+	return nil
+}
+
+func (am *AuthMethod) encrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(AuthMethod).encrypt"
+	if len(am.SecretId) == 0 {
+		// only the AppRole auth method has a secret to encrypt
+		return nil
+	}
+	if err := structwrapping.WrapStruct(ctx, cipher, am.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	keyId, err := cipher.KeyId(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt), errors.WithMsg("error reading cipher key id"))
+	}
+	am.KeyId = keyId
+	if err := am.hmacSecretId(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+func (am *AuthMethod) decrypt(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(AuthMethod).decrypt"
+	if len(am.CtSecretId) == 0 {
+		return nil
+	}
+	if err := structwrapping.UnwrapStruct(ctx, cipher, am.AuthMethod, nil); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decrypt))
+	}
+	return nil
+}
+
+func (am *AuthMethod) hmacSecretId(ctx context.Context, cipher wrapping.Wrapper) error {
+	const op = "vault.(AuthMethod).hmacSecretId"
+	if cipher == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing cipher")
+	}
+	hm, err := crypto.HmacSha256(ctx, am.SecretId, cipher, []byte(am.StoreId), nil)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	am.SecretIdHmac = []byte(hm)
+	return nil
+}
+
+// loginData returns the data sent to the login endpoint of the Vault auth
+// method. signer, and the org and project of the credential store, are
+// required for the JWT auth method.
+func (am *AuthMethod) loginData(ctx context.Context, signer *JwtSigner, orgId, projectId string) (map[string]any, error) {
+	const op = "vault.(AuthMethod).loginData"
+	switch AuthMethodType(am.Method) {
+	case AppRoleAuthMethod:
+		return map[string]any{
+			"role_id":   am.Role,
+			"secret_id": string(am.SecretId),
+		}, nil
+	case JwtAuthMethod:
+		if signer == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault jwt signing key configured on the controller")
+		}
+		token, err := signer.sign(ctx, am.StoreId, orgId, projectId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		return map[string]any{
+			"role": am.Role,
+			"jwt":  token,
+		}, nil
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown auth method: %q", am.Method))
+	}
+}
+
+// login logs in to Vault with the auth method using c and returns the
+// vault.Secret containing the Vault token issued by Vault. The token in c
+// is replaced with the new token. projectId is the project of the credential
+// store, whose org is read with r for the claims of a JWT.
+func (am *AuthMethod) login(ctx context.Context, r db.Reader, c vaultClient, signer *JwtSigner, projectId string) (*vault.Secret, error) {
+	const op = "vault.(AuthMethod).login"
+	var orgId string
+	if AuthMethodType(am.Method) == JwtAuthMethod && signer != nil {
+		var err error
+		if orgId, err = lookupProjectOrg(ctx, r, projectId); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	data, err := am.loginData(ctx, signer, orgId, projectId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	s, err := c.login(ctx, path.Join("auth", am.MountPath, "login"), data)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return s, nil
+}
+
+// lookupProjectOrg returns the id of the org of the project projectId.
+func lookupProjectOrg(ctx context.Context, r db.Reader, projectId string) (string, error) {
+	const op = "vault.lookupProjectOrg"
+	if projectId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	rows, err := r.Query(ctx, lookupProjectOrgQuery, []any{sql.Named("public_id", projectId)})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var orgId string
+	for rows.Next() {
+		if err := rows.Scan(&orgId); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if orgId == "" {
+		return "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("project %s not found", projectId))
+	}
+	return orgId, nil
+}
+
+func (am *AuthMethod) insertQuery() (query string, queryValues []any) {
+	query = upsertAuthMethodQuery
+	var secretId, secretIdHmac []byte
+	var keyId *string
+	if len(am.CtSecretId) > 0 {
+		secretId, secretIdHmac = am.CtSecretId, am.SecretIdHmac
+		keyId = &am.KeyId
+	}
+	queryValues = []any{
+		sql.Named("store_id", am.StoreId),
+		sql.Named("method", am.Method),
+		sql.Named("mount_path", am.MountPath),
+		sql.Named("role", am.Role),
+		sql.Named("secret_id", secretId),
+		sql.Named("secret_id_hmac", secretIdHmac),
+		sql.Named("key_id", keyId),
+	}
+	return
+}
+
+func (am *AuthMethod) deleteQuery() (query string, queryValues []any) {
+	query = deleteAuthMethodQuery
+	queryValues = []any{
+		am.StoreId,
+	}
+	return
+}
+
+func (am *AuthMethod) oplogMessage(opType db.OpType) *oplog.Message {
+	msg := oplog.Message{
+		Message:  am.clone(),
+		TypeName: am.TableName(),
+	}
+	switch opType {
+	case db.CreateOp, db.UpdateOp:
+		msg.OpType = oplog.OpType_OP_TYPE_CREATE
+	case db.DeleteOp:
+		msg.OpType = oplog.OpType_OP_TYPE_DELETE
+	}
+	return &msg
+}
+
+// lookupAuthMethod returns the auth method of the credential store storeId
+// with its secret decrypted by cipher. Returns nil, nil if the credential
+// store does not have an auth method.
+func lookupAuthMethod(ctx context.Context, r db.Reader, cipher wrapping.Wrapper, storeId string) (*AuthMethod, error) {
+	const op = "vault.lookupAuthMethod"
+	if storeId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	}
+	am := allocAuthMethod()
+	if err := r.LookupWhere(ctx, am, "store_id = ?", []any{storeId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", storeId)))
+	}
+	if err := am.decrypt(ctx, cipher); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return am, nil
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthMethod_validate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		am            *AuthMethod
+		wantMountPath string
+		wantErr       bool
+	}{
+		{
+			name:    "unknown-method",
+			am:      NewAuthMethod("userpass", "role", nil),
+			wantErr: true,
+		},
+		{
+			name:    "no-role",
+			am:      NewAuthMethod(AppRoleAuthMethod, "", SecretIdSecret("secret-id")),
+			wantErr: true,
+		},
+		{
+			name:    "approle-no-secret-id",
+			am:      NewAuthMethod(AppRoleAuthMethod, "role-id", nil),
+			wantErr: true,
+		},
+		{
+			name:    "jwt-with-secret-id",
+			am:      NewAuthMethod(JwtAuthMethod, "boundary", SecretIdSecret("secret-id")),
+			wantErr: true,
+		},
+		{
+			name:          "approle-default-mount-path",
+			am:            NewAuthMethod(AppRoleAuthMethod, "role-id", SecretIdSecret("secret-id")),
+			wantMountPath: "approle",
+		},
+		{
+			name:          "jwt-default-mount-path",
+			am:            NewAuthMethod(JwtAuthMethod, "boundary", nil),
+			wantMountPath: "jwt",
+		},
+		{
+			name:          "jwt-with-mount-path",
+			am:            NewAuthMethod(JwtAuthMethod, "boundary", nil, WithAuthMountPath("boundary-jwt")),
+			wantMountPath: "boundary-jwt",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert := assert.New(t)
+			err := tt.am.validate(ctx)
+			if tt.wantErr {
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(tt.wantMountPath, tt.am.GetMountPath())
+		})
+	}
+}
+
+func TestAuthMethod_loginData(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("approle", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		am := NewAuthMethod(AppRoleAuthMethod, "role-id", SecretIdSecret("secret-id"))
+		data, err := am.loginData(ctx, nil, "", "")
+		require.NoError(err)
+		assert.Equal(map[string]any{"role_id": "role-id", "secret_id": "secret-id"}, data)
+	})
+	t.Run("jwt-no-signer", func(t *testing.T) {
+		am := NewAuthMethod(JwtAuthMethod, "boundary", nil)
+		am.StoreId = "csvlt_1234567890"
+		_, err := am.loginData(ctx, nil, "o_1234567890", "p_1234567890")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+	})
+	t.Run("jwt", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(err)
+		pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+		am := NewAuthMethod(JwtAuthMethod, "boundary", nil)
+		am.StoreId = "csvlt_1234567890"
+		for _, tt := range []struct {
+			opts         []Option
+			wantAudience string
+		}{
+			{wantAudience: "vault"},
+			{opts: []Option{WithJwtAudience("https://vault.example.com")}, wantAudience: "https://vault.example.com"},
+		} {
+			signer, err := NewJwtSigner(ctx, pemKey, tt.opts...)
+			require.NoError(err)
+			data, err := am.loginData(ctx, signer, "o_1234567890", "p_1234567890")
+			require.NoError(err)
+			assert.Equal("boundary", data["role"])
+
+			token, err := jwt.ParseSigned(data["jwt"].(string))
+			require.NoError(err)
+			var claims jwt.Claims
+			var scopeClaims jwtScopeClaims
+			require.NoError(token.Claims(&key.PublicKey, &claims, &scopeClaims))
+			assert.Equal(jwtIssuer, claims.Issuer)
+			assert.Equal(am.StoreId, claims.Subject)
+			assert.Equal(jwt.Audience{tt.wantAudience}, claims.Audience)
+			assert.NoError(claims.Validate(jwt.Expected{Issuer: jwtIssuer, Subject: am.StoreId, Audience: jwt.Audience{tt.wantAudience}}))
+			assert.Equal(jwtScopeClaims{ScopeId: "o_1234567890", ProjectId: "p_1234567890"}, scopeClaims)
+		}
+
+		signer, err := NewJwtSigner(ctx, pemKey)
+		require.NoError(err)
+		_, err = am.loginData(ctx, signer, "", "p_1234567890")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+		_, err = am.loginData(ctx, signer, "o_1234567890", "")
+		assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+	})
+}
+
+func Test_lookupProjectOrg(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	got, err := lookupProjectOrg(ctx, rw, prj.GetPublicId())
+	require.NoError(err)
+	assert.Equal(org.GetPublicId(), got)
+
+	_, err = lookupProjectOrg(ctx, rw, org.GetPublicId())
+	assert.Truef(errors.Match(errors.T(errors.RecordNotFound), err), "want err: %q got: %q", errors.RecordNotFound, err)
+	_, err = lookupProjectOrg(ctx, rw, "")
+	assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+}
+
+func TestNewJwtSigner(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	ecDer, err := x509.MarshalECPrivateKey(ecKey)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	edDer, err := x509.MarshalPKCS8PrivateKey(edKey)
+	require.NoError(t, err)
+	ecP224Key, err := ecdsa.GenerateKey(elliptic.P224(), rand.Reader)
+	require.NoError(t, err)
+	ecP224Der, err := x509.MarshalECPrivateKey(ecP224Key)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		pemKey  []byte
+		wantErr bool
+	}{
+		{
+			name:    "not-pem",
+			pemKey:  []byte("not a key"),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported-block-type",
+			pemKey:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("cert")}),
+			wantErr: true,
+		},
+		{
+			name:    "bad-key",
+			pemKey:  pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("key")}),
+			wantErr: true,
+		},
+		{
+			name:    "unsupported-curve",
+			pemKey:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecP224Der}),
+			wantErr: true,
+		},
+		{
+			name:   "rsa",
+			pemKey: pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}),
+		},
+		{
+			name:   "ecdsa",
+			pemKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: ecDer}),
+		},
+		{
+			name:   "ed25519",
+			pemKey: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: edDer}),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewJwtSigner(ctx, tt.pemKey)
+			if tt.wantErr {
+				assert.Truef(errors.Match(errors.T(errors.InvalidParameter), err), "want err: %q got: %q", errors.InvalidParameter, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(got)
+			token, err := got.sign(ctx, "csvlt_1234567890", "o_1234567890", "p_1234567890")
+			require.NoError(err)
+			assert.NotEmpty(token)
+		})
+	}
+}
@@ -37,8 +37,9 @@ type CredentialLibrary struct {
 
 // NewCredentialLibrary creates a new in memory CredentialLibrary
 // for a Vault backend at vaultPath assigned to storeId.
-// Name, description, method, request body, credential type, and mapping
-// override are the only valid options. All other options are ignored.
+// Name, description, method, request body, secret version, credential type,
+// and mapping override are the only valid options. All other options are
+// ignored.
 func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*CredentialLibrary, error) {
 	const op = "vault.NewCredentialLibrary"
 	opts := getOpts(opt...)
@@ -52,6 +53,7 @@ func NewCredentialLibrary(storeId string, vaultPath string, opt ...Option) (*Cre
 			VaultPath:       vaultPath,
 			HttpRequestBody: opts.withRequestBody,
 			HttpMethod:      string(opts.withMethod),
+			SecretVersion:   opts.withSecretVersion,
 			CredentialType:  string(opts.withCredentialType),
 		},
 	}
@@ -63,6 +65,8 @@ func (l *CredentialLibrary) validate(ctx context.Context, caller errors.Op) erro
 	switch {
 	case !validMappingOverride(l.MappingOverride, l.CredentialType()):
 		return errors.New(ctx, errors.VaultInvalidMappingOverride, caller, "invalid credential type for mapping override")
+	case l.SecretVersion != 0 && Method(l.HttpMethod) != MethodGet:
+		return errors.New(ctx, errors.InvalidParameter, caller, "secret version only allowed with GET method")
 	}
 	return nil
 }
@@ -147,6 +151,7 @@ type listCredentialLibraryResult struct {
 	CredentialType            string
 	HttpMethod                string
 	HttpRequestBody           string
+	SecretVersion             int
 	Username                  string
 	KeyType                   string
 	Ttl                       string
@@ -177,6 +182,7 @@ func (l *listCredentialLibraryResult) toLibrary(ctx context.Context) (credential
 				VaultPath:      l.VaultPath,
 				CredentialType: l.CredentialType,
 				HttpMethod:     l.HttpMethod,
+				SecretVersion:  uint32(l.SecretVersion),
 			},
 		}
 		// Assign byte slices only if the string isn't empty
@@ -193,6 +193,45 @@ func TestCredentialLibrary_New(t *testing.T) {
 			},
 			wantCreateErr: true,
 		},
+		{
+			name: "valid-with-get-method-and-secret-version",
+			args: args{
+				storeId:   cs.PublicId,
+				vaultPath: "vault/path",
+				opts: []Option{
+					WithMethod(MethodGet),
+					WithSecretVersion(2),
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:       cs.PublicId,
+					VaultPath:     "vault/path",
+					HttpMethod:    "GET",
+					SecretVersion: 2,
+				},
+			},
+		},
+		{
+			name: "post-method-with-secret-version",
+			args: args{
+				storeId:   cs.PublicId,
+				vaultPath: "vault/path",
+				opts: []Option{
+					WithMethod(MethodPost),
+					WithSecretVersion(2),
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:       cs.PublicId,
+					VaultPath:     "vault/path",
+					HttpMethod:    "POST",
+					SecretVersion: 2,
+				},
+			},
+			wantCreateErr: true,
+		},
 		{
 			name: "minimum-actually-valid",
 			args: args{
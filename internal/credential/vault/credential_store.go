@@ -21,6 +21,7 @@ type CredentialStore struct {
 	tableName string `gorm:"-"`
 
 	clientCert  *ClientCertificate `gorm:"-"`
+	authMethod  *AuthMethod        `gorm:"-"`
 	inputToken  TokenSecret        `gorm:"-"`
 	outputToken *Token             `gorm:"-"`
 
@@ -30,13 +31,14 @@ type CredentialStore struct {
 
 // NewCredentialStore creates a new in memory CredentialStore for a Vault
 // server at vaultAddress assigned to projectId. Name, description, CA cert,
-// client cert, namespace, TLS server name, worker filter, and TLS skip verify are the
-// only valid options. All other options are ignored.
+// client cert, auth method, namespace, TLS server name, worker filter, and TLS
+// skip verify are the only valid options. All other options are ignored.
 func NewCredentialStore(projectId string, vaultAddress string, token TokenSecret, opt ...Option) (*CredentialStore, error) {
 	opts := getOpts(opt...)
 	cs := &CredentialStore{
 		inputToken: token,
 		clientCert: opts.withClientCert,
+		authMethod: opts.withAuthMethod,
 		CredentialStore: &store.CredentialStore{
 			ProjectId:     projectId,
 			Name:          opts.withName,
@@ -65,10 +67,15 @@ func (cs *CredentialStore) clone() *CredentialStore {
 	if cs.clientCert != nil {
 		clientCertCopy = cs.clientCert.clone()
 	}
+	var authMethodCopy *AuthMethod
+	if cs.authMethod != nil {
+		authMethodCopy = cs.authMethod.clone()
+	}
 	cp := proto.Clone(cs.CredentialStore)
 	return &CredentialStore{
 		inputToken:      tokenCopy,
 		clientCert:      clientCertCopy,
+		authMethod:      authMethodCopy,
 		CredentialStore: cp.(*store.CredentialStore),
 	}
 }
@@ -77,6 +84,11 @@ func (cs *CredentialStore) clone() *CredentialStore {
 // this based on the passed in fieldMaskPaths.
 func (cs *CredentialStore) applyUpdate(new *CredentialStore, fieldMaskPaths []string) *CredentialStore {
 	cp := cs.clone()
+	newAuthMethod := new.authMethod
+	if newAuthMethod == nil {
+		// the auth method fields are being unset
+		newAuthMethod = allocAuthMethod()
+	}
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold(nameField, f):
@@ -103,6 +115,40 @@ func (cs *CredentialStore) applyUpdate(new *CredentialStore, fieldMaskPaths []st
 			}
 			cp.clientCert.CertificateKey = new.clientCert.GetCertificateKey()
 			cp.clientCert.StoreId = cs.GetPublicId()
+		case strings.EqualFold(authMethodField, f):
+			if cp.authMethod == nil {
+				cp.authMethod = allocAuthMethod()
+			}
+			cp.authMethod.Method = newAuthMethod.GetMethod()
+			cp.authMethod.StoreId = cs.GetPublicId()
+			if AuthMethodType(cp.authMethod.Method) == JwtAuthMethod {
+				// the JWT auth method does not have a secret id
+				cp.authMethod.SecretId = nil
+				cp.authMethod.CtSecretId = nil
+				cp.authMethod.SecretIdHmac = nil
+				cp.authMethod.KeyId = ""
+			}
+		case strings.EqualFold(authMountPathField, f):
+			if cp.authMethod == nil {
+				cp.authMethod = allocAuthMethod()
+			}
+			cp.authMethod.MountPath = newAuthMethod.GetMountPath()
+			cp.authMethod.StoreId = cs.GetPublicId()
+		case strings.EqualFold(authRoleField, f):
+			if cp.authMethod == nil {
+				cp.authMethod = allocAuthMethod()
+			}
+			cp.authMethod.Role = newAuthMethod.GetRole()
+			cp.authMethod.StoreId = cs.GetPublicId()
+		case strings.EqualFold(authSecretIdField, f):
+			if cp.authMethod == nil {
+				cp.authMethod = allocAuthMethod()
+			}
+			cp.authMethod.SecretId = newAuthMethod.GetSecretId()
+			cp.authMethod.CtSecretId = nil
+			cp.authMethod.SecretIdHmac = nil
+			cp.authMethod.KeyId = ""
+			cp.authMethod.StoreId = cs.GetPublicId()
 		case strings.EqualFold(vaultAddressField, f):
 			cp.VaultAddress = new.VaultAddress
 		case strings.EqualFold(namespaceField, f):
@@ -119,6 +165,10 @@ func (cs *CredentialStore) applyUpdate(new *CredentialStore, fieldMaskPaths []st
 			cp.WorkerFilter = new.WorkerFilter
 		}
 	}
+	if cp.authMethod != nil && cp.authMethod.Method == "" {
+		// an auth method without a method has been unset
+		cp.authMethod = nil
+	}
 	return cp
 }
 
@@ -178,6 +228,11 @@ func (cs *CredentialStore) ClientCertificate() *ClientCertificate {
 	return cs.clientCert
 }
 
+// AuthMethod returns the Vault auth method if available.
+func (cs *CredentialStore) AuthMethod() *AuthMethod {
+	return cs.authMethod
+}
+
 func (cs *CredentialStore) client(ctx context.Context) (vaultClient, error) {
 	const op = "vault.(CredentialStore).client"
 	clientConfig := &clientConfig{
@@ -11,6 +11,7 @@ const (
 	vaultPathField       = "VaultPath"
 	httpMethodField      = "HttpMethod"
 	httpRequestBodyField = "HttpRequestBody"
+	secretVersionField   = "SecretVersion"
 
 	usernameField = "Username"
 	keyTypeField  = "KeyType"
@@ -36,6 +37,10 @@ const (
 	tlsSkipVerifyField  = "TlsSkipVerify"
 	tokenField          = "Token"
 	workerFilterField   = "WorkerFilter"
+	authMethodField     = "AuthMethod"
+	authMountPathField  = "AuthMountPath"
+	authRoleField       = "AuthRole"
+	authSecretIdField   = "AuthSecretId"
 
 	// MappingOverrideField represents the field mask indicating a mapping override
 	// update has been requested.
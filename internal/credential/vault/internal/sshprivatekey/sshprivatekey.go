@@ -97,7 +97,9 @@ func defaultExtract(sd data, usernameAttr, privateKeyAttr, passphraseAttr string
 }
 
 // kv2Extract looks for the the usernameAttr and privateKeyAttr in the embedded
-// 'data' field within the data map.
+// 'data' field within the data map. Attributes with the CustomMetadataPrefix
+// are looked for in the 'custom_metadata' field of the embedded 'metadata'
+// field instead.
 //
 // Additionally it validates the data is in the expected KV-v2 format:
 //
@@ -141,21 +143,36 @@ func kv2Extract(sd data, usernameAttr, privateKeyAttr, passphraseAttr string) (
 		return "", nil, nil
 	}
 
-	if u, ok := data[usernameAttr]; ok {
-		if u, ok := u.(string); ok {
-			username = u
-		}
+	if u, ok := kv2Value(data, metadata, usernameAttr).(string); ok {
+		username = u
 	}
-	if p, ok := data[privateKeyAttr]; ok {
-		if p, ok := p.(string); ok {
-			privateKey = credential.PrivateKey(p)
-		}
+	if p, ok := kv2Value(data, metadata, privateKeyAttr).(string); ok {
+		privateKey = credential.PrivateKey(p)
 	}
-	if p, ok := data[passphraseAttr]; ok {
-		if p, ok := p.(string); ok {
-			passphrase = []byte(p)
-		}
+	if p, ok := kv2Value(data, metadata, passphraseAttr).(string); ok {
+		passphrase = []byte(p)
 	}
 
 	return username, privateKey, passphrase
 }
+
+// CustomMetadataPrefix is the prefix of an attribute name which refers to a
+// key in the custom_metadata of a KV-v2 secret instead of a key in its data.
+// For example, "custom_metadata.username" refers to the "username" key in
+// the custom_metadata of the secret.
+const CustomMetadataPrefix = "custom_metadata."
+
+// kv2Value returns the value of attr in data or, if attr has the
+// CustomMetadataPrefix, the value of the key in the custom_metadata field of
+// metadata. It returns nil if the value is not found.
+func kv2Value(data, metadata map[string]any, attr string) any {
+	key, ok := strings.CutPrefix(attr, CustomMetadataPrefix)
+	if !ok {
+		return data[attr]
+	}
+	cm, ok := metadata["custom_metadata"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	return cm[key]
+}
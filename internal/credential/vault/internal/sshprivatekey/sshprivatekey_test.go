@@ -145,6 +145,38 @@ func TestExtract(t *testing.T) {
 			},
 			want: sshpk{user: "user", privateKey: edKey, passphrase: nil},
 		},
+		{
+			name: "valid-kv2-custom-metadata-username",
+			given: args{
+				s: data{
+					"metadata": map[string]any{
+						"custom_metadata": map[string]any{
+							"ssh_user": "user",
+						},
+					},
+					"data": map[string]any{
+						"private_key": string(edKey),
+					},
+				},
+				uAttr:  "custom_metadata.ssh_user",
+				pkAttr: "private_key",
+			},
+			want: sshpk{user: "user", privateKey: edKey, passphrase: nil},
+		},
+		{
+			name: "no-match-custom-metadata-kv2",
+			given: args{
+				s: data{
+					"metadata": map[string]any{},
+					"data": map[string]any{
+						"private_key": string(edKey),
+					},
+				},
+				uAttr:  "custom_metadata.ssh_user",
+				pkAttr: "private_key",
+			},
+			want: sshpk{user: "", privateKey: nil, passphrase: nil},
+		},
 		{
 			name: "valid-kv2-with-passphrase",
 			given: args{
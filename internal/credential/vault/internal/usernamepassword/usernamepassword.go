@@ -82,7 +82,9 @@ func defaultExtract(sd data, usernameAttr, passwordAttr string) (username string
 }
 
 // kv2Extract looks for the the usernameAttr and passwordAttr in the embedded
-// 'data' field within the data map.
+// 'data' field within the data map. Attributes with the CustomMetadataPrefix
+// are looked for in the 'custom_metadata' field of the embedded 'metadata'
+// field instead.
 //
 // Additionally it validates the data is in the expected KV-v2 format:
 //
@@ -124,16 +126,33 @@ func kv2Extract(sd data, usernameAttr, passwordAttr string) (username string, pa
 		return "", ""
 	}
 
-	if u, ok := data[usernameAttr]; ok {
-		if u, ok := u.(string); ok {
-			username = u
-		}
+	if u, ok := kv2Value(data, metadata, usernameAttr).(string); ok {
+		username = u
 	}
-	if p, ok := data[passwordAttr]; ok {
-		if p, ok := p.(string); ok {
-			password = p
-		}
+	if p, ok := kv2Value(data, metadata, passwordAttr).(string); ok {
+		password = p
 	}
 
 	return username, password
 }
+
+// CustomMetadataPrefix is the prefix of an attribute name which refers to a
+// key in the custom_metadata of a KV-v2 secret instead of a key in its data.
+// For example, "custom_metadata.username" refers to the "username" key in
+// the custom_metadata of the secret.
+const CustomMetadataPrefix = "custom_metadata."
+
+// kv2Value returns the value of attr in data or, if attr has the
+// CustomMetadataPrefix, the value of the key in the custom_metadata field of
+// metadata. It returns nil if the value is not found.
+func kv2Value(data, metadata map[string]any, attr string) any {
+	key, ok := strings.CutPrefix(attr, CustomMetadataPrefix)
+	if !ok {
+		return data[attr]
+	}
+	cm, ok := metadata["custom_metadata"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	return cm[key]
+}
@@ -124,6 +124,58 @@ func TestBaseToUsrPass(t *testing.T) {
 			},
 			want: usrPass{user: "user", pass: "pass"},
 		},
+		{
+			name: "valid-kv2-custom-metadata-username",
+			given: args{
+				s: data{
+					"metadata": map[string]any{
+						"custom_metadata": map[string]any{
+							"db_user": "user",
+						},
+					},
+					"data": map[string]any{
+						"password": "pass",
+					},
+				},
+				uAttr: "custom_metadata.db_user",
+				pAttr: "password",
+			},
+			want: usrPass{user: "user", pass: "pass"},
+		},
+		{
+			name: "no-match-custom-metadata-kv2",
+			given: args{
+				s: data{
+					"metadata": map[string]any{
+						"custom_metadata": map[string]any{
+							"db_user": "user",
+						},
+					},
+					"data": map[string]any{
+						"password": "pass",
+					},
+				},
+				uAttr: "custom_metadata.username",
+				pAttr: "password",
+			},
+			want: usrPass{user: "", pass: ""},
+		},
+		{
+			name: "null-custom-metadata-kv2",
+			given: args{
+				s: data{
+					"metadata": map[string]any{
+						"custom_metadata": nil,
+					},
+					"data": map[string]any{
+						"password": "pass",
+					},
+				},
+				uAttr: "custom_metadata.username",
+				pAttr: "password",
+			},
+			want: usrPass{user: "", pass: ""},
+		},
 		{
 			name: "no-metadata-kv2",
 			given: args{
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 	vault "github.com/hashicorp/vault/api"
 	ua "go.uber.org/atomic"
 )
@@ -30,9 +31,10 @@ const (
 	renewalWindow    = 10 * time.Minute
 )
 
-func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms) error {
+func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) error {
 	const op = "vault.RegisterJobs"
-	tokenRenewal, err := newTokenRenewalJob(ctx, r, w, kms)
+	opts := getOpts(opt...)
+	tokenRenewal, err := newTokenRenewalJob(ctx, r, w, kms, WithJwtSigner(opts.withJwtSigner))
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
 	}
@@ -78,13 +80,16 @@ func RegisterJobs(ctx context.Context, scheduler *scheduler.Scheduler, r db.Read
 }
 
 // TokenRenewalJob is the recurring job that renews credential store Vault tokens that
-// are in the `current` and `maintaining` state.  The TokenRenewalJob is not thread safe,
-// an attempt to Run the job concurrently will result in an JobAlreadyRunning error.
+// are in the `current` and `maintaining` state. If the credential store has an auth
+// method, the job logs in to Vault again when the current token can no longer be
+// renewed. The TokenRenewalJob is not thread safe, an attempt to Run the job
+// concurrently will result in an JobAlreadyRunning error.
 type TokenRenewalJob struct {
-	reader db.Reader
-	writer db.Writer
-	kms    *kms.Kms
-	limit  int
+	reader    db.Reader
+	writer    db.Writer
+	kms       *kms.Kms
+	limit     int
+	jwtSigner *JwtSigner
 
 	running      ua.Bool
 	numTokens    int
@@ -93,7 +98,7 @@ type TokenRenewalJob struct {
 
 // newTokenRenewalJob creates a new in-memory TokenRenewalJob.
 //
-// WithLimit is the only supported option.
+// WithLimit and WithJwtSigner are the only supported options.
 func newTokenRenewalJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, opt ...Option) (*TokenRenewalJob, error) {
 	const op = "vault.newTokenRenewalJob"
 	switch {
@@ -111,10 +116,11 @@ func newTokenRenewalJob(ctx context.Context, r db.Reader, w db.Writer, kms *kms.
 		opts.withLimit = db.DefaultLimit
 	}
 	return &TokenRenewalJob{
-		reader: r,
-		writer: w,
-		kms:    kms,
-		limit:  opts.withLimit,
+		reader:    r,
+		writer:    w,
+		kms:       kms,
+		limit:     opts.withLimit,
+		jwtSigner: opts.withJwtSigner,
 	}, nil
 }
 
@@ -185,6 +191,15 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 		return nil
 	}
 
+	// Only the current token of a credential store with an auth method is
+	// replaced by logging in to Vault again
+	var am *AuthMethod
+	if s.TokenStatus == string(CurrentToken) {
+		if am, err = lookupAuthMethod(ctx, r.reader, databaseWrapper, s.PublicId); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+
 	vc, err := s.client(ctx)
 	if err != nil {
 		return errors.Wrap(ctx, err, op)
@@ -214,9 +229,16 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 			return errors.Wrap(ctx, err, op, errors.WithMsg("error updating credentials to revoked after revoking token"))
 		}
 
+		if am != nil {
+			return r.login(ctx, s, am, databaseWrapper)
+		}
 		return nil
 	}
 	if err != nil {
+		if am != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to renew vault token, logging in to vault", "credential store id", s.PublicId))
+			return r.login(ctx, s, am, databaseWrapper)
+		}
 		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
 	}
 
@@ -235,6 +257,53 @@ func (r *TokenRenewalJob) renewToken(ctx context.Context, s *clientStore) error
 		return errors.New(ctx, errors.Unknown, op, "token renewed but failed to update repo")
 	}
 
+	if am != nil && tokenExpires <= renewalWindow {
+		// The token has reached its max TTL and will expire before the
+		// next renewal, replace it with a new token.
+		return r.login(ctx, s, am, databaseWrapper)
+	}
+
+	return nil
+}
+
+// login logs in to Vault with the auth method am of the credential store s
+// and inserts the Vault token issued by Vault as the current token of the
+// credential store. The previous current token of the credential store is
+// changed to a maintaining token.
+func (r *TokenRenewalJob) login(ctx context.Context, s *clientStore, am *AuthMethod, cipher wrapping.Wrapper) error {
+	const op = "vault.(TokenRenewalJob).login"
+	vc, err := s.client(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	resp, err := am.login(ctx, r.reader, vc, r.jwtSigner, s.ProjectId)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault"))
+	}
+	tokenExpires, err := resp.TokenTTL()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token expiration"))
+	}
+	accessor, err := resp.TokenAccessor()
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token accessor"))
+	}
+	token, err := newToken(ctx, s.PublicId, TokenSecret(resp.Auth.ClientToken), []byte(accessor), tokenExpires)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := token.encrypt(ctx, cipher); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	query, values := token.insertQuery()
+	numRows, err := r.writer.Exec(ctx, query, values)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if numRows != 1 {
+		return errors.New(ctx, errors.Unknown, op, "logged in to vault but failed to insert token")
+	}
+	event.WriteSysEvent(ctx, op, "Vault credential store logged in to vault for a new current token", "credential store id", s.PublicId)
 	return nil
 }
 
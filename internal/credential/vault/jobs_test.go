@@ -110,7 +110,7 @@ func testVaultCred(t *testing.T,
 	var err error
 	switch Method(cl.HttpMethod) {
 	case MethodGet:
-		secret, err = client.get(ctx, cl.VaultPath)
+		secret, err = client.get(ctx, cl.VaultPath, nil)
 	case MethodPost:
 		secret, err = client.post(ctx, cl.VaultPath, cl.HttpRequestBody)
 	}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package vault
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/go-jose/go-jose/v3/jwt"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+const (
+	// jwtIssuer is the value of the iss claim of the JWTs signed by a
+	// JwtSigner.
+	jwtIssuer = "boundary"
+
+	// jwtDefaultAudience is the value of the aud claim of the JWTs signed by
+	// a JwtSigner created without WithJwtAudience.
+	jwtDefaultAudience = "vault"
+
+	// jwtTtl is how long a JWT signed by a JwtSigner is valid for. The JWT
+	// is only used to log in to Vault so it can be short lived.
+	jwtTtl = 5 * time.Minute
+)
+
+// A JwtSigner signs the JWTs a Vault credential store presents to the Vault
+// JWT auth method when logging in to Vault. The sub claim of a JWT is the
+// public id of the credential store, the iss claim is "boundary" and the aud
+// claim is the audience of the signer. The scope_id and project_id claims
+// are the ids of the org and the project of the credential store, so Vault
+// roles can bind them with bound_claims. The Vault JWT auth method must be
+// configured to validate JWTs with the public key of the signer, and its
+// roles must bind the audience with bound_audiences.
+type JwtSigner struct {
+	signer   jose.Signer
+	audience string
+}
+
+// jwtScopeClaims are the claims of a JWT identifying the scopes of the
+// credential store it is signed for.
+type jwtScopeClaims struct {
+	ScopeId   string `json:"scope_id"`
+	ProjectId string `json:"project_id"`
+}
+
+// NewJwtSigner creates a new JwtSigner from a PEM encoded private key. RSA,
+// ECDSA and Ed25519 private keys in PKCS #1, SEC 1 or PKCS #8 form are
+// supported. WithJwtAudience is the only valid option, the audience defaults
+// to "vault". All other options are ignored.
+func NewJwtSigner(ctx context.Context, pemKey []byte, opt ...Option) (*JwtSigner, error) {
+	const op = "vault.NewJwtSigner"
+	opts := getOpts(opt...)
+	audience := opts.withJwtAudience
+	if audience == "" {
+		audience = jwtDefaultAudience
+	}
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no PEM encoded private key")
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported PEM block type: %s", block.Type))
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+
+	var alg jose.SignatureAlgorithm
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		alg = jose.RS256
+	case *ecdsa.PrivateKey:
+		switch k.Curve {
+		case elliptic.P256():
+			alg = jose.ES256
+		case elliptic.P384():
+			alg = jose.ES384
+		case elliptic.P521():
+			alg = jose.ES512
+		default:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "unsupported elliptic curve")
+		}
+	case ed25519.PrivateKey:
+		alg = jose.EdDSA
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported private key type: %T", key))
+	}
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: alg, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+	return &JwtSigner{signer: signer, audience: audience}, nil
+}
+
+// sign returns a signed JWT for the credential store storeId in the project
+// projectId of the org orgId.
+func (s *JwtSigner) sign(ctx context.Context, storeId, orgId, projectId string) (string, error) {
+	const op = "vault.(JwtSigner).sign"
+	switch {
+	case storeId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no store id")
+	case orgId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no org id")
+	case projectId == "":
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no project id")
+	}
+	now := time.Now()
+	claims := jwt.Claims{
+		Issuer:    jwtIssuer,
+		Subject:   storeId,
+		Audience:  jwt.Audience{s.audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		NotBefore: jwt.NewNumericDate(now),
+		Expiry:    jwt.NewNumericDate(now.Add(jwtTtl)),
+	}
+	scopeClaims := jwtScopeClaims{
+		ScopeId:   orgId,
+		ProjectId: projectId,
+	}
+	token, err := jwt.Signed(s.signer).Claims(claims).Claims(scopeClaims).CompactSerialize()
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithCode(errors.Encrypt))
+	}
+	return token, nil
+}
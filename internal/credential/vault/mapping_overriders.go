@@ -41,7 +41,9 @@ type MappingOverride interface {
 
 // A UsernamePasswordOverride contains optional values for overriding the
 // default mappings used to map a Vault secret to a UsernamePassword credential
-// type for the credential library that owns it.
+// type for the credential library that owns it. When the secret is read from
+// a KV v2 secrets engine, an attribute prefixed with "custom_metadata." maps
+// to a key in the custom metadata of the secret.
 type UsernamePasswordOverride struct {
 	*store.UsernamePasswordOverride
 	tableName string `gorm:"-"`
@@ -104,7 +106,9 @@ func (o *UsernamePasswordOverride) SetTableName(n string) {
 
 // A SshPrivateKeyOverride contains optional values for overriding the
 // default mappings used to map a Vault secret to a SshPrivateKey credential
-// type for the credential library that owns it.
+// type for the credential library that owns it. When the secret is read from
+// a KV v2 secrets engine, an attribute prefixed with "custom_metadata." maps
+// to a key in the custom metadata of the secret.
 type SshPrivateKeyOverride struct {
 	*store.SshPrivateKeyOverride
 	tableName string `gorm:"-"`
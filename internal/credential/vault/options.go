@@ -28,8 +28,13 @@ type options struct {
 	withTlsSkipVerify  bool
 	withWorkerFilter   string
 	withClientCert     *ClientCertificate
+	withAuthMethod     *AuthMethod
+	withAuthMountPath  string
+	withJwtSigner      *JwtSigner
+	withJwtAudience    string
 	withMethod         Method
 	withRequestBody    []byte
+	withSecretVersion  uint32
 	withCredentialType globals.CredentialType
 
 	withOverrideUsernameAttribute             string
@@ -122,6 +127,38 @@ func WithClientCert(clientCert *ClientCertificate) Option {
 	}
 }
 
+// WithAuthMethod provides an optional AuthMethod a credential store uses
+// to log in to Vault to obtain a Vault token.
+func WithAuthMethod(am *AuthMethod) Option {
+	return func(o *options) {
+		o.withAuthMethod = am
+	}
+}
+
+// WithAuthMountPath provides an optional path the Vault auth method is
+// mounted at.
+func WithAuthMountPath(p string) Option {
+	return func(o *options) {
+		o.withAuthMountPath = p
+	}
+}
+
+// WithJwtSigner provides an optional JwtSigner used to sign the JWTs
+// presented to Vault by credential stores using the JWT auth method.
+func WithJwtSigner(s *JwtSigner) Option {
+	return func(o *options) {
+		o.withJwtSigner = s
+	}
+}
+
+// WithJwtAudience provides an optional audience for the aud claim of the JWTs
+// signed by a JwtSigner.
+func WithJwtAudience(aud string) Option {
+	return func(o *options) {
+		o.withJwtAudience = aud
+	}
+}
+
 // WithMethod provides an optional Method to use for communicating with
 // Vault.
 func WithMethod(m Method) Option {
@@ -138,6 +175,14 @@ func WithRequestBody(b []byte) Option {
 	}
 }
 
+// WithSecretVersion provides an optional version of a KV v2 secret to
+// read when requesting credentials using HTTP Get.
+func WithSecretVersion(v uint32) Option {
+	return func(o *options) {
+		o.withSecretVersion = v
+	}
+}
+
 // WithCredentialType provides an optional credential type to associate
 // with a credential library.
 func WithCredentialType(t globals.CredentialType) Option {
@@ -94,6 +94,12 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withRequestBody = []byte("body")
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithSecretVersion", func(t *testing.T) {
+		opts := getOpts(WithSecretVersion(2))
+		testOpts := getDefaultOptions()
+		testOpts.withSecretVersion = 2
+		assert.Equal(t, opts, testOpts)
+	})
 	t.Run("WithCredentialType", func(t *testing.T) {
 		opts := getOpts(WithCredentialType(globals.UsernamePasswordCredentialType))
 		testOpts := getDefaultOptions()
@@ -131,4 +137,30 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.withMappingOverride = unknownMapper(1)
 		assert.Equal(t, opts, testOpts)
 	})
+	t.Run("WithAuthMethod", func(t *testing.T) {
+		am := NewAuthMethod(JwtAuthMethod, "boundary", nil)
+		opts := getOpts(WithAuthMethod(am))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthMethod = am
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithAuthMountPath", func(t *testing.T) {
+		opts := getOpts(WithAuthMountPath("test"))
+		testOpts := getDefaultOptions()
+		testOpts.withAuthMountPath = "test"
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithJwtSigner", func(t *testing.T) {
+		signer := &JwtSigner{}
+		opts := getOpts(WithJwtSigner(signer))
+		testOpts := getDefaultOptions()
+		testOpts.withJwtSigner = signer
+		assert.Equal(t, opts, testOpts)
+	})
+	t.Run("WithJwtAudience", func(t *testing.T) {
+		opts := getOpts(WithJwtAudience("vault"))
+		testOpts := getDefaultOptions()
+		testOpts.withJwtAudience = "vault"
+		assert.Equal(t, opts, testOpts)
+	})
 }
@@ -17,6 +17,7 @@ import (
 	"encoding/pem"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -190,6 +191,7 @@ type genericIssuingCredentialLibrary struct {
 	VaultPath                     string
 	HttpMethod                    string
 	HttpRequestBody               []byte
+	SecretVersion                 uint32
 	CredType                      string
 	ProjectId                     string
 	VaultAddress                  string
@@ -234,6 +236,7 @@ func (pl *genericIssuingCredentialLibrary) clone() *genericIssuingCredentialLibr
 		VaultPath:                     pl.VaultPath,
 		HttpMethod:                    pl.HttpMethod,
 		HttpRequestBody:               append(pl.HttpRequestBody[:0:0], pl.HttpRequestBody...),
+		SecretVersion:                 pl.SecretVersion,
 		VaultAddress:                  pl.VaultAddress,
 		Namespace:                     pl.Namespace,
 		CaCert:                        append(pl.CaCert[:0:0], pl.CaCert...),
@@ -358,7 +361,12 @@ func (pl *genericIssuingCredentialLibrary) retrieveCredential(ctx context.Contex
 
 	switch Method(pl.HttpMethod) {
 	case MethodGet:
-		secret, reqErr = client.get(ctx, path)
+		var params map[string][]string
+		if pl.SecretVersion != 0 {
+			// Select a specific version of a KV v2 secret.
+			params = map[string][]string{"version": {strconv.FormatUint(uint64(pl.SecretVersion), 10)}}
+		}
+		secret, reqErr = client.get(ctx, path, params)
 	case MethodPost:
 		secret, reqErr = client.post(ctx, path, []byte(body))
 	default:
@@ -471,6 +479,7 @@ type privateCredentialLibraryAllTypes struct {
 	VaultPath                     string
 	HttpMethod                    string
 	HttpRequestBody               []byte
+	SecretVersion                 uint32
 	CredType                      string `gorm:"column:credential_type"`
 	ProjectId                     string
 	VaultAddress                  string
@@ -563,6 +572,7 @@ func (pl *privateCredentialLibraryAllTypes) clone() *privateCredentialLibraryAll
 		VaultPath:                     pl.VaultPath,
 		HttpMethod:                    pl.HttpMethod,
 		HttpRequestBody:               append(pl.HttpRequestBody[:0:0], pl.HttpRequestBody...),
+		SecretVersion:                 pl.SecretVersion,
 		VaultAddress:                  pl.VaultAddress,
 		Namespace:                     pl.Namespace,
 		CaCert:                        append(pl.CaCert[:0:0], pl.CaCert...),
@@ -646,6 +656,7 @@ func (pl *privateCredentialLibraryAllTypes) toTypedIssuingCredentialLibrary() is
 			VaultPath:                     pl.VaultPath,
 			HttpMethod:                    pl.HttpMethod,
 			HttpRequestBody:               pl.HttpRequestBody,
+			SecretVersion:                 pl.SecretVersion,
 			VaultAddress:                  pl.VaultAddress,
 			Namespace:                     pl.Namespace,
 			CaCert:                        pl.CaCert,
@@ -90,6 +90,26 @@ delete from credential_vault_client_certificate
  where store_id = ?;
 `
 
+	upsertAuthMethodQuery = `
+insert into credential_vault_store_auth_method
+  (store_id, method, mount_path, role, secret_id, secret_id_hmac, key_id)
+values
+  (@store_id, @method, @mount_path, @role, @secret_id, @secret_id_hmac, @key_id)
+on conflict (store_id) do update
+  set method         = excluded.method,
+      mount_path     = excluded.mount_path,
+      role           = excluded.role,
+      secret_id      = excluded.secret_id,
+      secret_id_hmac = excluded.secret_id_hmac,
+      key_id         = excluded.key_id
+returning *;
+`
+
+	deleteAuthMethodQuery = `
+delete from credential_vault_store_auth_method
+ where store_id = ?;
+`
+
 	selectLibrariesQuery = `
 select *
   from credential_vault_library_issue_credentials
@@ -230,6 +250,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         secret_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -253,6 +274,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as secret_version,    -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -301,6 +323,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         secret_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -324,6 +347,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as secret_version,    -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -372,6 +396,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         secret_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -395,6 +420,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as secret_version,    -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -444,6 +470,7 @@ final as (
          credential_type,
          http_method,
          http_request_body,
+         secret_version,
          null as username,                     -- Add to make union uniform
          null as key_type,                     -- Add to make union uniform
          null as key_bits,                     -- Add to make union uniform
@@ -467,6 +494,7 @@ final as (
          credential_type,
          null as http_method,       -- Add to make union uniform
          null as http_request_body, -- Add to make union uniform
+         null as secret_version,    -- Add to make union uniform
          username,
          key_type,
          key_bits,
@@ -481,5 +509,12 @@ final as (
   select *
     from final
 order by update_time desc, public_id desc;
+`
+
+	lookupProjectOrgQuery = `
+select parent_id
+  from iam_scope
+ where public_id = @public_id
+   and type = 'project';
 `
 )
@@ -43,5 +43,14 @@ func (credentialHooks) NewStore(ctx context.Context, result *credential.StoreLis
 		s.clientCert.CertificateKeyHmac = result.ClientCertKeyHmac
 	}
 
+	if result.AuthMethod != "" {
+		s.authMethod = allocAuthMethod()
+		s.authMethod.StoreId = result.PublicId
+		s.authMethod.Method = result.AuthMethod
+		s.authMethod.MountPath = result.AuthMountPath
+		s.authMethod.Role = result.AuthRole
+		s.authMethod.SecretIdHmac = result.AuthSecretIdHmac
+	}
+
 	return s, nil
 }
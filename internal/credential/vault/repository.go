@@ -22,12 +22,17 @@ type Repository struct {
 	// defaultLimit provides a default for limiting the number of results
 	// returned from the repo
 	defaultLimit int
+	// jwtSigner signs the JWTs used by credential stores logging in to
+	// Vault with the JWT auth method
+	jwtSigner *JwtSigner
 }
 
 // NewRepository creates a new Repository. The returned repository should
 // only be used for one transaction and it is not safe for concurrent go
 // routines to access it. WithLimit option is used as a repo wide default
-// limit applied to all ListX methods.
+// limit applied to all ListX methods. WithJwtSigner option is used to sign
+// the JWTs presented to Vault by credential stores using the JWT auth
+// method.
 func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, scheduler *scheduler.Scheduler, opt ...Option) (*Repository, error) {
 	const op = "vault.NewRepository"
 	switch {
@@ -53,5 +58,6 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		kms:          kms,
 		scheduler:    scheduler,
 		defaultLimit: opts.withLimit,
+		jwtSigner:    opts.withJwtSigner,
 	}, nil
 }
@@ -132,6 +132,37 @@ func TestRepository_CreateCredentialLibrary(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid-with-secret-version",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:       cs.GetPublicId(),
+					HttpMethod:    "GET",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 2,
+				},
+			},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:       cs.GetPublicId(),
+					HttpMethod:    "GET",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 2,
+				},
+			},
+		},
+		{
+			name: "invalid-POST-method-with-secret-version",
+			in: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					StoreId:       cs.GetPublicId(),
+					HttpMethod:    "POST",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 2,
+				},
+			},
+			wantErr: errors.InvalidParameter,
+		},
 		{
 			name: "valid-POST-method",
 			in: &CredentialLibrary{
@@ -595,6 +626,13 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 		}
 	}
 
+	changeSecretVersion := func(v uint32) func(*CredentialLibrary) *CredentialLibrary {
+		return func(l *CredentialLibrary) *CredentialLibrary {
+			l.SecretVersion = v
+			return l
+		}
+	}
+
 	changeHttpMethod := func(m Method) func(*CredentialLibrary) *CredentialLibrary {
 		return func(l *CredentialLibrary) *CredentialLibrary {
 			l.HttpMethod = string(m)
@@ -1083,6 +1121,58 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 			},
 			wantCount: 1,
 		},
+		{
+			name: "change-secret-version",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:    "GET",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 1,
+				},
+			},
+			chgFn: changeSecretVersion(2),
+			masks: []string{secretVersionField},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:    "GET",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 2,
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "delete-secret-version",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:    "GET",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 1,
+				},
+			},
+			chgFn: changeSecretVersion(0),
+			masks: []string{secretVersionField},
+			want: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod: "GET",
+					VaultPath:  "/secret/data/my-secret",
+				},
+			},
+			wantCount: 1,
+		},
+		{
+			name: "change-method-to-POST-leave-secret-version",
+			orig: &CredentialLibrary{
+				CredentialLibrary: &store.CredentialLibrary{
+					HttpMethod:    "GET",
+					VaultPath:     "/secret/data/my-secret",
+					SecretVersion: 1,
+				},
+			},
+			chgFn:   changeHttpMethod(MethodPost),
+			masks:   []string{httpMethodField},
+			wantErr: errors.CheckConstraint,
+		},
 		{
 			name: "read-only-credential-type-in-field-mask",
 			orig: &CredentialLibrary{
@@ -1523,6 +1613,13 @@ func TestRepository_UpdateCredentialLibrary(t *testing.T) {
 				assert.Equal(tt.want.Description, got.Description)
 			}
 
+			switch tt.want.SecretVersion {
+			case 0:
+				dbassert.IsNull(got, "secret_version")
+			default:
+				assert.Equal(tt.want.SecretVersion, got.SecretVersion)
+			}
+
 			if tt.wantCount > 0 {
 				assert.NoError(db.TestVerifyOplog(t, rw, got.GetPublicId(), db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
 			}
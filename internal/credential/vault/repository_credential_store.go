@@ -42,6 +42,13 @@ import (
 // CreateCredentialStore see:
 // https://www.vaultproject.io/api-docs/auth/token#renew-a-token-self and
 // https://www.vaultproject.io/api-docs/auth/token#lookup-a-token-self.
+//
+// Instead of a Vault token, cs can contain an AuthMethod. The credential
+// store then logs in to Vault with the AppRole or JWT auth method to obtain
+// its Vault token and logs in again whenever the token can no longer be
+// renewed. The Vault token issued by the login does not have to be periodic
+// or orphan. A credential store using the JWT auth method requires the
+// repository to have a JwtSigner.
 func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialStore, _ ...Option) (*CredentialStore, error) {
 	const op = "vault.(Repository).CreateCredentialStore"
 	if cs == nil {
@@ -53,8 +60,11 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.ProjectId == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no project id")
 	}
-	if len(cs.inputToken) == 0 {
-		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault token")
+	if len(cs.inputToken) == 0 && cs.authMethod == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault token or auth method")
+	}
+	if len(cs.inputToken) != 0 && cs.authMethod != nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "vault token and auth method are mutually exclusive")
 	}
 	if cs.VaultAddress == "" {
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "no vault address")
@@ -76,17 +86,34 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 	if cs.clientCert != nil {
 		cs.clientCert.StoreId = id
 	}
+	if cs.authMethod != nil {
+		cs.authMethod.StoreId = id
+		if err := cs.authMethod.validate(ctx); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	client, err := cs.client(ctx)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create vault client"))
 	}
-	tokenLookup, err := client.lookupToken(ctx)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup vault token"))
-	}
-	if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
-		return nil, err
+	// loginResp is only set if the credential store logs in to Vault
+	var loginResp *vault.Secret
+	switch {
+	case cs.authMethod != nil:
+		loginResp, err = cs.authMethod.login(ctx, r.reader, client, r.jwtSigner, cs.ProjectId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault"))
+		}
+		cs.inputToken = TokenSecret(loginResp.Auth.ClientToken)
+	default:
+		tokenLookup, err := client.lookupToken(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to lookup vault token"))
+		}
+		if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
+			return nil, err
+		}
 	}
 
 	available, err := client.capabilities(ctx, requiredCapabilities.paths())
@@ -99,9 +126,12 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 			errors.New(ctx, errors.VaultTokenMissingCapabilities, op, fmt.Sprintf("missing capabilities: %v", missing))
 	}
 
-	renewedToken, err := client.renewToken(ctx)
-	if err != nil {
-		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
+	renewedToken := loginResp
+	if renewedToken == nil {
+		renewedToken, err = client.renewToken(ctx)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
+		}
 	}
 
 	tokenExpires, err := renewedToken.TokenTTL()
@@ -142,13 +172,18 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 			return nil, errors.Wrap(ctx, err, op)
 		}
 	}
+	if cs.authMethod != nil {
+		if err := cs.authMethod.encrypt(ctx, databaseWrapper); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
 
 	var newToken *Token
 	var newClientCertificate *ClientCertificate
 	var newCredentialStore *CredentialStore
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(_ db.Reader, w db.Writer) error {
-			msgs := make([]*oplog.Message, 0, 3)
+			msgs := make([]*oplog.Message, 0, 4)
 			ticket, err := w.GetTicket(ctx, cs)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
@@ -193,6 +228,24 @@ func (r *Repository) CreateCredentialStore(ctx context.Context, cs *CredentialSt
 				newCredentialStore.clientCert = newClientCertificate
 
 			}
+
+			// insert auth method (if exists)
+			if cs.authMethod != nil {
+				newAuthMethod := cs.authMethod.clone()
+				query, values := newAuthMethod.insertQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been created")
+				}
+				msgs = append(msgs, newAuthMethod.oplogMessage(db.CreateOp))
+
+				newAuthMethod.SecretId = nil
+				newAuthMethod.CtSecretId = nil
+				newCredentialStore.authMethod = newAuthMethod
+			}
 			metadata := cs.oplog(oplog.OpType_OP_TYPE_CREATE)
 			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
@@ -286,6 +339,10 @@ type listLookupStore struct {
 	TokenStatus       string
 	ClientCert        []byte
 	ClientCertKeyHmac []byte
+	AuthMethod        string
+	AuthMountPath     string
+	AuthRole          string
+	AuthSecretIdHmac  []byte
 }
 
 func allocListLookupStore() *listLookupStore {
@@ -319,6 +376,16 @@ func (ps *listLookupStore) toCredentialStore() *CredentialStore {
 		cert.CertificateKeyHmac = ps.ClientCertKeyHmac
 		cs.clientCert = cert
 	}
+
+	if ps.AuthMethod != "" {
+		am := allocAuthMethod()
+		am.StoreId = ps.PublicId
+		am.Method = ps.AuthMethod
+		am.MountPath = ps.AuthMountPath
+		am.Role = ps.AuthRole
+		am.SecretIdHmac = ps.AuthSecretIdHmac
+		cs.authMethod = am
+	}
 	return cs
 }
 
@@ -335,12 +402,20 @@ func (ps *listLookupStore) GetPublicId() string { return ps.PublicId }
 //
 // cs must contain a valid PublicId. Only Name, Description, Namespace,
 // TlsServerName, TlsSkipVerify, CaCert, VaultAddress, ClientCertificate,
-// ClientCertificateKey, workerFilter, and Token can be changed. If cs.Name is set to a
+// ClientCertificateKey, workerFilter, Token, AuthMethod, AuthMountPath,
+// AuthRole, and AuthSecretId can be changed. If cs.Name is set to a
 // non-empty string, it must be unique within cs.Projectid. If Token is changed,
 // the new token must have the same properties defined in CreateCredentialStore
 // and UpdateCredentialStore calls the same Vault endpoints described in
 // CreateCredentialStore.
 //
+// If the auth method of the credential store or its VaultAddress is
+// changed, the credential store logs in to Vault again with the updated
+// auth method to obtain a new Vault token. Token cannot be changed on a
+// credential store with an auth method. Setting AuthMethod to NULL removes
+// the auth method from the credential store, the current Vault token is
+// kept but the credential store no longer logs in to Vault.
+//
 // An attribute of cs will be set to NULL in the database if the attribute
 // in cs is the zero value and it is included in fieldMaskPaths.
 func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialStore, version uint32, fieldMaskPaths []string, _ ...Option) (*CredentialStore, int, error) {
@@ -376,6 +451,10 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 			validateToken = true
 		case strings.EqualFold(certificateField, f):
 		case strings.EqualFold(certificateKeyField, f):
+		case strings.EqualFold(authMethodField, f):
+		case strings.EqualFold(authMountPathField, f):
+		case strings.EqualFold(authRoleField, f):
+		case strings.EqualFold(authSecretIdField, f):
 		case strings.EqualFold(tokenField, f):
 			if len(cs.inputToken) != 0 {
 				updateToken = true
@@ -417,7 +496,22 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if len(certNullFields) != 0 && len(certNullFields) != 2 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "attempting to unset a required field on a client cert")
 	}
-	if len(append(dbMask, certDbMask...)) == 0 && len(append(nullFields, certNullFields...)) == 0 {
+	var authMethod, authMountPath, authRole string
+	var authSecretId []byte
+	if am := cs.AuthMethod(); am != nil {
+		authMethod, authMountPath, authRole, authSecretId = am.GetMethod(), am.GetMountPath(), am.GetRole(), am.GetSecretId()
+	}
+	authDbMask, authNullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			authMethodField:    authMethod,
+			authMountPathField: authMountPath,
+			authRoleField:      authRole,
+			authSecretIdField:  authSecretId,
+		},
+		fieldMaskPaths, nil,
+	)
+	updateAuth := len(authDbMask) > 0 || len(authNullFields) > 0
+	if len(append(dbMask, certDbMask...)) == 0 && len(append(nullFields, certNullFields...)) == 0 && !updateAuth {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "missing field mask")
 	}
 
@@ -464,6 +558,9 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("can't recreate client certificate for vault client creation"))
 	}
+	if origStore.authMethod, err = lookupAuthMethod(ctx, r.reader, databaseWrapper, cs.GetPublicId()); err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
 	updatedStore := origStore.applyUpdate(cs, fieldMaskPaths)
 
 	if len(certDbMask) > 0 && updatedStore.clientCert != nil {
@@ -471,19 +568,45 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 		}
 	}
+	if updatedStore.authMethod != nil {
+		if updateToken {
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "vault token and auth method are mutually exclusive")
+		}
+		if updateAuth {
+			if err := updatedStore.authMethod.validate(ctx); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
+			if err := updatedStore.authMethod.encrypt(ctx, databaseWrapper); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
+			validateToken = true
+		}
+	}
 
 	var token *Token
 	client, err := updatedStore.client(ctx)
 	if err != nil {
 		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get client for updated store"))
 	}
+	// loginResp is only set if the updated store logs in to Vault
+	var loginResp *vault.Secret
 	if validateToken {
-		tokenLookup, err := client.lookupToken(ctx)
-		if err != nil {
-			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("cannot lookup token for updated store"))
-		}
-		if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
-			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+		switch {
+		case updatedStore.authMethod != nil:
+			loginResp, err = updatedStore.authMethod.login(ctx, r.reader, client, r.jwtSigner, updatedStore.ProjectId)
+			if err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to log in to vault for updated store"))
+			}
+			updatedStore.inputToken = TokenSecret(loginResp.Auth.ClientToken)
+			updateToken = true
+		default:
+			tokenLookup, err := client.lookupToken(ctx)
+			if err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("cannot lookup token for updated store"))
+			}
+			if err := validateTokenLookup(ctx, op, tokenLookup); err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+			}
 		}
 
 		available, err := client.capabilities(ctx, requiredCapabilities.paths())
@@ -498,9 +621,12 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 		}
 	}
 	if updateToken {
-		renewedToken, err := client.renewToken(ctx)
-		if err != nil {
-			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
+		renewedToken := loginResp
+		if renewedToken == nil {
+			renewedToken, err = client.renewToken(ctx)
+			if err != nil {
+				return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to renew vault token"))
+			}
 		}
 		tokenExpires, err := renewedToken.TokenTTL()
 		if err != nil {
@@ -510,7 +636,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 		if err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get vault token accessor"))
 		}
-		if token, err = newToken(ctx, cs.GetPublicId(), cs.inputToken, []byte(accessor), tokenExpires); err != nil {
+		if token, err = newToken(ctx, cs.GetPublicId(), updatedStore.inputToken, []byte(accessor), tokenExpires); err != nil {
 			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
 		}
 		runJobsInterval := r.scheduler.GetRunJobsInterval()
@@ -527,7 +653,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 	var returnedCredentialStore *CredentialStore
 	_, err = r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{},
 		func(reader db.Reader, w db.Writer) error {
-			msgs := make([]*oplog.Message, 0, 3)
+			msgs := make([]*oplog.Message, 0, 4)
 			ticket, err := w.GetTicket(ctx, cs)
 			if err != nil {
 				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
@@ -539,7 +665,7 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 			switch {
 			case len(filteredDbMask) == 0 && len(filteredNullFields) == 0:
 				// the credential store's fields are not being updated,
-				// just it's token or client certificate, so we need to
+				// just it's token, client certificate or auth method, so we need to
 				// just update the credential store's version.
 				cs.Version = version + 1
 				rowsUpdated, err = w.Update(ctx, cs, []string{"Version"}, nil, db.NewOplogMsg(&csOplogMsg), db.WithVersion(&version))
@@ -600,6 +726,33 @@ func (r *Repository) UpdateCredentialStore(ctx context.Context, cs *CredentialSt
 				}
 			}
 
+			switch {
+			case !updateAuth:
+			case updatedStore.authMethod == nil && origStore.authMethod != nil:
+				// Delete the auth method
+				deleteAuthMethod := allocAuthMethod()
+				deleteAuthMethod.StoreId = cs.GetPublicId()
+				query, values := deleteAuthMethod.deleteQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been deleted")
+				}
+				msgs = append(msgs, deleteAuthMethod.oplogMessage(db.DeleteOp))
+			case updatedStore.authMethod != nil:
+				query, values := updatedStore.authMethod.insertQuery()
+				rows, err := w.Exec(ctx, query, values)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to upsert auth method"))
+				}
+				if rows > 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, "more than 1 auth method would have been upserted")
+				}
+				msgs = append(msgs, updatedStore.authMethod.oplogMessage(db.UpdateOp))
+			}
+
 			if updateToken {
 				query, values := token.insertQuery()
 				rows, err := w.Exec(ctx, query, values)
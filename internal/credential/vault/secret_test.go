@@ -133,3 +133,64 @@ func TestKeySecret_MarshalJSON(t *testing.T) {
 		assert.Equal(testB, sec.B)
 	})
 }
+
+func TestSecretIdSecret_String(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert := assert.New(t)
+		const want = redactedSecretIdSecret
+		tk := SecretIdSecret("our secret")
+		assert.Equalf(want, tk.String(), "SecretIdSecret.String() = %v, want %v", tk.String(), want)
+
+		// Verify stringer is called
+		s := fmt.Sprintf("%s", tk)
+		assert.Equalf(want, s, "SecretIdSecret.String() = %v, want %v", s, want)
+	})
+}
+
+func TestSecretIdSecret_GoString(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert := assert.New(t)
+		const want = redactedSecretIdSecret
+		tk := SecretIdSecret("private secret")
+		assert.Equalf(want, tk.GoString(), "SecretIdSecret.GoString() = %v, want %v", tk.GoString(), want)
+
+		// Verify gostringer is called
+		s := fmt.Sprintf("%#v", tk)
+		assert.Equalf(want, s, "SecretIdSecret.GoString() = %v, want %v", s, want)
+	})
+}
+
+func TestSecretIdSecret_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	t.Run("redacted", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		want, err := json.Marshal([]byte(redactedSecretIdSecret))
+		require.NoError(err)
+		tk := SecretIdSecret("hidden secret")
+		got, err := tk.MarshalJSON()
+		require.NoError(err)
+		assert.Equalf(want, got, "SecretIdSecret.MarshalJSON() = %s, want %s", got, want)
+	})
+	t.Run("within-struct", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		want := fmt.Sprintf(`%s`, redactedSecretIdSecret)
+
+		type secretContainer struct {
+			S SecretIdSecret
+			B []byte
+		}
+		testB := []byte("secure secret")
+		secret := secretContainer{S: testB, B: testB}
+
+		m, err := json.Marshal(secret)
+		require.NoError(err)
+
+		var sec secretContainer
+		err = json.Unmarshal(m, &sec)
+		require.NoError(err)
+		assert.Equal(SecretIdSecret(want), sec.S)
+		assert.Equal(testB, sec.B)
+	})
+}
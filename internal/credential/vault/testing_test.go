@@ -536,7 +536,7 @@ func TestTestVaultServer_LookupLease(t *testing.T) {
 
 	// Create secret
 	credPath := path.Join("database", "creds", "opened")
-	cred, err := client.get(ctx, credPath)
+	cred, err := client.get(ctx, credPath, nil)
 	require.NoError(err)
 
 	// Sleep to move ttl
@@ -627,7 +627,7 @@ func TestTestVaultServer_AddKVPolicy(t *testing.T) {
 		require.NotNil(token)
 		client := v.ClientUsingToken(t, token)
 
-		_, err := client.get(ctx, "/secret/data/my-secret")
+		_, err := client.get(ctx, "/secret/data/my-secret", nil)
 		assert.Error(err)
 
 		// An attempt to get my-secret should now fail with a 403
@@ -642,7 +642,7 @@ func TestTestVaultServer_AddKVPolicy(t *testing.T) {
 		require.NotNil(token)
 		client = v.ClientUsingToken(t, token)
 
-		_, err = client.get(ctx, "/secret/data/my-secret")
+		_, err = client.get(ctx, "/secret/data/my-secret", nil)
 		assert.NoError(err)
 	})
 }
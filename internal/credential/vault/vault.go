@@ -26,8 +26,9 @@ type vaultClient interface {
 	renewLease(context.Context, string, time.Duration) (*vault.Secret, error)
 	revokeLease(context.Context, string) error
 	lookupToken(context.Context) (*vault.Secret, error)
+	login(context.Context, string, map[string]any) (*vault.Secret, error)
 	swapToken(context.Context, TokenSecret) (old TokenSecret)
-	get(context.Context, string) (*vault.Secret, error)
+	get(context.Context, string, map[string][]string) (*vault.Secret, error)
 	post(context.Context, string, []byte) (*vault.Secret, error)
 	capabilities(context.Context, []string) (pathCapabilities, error)
 	headers(ctx context.Context) (http.Header, error)
@@ -55,8 +56,10 @@ type clientConfig struct {
 	Namespace     string `json:"namespace"`
 }
 
+// isValid reports whether c can be used to create a client. A client
+// without a token can only be used to log in to Vault.
 func (c *clientConfig) isValid() bool {
-	if c == nil || c.Addr == "" || len(c.Token) == 0 {
+	if c == nil || c.Addr == "" {
 		return false
 	}
 	return true
@@ -210,6 +213,30 @@ func (c *client) lookupToken(ctx context.Context) (*vault.Secret, error) {
 	return t, nil
 }
 
+// login sends the login request data to the login endpoint at path of a
+// Vault auth method and returns the vault.Secret response. The login
+// request is sent without a token. On success, the token in the Vault
+// client is replaced with the token returned by Vault. See
+// https://developer.hashicorp.com/vault/api-docs/auth/approle#login-with-approle
+// and https://developer.hashicorp.com/vault/api-docs/auth/jwt#jwt-login.
+func (c *client) login(ctx context.Context, path string, data map[string]any) (*vault.Secret, error) {
+	const op = "vault.(client).login"
+	old := c.cl.Token()
+	c.cl.ClearToken()
+	s, err := c.cl.Logical().WriteWithContext(ctx, path, data)
+	if err != nil {
+		c.cl.SetToken(old)
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.Unknown), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
+	}
+	if s == nil || s.Auth == nil || s.Auth.ClientToken == "" {
+		c.cl.SetToken(old)
+		return nil, errors.New(ctx, errors.Unknown, op, fmt.Sprintf("no token returned from login: vault: %s", c.cl.Address()))
+	}
+	c.cl.SetToken(s.Auth.ClientToken)
+	c.token = TokenSecret(s.Auth.ClientToken)
+	return s, nil
+}
+
 // swapToken replaces the token in the Vault client with t and returns the
 // token that was replaced.
 func (c *client) swapToken(ctx context.Context, new TokenSecret) (old TokenSecret) {
@@ -218,9 +245,11 @@ func (c *client) swapToken(ctx context.Context, new TokenSecret) (old TokenSecre
 	return
 }
 
-func (c *client) get(ctx context.Context, path string) (*vault.Secret, error) {
+// get sends a GET request to path. params are added as query parameters to
+// the request.
+func (c *client) get(ctx context.Context, path string, params map[string][]string) (*vault.Secret, error) {
 	const op = "vault.(client).get"
-	s, err := c.cl.Logical().Read(path)
+	s, err := c.cl.Logical().ReadWithData(path, params)
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.VaultCredentialRequest), errors.WithMsg(fmt.Sprintf("vault: %s", c.cl.Address())))
 	}
@@ -187,7 +187,7 @@ func TestClient_Get(t *testing.T) {
 
 	client := v.client(t)
 
-	cred, err := client.get(ctx, path.Join("database", "creds", "opened"))
+	cred, err := client.get(ctx, path.Join("database", "creds", "opened"), nil)
 	assert.NoError(err)
 	assert.NotNil(cred)
 }
@@ -226,7 +226,7 @@ func TestClient_RenewLease(t *testing.T) {
 	client := v.ClientUsingToken(t, token)
 
 	// Create secret
-	cred, err := client.get(ctx, path.Join("database", "creds", "opened"))
+	cred, err := client.get(ctx, path.Join("database", "creds", "opened"), nil)
 	assert.NoError(err)
 	require.NotNil(cred)
 
@@ -303,7 +303,7 @@ func TestClient_revokeLease(t *testing.T) {
 	_, token := v.CreateToken(t, WithPolicies([]string{"boundary-controller", "database"}))
 	client := v.ClientUsingToken(t, token)
 
-	cred, err := client.get(ctx, path.Join("database", "creds", "opened"))
+	cred, err := client.get(ctx, path.Join("database", "creds", "opened"), nil)
 	assert.NoError(err)
 	require.NotNil(cred)
 
@@ -6,6 +6,7 @@ package handlers
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -18,6 +19,8 @@ import (
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
+	targethttp "github.com/hashicorp/boundary/internal/target/http"
+	targetpostgres "github.com/hashicorp/boundary/internal/target/postgres"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
 	"github.com/hashicorp/boundary/sdk/pbs/plugin"
@@ -55,10 +58,10 @@ var (
 
 	// getProtocolContext populates the protocol specific context fields
 	// depending on the protocol used to for the boundary connection. Defaults
-	// to noProtocolContext since tcp connections are the only protocol schemes
-	// available in OSS and are a straight forward proxy with no additional
-	// fields needed.
-	getProtocolContext = noProtocolContext
+	// to endpointProtocolContext which provides no context for tcp connections,
+	// since they are a straight forward proxy with no additional fields needed,
+	// and the injected credentials for ssh, postgres and http connections.
+	getProtocolContext = endpointProtocolContext
 
 	// updateWorkerStorageBucketCredentialStatesFn will update the worker storage bucket
 	// credential state.
@@ -412,6 +415,170 @@ func noProtocolContext(
 	return nil, nil
 }
 
+// endpointProtocolContext provides the protocol context based on the scheme
+// of the session's endpoint. Only ssh, postgres and http endpoints need a
+// protocol context, which contains the credentials the worker injects when
+// connecting to the endpoint. For ssh targets with session recording enabled
+// the session recording is created and included in the protocol context.
+func endpointProtocolContext(
+	ctx context.Context,
+	sessionRepo *session.Repository,
+	serversRepo *server.Repository,
+	workerAuthRepoFn common.WorkerAuthRepoStorageFactory,
+	req *pbs.AuthorizeConnectionRequest,
+	route []string,
+	connectionId string,
+	controllerExt intglobals.ControllerExtension,
+) (*anypb.Any, error) {
+	sess, _, err := sessionRepo.LookupSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error looking up session: %v", err)
+	}
+	if sess == nil {
+		return nil, status.Error(codes.NotFound, "session not found")
+	}
+	endpoint, err := url.Parse(sess.Endpoint)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error parsing session endpoint: %v", err)
+	}
+
+	var pc proto.Message
+	switch endpoint.Scheme {
+	case "ssh":
+		creds, err := sessionInjectedCredentials(ctx, sessionRepo, sess)
+		if err != nil {
+			return nil, err
+		}
+		sr, err := sessionRecording(ctx, sessionRepo, sess)
+		if err != nil {
+			return nil, err
+		}
+		hostKeys, err := sessionRepo.LookupTargetHostKeys(ctx, sess.PublicId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error looking up target host key settings: %v", err)
+		}
+		if hostKeys == nil {
+			return nil, status.Error(codes.Internal, "target host key settings not found")
+		}
+		pc = &pbs.SshProtocolContext{
+			InjectedCredentials:             creds,
+			SessionRecording:                sr,
+			Endpoint:                        sess.Endpoint,
+			KnownHosts:                      hostKeys.KnownHosts,
+			InsecureSkipHostKeyVerification: hostKeys.InsecureSkipHostKeyVerification,
+		}
+	case "postgres":
+		creds, err := sessionInjectedCredentials(ctx, sessionRepo, sess)
+		if err != nil {
+			return nil, err
+		}
+		tlsSettings, err := sessionRepo.LookupTargetTls(ctx, sess.PublicId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error looking up target tls settings: %v", err)
+		}
+		if tlsSettings == nil {
+			return nil, status.Error(codes.Internal, "target tls settings not found")
+		}
+		pc = &pbs.PostgresProtocolContext{
+			InjectedCredentials: creds,
+			SessionId:           sess.PublicId,
+			UserId:              sess.UserId,
+			TargetId:            sess.TargetId,
+			Endpoint:            sess.Endpoint,
+			Tls: &pbs.EndpointTls{
+				CaCert:                     tlsSettings.TlsCaCert,
+				ServerName:                 tlsSettings.TlsServerName,
+				SkipServerNameVerification: tlsSettings.SslMode == targetpostgres.SslModeVerifyCa,
+			},
+		}
+	case "http":
+		creds, err := sessionInjectedCredentials(ctx, sessionRepo, sess)
+		if err != nil {
+			return nil, err
+		}
+		tlsSettings, err := sessionRepo.LookupTargetTls(ctx, sess.PublicId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error looking up target tls settings: %v", err)
+		}
+		if tlsSettings == nil {
+			return nil, status.Error(codes.Internal, "target tls settings not found")
+		}
+		httpCtx := &pbs.HttpProtocolContext{
+			InjectedCredentials:       creds,
+			Endpoint:                  sess.Endpoint,
+			AllowPlaintextCredentials: tlsSettings.AllowPlaintextCredentials,
+		}
+		if tlsSettings.Scheme != targethttp.SchemeHttp {
+			httpCtx.Tls = &pbs.EndpointTls{
+				CaCert:     tlsSettings.TlsCaCert,
+				ServerName: tlsSettings.TlsServerName,
+			}
+		}
+		pc = httpCtx
+	default:
+		return noProtocolContext(ctx, sessionRepo, serversRepo, workerAuthRepoFn, req, route, connectionId, controllerExt)
+	}
+	ret, err := anypb.New(pc)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error marshaling %s protocol context: %v", endpoint.Scheme, err)
+	}
+	return ret, nil
+}
+
+// sessionRecording creates the recording of the session if its target has
+// session recording enabled and returns the information the worker needs to
+// write the BSR. It returns nil if the session is not recorded.
+func sessionRecording(ctx context.Context, sessionRepo *session.Repository, sess *session.Session) (*pbs.SessionRecording, error) {
+	rec, err := sessionRepo.CreateRecording(ctx, sess.PublicId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error creating session recording: %v", err)
+	}
+	if rec == nil {
+		return nil, nil
+	}
+	ret := &pbs.SessionRecording{
+		SessionRecordingId: rec.PublicId,
+		SessionId:          sess.PublicId,
+		Endpoint:           sess.Endpoint,
+		UserId:             sess.UserId,
+		UserScopeId:        rec.UserScopeId,
+		TargetId:           sess.TargetId,
+		TargetName:         rec.TargetName,
+		TargetProjectId:    sess.ProjectId,
+		TargetDefaultPort:  rec.TargetDefaultPort,
+		StorageBucketId:    rec.StorageBucketId,
+	}
+	for _, c := range rec.Credentials {
+		ret.Credentials = append(ret.Credentials, &pbs.SessionRecordingCredential{
+			Id:                c.Id,
+			CredentialStoreId: c.CredentialStoreId,
+			SourceType:        c.SourceType,
+			CredentialType:    c.CredentialType,
+			Purpose:           c.Purpose,
+			Username:          c.Username,
+		})
+	}
+	return ret, nil
+}
+
+// sessionInjectedCredentials returns the credentials stored with the session
+// for the worker to inject when connecting to the endpoint.
+func sessionInjectedCredentials(ctx context.Context, sessionRepo *session.Repository, sess *session.Session) ([]*pbs.Credential, error) {
+	creds, err := sessionRepo.ListSessionCredentials(ctx, sess.ProjectId, sess.PublicId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error listing session credentials: %v", err)
+	}
+	var ret []*pbs.Credential
+	for _, c := range creds {
+		workerCred := &pbs.Credential{}
+		if err := proto.Unmarshal(c, workerCred); err != nil {
+			return nil, status.Errorf(codes.Internal, "error unmarshaling session credential: %v", err)
+		}
+		ret = append(ret, workerCred)
+	}
+	return ret, nil
+}
+
 func lookupSessionWorkerFilter(ctx context.Context, sessionInfo *session.Session, authzSummary *session.AuthzSummary, ws *workerServiceServer,
 	req *pbs.LookupSessionRequest,
 ) error {
@@ -6,27 +6,46 @@ package handlers
 import (
 	"context"
 	"crypto/rand"
+	"database/sql"
 	"fmt"
+	"io"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/credential"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/storage_buckets"
+	workerrecording "github.com/hashicorp/boundary/internal/daemon/worker/recording"
 	"github.com/hashicorp/boundary/internal/db"
+	apipbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
 	"github.com/hashicorp/boundary/internal/target"
+	targetssh "github.com/hashicorp/boundary/internal/target/ssh"
 	"github.com/hashicorp/boundary/internal/target/tcp"
 	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/hashicorp/go-secure-stdlib/base62"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -459,6 +478,192 @@ func TestAuthorizeConnection(t *testing.T) {
 	}
 }
 
+func TestAuthorizeConnection_SessionRecording(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	require.NoError(t, kmsCache.CreateKeys(context.Background(), scope.Global.String(), kms.WithRandomReader(rand.Reader)))
+
+	currentConnFn := connectionRouteFn
+	t.Cleanup(func() {
+		connectionRouteFn = currentConnFn
+	})
+	connectionRouteFn = singleHopConnectionRoute
+
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	workerAuthRepoFn := func() (*server.WorkerAuthRepositoryStorage, error) {
+		return server.NewRepositoryStorage(ctx, rw, rw, kmsCache)
+	}
+	sessionRepoFn := func(opts ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kmsCache, opts...)
+	}
+	connectionRepoFn := func() (*session.ConnectionRepository, error) {
+		return session.NewConnectionRepository(ctx, rw, rw, kmsCache)
+	}
+
+	var workerKeyId string
+	worker := server.TestPkiWorker(t, conn, wrapper, server.WithTestPkiWorkerAuthorizedKeyId(&workerKeyId))
+	serverRepo, err := serversRepoFn()
+	require.NoError(t, err)
+	_, err = serverRepo.UpsertWorkerStatus(ctx, worker, server.WithKeyId(workerKeyId))
+	require.NoError(t, err)
+
+	// The storage bucket is created through the storage bucket service with
+	// the built-in filesystem storage plugin.
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	fsPlg := plugin.TestPlugin(t, conn, filesystem.PluginName, plugin.WithHostFlag(false), plugin.WithStorageFlag(true))
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	plgm := map[string]plgpb.StoragePluginServiceClient{
+		fsPlg.GetPublicId(): loopback.NewWrappingPluginStorageClient(filesystem.NewFilesystemPlugin(t.TempDir())),
+	}
+	storageRepoFn := func() (*pluginstorage.Repository, error) {
+		return pluginstorage.NewRepository(ctx, rw, rw, kmsCache, sche, plgm)
+	}
+	pluginRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	sbService, err := storage_buckets.NewService(ctx, storageRepoFn, iamRepoFn, pluginRepoFn, 1000)
+	require.NoError(t, err)
+	sbCtx := auth.DisabledAuthTestContext(iamRepoFn, org.GetPublicId())
+	sbResp, err := sbService.CreateStorageBucket(sbCtx, &apipbs.CreateStorageBucketRequest{
+		PluginName: filesystem.PluginName,
+		Item: &storagebuckets.StorageBucket{
+			ScopeId:      org.GetPublicId(),
+			BucketName:   "recordings",
+			WorkerFilter: `"pki" in "/name"`,
+		},
+	})
+	require.NoError(t, err)
+	sb := sbResp.GetItem()
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, org.GetPublicId())
+	hc := static.TestCatalogs(t, conn, prj.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	knownHosts := "127.0.0.1 ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+	tar := targetssh.TestTarget(ctx, t, conn, prj.GetPublicId(), "recorded",
+		target.WithHostSources([]string{hs.GetPublicId()}),
+		target.WithDefaultPort(22),
+		target.WithStorageBucketId(sb.GetId()),
+		target.WithEnableSessionRecording(true),
+		target.WithKnownHosts(knownHosts),
+	)
+
+	cs := credstatic.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	upCred := credstatic.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", cs.GetPublicId(), prj.GetPublicId())
+
+	sess := session.TestSession(t, conn, wrapper, session.ComposedOf{
+		UserId:            at.GetIamUserId(),
+		HostId:            h.GetPublicId(),
+		TargetId:          tar.GetPublicId(),
+		HostSetId:         hs.GetPublicId(),
+		AuthTokenId:       at.GetPublicId(),
+		ProjectId:         prj.GetPublicId(),
+		Endpoint:          "ssh://127.0.0.1:22",
+		ConnectionLimit:   -1,
+		StaticCredentials: []*session.StaticCredential{session.NewStaticCredential(upCred.GetPublicId(), credential.InjectedApplicationPurpose)},
+	})
+	repo, err := sessionRepoFn()
+	require.NoError(t, err)
+	tofuToken, err := base62.Random(20)
+	require.NoError(t, err)
+	_, _, err = repo.ActivateSession(ctx, sess.GetPublicId(), sess.Version, []byte(tofuToken))
+	require.NoError(t, err)
+
+	s := NewWorkerServiceServer(serversRepoFn, workerAuthRepoFn, sessionRepoFn, connectionRepoFn, nil, new(sync.Map), kmsCache, new(atomic.Int64), &fakeControllerExtension{reader: rw, writer: rw})
+	resp, err := s.AuthorizeConnection(ctx, &pbs.AuthorizeConnectionRequest{
+		SessionId: sess.GetPublicId(),
+		WorkerId:  worker.GetPublicId(),
+	})
+	require.NoError(t, err)
+	pc := &pbs.SshProtocolContext{}
+	require.NoError(t, resp.GetProtocolContext().UnmarshalTo(pc))
+	assert.Equal(t, "ssh://127.0.0.1:22", pc.GetEndpoint())
+	assert.Equal(t, knownHosts, pc.GetKnownHosts())
+	assert.False(t, pc.GetInsecureSkipHostKeyVerification())
+	sr := pc.GetSessionRecording()
+	require.NotNil(t, sr)
+	assert.True(t, strings.HasPrefix(sr.GetSessionRecordingId(), globals.SessionRecordingPrefix+"_"))
+	assert.Equal(t, sess.GetPublicId(), sr.GetSessionId())
+	assert.Equal(t, at.GetIamUserId(), sr.GetUserId())
+	assert.Equal(t, org.GetPublicId(), sr.GetUserScopeId())
+	assert.Equal(t, tar.GetPublicId(), sr.GetTargetId())
+	assert.Equal(t, "recorded", sr.GetTargetName())
+	assert.Equal(t, prj.GetPublicId(), sr.GetTargetProjectId())
+	assert.Equal(t, uint32(22), sr.GetTargetDefaultPort())
+	assert.Equal(t, sb.GetId(), sr.GetStorageBucketId())
+	assert.Empty(t, cmp.Diff([]*pbs.SessionRecordingCredential{
+		{
+			Id:                upCred.GetPublicId(),
+			CredentialStoreId: cs.GetPublicId(),
+			SourceType:        "static",
+			CredentialType:    "username_password",
+			Purpose:           string(credential.InjectedApplicationPurpose),
+			Username:          "user",
+		},
+	}, sr.GetCredentials(), protocmp.Transform()))
+
+	// The recording row references the storage bucket of the target.
+	rows, err := rw.Query(ctx, "select public_id, storage_bucket_id from recording_session where session_id = @session_id", []any{sql.Named("session_id", sess.GetPublicId())})
+	require.NoError(t, err)
+	var recIds, recBucketIds []string
+	for rows.Next() {
+		var recId, recBucketId string
+		require.NoError(t, rows.Scan(&recId, &recBucketId))
+		recIds = append(recIds, recId)
+		recBucketIds = append(recBucketIds, recBucketId)
+	}
+	require.NoError(t, rows.Err())
+	assert.Equal(t, []string{sr.GetSessionRecordingId()}, recIds)
+	assert.Equal(t, []string{sb.GetId()}, recBucketIds)
+
+	// A storage bucket with session recordings cannot be deleted.
+	_, err = sbService.DeleteStorageBucket(sbCtx, &apipbs.DeleteStorageBucketRequest{Id: sb.GetId()})
+	require.Error(t, err)
+	_, err = sbService.GetStorageBucket(sbCtx, &apipbs.GetStorageBucketRequest{Id: sb.GetId()})
+	require.NoError(t, err)
+
+	// A second connection uses the recording created for the first one.
+	resp2, err := s.AuthorizeConnection(ctx, &pbs.AuthorizeConnectionRequest{
+		SessionId: sess.GetPublicId(),
+		WorkerId:  worker.GetPublicId(),
+	})
+	require.NoError(t, err)
+	pc2 := &pbs.SshProtocolContext{}
+	require.NoError(t, resp2.GetProtocolContext().UnmarshalTo(pc2))
+	assert.Equal(t, sr.GetSessionRecordingId(), pc2.GetSessionRecording().GetSessionRecordingId())
+
+	// The worker writes the BSR of the session from the protocol context.
+	fs, err := workerrecording.NewLocalFS(ctx, t.TempDir())
+	require.NoError(t, err)
+	bsrWrapper := bsrkms.TestWrapper(t)
+	m, err := workerrecording.NewManager(ctx, fs, bsrWrapper, func() *bsr.Worker {
+		return &bsr.Worker{PublicId: worker.GetPublicId(), Version: "0.0.1"}
+	})
+	require.NoError(t, err)
+	cr, err := m.NewConnectionRecorder(ctx, sr, resp.GetConnectionId())
+	require.NoError(t, err)
+	ch, err := cr.NewChannelRecorder(ctx, "session")
+	require.NoError(t, err)
+	_, err = io.WriteString(ch.NewDataWriter(ctx, bsr.Outbound), "output")
+	require.NoError(t, err)
+	require.NoError(t, cr.Close(ctx))
+	require.NoError(t, m.ReauthorizeAllExcept(ctx, []string{sess.GetPublicId()}))
+
+	c, err := fs.Open(ctx, sr.GetSessionRecordingId()+".bsr")
+	require.NoError(t, err)
+	require.NoError(t, c.Close())
+}
+
 func TestCancelSession(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
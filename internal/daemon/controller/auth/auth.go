@@ -122,6 +122,11 @@ type verifier struct {
 	acl                perms.ACL
 }
 
+// aclOptions returns the options checking grant conditions against the request
+func (v *verifier) aclOptions() []perms.Option {
+	return []perms.Option{perms.WithClientIp(v.requestInfo.GetClientIp())}
+}
+
 // TODO (jefferai 10/2022): NewVerifierContextWithAccounts performs the function
 // of NewVerifierContext (see the docs for that function) but with extra
 // parameters that can be used to look up account information. This is not
@@ -680,7 +685,7 @@ func (v verifier) performAuthCheck(ctx context.Context) (
 	}
 
 	retAcl = perms.NewACL(parsedGrants...)
-	aclResults = retAcl.Allowed(*v.res, v.act, *userData.User.Id)
+	aclResults = retAcl.Allowed(*v.res, v.act, *userData.User.Id, v.aclOptions()...)
 	// We don't set authenticated above because setting this but not authorized
 	// is used for further permissions checks, such as during recursive listing.
 	// So we want to make sure any code relying on that has the full set of
@@ -737,7 +742,7 @@ func (r *VerifyResults) fetchActions(id string, typ resource.Type, availableActi
 
 	ret := make(action.ActionSet, len(availableActions))
 	for act := range availableActions {
-		if r.v.acl.Allowed(*res, act, *r.UserData.User.Id).Authorized {
+		if r.v.acl.Allowed(*res, act, *r.UserData.User.Id, r.v.aclOptions()...).Authorized {
 			ret.Add(act)
 		}
 	}
@@ -761,7 +766,17 @@ func (r *VerifyResults) FetchOutputFields(res perms.Resource, act action.Type) *
 		return ret
 	}
 
-	return r.v.acl.Allowed(res, act, *r.UserData.User.Id).OutputFields
+	return r.v.acl.Allowed(res, act, *r.UserData.User.Id, r.v.aclOptions()...).OutputFields
+}
+
+// ACLOptions returns the options to provide to the ACL of the verifier, or of
+// another user, when checking permissions for the request, so grant conditions
+// are checked against the request.
+func (r *VerifyResults) ACLOptions() []perms.Option {
+	if r.v == nil {
+		return nil
+	}
+	return r.v.aclOptions()
 }
 
 // ACL returns the perms.ACL of the verifier.
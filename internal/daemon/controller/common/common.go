@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/billing"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/host"
@@ -19,6 +20,8 @@ import (
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/plugin"
+	storagepolicy "github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/session"
 	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
@@ -28,6 +31,7 @@ type (
 	AuthTokenRepoFactory           = oidc.AuthTokenRepoFactory
 	VaultCredentialRepoFactory     = func() (*vault.Repository, error)
 	StaticCredentialRepoFactory    = func() (*credstatic.Repository, error)
+	SshCaCredentialRepoFactory     = func() (*sshca.Repository, error)
 	CredentialStoreRepoFactory     func() (*credential.StoreRepository, error)
 	HostCatalogRepoFactory         func() (*host.CatalogRepository, error)
 	IamRepoFactory                 = iam.IamRepoFactory
@@ -45,6 +49,8 @@ type (
 	BillingRepoFactory             func() (*billing.Repository, error)
 	AliasRepoFactory               func() (*alias.Repository, error)
 	TargetAliasRepoFactory         func() (*target.Repository, error)
+	RecordingRepoFactory           func() (*recording.Repository, error)
+	StoragePolicyRepoFactory       func() (*storagepolicy.Repository, error)
 )
 
 // Downstreamers provides at least a minimum interface that must be met by a
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/boundary/internal/cmd/base"
 	"github.com/hashicorp/boundary/internal/cmd/config"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	credstatic "github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/daemon/cluster"
@@ -37,11 +38,14 @@ import (
 	pluginhost "github.com/hashicorp/boundary/internal/host/plugin"
 	"github.com/hashicorp/boundary/internal/host/static"
 	"github.com/hashicorp/boundary/internal/iam"
+	iamjob "github.com/hashicorp/boundary/internal/iam/job"
 	"github.com/hashicorp/boundary/internal/kms"
 	kmsjob "github.com/hashicorp/boundary/internal/kms/job"
 	"github.com/hashicorp/boundary/internal/pagination/purge"
 	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
 	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	storagepolicy "github.com/hashicorp/boundary/internal/policy/storage"
 	"github.com/hashicorp/boundary/internal/ratelimit"
 	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/scheduler"
@@ -131,10 +135,16 @@ type Controller struct {
 	rateLimiter   ratelimit.Limiter
 	rateLimiterMu sync.RWMutex
 
+	// vaultJwtSigner signs the JWTs used by Vault credential stores logging
+	// in to Vault with the JWT auth method. It is nil if no Vault JWT
+	// signing key is configured.
+	vaultJwtSigner *vault.JwtSigner
+
 	// Repo factory methods
 	AuthTokenRepoFn           common.AuthTokenRepoFactory
 	VaultCredentialRepoFn     common.VaultCredentialRepoFactory
 	StaticCredentialRepoFn    common.StaticCredentialRepoFactory
+	SshCaCredentialRepoFn     common.SshCaCredentialRepoFactory
 	CredentialStoreRepoFn     common.CredentialStoreRepoFactory
 	HostCatalogRepoFn         common.HostCatalogRepoFactory
 	IamRepoFn                 common.IamRepoFactory
@@ -154,6 +164,8 @@ type Controller struct {
 	BillingRepoFn             common.BillingRepoFactory
 	AliasRepoFn               common.AliasRepoFactory
 	TargetAliasRepoFn         common.TargetAliasRepoFactory
+	RecordingRepoFn           common.RecordingRepoFactory
+	StoragePolicyRepoFn       common.StoragePolicyRepoFactory
 
 	scheduler *scheduler.Scheduler
 
@@ -345,12 +357,27 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 			if _, err := conf.RegisterPlugin(ctx, pluginType, nil, []plugin.PluginType{plugin.PluginTypeStorage}, plugin.WithDescription(fmt.Sprintf("Built-in %s storage plugin", enabledPlugin.String()))); err != nil {
 				return nil, fmt.Errorf("error registering %s storage plugin: %w", pluginType, err)
 			}
+		case enabledPlugin == base.EnabledPluginFilesystem:
+			pluginType := strings.ToLower(enabledPlugin.String())
+			plg, err := conf.RegisterPlugin(ctx, pluginType, nil, []plugin.PluginType{plugin.PluginTypeStorage}, plugin.WithDescription(fmt.Sprintf("Built-in %s storage plugin", enabledPlugin.String())))
+			if err != nil {
+				return nil, fmt.Errorf("error registering %s storage plugin: %w", pluginType, err)
+			}
+			// The filesystem plugin runs in the controller so that the
+			// lifecycle hooks of its storage buckets can be called.
+			if conf.StoragePlugins == nil {
+				conf.StoragePlugins = make(map[string]plgpb.StoragePluginServiceClient)
+			}
+			conf.StoragePlugins[plg.GetPublicId()] = loopback.NewWrappingPluginStorageClient(filesystem.NewFilesystemPlugin(conf.RawConfig.Plugins.FilesystemRootDir))
 		}
 	}
 
 	if conf.HostPlugins == nil {
 		conf.HostPlugins = make(map[string]plgpb.HostPluginServiceClient)
 	}
+	if conf.StoragePlugins == nil {
+		conf.StoragePlugins = make(map[string]plgpb.StoragePluginServiceClient)
+	}
 
 	// Set up repo stuff
 	dbase := db.New(c.conf.Database)
@@ -424,18 +451,30 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 		return plugin.NewRepository(ctx, dbase, dbase, c.kms)
 	}
 	c.PluginStorageBucketRepoFn = func() (*pluginstorage.Repository, error) {
-		return pluginstorage.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler)
+		return pluginstorage.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler, c.conf.StoragePlugins)
 	}
 	c.AuthTokenRepoFn = func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, dbase, dbase, c.kms,
 			authtoken.WithTokenTimeToLiveDuration(c.conf.RawConfig.Controller.AuthTokenTimeToLiveDuration),
 			authtoken.WithTokenTimeToStaleDuration(c.conf.RawConfig.Controller.AuthTokenTimeToStaleDuration))
 	}
+	if key := c.conf.RawConfig.Controller.VaultJwtSigningKey; key != "" {
+		if c.vaultJwtSigner, err = vault.NewJwtSigner(ctx, []byte(key), vault.WithJwtAudience(c.conf.RawConfig.Controller.VaultJwtAudience)); err != nil {
+			return nil, fmt.Errorf("error parsing vault jwt signing key: %w", err)
+		}
+	}
 	c.VaultCredentialRepoFn = func() (*vault.Repository, error) {
-		return vault.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler)
+		return vault.NewRepository(ctx, dbase, dbase, c.kms, c.scheduler, vault.WithJwtSigner(c.vaultJwtSigner))
 	}
 	c.StaticCredentialRepoFn = func() (*credstatic.Repository, error) {
-		return credstatic.NewRepository(ctx, dbase, dbase, c.kms)
+		var opts []credstatic.Option
+		if hook := c.credentialRotationHook(); hook != nil {
+			opts = append(opts, credstatic.WithRotationHook(hook))
+		}
+		return credstatic.NewRepository(ctx, dbase, dbase, c.kms, opts...)
+	}
+	c.SshCaCredentialRepoFn = func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, dbase, dbase, c.kms)
 	}
 	c.CredentialStoreRepoFn = func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(ctx, dbase, dbase)
@@ -482,6 +521,12 @@ func New(ctx context.Context, conf *Config) (*Controller, error) {
 	c.TargetAliasRepoFn = func() (*talias.Repository, error) {
 		return talias.NewRepository(ctx, dbase, dbase, c.kms)
 	}
+	c.RecordingRepoFn = func() (*recording.Repository, error) {
+		return recording.NewRepository(ctx, dbase, dbase, c.kms)
+	}
+	c.StoragePolicyRepoFn = func() (*storagepolicy.Repository, error) {
+		return storagepolicy.NewRepository(ctx, dbase, dbase, c.kms)
+	}
 
 	// Check that credentials are available at startup, to avoid some harmless
 	// but nasty-looking errors
@@ -616,12 +661,15 @@ func (c *Controller) Start() error {
 
 func (c *Controller) registerJobs() error {
 	rw := db.New(c.conf.Database)
-	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+	if err := vault.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, vault.WithJwtSigner(c.vaultJwtSigner)); err != nil {
 		return err
 	}
 	if err := pluginhost.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.conf.HostPlugins); err != nil {
 		return err
 	}
+	if err := credstatic.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.credentialRotationHook()); err != nil {
+		return err
+	}
 	if err := session.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms, c.workerStatusGracePeriod); err != nil {
 		return err
 	}
@@ -638,6 +686,9 @@ func (c *Controller) registerJobs() error {
 	if err := kmsjob.RegisterJobs(c.baseContext, c.scheduler, c.kms); err != nil {
 		return err
 	}
+	if err := iamjob.RegisterJobs(c.baseContext, c.scheduler, rw, rw, c.kms); err != nil {
+		return err
+	}
 	if err := snapshot.RegisterJob(c.baseContext, c.scheduler, rw, rw); err != nil {
 		return err
 	}
@@ -647,13 +698,26 @@ func (c *Controller) registerJobs() error {
 	if err := purge.RegisterJobs(c.baseContext, c.scheduler, rw, rw); err != nil {
 		return err
 	}
-	if err := recording.RegisterJob(c.baseContext, c.scheduler, rw, rw, c.ControllerExtension, c.kms); err != nil {
+	if err := recording.RegisterJob(c.baseContext, c.scheduler, rw, rw, c.ControllerExtension, c.kms,
+		recording.WithFilesystemRootDir(c.conf.RawConfig.Plugins.FilesystemRootDir),
+		recording.WithBsrWrapper(c.conf.BsrKms),
+	); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// credentialRotationHook returns the hook used to rotate static username
+// password credentials, or nil if no credential rotation command is
+// configured.
+func (c *Controller) credentialRotationHook() credstatic.RotationHook {
+	if c.conf.RawConfig.Controller.CredentialRotationCommand == "" {
+		return nil
+	}
+	return credstatic.NewCommandRotationHook(c.conf.RawConfig.Controller.CredentialRotationCommand)
+}
+
 func (c *Controller) Shutdown() error {
 	const op = "controller.(Controller).Shutdown"
 	if !c.started.Load() {
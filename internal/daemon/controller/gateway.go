@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	"github.com/hashicorp/go-uuid"
 	"google.golang.org/grpc"
@@ -38,6 +39,7 @@ type grpcServerListener interface {
 
 func gatewayDialOptions(lis grpcServerListener) []grpc.DialOption {
 	return []grpc.DialOption{
+		grpc.WithStatsHandler(tracing.GrpcClientHandler()),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
 			return lis.Dial()
@@ -137,6 +139,7 @@ func newGrpcServer(
 		return nil, "", err
 	}
 	return grpc.NewServer(
+		grpc.StatsHandler(tracing.GrpcServerHandler()),
 		grpc.MaxRecvMsgSize(math.MaxInt32),
 		grpc.MaxSendMsgSize(math.MaxInt32),
 		grpc.StreamInterceptor(
@@ -51,6 +51,7 @@ import (
 	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	opsservices "github.com/hashicorp/boundary/internal/gen/ops/services"
 	"github.com/hashicorp/boundary/internal/ratelimit"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-secure-stdlib/listenerutil"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
@@ -107,9 +108,10 @@ func (c *Controller) apiHandler(props HandlerProperties) (http.Handler, error) {
 		return nil, err
 	}
 	metricsHandler := metric.InstrumentApiHandler(eventsHandler)
+	tracingHandler := tracing.HttpHandler(metricsHandler, "api")
 
 	// This wrap MUST be performed last. If you add a new wrapper, do so above.
-	return listenerutil.WrapCustomHeadersHandler(metricsHandler, props.ListenerConfig, isUiRequest), nil
+	return listenerutil.WrapCustomHeadersHandler(tracingHandler, props.ListenerConfig, isUiRequest), nil
 }
 
 // GetHealthHandler returns a gRPC Gateway mux that is registered against the
@@ -230,6 +232,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 		ps, err := policies.NewServiceFn(
 			c.baseContext,
 			c.IamRepoFn,
+			c.StoragePolicyRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 			c.ControllerExtension,
 		)
@@ -243,6 +246,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.baseContext,
 			c.IamRepoFn,
 			c.ServersRepoFn,
+			c.RecordingRepoFn,
 			c.workerStatusGracePeriod,
 			c.kms,
 			c.conf.RawConfig.Controller.MaxPageSize,
@@ -264,6 +268,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.StaticHostRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.SshCaCredentialRepoFn,
 			c.TargetAliasRepoFn,
 			c.downstreamWorkers,
 			c.workerStatusGracePeriod,
@@ -309,6 +314,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.IamRepoFn,
 			c.VaultCredentialRepoFn,
 			c.StaticCredentialRepoFn,
+			c.SshCaCredentialRepoFn,
 			c.CredentialStoreRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
@@ -322,6 +328,7 @@ func (c *Controller) registerGrpcServices(s *grpc.Server) error {
 			c.baseContext,
 			c.IamRepoFn,
 			c.VaultCredentialRepoFn,
+			c.SshCaCredentialRepoFn,
 			c.conf.RawConfig.Controller.MaxPageSize,
 		)
 		if err != nil {
@@ -160,6 +160,8 @@ func TestHandleImplementedPaths(t *testing.T) {
 			// custom methods
 			"v1/accounts/someid:set-password",
 			"v1/accounts/someid:change-password",
+			"v1/accounts/someid:enroll-totp",
+			"v1/accounts/someid:remove-totp",
 			"v1/auth-methods/someid:authenticate",
 			"v1/groups/someid:add-members",
 			"v1/groups/someid:set-members",
@@ -80,6 +80,8 @@ var (
 			action.Delete,
 			action.SetPassword,
 			action.ChangePassword,
+			action.EnrollTotp,
+			action.RemoveTotp,
 		),
 		oidc.Subtype: action.NewActionSet(
 			action.NoOp,
@@ -575,6 +577,42 @@ func (s Service) SetPassword(ctx context.Context, req *pbs.SetPasswordRequest) (
 	return &pbs.SetPasswordResponse{Item: item}, nil
 }
 
+// EnrollTotp implements the interface pbs.AccountServiceServer.
+func (s Service) EnrollTotp(ctx context.Context, req *pbs.EnrollTotpRequest) (*pbs.EnrollTotpResponse, error) {
+	if err := validateEnrollTotpRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.EnrollTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	enrollment, err := s.enrollTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.EnrollTotpResponse{
+		AccountId:     enrollment.AccountId,
+		Secret:        enrollment.Secret,
+		Uri:           enrollment.Uri,
+		RecoveryCodes: enrollment.RecoveryCodes,
+	}, nil
+}
+
+// RemoveTotp implements the interface pbs.AccountServiceServer.
+func (s Service) RemoveTotp(ctx context.Context, req *pbs.RemoveTotpRequest) (*pbs.RemoveTotpResponse, error) {
+	if err := validateRemoveTotpRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	_, authResults := s.parentAndAuthResult(ctx, req.GetId(), action.RemoveTotp)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if err := s.removeTotpInRepo(ctx, authResults.Scope.GetId(), req.GetId()); err != nil {
+		return nil, err
+	}
+	return &pbs.RemoveTotpResponse{}, nil
+}
+
 // getFromRepo returns the account and, if available, managed groups the account
 // belongs to within the auth method
 func (s Service) getFromRepo(ctx context.Context, id string) (auth.Account, []string, error) {
@@ -1027,6 +1065,40 @@ func (s Service) setPasswordInRepo(ctx context.Context, scopeId, id string, vers
 	return out, nil
 }
 
+func (s Service) enrollTotpInRepo(ctx context.Context, scopeId, id string) (*password.TotpEnrollment, error) {
+	const op = "accounts.(Service).enrollTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.EnrollTotp(ctx, scopeId, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Account not found.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return out, nil
+}
+
+func (s Service) removeTotpInRepo(ctx context.Context, scopeId, id string) error {
+	const op = "accounts.(Service).removeTotpInRepo"
+
+	repo, err := s.pwRepoFn()
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.RemoveTotp(ctx, scopeId, id)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if rows == 0 {
+		return handlers.NotFoundErrorf("Account is not enrolled in TOTP.")
+	}
+	return nil
+}
+
 func (s Service) parentAndAuthResult(ctx context.Context, id string, a action.Type) (auth.AuthMethod, requestauth.VerifyResults) {
 	res := requestauth.VerifyResults{}
 	pwRepo, err := s.pwRepoFn()
@@ -1486,6 +1558,28 @@ func validateSetPasswordRequest(ctx context.Context, req *pbs.SetPasswordRequest
 	return nil
 }
 
+func validateEnrollTotpRequest(ctx context.Context, req *pbs.EnrollTotpRequest) error {
+	const op = "accounts.validateEnrollTotpRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{idField: "Improperly formatted identifier."})
+	}
+	return nil
+}
+
+func validateRemoveTotpRequest(ctx context.Context, req *pbs.RemoveTotpRequest) error {
+	const op = "accounts.validateRemoveTotpRequest"
+	if req == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "nil request")
+	}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.PasswordAccountPreviousPrefix, globals.PasswordAccountPrefix) {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{idField: "Improperly formatted identifier."})
+	}
+	return nil
+}
+
 func newOutputOpts(ctx context.Context, item auth.Account, authMethodId string, authResults requestauth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		ScopeId: authResults.Scope.Id,
@@ -55,6 +55,8 @@ var (
 		action.Delete.String(),
 		action.SetPassword.String(),
 		action.ChangePassword.String(),
+		action.EnrollTotp.String(),
+		action.RemoveTotp.String(),
 	}
 	oidcAuthorizedActions = []string{
 		action.NoOp.String(),
@@ -4394,6 +4396,76 @@ func TestChangePassword(t *testing.T) {
 
 // The purpose of this test is mainly to ensure that we are properly fetching
 // membership information in GrantsForUser across managed group types
+func TestEnrollAndRemoveTotp(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(ctx, rw, rw, kms)
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrap))
+	tested, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	require.NoError(t, err, "Error when getting new auth_method service.")
+
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+	acct := password.TestAccount(t, conn, am.GetPublicId(), "name1")
+
+	t.Run("enroll and remove", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		enrollResp, err := tested.EnrollTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.EnrollTotpRequest{
+			Id: acct.GetPublicId(),
+		})
+		require.NoError(err)
+		assert.Equal(acct.GetPublicId(), enrollResp.GetAccountId())
+		assert.NotEmpty(enrollResp.GetSecret())
+		assert.Contains(enrollResp.GetUri(), enrollResp.GetSecret())
+		assert.Len(enrollResp.GetRecoveryCodes(), 10)
+
+		removeResp, err := tested.RemoveTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.RemoveTotpRequest{
+			Id: acct.GetPublicId(),
+		})
+		require.NoError(err)
+		assert.NotNil(removeResp)
+
+		_, err = tested.RemoveTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.RemoveTotpRequest{
+			Id: acct.GetPublicId(),
+		})
+		assert.True(errors.Is(err, handlers.NotFoundError()), "Got %v, wanted not found error", err)
+	})
+
+	t.Run("bad id", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := tested.EnrollTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.EnrollTotpRequest{
+			Id: globals.OidcAccountPrefix + "_1234567890",
+		})
+		assert.True(errors.Is(err, handlers.InvalidArgumentErrorf("", nil)), "Got %v, wanted invalid argument error", err)
+		_, err = tested.RemoveTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.RemoveTotpRequest{
+			Id: globals.OidcAccountPrefix + "_1234567890",
+		})
+		assert.True(errors.Is(err, handlers.InvalidArgumentErrorf("", nil)), "Got %v, wanted invalid argument error", err)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		assert := assert.New(t)
+		_, err := tested.EnrollTotp(requestauth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.EnrollTotpRequest{
+			Id: globals.PasswordAccountPrefix + "_1234567890",
+		})
+		assert.Error(err)
+	})
+}
+
 func TestGrantsAcrossManagedGroups(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -903,6 +903,7 @@ func toAuthMethodProto(ctx context.Context, in auth.AuthMethod, opt ...handlers.
 			PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
 				MinLoginNameLength: i.GetMinLoginNameLength(),
 				MinPasswordLength:  i.GetMinPasswordLength(),
+				TotpRequired:       i.GetTotpRequired(),
 			},
 		}
 	case *oidc.AuthMethod:
@@ -93,14 +93,14 @@ func (s Service) updatePwInRepo(ctx context.Context, scopeId, id string, mask []
 
 func (s Service) authenticatePassword(ctx context.Context, req *pbs.AuthenticateRequest, authResults *auth.VerifyResults) (*pbs.AuthenticateResponse, error) {
 	reqAttrs := req.GetPasswordLoginAttributes()
-	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password)
+	tok, err := s.authenticateWithPwRepo(ctx, authResults.Scope.GetId(), req.GetAuthMethodId(), reqAttrs.LoginName, reqAttrs.Password, reqAttrs.TotpCode)
 	if err != nil {
 		return nil, err
 	}
 	return s.convertToAuthenticateResponse(ctx, req, authResults, tok)
 }
 
-func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw string) (*pba.AuthToken, error) {
+func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethodId, loginName, pw, totpCode string) (*pba.AuthToken, error) {
 	const op = "authmethods.(Service).authenticateWithPwRepo"
 	iamRepo, err := s.iamRepoFn()
 	if err != nil {
@@ -115,8 +115,15 @@ func (s Service) authenticateWithPwRepo(ctx context.Context, scopeId, authMethod
 		return nil, err
 	}
 
-	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw)
+	acct, err := pwRepo.Authenticate(ctx, scopeId, authMethodId, loginName, pw, password.WithTotpCode(totpCode))
 	if err != nil {
+		switch {
+		case errors.Match(errors.T(errors.TotpCodeRequired), err):
+			return nil, handlers.InvalidArgumentErrorf("A TOTP code is required to authenticate.",
+				map[string]string{"attributes.totp_code": "This is a required field."})
+		case errors.Match(errors.T(errors.TotpEnrollmentRequired), err):
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Unauthenticated, "Unable to authenticate: the account must be enrolled in TOTP.")
+		}
 		return nil, err
 	}
 	if acct == nil {
@@ -218,5 +225,6 @@ func toStoragePwAuthMethod(ctx context.Context, scopeId string, item *pb.AuthMet
 	if pwAttrs.GetMinPasswordLength() != 0 {
 		u.MinPasswordLength = pwAttrs.GetMinPasswordLength()
 	}
+	u.TotpRequired = pwAttrs.GetTotpRequired()
 	return u, nil
 }
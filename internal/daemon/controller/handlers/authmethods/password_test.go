@@ -21,13 +21,17 @@ import (
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/accounts"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/authmethods"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/authmethods"
 	scopepb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
@@ -435,6 +439,41 @@ func TestUpdate_Password(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Update totp required",
+			req: &pbs.UpdateAuthMethodRequest{
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"attributes.totp_required"},
+				},
+				Item: &pb.AuthMethod{
+					Name: &wrapperspb.StringValue{Value: "ignored"},
+					Attrs: &pb.AuthMethod_PasswordAuthMethodAttributes{
+						PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
+							MinPasswordLength: 42,
+							TotpRequired:      true,
+						},
+					},
+				},
+			},
+			res: &pbs.UpdateAuthMethodResponse{
+				Item: &pb.AuthMethod{
+					ScopeId:     o.GetPublicId(),
+					Name:        &wrapperspb.StringValue{Value: "default"},
+					Description: &wrapperspb.StringValue{Value: "default"},
+					Type:        "password",
+					Attrs: &pb.AuthMethod_PasswordAuthMethodAttributes{
+						PasswordAuthMethodAttributes: &pb.PasswordAuthMethodAttributes{
+							MinPasswordLength:  8,
+							MinLoginNameLength: 3,
+							TotpRequired:       true,
+						},
+					},
+					Scope:                       defaultScopeInfo,
+					AuthorizedActions:           pwAuthorizedActions,
+					AuthorizedCollectionActions: authorizedCollectionActions,
+				},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -718,6 +757,195 @@ func TestAuthenticate_Password(t *testing.T) {
 	}
 }
 
+func TestAuthenticate_PasswordTotp(t *testing.T) {
+	ctx := context.TODO()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	authMethodRepoFn := func() (*am.AuthMethodRepository, error) {
+		return am.NewAuthMethodRepository(ctx, rw, rw, kms)
+	}
+	am := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	iam.TestSetPrimaryAuthMethod(t, iam.TestRepo(t, conn, wrapper), o, am.PublicId)
+
+	acct, err := password.NewAccount(ctx, am.GetPublicId(), password.WithLoginName(testLoginName))
+	require.NoError(t, err)
+
+	pwRepo, err := pwRepoFn()
+	require.NoError(t, err)
+	acct, err = pwRepo.CreateAccount(context.Background(), o.GetPublicId(), acct, password.WithPassword(testPassword))
+	require.NoError(t, err)
+	require.NotNil(t, acct)
+	enrollment, err := pwRepo.EnrollTotp(ctx, o.GetPublicId(), acct.GetPublicId())
+	require.NoError(t, err)
+
+	request := func(code string) *pbs.AuthenticateRequest {
+		return &pbs.AuthenticateRequest{
+			AuthMethodId: am.GetPublicId(),
+			TokenType:    "token",
+			Attrs: &pbs.AuthenticateRequest_PasswordLoginAttributes{
+				PasswordLoginAttributes: &pbs.PasswordLoginAttributes{
+					LoginName: testLoginName,
+					Password:  testPassword,
+					TotpCode:  code,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name            string
+		request         *pbs.AuthenticateRequest
+		wantErr         error
+		wantErrContains string
+	}{
+		{
+			name:            "missing-code",
+			request:         request(""),
+			wantErr:         handlers.ApiErrorWithCode(codes.InvalidArgument),
+			wantErrContains: `Details: {{name: "attributes.totp_code", desc: "This is a required field."}}`,
+		},
+		{
+			name:    "wrong-code",
+			request: request("000000x"),
+			wantErr: handlers.ApiErrorWithCode(codes.Unauthenticated),
+		},
+		{
+			name:    "recovery-code",
+			request: request(enrollment.RecoveryCodes[0]),
+		},
+		{
+			name:    "reused-recovery-code",
+			request: request(enrollment.RecoveryCodes[0]),
+			wantErr: handlers.ApiErrorWithCode(codes.Unauthenticated),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+			require.NoError(err)
+
+			resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), tc.request)
+			if tc.wantErr != nil {
+				assert.Error(err)
+				assert.Truef(errors.Is(err, tc.wantErr), "Got %#v, wanted %#v", err, tc.wantErr)
+				if tc.wantErrContains != "" {
+					assert.Contains(err.Error(), tc.wantErrContains)
+				}
+				return
+			}
+			require.NoError(err)
+			aToken := resp.GetAuthTokenResponse()
+			assert.NotEmpty(aToken.GetToken())
+			assert.Equal(acct.GetPublicId(), aToken.GetAccountId())
+		})
+	}
+}
+
+func TestAuthenticate_PasswordTotpDefaultGrants(t *testing.T) {
+	ctx := context.TODO()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	// The org is created with its default role, so the user only has the
+	// default grants.
+	o, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	oidcRepoFn := func() (*oidc.Repository, error) {
+		return oidc.NewRepository(ctx, rw, rw, kms)
+	}
+	ldapRepoFn := func() (*ldap.Repository, error) {
+		return ldap.NewRepository(ctx, rw, rw, kms)
+	}
+	pwRepoFn := func() (*password.Repository, error) {
+		return password.NewRepository(ctx, rw, rw, kms)
+	}
+	atRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	authMethodRepoFn := func() (*am.AuthMethodRepository, error) {
+		return am.NewAuthMethodRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+	authMethod := password.TestAuthMethods(t, conn, o.GetPublicId(), 1)[0]
+
+	acct, err := password.NewAccount(ctx, authMethod.GetPublicId(), password.WithLoginName(testLoginName))
+	require.NoError(err)
+	pwRepo, err := pwRepoFn()
+	require.NoError(err)
+	acct, err = pwRepo.CreateAccount(ctx, o.GetPublicId(), acct, password.WithPassword(testPassword))
+	require.NoError(err)
+	iamRepo, err := iamRepoFn()
+	require.NoError(err)
+	u := iam.TestUser(t, iamRepo, o.GetPublicId(), iam.WithAccountIds(acct.GetPublicId()))
+	atRepo, err := atRepoFn()
+	require.NoError(err)
+	at, err := atRepo.CreateAuthToken(ctx, u, acct.GetPublicId())
+	require.NoError(err)
+
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	authCtx := auth.NewVerifierContext(requestContext, iamRepoFn, atRepoFn, serversRepoFn, kms, &requestInfo)
+
+	acctService, err := accounts.NewService(ctx, pwRepoFn, oidcRepoFn, ldapRepoFn, 1000)
+	require.NoError(err)
+	enrollment, err := acctService.EnrollTotp(authCtx, &pbs.EnrollTotpRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+
+	s, err := authmethods.NewService(ctx, kms, pwRepoFn, oidcRepoFn, iamRepoFn, atRepoFn, ldapRepoFn, authMethodRepoFn, 1000)
+	require.NoError(err)
+	resp, err := s.Authenticate(auth.DisabledAuthTestContext(iamRepoFn, o.GetPublicId()), &pbs.AuthenticateRequest{
+		AuthMethodId: authMethod.GetPublicId(),
+		TokenType:    "token",
+		Attrs: &pbs.AuthenticateRequest_PasswordLoginAttributes{
+			PasswordLoginAttributes: &pbs.PasswordLoginAttributes{
+				LoginName: testLoginName,
+				Password:  testPassword,
+				TotpCode:  password.TestTotpCode(t, enrollment.GetSecret()),
+			},
+		},
+	})
+	require.NoError(err)
+	assert.NotEmpty(resp.GetAuthTokenResponse().GetToken())
+	assert.Equal(acct.GetPublicId(), resp.GetAuthTokenResponse().GetAccountId())
+
+	// The user can also remove their own TOTP enrollment.
+	_, err = acctService.RemoveTotp(authCtx, &pbs.RemoveTotpRequest{Id: acct.GetPublicId()})
+	require.NoError(err)
+}
+
 func TestAuthenticate_AuthAccountConnectedToIamUser_Password(t *testing.T) {
 	ctx := context.TODO()
 	assert, require := assert.New(t), require.New(t)
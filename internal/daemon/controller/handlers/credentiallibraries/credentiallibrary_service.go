@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
+	sshcastore "github.com/hashicorp/boundary/internal/credential/sshca/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -39,6 +41,7 @@ const (
 	vaultPathField             = "attributes.path"
 	httpMethodField            = "attributes.http_method"
 	httpRequestBodyField       = "attributes.http_request_body"
+	secretVersionField         = "attributes.secret_version"
 	credentialMappingPathField = "credential_mapping_overrides"
 	sshCertUsernameField       = "attributes.username"
 	keyTypeField               = "attributes.key_type"
@@ -59,6 +62,7 @@ const (
 var (
 	maskManager        handlers.MaskManager
 	sshCertMaskManager handlers.MaskManager
+	sshCaMaskManager   handlers.MaskManager
 
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
@@ -105,6 +109,13 @@ func init() {
 	); err != nil {
 		panic(err)
 	}
+	if sshCaMaskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&sshcastore.CertificateCredentialLibrary{}},
+		handlers.MaskSource{&pb.CredentialLibrary{}, &pb.SshCaCertificateCredentialLibraryAttributes{}},
+	); err != nil {
+		panic(err)
+	}
 
 	// TODO: refactor to remove IdActionsMap and CollectionActions package variables
 	action.RegisterResource(resource.CredentialLibrary, IdActions, CollectionActions)
@@ -116,6 +127,7 @@ type Service struct {
 
 	iamRepoFn   common.IamRepoFactory
 	repoFn      common.VaultCredentialRepoFactory
+	sshCaRepoFn common.SshCaCredentialRepoFactory
 	maxPageSize uint
 }
 
@@ -126,6 +138,7 @@ func NewService(
 	ctx context.Context,
 	iamRepoFn common.IamRepoFactory,
 	repoFn common.VaultCredentialRepoFactory,
+	sshCaRepoFn common.SshCaCredentialRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
 	const op = "credentiallibraries.NewService"
@@ -135,12 +148,16 @@ func NewService(
 	if repoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	}
+	if sshCaRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ssh ca credential repository")
+	}
 	if maxPageSize == 0 {
 		maxPageSize = uint(globals.DefaultMaxPageSize)
 	}
 	return Service{
 		iamRepoFn:   iamRepoFn,
 		repoFn:      repoFn,
+		sshCaRepoFn: sshCaRepoFn,
 		maxPageSize: maxPageSize,
 	}, nil
 }
@@ -194,7 +211,14 @@ func (s Service) ListCredentialLibraries(ctx context.Context, req *pbs.ListCrede
 			return true, nil
 		}
 	}
-	repo, err := s.repoFn()
+	var repo credential.LibraryService
+	var err error
+	switch globals.ResourceInfoFromPrefix(req.GetCredentialStoreId()).Subtype {
+	case sshca.Subtype:
+		repo, err = s.sshCaRepoFn()
+	default:
+		repo, err = s.repoFn()
+	}
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
@@ -364,6 +388,16 @@ func (s Service) UpdateCredentialLibrary(ctx context.Context, req *pbs.UpdateCre
 	var currentCredentialType globals.CredentialType
 	var mo vault.MappingOverride
 	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
+	case sshca.CertificateLibrarySubtype:
+		sshCaRepo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, err
+		}
+		cur, err := sshCaRepo.LookupCertificateCredentialLibrary(ctx, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		currentCredentialType = globals.CredentialType(cur.GetCredentialType())
 	case vault.SSHCertificateLibrarySubtype:
 		cur, err := repo.LookupSSHCertificateCredentialLibrary(ctx, req.Id)
 		if err != nil {
@@ -454,6 +488,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Library
 			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential library %q not found", id))
 		}
 		return cs, err
+	case sshca.CertificateLibrarySubtype:
+		sshCaRepo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := sshCaRepo.LookupCertificateCredentialLibrary(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs == nil {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate credential library %q not found", id))
+		}
+		return cs, err
 	}
 	return nil, errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype")
 }
@@ -479,6 +526,23 @@ func (s Service) createInRepo(ctx context.Context, scopeId string, item *pb.Cred
 			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh certificate credential library but no error returned from repository.")
 		}
 		out = rl
+	case sshca.CertificateLibrarySubtype.String():
+		cl, err := toStorageSshCaCertificateLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		rl, err := repo.CreateCertificateCredentialLibrary(ctx, scopeId, cl)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create ssh certificate credential library"))
+		}
+		if rl == nil {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create ssh certificate credential library but no error returned from repository.")
+		}
+		out = rl
 	default:
 		cl, err := toStorageVaultLibrary(ctx, item.GetCredentialStoreId(), item)
 		if err != nil {
@@ -555,6 +619,33 @@ func (s Service) updateInRepo(
 		if rowsUpdated == 0 {
 			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
 		}
+	case sshca.CertificateLibrarySubtype:
+		dbMasks = append(dbMasks, sshCaMaskManager.Translate(masks)...)
+		if getMapUpdate(criticalOptionsField, masks) {
+			dbMasks = append(dbMasks, sshca.CriticalOptionsField)
+		}
+		if getMapUpdate(extensionsField, masks) {
+			dbMasks = append(dbMasks, sshca.ExtensionsField)
+		}
+		if len(dbMasks) == 0 {
+			return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+		}
+		cl, err := toStorageSshCaCertificateLibrary(ctx, item.GetCredentialStoreId(), item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cl.PublicId = id
+		sshCaRepo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = sshCaRepo.UpdateCertificateCredentialLibrary(ctx, projId, cl, item.GetVersion(), dbMasks)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential library"))
+		}
+		if rowsUpdated == 0 {
+			return nil, handlers.NotFoundErrorf("Credential Library %q doesn't exist or incorrect version provided.", id)
+		}
 	default:
 		dbMasks = append(dbMasks, maskManager.Translate(masks)...)
 		if len(dbMasks) == 0 {
@@ -586,6 +677,13 @@ func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool,
 	switch globals.ResourceInfoFromPrefix(id).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
 		rows, err = repo.DeleteSSHCertificateCredentialLibrary(ctx, scopeId, id)
+	case sshca.CertificateLibrarySubtype:
+		var sshCaRepo *sshca.Repository
+		sshCaRepo, err = s.sshCaRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = sshCaRepo.DeleteCertificateCredentialLibrary(ctx, scopeId, id)
 	default:
 		rows, err = repo.DeleteCredentialLibrary(ctx, scopeId, id)
 	}
@@ -606,6 +704,11 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	sshCaRepo, err := s.sshCaRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialLibrary), auth.WithAction(a)}
@@ -638,6 +741,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cl.GetStoreId()
+		case sshca.CertificateLibrarySubtype:
+			cl, err := sshCaRepo.LookupCertificateCredentialLibrary(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cl == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cl.GetStoreId()
 		default:
 			res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential library subtype from id")
 			return res
@@ -663,6 +777,17 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 			return res
 		}
 		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
+	case sshca.Subtype:
+		cs, err := sshCaRepo.LookupCredentialStore(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if cs == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		opts = append(opts, auth.WithScopeId(cs.GetProjectId()))
 	default:
 		res.Error = errors.New(ctx, errors.InvalidParameter, op, "unrecognized credential store subtype from id")
 		return res
@@ -790,6 +915,9 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 			if vaultIn.GetHttpRequestBody() != nil {
 				attrs.HttpRequestBody = wrapperspb.String(string(vaultIn.GetHttpRequestBody()))
 			}
+			if vaultIn.GetSecretVersion() != 0 {
+				attrs.SecretVersion = wrapperspb.UInt32(vaultIn.GetSecretVersion())
+			}
 			out.Attrs = &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
 				VaultGenericCredentialLibraryAttributes: attrs,
 			}
@@ -841,7 +969,50 @@ func toProto(ctx context.Context, in credential.Library, opt ...handlers.Option)
 				VaultSshCertificateCredentialLibraryAttributes: attrs,
 			}
 		}
-
+	case sshca.CertificateLibrarySubtype:
+		sshCaIn, ok := in.(*sshca.CertificateCredentialLibrary)
+		if !ok {
+			return nil, errors.New(ctx, errors.Internal, op, "unable to cast to ssh ca certificate credential library")
+		}
+		out.CredentialType = sshCaIn.GetCredentialType()
+		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.SshCaCertificateCredentialLibraryAttributes{}
+			if sshCaIn.GetUsername() != "" {
+				attrs.Username = wrapperspb.String(sshCaIn.GetUsername())
+			}
+			if sshCaIn.GetKeyType() != "" {
+				attrs.KeyType = wrapperspb.String(sshCaIn.GetKeyType())
+			}
+			if sshCaIn.GetKeyBits() != 0 {
+				attrs.KeyBits = &wrapperspb.UInt32Value{Value: sshCaIn.GetKeyBits()}
+			}
+			if sshCaIn.GetTtl() != "" {
+				attrs.Ttl = wrapperspb.String(sshCaIn.GetTtl())
+			}
+			if sshCaIn.GetKeyId() != "" {
+				attrs.KeyId = wrapperspb.String(sshCaIn.GetKeyId())
+			}
+			if sshCaIn.GetCriticalOptions() != "" {
+				co := make(map[string]string)
+				json.Unmarshal([]byte(sshCaIn.GetCriticalOptions()), &co)
+				attrs.CriticalOptions = co
+			}
+			if sshCaIn.GetExtensions() != "" {
+				e := make(map[string]string)
+				json.Unmarshal([]byte(sshCaIn.GetExtensions()), &e)
+				attrs.Extensions = e
+			}
+			if sshCaIn.GetAdditionalValidPrincipals() != "" {
+				avp := strings.Split(sshCaIn.GetAdditionalValidPrincipals(), ",")
+				attrs.AdditionalValidPrincipals = make([]*wrapperspb.StringValue, len(avp))
+				for i, p := range avp {
+					attrs.AdditionalValidPrincipals[i] = &wrapperspb.StringValue{Value: p}
+				}
+			}
+			out.Attrs = &pb.CredentialLibrary_SshCaCertificateCredentialLibraryAttributes{
+				SshCaCertificateCredentialLibraryAttributes: attrs,
+			}
+		}
 	}
 	return &out, nil
 }
@@ -867,6 +1038,9 @@ func toStorageVaultLibrary(ctx context.Context, storeId string, in *pb.Credentia
 	if attrs.GetHttpRequestBody() != nil {
 		opts = append(opts, vault.WithRequestBody([]byte(attrs.GetHttpRequestBody().GetValue())))
 	}
+	if attrs.GetSecretVersion() != nil {
+		opts = append(opts, vault.WithSecretVersion(attrs.GetSecretVersion().GetValue()))
+	}
 
 	credentialType := globals.CredentialType(in.GetCredentialType())
 	switch credentialType {
@@ -962,6 +1136,58 @@ func toStorageVaultSSHCertificateLibrary(ctx context.Context, storeId string, in
 	return cs, err
 }
 
+func toStorageSshCaCertificateLibrary(ctx context.Context, storeId string, in *pb.CredentialLibrary) (out *sshca.CertificateCredentialLibrary, err error) {
+	const op = "credentiallibraries.toStorageSshCaCertificateLibrary"
+	var opts []sshca.Option
+	if in.GetName() != nil {
+		opts = append(opts, sshca.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, sshca.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetSshCaCertificateCredentialLibraryAttributes()
+	if attrs.GetKeyType() != nil {
+		opts = append(opts, sshca.WithKeyType(attrs.GetKeyType().GetValue()))
+	}
+	if attrs.GetKeyBits() != nil {
+		opts = append(opts, sshca.WithKeyBits(attrs.GetKeyBits().GetValue()))
+	}
+	if attrs.GetTtl() != nil {
+		opts = append(opts, sshca.WithTtl(attrs.GetTtl().GetValue()))
+	}
+	if attrs.GetKeyId() != nil {
+		opts = append(opts, sshca.WithKeyId(attrs.GetKeyId().GetValue()))
+	}
+	if attrs.GetCriticalOptions() != nil {
+		co, err := json.Marshal(attrs.GetCriticalOptions())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sshca.WithCriticalOptions(string(co)))
+	}
+	if attrs.GetExtensions() != nil {
+		e, err := json.Marshal(attrs.GetExtensions())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sshca.WithExtensions(string(e)))
+	}
+	if attrs.GetAdditionalValidPrincipals() != nil {
+		avp := make([]string, len(attrs.GetAdditionalValidPrincipals()))
+		for i, p := range attrs.GetAdditionalValidPrincipals() {
+			avp[i] = p.GetValue()
+		}
+		opts = append(opts, sshca.WithAdditionalValidPrincipals(avp))
+	}
+
+	cl, err := sshca.NewCertificateCredentialLibrary(storeId, attrs.GetUsername().GetValue(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential library"))
+	}
+	return cl, err
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -972,6 +1198,8 @@ func validateGetRequest(req *pbs.GetCredentialLibraryRequest) error {
 	switch globals.ResourceInfoFromPrefix(req.GetId()).Subtype {
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case sshca.CertificateLibrarySubtype:
+		prefix = globals.SshCaCertificateCredentialLibraryPrefix
 	default:
 		prefix = globals.VaultCredentialLibraryPrefix
 	}
@@ -1053,6 +1281,14 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) != "POST" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				if v := attrs.GetSecretVersion(); v != nil {
+					switch {
+					case v.GetValue() == 0:
+						badFields[secretVersionField] = "If set, value must be greater than zero."
+					case strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST":
+						badFields[secretVersionField] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+					}
+				}
 				validateMapping(badFields, globals.CredentialType(req.GetItem().GetCredentialType()), req.GetItem().CredentialMappingOverrides.AsMap())
 			case vault.SSHCertificateLibrarySubtype.String():
 				if req.GetItem().GetCredentialType() != "" {
@@ -1082,6 +1318,28 @@ func validateCreateRequest(req *pbs.CreateCredentialLibraryRequest) error {
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case sshca.Subtype:
+			if t := req.GetItem().GetType(); t != sshca.CertificateLibrarySubtype.String() {
+				badFields[globals.TypeField] = fmt.Sprintf("Type must be %q for ssh ca credential stores", sshca.CertificateLibrarySubtype.String())
+			}
+			if req.GetItem().GetCredentialType() != "" {
+				badFields[globals.CredentialTypeField] = "This field is read only and cannot be set."
+			}
+			if len(req.GetItem().GetCredentialMappingOverrides().AsMap()) > 0 {
+				badFields[globals.CredentialMappingOverridesField] = "This field is not supported for this credential library type."
+			}
+
+			attrs := req.GetItem().GetSshCaCertificateCredentialLibraryAttributes()
+			if attrs == nil {
+				badFields[attributesPathField] = "This is a required field."
+			}
+			if attrs.GetUsername().GetValue() == "" {
+				badFields[sshCertUsernameField] = "This is a required field."
+			}
+			if t := attrs.GetKeyType(); t != nil && !strutil.StrListContains(validKeyTypes, strings.ToLower(t.GetValue())) {
+				badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+			}
+			validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 		default:
 			badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 		}
@@ -1097,6 +1355,8 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 		prefix = globals.VaultCredentialLibraryPrefix
 	case vault.SSHCertificateLibrarySubtype:
 		prefix = globals.VaultSshCertificateCredentialLibraryPrefix
+	case sshca.CertificateLibrarySubtype:
+		prefix = globals.SshCaCertificateCredentialLibraryPrefix
 	}
 	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
 		badFields := map[string]string{}
@@ -1123,6 +1383,14 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				if b := attrs.GetHttpRequestBody(); b != nil && strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "GET" {
 					badFields[httpRequestBodyField] = fmt.Sprintf("Field can only be set if %q is set to the value 'POST'.", httpMethodField)
 				}
+				if v := attrs.GetSecretVersion(); v != nil {
+					switch {
+					case v.GetValue() == 0:
+						badFields[secretVersionField] = "If set, value must be greater than zero."
+					case strings.ToUpper(attrs.GetHttpMethod().GetValue()) == "POST":
+						badFields[secretVersionField] = fmt.Sprintf("Field can only be set if %q is set to the value 'GET'.", httpMethodField)
+					}
+				}
 				validateMapping(badFields, currentCredentialType, req.GetItem().CredentialMappingOverrides.AsMap())
 			}
 		case vault.SSHCertificateLibrarySubtype:
@@ -1145,18 +1413,35 @@ func validateUpdateRequest(req *pbs.UpdateCredentialLibraryRequest, currentCrede
 				}
 				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
 			}
+		case sshca.CertificateLibrarySubtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != sshca.CertificateLibrarySubtype.String() {
+				badFields[globals.TypeField] = "Cannot modify resource type."
+			}
+			if req.GetItem().GetCredentialType() != "" && req.GetItem().GetCredentialType() != string(currentCredentialType) {
+				badFields[globals.CredentialTypeField] = "Cannot modify credential type."
+			}
+			attrs := req.GetItem().GetSshCaCertificateCredentialLibraryAttributes()
+			if attrs != nil {
+				if u := attrs.GetUsername().GetValue(); handlers.MaskContains(req.GetUpdateMask().GetPaths(), sshCertUsernameField) && u == "" {
+					badFields[sshCertUsernameField] = "This is a required field and cannot be set to empty."
+				}
+				if t := attrs.GetKeyType(); t != nil && !strutil.StrListContains(validKeyTypes, strings.ToLower(t.GetValue())) {
+					badFields[keyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+				}
+				validateKeyBits(badFields, attrs.GetKeyBits().GetValue(), attrs.GetKeyType().GetValue())
+			}
 		}
 		return badFields
 	}, prefix)
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialLibraryRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialLibraryPrefix, globals.VaultSshCertificateCredentialLibraryPrefix, globals.SshCaCertificateCredentialLibraryPrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialLibrariesRequest) error {
 	badFields := map[string]string{}
-	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix) {
+	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.VaultCredentialStorePrefix, globals.SshCaCredentialStorePrefix) {
 		badFields[globals.CredentialStoreIdField] = "This field must be a valid credential store id."
 	}
 	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -101,6 +102,9 @@ func TestList(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 
 	_, prjNoLibs := iam.TestScopes(t, iamRepo)
 	storeNoLibs := vault.TestCredentialStores(t, conn, wrapper, prjNoLibs.GetPublicId(), 1)[0]
@@ -202,7 +206,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 			require.NoError(t, err)
 			// Test non-anonymous listing
 			got, gErr := s.ListCredentialLibraries(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -261,6 +265,9 @@ func TestList_Attributes(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
@@ -321,7 +328,7 @@ func TestList_Attributes(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 			require.NoError(t, err)
 			// Test non-anonymous listing
 			got, gErr := s.ListCredentialLibraries(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
@@ -379,6 +386,9 @@ func TestCreate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -522,6 +532,37 @@ func TestCreate(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Secret Version With POST Method",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+					VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+						Path:          wrapperspb.String("something"),
+						HttpMethod:    wrapperspb.String("POST"),
+						SecretVersion: wrapperspb.UInt32(2),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Zero Secret Version",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+					VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+						Path:          wrapperspb.String("something"),
+						SecretVersion: wrapperspb.UInt32(0),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Invalid credential type",
 			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
@@ -596,6 +637,40 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Using secret version",
+			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
+				CredentialStoreId: store.GetPublicId(),
+				Type:              vault.GenericLibrarySubtype.String(),
+				Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+					VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+						Path:          wrapperspb.String("secret/data/something"),
+						SecretVersion: wrapperspb.UInt32(2),
+					},
+				},
+			}},
+			idPrefix: globals.VaultCredentialLibraryPrefix + "_",
+			res: &pbs.CreateCredentialLibraryResponse{
+				Uri: fmt.Sprintf("credential-libraries/%s_", globals.VaultCredentialLibraryPrefix),
+				Item: &pb.CredentialLibrary{
+					Id:                store.GetPublicId(),
+					CredentialStoreId: store.GetPublicId(),
+					CreatedTime:       store.GetCreateTime().GetTimestamp(),
+					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
+					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
+					Version:           1,
+					Type:              vault.GenericLibrarySubtype.String(),
+					Attrs: &pb.CredentialLibrary_VaultGenericCredentialLibraryAttributes{
+						VaultGenericCredentialLibraryAttributes: &pb.VaultCredentialLibraryAttributes{
+							Path:          wrapperspb.String("secret/data/something"),
+							HttpMethod:    wrapperspb.String("GET"),
+							SecretVersion: wrapperspb.UInt32(2),
+						},
+					},
+					AuthorizedActions: testAuthorizedActions,
+				},
+			},
+		},
 		{
 			name: "Create a valid vault CredentialLibrary",
 			req: &pbs.CreateCredentialLibraryRequest{Item: &pb.CredentialLibrary{
@@ -893,7 +968,7 @@ func TestCreate(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 			require.NoError(err)
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
 			if tc.wantErr || tc.err != nil {
@@ -950,12 +1025,15 @@ func TestGet(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	unspecifiedLib := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 	require.NoError(t, err)
 	repo, err := repoFn()
 	require.NoError(t, err)
@@ -1161,13 +1239,16 @@ func TestDelete(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	vl := vault.TestCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
 	vl2 := vault.TestSSHCertificateCredentialLibraries(t, conn, wrapper, store.GetPublicId(), 1)[0]
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 	require.NoError(t, err)
 	cases := []struct {
 		name string
@@ -1226,11 +1307,14 @@ func TestUpdate(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(testCtx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(testCtx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(testCtx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2004,6 +2088,9 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	store := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
@@ -2279,7 +2366,7 @@ func TestCreate_SSHCertificateCredentialLibrary(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 			require.NoError(err)
 			got, gErr := s.CreateCredentialLibrary(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
 			if tc.wantErr || tc.err != nil {
@@ -2336,11 +2423,14 @@ func TestUpdate_SSHCertificateCredentialLibrary(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(testCtx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
 
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(testCtx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(testCtx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 	require.NoError(t, err)
 	cs := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)
 	store, diffStore := cs[0], cs[1]
@@ -2735,6 +2825,9 @@ func TestListPagination(t *testing.T) {
 	repoFn := func() (*vault.Repository, error) {
 		return vault.NewRepository(ctx, rw, rw, kms, sche)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -2790,7 +2883,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, repoFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, repoFn, sshCaRepoFn, 1000)
 	require.NoError(err)
 	// Start paginating, recursively
 	req := &pbs.ListCredentialLibrariesRequest{
@@ -55,6 +55,8 @@ var (
 		action.Read,
 		action.Update,
 		action.Delete,
+		action.Rotate,
+		action.Rollback,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -392,6 +394,82 @@ func (s Service) DeleteCredential(ctx context.Context, req *pbs.DeleteCredential
 	return nil, nil
 }
 
+// RotateCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) RotateCredential(ctx context.Context, req *pbs.RotateCredentialRequest) (*pbs.RotateCredentialResponse, error) {
+	const op = "credentials.(Service).RotateCredential"
+
+	if err := validateRotateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Rotate)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.rotateInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetVersion(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RotateCredentialResponse{Item: item}, nil
+}
+
+// RollbackCredential implements the interface pbs.CredentialServiceServer.
+func (s Service) RollbackCredential(ctx context.Context, req *pbs.RollbackCredentialRequest) (*pbs.RollbackCredentialResponse, error) {
+	const op = "credentials.(Service).RollbackCredential"
+
+	if err := validateRollbackRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Rollback)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	c, err := s.rollbackInRepo(ctx, authResults.Scope.GetId(), req.GetId(), req.GetVersion(), req.GetCredentialVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, c.GetPublicId(), IdActions).Strings()))
+	}
+
+	item, err := toProto(c, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.RollbackCredentialResponse{Item: item}, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (credential.Static, error) {
 	const op = "credentials.(Service).getFromRepo"
 	repo, err := s.repoFn()
@@ -567,6 +645,42 @@ func (s Service) updateInRepo(
 	}
 }
 
+func (s Service) rotateInRepo(ctx context.Context, scopeId, id string, version uint32, password string) (credential.Static, error) {
+	const op = "credentials.(Service).rotateInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var opts []static.Option
+	if password != "" {
+		opts = append(opts, static.WithPassword(credential.Password(password)))
+	}
+	out, rowsUpdated, err := repo.RotateUsernamePasswordCredential(ctx, scopeId, id, version, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to rotate credential"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s Service) rollbackInRepo(ctx context.Context, scopeId, id string, version, credentialVersion uint32) (credential.Static, error) {
+	const op = "credentials.(Service).rollbackInRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.RollbackCredential(ctx, scopeId, id, version, credentialVersion)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to roll back credential"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Credential %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
 func (s Service) deleteFromRepo(ctx context.Context, scopeId, id string) (bool, error) {
 	const op = "credentials.(Service).deleteFromRepo"
 	repo, err := s.repoFn()
@@ -710,15 +824,25 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 
 	switch cred := in.(type) {
 	case *static.UsernamePasswordCredential:
+		if outputFields.Has(globals.CredentialVersionField) {
+			out.CredentialVersion = cred.GetCredentialVersion()
+		}
 		if outputFields.Has(globals.AttributesField) {
+			attrs := &pb.UsernamePasswordAttributes{
+				Username:     wrapperspb.String(cred.GetUsername()),
+				PasswordHmac: base64.RawURLEncoding.EncodeToString(cred.GetPasswordHmac()),
+			}
+			if cred.GetRotationPeriodSeconds() > 0 {
+				attrs.RotationPeriodSeconds = wrapperspb.UInt32(cred.GetRotationPeriodSeconds())
+			}
 			out.Attrs = &pb.Credential_UsernamePasswordAttributes{
-				UsernamePasswordAttributes: &pb.UsernamePasswordAttributes{
-					Username:     wrapperspb.String(cred.GetUsername()),
-					PasswordHmac: base64.RawURLEncoding.EncodeToString(cred.GetPasswordHmac()),
-				},
+				UsernamePasswordAttributes: attrs,
 			}
 		}
 	case *static.SshPrivateKeyCredential:
+		if outputFields.Has(globals.CredentialVersionField) {
+			out.CredentialVersion = cred.GetCredentialVersion()
+		}
 		if outputFields.Has(globals.AttributesField) {
 			out.Attrs = &pb.Credential_SshPrivateKeyAttributes{
 				SshPrivateKeyAttributes: &pb.SshPrivateKeyAttributes{
@@ -729,6 +853,9 @@ func toProto(in credential.Static, opt ...handlers.Option) (*pb.Credential, erro
 			}
 		}
 	case *static.JsonCredential:
+		if outputFields.Has(globals.CredentialVersionField) {
+			out.CredentialVersion = cred.GetCredentialVersion()
+		}
 		if outputFields.Has(globals.AttributesField) {
 			out.Attrs = &pb.Credential_JsonAttributes{
 				JsonAttributes: &pb.JsonAttributes{
@@ -751,6 +878,9 @@ func toUsernamePasswordStorageCredential(ctx context.Context, storeId string, in
 	}
 
 	attrs := in.GetUsernamePasswordAttributes()
+	if attrs.GetRotationPeriodSeconds() != nil {
+		opts = append(opts, static.WithRotationPeriodSeconds(attrs.GetRotationPeriodSeconds().GetValue()))
+	}
 	cs, err := static.NewUsernamePasswordCredential(
 		storeId,
 		attrs.GetUsername().GetValue(),
@@ -978,6 +1108,42 @@ func validateDeleteRequest(req *pbs.DeleteCredentialRequest) error {
 	)
 }
 
+func validateRotateRequest(req *pbs.RotateCredentialRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.UsernamePasswordCredentialPrefix, globals.UsernamePasswordCredentialPreviousPrefix) {
+		badFields[globals.IdField] = "Only username password credentials can be rotated."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateRollbackRequest(req *pbs.RollbackCredentialRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()),
+		globals.UsernamePasswordCredentialPrefix,
+		globals.UsernamePasswordCredentialPreviousPrefix,
+		globals.SshPrivateKeyCredentialPrefix,
+		globals.JsonCredentialPrefix,
+	) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields[globals.VersionField] = "Required field."
+	}
+	if req.GetCredentialVersion() == 0 {
+		badFields[globals.CredentialVersionField] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetCredentialStoreId()), globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix) {
@@ -48,7 +48,7 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "rotate", "rollback"}
 
 func staticJsonCredentialToProto(cred *static.JsonCredential, prj *iam.Scope, hmac string) *pb.Credential {
 	return &pb.Credential{
@@ -58,6 +58,7 @@ func staticJsonCredentialToProto(cred *static.JsonCredential, prj *iam.Scope, hm
 		CreatedTime:       cred.GetCreateTime().GetTimestamp(),
 		UpdatedTime:       cred.GetUpdateTime().GetTimestamp(),
 		Version:           cred.GetVersion(),
+		CredentialVersion: cred.GetCredentialVersion(),
 		Type:              credential.JsonSubtype.String(),
 		AuthorizedActions: testAuthorizedActions,
 		Attrs: &pb.Credential_JsonAttributes{
@@ -76,6 +77,7 @@ func staticPasswordCredentialToProto(cred *static.UsernamePasswordCredential, pr
 		CreatedTime:       cred.GetCreateTime().GetTimestamp(),
 		UpdatedTime:       cred.GetUpdateTime().GetTimestamp(),
 		Version:           cred.GetVersion(),
+		CredentialVersion: cred.GetCredentialVersion(),
 		Type:              credential.UsernamePasswordSubtype.String(),
 		AuthorizedActions: testAuthorizedActions,
 		Attrs: &pb.Credential_UsernamePasswordAttributes{
@@ -95,6 +97,7 @@ func staticSshCredentialToProto(cred *static.SshPrivateKeyCredential, prj *iam.S
 		CreatedTime:       cred.GetCreateTime().GetTimestamp(),
 		UpdatedTime:       cred.GetUpdateTime().GetTimestamp(),
 		Version:           cred.GetVersion(),
+		CredentialVersion: cred.GetCredentialVersion(),
 		Type:              credential.SshPrivateKeySubtype.String(),
 		AuthorizedActions: testAuthorizedActions,
 		Attrs: &pb.Credential_SshPrivateKeyAttributes{
@@ -371,6 +374,7 @@ func TestGet(t *testing.T) {
 					CreatedTime:       upCred.CreateTime.GetTimestamp(),
 					UpdatedTime:       upCred.UpdateTime.GetTimestamp(),
 					Version:           1,
+					CredentialVersion: 1,
 					Attrs: &pb.Credential_UsernamePasswordAttributes{
 						UsernamePasswordAttributes: &pb.UsernamePasswordAttributes{
 							Username:     wrapperspb.String("user"),
@@ -393,6 +397,7 @@ func TestGet(t *testing.T) {
 					CreatedTime:       upCredPrev.CreateTime.GetTimestamp(),
 					UpdatedTime:       upCredPrev.UpdateTime.GetTimestamp(),
 					Version:           1,
+					CredentialVersion: 1,
 					Attrs: &pb.Credential_UsernamePasswordAttributes{
 						UsernamePasswordAttributes: &pb.UsernamePasswordAttributes{
 							Username:     wrapperspb.String("user"),
@@ -415,6 +420,7 @@ func TestGet(t *testing.T) {
 					CreatedTime:       spkCred.CreateTime.GetTimestamp(),
 					UpdatedTime:       spkCred.UpdateTime.GetTimestamp(),
 					Version:           1,
+					CredentialVersion: 1,
 					Attrs: &pb.Credential_SshPrivateKeyAttributes{
 						SshPrivateKeyAttributes: &pb.SshPrivateKeyAttributes{
 							Username:       wrapperspb.String("user"),
@@ -437,6 +443,7 @@ func TestGet(t *testing.T) {
 					CreatedTime:       spkCredWithPass.CreateTime.GetTimestamp(),
 					UpdatedTime:       spkCredWithPass.UpdateTime.GetTimestamp(),
 					Version:           1,
+					CredentialVersion: 1,
 					Attrs: &pb.Credential_SshPrivateKeyAttributes{
 						SshPrivateKeyAttributes: &pb.SshPrivateKeyAttributes{
 							Username:                 wrapperspb.String("user"),
@@ -460,6 +467,7 @@ func TestGet(t *testing.T) {
 					CreatedTime:       jsonCred.CreateTime.GetTimestamp(),
 					UpdatedTime:       jsonCred.UpdateTime.GetTimestamp(),
 					Version:           1,
+					CredentialVersion: 1,
 					Attrs: &pb.Credential_JsonAttributes{
 						JsonAttributes: &pb.JsonAttributes{
 							ObjectHmac: base64.RawURLEncoding.EncodeToString([]byte(objectHmac)),
@@ -586,6 +594,147 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestRotate(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+	require.NoError(t, err)
+
+	upCred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId())
+	spkCred := static.TestSshPrivateKeyCredential(t, conn, wrapper, "user", static.TestSshPrivateKeyPem, store.GetPublicId(), prj.GetPublicId())
+
+	cases := []struct {
+		name string
+		req  *pbs.RotateCredentialRequest
+		err  error
+	}{
+		{
+			name: "spk",
+			req:  &pbs.RotateCredentialRequest{Id: spkCred.GetPublicId(), Version: spkCred.GetVersion(), Password: "new-pass"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing-version",
+			req:  &pbs.RotateCredentialRequest{Id: upCred.GetPublicId(), Password: "new-pass"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "not found error",
+			req:  &pbs.RotateCredentialRequest{Id: fmt.Sprintf("%s_1234567890", globals.UsernamePasswordCredentialPrefix), Version: 1, Password: "new-pass"},
+			err:  handlers.NotFoundError(),
+		},
+		{
+			name: "missing-password-without-rotation-command",
+			req:  &pbs.RotateCredentialRequest{Id: upCred.GetPublicId(), Version: upCred.GetVersion()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "success",
+			req:  &pbs.RotateCredentialRequest{Id: upCred.GetPublicId(), Version: upCred.GetVersion(), Password: "new-pass"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RotateCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RotateCredential(%q) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(upCred.GetVersion()+1, got.GetItem().GetVersion())
+			assert.Equal(uint32(2), got.GetItem().GetCredentialVersion())
+			assert.NotEqual(base64.RawURLEncoding.EncodeToString(upCred.GetPasswordHmac()), got.GetItem().GetUsernamePasswordAttributes().GetPasswordHmac())
+			assert.Empty(got.GetItem().GetUsernamePasswordAttributes().GetPassword())
+			assert.Equal(testAuthorizedActions, got.GetItem().GetAuthorizedActions())
+		})
+	}
+}
+
+func TestRollback(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrapper)
+	rw := db.New(conn)
+
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	staticRepoFn := func() (*static.Repository, error) {
+		return static.NewRepository(context.Background(), rw, rw, kms)
+	}
+
+	_, prj := iam.TestScopes(t, iamRepo)
+
+	store := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
+	s, err := NewService(ctx, iamRepoFn, staticRepoFn, 1000)
+	require.NoError(t, err)
+
+	upCred := static.TestUsernamePasswordCredential(t, conn, wrapper, "user", "pass", store.GetPublicId(), prj.GetPublicId())
+	rotated, err := s.RotateCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()),
+		&pbs.RotateCredentialRequest{Id: upCred.GetPublicId(), Version: upCred.GetVersion(), Password: "new-pass"})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name string
+		req  *pbs.RollbackCredentialRequest
+		err  error
+	}{
+		{
+			name: "missing-credential-version",
+			req:  &pbs.RollbackCredentialRequest{Id: upCred.GetPublicId(), Version: rotated.GetItem().GetVersion()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "bad prefix",
+			req:  &pbs.RollbackCredentialRequest{Id: store.GetPublicId(), Version: 1, CredentialVersion: 1},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "current-credential-version",
+			req:  &pbs.RollbackCredentialRequest{Id: upCred.GetPublicId(), Version: rotated.GetItem().GetVersion(), CredentialVersion: 2},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "success",
+			req:  &pbs.RollbackCredentialRequest{Id: upCred.GetPublicId(), Version: rotated.GetItem().GetVersion(), CredentialVersion: 1},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.RollbackCredential(auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "RollbackCredential(%q) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(rotated.GetItem().GetVersion()+1, got.GetItem().GetVersion())
+			assert.Equal(uint32(3), got.GetItem().GetCredentialVersion())
+			assert.Equal(base64.RawURLEncoding.EncodeToString(upCred.GetPasswordHmac()), got.GetItem().GetUsernamePasswordAttributes().GetPasswordHmac())
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	ctx := context.Background()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -766,6 +915,7 @@ func TestCreate(t *testing.T) {
 					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
 					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
 					Version:           1,
+					CredentialVersion: 1,
 					Type:              credential.UsernamePasswordSubtype.String(),
 					AuthorizedActions: testAuthorizedActions,
 				},
@@ -793,6 +943,7 @@ func TestCreate(t *testing.T) {
 					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
 					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
 					Version:           1,
+					CredentialVersion: 1,
 					Type:              credential.SshPrivateKeySubtype.String(),
 					AuthorizedActions: testAuthorizedActions,
 				},
@@ -821,6 +972,7 @@ func TestCreate(t *testing.T) {
 					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
 					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
 					Version:           1,
+					CredentialVersion: 1,
 					Type:              credential.SshPrivateKeySubtype.String(),
 					AuthorizedActions: testAuthorizedActions,
 				},
@@ -847,6 +999,7 @@ func TestCreate(t *testing.T) {
 					UpdatedTime:       store.GetUpdateTime().GetTimestamp(),
 					Scope:             &scopepb.ScopeInfo{Id: prj.GetPublicId(), Type: prj.GetType(), ParentScopeId: prj.GetParentId()},
 					Version:           1,
+					CredentialVersion: 1,
 					Type:              credential.JsonSubtype.String(),
 					AuthorizedActions: testAuthorizedActions,
 				},
@@ -1109,6 +1262,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 				out.GetUsernamePasswordAttributes().Username = wrapperspb.String("new-user-name")
 				return out
 			},
@@ -1127,6 +1281,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 				out.GetSshPrivateKeyAttributes().Username = wrapperspb.String("new-user-name")
 				return out
 			},
@@ -1145,6 +1300,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 				hm, err := crypto.HmacSha256(context.Background(), []byte("new-password"), databaseWrapper, []byte(store.GetPublicId()), nil, crypto.WithEd25519())
 				require.NoError(t, err)
 				out.GetUsernamePasswordAttributes().PasswordHmac = base64.RawURLEncoding.EncodeToString([]byte(hm))
@@ -1165,6 +1321,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 				hm, err := crypto.HmacSha256(context.Background(), []byte(TestSecondarySshPrivateKeyPem), databaseWrapper, []byte(store.GetPublicId()), nil)
 				require.NoError(t, err)
 				out.GetSshPrivateKeyAttributes().PrivateKeyHmac = base64.RawURLEncoding.EncodeToString([]byte(hm))
@@ -1186,6 +1343,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 
 				out.GetUsernamePasswordAttributes().Username = wrapperspb.String("new-username")
 
@@ -1210,6 +1368,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 
 				out.GetSshPrivateKeyAttributes().Username = wrapperspb.String("new-username")
 
@@ -1234,6 +1393,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 
 				hm, err := crypto.HmacSha256(context.Background(), testdata.PEMEncryptedKeys[0].PEMBytes, databaseWrapper, []byte(store.GetPublicId()), nil)
 				require.NoError(t, err)
@@ -1259,6 +1419,7 @@ func TestUpdate(t *testing.T) {
 			},
 			res: func(in *pb.Credential) *pb.Credential {
 				out := proto.Clone(in).(*pb.Credential)
+				out.CredentialVersion = 2
 
 				hm, err := crypto.HmacSha256(context.Background(), secondSecretBytes, databaseWrapper, []byte(store.GetPublicId()), nil)
 				require.NoError(t, err)
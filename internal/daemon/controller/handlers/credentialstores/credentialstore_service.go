@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/vault"
 	"github.com/hashicorp/boundary/internal/credential/vault/store"
@@ -32,6 +33,7 @@ import (
 	"github.com/hashicorp/boundary/internal/types/subtypes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/credentialstores"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -41,9 +43,17 @@ const (
 	vaultTokenField        = "attributes.token"
 	vaultTokenHmacField    = "attributes.token_hmac"
 	vaultWorkerFilterField = "attributes.worker_filter"
+	vaultAuthMethodField   = "attributes.auth_method"
+	vaultAuthRoleField     = "attributes.auth_role"
+	vaultAuthSecretIdField = "attributes.auth_secret_id"
+	vaultAuthSecretIdHmac  = "attributes.auth_secret_id_hmac"
 	caCertsField           = "attributes.ca_cert"
 	clientCertField        = "attributes.client_certificate"
 	clientCertKeyField     = "attributes.certificate_key"
+	sshCaKeyTypeField      = "attributes.key_type"
+	sshCaKeyBitsField      = "attributes.key_bits"
+	sshCaPrivateKeyField   = "attributes.private_key"
+	sshCaPublicKeyField    = "attributes.public_key"
 	domain                 = "credential"
 )
 
@@ -72,6 +82,9 @@ var (
 	staticCollectionTypeMap = map[resource.Type]action.ActionSet{
 		resource.Credential: credentials.CollectionActions,
 	}
+	sshCaCollectionTypeMap = map[resource.Type]action.ActionSet{
+		resource.CredentialLibrary: credentiallibraries.CollectionActions,
+	}
 	validateVaultWorkerFilterFn = vaultWorkerFilterUnsupported
 	vaultWorkerFilterToProto    = false
 )
@@ -84,7 +97,7 @@ func init() {
 	var err error
 	if maskManager, err = handlers.NewMaskManager(
 		context.Background(),
-		handlers.MaskDestination{&store.CredentialStore{}, &store.Token{}, &store.ClientCertificate{}},
+		handlers.MaskDestination{&store.CredentialStore{}, &store.Token{}, &store.ClientCertificate{}, &store.AuthMethod{}},
 		handlers.MaskSource{&pb.CredentialStore{}, &pb.VaultCredentialStoreAttributes{}},
 	); err != nil {
 		panic(err)
@@ -101,6 +114,7 @@ type Service struct {
 	iamRepoFn    common.IamRepoFactory
 	vaultRepoFn  common.VaultCredentialRepoFactory
 	staticRepoFn common.StaticCredentialRepoFactory
+	sshCaRepoFn  common.SshCaCredentialRepoFactory
 	storeRepoFn  common.CredentialStoreRepoFactory
 	maxPageSize  uint
 }
@@ -113,6 +127,7 @@ func NewService(
 	iamRepo common.IamRepoFactory,
 	vaultRepo common.VaultCredentialRepoFactory,
 	staticRepo common.StaticCredentialRepoFactory,
+	sshCaRepo common.SshCaCredentialRepoFactory,
 	storeRepoFn common.CredentialStoreRepoFactory,
 	maxPageSize uint,
 ) (Service, error) {
@@ -126,6 +141,9 @@ func NewService(
 	if staticRepo == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
 	}
+	if sshCaRepo == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ssh ca credential repository")
+	}
 	if storeRepoFn == nil {
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing credential store repo")
 	}
@@ -136,6 +154,7 @@ func NewService(
 		iamRepoFn:    iamRepo,
 		vaultRepoFn:  vaultRepo,
 		staticRepoFn: staticRepo,
+		sshCaRepoFn:  sshCaRepo,
 		storeRepoFn:  storeRepoFn,
 		maxPageSize:  maxPageSize,
 	}, nil
@@ -476,6 +495,19 @@ func (s Service) getFromRepo(ctx context.Context, id string) (credential.Store,
 		if cs != nil {
 			return cs, nil
 		}
+
+	case sshca.Subtype:
+		repo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs, err := repo.LookupCredentialStore(ctx, id)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if cs != nil {
+			return cs, nil
+		}
 	}
 
 	return nil, handlers.NotFoundErrorf("credential store %q not found", id)
@@ -515,6 +547,21 @@ func (s Service) createInRepo(ctx context.Context, projId string, item *pb.Crede
 		}
 		return out, nil
 
+	case sshca.Subtype.String():
+		cs, err := toStorageSshCaStore(ctx, projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		repo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, err := repo.CreateCredentialStore(ctx, cs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create credential store"))
+		}
+		return out, nil
+
 	default:
 		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create credential store, unknown type.")
 	}
@@ -563,6 +610,22 @@ func (s Service) updateInRepo(ctx context.Context, projId, id string, mask []str
 		if err != nil {
 			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
 		}
+
+	case sshca.Subtype:
+		cs, err := toStorageSshCaStore(ctx, projId, item)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		cs.PublicId = id
+
+		repo, err := s.sshCaRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		out, rowsUpdated, err = repo.UpdateCredentialStore(ctx, cs, item.GetVersion(), dbMask)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update credential store"))
+		}
 	}
 	if rowsUpdated == 0 {
 		return nil, handlers.NotFoundErrorf("Credential Store %q doesn't exist or incorrect version provided.", id)
@@ -600,6 +663,19 @@ func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
 			}
 			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
 		}
+
+	case sshca.Subtype:
+		repo, err := s.sshCaRepoFn()
+		if err != nil {
+			return false, err
+		}
+		rows, err = repo.DeleteCredentialStore(ctx, id)
+		if err != nil {
+			if errors.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete credential store"))
+		}
 	}
 	return rows > 0, nil
 }
@@ -621,6 +697,11 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 		res.Error = err
 		return res
 	}
+	sshCaRepo, err := s.sshCaRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
 
 	var parentId string
 	opts := []auth.Option{auth.WithType(resource.CredentialStore), auth.WithAction(a)}
@@ -662,6 +743,18 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.
 				return res
 			}
 			parentId = cs.GetProjectId()
+
+		case sshca.Subtype:
+			cs, err := sshCaRepo.LookupCredentialStore(ctx, id)
+			if err != nil {
+				res.Error = err
+				return res
+			}
+			if cs == nil {
+				res.Error = handlers.NotFoundError()
+				return res
+			}
+			parentId = cs.GetProjectId()
 		}
 		opts = append(opts, auth.WithId(id))
 	}
@@ -784,10 +877,31 @@ func toProto(ctx context.Context, in credential.Store, opt ...handlers.Option) (
 				}
 				attrs.ClientCertificateKeyHmac = base64.RawURLEncoding.EncodeToString(cc.GetCertificateKeyHmac())
 			}
+			if am := vaultIn.AuthMethod(); am != nil {
+				attrs.AuthMethod = wrapperspb.String(am.GetMethod())
+				attrs.AuthMountPath = wrapperspb.String(am.GetMountPath())
+				attrs.AuthRole = wrapperspb.String(am.GetRole())
+				if len(am.GetSecretIdHmac()) != 0 {
+					attrs.AuthSecretIdHmac = base64.RawURLEncoding.EncodeToString(am.GetSecretIdHmac())
+				}
+			}
 
 			out.Attrs = &pb.CredentialStore_VaultCredentialStoreAttributes{
 				VaultCredentialStoreAttributes: attrs,
 			}
+
+		case sshca.Subtype:
+			sshCaIn, ok := in.(*sshca.CredentialStore)
+			if !ok {
+				return nil, errors.New(ctx, errors.Internal, op, "unable to cast to ssh ca credential store")
+			}
+			out.Attrs = &pb.CredentialStore_SshCaCredentialStoreAttributes{
+				SshCaCredentialStoreAttributes: &pb.SshCaCredentialStoreAttributes{
+					KeyType:   wrapperspb.String(sshCaIn.GetKeyType()),
+					KeyBits:   wrapperspb.UInt32(sshCaIn.GetKeyBits()),
+					PublicKey: sshCaIn.GetPublicKey(),
+				},
+			}
 		}
 	}
 	return &out, nil
@@ -810,6 +924,34 @@ func toStorageStaticStore(ctx context.Context, scopeId string, in *pb.Credential
 	return cs, err
 }
 
+func toStorageSshCaStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *sshca.CredentialStore, err error) {
+	const op = "credentialstores.toStorageSshCaStore"
+	var opts []sshca.Option
+	if in.GetName() != nil {
+		opts = append(opts, sshca.WithName(in.GetName().GetValue()))
+	}
+	if in.GetDescription() != nil {
+		opts = append(opts, sshca.WithDescription(in.GetDescription().GetValue()))
+	}
+
+	attrs := in.GetSshCaCredentialStoreAttributes()
+	if attrs.GetKeyType().GetValue() != "" {
+		opts = append(opts, sshca.WithKeyType(attrs.GetKeyType().GetValue()))
+	}
+	if attrs.GetKeyBits().GetValue() != 0 {
+		opts = append(opts, sshca.WithKeyBits(attrs.GetKeyBits().GetValue()))
+	}
+	if attrs.GetPrivateKey().GetValue() != "" {
+		opts = append(opts, sshca.WithPrivateKey([]byte(attrs.GetPrivateKey().GetValue())))
+	}
+
+	cs, err := sshca.NewCredentialStore(scopeId, opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build credential store for creation"))
+	}
+	return cs, err
+}
+
 func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialStore) (out *vault.CredentialStore, err error) {
 	const op = "credentialstores.toStorageVaultStore"
 	var opts []vault.Option
@@ -858,6 +1000,14 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 		}
 		opts = append(opts, vault.WithClientCert(cc))
 	}
+	if attrs.GetAuthMethod() != nil || attrs.GetAuthMountPath() != nil || attrs.GetAuthRole() != nil || attrs.GetAuthSecretId() != nil {
+		var amOpts []vault.Option
+		if attrs.GetAuthMountPath().GetValue() != "" {
+			amOpts = append(amOpts, vault.WithAuthMountPath(attrs.GetAuthMountPath().GetValue()))
+		}
+		am := vault.NewAuthMethod(vault.AuthMethodType(attrs.GetAuthMethod().GetValue()), attrs.GetAuthRole().GetValue(), []byte(attrs.GetAuthSecretId().GetValue()), amOpts...)
+		opts = append(opts, vault.WithAuthMethod(am))
+	}
 
 	cs, err := vault.NewCredentialStore(scopeId, attrs.GetAddress().GetValue(), []byte(attrs.GetToken().GetValue()), opts...)
 	if err != nil {
@@ -872,7 +1022,7 @@ func toStorageVaultStore(ctx context.Context, scopeId string, in *pb.CredentialS
 //   - All required parameters are set
 //   - There are no conflicting parameters provided
 func validateGetRequest(req *pbs.GetCredentialStoreRequest) error {
-	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.SshCaCredentialStorePrefix)
 }
 
 func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRequest) error {
@@ -891,8 +1041,19 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			if attrs.GetAddress().GetValue() == "" {
 				badFields[globals.AttributesAddressField] = "Field required for creating a vault credential store."
 			}
-			if attrs.GetToken().GetValue() == "" {
-				badFields[vaultTokenField] = "Field required for creating a vault credential store."
+			switch {
+			case attrs.GetToken().GetValue() == "" && attrs.GetAuthMethod().GetValue() == "":
+				badFields[vaultTokenField] = "Either this field or auth_method is required for creating a vault credential store."
+			case attrs.GetToken().GetValue() != "" && attrs.GetAuthMethod().GetValue() != "":
+				badFields[vaultTokenField] = "Cannot set both a token and an auth method."
+			}
+			if attrs.GetAuthMethod() != nil {
+				validateVaultAuthMethod(attrs, badFields)
+			} else if attrs.GetAuthMountPath() != nil || attrs.GetAuthRole() != nil || attrs.GetAuthSecretId() != nil {
+				badFields[vaultAuthMethodField] = "Field required when setting auth method fields."
+			}
+			if attrs.GetAuthSecretIdHmac() != "" {
+				badFields[vaultAuthSecretIdHmac] = "This is a read only field."
 			}
 			if attrs.GetTokenHmac() != "" {
 				badFields[vaultTokenHmacField] = "This is a read only field."
@@ -921,6 +1082,19 @@ func validateCreateRequest(ctx context.Context, req *pbs.CreateCredentialStoreRe
 			}
 		case static.Subtype.String():
 			// No additional validation required for static credential store
+		case sshca.Subtype.String():
+			attrs := req.GetItem().GetSshCaCredentialStoreAttributes()
+			if attrs.GetPublicKey() != "" {
+				badFields[sshCaPublicKeyField] = "This is a read only field."
+			}
+			if pk := attrs.GetPrivateKey().GetValue(); pk != "" {
+				if _, err := ssh.ParseRawPrivateKey([]byte(pk)); err != nil {
+					badFields[sshCaPrivateKeyField] = "Unable to parse given private key value."
+				}
+			} else if !validKeyTypes(attrs.GetKeyType().GetValue(), attrs.GetKeyBits().GetValue()) {
+				badFields[sshCaKeyTypeField] = "If set, value must be 'ed25519', 'ecdsa', or 'rsa'."
+				badFields[sshCaKeyBitsField] = "If set, value must be 0 for ed25519, 256, 384, or 521 for ecdsa, and 2048, 3072, or 4096 for rsa."
+			}
 		default:
 			badFields[globals.TypeField] = "This is a required field and must be a known credential store type."
 		}
@@ -949,6 +1123,17 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 				if attrs.GetTokenHmac() != "" {
 					badFields[vaultTokenHmacField] = "This is a read only field."
 				}
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), vaultTokenField) &&
+					handlers.MaskContains(req.GetUpdateMask().GetPaths(), vaultAuthMethodField) &&
+					attrs.GetAuthMethod().GetValue() != "" {
+					badFields[vaultTokenField] = "Cannot set both a token and an auth method."
+				}
+				if attrs.GetAuthMethod() != nil {
+					validateVaultAuthMethod(attrs, badFields)
+				}
+				if attrs.GetAuthSecretIdHmac() != "" {
+					badFields[vaultAuthSecretIdHmac] = "This is a read only field."
+				}
 				if attrs.WorkerFilter.GetValue() != "" {
 					err := validateVaultWorkerFilterFn(attrs.WorkerFilter.GetValue())
 					if err != nil {
@@ -967,13 +1152,46 @@ func validateUpdateRequest(ctx context.Context, req *pbs.UpdateCredentialStoreRe
 					badFields[clientCertField] = fmt.Sprintf("Invalid values: %q", err.Error())
 				}
 			}
+		case sshca.Subtype:
+			if req.GetItem().GetType() != "" && req.GetItem().GetType() != sshca.Subtype.String() {
+				badFields["type"] = "Cannot modify resource type."
+			}
+			for _, f := range []string{sshCaKeyTypeField, sshCaKeyBitsField, sshCaPrivateKeyField, sshCaPublicKeyField} {
+				if handlers.MaskContains(req.GetUpdateMask().GetPaths(), f) {
+					badFields[f] = "The certificate authority key of a credential store cannot be modified."
+				}
+			}
 		}
 		return badFields
-	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	}, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.SshCaCredentialStorePrefix)
+}
+
+// validateVaultAuthMethod validates the auth method fields of attrs are
+// consistent with the auth method type. Any invalid fields are added to
+// badFields.
+func validateVaultAuthMethod(attrs *pb.VaultCredentialStoreAttributes, badFields map[string]string) {
+	switch vault.AuthMethodType(attrs.GetAuthMethod().GetValue()) {
+	case vault.AppRoleAuthMethod:
+		if attrs.GetAuthRole().GetValue() == "" {
+			badFields[vaultAuthRoleField] = "Field required for the approle auth method."
+		}
+		if attrs.GetAuthSecretId().GetValue() == "" {
+			badFields[vaultAuthSecretIdField] = "Field required for the approle auth method."
+		}
+	case vault.JwtAuthMethod:
+		if attrs.GetAuthRole().GetValue() == "" {
+			badFields[vaultAuthRoleField] = "Field required for the jwt auth method."
+		}
+		if attrs.GetAuthSecretId() != nil {
+			badFields[vaultAuthSecretIdField] = "Field not allowed for the jwt auth method."
+		}
+	default:
+		badFields[vaultAuthMethodField] = "If set, value must be 'approle' or 'jwt'."
+	}
 }
 
 func validateDeleteRequest(req *pbs.DeleteCredentialStoreRequest) error {
-	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix)
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.VaultCredentialStorePrefix, globals.StaticCredentialStorePrefix, globals.StaticCredentialStorePreviousPrefix, globals.SshCaCredentialStorePrefix)
 }
 
 func validateListRequest(ctx context.Context, req *pbs.ListCredentialStoresRequest) error {
@@ -1000,6 +1218,9 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	case static.Subtype:
 		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, staticCollectionTypeMap, authResults.Scope, id)
+
+	case sshca.Subtype:
+		collectionActions, err = auth.CalculateAuthorizedCollectionActions(ctx, authResults, sshCaCollectionTypeMap, authResults.Scope, id)
 	}
 	if err != nil {
 		return nil, err
@@ -1007,3 +1228,24 @@ func calculateAuthorizedCollectionActions(ctx context.Context, authResults auth.
 
 	return collectionActions, nil
 }
+
+// validKeyTypes returns true if keyType and keyBits are valid for the
+// certificate authority key of an ssh ca credential store. Both may be
+// unset to use the defaults.
+func validKeyTypes(keyType string, keyBits uint32) bool {
+	switch keyType {
+	case "", sshca.KeyTypeEd25519:
+		return keyBits == sshca.KeyBitsDefault
+	case sshca.KeyTypeEcdsa:
+		switch keyBits {
+		case sshca.KeyBitsDefault, sshca.KeyBitsEcdsa256, sshca.KeyBitsEcdsa384, sshca.KeyBitsEcdsa521:
+			return true
+		}
+	case sshca.KeyTypeRsa:
+		switch keyBits {
+		case sshca.KeyBitsDefault, sshca.KeyBitsRsa2048, sshca.KeyBitsRsa3072, sshca.KeyBitsRsa4096:
+			return true
+		}
+	}
+	return false
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/boundary/internal/auth/password"
 	"github.com/hashicorp/boundary/internal/authtoken"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	"github.com/hashicorp/boundary/internal/credential/static"
 	"github.com/hashicorp/boundary/internal/credential/static/store"
 	"github.com/hashicorp/boundary/internal/credential/vault"
@@ -125,6 +126,9 @@ func TestList(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreRepoFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -278,7 +282,7 @@ func TestList(t *testing.T) {
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreRepoFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreRepoFn, 1000)
 			require.NoError(t, err, "Couldn't create new host set service.")
 
 			// Test non-anonymous listing
@@ -338,6 +342,9 @@ func TestCreateVault(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -568,6 +575,90 @@ func TestCreateVault(t *testing.T) {
 			res: nil,
 			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
 		},
+		{
+			name: "Can't specify both token and auth method",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:    wrapperspb.String(v.Addr),
+						Token:      wrapperspb.String(newToken()),
+						AuthMethod: wrapperspb.String("jwt"),
+						AuthRole:   wrapperspb.String("boundary"),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown auth method",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:    wrapperspb.String(v.Addr),
+						AuthMethod: wrapperspb.String("userpass"),
+						AuthRole:   wrapperspb.String("boundary"),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Approle auth method requires secret id",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:    wrapperspb.String(v.Addr),
+						AuthMethod: wrapperspb.String("approle"),
+						AuthRole:   wrapperspb.String("role-id"),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Jwt auth method does not allow secret id",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:      wrapperspb.String(v.Addr),
+						AuthMethod:   wrapperspb.String("jwt"),
+						AuthRole:     wrapperspb.String("boundary"),
+						AuthSecretId: wrapperspb.String("secret-id"),
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Can't specify auth secret id hmac",
+			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
+				ScopeId: prj.GetPublicId(),
+				Type:    vault.Subtype.String(),
+				Attrs: &pb.CredentialStore_VaultCredentialStoreAttributes{
+					VaultCredentialStoreAttributes: &pb.VaultCredentialStoreAttributes{
+						Address:          wrapperspb.String(v.Addr),
+						AuthMethod:       wrapperspb.String("approle"),
+						AuthRole:         wrapperspb.String("role-id"),
+						AuthSecretId:     wrapperspb.String("secret-id"),
+						AuthSecretIdHmac: "hmac",
+					},
+				},
+			}},
+			res: nil,
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
 		{
 			name: "Create a valid vault CredentialStore with client cert and key in same field",
 			req: &pbs.CreateCredentialStoreRequest{Item: &pb.CredentialStore{
@@ -652,7 +743,7 @@ func TestCreateVault(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -728,6 +819,9 @@ func TestCreateStatic(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -838,7 +932,7 @@ func TestCreateStatic(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert, require := assert.New(t), require.New(t)
 
-			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+			s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 			require.NoError(err, "Error when getting new credential store service.")
 			defer cleanup(s)
 
@@ -903,6 +997,9 @@ func TestGet(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -912,7 +1009,7 @@ func TestGet(t *testing.T) {
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 1)[0]
 	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
 	staticStorePrev := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId(), static.WithPublicId(fmt.Sprintf("%s_1234567890", globals.StaticCredentialStorePreviousPrefix)))
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1050,6 +1147,9 @@ func TestDelete(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1058,7 +1158,7 @@ func TestDelete(t *testing.T) {
 
 	vaultStore := vault.TestCredentialStores(t, conn, wrapper, prj.GetPublicId(), 2)[0]
 	staticStore := static.TestCredentialStore(t, conn, wrapper, prj.GetPublicId())
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	cases := []struct {
@@ -1128,6 +1228,9 @@ func TestUpdateVault(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1135,7 +1238,7 @@ func TestUpdateVault(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1475,6 +1578,9 @@ func TestUpdateStatic(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(testCtx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(testCtx, rw, rw, kms)
+	}
 	credStoreServiceFn := func() (*credential.StoreRepository, error) {
 		return credential.NewStoreRepository(context.Background(), rw, rw)
 	}
@@ -1482,7 +1588,7 @@ func TestUpdateStatic(t *testing.T) {
 	_, prj := iam.TestScopes(t, iamRepo)
 	ctx := auth.DisabledAuthTestContext(iamRepoFn, prj.GetPublicId())
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 	require.NoError(t, err)
 
 	fieldmask := func(paths ...string) *fieldmaskpb.FieldMask {
@@ -1673,6 +1779,9 @@ func TestListPagination(t *testing.T) {
 	staticRepoFn := func() (*static.Repository, error) {
 		return static.NewRepository(ctx, rw, rw, kms)
 	}
+	sshCaRepoFn := func() (*sshca.Repository, error) {
+		return sshca.NewRepository(ctx, rw, rw, kms)
+	}
 	tokenRepoFn := func() (*authtoken.Repository, error) {
 		return authtoken.NewRepository(ctx, rw, rw, kms)
 	}
@@ -1726,7 +1835,7 @@ func TestListPagination(t *testing.T) {
 	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
 	ctx = auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
 
-	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, credStoreServiceFn, 1000)
+	s, err := NewService(ctx, iamRepoFn, vaultRepoFn, staticRepoFn, sshCaRepoFn, credStoreServiceFn, 1000)
 	require.NoError(err)
 
 	// Start paginating, recursively
@@ -5,19 +5,37 @@ package policies
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	internalglobals "github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/pagination"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/hashicorp/boundary/internal/policy/storage/store"
+	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/util"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/policies"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
 	_ pbs.PolicyServiceServer = (*Service)(nil)
 
+	maskManager handlers.MaskManager
+
 	// idActions contains the set of actions that can be performed on individual
 	// resources.
 	idActions = action.NewActionSet(
@@ -34,43 +52,565 @@ var (
 	)
 )
 
-// NewServiceFn returns a policy service which is not implemented in OSS
+func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.Policy{}},
+		handlers.MaskSource{&pb.Policy{}, &pb.StoragePolicyAttributes{}, &pb.StoragePolicyRetainFor{}, &pb.StoragePolicyDeleteAfter{}},
+	); err != nil {
+		panic(err)
+	}
+
+	action.RegisterResource(resource.Policy, idActions, CollectionActions)
+}
+
+// NewServiceFn returns a policy service which handles storage policy related
+// requests to boundary.
 var NewServiceFn = func(ctx context.Context,
 	iamRepoFn common.IamRepoFactory,
+	repoFn common.StoragePolicyRepoFactory,
 	maxPageSize uint,
 	controllerExt internalglobals.ControllerExtension,
 ) (pbs.PolicyServiceServer, error) {
-	return &Service{}, nil
-}
-
-func init() {
-	action.RegisterResource(resource.Policy, idActions, CollectionActions)
+	return NewService(ctx, iamRepoFn, repoFn, maxPageSize)
 }
 
+// Service handles requests as described by the pbs.PolicyServiceServer
+// interface.
 type Service struct {
-	pbs.UnimplementedPolicyServiceServer
+	pbs.UnsafePolicyServiceServer
+
+	iamRepoFn   common.IamRepoFactory
+	repoFn      common.StoragePolicyRepoFactory
+	maxPageSize uint
 }
 
-// GetPolicy implements the interface pbs.PolicyServiceServer.
-func (s *Service) GetPolicy(ctx context.Context, req *pbs.GetPolicyRequest) (*pbs.GetPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+// NewService returns a policy service which handles storage policy related
+// requests to boundary.
+func NewService(ctx context.Context, iamRepoFn common.IamRepoFactory, repoFn common.StoragePolicyRepoFactory, maxPageSize uint) (*Service, error) {
+	const op = "policies.NewService"
+	if util.IsNil(iamRepoFn) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	if util.IsNil(repoFn) {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage policy repository")
+	}
+	if maxPageSize == 0 {
+		maxPageSize = uint(globals.DefaultMaxPageSize)
+	}
+	return &Service{iamRepoFn: iamRepoFn, repoFn: repoFn, maxPageSize: maxPageSize}, nil
 }
 
 // ListPolicies implements the interface pbs.PolicyServiceServer.
 func (s *Service) ListPolicies(ctx context.Context, req *pbs.ListPoliciesRequest) (*pbs.ListPoliciesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+	const op = "policies.(Service).ListPolicies"
+	if err := validateListRequest(ctx, req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, req.GetScopeId(), resource.Policy, req.GetRecursive())
+	if err != nil {
+		return nil, err
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListPoliciesResponse{}, nil
+	}
+
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+
+	var filterItemFn func(ctx context.Context, item *storage.Policy) (bool, error)
+	switch {
+	case req.GetFilter() != "":
+		// Only use a filter if we need to
+		filter, err := handlers.NewFilter(ctx, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+		filterItemFn = func(ctx context.Context, item *storage.Policy) (bool, error) {
+			outputOpts, ok := newOutputOpts(ctx, item, scopeInfoMap, authResults)
+			if !ok {
+				return false, nil
+			}
+			pbItem, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return false, err
+			}
+			return filter.Match(pbItem), nil
+		}
+	default:
+		filterItemFn = func(ctx context.Context, item *storage.Policy) (bool, error) {
+			return true, nil
+		}
+	}
+
+	grantsHash, err := authResults.GrantsHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var listResp *pagination.ListResponse[*storage.Policy]
+	var sortBy string
+	if req.GetListToken() == "" {
+		sortBy = "created_time"
+		listResp, err = storage.ListPolicies(ctx, grantsHash, pageSize, filterItemFn, repo, scopeIds)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.Policy, grantsHash)
+		if err != nil {
+			return nil, err
+		}
+		switch st := listToken.Subtype.(type) {
+		case *listtoken.PaginationToken:
+			sortBy = "created_time"
+			listResp, err = storage.ListPoliciesPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, scopeIds)
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.StartRefreshToken:
+			sortBy = "updated_time"
+			listResp, err = storage.ListPoliciesRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, scopeIds)
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.RefreshToken:
+			sortBy = "updated_time"
+			listResp, err = storage.ListPoliciesRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, scopeIds)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+		}
+	}
+
+	finalItems := make([]*pb.Policy, 0, len(listResp.Items))
+	for _, item := range listResp.Items {
+		outputOpts, ok := newOutputOpts(ctx, item, scopeInfoMap, authResults)
+		if !ok {
+			continue
+		}
+		item, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		finalItems = append(finalItems, item)
+	}
+	respType := "delta"
+	if listResp.CompleteListing {
+		respType = "complete"
+	}
+	resp := &pbs.ListPoliciesResponse{
+		Items:        finalItems,
+		EstItemCount: uint32(listResp.EstimatedItemCount),
+		RemovedIds:   listResp.DeletedIds,
+		ResponseType: respType,
+		SortBy:       sortBy,
+		SortDir:      "desc",
+	}
+	if listResp.ListToken != nil {
+		resp.ListToken, err = handlers.MarshalListToken(ctx, listResp.ListToken, pbs.ResourceType_RESOURCE_TYPE_POLICY)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GetPolicy implements the interface pbs.PolicyServiceServer.
+func (s *Service) GetPolicy(ctx context.Context, req *pbs.GetPolicyRequest) (*pbs.GetPolicyResponse, error) {
+	const op = "policies.(Service).GetPolicy"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	p, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.responseItem(ctx, authResults, p)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.GetPolicyResponse{Item: item}, nil
 }
 
 // CreatePolicy implements the interface pbs.PolicyServiceServer.
 func (s *Service) CreatePolicy(ctx context.Context, req *pbs.CreatePolicyRequest) (*pbs.CreatePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+	const op = "policies.(Service).CreatePolicy"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	p, err := s.createInRepo(ctx, authResults.Scope.GetId(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.responseItem(ctx, authResults, p)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.CreatePolicyResponse{Item: item, Uri: fmt.Sprintf("policies/%s", item.GetId())}, nil
 }
 
 // UpdatePolicy implements the interface pbs.PolicyServiceServer.
 func (s *Service) UpdatePolicy(ctx context.Context, req *pbs.UpdatePolicyRequest) (*pbs.UpdatePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+	const op = "policies.(Service).UpdatePolicy"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	p, err := s.updateInRepo(ctx, req.GetId(), req.GetUpdateMask().GetPaths(), req.GetItem())
+	if err != nil {
+		return nil, err
+	}
+	item, err := s.responseItem(ctx, authResults, p)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.UpdatePolicyResponse{Item: item}, nil
 }
 
+// DeletePolicy implements the interface pbs.PolicyServiceServer.
 func (s *Service) DeletePolicy(ctx context.Context, req *pbs.DeletePolicyRequest) (*pbs.DeletePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+	const op = "policies.(Service).DeletePolicy"
+
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if _, err := repo.DeletePolicy(ctx, req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete storage policy"))
+	}
+	return nil, nil
+}
+
+func (s *Service) getFromRepo(ctx context.Context, id string) (*storage.Policy, error) {
+	const op = "policies.(Service).getFromRepo"
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p, err := repo.LookupPolicy(ctx, id)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if p == nil {
+		return nil, handlers.NotFoundErrorf("Policy %q doesn't exist.", id)
+	}
+	return p, nil
+}
+
+// toStoragePolicyOptions converts the fields of the provided API policy to
+// storage policy options.
+func toStoragePolicyOptions(item *pb.Policy) []storage.Option {
+	var opts []storage.Option
+	if item.GetName() != nil {
+		opts = append(opts, storage.WithName(item.GetName().GetValue()))
+	}
+	if item.GetDescription() != nil {
+		opts = append(opts, storage.WithDescription(item.GetDescription().GetValue()))
+	}
+	attrs := item.GetStoragePolicyAttributes()
+	opts = append(opts,
+		storage.WithRetainForDays(attrs.GetRetainFor().GetDays()),
+		storage.WithRetainForDaysOverridable(attrs.GetRetainFor().GetOverridable().GetValue()),
+		storage.WithDeleteAfterDays(attrs.GetDeleteAfter().GetDays()),
+		storage.WithDeleteAfterDaysOverridable(attrs.GetDeleteAfter().GetOverridable().GetValue()),
+		storage.WithLegalHold(attrs.GetLegalHold().GetValue()),
+	)
+	return opts
+}
+
+func (s *Service) createInRepo(ctx context.Context, scopeId string, item *pb.Policy) (*storage.Policy, error) {
+	const op = "policies.(Service).createInRepo"
+	p, err := storage.NewPolicy(ctx, scopeId, toStoragePolicyOptions(item)...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build storage policy for creation: %v.", err)
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, err := repo.CreatePolicy(ctx, p)
+	if err != nil {
+		if errors.Match(errors.T(errors.InvalidParameter), err) {
+			return nil, handlers.InvalidArgumentErrorf(err.Error(), nil)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create storage policy"))
+	}
+	if out == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create storage policy but no error returned from repository.")
+	}
+	return out, nil
+}
+
+func (s *Service) updateInRepo(ctx context.Context, id string, mask []string, item *pb.Policy) (*storage.Policy, error) {
+	const op = "policies.(Service).updateInRepo"
+	p, err := storage.NewPolicy(ctx, "", toStoragePolicyOptions(item)...)
+	if err != nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to build storage policy for update: %v.", err)
+	}
+	p.PublicId = id
+	dbMask := maskManager.Translate(mask)
+	if len(dbMask) == 0 {
+		return nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	out, rowsUpdated, err := repo.UpdatePolicy(ctx, p, item.GetVersion(), dbMask)
+	if err != nil {
+		if errors.Match(errors.T(errors.InvalidParameter), err) {
+			return nil, handlers.InvalidArgumentErrorf(err.Error(), nil)
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update storage policy"))
+	}
+	if rowsUpdated == 0 {
+		return nil, handlers.NotFoundErrorf("Policy %q doesn't exist or incorrect version provided.", id)
+	}
+	return out, nil
+}
+
+func (s *Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.Policy), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	default:
+		repo, err := s.repoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		p, err := repo.LookupPolicy(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if p == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = p.GetScopeId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+// responseItem returns the API representation of the provided storage
+// policy for the response to a request on a single storage policy.
+func (s *Service) responseItem(ctx context.Context, authResults auth.VerifyResults, p *storage.Policy) (*pb.Policy, error) {
+	const op = "policies.(Service).responseItem"
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), idActions).Strings()))
+	}
+	return toProto(ctx, p, outputOpts...)
+}
+
+func toProto(ctx context.Context, in *storage.Policy, opt ...handlers.Option) (*pb.Policy, error) {
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building policy proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.Policy{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetScopeId()
+	}
+	if outputFields.Has(globals.TypeField) {
+		out.Type = storage.Subtype.String()
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	if outputFields.Has(globals.AttributesField) {
+		out.Attrs = &pb.Policy_StoragePolicyAttributes{
+			StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+				RetainFor: &pb.StoragePolicyRetainFor{
+					Days:        in.GetRetainForDays(),
+					Overridable: wrapperspb.Bool(in.GetRetainForDaysOverridable()),
+				},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{
+					Days:        in.GetDeleteAfterDays(),
+					Overridable: wrapperspb.Bool(in.GetDeleteAfterDaysOverridable()),
+				},
+				LegalHold: wrapperspb.Bool(in.GetLegalHold()),
+			},
+		}
+	}
+	return &out, nil
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+func validateGetRequest(req *pbs.GetPolicyRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.StoragePolicyPrefix)
+}
+
+func validateCreateRequest(req *pbs.CreatePolicyRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		item := req.GetItem()
+		if item.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(item.GetScopeId()), scope.Org.Prefix()) {
+			badFields[globals.ScopeIdField] = "This field must be 'global' or a valid org scope id."
+		}
+		if item.GetType() != storage.Subtype.String() {
+			badFields[globals.TypeField] = "This field is required. Current supported values are 'storage'."
+		}
+		if item.GetStoragePolicyAttributes() == nil {
+			badFields[globals.AttributesField] = "This field is required."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdatePolicyRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		if req.GetItem().GetType() != "" && req.GetItem().GetType() != storage.Subtype.String() {
+			badFields[globals.TypeField] = "Cannot modify the resource type."
+		}
+		return badFields
+	}, globals.StoragePolicyPrefix)
+}
+
+func validateDeleteRequest(req *pbs.DeletePolicyRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.StoragePolicyPrefix)
+}
+
+func validateListRequest(ctx context.Context, req *pbs.ListPoliciesRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
+		badFields["scope_id"] = "Must be 'global' or a valid org scope id when listing."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields["filter"] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
+func newOutputOpts(ctx context.Context, item *storage.Policy, scopeInfoMap map[string]*scopes.ScopeInfo, authResults auth.VerifyResults) ([]handlers.Option, bool) {
+	res := perms.Resource{
+		Type:    resource.Policy,
+		Id:      item.GetPublicId(),
+		ScopeId: item.GetScopeId(),
+	}
+	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), idActions, auth.WithResource(&res))
+	if len(authorizedActions) == 0 {
+		return nil, false
+	}
+
+	outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+	return outputOpts, true
 }
@@ -1,62 +1,465 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: BUSL-1.1
 
-package policies
+package policies_test
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/policies"
+	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/policies"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func TestPolicyService(t *testing.T) {
-	t.Parallel()
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete"}
+
+func testService(t *testing.T) (*db.DB, func() (*iam.Repository, error), *policies.Service) {
+	t.Helper()
 	ctx := context.Background()
-	assert, require := assert.New(t), require.New(t)
-	s := Service{}
-
-	gotCreate, err := s.CreatePolicy(ctx, &pbs.CreatePolicyRequest{})
-	require.Nil(gotCreate)
-	require.Error(err)
-	gotStatus, ok := status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "Policies are an Enterprise-only feature")
-
-	gotUpdate, err := s.UpdatePolicy(ctx, &pbs.UpdatePolicyRequest{})
-	require.Nil(gotUpdate)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "Policies are an Enterprise-only feature")
-
-	gotGet, err := s.GetPolicy(ctx, &pbs.GetPolicyRequest{})
-	require.Nil(gotGet)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "Policies are an Enterprise-only feature")
-
-	gotDelete, err := s.DeletePolicy(ctx, &pbs.DeletePolicyRequest{})
-	require.Nil(gotDelete)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "Policies are an Enterprise-only feature")
-
-	gotList, err := s.ListPolicies(ctx, &pbs.ListPoliciesRequest{})
-	require.Nil(gotList)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "Policies are an Enterprise-only feature")
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	repoFn := func() (*storage.Repository, error) {
+		return storage.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	s, err := policies.NewService(ctx, iamRepoFn, repoFn, 1000)
+	require.NoError(t, err, "Couldn't create new policy service.")
+	return conn, iamRepoFn, s
+}
+
+func policyToProto(p *storage.Policy, si *scopes.ScopeInfo) *pb.Policy {
+	out := &pb.Policy{
+		Id:          p.GetPublicId(),
+		ScopeId:     p.GetScopeId(),
+		Scope:       si,
+		Type:        "storage",
+		CreatedTime: p.GetCreateTime().GetTimestamp(),
+		UpdatedTime: p.GetUpdateTime().GetTimestamp(),
+		Version:     p.GetVersion(),
+		Attrs: &pb.Policy_StoragePolicyAttributes{
+			StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+				RetainFor: &pb.StoragePolicyRetainFor{
+					Days:        p.GetRetainForDays(),
+					Overridable: wrapperspb.Bool(p.GetRetainForDaysOverridable()),
+				},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{
+					Days:        p.GetDeleteAfterDays(),
+					Overridable: wrapperspb.Bool(p.GetDeleteAfterDaysOverridable()),
+				},
+				LegalHold: wrapperspb.Bool(p.GetLegalHold()),
+			},
+		},
+		AuthorizedActions: testAuthorizedActions,
+	}
+	if p.GetName() != "" {
+		out.Name = wrapperspb.String(p.GetName())
+	}
+	if p.GetDescription() != "" {
+		out.Description = wrapperspb.String(p.GetDescription())
+	}
+	return out
+}
+
+func TestGet(t *testing.T) {
+	conn, iamRepoFn, s := testService(t)
+	globalScopeInfo := &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: "global", Description: "Global Scope"}
+	p := storage.TestPolicy(t, conn, scope.Global.String(), storage.WithName("name"), storage.WithDescription("desc"), storage.WithDeleteAfterDays(10))
+
+	cases := []struct {
+		name string
+		req  *pbs.GetPolicyRequest
+		res  *pbs.GetPolicyResponse
+		err  error
+	}{
+		{
+			name: "Get an existing policy",
+			req:  &pbs.GetPolicyRequest{Id: p.GetPublicId()},
+			res:  &pbs.GetPolicyResponse{Item: policyToProto(p, globalScopeInfo)},
+		},
+		{
+			name: "Get a non existent policy",
+			req:  &pbs.GetPolicyRequest{Id: globals.StoragePolicyPrefix + "_DoesntExis"},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Wrong id prefix",
+			req:  &pbs.GetPolicyRequest{Id: "j_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.GetPolicy(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "GetPolicy(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(got, tc.res, protocmp.Transform(), cmpopts.SortSlices(func(a, b string) bool { return a < b })))
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	conn, iamRepoFn, s := testService(t)
+	iamRepo, err := iamRepoFn()
+	require.NoError(t, err)
+	org, _ := iam.TestScopes(t, iamRepo)
+	orgScopeInfo := &scopes.ScopeInfo{Id: org.GetPublicId(), Type: scope.Org.String(), ParentScopeId: scope.Global.String()}
+	globalScopeInfo := &scopes.ScopeInfo{Id: scope.Global.String(), Type: scope.Global.String(), Name: "global", Description: "Global Scope"}
+
+	var wantGlobal, wantOrg []*pb.Policy
+	for i := 0; i < 3; i++ {
+		wantGlobal = append(wantGlobal, policyToProto(storage.TestPolicy(t, conn, scope.Global.String()), globalScopeInfo))
+		wantOrg = append(wantOrg, policyToProto(storage.TestPolicy(t, conn, org.GetPublicId()), orgScopeInfo))
+	}
+	slices.Reverse(wantGlobal)
+	slices.Reverse(wantOrg)
+	wantAll := append(slices.Clone(wantOrg), wantGlobal...)
+
+	cases := []struct {
+		name string
+		req  *pbs.ListPoliciesRequest
+		res  *pbs.ListPoliciesResponse
+		err  error
+	}{
+		{
+			name: "List global policies",
+			req:  &pbs.ListPoliciesRequest{ScopeId: scope.Global.String()},
+			res: &pbs.ListPoliciesResponse{
+				Items:        wantGlobal,
+				EstItemCount: uint32(len(wantAll)),
+				ResponseType: "complete",
+				SortBy:       "created_time",
+				SortDir:      "desc",
+			},
+		},
+		{
+			name: "List org policies",
+			req:  &pbs.ListPoliciesRequest{ScopeId: org.GetPublicId()},
+			res: &pbs.ListPoliciesResponse{
+				Items:        wantOrg,
+				EstItemCount: uint32(len(wantAll)),
+				ResponseType: "complete",
+				SortBy:       "created_time",
+				SortDir:      "desc",
+			},
+		},
+		{
+			name: "List policies recursively",
+			req:  &pbs.ListPoliciesRequest{ScopeId: scope.Global.String(), Recursive: true},
+			res: &pbs.ListPoliciesResponse{
+				Items:        wantAll,
+				EstItemCount: uint32(len(wantAll)),
+				ResponseType: "complete",
+				SortBy:       "created_time",
+				SortDir:      "desc",
+			},
+		},
+		{
+			name: "Filter to no policies",
+			req:  &pbs.ListPoliciesRequest{ScopeId: scope.Global.String(), Recursive: true, Filter: `"/item/id"=="doesntmatch"`},
+			res: &pbs.ListPoliciesResponse{
+				ResponseType: "complete",
+				SortBy:       "created_time",
+				SortDir:      "desc",
+			},
+		},
+		{
+			name: "Project scope",
+			req:  &pbs.ListPoliciesRequest{ScopeId: scope.Project.Prefix() + "_1234567890"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ListPolicies(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ListPolicies(%q) got error %v, wanted %v", tc.req.GetScopeId(), gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Empty(cmp.Diff(
+				got,
+				tc.res,
+				protocmp.Transform(),
+				protocmp.IgnoreFields(&pbs.ListPoliciesResponse{}, "list_token"),
+				protocmp.IgnoreFields(&scopes.ScopeInfo{}, "name", "description"),
+				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+			), "ListPolicies(%q) got response %q, wanted %q", tc.req.GetScopeId(), got, tc.res)
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	_, iamRepoFn, s := testService(t)
+	iamRepo, err := iamRepoFn()
+	require.NoError(t, err)
+	org, proj := iam.TestScopes(t, iamRepo)
+
+	cases := []struct {
+		name string
+		req  *pbs.CreatePolicyRequest
+		want *pb.StoragePolicyAttributes
+		err  error
+	}{
+		{
+			name: "Create a valid org policy",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: org.GetPublicId(),
+				Type:    "storage",
+				Name:    wrapperspb.String("name"),
+				Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+					RetainFor:   &pb.StoragePolicyRetainFor{Days: 10, Overridable: wrapperspb.Bool(true)},
+					DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 20},
+				}},
+			}},
+			want: &pb.StoragePolicyAttributes{
+				RetainFor:   &pb.StoragePolicyRetainFor{Days: 10, Overridable: wrapperspb.Bool(true)},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 20, Overridable: wrapperspb.Bool(false)},
+				LegalHold:   wrapperspb.Bool(false),
+			},
+		},
+		{
+			name: "Create a valid global policy on legal hold",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: scope.Global.String(),
+				Type:    "storage",
+				Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+					RetainFor: &pb.StoragePolicyRetainFor{Days: storage.InfiniteRetention},
+					LegalHold: wrapperspb.Bool(true),
+				}},
+			}},
+			want: &pb.StoragePolicyAttributes{
+				RetainFor:   &pb.StoragePolicyRetainFor{Days: storage.InfiniteRetention, Overridable: wrapperspb.Bool(false)},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 0, Overridable: wrapperspb.Bool(false)},
+				LegalHold:   wrapperspb.Bool(true),
+			},
+		},
+		{
+			name: "Project scope",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: proj.GetPublicId(),
+				Type:    "storage",
+				Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+					RetainFor: &pb.StoragePolicyRetainFor{Days: 1},
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing type",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: org.GetPublicId(),
+				Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+					RetainFor: &pb.StoragePolicyRetainFor{Days: 1},
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing attributes",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: org.GetPublicId(),
+				Type:    "storage",
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "No retention or deletion",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: org.GetPublicId(),
+				Type:    "storage",
+				Attrs:   &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Delete before retention ends",
+			req: &pbs.CreatePolicyRequest{Item: &pb.Policy{
+				ScopeId: org.GetPublicId(),
+				Type:    "storage",
+				Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+					RetainFor:   &pb.StoragePolicyRetainFor{Days: 20},
+					DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 10},
+				}},
+			}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.CreatePolicy(auth.DisabledAuthTestContext(iamRepoFn, tc.req.GetItem().GetScopeId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "CreatePolicy(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.True(handlers.ValidId(handlers.Id(got.GetItem().GetId()), globals.StoragePolicyPrefix))
+			assert.Equal("policies/"+got.GetItem().GetId(), got.GetUri())
+			assert.Equal("storage", got.GetItem().GetType())
+			assert.Equal(tc.req.GetItem().GetScopeId(), got.GetItem().GetScopeId())
+			assert.Equal(tc.req.GetItem().GetName(), got.GetItem().GetName())
+			assert.Equal(uint32(1), got.GetItem().GetVersion())
+			assert.Empty(cmp.Diff(tc.want, got.GetItem().GetStoragePolicyAttributes(), protocmp.Transform()))
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	conn, iamRepoFn, s := testService(t)
+
+	cases := []struct {
+		name  string
+		paths []string
+		item  *pb.Policy
+		want  *pb.StoragePolicyAttributes
+		err   error
+	}{
+		{
+			name:  "Update retention and deletion",
+			paths: []string{"attributes.retain_for.days", "attributes.delete_after.days"},
+			item: &pb.Policy{Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+				RetainFor:   &pb.StoragePolicyRetainFor{Days: 5},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 5},
+			}}},
+			want: &pb.StoragePolicyAttributes{
+				RetainFor:   &pb.StoragePolicyRetainFor{Days: 5, Overridable: wrapperspb.Bool(false)},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 5, Overridable: wrapperspb.Bool(false)},
+				LegalHold:   wrapperspb.Bool(false),
+			},
+		},
+		{
+			name:  "Place on legal hold",
+			paths: []string{"attributes.legal_hold"},
+			item: &pb.Policy{Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+				LegalHold: wrapperspb.Bool(true),
+			}}},
+			want: &pb.StoragePolicyAttributes{
+				RetainFor:   &pb.StoragePolicyRetainFor{Days: 10, Overridable: wrapperspb.Bool(false)},
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 0, Overridable: wrapperspb.Bool(false)},
+				LegalHold:   wrapperspb.Bool(true),
+			},
+		},
+		{
+			name:  "Delete before retention ends",
+			paths: []string{"attributes.delete_after.days"},
+			item: &pb.Policy{Attrs: &pb.Policy_StoragePolicyAttributes{StoragePolicyAttributes: &pb.StoragePolicyAttributes{
+				DeleteAfter: &pb.StoragePolicyDeleteAfter{Days: 5},
+			}}},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "No valid fields",
+			paths: []string{"created_time"},
+			item:  &pb.Policy{},
+			err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			p := storage.TestPolicy(t, conn, scope.Global.String(), storage.WithRetainForDays(10))
+			item := tc.item
+			item.Version = p.GetVersion()
+			req := &pbs.UpdatePolicyRequest{
+				Id:         p.GetPublicId(),
+				Item:       item,
+				UpdateMask: &field_mask.FieldMask{Paths: tc.paths},
+			}
+			got, gErr := s.UpdatePolicy(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "UpdatePolicy(%+v) got error %v, wanted %v", req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Equal(p.GetVersion()+1, got.GetItem().GetVersion())
+			assert.Empty(cmp.Diff(tc.want, got.GetItem().GetStoragePolicyAttributes(), protocmp.Transform()))
+		})
+	}
+
+	t.Run("Wrong version", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		p := storage.TestPolicy(t, conn, scope.Global.String())
+		req := &pbs.UpdatePolicyRequest{
+			Id:         p.GetPublicId(),
+			Item:       &pb.Policy{Name: wrapperspb.String("new"), Version: p.GetVersion() + 1},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"name"}},
+		}
+		_, gErr := s.UpdatePolicy(auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String()), req)
+		require.Error(gErr)
+		assert.True(errors.Is(gErr, handlers.ApiErrorWithCode(codes.NotFound)), "got error %v", gErr)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	conn, iamRepoFn, s := testService(t)
+	p := storage.TestPolicy(t, conn, scope.Global.String())
+	ctx := auth.DisabledAuthTestContext(iamRepoFn, scope.Global.String())
+
+	cases := []struct {
+		name string
+		req  *pbs.DeletePolicyRequest
+		err  error
+	}{
+		{
+			name: "Delete an existing policy",
+			req:  &pbs.DeletePolicyRequest{Id: p.GetPublicId()},
+		},
+		{
+			name: "Delete it again",
+			req:  &pbs.DeletePolicyRequest{Id: p.GetPublicId()},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "Bad policy id formatting",
+			req:  &pbs.DeletePolicyRequest{Id: "bad_format"},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.DeletePolicy(ctx, tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "DeletePolicy(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			assert.Nil(got)
+		})
+	}
 }
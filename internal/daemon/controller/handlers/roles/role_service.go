@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
@@ -29,6 +30,7 @@ import (
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	"github.com/hashicorp/go-secure-stdlib/strutil"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -51,6 +53,10 @@ var (
 		action.AddGrantScopes,
 		action.SetGrantScopes,
 		action.RemoveGrantScopes,
+		action.RequestAccess,
+		action.ListAccessRequests,
+		action.ApproveRequests,
+		action.ValidateGrants,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -519,6 +525,67 @@ func (s Service) AddRoleGrants(ctx context.Context, req *pbs.AddRoleGrantsReques
 	return &pbs.AddRoleGrantsResponse{Item: item}, nil
 }
 
+// ValidateRoleGrants implements the interface pbs.RoleServiceServer.
+func (s Service) ValidateRoleGrants(ctx context.Context, req *pbs.ValidateRoleGrantsRequest) (*pbs.ValidateRoleGrantsResponse, error) {
+	const op = "roles.(Service).ValidateRoleGrants"
+
+	if err := validateValidateRoleGrantsRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ValidateGrants)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	r, _, roleGrants, grantScopes, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+
+	role := perms.ValidationRole{
+		Id:            r.GetPublicId(),
+		ScopeId:       r.GetScopeId(),
+		ParentScopeId: authResults.Scope.GetParentScopeId(),
+	}
+	for _, gs := range grantScopes {
+		role.GrantScopeIds = append(role.GrantScopeIds, gs.GetScopeIdOrSpecial())
+	}
+	for _, g := range roleGrants {
+		role.Grants = append(role.Grants, g.GetCanonicalGrant())
+	}
+	lookup := func(ctx context.Context, id string) (*perms.Resource, error) {
+		return repo.LookupResourceScope(ctx, id)
+	}
+	validation, err := perms.ValidateGrants(ctx, role, strutil.RemoveDuplicates(req.GetGrantStrings(), false), lookup)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	item := &pb.GrantsValidation{
+		RoleId: r.GetPublicId(),
+	}
+	for _, g := range validation.Grants {
+		item.Grants = append(item.Grants, &pb.GrantValidation{
+			Grant:          g.Grant,
+			CanonicalGrant: g.CanonicalGrant,
+			Error:          g.Error,
+			Warnings:       g.Warnings,
+		})
+	}
+	for _, na := range validation.NewlyAllowed {
+		item.NewlyAllowed = append(item.NewlyAllowed, &pb.NewlyAllowedPermission{
+			GrantScopeId: na.GrantScopeId,
+			Type:         na.Type.String(),
+			Id:           na.Id,
+			Actions:      na.Actions,
+		})
+	}
+	return &pbs.ValidateRoleGrantsResponse{Item: item}, nil
+}
+
 // SetRoleGrants implements the interface pbs.RoleServiceServer.
 func (s Service) SetRoleGrants(ctx context.Context, req *pbs.SetRoleGrantsRequest) (*pbs.SetRoleGrantsResponse, error) {
 	const op = "roles.(Service).SetRoleGrants"
@@ -712,6 +779,130 @@ func (s Service) RemoveRoleGrantScopes(ctx context.Context, req *pbs.RemoveRoleG
 	return &pbs.RemoveRoleGrantScopesResponse{Item: item}, nil
 }
 
+// RequestRoleAccess implements the interface pbs.RoleServiceServer.
+func (s Service) RequestRoleAccess(ctx context.Context, req *pbs.RequestRoleAccessRequest) (*pbs.RequestRoleAccessResponse, error) {
+	const op = "roles.(Service).RequestRoleAccess"
+
+	if err := validateRequestRoleAccessRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.RequestAccess)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if authResults.UserId == "" || authResults.UserId == globals.AnonymousUserId {
+		return nil, handlers.ForbiddenError()
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ar, err := iam.NewAccessRequest(ctx, req.GetId(), authResults.UserId, req.GetJustification(), time.Duration(req.GetDurationSeconds())*time.Second)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ar, err = repo.CreateAccessRequest(ctx, ar)
+	if err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return nil, handlers.ConflictErrorf("User already has an open access request for this role.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.RequestRoleAccessResponse{Item: accessRequestToProto(ar)}, nil
+}
+
+// ListRoleAccessRequests implements the interface pbs.RoleServiceServer.
+func (s Service) ListRoleAccessRequests(ctx context.Context, req *pbs.ListRoleAccessRequestsRequest) (*pbs.ListRoleAccessRequestsResponse, error) {
+	const op = "roles.(Service).ListRoleAccessRequests"
+
+	if err := validateRoleId(req.GetId()); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.ListAccessRequests)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	ars, err := repo.ListAccessRequests(ctx, req.GetId(), iam.WithLimit(pageSize))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	items := make([]*pb.AccessRequest, 0, len(ars))
+	for _, ar := range ars {
+		items = append(items, accessRequestToProto(ar))
+	}
+	return &pbs.ListRoleAccessRequestsResponse{Items: items}, nil
+}
+
+// ApproveRoleAccessRequest implements the interface pbs.RoleServiceServer.
+func (s Service) ApproveRoleAccessRequest(ctx context.Context, req *pbs.ApproveRoleAccessRequestRequest) (*pbs.ApproveRoleAccessRequestResponse, error) {
+	const op = "roles.(Service).ApproveRoleAccessRequest"
+
+	if err := validateDecideAccessRequest(req.GetId(), req.GetAccessRequestId()); err != nil {
+		return nil, err
+	}
+	repo, authResults, err := s.authDecideAccessRequest(ctx, req.GetId(), req.GetAccessRequestId())
+	if err != nil {
+		return nil, err
+	}
+	ar, err := repo.ApproveAccessRequest(ctx, req.GetAccessRequestId(), authResults.UserId, req.GetComment())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.ApproveRoleAccessRequestResponse{Item: accessRequestToProto(ar)}, nil
+}
+
+// DenyRoleAccessRequest implements the interface pbs.RoleServiceServer.
+func (s Service) DenyRoleAccessRequest(ctx context.Context, req *pbs.DenyRoleAccessRequestRequest) (*pbs.DenyRoleAccessRequestResponse, error) {
+	const op = "roles.(Service).DenyRoleAccessRequest"
+
+	if err := validateDecideAccessRequest(req.GetId(), req.GetAccessRequestId()); err != nil {
+		return nil, err
+	}
+	repo, authResults, err := s.authDecideAccessRequest(ctx, req.GetId(), req.GetAccessRequestId())
+	if err != nil {
+		return nil, err
+	}
+	ar, err := repo.DenyAccessRequest(ctx, req.GetAccessRequestId(), authResults.UserId, req.GetComment())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.DenyRoleAccessRequestResponse{Item: accessRequestToProto(ar)}, nil
+}
+
+// authDecideAccessRequest verifies the approve-requests action on the role and
+// that the access request belongs to the role.
+func (s Service) authDecideAccessRequest(ctx context.Context, roleId, requestId string) (*iam.Repository, auth.VerifyResults, error) {
+	authResults := s.authResult(ctx, roleId, action.ApproveRequests)
+	if authResults.Error != nil {
+		return nil, authResults, authResults.Error
+	}
+	if authResults.UserId == "" || authResults.UserId == globals.AnonymousUserId {
+		return nil, authResults, handlers.ForbiddenError()
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, authResults, err
+	}
+	ar, err := repo.LookupAccessRequest(ctx, requestId)
+	if err != nil {
+		return nil, authResults, err
+	}
+	if ar == nil || ar.GetRoleId() != roleId {
+		return nil, authResults, handlers.NotFoundErrorf("Access request %q not found for role %q.", requestId, roleId)
+	}
+	return repo, authResults, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*iam.Role, []*iam.PrincipalRole, []*iam.RoleGrant, []*iam.RoleGrantScope, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -1138,10 +1329,12 @@ func toProto(ctx context.Context, in *iam.Role, principals []*iam.PrincipalRole,
 					Raw:       g.GetRawGrant(),
 					Canonical: g.GetCanonicalGrant(),
 					Json: &pb.GrantJson{
-						Id:      parsed.Id(),
-						Ids:     parsed.Ids(),
-						Type:    parsed.Type().String(),
-						Actions: actions,
+						Id:         parsed.Id(),
+						Ids:        parsed.Ids(),
+						Type:       parsed.Type().String(),
+						Actions:    actions,
+						Conditions: grantConditionsToProto(parsed.Conditions()),
+						Deny:       parsed.Deny(),
 					},
 				})
 			}
@@ -1151,6 +1344,44 @@ func toProto(ctx context.Context, in *iam.Role, principals []*iam.PrincipalRole,
 	return &out, nil
 }
 
+// grantConditionsToProto returns the proto representation of the conditions
+// of a grant, or nil if the grant has no conditions.
+func grantConditionsToProto(c *perms.Conditions) *pb.GrantConditions {
+	if c == nil {
+		return nil
+	}
+	out := &pb.GrantConditions{
+		ClientCidrs: c.ClientCidrs(),
+		Days:        c.Days(),
+		Hours:       c.Hours(),
+		Timezone:    c.Timezone(),
+	}
+	if expires := c.Expires(); !expires.IsZero() {
+		out.Expires = timestamppb.New(expires)
+	}
+	return out
+}
+
+// accessRequestToProto returns the proto representation of an access request.
+func accessRequestToProto(in *iam.AccessRequest) *pb.AccessRequest {
+	out := &pb.AccessRequest{
+		Id:              in.GetPublicId(),
+		RoleId:          in.GetRoleId(),
+		UserId:          in.GetUserId(),
+		Justification:   in.GetJustification(),
+		DurationSeconds: in.GetDurationSeconds(),
+		Status:          in.GetStatus(),
+		ApproverId:      in.GetApproverId(),
+		DecisionComment: in.GetDecisionComment(),
+		CreatedTime:     in.GetCreateTime().GetTimestamp(),
+		UpdatedTime:     in.GetUpdateTime().GetTimestamp(),
+		DecisionTime:    in.GetDecisionTime().GetTimestamp(),
+		ExpirationTime:  in.GetExpirationTime().GetTimestamp(),
+		RevokeTime:      in.GetRevokeTime().GetTimestamp(),
+	}
+	return out
+}
+
 // A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
 // requirements on the structure of the request.  They verify that:
 //   - The path passed in is correctly formatted
@@ -1343,6 +1574,26 @@ func validateAddRoleGrantsRequest(ctx context.Context, req *pbs.AddRoleGrantsReq
 	return nil
 }
 
+func validateValidateRoleGrantsRequest(req *pbs.ValidateRoleGrantsRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.RolePrefix) {
+		badFields["id"] = "Incorrectly formatted identifier."
+	}
+	if len(req.GetGrantStrings()) == 0 {
+		badFields["grant_strings"] = "Must be non-empty."
+	}
+	for _, v := range req.GetGrantStrings() {
+		if len(v) == 0 {
+			badFields["grant_strings"] = "Grant strings must not be empty."
+			break
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func validateSetRoleGrantsRequest(ctx context.Context, req *pbs.SetRoleGrantsRequest) error {
 	badFields := map[string]string{}
 	if !handlers.ValidId(handlers.Id(req.GetId()), globals.RolePrefix) {
@@ -1537,3 +1788,44 @@ func newOutputOpts(ctx context.Context, item *iam.Role, scopeInfoMap map[string]
 	}
 	return outputOpts, true
 }
+
+func validateRoleId(id string) error {
+	if !handlers.ValidId(handlers.Id(id), globals.RolePrefix) {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", map[string]string{globals.IdField: "Incorrectly formatted identifier."})
+	}
+	return nil
+}
+
+func validateRequestRoleAccessRequest(req *pbs.RequestRoleAccessRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.RolePrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if strings.TrimSpace(req.GetJustification()) == "" {
+		badFields["justification"] = "Must be provided."
+	}
+	switch d := time.Duration(req.GetDurationSeconds()) * time.Second; {
+	case d == 0:
+		badFields["duration_seconds"] = "Must be provided."
+	case d > iam.MaxAccessRequestDuration:
+		badFields["duration_seconds"] = fmt.Sprintf("Must not be greater than %d.", int(iam.MaxAccessRequestDuration.Seconds()))
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
+func validateDecideAccessRequest(roleId, requestId string) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(roleId), globals.RolePrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if !handlers.ValidId(handlers.Id(requestId), iam.AccessRequestPrefix) {
+		badFields["access_request_id"] = "Incorrectly formatted identifier."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
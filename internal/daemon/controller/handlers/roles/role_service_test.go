@@ -47,7 +47,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals", "add-grants", "set-grants", "remove-grants", "add-grant-scopes", "set-grant-scopes", "remove-grant-scopes"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-principals", "set-principals", "remove-principals", "add-grants", "set-grants", "remove-grants", "add-grant-scopes", "set-grant-scopes", "remove-grant-scopes", "request-access", "list-access-requests", "approve-requests", "validate-grants"}
 
 func createDefaultRolesAndRepo(t *testing.T) (*iam.Role, *iam.Role, func() (*iam.Repository, error)) {
 	t.Helper()
@@ -2289,6 +2289,103 @@ func TestAddGrants(t *testing.T) {
 	}
 }
 
+func TestValidateGrants(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := roles.NewService(context.Background(), repoFn, 1000)
+	require.NoError(t, err, "Error when getting new role service.")
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	u := iam.TestUser(t, iamRepo, o.GetPublicId())
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	_ = iam.TestRoleGrant(t, conn, role.GetPublicId(), "ids=*;type=user;actions=read")
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ValidateRoleGrants(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), &pbs.ValidateRoleGrantsRequest{
+			Id: role.GetPublicId(),
+			GrantStrings: []string{
+				fmt.Sprintf("ids=%s;actions=read,update", u.GetPublicId()),
+				"ids=u_missing;actions=read",
+				"ids=*;type=user;actions=bogus",
+			},
+		})
+		require.NoError(err)
+		item := got.GetItem()
+		assert.Equal(role.GetPublicId(), item.GetRoleId())
+		require.Len(item.GetGrants(), 3)
+		assert.Equal([]string{`overlaps with existing grant "ids=*;type=user;actions=read"`}, item.GetGrants()[0].GetWarnings())
+		assert.Equal([]string{
+			`resource "u_missing" does not exist`,
+			`is redundant with existing grant "ids=*;type=user;actions=read"`,
+		}, item.GetGrants()[1].GetWarnings())
+		assert.NotEmpty(item.GetGrants()[2].GetError())
+		assert.Empty(cmp.Diff([]*pb.NewlyAllowedPermission{
+			{
+				GrantScopeId: o.GetPublicId(),
+				Type:         "user",
+				Id:           u.GetPublicId(),
+				Actions:      []string{"update"},
+			},
+		}, item.GetNewlyAllowed(), protocmp.Transform()))
+
+		// Validating grants must not change the role
+		_, _, roleGrants, _, err := iamRepo.LookupRole(context.Background(), role.GetPublicId())
+		require.NoError(err)
+		assert.Len(roleGrants, 1)
+	})
+
+	failCases := []struct {
+		name string
+		req  *pbs.ValidateRoleGrantsRequest
+		err  error
+	}{
+		{
+			name: "Bad Role Id",
+			req: &pbs.ValidateRoleGrantsRequest{
+				Id:           "bad id",
+				GrantStrings: []string{"ids=*;type=*;actions=create"},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Missing Grants",
+			req: &pbs.ValidateRoleGrantsRequest{
+				Id: role.GetPublicId(),
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Empty Grant",
+			req: &pbs.ValidateRoleGrantsRequest{
+				Id:           role.GetPublicId(),
+				GrantStrings: []string{"ids=*;type=*;actions=create", ""},
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "Unknown Role",
+			req: &pbs.ValidateRoleGrantsRequest{
+				Id:           globals.RolePrefix + "_doesntexis",
+				GrantStrings: []string{"ids=*;type=*;actions=create"},
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range failCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			_, gErr := s.ValidateRoleGrants(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), tc.req)
+			require.Error(gErr)
+			assert.True(errors.Is(gErr, tc.err), "ValidateRoleGrants(%+v) got error %#v, wanted %#v", tc.req, gErr, tc.err)
+		})
+	}
+}
+
 func TestSetGrants(t *testing.T) {
 	conn, _ := db.TestSetup(t, "postgres")
 	wrap := db.TestWrapper(t)
@@ -3400,3 +3497,239 @@ func TestRemoveGrantScopes(t *testing.T) {
 		})
 	}
 }
+
+func TestAccessRequests(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	o, _ := iam.TestScopes(t, iamRepo)
+	role := iam.TestRole(t, conn, o.GetPublicId())
+	otherRole := iam.TestRole(t, conn, o.GetPublicId())
+
+	requesterToken := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+	requesterRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, requesterRole.GetPublicId(), "ids=*;type=role;actions=request-access")
+	iam.TestUserRole(t, conn, requesterRole.GetPublicId(), requesterToken.GetIamUserId())
+	approverToken := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+	approverRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, approverRole.GetPublicId(), "ids=*;type=role;actions=list-access-requests,approve-requests")
+	iam.TestUserRole(t, conn, approverRole.GetPublicId(), approverToken.GetIamUserId())
+	unprivToken := authtoken.TestAuthToken(t, conn, kmsCache, o.GetPublicId())
+
+	s, err := roles.NewService(ctx, iamRepoFn, 1000)
+	require.NoError(t, err)
+
+	authCtx := func(at *authtoken.AuthToken) context.Context {
+		requestInfo := authpb.RequestInfo{
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+		return auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+	}
+	isPrincipal := func(t *testing.T, roleId string) bool {
+		t.Helper()
+		prs, err := iamRepo.ListPrincipalRoles(ctx, roleId)
+		require.NoError(t, err)
+		for _, pr := range prs {
+			if pr.GetPrincipalId() == requesterToken.GetIamUserId() {
+				return true
+			}
+		}
+		return false
+	}
+	request := func(t *testing.T, roleId string) *pb.AccessRequest {
+		t.Helper()
+		got, err := s.RequestRoleAccess(authCtx(requesterToken), &pbs.RequestRoleAccessRequest{
+			Id:              roleId,
+			Justification:   "incident 1234",
+			DurationSeconds: 3600,
+		})
+		require.NoError(t, err)
+		return got.GetItem()
+	}
+
+	// The subtests run in order, each one depending on the requests left by
+	// the previous ones.
+	var requestId string
+	t.Run("request", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		item := request(t, role.GetPublicId())
+		assert.Equal(role.GetPublicId(), item.GetRoleId())
+		assert.Equal(requesterToken.GetIamUserId(), item.GetUserId())
+		assert.Equal("incident 1234", item.GetJustification())
+		assert.Equal(uint32(3600), item.GetDurationSeconds())
+		assert.Equal("pending", item.GetStatus())
+		assert.NotNil(item.GetCreatedTime())
+		assert.Nil(item.GetExpirationTime())
+		requestId = item.GetId()
+		require.NotEmpty(requestId)
+	})
+
+	t.Run("request-invalid", func(t *testing.T) {
+		cases := []struct {
+			name  string
+			token *authtoken.AuthToken
+			req   *pbs.RequestRoleAccessRequest
+			err   error
+		}{
+			{
+				name:  "missing-justification",
+				token: requesterToken,
+				req:   &pbs.RequestRoleAccessRequest{Id: role.GetPublicId(), DurationSeconds: 3600},
+				err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name:  "duration-too-long",
+				token: requesterToken,
+				req:   &pbs.RequestRoleAccessRequest{Id: role.GetPublicId(), Justification: "incident 1234", DurationSeconds: 604801},
+				err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+			{
+				name:  "already-open",
+				token: requesterToken,
+				req:   &pbs.RequestRoleAccessRequest{Id: role.GetPublicId(), Justification: "incident 1234", DurationSeconds: 3600},
+				err:   handlers.ApiErrorWithCode(codes.FailedPrecondition),
+			},
+			{
+				name:  "unauthorized",
+				token: unprivToken,
+				req:   &pbs.RequestRoleAccessRequest{Id: role.GetPublicId(), Justification: "incident 1234", DurationSeconds: 3600},
+				err:   handlers.ApiErrorWithCode(codes.PermissionDenied),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := s.RequestRoleAccess(authCtx(tc.token), tc.req)
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tc.err), "RequestRoleAccess(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+			})
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ListRoleAccessRequests(authCtx(approverToken), &pbs.ListRoleAccessRequestsRequest{Id: role.GetPublicId()})
+		require.NoError(err)
+		require.Len(got.GetItems(), 1)
+		assert.Equal(requestId, got.GetItems()[0].GetId())
+
+		got, err = s.ListRoleAccessRequests(authCtx(approverToken), &pbs.ListRoleAccessRequestsRequest{Id: otherRole.GetPublicId()})
+		require.NoError(err)
+		assert.Empty(got.GetItems())
+
+		_, err = s.ListRoleAccessRequests(authCtx(requesterToken), &pbs.ListRoleAccessRequestsRequest{Id: role.GetPublicId()})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)))
+	})
+
+	t.Run("decide-invalid", func(t *testing.T) {
+		cases := []struct {
+			name  string
+			token *authtoken.AuthToken
+			req   *pbs.ApproveRoleAccessRequestRequest
+			err   error
+		}{
+			{
+				name:  "requester-without-approve-requests",
+				token: requesterToken,
+				req:   &pbs.ApproveRoleAccessRequestRequest{Id: role.GetPublicId(), AccessRequestId: requestId},
+				err:   handlers.ApiErrorWithCode(codes.PermissionDenied),
+			},
+			{
+				name:  "unauthorized",
+				token: unprivToken,
+				req:   &pbs.ApproveRoleAccessRequestRequest{Id: role.GetPublicId(), AccessRequestId: requestId},
+				err:   handlers.ApiErrorWithCode(codes.PermissionDenied),
+			},
+			{
+				name:  "request-of-other-role",
+				token: approverToken,
+				req:   &pbs.ApproveRoleAccessRequestRequest{Id: otherRole.GetPublicId(), AccessRequestId: requestId},
+				err:   handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name:  "not-found",
+				token: approverToken,
+				req:   &pbs.ApproveRoleAccessRequestRequest{Id: role.GetPublicId(), AccessRequestId: iam.AccessRequestPrefix + "_1234567890"},
+				err:   handlers.ApiErrorWithCode(codes.NotFound),
+			},
+			{
+				name:  "bad-access-request-id",
+				token: approverToken,
+				req:   &pbs.ApproveRoleAccessRequestRequest{Id: role.GetPublicId(), AccessRequestId: "s_1234567890"},
+				err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+			},
+		}
+		for _, tc := range cases {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := s.ApproveRoleAccessRequest(authCtx(tc.token), tc.req)
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, tc.err), "ApproveRoleAccessRequest(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+			})
+		}
+		assert.False(t, isPrincipal(t, role.GetPublicId()))
+	})
+
+	t.Run("approve", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.ApproveRoleAccessRequest(authCtx(approverToken), &pbs.ApproveRoleAccessRequestRequest{
+			Id:              role.GetPublicId(),
+			AccessRequestId: requestId,
+			Comment:         "approved for the incident",
+		})
+		require.NoError(err)
+		assert.Equal("approved", got.GetItem().GetStatus())
+		assert.Equal(approverToken.GetIamUserId(), got.GetItem().GetApproverId())
+		assert.Equal("approved for the incident", got.GetItem().GetDecisionComment())
+		assert.NotNil(got.GetItem().GetDecisionTime())
+		assert.NotNil(got.GetItem().GetExpirationTime())
+		assert.True(isPrincipal(t, role.GetPublicId()))
+
+		// a decided request cannot be decided again
+		_, err = s.DenyRoleAccessRequest(authCtx(approverToken), &pbs.DenyRoleAccessRequestRequest{
+			Id:              role.GetPublicId(),
+			AccessRequestId: requestId,
+		})
+		require.Error(err)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		item := request(t, otherRole.GetPublicId())
+
+		_, err := s.DenyRoleAccessRequest(authCtx(requesterToken), &pbs.DenyRoleAccessRequestRequest{
+			Id:              otherRole.GetPublicId(),
+			AccessRequestId: item.GetId(),
+		})
+		require.Error(err)
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.PermissionDenied)))
+
+		got, err := s.DenyRoleAccessRequest(authCtx(approverToken), &pbs.DenyRoleAccessRequestRequest{
+			Id:              otherRole.GetPublicId(),
+			AccessRequestId: item.GetId(),
+		})
+		require.NoError(err)
+		assert.Equal("denied", got.GetItem().GetStatus())
+		assert.Equal(approverToken.GetIamUserId(), got.GetItem().GetApproverId())
+		assert.Empty(got.GetItem().GetDecisionComment())
+		assert.NotNil(got.GetItem().GetDecisionTime())
+		assert.Nil(got.GetItem().GetExpirationTime())
+		assert.False(isPrincipal(t, otherRole.GetPublicId()))
+	})
+}
@@ -44,7 +44,6 @@ import (
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	wrappingKms "github.com/hashicorp/go-kms-wrapping/extras/kms/v2"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -617,12 +616,88 @@ func (s *Service) DestroyKeyVersion(ctx context.Context, req *pbs.DestroyKeyVers
 
 // AttachStoragePolicy implements the interface pbs.ScopeServiceServer.
 func (s *Service) AttachStoragePolicy(ctx context.Context, req *pbs.AttachStoragePolicyRequest) (*pbs.AttachStoragePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+	const op = "scopes.(Service).AttachStoragePolicy"
+
+	if err := validateAttachStoragePolicyRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.AttachStoragePolicy)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p, err := repo.AttachScopeStoragePolicy(ctx, req.GetId(), req.GetStoragePolicyId(), req.GetVersion())
+	if err != nil {
+		return nil, storagePolicyError(req.GetId(), err)
+	}
+	item, err := s.storagePolicyResponseItem(ctx, authResults, p)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.AttachStoragePolicyResponse{Item: item}, nil
 }
 
 // DetachStoragePolicy implements the interface pbs.ScopeServiceServer.
 func (s *Service) DetachStoragePolicy(ctx context.Context, req *pbs.DetachStoragePolicyRequest) (*pbs.DetachStoragePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "Policies are an Enterprise-only feature")
+	const op = "scopes.(Service).DetachStoragePolicy"
+
+	if err := validateDetachStoragePolicyRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.DetachStoragePolicy)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p, err := repo.DetachScopeStoragePolicy(ctx, req.GetId(), req.GetVersion())
+	if err != nil {
+		return nil, storagePolicyError(req.GetId(), err)
+	}
+	item, err := s.storagePolicyResponseItem(ctx, authResults, p)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.DetachStoragePolicyResponse{Item: item}, nil
+}
+
+// storagePolicyError converts the errors of attaching or detaching a storage
+// policy caused by the request into API errors.
+func storagePolicyError(id string, err error) error {
+	switch {
+	case errors.Match(errors.T(errors.VersionMismatch), err):
+		return handlers.NotFoundErrorf("Scope %q doesn't exist or incorrect version provided.", id)
+	case errors.Match(errors.T(errors.RecordNotFound), err):
+		return handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{"storage_policy_id": "Storage policy not found."})
+	case errors.Match(errors.T(errors.InvalidParameter), err):
+		return handlers.InvalidArgumentErrorf(err.Error(), nil)
+	}
+	return err
+}
+
+// storagePolicyResponseItem returns the scope to include in the response to
+// attaching or detaching a storage policy.
+func (s *Service) storagePolicyResponseItem(ctx context.Context, authResults auth.VerifyResults, p *iam.Scope) (*pb.Scope, error) {
+	const op = "scopes.(Service).storagePolicyResponseItem"
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 2)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, p.GetPublicId(), idActionsById(p.GetPublicId())).Strings()))
+	}
+	return ToProto(ctx, p, outputOpts...)
 }
 
 func (s *Service) getFromRepo(ctx context.Context, id string) (*iam.Scope, error) {
@@ -1074,6 +1149,37 @@ func validateDeleteRequest(req *pbs.DeleteScopeRequest) error {
 	return nil
 }
 
+func validateAttachStoragePolicyRequest(req *pbs.AttachStoragePolicyRequest) error {
+	badFields := map[string]string{}
+	if req.GetId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetId()), scope.Org.Prefix()) {
+		badFields["id"] = "Must be 'global' or a valid org scope id when attaching a storage policy."
+	}
+	if !handlers.ValidId(handlers.Id(req.GetStoragePolicyId()), globals.StoragePolicyPrefix) {
+		badFields["storage_policy_id"] = "Incorrectly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
+func validateDetachStoragePolicyRequest(req *pbs.DetachStoragePolicyRequest) error {
+	badFields := map[string]string{}
+	if req.GetId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetId()), scope.Org.Prefix()) {
+		badFields["id"] = "Must be 'global' or a valid org scope id when detaching a storage policy."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Error in provided request.", badFields)
+	}
+	return nil
+}
+
 func validateListRequest(ctx context.Context, req *pbs.ListScopesRequest) error {
 	badFields := map[string]string{}
 	if req.GetScopeId() != scope.Global.String() && !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) {
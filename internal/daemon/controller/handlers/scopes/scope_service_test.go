@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/boundary/internal/iam"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/policy/storage"
 	"github.com/hashicorp/boundary/internal/requests"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/types/scope"
@@ -38,7 +39,6 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -129,6 +129,8 @@ var globalAuthorizedCollectionActions = map[string]*structpb.ListValue{
 	"session-recordings": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("list"),
+			structpb.NewStringValue("search"),
+			structpb.NewStringValue("list-retention-reports"),
 		},
 	},
 	"storage-buckets": {
@@ -197,6 +199,8 @@ var orgAuthorizedCollectionActions = map[string]*structpb.ListValue{
 	"session-recordings": {
 		Values: []*structpb.Value{
 			structpb.NewStringValue("list"),
+			structpb.NewStringValue("search"),
+			structpb.NewStringValue("list-retention-reports"),
 		},
 	},
 	"storage-buckets": {
@@ -3166,25 +3170,114 @@ func TestDestroyKeyVersion(t *testing.T) {
 }
 
 func TestAttachStoragePolicy(t *testing.T) {
-	t.Run("unimplemented", func(t *testing.T) {
-		service := &scopes.Service{}
-		_, err := service.AttachStoragePolicy(context.Background(), &pbs.AttachStoragePolicyRequest{})
-		require.Error(t, err)
-		gotStatus, ok := status.FromError(err)
-		require.True(t, ok)
-		assert.Equal(t, gotStatus.Code(), codes.Unimplemented)
-		assert.Equal(t, gotStatus.Message(), "Policies are an Enterprise-only feature")
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := scopes.NewServiceFn(ctx, repoFn, kms.TestKms(t, conn, wrap), 1000)
+	require.NoError(t, err)
+
+	org, proj := iam.TestScopes(t, iamRepo)
+	otherOrg, _ := iam.TestScopes(t, iamRepo)
+	orgPolicy := storage.TestPolicy(t, conn, org.GetPublicId())
+	otherOrgPolicy := storage.TestPolicy(t, conn, otherOrg.GetPublicId())
+
+	cases := []struct {
+		name string
+		req  *pbs.AttachStoragePolicyRequest
+		err  error
+	}{
+		{
+			name: "project",
+			req:  &pbs.AttachStoragePolicyRequest{Id: proj.GetPublicId(), StoragePolicyId: orgPolicy.GetPublicId(), Version: proj.GetVersion()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "bad-policy-id",
+			req:  &pbs.AttachStoragePolicyRequest{Id: org.GetPublicId(), StoragePolicyId: "hcst_1234567890", Version: org.GetVersion()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing-policy",
+			req:  &pbs.AttachStoragePolicyRequest{Id: org.GetPublicId(), StoragePolicyId: "pst_1234567890", Version: org.GetVersion()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "other-org-policy",
+			req:  &pbs.AttachStoragePolicyRequest{Id: org.GetPublicId(), StoragePolicyId: otherOrgPolicy.GetPublicId(), Version: org.GetVersion()},
+			err:  handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "wrong-version",
+			req:  &pbs.AttachStoragePolicyRequest{Id: org.GetPublicId(), StoragePolicyId: orgPolicy.GetPublicId(), Version: org.GetVersion() + 1},
+			err:  handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.AttachStoragePolicy(auth.DisabledAuthTestContext(repoFn, tc.req.GetId()), tc.req)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tc.err), "AttachStoragePolicy(%+v) got error %v, wanted %v", tc.req, err, tc.err)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := s.AttachStoragePolicy(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), &pbs.AttachStoragePolicyRequest{
+			Id:              org.GetPublicId(),
+			StoragePolicyId: orgPolicy.GetPublicId(),
+			Version:         org.GetVersion(),
+		})
+		require.NoError(err)
+		assert.Equal(org.GetPublicId(), got.GetItem().GetId())
+		assert.Equal(orgPolicy.GetPublicId(), got.GetItem().GetStoragePolicyId())
+		assert.Equal(org.GetVersion()+1, got.GetItem().GetVersion())
+
+		_, err = s.AttachStoragePolicy(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), &pbs.AttachStoragePolicyRequest{
+			Id:              org.GetPublicId(),
+			StoragePolicyId: orgPolicy.GetPublicId(),
+			Version:         got.GetItem().GetVersion(),
+		})
+		assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "attaching to a scope with a storage policy got error %v", err)
 	})
 }
 
 func TestDetachStoragePolicy(t *testing.T) {
-	t.Run("unimplemented", func(t *testing.T) {
-		service := &scopes.Service{}
-		_, err := service.DetachStoragePolicy(context.Background(), &pbs.DetachStoragePolicyRequest{})
-		require.Error(t, err)
-		gotStatus, ok := status.FromError(err)
-		require.True(t, ok)
-		assert.Equal(t, gotStatus.Code(), codes.Unimplemented)
-		assert.Equal(t, gotStatus.Message(), "Policies are an Enterprise-only feature")
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	s, err := scopes.NewServiceFn(ctx, repoFn, kms.TestKms(t, conn, wrap), 1000)
+	require.NoError(t, err)
+
+	org, _ := iam.TestScopes(t, iamRepo)
+	p := storage.TestPolicy(t, conn, org.GetPublicId())
+	attached, err := iamRepo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), p.GetPublicId(), org.GetVersion())
+	require.NoError(t, err)
+
+	_, err = s.DetachStoragePolicy(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), &pbs.DetachStoragePolicyRequest{
+		Id:      org.GetPublicId(),
+		Version: attached.GetVersion() + 1,
+	})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)), "detaching with the wrong version got error %v", err)
+
+	got, err := s.DetachStoragePolicy(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), &pbs.DetachStoragePolicyRequest{
+		Id:      org.GetPublicId(),
+		Version: attached.GetVersion(),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, got.GetItem().GetStoragePolicyId())
+	assert.Equal(t, attached.GetVersion()+1, got.GetItem().GetVersion())
+
+	_, err = s.DetachStoragePolicy(auth.DisabledAuthTestContext(repoFn, org.GetPublicId()), &pbs.DetachStoragePolicyRequest{
+		Id:      org.GetPublicId(),
+		Version: got.GetItem().GetVersion(),
 	})
+	assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "detaching without a storage policy got error %v", err)
 }
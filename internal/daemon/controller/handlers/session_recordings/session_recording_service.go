@@ -5,16 +5,30 @@ package session_recordings
 
 import (
 	"context"
+	"strings"
 	"sync/atomic"
+	"time"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
 	intglobals "github.com/hashicorp/boundary/internal/globals"
 	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/recording"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/session_recordings"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -26,12 +40,16 @@ var (
 		action.Download,
 		action.Delete,
 		action.ReApplyStoragePolicy,
+		action.SetLegalHold,
+		action.RemoveLegalHold,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
 	// this collection
 	CollectionActions = action.NewActionSet(
 		action.List,
+		action.Search,
+		action.ListRetentionReports,
 	)
 )
 
@@ -40,20 +58,40 @@ func init() {
 	action.RegisterResource(resource.SessionRecording, IdActions, CollectionActions)
 }
 
-// NewServiceFn returns a storage bucket service which is not implemented in OSS
+// NewServiceFn returns a session recording service. Only searching session
+// recordings, listing retention reports and legal holds are implemented in OSS.
 var NewServiceFn = func(ctx context.Context,
 	iamRepoFn common.IamRepoFactory,
 	serverRepoFn common.ServersRepoFactory,
+	recordingRepoFn common.RecordingRepoFactory,
 	workerStatusGracePeriod *atomic.Int64,
 	kms *kms.Kms,
 	maxPageSize uint,
 	controllerExt intglobals.ControllerExtension,
 ) (pbs.SessionRecordingServiceServer, error) {
-	return Service{}, nil
+	const op = "session_recordings.NewServiceFn"
+	switch {
+	case iamRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	case recordingRepoFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing recording repository")
+	}
+	if maxPageSize == 0 {
+		maxPageSize = uint(globals.DefaultMaxPageSize)
+	}
+	return Service{
+		iamRepoFn:       iamRepoFn,
+		recordingRepoFn: recordingRepoFn,
+		maxPageSize:     maxPageSize,
+	}, nil
 }
 
 type Service struct {
 	pbs.UnimplementedSessionRecordingServiceServer
+
+	iamRepoFn       common.IamRepoFactory
+	recordingRepoFn common.RecordingRepoFactory
+	maxPageSize     uint
 }
 
 var _ pbs.SessionRecordingServiceServer = (*Service)(nil)
@@ -78,6 +116,388 @@ func (s Service) ReApplyStoragePolicy(context.Context, *pbs.ReApplyStoragePolicy
 	return nil, status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
 }
 
+// SearchSessionRecordings implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) SearchSessionRecordings(ctx context.Context, req *pbs.SearchSessionRecordingsRequest) (*pbs.SearchSessionRecordingsResponse, error) {
+	const op = "session_recordings.(Service).SearchSessionRecordings"
+
+	if err := validateSearchRequest(req); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	scopeId := req.GetScopeId()
+	if scopeId == "" {
+		scopeId = scope.Global.String()
+	}
+	authResults := s.authResult(ctx, scopeId, action.Search)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, scopeId, resource.SessionRecording, req.GetRecursive())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// The listing scopes are the scopes in which session recordings can be
+	// listed, only keep the ones in which they can be searched.
+	searchScopeIds := scopeIdsWithAction(ctx, authResults, scopeIds, action.Search)
+	if len(searchScopeIds) == 0 {
+		return &pbs.SearchSessionRecordingsResponse{}, nil
+	}
+
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+	repo, err := s.recordingRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grantsHash, err := authResults.GrantsHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	// The list token of a search is a pagination token with the id and time
+	// of the last line returned.
+	tokenCreateTime := time.Now()
+	var afterLineId string
+	var afterLineTime time.Time
+	if req.GetListToken() != "" {
+		listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.SessionRecording, grantsHash)
+		if err != nil {
+			return nil, err
+		}
+		st, ok := listToken.Subtype.(*listtoken.PaginationToken)
+		if !ok {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", listToken.Subtype)
+		}
+		tokenCreateTime = listToken.CreateTime
+		afterLineId, afterLineTime = st.LastItemId, st.LastItemCreateTime
+	}
+
+	// The lines of a session recording are part of its content, so they are
+	// only returned if the session recording can be read or downloaded. As
+	// matches are filtered out after the query, more matches are requested
+	// until the page is filled.
+	readable := make(map[string]bool)
+	limit := pageSize + 1
+	results := make([]*recording.SearchResult, 0, limit)
+dbLoop:
+	for {
+		opts := []recording.Option{recording.WithLimit(limit)}
+		if afterLineId != "" {
+			opts = append(opts, recording.WithStartPageAfterLine(afterLineId, afterLineTime))
+		}
+		page, err := repo.Search(ctx, searchScopeIds, req.GetQuery(), opts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		for _, r := range page {
+			ok, checked := readable[r.SessionRecordingId]
+			if !checked {
+				ok = canReadRecording(ctx, authResults, r.SessionRecordingId, r.ScopeId)
+				readable[r.SessionRecordingId] = ok
+			}
+			if !ok {
+				continue
+			}
+			results = append(results, r)
+			if len(results) == cap(results) {
+				break dbLoop
+			}
+		}
+		// If the current page was shorter than the limit, stop iterating
+		if len(page) < limit {
+			break dbLoop
+		}
+		last := page[len(page)-1]
+		afterLineId, afterLineTime = last.LineId(), last.LineTime
+	}
+
+	resp := &pbs.SearchSessionRecordingsResponse{
+		ResponseType: "complete",
+	}
+	if len(results) == cap(results) {
+		// Results is of size pageSize+1, so truncate it and return a token
+		// to continue after the last line of the page.
+		results = results[:pageSize]
+		last := results[len(results)-1]
+		listToken, err := listtoken.NewPagination(ctx, tokenCreateTime, resource.SessionRecording, grantsHash, last.LineId(), last.LineTime)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		resp.ListToken, err = handlers.MarshalListToken(ctx, listToken, pbs.ResourceType_RESOURCE_TYPE_SESSION_RECORDING)
+		if err != nil {
+			return nil, err
+		}
+		resp.ResponseType = "delta"
+	}
+	resp.Items = make([]*pb.SearchMatch, 0, len(results))
+	for _, r := range results {
+		resp.Items = append(resp.Items, &pb.SearchMatch{
+			SessionRecordingId:    r.SessionRecordingId,
+			ConnectionRecordingId: r.ConnectionRecordingId,
+			ChannelRecordingId:    r.ChannelRecordingId,
+			Scope:                 scopeInfoMap[r.ScopeId],
+			Time:                  timestamppb.New(r.LineTime),
+			Direction:             r.Direction,
+			Line:                  r.Content,
+		})
+	}
+	return resp, nil
+}
+
+// ListSessionRecordingRetentionReports implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) ListSessionRecordingRetentionReports(ctx context.Context, req *pbs.ListSessionRecordingRetentionReportsRequest) (*pbs.ListSessionRecordingRetentionReportsResponse, error) {
+	const op = "session_recordings.(Service).ListSessionRecordingRetentionReports"
+
+	if err := validateListRetentionReportsRequest(req); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	scopeId := req.GetScopeId()
+	if scopeId == "" {
+		scopeId = scope.Global.String()
+	}
+	authResults := s.authResult(ctx, scopeId, action.ListRetentionReports)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, scopeId, resource.SessionRecording, req.GetRecursive())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	reportScopeIds := scopeIdsWithAction(ctx, authResults, scopeIds, action.ListRetentionReports)
+	if len(reportScopeIds) == 0 {
+		return &pbs.ListSessionRecordingRetentionReportsResponse{}, nil
+	}
+
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+	repo, err := s.recordingRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	reports, err := repo.ListRetentionReports(ctx, reportScopeIds, recording.WithLimit(pageSize))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	items := make([]*pb.RetentionReport, 0, len(reports))
+	for _, r := range reports {
+		items = append(items, toRetentionReportProto(r, scopeInfoMap[r.ScopeId]))
+	}
+	return &pbs.ListSessionRecordingRetentionReportsResponse{Items: items}, nil
+}
+
+// SetSessionRecordingLegalHold implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) SetSessionRecordingLegalHold(ctx context.Context, req *pbs.SetSessionRecordingLegalHoldRequest) (*pbs.SetSessionRecordingLegalHoldResponse, error) {
+	const op = "session_recordings.(Service).SetSessionRecordingLegalHold"
+
+	if err := validateLegalHoldId(req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authResults := s.authResultForId(ctx, req.GetId(), action.SetLegalHold)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.recordingRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	h, err := repo.SetLegalHold(ctx, req.GetId(), authResults.UserId, req.GetReason())
+	if err != nil {
+		if errors.Match(errors.T(errors.NotUnique), err) {
+			return nil, handlers.ConflictErrorf("Session recording is already under legal hold.")
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &pbs.SetSessionRecordingLegalHoldResponse{Item: &pb.LegalHold{
+		SessionRecordingId: h.GetRecordingSessionId(),
+		UserId:             h.GetUserId(),
+		Reason:             h.GetReason(),
+		CreatedTime:        h.GetCreateTime().GetTimestamp(),
+	}}, nil
+}
+
+// RemoveSessionRecordingLegalHold implements the interface pbs.SessionRecordingServiceServer.
+func (s Service) RemoveSessionRecordingLegalHold(ctx context.Context, req *pbs.RemoveSessionRecordingLegalHoldRequest) (*pbs.RemoveSessionRecordingLegalHoldResponse, error) {
+	const op = "session_recordings.(Service).RemoveSessionRecordingLegalHold"
+
+	if err := validateLegalHoldId(req.GetId()); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authResults := s.authResultForId(ctx, req.GetId(), action.RemoveLegalHold)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	repo, err := s.recordingRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.RemoveLegalHold(ctx, req.GetId(), authResults.UserId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if rows == 0 {
+		return nil, handlers.NotFoundErrorf("Session recording %q is not under legal hold.", req.GetId())
+	}
+	return &pbs.RemoveSessionRecordingLegalHoldResponse{}, nil
+}
+
+func (s Service) authResult(ctx context.Context, scopeId string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	scp, err := iamRepo.LookupScope(ctx, scopeId)
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	if scp == nil {
+		res.Error = handlers.NotFoundError()
+		return res
+	}
+	return auth.Verify(ctx, auth.WithType(resource.SessionRecording), auth.WithAction(a), auth.WithScopeId(scopeId))
+}
+
+// authResultForId verifies the action on a session recording, in the scope of
+// its storage bucket.
+func (s Service) authResultForId(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+	repo, err := s.recordingRepoFn()
+	if err != nil {
+		res.Error = err
+		return res
+	}
+	scopeId, err := repo.LookupScopeId(ctx, id)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		res.Error = err
+		return res
+	}
+	return auth.Verify(ctx, auth.WithType(resource.SessionRecording), auth.WithAction(a), auth.WithId(id), auth.WithScopeId(scopeId))
+}
+
+func validateLegalHoldId(id string) error {
+	if !handlers.ValidId(handlers.Id(id), globals.SessionRecordingPrefix) {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", map[string]string{globals.IdField: "Invalid formatted identifier."})
+	}
+	return nil
+}
+
+func validateSearchRequest(req *pbs.SearchSessionRecordingsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != "" || !req.GetRecursive() {
+		if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+			req.GetScopeId() != scope.Global.String() {
+			badFields[globals.ScopeIdField] = "This field must be 'global' or a valid org scope ID or the search must be recursive."
+		}
+	}
+	if strings.TrimSpace(req.GetQuery()) == "" {
+		badFields["query"] = "This field is required."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+func validateListRetentionReportsRequest(req *pbs.ListSessionRecordingRetentionReportsRequest) error {
+	badFields := map[string]string{}
+	if req.GetScopeId() != "" || !req.GetRecursive() {
+		if !handlers.ValidId(handlers.Id(req.GetScopeId()), scope.Org.Prefix()) &&
+			req.GetScopeId() != scope.Global.String() {
+			badFields[globals.ScopeIdField] = "This field must be 'global' or a valid org scope ID or the list must be recursive."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Invalid fields provided in request.", badFields)
+	}
+	return nil
+}
+
+// scopeIdsWithAction returns the scopes in which the provided action can be
+// performed on session recordings.
+func scopeIdsWithAction(ctx context.Context, authResults auth.VerifyResults, scopeIds []string, a action.Type) []string {
+	ids := make([]string, 0, len(scopeIds))
+	for _, id := range scopeIds {
+		res := perms.Resource{
+			Type:    resource.SessionRecording,
+			ScopeId: id,
+		}
+		if authResults.FetchActionSetForType(ctx, resource.Unknown, action.NewActionSet(a), auth.WithResource(&res)).HasAction(a) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// canReadRecording reports whether the session recording with the provided id,
+// in the scope of its storage bucket, can be read or downloaded.
+func canReadRecording(ctx context.Context, authResults auth.VerifyResults, id, scopeId string) bool {
+	res := perms.Resource{
+		Id:      id,
+		Type:    resource.SessionRecording,
+		ScopeId: scopeId,
+	}
+	actions := authResults.FetchActionSetForId(ctx, id, action.NewActionSet(action.Read, action.Download), auth.WithResource(&res))
+	return actions.HasAction(action.Read) || actions.HasAction(action.Download)
+}
+
+func toRetentionReportProto(r *recording.RetentionReport, scp *scopes.ScopeInfo) *pb.RetentionReport {
+	out := &pb.RetentionReport{
+		Id:                  r.PublicId,
+		ScopeId:             r.ScopeId,
+		Scope:               scp,
+		CreatedTime:         timestamppb.New(r.CreateTime),
+		RecordingsEvaluated: uint32(r.RecordingsEvaluated),
+		RecordingsHeld:      uint32(r.RecordingsHeld),
+		RecordingsMarked:    uint32(r.RecordingsMarked),
+		RecordingsDeleted:   uint32(r.RecordingsDeleted),
+		RecordingsFailed:    uint32(r.RecordingsFailed),
+	}
+	for _, item := range r.Items {
+		out.Items = append(out.Items, &pb.RetentionReportItem{
+			SessionRecordingId: item.SessionRecordingId,
+			Result:             string(item.Result),
+			Details:            item.Details,
+		})
+	}
+	return out
+}
+
 // Delete implements the interface pbs.SessionRecordingServiceServer.
 func (s Service) Delete(*pbs.DownloadRequest, *pbs.DeleteSessionRecordingRequest) error {
 	return status.Errorf(codes.Unimplemented, "session recordings are an Enterprise-only feature")
@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session_recordings_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/authtoken"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/session_recordings"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	authpb "github.com/hashicorp/boundary/internal/gen/controller/auth"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/hashicorp/boundary/internal/recording"
+	"github.com/hashicorp/boundary/internal/requests"
+	"github.com/hashicorp/boundary/internal/server"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestLegalHold(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	recordingRepoFn := func() (*recording.Repository, error) {
+		return recording.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	recordingId, orgId := recording.TestSessionRecording(t, conn, wrap, iamRepo)
+
+	privToken := authtoken.TestAuthToken(t, conn, kmsCache, orgId)
+	privRole := iam.TestRole(t, conn, orgId)
+	iam.TestRoleGrant(t, conn, privRole.GetPublicId(), "ids=*;type=session-recording;actions=set-legal-hold,remove-legal-hold")
+	iam.TestUserRole(t, conn, privRole.GetPublicId(), privToken.GetIamUserId())
+	unprivToken := authtoken.TestAuthToken(t, conn, kmsCache, orgId)
+
+	s, err := session_recordings.NewServiceFn(ctx, iamRepoFn, serversRepoFn, recordingRepoFn, nil, kmsCache, 1000, nil)
+	require.NoError(t, err)
+
+	authCtx := func(at *authtoken.AuthToken) context.Context {
+		requestInfo := authpb.RequestInfo{
+			TokenFormat: uint32(auth.AuthTokenTypeBearer),
+			PublicId:    at.GetPublicId(),
+			Token:       at.GetToken(),
+		}
+		requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+		return auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+	}
+
+	// The cases run in order, each one depending on the hold left by the
+	// previous ones.
+	cases := []struct {
+		name   string
+		token  *authtoken.AuthToken
+		id     string
+		remove bool
+		err    error
+	}{
+		{
+			name:  "set-invalid-id",
+			token: privToken,
+			id:    "s_1234567890",
+			err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name:  "set-not-found",
+			token: privToken,
+			id:    globals.SessionRecordingPrefix + "_1234567890",
+			err:   handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:  "set-unauthorized",
+			token: unprivToken,
+			id:    recordingId,
+			err:   handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:  "set",
+			token: privToken,
+			id:    recordingId,
+		},
+		{
+			name:  "set-already-held",
+			token: privToken,
+			id:    recordingId,
+			err:   handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name:   "remove-unauthorized",
+			token:  unprivToken,
+			id:     recordingId,
+			remove: true,
+			err:    handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:   "remove",
+			token:  privToken,
+			id:     recordingId,
+			remove: true,
+		},
+		{
+			name:   "remove-not-held",
+			token:  privToken,
+			id:     recordingId,
+			remove: true,
+			err:    handlers.ApiErrorWithCode(codes.NotFound),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			ctx := authCtx(tc.token)
+
+			if tc.remove {
+				got, err := s.RemoveSessionRecordingLegalHold(ctx, &pbs.RemoveSessionRecordingLegalHoldRequest{Id: tc.id})
+				if tc.err != nil {
+					require.Error(err)
+					assert.True(errors.Is(err, tc.err), "RemoveSessionRecordingLegalHold(%q) got error %v, wanted %v", tc.id, err, tc.err)
+					return
+				}
+				require.NoError(err)
+				assert.NotNil(got)
+				return
+			}
+
+			got, err := s.SetSessionRecordingLegalHold(ctx, &pbs.SetSessionRecordingLegalHoldRequest{Id: tc.id, Reason: "litigation"})
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "SetSessionRecordingLegalHold(%q) got error %v, wanted %v", tc.id, err, tc.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.id, got.GetItem().GetSessionRecordingId())
+			assert.Equal(tc.token.GetIamUserId(), got.GetItem().GetUserId())
+			assert.Equal("litigation", got.GetItem().GetReason())
+			assert.NotNil(got.GetItem().GetCreatedTime())
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	recordingRepoFn := func() (*recording.Repository, error) {
+		return recording.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	// The lines of the unreadable recording are more recent than the ones of
+	// the readable recording, so the first matches of the query are filtered
+	// out.
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	readableId, readableOrgId := recording.TestSessionRecording(t, conn, wrap, iamRepo)
+	unreadableId, unreadableOrgId := recording.TestSessionRecording(t, conn, wrap, iamRepo)
+	var readableLines, unreadableLines []string
+	for i := 0; i < 5; i++ {
+		readableLines = append(readableLines, fmt.Sprintf("sudo readable %d", i))
+		unreadableLines = append(unreadableLines, fmt.Sprintf("sudo unreadable %d", i))
+	}
+	recording.TestIndexedChannel(t, conn, readableId, start, readableLines...)
+	recording.TestIndexedChannel(t, conn, unreadableId, start.Add(time.Minute), unreadableLines...)
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, readableOrgId)
+	readableRole := iam.TestRole(t, conn, readableOrgId)
+	iam.TestRoleGrant(t, conn, readableRole.GetPublicId(), "ids=*;type=session-recording;actions=search")
+	iam.TestRoleGrant(t, conn, readableRole.GetPublicId(), "ids="+readableId+";actions=read")
+	iam.TestUserRole(t, conn, readableRole.GetPublicId(), at.GetIamUserId())
+	unreadableRole := iam.TestRole(t, conn, unreadableOrgId)
+	iam.TestRoleGrant(t, conn, unreadableRole.GetPublicId(), "ids=*;type=session-recording;actions=search")
+	iam.TestUserRole(t, conn, unreadableRole.GetPublicId(), at.GetIamUserId())
+
+	s, err := session_recordings.NewServiceFn(ctx, iamRepoFn, serversRepoFn, recordingRepoFn, nil, kmsCache, 1000, nil)
+	require.NoError(t, err)
+
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	authCtx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+
+	// Each page is filled with the matches of the readable recording, most
+	// recent first, and the list token returns the following ones.
+	var got []string
+	var responseTypes []string
+	var listToken string
+	for i := 0; i < 3; i++ {
+		resp, err := s.SearchSessionRecordings(authCtx, &pbs.SearchSessionRecordingsRequest{
+			ScopeId:   scope.Global.String(),
+			Recursive: true,
+			Query:     "sudo",
+			PageSize:  2,
+			ListToken: listToken,
+		})
+		require.NoError(t, err)
+		for _, item := range resp.GetItems() {
+			assert.Equal(t, readableId, item.GetSessionRecordingId())
+			got = append(got, item.GetLine())
+		}
+		responseTypes = append(responseTypes, resp.GetResponseType())
+		listToken = resp.GetListToken()
+		if resp.GetResponseType() == "complete" {
+			assert.Empty(t, listToken)
+			break
+		}
+		require.NotEmpty(t, listToken)
+	}
+	assert.Equal(t, []string{"sudo readable 4", "sudo readable 3", "sudo readable 2", "sudo readable 1", "sudo readable 0"}, got)
+	assert.Equal(t, []string{"delta", "delta", "complete"}, responseTypes)
+
+	// The list token of a search cannot be used once the grants changed.
+	resp, err := s.SearchSessionRecordings(authCtx, &pbs.SearchSessionRecordingsRequest{
+		ScopeId:   scope.Global.String(),
+		Recursive: true,
+		Query:     "sudo",
+		PageSize:  2,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetListToken())
+	iam.TestRoleGrant(t, conn, readableRole.GetPublicId(), "ids="+unreadableId+";actions=read")
+	_, err = s.SearchSessionRecordings(authCtx, &pbs.SearchSessionRecordingsRequest{
+		ScopeId:   scope.Global.String(),
+		Recursive: true,
+		Query:     "sudo",
+		PageSize:  2,
+		ListToken: resp.GetListToken(),
+	})
+	require.Error(t, err)
+}
+
+func TestListRetentionReports(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	recordingRepoFn := func() (*recording.Repository, error) {
+		return recording.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kmsCache)
+	}
+
+	// The recording ended three days ago, with the retention of the storage
+	// policy attached to its org, which deletes recordings after two days.
+	recordingId, orgId := recording.TestSessionRecording(t, conn, wrap, iamRepo)
+	_, err := rw.Exec(ctx, `
+update recording_session
+   set start_time        = now() - interval '4 days',
+       end_time          = now() - interval '3 days',
+       retain_for_days   = 1,
+       delete_after_days = 2
+ where public_id = @public_id;`,
+		[]any{sql.Named("public_id", recordingId)})
+	require.NoError(t, err)
+	p := storage.TestPolicy(t, conn, orgId, storage.WithRetainForDays(1), storage.WithDeleteAfterDays(2))
+	org, err := iamRepo.LookupScope(ctx, orgId)
+	require.NoError(t, err)
+	_, err = iamRepo.AttachScopeStoragePolicy(ctx, orgId, p.GetPublicId(), org.GetVersion())
+	require.NoError(t, err)
+
+	job, err := recording.NewStoragePolicyJob(ctx, rw, rw, kmsCache, nil)
+	require.NoError(t, err)
+	require.NoError(t, job.Run(ctx, 0))
+
+	at := authtoken.TestAuthToken(t, conn, kmsCache, orgId)
+	role := iam.TestRole(t, conn, orgId)
+	iam.TestRoleGrant(t, conn, role.GetPublicId(), "ids=*;type=session-recording;actions=list-retention-reports")
+	iam.TestUserRole(t, conn, role.GetPublicId(), at.GetIamUserId())
+
+	s, err := session_recordings.NewServiceFn(ctx, iamRepoFn, serversRepoFn, recordingRepoFn, nil, kmsCache, 1000, nil)
+	require.NoError(t, err)
+
+	requestInfo := authpb.RequestInfo{
+		TokenFormat: uint32(auth.AuthTokenTypeBearer),
+		PublicId:    at.GetPublicId(),
+		Token:       at.GetToken(),
+	}
+	requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+	authCtx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kmsCache, &requestInfo)
+
+	resp, err := s.ListSessionRecordingRetentionReports(authCtx, &pbs.ListSessionRecordingRetentionReportsRequest{ScopeId: orgId})
+	require.NoError(t, err)
+	require.Len(t, resp.GetItems(), 1)
+	report := resp.GetItems()[0]
+	assert.Equal(t, orgId, report.GetScopeId())
+	assert.Equal(t, uint32(1), report.GetRecordingsEvaluated())
+	assert.Equal(t, uint32(1), report.GetRecordingsMarked())
+	assert.Zero(t, report.GetRecordingsHeld())
+	assert.Zero(t, report.GetRecordingsDeleted())
+	assert.Zero(t, report.GetRecordingsFailed())
+	require.Len(t, report.GetItems(), 1)
+	assert.Equal(t, recordingId, report.GetItems()[0].GetSessionRecordingId())
+	assert.Equal(t, string(recording.RetentionMarked), report.GetItems()[0].GetResult())
+}
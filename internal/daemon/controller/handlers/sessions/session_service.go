@@ -25,6 +25,8 @@ import (
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
 	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/sessions"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mitchellh/pointerstructure"
 	"google.golang.org/grpc/codes"
 )
 
@@ -37,6 +39,7 @@ var (
 		action.ReadSelf,
 		action.Cancel,
 		action.CancelSelf,
+		action.Approve,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -200,7 +203,7 @@ func (s Service) ListSessions(ctx context.Context, req *pbs.ListSessionsRequest)
 		return nil, errors.Wrap(ctx, err, op)
 	}
 
-	listPerms := authResults.ACL().ListPermissions(scopeIds, resource.Session, IdActions, authResults.UserId)
+	listPerms := authResults.ACL().ListPermissions(scopeIds, resource.Session, IdActions, authResults.UserId, authResults.ACLOptions()...)
 
 	repo, err := s.repoFn(session.WithPermissions(&perms.UserPermissions{
 		UserId:      authResults.UserId,
@@ -366,6 +369,112 @@ func (s Service) CancelSession(ctx context.Context, req *pbs.CancelSessionReques
 	return &pbs.CancelSessionResponse{Item: item}, nil
 }
 
+// ApproveSession implements the interface pbs.SessionServiceServer.
+func (s Service) ApproveSession(ctx context.Context, req *pbs.ApproveSessionRequest) (*pbs.ApproveSessionResponse, error) {
+	const op = "sessions.(Service).ApproveSession"
+
+	if err := validateApproveRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Approve, false)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	repo, err := s.repoFn()
+	if err != nil {
+		return nil, err
+	}
+	ses, _, err := repo.LookupSession(ctx, req.GetId())
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", req.GetId())
+		}
+		return nil, err
+	}
+	if ses == nil {
+		return nil, handlers.NotFoundErrorf("Session %q doesn't exist.", req.GetId())
+	}
+	// A user can never approve their own session, regardless of their grants.
+	if ses.UserId == authResults.UserId {
+		return nil, handlers.ForbiddenError()
+	}
+	if ses.ApproversFilter != "" {
+		ok, err := s.matchesApproversFilter(ctx, ses.ApproversFilter, authResults.UserId)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if !ok {
+			return nil, handlers.ForbiddenError()
+		}
+	}
+
+	ses, err = repo.ApproveSession(ctx, req.GetId(), req.GetVersion(), authResults.UserId)
+	if err != nil {
+		if errors.Match(errors.T(errors.InvalidSessionState), err) {
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.FailedPrecondition, fmt.Sprintf("Session %q is not awaiting approval.", req.GetId()))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to approve session"))
+	}
+
+	authorizedActions := authResults.FetchActionSetForId(ctx, ses.GetPublicId(), IdActions)
+	outputFields := authResults.FetchOutputFields(perms.Resource{
+		Id:      ses.GetPublicId(),
+		ScopeId: ses.ProjectId,
+		Type:    resource.Session,
+	}, action.Approve).SelfOrDefaults(authResults.UserId)
+
+	outputOpts := make([]handlers.Option, 0, 3)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions.Strings()))
+	}
+
+	item, err := toProto(ctx, ses, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &pbs.ApproveSessionResponse{Item: item}, nil
+}
+
+// matchesApproversFilter evaluates the approvers filter of a session against
+// the user attempting to approve it.
+func (s Service) matchesApproversFilter(ctx context.Context, filter, userId string) (bool, error) {
+	const op = "sessions.(Service).matchesApproversFilter"
+	iamRepo, err := s.iamRepoFn()
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	u, _, err := iamRepo.LookupUser(ctx, userId)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	if u == nil {
+		return false, nil
+	}
+	eval, err := bexpr.CreateEvaluator(filter)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse approvers filter"))
+	}
+	filterInput := map[string]any{
+		"user": map[string]any{
+			"id":         u.GetPublicId(),
+			"name":       u.GetName(),
+			"login_name": u.GetLoginName(),
+			"full_name":  u.GetFullName(),
+			"email":      u.GetEmail(),
+		},
+	}
+	ok, err := eval.Evaluate(filterInput)
+	if err != nil && !stderrors.Is(err, pointerstructure.ErrNotFound) {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to evaluate approvers filter"))
+	}
+	return ok, nil
+}
+
 func (s Service) getFromRepo(ctx context.Context, id string) (*session.Session, error) {
 	repo, err := s.repoFn()
 	if err != nil {
@@ -406,7 +515,7 @@ func (s Service) authResult(ctx context.Context, id string, a action.Type, ignor
 			res.Error = handlers.NotFoundError()
 			return res
 		}
-	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf:
+	case action.Read, action.ReadSelf, action.Cancel, action.CancelSelf, action.Approve:
 		repo, err := s.repoFn()
 		if err != nil {
 			res.Error = err
@@ -487,6 +596,9 @@ func toProto(ctx context.Context, in *session.Session, opt ...handlers.Option) (
 	if outputFields.Has(globals.TerminationReasonField) {
 		out.TerminationReason = in.TerminationReason
 	}
+	if outputFields.Has(globals.ApproverIdField) {
+		out.ApproverId = in.ApproverId
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -573,6 +685,20 @@ func validateCancelRequest(req *pbs.CancelSessionRequest) error {
 	return nil
 }
 
+func validateApproveRequest(req *pbs.ApproveSessionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.SessionPrefix) {
+		badFields["id"] = "Improperly formatted identifier."
+	}
+	if req.GetVersion() == 0 {
+		badFields["version"] = "Required field."
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
+}
+
 func newOutputOpts(ctx context.Context, item *session.Session, scopeIds map[string]*scopes.ScopeInfo, authResults auth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		Type:          resource.Session,
@@ -1294,3 +1294,152 @@ func TestCancel(t *testing.T) {
 		})
 	}
 }
+
+func TestApprove(t *testing.T) {
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kms := kms.TestKms(t, conn, wrap)
+	iamRepo := iam.TestRepo(t, conn, wrap)
+	rw := db.New(conn)
+
+	ctx := context.Background()
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iamRepo, nil
+	}
+	sessRepoFn := func(opt ...session.Option) (*session.Repository, error) {
+		return session.NewRepository(ctx, rw, rw, kms, opt...)
+	}
+	tokenRepoFn := func() (*authtoken.Repository, error) {
+		return authtoken.NewRepository(ctx, rw, rw, kms)
+	}
+	serversRepoFn := func() (*server.Repository, error) {
+		return server.NewRepository(ctx, rw, rw, kms)
+	}
+
+	o, p := iam.TestScopes(t, iamRepo)
+	hc := static.TestCatalogs(t, conn, p.GetPublicId(), 1)[0]
+	hs := static.TestSets(t, conn, hc.GetPublicId(), 1)[0]
+	h := static.TestHosts(t, conn, hc.GetPublicId(), 1)[0]
+	static.TestSetMembers(t, conn, hs.GetPublicId(), []*static.Host{h})
+	tar := tcp.TestTarget(context.Background(), t, conn, p.GetPublicId(), "test", target.WithHostSources([]string{hs.GetPublicId()}))
+
+	// Both the user of the sessions and the approver are granted the approve
+	// action, the user of a session can still never approve it.
+	userToken := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	approverToken := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	unprivToken := authtoken.TestAuthToken(t, conn, kms, o.GetPublicId())
+	approverRole := iam.TestRole(t, conn, p.GetPublicId())
+	iam.TestRoleGrant(t, conn, approverRole.GetPublicId(), "ids=*;type=session;actions=approve")
+	iam.TestUserRole(t, conn, approverRole.GetPublicId(), userToken.GetIamUserId())
+	iam.TestUserRole(t, conn, approverRole.GetPublicId(), approverToken.GetIamUserId())
+
+	newSession := func(approvalRequired bool, approversFilter string) *session.Session {
+		return session.TestSession(t, conn, wrap, session.ComposedOf{
+			UserId:           userToken.GetIamUserId(),
+			HostId:           h.GetPublicId(),
+			TargetId:         tar.GetPublicId(),
+			HostSetId:        hs.GetPublicId(),
+			AuthTokenId:      userToken.GetPublicId(),
+			ProjectId:        p.GetPublicId(),
+			Endpoint:         "tcp://127.0.0.1:22",
+			ApprovalRequired: approvalRequired,
+			ApproversFilter:  approversFilter,
+		})
+	}
+
+	cases := []struct {
+		name    string
+		token   *authtoken.AuthToken
+		session *session.Session
+		id      string
+		err     error
+	}{
+		{
+			name:    "approve",
+			token:   approverToken,
+			session: newSession(true, ""),
+		},
+		{
+			name:    "approve-matching-approvers-filter",
+			token:   approverToken,
+			session: newSession(true, fmt.Sprintf(`"/user/id" == %q`, approverToken.GetIamUserId())),
+		},
+		{
+			name:    "approvers-filter-not-matching",
+			token:   approverToken,
+			session: newSession(true, fmt.Sprintf(`"/user/id" == %q`, unprivToken.GetIamUserId())),
+			err:     handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:    "approvers-filter-missing-field",
+			token:   approverToken,
+			session: newSession(true, `"/user/department" == "security"`),
+			err:     handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:    "approve-own-session",
+			token:   userToken,
+			session: newSession(true, ""),
+			err:     handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:    "unauthorized",
+			token:   unprivToken,
+			session: newSession(true, ""),
+			err:     handlers.ApiErrorWithCode(codes.PermissionDenied),
+		},
+		{
+			name:    "not-awaiting-approval",
+			token:   approverToken,
+			session: newSession(false, ""),
+			err:     handlers.ApiErrorWithCode(codes.FailedPrecondition),
+		},
+		{
+			name:  "not-found",
+			token: approverToken,
+			id:    globals.SessionPrefix + "_DoesntExis",
+			err:   handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name:  "wrong-id-prefix",
+			token: approverToken,
+			id:    "j_1234567890",
+			err:   handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s, err := sessions.NewService(ctx, sessRepoFn, iamRepoFn, 1000)
+			require.NoError(err, "Couldn't create new session service.")
+
+			req := &pbs.ApproveSessionRequest{Id: tc.id, Version: 1}
+			if tc.session != nil {
+				req.Id = tc.session.GetPublicId()
+				req.Version = tc.session.Version
+			}
+			requestInfo := authpb.RequestInfo{
+				TokenFormat: uint32(auth.AuthTokenTypeBearer),
+				PublicId:    tc.token.GetPublicId(),
+				Token:       tc.token.GetToken(),
+			}
+			requestContext := context.WithValue(context.Background(), requests.ContextRequestInformationKey, &requests.RequestContext{})
+			ctx := auth.NewVerifierContext(requestContext, iamRepoFn, tokenRepoFn, serversRepoFn, kms, &requestInfo)
+			got, err := s.ApproveSession(ctx, req)
+			if tc.err != nil {
+				require.Error(err)
+				assert.True(errors.Is(err, tc.err), "ApproveSession(%+v) got error %v, wanted %v", req, err, tc.err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(req.GetId(), got.GetItem().GetId())
+			assert.Equal(session.StatusPending.String(), got.GetItem().GetStatus())
+
+			repo, err := sessRepoFn()
+			require.NoError(err)
+			found, _, err := repo.LookupSession(ctx, req.GetId())
+			require.NoError(err)
+			assert.Equal(tc.token.GetIamUserId(), found.ApproverId)
+		})
+	}
+}
@@ -5,17 +5,42 @@ package storage_buckets
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
+	"github.com/hashicorp/boundary/internal/daemon/controller/common/scopeids"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/errors"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
-	"github.com/hashicorp/boundary/internal/globals"
+	intglobals "github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/pagination"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
+	"github.com/hashicorp/boundary/internal/requests"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/storage/plugin/store"
 	"github.com/hashicorp/boundary/internal/types/action"
 	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/internal/types/subtypes"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	"github.com/hashicorp/go-bexpr"
+	"github.com/mr-tron/base58"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 var (
+	maskManager handlers.MaskManager
+
 	// IdActions contains the set of actions that can be performed on
 	// individual resources
 	IdActions = action.NewActionSet(
@@ -34,48 +59,751 @@ var (
 )
 
 func init() {
+	var err error
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&store.StorageBucket{}},
+		handlers.MaskSource{&pb.StorageBucket{}},
+	); err != nil {
+		panic(err)
+	}
+
 	// TODO: refactor to remove IdActions and CollectionActions package variables
 	action.RegisterResource(resource.StorageBucket, IdActions, CollectionActions)
 }
 
-// NewServiceFn returns a storage bucket service which is not implemented in OSS
+// NewServiceFn returns a storage bucket service. In OSS only storage buckets
+// of the built-in filesystem storage plugin are supported.
 var NewServiceFn = func(ctx context.Context,
 	pluginStorageRepoFn common.PluginStorageBucketRepoFactory,
 	iamRepoFn common.IamRepoFactory,
 	pluginRepoFn common.PluginRepoFactory,
 	maxPageSize uint,
-	controllerExt globals.ControllerExtension,
+	controllerExt intglobals.ControllerExtension,
 ) (pbs.StorageBucketServiceServer, error) {
-	return Service{}, nil
+	return NewService(ctx, pluginStorageRepoFn, iamRepoFn, pluginRepoFn, maxPageSize)
 }
 
 type Service struct {
-	pbs.UnimplementedStorageBucketServiceServer
+	pbs.UnsafeStorageBucketServiceServer
+
+	pluginStorageRepoFn common.PluginStorageBucketRepoFactory
+	iamRepoFn           common.IamRepoFactory
+	pluginRepoFn        common.PluginRepoFactory
+	maxPageSize         uint
 }
 
 var _ pbs.StorageBucketServiceServer = (*Service)(nil)
 
+// NewService returns a storage bucket Service which handles storage bucket
+// related requests to boundary and uses the provided repositories for storage
+// and retrieval.
+func NewService(
+	ctx context.Context,
+	pluginStorageRepoFn common.PluginStorageBucketRepoFactory,
+	iamRepoFn common.IamRepoFactory,
+	pluginRepoFn common.PluginRepoFactory,
+	maxPageSize uint,
+) (Service, error) {
+	const op = "storage_buckets.NewService"
+	if pluginStorageRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin storage bucket repository")
+	}
+	if iamRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing iam repository")
+	}
+	if pluginRepoFn == nil {
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing plugin repository")
+	}
+	if maxPageSize == 0 {
+		maxPageSize = uint(globals.DefaultMaxPageSize)
+	}
+	return Service{
+		pluginStorageRepoFn: pluginStorageRepoFn,
+		iamRepoFn:           iamRepoFn,
+		pluginRepoFn:        pluginRepoFn,
+		maxPageSize:         maxPageSize,
+	}, nil
+}
+
 // ListStorageBuckets implements the interface pbs.StorageBucketServiceServer.
 func (s Service) ListStorageBuckets(ctx context.Context, req *pbs.ListStorageBucketsRequest) (*pbs.ListStorageBucketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
+	const op = "storage_buckets.(Service).ListStorageBuckets"
+	if err := validateListRequest(ctx, req); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	authResults := s.authResult(ctx, req.GetScopeId(), action.List)
+	if authResults.Error != nil {
+		// If it's forbidden, and it's a recursive request, and they're
+		// successfully authenticated but just not authorized, keep going as we
+		// may have authorization on downstream scopes. Or, if they've not
+		// authenticated, still process in case u_anon has permissions.
+		if (authResults.Error == handlers.ForbiddenError() || authResults.Error == handlers.UnauthenticatedError()) &&
+			req.GetRecursive() &&
+			authResults.AuthenticationFinished {
+		} else {
+			return nil, authResults.Error
+		}
+	}
+
+	scopeIds, scopeInfoMap, err := scopeids.GetListingScopeIds(
+		ctx, s.iamRepoFn, authResults, req.GetScopeId(), resource.StorageBucket, req.GetRecursive())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	// If no scopes match, return an empty response
+	if len(scopeIds) == 0 {
+		return &pbs.ListStorageBucketsResponse{}, nil
+	}
+	pageSize := int(s.maxPageSize)
+	// Use the requested page size only if it is smaller than
+	// the configured max.
+	if req.GetPageSize() != 0 && uint(req.GetPageSize()) < s.maxPageSize {
+		pageSize = int(req.GetPageSize())
+	}
+
+	var filterItemFn func(ctx context.Context, item *pluginstorage.StorageBucket, plgs map[string]*plugin.Plugin) (bool, error)
+	switch {
+	case req.GetFilter() != "":
+		// Only use a filter if we need to
+		filter, err := handlers.NewFilter(ctx, req.GetFilter())
+		if err != nil {
+			return nil, err
+		}
+		filterItemFn = func(ctx context.Context, item *pluginstorage.StorageBucket, plgs map[string]*plugin.Plugin) (bool, error) {
+			outputOpts, ok, err := newOutputOpts(ctx, item, authResults, scopeInfoMap, plgs)
+			if err != nil {
+				return false, err
+			}
+			if !ok {
+				return false, nil
+			}
+			pbItem, err := toProto(ctx, item, outputOpts...)
+			if err != nil {
+				return false, err
+			}
+
+			// This comes last so that we can use item fields in the filter after
+			// the allowed fields are populated above
+			filterable, err := subtypes.Filterable(ctx, pbItem)
+			if err != nil {
+				return false, err
+			}
+			return filter.Match(filterable), nil
+		}
+	default:
+		filterItemFn = func(ctx context.Context, item *pluginstorage.StorageBucket, plgs map[string]*plugin.Plugin) (bool, error) {
+			return true, nil
+		}
+	}
+	repo, err := s.pluginStorageRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	grantsHash, err := authResults.GrantsHash(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var listResp *pagination.ListResponse[*pluginstorage.StorageBucket]
+	var plgs map[string]*plugin.Plugin
+	var sortBy string
+	if req.GetListToken() == "" {
+		sortBy = "created_time"
+		listResp, plgs, err = pluginstorage.ListStorageBuckets(ctx, grantsHash, pageSize, filterItemFn, repo, scopeIds)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		listToken, err := handlers.ParseListToken(ctx, req.GetListToken(), resource.StorageBucket, grantsHash)
+		if err != nil {
+			return nil, err
+		}
+		switch st := listToken.Subtype.(type) {
+		case *listtoken.PaginationToken:
+			sortBy = "created_time"
+			listResp, plgs, err = pluginstorage.ListStorageBucketsPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, scopeIds)
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.StartRefreshToken:
+			sortBy = "updated_time"
+			listResp, plgs, err = pluginstorage.ListStorageBucketsRefresh(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, scopeIds)
+			if err != nil {
+				return nil, err
+			}
+		case *listtoken.RefreshToken:
+			sortBy = "updated_time"
+			listResp, plgs, err = pluginstorage.ListStorageBucketsRefreshPage(ctx, grantsHash, pageSize, filterItemFn, listToken, repo, scopeIds)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, handlers.ApiErrorWithCodeAndMessage(codes.InvalidArgument, "unexpected list token subtype: %T", st)
+		}
+	}
+
+	finalItems := make([]*pb.StorageBucket, 0, len(listResp.Items))
+	for _, item := range listResp.Items {
+		outputOpts, ok, err := newOutputOpts(ctx, item, authResults, scopeInfoMap, plgs)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if !ok {
+			continue
+		}
+		item, err := toProto(ctx, item, outputOpts...)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		finalItems = append(finalItems, item)
+	}
+	respType := "delta"
+	if listResp.CompleteListing {
+		respType = "complete"
+	}
+	resp := &pbs.ListStorageBucketsResponse{
+		Items:        finalItems,
+		EstItemCount: uint32(listResp.EstimatedItemCount),
+		RemovedIds:   listResp.DeletedIds,
+		ResponseType: respType,
+		SortBy:       sortBy,
+		SortDir:      "desc",
+	}
+
+	if listResp.ListToken != nil {
+		resp.ListToken, err = handlers.MarshalListToken(ctx, listResp.ListToken, pbs.ResourceType_RESOURCE_TYPE_STORAGE_BUCKET)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
 }
 
 // GetStorageBucket implements the interface pbs.StorageBucketServiceServer.
 func (s Service) GetStorageBucket(ctx context.Context, req *pbs.GetStorageBucketRequest) (*pbs.GetStorageBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
+	const op = "storage_buckets.(Service).GetStorageBucket"
+
+	if err := validateGetRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Read)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sb, plg, err := s.getFromRepo(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := s.outputOpts(ctx, sb.GetPublicId(), authResults, plg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, sb, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.GetStorageBucketResponse{Item: item}, nil
 }
 
 // CreateStorageBucket implements the interface pbs.StorageBucketServiceServer.
 func (s Service) CreateStorageBucket(ctx context.Context, req *pbs.CreateStorageBucketRequest) (*pbs.CreateStorageBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
+	const op = "storage_buckets.(Service).CreateStorageBucket"
+
+	if err := validateCreateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetItem().GetScopeId(), action.Create)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sb, plg, err := s.createInRepo(ctx, authResults.Scope.GetId(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := s.outputOpts(ctx, sb.GetPublicId(), authResults, plg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, sb, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.CreateStorageBucketResponse{
+		Item: item,
+		Uri:  fmt.Sprintf("storage-buckets/%s", item.GetId()),
+	}, nil
 }
 
 // UpdateStorageBucket implements the interface pbs.StorageBucketServiceServer.
 func (s Service) UpdateStorageBucket(ctx context.Context, req *pbs.UpdateStorageBucketRequest) (*pbs.UpdateStorageBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
+	const op = "storage_buckets.(Service).UpdateStorageBucket"
+
+	if err := validateUpdateRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Update)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	sb, plg, err := s.updateInRepo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	outputOpts, err := s.outputOpts(ctx, sb.GetPublicId(), authResults, plg)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	item, err := toProto(ctx, sb, outputOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pbs.UpdateStorageBucketResponse{Item: item}, nil
 }
 
 // DeleteStorageBucket implements the interface pbs.StorageBucketServiceServer.
 func (s Service) DeleteStorageBucket(ctx context.Context, req *pbs.DeleteStorageBucketRequest) (*pbs.DeleteStorageBucketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "storage buckets are an Enterprise-only feature")
+	if err := validateDeleteRequest(req); err != nil {
+		return nil, err
+	}
+	authResults := s.authResult(ctx, req.GetId(), action.Delete)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+	if _, err := s.deleteFromRepo(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+func (s Service) outputOpts(ctx context.Context, id string, authResults auth.VerifyResults, plg *plugins.PluginInfo) ([]handlers.Option, error) {
+	const op = "storage_buckets.(Service).outputOpts"
+	outputFields, ok := requests.OutputFields(ctx)
+	if !ok {
+		return nil, errors.New(ctx, errors.Internal, op, "no request context found")
+	}
+
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(authResults.Scope))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authResults.FetchActionSetForId(ctx, id, IdActions).Strings()))
+	}
+	if plg != nil {
+		outputOpts = append(outputOpts, handlers.WithPlugin(plg))
+	}
+	return outputOpts, nil
+}
+
+func (s Service) getFromRepo(ctx context.Context, id string) (*pluginstorage.StorageBucket, *plugins.PluginInfo, error) {
+	repo, err := s.pluginStorageRepoFn()
+	if err != nil {
+		return nil, nil, err
+	}
+	sb, plg, err := repo.LookupStorageBucket(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sb == nil {
+		return nil, nil, handlers.NotFoundErrorf("Storage Bucket %q doesn't exist.", id)
+	}
+	return sb, toPluginInfo(plg), nil
+}
+
+// lookupPlugin returns the storage plugin named by the create request. Only
+// the built-in filesystem storage plugin is supported.
+func (s Service) lookupPlugin(ctx context.Context, req *pbs.CreateStorageBucketRequest) (*plugin.Plugin, error) {
+	const op = "storage_buckets.(Service).lookupPlugin"
+	plgRepo, err := s.pluginRepoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var plg *plugin.Plugin
+	badField := globals.PluginIdField
+	if pluginId := req.GetItem().GetPluginId(); pluginId != "" {
+		plg, err = plgRepo.LookupPlugin(ctx, pluginId)
+	} else {
+		badField = globals.PluginNameField
+		plg, err = plgRepo.LookupPluginByName(ctx, req.GetPluginName())
+	}
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch {
+	case plg == nil:
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{badField: "Plugin not found."})
+	case plg.GetName() != filesystem.PluginName:
+		return nil, handlers.InvalidArgumentErrorf("Error in provided request.", map[string]string{badField: fmt.Sprintf("Only the %s storage plugin is supported.", filesystem.PluginName)})
+	}
+	return plg, nil
+}
+
+func (s Service) createInRepo(ctx context.Context, scopeId string, req *pbs.CreateStorageBucketRequest) (*pluginstorage.StorageBucket, *plugins.PluginInfo, error) {
+	const op = "storage_buckets.(Service).createInRepo"
+	plg, err := s.lookupPlugin(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	sb, err := toStorageStorageBucket(ctx, scopeId, plg.GetPublicId(), req.GetItem())
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build storage bucket for creation"))
+	}
+	repo, err := s.pluginStorageRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	out, outPlg, err := repo.CreateStorageBucket(ctx, sb)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create storage bucket"))
+	}
+	if out == nil {
+		return nil, nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "Unable to create storage bucket but no error returned from repository.")
+	}
+	return out, toPluginInfo(outPlg), nil
+}
+
+func (s Service) updateInRepo(ctx context.Context, req *pbs.UpdateStorageBucketRequest) (*pluginstorage.StorageBucket, *plugins.PluginInfo, error) {
+	const op = "storage_buckets.(Service).updateInRepo"
+	item := req.GetItem()
+	sb, err := toStorageStorageBucket(ctx, "", "", item)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build storage bucket for update"))
+	}
+	sb.PublicId = req.GetId()
+	dbMask := maskManager.Translate(req.GetUpdateMask().GetPaths(), "attributes")
+	if len(dbMask) == 0 {
+		return nil, nil, handlers.InvalidArgumentErrorf("No valid fields included in the update mask.", map[string]string{"update_mask": "No valid fields provided in the update mask."})
+	}
+	repo, err := s.pluginStorageRepoFn()
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	out, plg, rowsUpdated, err := repo.UpdateStorageBucket(ctx, sb, item.GetVersion(), dbMask)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to update storage bucket"))
+	}
+	if rowsUpdated == 0 {
+		return nil, nil, handlers.NotFoundErrorf("Storage Bucket %q doesn't exist or incorrect version provided.", req.GetId())
+	}
+	return out, toPluginInfo(plg), nil
+}
+
+func (s Service) deleteFromRepo(ctx context.Context, id string) (bool, error) {
+	const op = "storage_buckets.(Service).deleteFromRepo"
+	repo, err := s.pluginStorageRepoFn()
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op)
+	}
+	rows, err := repo.DeleteStorageBucket(ctx, id)
+	if err != nil {
+		return false, errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete storage bucket"))
+	}
+	return rows > 0, nil
+}
+
+func (s Service) authResult(ctx context.Context, id string, a action.Type) auth.VerifyResults {
+	res := auth.VerifyResults{}
+
+	var parentId string
+	opts := []auth.Option{auth.WithType(resource.StorageBucket), auth.WithAction(a)}
+	switch a {
+	case action.List, action.Create:
+		parentId = id
+		iamRepo, err := s.iamRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		scp, err := iamRepo.LookupScope(ctx, parentId)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if scp == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+	default:
+		repo, err := s.pluginStorageRepoFn()
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		sb, _, err := repo.LookupStorageBucket(ctx, id)
+		if err != nil {
+			res.Error = err
+			return res
+		}
+		if sb == nil {
+			res.Error = handlers.NotFoundError()
+			return res
+		}
+		parentId = sb.GetScopeId()
+		opts = append(opts, auth.WithId(id))
+	}
+	opts = append(opts, auth.WithScopeId(parentId))
+	return auth.Verify(ctx, opts...)
+}
+
+func toPluginInfo(plg *plugin.Plugin) *plugins.PluginInfo {
+	if plg == nil {
+		return nil
+	}
+	return &plugins.PluginInfo{
+		Id:          plg.GetPublicId(),
+		Name:        plg.GetName(),
+		Description: plg.GetDescription(),
+	}
+}
+
+func newOutputOpts(
+	ctx context.Context,
+	item *pluginstorage.StorageBucket,
+	authResults auth.VerifyResults,
+	scopeInfoMap map[string]*scopes.ScopeInfo,
+	pluginMap map[string]*plugin.Plugin,
+) ([]handlers.Option, bool, error) {
+	res := perms.Resource{
+		Type:    resource.StorageBucket,
+		Id:      item.GetPublicId(),
+		ScopeId: item.GetScopeId(),
+	}
+	authorizedActions := authResults.FetchActionSetForId(ctx, item.GetPublicId(), IdActions, auth.WithResource(&res)).Strings()
+	if len(authorizedActions) == 0 {
+		return nil, false, nil
+	}
+
+	outputFields := authResults.FetchOutputFields(res, action.List).SelfOrDefaults(authResults.UserId)
+	outputOpts := make([]handlers.Option, 0, 4)
+	outputOpts = append(outputOpts, handlers.WithOutputFields(outputFields))
+	if outputFields.Has(globals.ScopeField) {
+		outputOpts = append(outputOpts, handlers.WithScope(scopeInfoMap[item.GetScopeId()]))
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		outputOpts = append(outputOpts, handlers.WithAuthorizedActions(authorizedActions))
+	}
+	if plg, ok := pluginMap[item.GetPluginId()]; ok {
+		outputOpts = append(outputOpts, handlers.WithPlugin(toPluginInfo(plg)))
+	}
+	return outputOpts, true, nil
+}
+
+func toProto(ctx context.Context, in *pluginstorage.StorageBucket, opt ...handlers.Option) (*pb.StorageBucket, error) {
+	const op = "storage_bucket_service.toProto"
+	opts := handlers.GetOpts(opt...)
+	if opts.WithOutputFields == nil {
+		return nil, handlers.ApiErrorWithCodeAndMessage(codes.Internal, "output fields not found when building storage bucket proto")
+	}
+	outputFields := *opts.WithOutputFields
+
+	out := pb.StorageBucket{}
+	if outputFields.Has(globals.IdField) {
+		out.Id = in.GetPublicId()
+	}
+	if outputFields.Has(globals.ScopeIdField) {
+		out.ScopeId = in.GetScopeId()
+	}
+	if outputFields.Has(globals.TypeField) {
+		out.Type = pluginstorage.Subtype.String()
+	}
+	if outputFields.Has(globals.DescriptionField) && in.GetDescription() != "" {
+		out.Description = wrapperspb.String(in.GetDescription())
+	}
+	if outputFields.Has(globals.NameField) && in.GetName() != "" {
+		out.Name = wrapperspb.String(in.GetName())
+	}
+	if outputFields.Has(globals.BucketNameField) {
+		out.BucketName = in.GetBucketName()
+	}
+	if outputFields.Has(globals.BucketPrefixField) {
+		out.BucketPrefix = in.GetBucketPrefix()
+	}
+	if outputFields.Has(globals.CreatedTimeField) {
+		out.CreatedTime = in.GetCreateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.UpdatedTimeField) {
+		out.UpdatedTime = in.GetUpdateTime().GetTimestamp()
+	}
+	if outputFields.Has(globals.VersionField) {
+		out.Version = in.GetVersion()
+	}
+	if outputFields.Has(globals.ScopeField) {
+		out.Scope = opts.WithScope
+	}
+	if outputFields.Has(globals.PluginIdField) {
+		out.PluginId = in.GetPluginId()
+	}
+	if outputFields.Has(globals.PluginField) {
+		out.Plugin = opts.WithPlugin
+	}
+	if outputFields.Has(globals.WorkerFilterField) {
+		out.WorkerFilter = in.GetWorkerFilter()
+	}
+	if outputFields.Has(globals.SecretsHmacField) && len(in.GetSecretsHmac()) > 0 {
+		out.SecretsHmac = base58.Encode(in.GetSecretsHmac())
+	}
+	if outputFields.Has(globals.AuthorizedActionsField) {
+		out.AuthorizedActions = opts.WithAuthorizedActions
+	}
+	if outputFields.Has(globals.AttributesField) {
+		attrs := &structpb.Struct{}
+		if err := proto.Unmarshal(in.GetAttributes(), attrs); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		if len(attrs.GetFields()) > 0 {
+			out.Attributes = attrs
+		}
+	}
+	return &out, nil
+}
+
+func toStorageStorageBucket(ctx context.Context, scopeId, plgId string, item *pb.StorageBucket) (*pluginstorage.StorageBucket, error) {
+	const op = "storage_bucket_service.toStorageStorageBucket"
+	var opts []pluginstorage.Option
+	if name := item.GetName(); name != nil {
+		opts = append(opts, pluginstorage.WithName(name.GetValue()))
+	}
+	if desc := item.GetDescription(); desc != nil {
+		opts = append(opts, pluginstorage.WithDescription(desc.GetValue()))
+	}
+	if attrs := item.GetAttributes(); attrs != nil {
+		opts = append(opts, pluginstorage.WithAttributes(attrs))
+	}
+	if prefix := item.GetBucketPrefix(); prefix != "" {
+		opts = append(opts, pluginstorage.WithBucketPrefix(prefix))
+	}
+	if workerFilter := item.GetWorkerFilter(); workerFilter != "" {
+		opts = append(opts, pluginstorage.WithWorkerFilter(workerFilter))
+	}
+	sb, err := pluginstorage.NewStorageBucket(ctx, scopeId, plgId, item.GetBucketName(), opts...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to build storage bucket"))
+	}
+	return sb, nil
+}
+
+// validScopeId reports whether id is the global scope or an org scope, the
+// scopes which can contain storage buckets.
+func validScopeId(id string) bool {
+	return id == scope.Global.String() || handlers.ValidId(handlers.Id(id), scope.Org.Prefix())
+}
+
+// A validateX method should exist for each method above.  These methods do not make calls to any backing service but enforce
+// requirements on the structure of the request.  They verify that:
+//   - The path passed in is correctly formatted
+//   - All required parameters are set
+//   - There are no conflicting parameters provided
+//   - The type asserted by the ID and/or field is known
+//   - If relevant, the type derived from the id prefix matches what is claimed by the type field
+func validateGetRequest(req *pbs.GetStorageBucketRequest) error {
+	return handlers.ValidateGetRequest(handlers.NoopValidatorFn, req, globals.PluginStorageBucketPrefix)
+}
+
+func validateCreateRequest(req *pbs.CreateStorageBucketRequest) error {
+	return handlers.ValidateCreateRequest(req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		item := req.GetItem()
+		if !validScopeId(item.GetScopeId()) {
+			badFields[globals.ScopeIdField] = "This field must be 'global' or a valid org scope id."
+		}
+		if item.GetType() != "" && item.GetType() != pluginstorage.Subtype.String() {
+			badFields[globals.TypeField] = fmt.Sprintf("If set, this must be %q.", pluginstorage.Subtype.String())
+		}
+		if item.GetPlugin() != nil {
+			badFields[globals.PluginField] = "This is a read only field."
+		}
+		if item.GetPluginId() == "" && req.GetPluginName() == "" {
+			badFields[globals.PluginIdField] = "This or plugin name is a required field."
+			badFields[globals.PluginNameField] = "This or plugin id is a required field."
+		}
+		if item.GetPluginId() != "" && req.GetPluginName() != "" {
+			badFields[globals.PluginIdField] = "Can't set the plugin name field along with this field."
+			badFields[globals.PluginNameField] = "Can't set the plugin id field along with this field."
+		}
+		if item.GetBucketName() == "" {
+			badFields[globals.BucketNameField] = "This is a required field."
+		}
+		if item.GetWorkerFilter() == "" {
+			badFields[globals.WorkerFilterField] = "This is a required field."
+		} else if _, err := bexpr.CreateEvaluator(item.GetWorkerFilter()); err != nil {
+			badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
+		}
+		if len(item.GetSecrets().GetFields()) > 0 {
+			badFields[globals.SecretsField] = fmt.Sprintf("Secrets are not supported by the %s storage plugin.", filesystem.PluginName)
+		}
+		if item.GetSecretsHmac() != "" {
+			badFields[globals.SecretsHmacField] = "This is a read only field."
+		}
+		if item.GetStorageBucketCredentialId() != "" {
+			badFields["storage_bucket_credential_id"] = "This is a read only field."
+		}
+		return badFields
+	})
+}
+
+func validateUpdateRequest(req *pbs.UpdateStorageBucketRequest) error {
+	return handlers.ValidateUpdateRequest(req, req.GetItem(), func() map[string]string {
+		badFields := map[string]string{}
+		item := req.GetItem()
+		paths := req.GetUpdateMask().GetPaths()
+		if item.GetType() != "" && item.GetType() != pluginstorage.Subtype.String() {
+			badFields[globals.TypeField] = "Cannot modify resource type."
+		}
+		if item.GetPlugin() != nil {
+			badFields[globals.PluginField] = "This is a read only field."
+		}
+		if item.GetPluginId() != "" {
+			badFields[globals.PluginIdField] = "This is a read only field."
+		}
+		if item.GetBucketName() != "" || handlers.MaskContains(paths, globals.BucketNameField) {
+			badFields[globals.BucketNameField] = "This field cannot be updated."
+		}
+		if item.GetBucketPrefix() != "" || handlers.MaskContains(paths, globals.BucketPrefixField) {
+			badFields[globals.BucketPrefixField] = "This field cannot be updated."
+		}
+		if handlers.MaskContains(paths, globals.WorkerFilterField) {
+			if item.GetWorkerFilter() == "" {
+				badFields[globals.WorkerFilterField] = "This field cannot be empty."
+			} else if _, err := bexpr.CreateEvaluator(item.GetWorkerFilter()); err != nil {
+				badFields[globals.WorkerFilterField] = "Unable to successfully parse filter expression."
+			}
+		}
+		if len(item.GetSecrets().GetFields()) > 0 || handlers.MaskContainsPrefix(paths, globals.SecretsField) {
+			badFields[globals.SecretsField] = fmt.Sprintf("Secrets are not supported by the %s storage plugin.", filesystem.PluginName)
+		}
+		if item.GetSecretsHmac() != "" {
+			badFields[globals.SecretsHmacField] = "This is a read only field."
+		}
+		if item.GetStorageBucketCredentialId() != "" {
+			badFields["storage_bucket_credential_id"] = "This is a read only field."
+		}
+		return badFields
+	}, globals.PluginStorageBucketPrefix)
+}
+
+func validateDeleteRequest(req *pbs.DeleteStorageBucketRequest) error {
+	return handlers.ValidateDeleteRequest(handlers.NoopValidatorFn, req, globals.PluginStorageBucketPrefix)
+}
+
+func validateListRequest(ctx context.Context, req *pbs.ListStorageBucketsRequest) error {
+	badFields := map[string]string{}
+	if !validScopeId(req.GetScopeId()) && !req.GetRecursive() {
+		badFields[globals.ScopeIdField] = "This field must be 'global' or a valid org scope id or the list operation must be recursive."
+	}
+	if _, err := handlers.NewFilter(ctx, req.GetFilter()); err != nil {
+		badFields[globals.FilterField] = fmt.Sprintf("This field could not be parsed. %v", err)
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Improperly formatted identifier.", badFields)
+	}
+	return nil
 }
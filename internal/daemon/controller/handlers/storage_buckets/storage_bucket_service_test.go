@@ -5,58 +5,329 @@ package storage_buckets
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
 	"testing"
 
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/db"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/api/services"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	pluginstorage "github.com/hashicorp/boundary/internal/storage/plugin"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
-func TestService(t *testing.T) {
+type testEnv struct {
+	s         Service
+	iamRepoFn func() (*iam.Repository, error)
+	fsPlg     *plugin.Plugin
+	otherPlg  *plugin.Plugin
+	rootDir   string
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	sche := scheduler.TestScheduler(t, conn, wrapper)
+	rw := db.New(conn)
+
+	rootDir := t.TempDir()
+	fsPlg := plugin.TestPlugin(t, conn, filesystem.PluginName, plugin.WithHostFlag(false), plugin.WithStorageFlag(true))
+	otherPlg := plugin.TestPlugin(t, conn, "other", plugin.WithHostFlag(false), plugin.WithStorageFlag(true))
+	plgm := map[string]plgpb.StoragePluginServiceClient{
+		fsPlg.GetPublicId(): loopback.NewWrappingPluginStorageClient(filesystem.NewFilesystemPlugin(rootDir)),
+	}
+
+	storageRepoFn := func() (*pluginstorage.Repository, error) {
+		return pluginstorage.NewRepository(ctx, rw, rw, kmsCache, sche, plgm)
+	}
+	pluginRepoFn := func() (*plugin.Repository, error) {
+		return plugin.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	iamRepoFn := func() (*iam.Repository, error) {
+		return iam.TestRepo(t, conn, wrapper), nil
+	}
+	s, err := NewService(ctx, storageRepoFn, iamRepoFn, pluginRepoFn, 1000)
+	require.NoError(t, err)
+	return &testEnv{
+		s:         s,
+		iamRepoFn: iamRepoFn,
+		fsPlg:     fsPlg,
+		otherPlg:  otherPlg,
+		rootDir:   rootDir,
+	}
+}
+
+func TestNewService(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
+	storageRepoFn := func() (*pluginstorage.Repository, error) { return nil, nil }
+	iamRepoFn := func() (*iam.Repository, error) { return nil, nil }
+	pluginRepoFn := func() (*plugin.Repository, error) { return nil, nil }
+
+	_, err := NewService(ctx, nil, iamRepoFn, pluginRepoFn, 1000)
+	assert.Error(t, err)
+	_, err = NewService(ctx, storageRepoFn, nil, pluginRepoFn, 1000)
+	assert.Error(t, err)
+	_, err = NewService(ctx, storageRepoFn, iamRepoFn, nil, 1000)
+	assert.Error(t, err)
+	s, err := NewService(ctx, storageRepoFn, iamRepoFn, pluginRepoFn, 0)
+	require.NoError(t, err)
+	assert.Equal(t, uint(globals.DefaultMaxPageSize), s.maxPageSize)
+}
+
+func TestLifecycle(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
-	s := Service{}
-
-	gotCreate, err := s.CreateStorageBucket(ctx, &pbs.CreateStorageBucketRequest{})
-	require.Nil(gotCreate)
-	require.Error(err)
-	gotStatus, ok := status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "storage buckets are an Enterprise-only feature")
-
-	gotUpdate, err := s.UpdateStorageBucket(ctx, &pbs.UpdateStorageBucketRequest{})
-	require.Nil(gotUpdate)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "storage buckets are an Enterprise-only feature")
-
-	gotGet, err := s.GetStorageBucket(ctx, &pbs.GetStorageBucketRequest{})
-	require.Nil(gotGet)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "storage buckets are an Enterprise-only feature")
-
-	gotDelete, err := s.DeleteStorageBucket(ctx, &pbs.DeleteStorageBucketRequest{})
-	require.Nil(gotDelete)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "storage buckets are an Enterprise-only feature")
-
-	got, err := s.ListStorageBuckets(ctx, &pbs.ListStorageBucketsRequest{})
-	require.Nil(got)
-	require.Error(err)
-	gotStatus, ok = status.FromError(err)
-	require.True(ok)
-	assert.Equal(gotStatus.Code(), codes.Unimplemented)
-	assert.Equal(gotStatus.Message(), "storage buckets are an Enterprise-only feature")
+	env := newTestEnv(t)
+	ctx := auth.DisabledAuthTestContext(env.iamRepoFn, scope.Global.String())
+
+	// Create
+	createResp, err := env.s.CreateStorageBucket(ctx, &pbs.CreateStorageBucketRequest{
+		PluginName: filesystem.PluginName,
+		Item: &pb.StorageBucket{
+			ScopeId:      scope.Global.String(),
+			Name:         wrapperspb.String("bucket"),
+			BucketName:   "recordings",
+			BucketPrefix: "prefix/",
+			WorkerFilter: `"test" in "/tags/type"`,
+		},
+	})
+	require.NoError(err)
+	got := createResp.GetItem()
+	assert.Equal("storage-buckets/"+got.GetId(), createResp.GetUri())
+	assert.Equal(scope.Global.String(), got.GetScopeId())
+	assert.Equal(pluginstorage.Subtype.String(), got.GetType())
+	assert.Equal("bucket", got.GetName().GetValue())
+	assert.Equal("recordings", got.GetBucketName())
+	assert.Equal("prefix/", got.GetBucketPrefix())
+	assert.Equal(env.fsPlg.GetPublicId(), got.GetPluginId())
+	assert.Equal(filesystem.PluginName, got.GetPlugin().GetName())
+	assert.Equal(uint32(1), got.GetVersion())
+	assert.NotEmpty(got.GetStorageBucketCredentialId())
+	// The plugin created the bucket directory in OnCreateStorageBucket.
+	assert.DirExists(filepath.Join(env.rootDir, "recordings"))
+
+	// Get
+	getResp, err := env.s.GetStorageBucket(ctx, &pbs.GetStorageBucketRequest{Id: got.GetId()})
+	require.NoError(err)
+	assert.Equal(got.GetId(), getResp.GetItem().GetId())
+	assert.Equal("recordings", getResp.GetItem().GetBucketName())
+
+	// List
+	listResp, err := env.s.ListStorageBuckets(ctx, &pbs.ListStorageBucketsRequest{ScopeId: scope.Global.String()})
+	require.NoError(err)
+	require.Len(listResp.GetItems(), 1)
+	assert.Equal(got.GetId(), listResp.GetItems()[0].GetId())
+	assert.Equal("complete", listResp.GetResponseType())
+	assert.Equal("created_time", listResp.GetSortBy())
+	assert.NotEmpty(listResp.GetListToken())
+
+	// Update
+	updateResp, err := env.s.UpdateStorageBucket(ctx, &pbs.UpdateStorageBucketRequest{
+		Id: got.GetId(),
+		Item: &pb.StorageBucket{
+			Version:     got.GetVersion(),
+			Description: wrapperspb.String("updated"),
+			Attributes: &structpb.Struct{Fields: map[string]*structpb.Value{
+				filesystem.ConstRootPath: structpb.NewStringValue("nested"),
+			}},
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{globals.DescriptionField, "attributes.root_path"}},
+	})
+	require.NoError(err)
+	assert.Equal("updated", updateResp.GetItem().GetDescription().GetValue())
+	assert.Equal("nested", updateResp.GetItem().GetAttributes().GetFields()[filesystem.ConstRootPath].GetStringValue())
+	assert.Equal(uint32(2), updateResp.GetItem().GetVersion())
+	// The plugin created the new bucket directory in OnUpdateStorageBucket.
+	assert.DirExists(filepath.Join(env.rootDir, "nested", "recordings"))
+
+	// The list token picks up the update on refresh.
+	refreshResp, err := env.s.ListStorageBuckets(ctx, &pbs.ListStorageBucketsRequest{
+		ScopeId:   scope.Global.String(),
+		ListToken: listResp.GetListToken(),
+	})
+	require.NoError(err)
+	require.Len(refreshResp.GetItems(), 1)
+	assert.Equal("updated_time", refreshResp.GetSortBy())
+	assert.Equal(uint32(2), refreshResp.GetItems()[0].GetVersion())
+
+	// Delete
+	_, err = env.s.DeleteStorageBucket(ctx, &pbs.DeleteStorageBucketRequest{Id: got.GetId()})
+	require.NoError(err)
+	_, err = env.s.GetStorageBucket(ctx, &pbs.GetStorageBucketRequest{Id: got.GetId()})
+	assert.True(errors.Is(err, handlers.ApiErrorWithCode(codes.NotFound)))
+	// Deleting a storage bucket does not remove the stored recordings.
+	assert.DirExists(filepath.Join(env.rootDir, "nested", "recordings"))
+
+	refreshResp, err = env.s.ListStorageBuckets(ctx, &pbs.ListStorageBucketsRequest{
+		ScopeId:   scope.Global.String(),
+		ListToken: refreshResp.GetListToken(),
+	})
+	require.NoError(err)
+	assert.Empty(refreshResp.GetItems())
+	assert.Equal([]string{got.GetId()}, refreshResp.GetRemovedIds())
+}
+
+func TestCreate_Errors(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := auth.DisabledAuthTestContext(env.iamRepoFn, scope.Global.String())
+
+	validItem := func() *pb.StorageBucket {
+		return &pb.StorageBucket{
+			ScopeId:      scope.Global.String(),
+			BucketName:   "bucket",
+			WorkerFilter: `"test" in "/tags/type"`,
+		}
+	}
+	cases := []struct {
+		name string
+		req  *pbs.CreateStorageBucketRequest
+	}{
+		{
+			name: "unsupported-plugin-name",
+			req:  &pbs.CreateStorageBucketRequest{PluginName: env.otherPlg.GetName(), Item: validItem()},
+		},
+		{
+			name: "unsupported-plugin-id",
+			req: &pbs.CreateStorageBucketRequest{Item: func() *pb.StorageBucket {
+				i := validItem()
+				i.PluginId = env.otherPlg.GetPublicId()
+				return i
+			}()},
+		},
+		{
+			name: "unknown-plugin",
+			req:  &pbs.CreateStorageBucketRequest{PluginName: "unknown", Item: validItem()},
+		},
+		{
+			name: "missing-plugin",
+			req:  &pbs.CreateStorageBucketRequest{Item: validItem()},
+		},
+		{
+			name: "project-scope",
+			req: &pbs.CreateStorageBucketRequest{PluginName: filesystem.PluginName, Item: func() *pb.StorageBucket {
+				i := validItem()
+				i.ScopeId = "p_1234567890"
+				return i
+			}()},
+		},
+		{
+			name: "missing-bucket-name",
+			req: &pbs.CreateStorageBucketRequest{PluginName: filesystem.PluginName, Item: func() *pb.StorageBucket {
+				i := validItem()
+				i.BucketName = ""
+				return i
+			}()},
+		},
+		{
+			name: "missing-worker-filter",
+			req: &pbs.CreateStorageBucketRequest{PluginName: filesystem.PluginName, Item: func() *pb.StorageBucket {
+				i := validItem()
+				i.WorkerFilter = ""
+				return i
+			}()},
+		},
+		{
+			name: "bad-worker-filter",
+			req: &pbs.CreateStorageBucketRequest{PluginName: filesystem.PluginName, Item: func() *pb.StorageBucket {
+				i := validItem()
+				i.WorkerFilter = "bad expression"
+				return i
+			}()},
+		},
+		{
+			name: "secrets",
+			req: &pbs.CreateStorageBucketRequest{PluginName: filesystem.PluginName, Item: func() *pb.StorageBucket {
+				i := validItem()
+				i.Secrets = &structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}}
+				return i
+			}()},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := env.s.CreateStorageBucket(ctx, tc.req)
+			require.Error(t, err)
+			assert.Nil(t, got)
+			assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+		})
+	}
+}
+
+func TestUpdate_Errors(t *testing.T) {
+	env := newTestEnv(t)
+	ctx := auth.DisabledAuthTestContext(env.iamRepoFn, scope.Global.String())
+
+	createResp, err := env.s.CreateStorageBucket(ctx, &pbs.CreateStorageBucketRequest{
+		PluginName: filesystem.PluginName,
+		Item: &pb.StorageBucket{
+			ScopeId:      scope.Global.String(),
+			BucketName:   "bucket",
+			WorkerFilter: `"test" in "/tags/type"`,
+		},
+	})
+	require.NoError(t, err)
+	id := createResp.GetItem().GetId()
+
+	cases := []struct {
+		name string
+		item *pb.StorageBucket
+		path string
+	}{
+		{
+			name: "bucket-name",
+			item: &pb.StorageBucket{Version: 1, BucketName: "other"},
+			path: globals.BucketNameField,
+		},
+		{
+			name: "bucket-prefix",
+			item: &pb.StorageBucket{Version: 1, BucketPrefix: "other"},
+			path: globals.BucketPrefixField,
+		},
+		{
+			name: "empty-worker-filter",
+			item: &pb.StorageBucket{Version: 1},
+			path: globals.WorkerFilterField,
+		},
+		{
+			name: "secrets",
+			item: &pb.StorageBucket{Version: 1, Secrets: &structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}}},
+			path: "secrets.key",
+		},
+		{
+			name: "no-valid-fields",
+			item: &pb.StorageBucket{Version: 1},
+			path: "unknown",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := env.s.UpdateStorageBucket(ctx, &pbs.UpdateStorageBucketRequest{
+				Id:         id,
+				Item:       tc.item,
+				UpdateMask: &field_mask.FieldMask{Paths: []string{tc.path}},
+			})
+			require.Error(t, err)
+			assert.Nil(t, got)
+			assert.True(t, errors.Is(err, handlers.ApiErrorWithCode(codes.InvalidArgument)), "got error %v, wanted invalid argument", err)
+		})
+	}
 }
@@ -114,6 +114,19 @@ func dynamicToSessionCredential(ctx context.Context, cred credential.Dynamic) (*
 				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
 			}
 
+		case credential.SshCertificate:
+			credData, err = handlers.ProtoToStruct(
+				ctx,
+				&pb.SshCertificateCredential{
+					Username:    c.Username(),
+					PrivateKey:  string(c.PrivateKey()),
+					Certificate: string(c.Certificate()),
+				},
+			)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("creating proto struct for credential"))
+			}
+
 		case credential.SshPrivateKey:
 			credData, err = handlers.ProtoToStruct(
 				ctx,
@@ -176,6 +189,15 @@ func staticToWorkerCredential(ctx context.Context, cred credential.Static) (sess
 			},
 		}
 
+	case *credstatic.JsonCredential:
+		workerCred = &serverpb.Credential{
+			Credential: &serverpb.Credential_Json{
+				Json: &serverpb.Json{
+					Object: c.GetObject(),
+				},
+			},
+		}
+
 	default:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", c))
 	}
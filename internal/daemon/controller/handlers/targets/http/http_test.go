@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package http
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/http"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttribute_Vet(t *testing.T) {
+	tests := []struct {
+		name          string
+		attrs         *pb.HttpTargetAttributes
+		wantBadFields []string
+	}{
+		{
+			name:  "empty uses default port",
+			attrs: &pb.HttpTargetAttributes{},
+		},
+		{
+			name:          "zero port",
+			attrs:         &pb.HttpTargetAttributes{DefaultPort: &wrappers.UInt32Value{Value: 0}},
+			wantBadFields: []string{defaultPortField},
+		},
+		{
+			name:          "port too large",
+			attrs:         &pb.HttpTargetAttributes{DefaultPort: &wrappers.UInt32Value{Value: 70000}},
+			wantBadFields: []string{defaultPortField},
+		},
+		{
+			name:          "client port too large",
+			attrs:         &pb.HttpTargetAttributes{DefaultClientPort: &wrappers.UInt32Value{Value: 70000}},
+			wantBadFields: []string{defaultClientPortField},
+		},
+		{
+			name: "valid tls",
+			attrs: &pb.HttpTargetAttributes{
+				Scheme:        &wrappers.StringValue{Value: http.SchemeHttps},
+				TlsCaCert:     &wrappers.StringValue{Value: testCaCert(t)},
+				TlsServerName: &wrappers.StringValue{Value: "api.example.com"},
+			},
+		},
+		{
+			name: "plaintext",
+			attrs: &pb.HttpTargetAttributes{
+				Scheme:                    &wrappers.StringValue{Value: http.SchemeHttp},
+				AllowPlaintextCredentials: &wrappers.BoolValue{Value: true},
+			},
+		},
+		{
+			name:          "invalid scheme",
+			attrs:         &pb.HttpTargetAttributes{Scheme: &wrappers.StringValue{Value: "ftp"}},
+			wantBadFields: []string{schemeField},
+		},
+		{
+			name:          "invalid ca cert",
+			attrs:         &pb.HttpTargetAttributes{TlsCaCert: &wrappers.StringValue{Value: "not a certificate"}},
+			wantBadFields: []string{tlsCaCertField},
+		},
+		{
+			name:          "empty server name",
+			attrs:         &pb.HttpTargetAttributes{TlsServerName: &wrappers.StringValue{Value: " "}},
+			wantBadFields: []string{tlsServerNameField},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAttribute(&pb.Target_HttpTargetAttributes{HttpTargetAttributes: tt.attrs})
+			got := a.Vet()
+			assert.Len(t, got, len(tt.wantBadFields))
+			for _, f := range tt.wantBadFields {
+				assert.Contains(t, got, f)
+			}
+		})
+	}
+}
+
+func TestAttribute_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	a := newAttribute(&pb.Target_HttpTargetAttributes{HttpTargetAttributes: &pb.HttpTargetAttributes{
+		DefaultPort:       &wrappers.UInt32Value{Value: 5433},
+		DefaultClientPort: &wrappers.UInt32Value{Value: 8080},
+	}})
+	tar, err := target.New(ctx, http.Subtype, "p_1234567890", a.Options()...)
+	require.NoError(t, err)
+
+	out := &pb.Target{}
+	require.NoError(t, setAttributes(tar, out))
+	got := out.GetHttpTargetAttributes()
+	require.NotNil(t, got)
+	assert.Equal(t, uint32(5433), got.GetDefaultPort().GetValue())
+	assert.Equal(t, uint32(8080), got.GetDefaultClientPort().GetValue())
+	assert.Equal(t, http.SchemeHttps, got.GetScheme().GetValue())
+	assert.Nil(t, got.GetTlsCaCert())
+	assert.Nil(t, got.GetTlsServerName())
+	assert.False(t, got.GetAllowPlaintextCredentials().GetValue())
+
+	caCert := testCaCert(t)
+	a = newAttribute(&pb.Target_HttpTargetAttributes{HttpTargetAttributes: &pb.HttpTargetAttributes{
+		TlsCaCert:     &wrappers.StringValue{Value: caCert},
+		TlsServerName: &wrappers.StringValue{Value: "api.example.com"},
+	}})
+	tar, err = target.New(ctx, http.Subtype, "p_1234567890", a.Options()...)
+	require.NoError(t, err)
+	out = &pb.Target{}
+	require.NoError(t, setAttributes(tar, out))
+	got = out.GetHttpTargetAttributes()
+	assert.Equal(t, uint32(http.DefaultPort), got.GetDefaultPort().GetValue())
+	assert.Equal(t, caCert, got.GetTlsCaCert().GetValue())
+	assert.Equal(t, "api.example.com", got.GetTlsServerName().GetValue())
+
+	a = newAttribute(&pb.Target_HttpTargetAttributes{HttpTargetAttributes: &pb.HttpTargetAttributes{
+		Scheme:                    &wrappers.StringValue{Value: http.SchemeHttp},
+		AllowPlaintextCredentials: &wrappers.BoolValue{Value: true},
+	}})
+	tar, err = target.New(ctx, http.Subtype, "p_1234567890", a.Options()...)
+	require.NoError(t, err)
+	out = &pb.Target{}
+	require.NoError(t, setAttributes(tar, out))
+	got = out.GetHttpTargetAttributes()
+	assert.Equal(t, uint32(http.DefaultHttpPort), got.GetDefaultPort().GetValue())
+	assert.Equal(t, http.SchemeHttp, got.GetScheme().GetValue())
+	assert.True(t, got.GetAllowPlaintextCredentials().GetValue())
+}
+
+// testCaCert returns a PEM encoded self signed certificate.
+func testCaCert(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttribute_Vet(t *testing.T) {
+	tests := []struct {
+		name          string
+		attrs         *pb.PostgresTargetAttributes
+		wantBadFields []string
+	}{
+		{
+			name:  "empty uses default port",
+			attrs: &pb.PostgresTargetAttributes{},
+		},
+		{
+			name:          "zero port",
+			attrs:         &pb.PostgresTargetAttributes{DefaultPort: &wrappers.UInt32Value{Value: 0}},
+			wantBadFields: []string{defaultPortField},
+		},
+		{
+			name:          "port too large",
+			attrs:         &pb.PostgresTargetAttributes{DefaultPort: &wrappers.UInt32Value{Value: 70000}},
+			wantBadFields: []string{defaultPortField},
+		},
+		{
+			name:          "client port too large",
+			attrs:         &pb.PostgresTargetAttributes{DefaultClientPort: &wrappers.UInt32Value{Value: 70000}},
+			wantBadFields: []string{defaultClientPortField},
+		},
+		{
+			name: "valid tls",
+			attrs: &pb.PostgresTargetAttributes{
+				SslMode:       &wrappers.StringValue{Value: postgres.SslModeVerifyCa},
+				TlsCaCert:     &wrappers.StringValue{Value: testCaCert(t)},
+				TlsServerName: &wrappers.StringValue{Value: "db.example.com"},
+			},
+		},
+		{
+			name:          "unverified ssl mode",
+			attrs:         &pb.PostgresTargetAttributes{SslMode: &wrappers.StringValue{Value: "prefer"}},
+			wantBadFields: []string{sslModeField},
+		},
+		{
+			name:          "invalid ca cert",
+			attrs:         &pb.PostgresTargetAttributes{TlsCaCert: &wrappers.StringValue{Value: "not a certificate"}},
+			wantBadFields: []string{tlsCaCertField},
+		},
+		{
+			name:          "empty server name",
+			attrs:         &pb.PostgresTargetAttributes{TlsServerName: &wrappers.StringValue{Value: " "}},
+			wantBadFields: []string{tlsServerNameField},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAttribute(&pb.Target_PostgresTargetAttributes{PostgresTargetAttributes: tt.attrs})
+			got := a.Vet()
+			assert.Len(t, got, len(tt.wantBadFields))
+			for _, f := range tt.wantBadFields {
+				assert.Contains(t, got, f)
+			}
+		})
+	}
+}
+
+func TestAttribute_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	a := newAttribute(&pb.Target_PostgresTargetAttributes{PostgresTargetAttributes: &pb.PostgresTargetAttributes{
+		DefaultPort:       &wrappers.UInt32Value{Value: 5433},
+		DefaultClientPort: &wrappers.UInt32Value{Value: 15432},
+	}})
+	tar, err := target.New(ctx, postgres.Subtype, "p_1234567890", a.Options()...)
+	require.NoError(t, err)
+
+	out := &pb.Target{}
+	require.NoError(t, setAttributes(tar, out))
+	got := out.GetPostgresTargetAttributes()
+	require.NotNil(t, got)
+	assert.Equal(t, uint32(5433), got.GetDefaultPort().GetValue())
+	assert.Equal(t, uint32(15432), got.GetDefaultClientPort().GetValue())
+	assert.Equal(t, postgres.SslModeVerifyFull, got.GetSslMode().GetValue())
+	assert.Nil(t, got.GetTlsCaCert())
+	assert.Nil(t, got.GetTlsServerName())
+
+	caCert := testCaCert(t)
+	a = newAttribute(&pb.Target_PostgresTargetAttributes{PostgresTargetAttributes: &pb.PostgresTargetAttributes{
+		SslMode:       &wrappers.StringValue{Value: postgres.SslModeVerifyCa},
+		TlsCaCert:     &wrappers.StringValue{Value: caCert},
+		TlsServerName: &wrappers.StringValue{Value: "db.example.com"},
+	}})
+	tar, err = target.New(ctx, postgres.Subtype, "p_1234567890", a.Options()...)
+	require.NoError(t, err)
+	out = &pb.Target{}
+	require.NoError(t, setAttributes(tar, out))
+	got = out.GetPostgresTargetAttributes()
+	assert.Equal(t, postgres.SslModeVerifyCa, got.GetSslMode().GetValue())
+	assert.Equal(t, caCert, got.GetTlsCaCert().GetValue())
+	assert.Equal(t, "db.example.com", got.GetTlsServerName().GetValue())
+}
+
+// testCaCert returns a PEM encoded self signed certificate.
+func testCaCert(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
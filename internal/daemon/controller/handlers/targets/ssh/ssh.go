@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"context"
+	"math"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
+	"github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	sshStore "github.com/hashicorp/boundary/internal/target/ssh/store"
+	"github.com/hashicorp/boundary/internal/target/store"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+)
+
+const (
+	defaultPortField            = "attributes.default_port"
+	defaultClientPortField      = "attributes.default_client_port"
+	storageBucketIdField        = "attributes.storage_bucket_id"
+	enableSessionRecordingField = "attributes.enable_session_recording"
+	knownHostsField             = "attributes.known_hosts"
+)
+
+type attribute struct {
+	*pb.SshTargetAttributes
+}
+
+func (a *attribute) Options() []target.Option {
+	var opts []target.Option
+	if a.GetDefaultPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultPort(a.GetDefaultPort().GetValue()))
+	}
+	if a.GetDefaultClientPort().GetValue() != 0 {
+		opts = append(opts, target.WithDefaultClientPort(a.GetDefaultClientPort().GetValue()))
+	}
+	if a.GetStorageBucketId().GetValue() != "" {
+		opts = append(opts, target.WithStorageBucketId(a.GetStorageBucketId().GetValue()))
+	}
+	if a.GetEnableSessionRecording().GetValue() {
+		opts = append(opts, target.WithEnableSessionRecording(true))
+	}
+	if a.GetKnownHosts().GetValue() != "" {
+		opts = append(opts, target.WithKnownHosts(a.GetKnownHosts().GetValue()))
+	}
+	if a.GetInsecureSkipHostKeyVerification().GetValue() {
+		opts = append(opts, target.WithInsecureSkipHostKeyVerification(true))
+	}
+	return opts
+}
+
+// vetKnownHosts validates the known_hosts attribute if it is set.
+func (a *attribute) vetKnownHosts(badFields map[string]string) {
+	if a.GetKnownHosts() != nil {
+		if err := ssh.ValidateKnownHosts(a.GetKnownHosts().GetValue()); err != nil {
+			badFields[knownHostsField] = "Must contain known_hosts entries."
+		}
+	}
+}
+
+func (a *attribute) Vet() map[string]string {
+	badFields := map[string]string{}
+	if a.GetDefaultPort() != nil {
+		if a.GetDefaultPort().GetValue() == 0 {
+			badFields[defaultPortField] = "This field cannot be set to zero."
+		}
+		if a.GetDefaultPort().GetValue() > math.MaxUint16 {
+			badFields[defaultPortField] = "Value is greater than maximum port number."
+		}
+	}
+	if a.GetDefaultClientPort() != nil {
+		if a.GetDefaultClientPort().GetValue() == 0 {
+			badFields[defaultClientPortField] = "This field cannot be set to zero."
+		}
+		if a.GetDefaultClientPort().GetValue() > math.MaxUint16 {
+			badFields[defaultClientPortField] = "Value is greater than maximum port number."
+		}
+	}
+	if a.GetEnableSessionRecording().GetValue() && a.GetStorageBucketId().GetValue() == "" {
+		badFields[storageBucketIdField] = "This field is required when session recording is enabled."
+	}
+	a.vetKnownHosts(badFields)
+	return badFields
+}
+
+func (a *attribute) VetForUpdate(p []string) map[string]string {
+	badFields := map[string]string{}
+	if handlers.MaskContains(p, defaultPortField) {
+		if a.GetDefaultPort() == nil {
+			badFields[defaultPortField] = "This field is required."
+		} else {
+			if a.GetDefaultPort().GetValue() == 0 {
+				badFields[defaultPortField] = "This cannot be set to zero."
+			}
+			if a.GetDefaultPort().GetValue() > math.MaxUint16 {
+				badFields[defaultPortField] = "Value is greater than maximum port number."
+			}
+		}
+	}
+	if handlers.MaskContains(p, defaultClientPortField) && a.GetDefaultClientPort() != nil {
+		if a.GetDefaultClientPort().GetValue() == 0 {
+			badFields[defaultClientPortField] = "This cannot be set to zero."
+		}
+		if a.GetDefaultClientPort().GetValue() > math.MaxUint16 {
+			badFields[defaultClientPortField] = "Value is greater than maximum port number."
+		}
+	}
+	if handlers.MaskContains(p, enableSessionRecordingField) && handlers.MaskContains(p, storageBucketIdField) &&
+		a.GetEnableSessionRecording().GetValue() && a.GetStorageBucketId().GetValue() == "" {
+		badFields[storageBucketIdField] = "This field is required when session recording is enabled."
+	}
+	a.vetKnownHosts(badFields)
+	return badFields
+}
+
+func newAttribute(m any) targets.Attributes {
+	a := &attribute{
+		&pb.SshTargetAttributes{},
+	}
+	if sshAttr, ok := m.(*pb.Target_SshTargetAttributes); ok {
+		a.SshTargetAttributes = sshAttr.SshTargetAttributes
+	}
+	return a
+}
+
+func setAttributes(t target.Target, out *pb.Target) error {
+	if t == nil {
+		return nil
+	}
+
+	attrs := &pb.Target_SshTargetAttributes{
+		SshTargetAttributes: &pb.SshTargetAttributes{},
+	}
+	if t.GetDefaultPort() > 0 {
+		attrs.SshTargetAttributes.DefaultPort = &wrappers.UInt32Value{Value: t.GetDefaultPort()}
+	}
+	if t.GetDefaultClientPort() > 0 {
+		attrs.SshTargetAttributes.DefaultClientPort = &wrappers.UInt32Value{Value: t.GetDefaultClientPort()}
+	}
+	if t.GetStorageBucketId() != "" {
+		attrs.SshTargetAttributes.StorageBucketId = &wrappers.StringValue{Value: t.GetStorageBucketId()}
+	}
+	attrs.SshTargetAttributes.EnableSessionRecording = &wrappers.BoolValue{Value: t.GetEnableSessionRecording()}
+	if st, ok := t.(*ssh.Target); ok {
+		if st.GetKnownHosts() != "" {
+			attrs.SshTargetAttributes.KnownHosts = &wrappers.StringValue{Value: st.GetKnownHosts()}
+		}
+		attrs.SshTargetAttributes.InsecureSkipHostKeyVerification = &wrappers.BoolValue{Value: st.GetInsecureSkipHostKeyVerification()}
+	}
+
+	out.Attrs = attrs
+	return nil
+}
+
+func noopSessionValidation(context.Context, *session.Session) error { return nil }
+
+func init() {
+	var maskManager handlers.MaskManager
+	var err error
+
+	if maskManager, err = handlers.NewMaskManager(
+		context.Background(),
+		handlers.MaskDestination{&sshStore.Target{}, &store.TargetAddress{}},
+		handlers.MaskSource{&pb.Target{}, &pb.SshTargetAttributes{}},
+	); err != nil {
+		panic(err)
+	}
+
+	targets.Register(ssh.Subtype, maskManager, newAttribute, setAttributes, noopSessionValidation)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	pb "github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/targets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cryptossh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestAttribute_Vet(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := cryptossh.NewPublicKey(pub)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		attrs         *pb.SshTargetAttributes
+		wantBadFields []string
+	}{
+		{
+			name:  "empty uses default port",
+			attrs: &pb.SshTargetAttributes{},
+		},
+		{
+			name:          "zero port",
+			attrs:         &pb.SshTargetAttributes{DefaultPort: &wrappers.UInt32Value{Value: 0}},
+			wantBadFields: []string{defaultPortField},
+		},
+		{
+			name:          "port too large",
+			attrs:         &pb.SshTargetAttributes{DefaultPort: &wrappers.UInt32Value{Value: 70000}},
+			wantBadFields: []string{defaultPortField},
+		},
+		{
+			name:          "client port too large",
+			attrs:         &pb.SshTargetAttributes{DefaultClientPort: &wrappers.UInt32Value{Value: 70000}},
+			wantBadFields: []string{defaultClientPortField},
+		},
+		{
+			name:          "recording without bucket",
+			attrs:         &pb.SshTargetAttributes{EnableSessionRecording: &wrappers.BoolValue{Value: true}},
+			wantBadFields: []string{storageBucketIdField},
+		},
+		{
+			name: "recording with bucket",
+			attrs: &pb.SshTargetAttributes{
+				EnableSessionRecording: &wrappers.BoolValue{Value: true},
+				StorageBucketId:        &wrappers.StringValue{Value: "sb_1234567890"},
+			},
+		},
+		{
+			name:  "known hosts",
+			attrs: &pb.SshTargetAttributes{KnownHosts: &wrappers.StringValue{Value: knownhosts.Line([]string{"example.com"}, hostKey)}},
+		},
+		{
+			name:          "invalid known hosts",
+			attrs:         &pb.SshTargetAttributes{KnownHosts: &wrappers.StringValue{Value: "example.com not-a-key"}},
+			wantBadFields: []string{knownHostsField},
+		},
+		{
+			name:          "empty known hosts",
+			attrs:         &pb.SshTargetAttributes{KnownHosts: &wrappers.StringValue{Value: ""}},
+			wantBadFields: []string{knownHostsField},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newAttribute(&pb.Target_SshTargetAttributes{SshTargetAttributes: tt.attrs})
+			got := a.Vet()
+			assert.Len(t, got, len(tt.wantBadFields))
+			for _, f := range tt.wantBadFields {
+				assert.Contains(t, got, f)
+			}
+		})
+	}
+}
+
+func TestAttribute_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := cryptossh.NewPublicKey(pub)
+	require.NoError(t, err)
+	knownHosts := knownhosts.Line([]string{"example.com"}, hostKey)
+
+	a := newAttribute(&pb.Target_SshTargetAttributes{SshTargetAttributes: &pb.SshTargetAttributes{
+		DefaultPort:                     &wrappers.UInt32Value{Value: 2222},
+		DefaultClientPort:               &wrappers.UInt32Value{Value: 2200},
+		StorageBucketId:                 &wrappers.StringValue{Value: "sb_1234567890"},
+		EnableSessionRecording:          &wrappers.BoolValue{Value: true},
+		KnownHosts:                      &wrappers.StringValue{Value: knownHosts},
+		InsecureSkipHostKeyVerification: &wrappers.BoolValue{Value: true},
+	}})
+	tar, err := target.New(ctx, ssh.Subtype, "p_1234567890", a.Options()...)
+	require.NoError(t, err)
+
+	out := &pb.Target{}
+	require.NoError(t, setAttributes(tar, out))
+	got := out.GetSshTargetAttributes()
+	require.NotNil(t, got)
+	assert.Equal(t, uint32(2222), got.GetDefaultPort().GetValue())
+	assert.Equal(t, uint32(2200), got.GetDefaultClientPort().GetValue())
+	assert.Equal(t, "sb_1234567890", got.GetStorageBucketId().GetValue())
+	assert.True(t, got.GetEnableSessionRecording().GetValue())
+	assert.Equal(t, knownHosts, got.GetKnownHosts().GetValue())
+	assert.True(t, got.GetInsecureSkipHostKeyVerification().GetValue())
+}
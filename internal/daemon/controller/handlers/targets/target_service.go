@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/boundary/internal/alias"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
 	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/credential/sshca"
 	"github.com/hashicorp/boundary/internal/daemon/controller/auth"
 	"github.com/hashicorp/boundary/internal/daemon/controller/common"
 	"github.com/hashicorp/boundary/internal/daemon/controller/handlers"
@@ -129,6 +130,7 @@ type Service struct {
 	staticHostRepoFn        common.StaticRepoFactory
 	vaultCredRepoFn         common.VaultCredentialRepoFactory
 	staticCredRepoFn        common.StaticCredentialRepoFactory
+	sshCaCredRepoFn         common.SshCaCredentialRepoFactory
 	downstreams             common.Downstreamers
 	kmsCache                *kms.Kms
 	workerStatusGracePeriod *atomic.Int64
@@ -150,6 +152,7 @@ func NewService(
 	staticHostRepoFn common.StaticRepoFactory,
 	vaultCredRepoFn common.VaultCredentialRepoFactory,
 	staticCredRepoFn common.StaticCredentialRepoFactory,
+	sshCaCredRepoFn common.SshCaCredentialRepoFactory,
 	aliasRepoFn common.TargetAliasRepoFactory,
 	downstreams common.Downstreamers,
 	workerStatusGracePeriod *atomic.Int64,
@@ -176,6 +179,8 @@ func NewService(
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing vault credential repository")
 	case staticCredRepoFn == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing static credential repository")
+	case sshCaCredRepoFn == nil:
+		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing ssh ca credential repository")
 	case aliasRepoFn == nil:
 		return Service{}, errors.New(ctx, errors.InvalidParameter, op, "missing target alias repository")
 	}
@@ -191,6 +196,7 @@ func NewService(
 		staticHostRepoFn:        staticHostRepoFn,
 		vaultCredRepoFn:         vaultCredRepoFn,
 		staticCredRepoFn:        staticCredRepoFn,
+		sshCaCredRepoFn:         sshCaCredRepoFn,
 		aliasRepoFn:             aliasRepoFn,
 		downstreams:             downstreams,
 		kmsCache:                kmsCache,
@@ -233,7 +239,7 @@ func (s Service) ListTargets(ctx context.Context, req *pbs.ListTargetsRequest) (
 	}
 
 	// Get all user permissions for the requested scope(s).
-	userPerms := authResults.ACL().ListPermissions(authzScopes, resource.Target, IdActions, authResults.UserId)
+	userPerms := authResults.ACL().ListPermissions(authzScopes, resource.Target, IdActions, authResults.UserId, authResults.ACLOptions()...)
 	if len(userPerms) == 0 {
 		return &pbs.ListTargetsResponse{
 			ResponseType: "complete",
@@ -1003,16 +1009,23 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 	})
 
 	var vaultReqs []credential.Request
+	var sshCaReqs []credential.Request
 	var staticIds []string
 	var dynCreds []*session.DynamicCredential
 	var staticCreds []*session.StaticCredential
 	for _, cs := range credSources {
 		switch cs.Type() {
 		case target.LibraryCredentialSourceType:
-			vaultReqs = append(vaultReqs, credential.Request{
+			req := credential.Request{
 				SourceId: cs.Id(),
 				Purpose:  cs.CredentialPurpose(),
-			})
+			}
+			switch globals.ResourceInfoFromPrefix(cs.Id()).Subtype {
+			case sshca.CertificateLibrarySubtype:
+				sshCaReqs = append(sshCaReqs, req)
+			default:
+				vaultReqs = append(vaultReqs, req)
+			}
 			dynCreds = append(dynCreds, session.NewDynamicCredential(cs.Id(), cs.CredentialPurpose()))
 		case target.StaticCredentialSourceType:
 			staticIds = append(staticIds, cs.Id())
@@ -1035,10 +1048,17 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		WorkerFilter:        t.GetWorkerFilter(),
 		EgressWorkerFilter:  t.GetEgressWorkerFilter(),
 		IngressWorkerFilter: t.GetIngressWorkerFilter(),
+		ApprovalRequired:    t.GetApprovalRequired(),
+		ApproversFilter:     t.GetApproversFilter(),
 		DynamicCredentials:  dynCreds,
 		StaticCredentials:   staticCreds,
 		CorrelationId:       correlationId,
 	}
+	if t.GetApprovalRequired() && t.GetApprovalTimeoutSeconds() > 0 {
+		approvalExpTime := timestamppb.Now()
+		approvalExpTime.Seconds += int64(t.GetApprovalTimeoutSeconds())
+		sessionComposition.ApprovalExpirationTime = &timestamp.Timestamp{Timestamp: approvalExpTime}
+	}
 	if protoWorker != nil {
 		sessionComposition.ProtocolWorkerId = protoWorker.GetPublicId()
 	}
@@ -1099,6 +1119,18 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		}()
 	}
 
+	if len(sshCaReqs) > 0 {
+		credRepo, err := s.sshCaCredRepoFn()
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		sshCaCreds, err := credRepo.Issue(ctx, sess.GetPublicId(), sshCaReqs, credential.WithTemplateData(authResults.UserData))
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		dynamic = append(dynamic, sshCaCreds...)
+	}
+
 	if len(staticIds) > 0 {
 		credRepo, err := s.staticCredRepoFn()
 		if err != nil {
@@ -1215,6 +1247,9 @@ func (s Service) AuthorizeSession(ctx context.Context, req *pbs.AuthorizeSession
 		Endpoint:           endpointUrl.String(),
 		Credentials:        creds,
 	}
+	if len(sess.States) > 0 {
+		ret.Status = sess.States[0].Status.String()
+	}
 
 	ret.SessionRecordingId, err = SessionRecordingFn(
 		ctx,
@@ -1293,6 +1328,15 @@ func (s Service) createInRepo(ctx context.Context, item *pb.Target) (target.Targ
 	if item.GetIngressWorkerFilter() != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if item.GetApprovalRequired() != nil {
+		opts = append(opts, target.WithApprovalRequired(item.GetApprovalRequired().GetValue()))
+	}
+	if item.GetApproversFilter() != nil {
+		opts = append(opts, target.WithApproversFilter(item.GetApproversFilter().GetValue()))
+	}
+	if item.GetApprovalTimeoutSeconds() != nil {
+		opts = append(opts, target.WithApprovalTimeoutSeconds(item.GetApprovalTimeoutSeconds().GetValue()))
+	}
 	if item.GetAddress() != nil {
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
 	}
@@ -1364,6 +1408,15 @@ func (s Service) updateInRepo(ctx context.Context, scopeId, id string, mask []st
 	if ingressFilter := item.GetIngressWorkerFilter(); ingressFilter != nil {
 		opts = append(opts, target.WithIngressWorkerFilter(item.GetIngressWorkerFilter().GetValue()))
 	}
+	if approvalRequired := item.GetApprovalRequired(); approvalRequired != nil {
+		opts = append(opts, target.WithApprovalRequired(approvalRequired.GetValue()))
+	}
+	if approversFilter := item.GetApproversFilter(); approversFilter != nil {
+		opts = append(opts, target.WithApproversFilter(approversFilter.GetValue()))
+	}
+	if approvalTimeout := item.GetApprovalTimeoutSeconds(); approvalTimeout != nil {
+		opts = append(opts, target.WithApprovalTimeoutSeconds(approvalTimeout.GetValue()))
+	}
 	if item.GetAddress() != nil {
 		dbMask = append(dbMask, "Address")
 		opts = append(opts, target.WithAddress(strings.TrimSpace(item.GetAddress().GetValue())))
@@ -1727,6 +1780,15 @@ func toProto(ctx context.Context, in target.Target, opt ...handlers.Option) (*pb
 	if outputFields.Has(globals.IngressWorkerFilterField) && in.GetIngressWorkerFilter() != "" {
 		out.IngressWorkerFilter = wrapperspb.String(in.GetIngressWorkerFilter())
 	}
+	if outputFields.Has(globals.ApprovalRequiredField) && in.GetApprovalRequired() {
+		out.ApprovalRequired = wrapperspb.Bool(in.GetApprovalRequired())
+	}
+	if outputFields.Has(globals.ApproversFilterField) && in.GetApproversFilter() != "" {
+		out.ApproversFilter = wrapperspb.String(in.GetApproversFilter())
+	}
+	if outputFields.Has(globals.ApprovalTimeoutSecondsField) && in.GetApprovalTimeoutSeconds() != 0 {
+		out.ApprovalTimeoutSeconds = wrapperspb.UInt32(in.GetApprovalTimeoutSeconds())
+	}
 	if outputFields.Has(globals.ScopeField) {
 		out.Scope = opts.WithScope
 	}
@@ -1864,6 +1926,14 @@ func validateCreateRequest(req *pbs.CreateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		if approversFilter := item.GetApproversFilter(); approversFilter != nil {
+			if _, err := bexpr.CreateEvaluator(approversFilter.GetValue()); err != nil {
+				badFields[globals.ApproversFilterField] = "Unable to successfully parse approvers filter expression."
+			}
+		}
+		if item.GetApprovalTimeoutSeconds() != nil && item.GetApprovalTimeoutSeconds().GetValue() == 0 {
+			badFields[globals.ApprovalTimeoutSecondsField] = "This must be greater than zero."
+		}
 		if address := item.GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -1944,6 +2014,14 @@ func validateUpdateRequest(req *pbs.UpdateTargetRequest) error {
 				badFields[globals.IngressWorkerFilterField] = err.Error()
 			}
 		}
+		if approversFilter := item.GetApproversFilter(); approversFilter != nil {
+			if _, err := bexpr.CreateEvaluator(approversFilter.GetValue()); err != nil {
+				badFields[globals.ApproversFilterField] = "Unable to successfully parse approvers filter expression."
+			}
+		}
+		if item.GetApprovalTimeoutSeconds() != nil && item.GetApprovalTimeoutSeconds().GetValue() == 0 {
+			badFields[globals.ApprovalTimeoutSecondsField] = "This must be greater than zero."
+		}
 		if address := item.GetAddress(); address != nil {
 			if len(address.GetValue()) < static.MinHostAddressLength ||
 				len(address.GetValue()) > static.MaxHostAddressLength {
@@ -2097,6 +2175,7 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.SshCaCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2109,6 +2188,7 @@ func validateAddCredentialSourcesRequest(req *pbs.AddTargetCredentialSourcesRequ
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.SshCaCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix) {
@@ -2133,6 +2213,7 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.SshCaCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2145,6 +2226,7 @@ func validateSetCredentialSourcesRequest(req *pbs.SetTargetCredentialSourcesRequ
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.SshCaCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix) {
@@ -2173,6 +2255,7 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 	for _, cl := range req.GetBrokeredCredentialSourceIds() {
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
+			globals.SshCaCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
@@ -2185,6 +2268,7 @@ func validateRemoveCredentialSourcesRequest(req *pbs.RemoveTargetCredentialSourc
 		if !handlers.ValidId(handlers.Id(cl),
 			globals.VaultCredentialLibraryPrefix,
 			globals.VaultSshCertificateCredentialLibraryPrefix,
+			globals.SshCaCertificateCredentialLibraryPrefix,
 			globals.UsernamePasswordCredentialPrefix,
 			globals.UsernamePasswordCredentialPreviousPrefix,
 			globals.SshPrivateKeyCredentialPrefix,
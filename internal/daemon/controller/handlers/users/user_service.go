@@ -6,6 +6,7 @@ package users
 import (
 	"context"
 	"fmt"
+	"net/netip"
 
 	"github.com/hashicorp/boundary/globals"
 	talias "github.com/hashicorp/boundary/internal/alias/target"
@@ -47,6 +48,7 @@ var (
 		action.SetAccounts,
 		action.RemoveAccounts,
 		action.ListResolvableAliases,
+		action.Explain,
 	)
 
 	// CollectionActions contains the set of actions that can be performed on
@@ -506,7 +508,7 @@ func (s Service) ListResolvableAliases(ctx context.Context, req *pbs.ListResolva
 		}
 	}
 
-	permissions := acl.ListResolvableAliasesPermissions(resource.Target, targets.IdActions)
+	permissions := acl.ListResolvableAliasesPermissions(resource.Target, targets.IdActions, authResults.ACLOptions()...)
 
 	if len(permissions) == 0 {
 		// if there are no permitted targets then there will be no aliases that
@@ -595,6 +597,76 @@ func (s Service) ListResolvableAliases(ctx context.Context, req *pbs.ListResolva
 	return resp, nil
 }
 
+// ExplainPermission implements the interface pbs.UserServiceServer.
+func (s Service) ExplainPermission(ctx context.Context, req *pbs.ExplainPermissionRequest) (*pbs.ExplainPermissionResponse, error) {
+	const op = "users.(Service).ExplainPermission"
+	if err := validateExplainPermissionRequest(req); err != nil {
+		return nil, err
+	}
+
+	authResults := s.authResult(ctx, req.GetId(), action.Explain)
+	if authResults.Error != nil {
+		return nil, authResults.Error
+	}
+
+	iamRepo, err := s.repoFn()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	res, err := iamRepo.LookupResourceScope(ctx, req.GetResourceId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if res == nil {
+		return nil, handlers.NotFoundErrorf("Resource %q doesn't exist.", req.GetResourceId())
+	}
+	grantTuples, err := iamRepo.GrantsForUser(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	principalRoles, err := iamRepo.ListPrincipalRolesForUser(ctx, req.GetId())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	permsOpts := authResults.ACLOptions()
+	if req.GetClientIp() != "" {
+		permsOpts = []perms.Option{perms.WithClientIp(req.GetClientIp())}
+	}
+	explanation := perms.Explain(ctx, grantTuples, *res, action.Map[req.GetAction()], req.GetId(), permsOpts...)
+
+	item := &pb.PermissionExplanation{
+		UserId:       req.GetId(),
+		ResourceId:   res.Id,
+		ResourceType: res.Type.String(),
+		ScopeId:      res.ScopeId,
+		Action:       req.GetAction(),
+		Allowed:      explanation.Allowed,
+	}
+	for _, pr := range principalRoles {
+		item.Principals = append(item.Principals, &pb.ExplainedPrincipal{
+			RoleId:      pr.GetRoleId(),
+			RoleScopeId: pr.GetRoleScopeId(),
+			PrincipalId: pr.GetPrincipalId(),
+			Type:        pr.GetType(),
+		})
+	}
+	for _, g := range explanation.Grants {
+		item.Grants = append(item.Grants, &pb.ExplainedGrant{
+			RoleId:        g.RoleId,
+			RoleScopeId:   g.RoleScopeId,
+			GrantScopeId:  g.GrantScopeId,
+			Grant:         g.Grant,
+			InScope:       g.InScope,
+			ConditionsMet: g.ConditionsMet,
+			Allowed:       g.Allowed,
+			Denied:        g.Denied,
+			ParseError:    g.ParseError,
+		})
+	}
+	return &pbs.ExplainPermissionResponse{Item: item}, nil
+}
+
 // aclAndGrantHashForUser returns an ACL from the grants provided to the user and
 // the hash of those grants.
 func (s Service) aclAndGrantHashForUser(ctx context.Context, userId string) (perms.ACL, []byte, error) {
@@ -1061,6 +1133,30 @@ func validateListResolvableAliasesRequest(req *pbs.ListResolvableAliasesRequest)
 	return nil
 }
 
+func validateExplainPermissionRequest(req *pbs.ExplainPermissionRequest) error {
+	badFields := map[string]string{}
+	if !handlers.ValidId(handlers.Id(req.GetId()), globals.UserPrefix) {
+		badFields[globals.IdField] = "Incorrectly formatted identifier."
+	}
+	if req.GetResourceId() == "" {
+		badFields["resource_id"] = "This field is required."
+	} else if globals.ResourceInfoFromPrefix(req.GetResourceId()).Type == resource.Unknown {
+		badFields["resource_id"] = "Unknown resource type."
+	}
+	if _, ok := action.Map[req.GetAction()]; !ok || req.GetAction() == action.All.String() {
+		badFields["action"] = "Must be a valid action."
+	}
+	if req.GetClientIp() != "" {
+		if _, err := netip.ParseAddr(req.GetClientIp()); err != nil {
+			badFields["client_ip"] = "Must be a valid IP address."
+		}
+	}
+	if len(badFields) > 0 {
+		return handlers.InvalidArgumentErrorf("Errors in provided fields.", badFields)
+	}
+	return nil
+}
+
 func newOutputOpts(ctx context.Context, item *iam.User, scopeInfoMap map[string]*scopes.ScopeInfo, authResults auth.VerifyResults) ([]handlers.Option, bool) {
 	res := perms.Resource{
 		Type: resource.User,
@@ -46,7 +46,7 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts", "list-resolvable-aliases"}
+var testAuthorizedActions = []string{"no-op", "read", "update", "delete", "add-accounts", "set-accounts", "remove-accounts", "list-resolvable-aliases", "explain"}
 
 func createDefaultUserAndRepos(t *testing.T, withAccts bool) (*iam.User, []string, common.IamRepoFactory, common.TargetAliasRepoFactory) {
 	t.Helper()
@@ -2077,3 +2077,142 @@ func TestRemoveAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestExplainPermission(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrap := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrap)
+	rw := db.New(conn)
+	repo := iam.TestRepo(t, conn, wrap)
+	repoFn := func() (*iam.Repository, error) {
+		return repo, nil
+	}
+	aliasRepoFn := func() (*talias.Repository, error) {
+		return talias.NewRepository(ctx, rw, rw, kmsCache)
+	}
+	o, _ := iam.TestScopes(t, repo)
+	u := iam.TestUser(t, repo, o.GetPublicId())
+	other := iam.TestUser(t, repo, o.GetPublicId())
+
+	userRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, userRole.GetPublicId(), "ids=*;type=user;actions=read")
+	iam.TestUserRole(t, conn, userRole.GetPublicId(), u.GetPublicId())
+
+	g := iam.TestGroup(t, conn, o.GetPublicId())
+	iam.TestGroupMember(t, conn, g.GetPublicId(), u.GetPublicId())
+	groupRole := iam.TestRole(t, conn, o.GetPublicId())
+	iam.TestRoleGrant(t, conn, groupRole.GetPublicId(), "ids=*;type=user;actions=update;client_cidrs=10.0.0.0/8")
+	iam.TestGroupRole(t, conn, groupRole.GetPublicId(), g.GetPublicId())
+
+	s, err := users.NewService(ctx, repoFn, aliasRepoFn, 1000)
+	require.NoError(t, err, "Error when getting new user service.")
+
+	cases := []struct {
+		name        string
+		req         *pbs.ExplainPermissionRequest
+		wantAllowed bool
+		wantGrants  map[string]bool
+		err         error
+	}{
+		{
+			name: "allowed by user role",
+			req: &pbs.ExplainPermissionRequest{
+				Id:         u.GetPublicId(),
+				ResourceId: other.GetPublicId(),
+				Action:     "read",
+			},
+			wantAllowed: true,
+			wantGrants: map[string]bool{
+				userRole.GetPublicId():  true,
+				groupRole.GetPublicId(): false,
+			},
+		},
+		{
+			name: "group role condition not met",
+			req: &pbs.ExplainPermissionRequest{
+				Id:         u.GetPublicId(),
+				ResourceId: other.GetPublicId(),
+				Action:     "update",
+				ClientIp:   "192.168.0.1",
+			},
+			wantGrants: map[string]bool{
+				userRole.GetPublicId():  false,
+				groupRole.GetPublicId(): false,
+			},
+		},
+		{
+			name: "group role condition met",
+			req: &pbs.ExplainPermissionRequest{
+				Id:         u.GetPublicId(),
+				ResourceId: other.GetPublicId(),
+				Action:     "update",
+				ClientIp:   "10.1.2.3",
+			},
+			wantAllowed: true,
+			wantGrants: map[string]bool{
+				userRole.GetPublicId():  false,
+				groupRole.GetPublicId(): true,
+			},
+		},
+		{
+			name: "unknown resource",
+			req: &pbs.ExplainPermissionRequest{
+				Id:         u.GetPublicId(),
+				ResourceId: globals.UserPrefix + "_doesntexis",
+				Action:     "read",
+			},
+			err: handlers.ApiErrorWithCode(codes.NotFound),
+		},
+		{
+			name: "invalid action",
+			req: &pbs.ExplainPermissionRequest{
+				Id:         u.GetPublicId(),
+				ResourceId: other.GetPublicId(),
+				Action:     "bogus",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+		{
+			name: "missing resource id",
+			req: &pbs.ExplainPermissionRequest{
+				Id:     u.GetPublicId(),
+				Action: "read",
+			},
+			err: handlers.ApiErrorWithCode(codes.InvalidArgument),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, gErr := s.ExplainPermission(auth.DisabledAuthTestContext(repoFn, o.GetPublicId()), tc.req)
+			if tc.err != nil {
+				require.Error(gErr)
+				assert.True(errors.Is(gErr, tc.err), "ExplainPermission(%+v) got error %v, wanted %v", tc.req, gErr, tc.err)
+				return
+			}
+			require.NoError(gErr)
+			item := got.GetItem()
+			assert.Equal(tc.wantAllowed, item.GetAllowed())
+			assert.Equal(other.GetPublicId(), item.GetResourceId())
+			assert.Equal("user", item.GetResourceType())
+			assert.Equal(o.GetPublicId(), item.GetScopeId())
+
+			principals := map[string]string{}
+			for _, p := range item.GetPrincipals() {
+				principals[p.GetRoleId()] = p.GetPrincipalId()
+			}
+			assert.Equal(u.GetPublicId(), principals[userRole.GetPublicId()])
+			assert.Equal(g.GetPublicId(), principals[groupRole.GetPublicId()])
+
+			grants := map[string]bool{}
+			for _, eg := range item.GetGrants() {
+				if _, ok := tc.wantGrants[eg.GetRoleId()]; ok {
+					assert.True(eg.GetInScope())
+					grants[eg.GetRoleId()] = eg.GetAllowed()
+				}
+			}
+			assert.Equal(tc.wantGrants, grants)
+		})
+	}
+}
@@ -4,5 +4,8 @@
 package worker
 
 import (
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/http"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/postgres"
+	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/ssh"
 	_ "github.com/hashicorp/boundary/internal/daemon/worker/proxy/tcp"
 )
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package http
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+// tokenFields are the fields of a json credential which contain a bearer
+// token, in order of precedence.
+var tokenFields = []string{"token", "access_token", "bearer_token"}
+
+// authorizationHeader returns the value of the Authorization header added to
+// the requests sent to the endpoint, built from the first injected credential.
+//
+// A username password credential is sent using basic authentication. A json
+// credential is sent as a bearer token when it contains one of the
+// tokenFields, otherwise it must contain a username and a password which are
+// sent using basic authentication.
+func authorizationHeader(ctx context.Context, creds []*serverpb.Credential) (string, error) {
+	const op = "http.authorizationHeader"
+	if len(creds) == 0 {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "no injected credentials provided")
+	}
+
+	switch cred := creds[0].GetCredential().(type) {
+	case *serverpb.Credential_UsernamePassword:
+		if cred.UsernamePassword.GetUsername() == "" {
+			return "", errors.New(ctx, errors.InvalidParameter, op, "injected credential is missing a username")
+		}
+		return basicAuth(cred.UsernamePassword.GetUsername(), cred.UsernamePassword.GetPassword()), nil
+
+	case *serverpb.Credential_Json:
+		var object map[string]any
+		if err := json.Unmarshal(cred.Json.GetObject(), &object); err != nil {
+			return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal json credential"))
+		}
+		for _, f := range tokenFields {
+			if token, ok := object[f].(string); ok && token != "" {
+				return "Bearer " + token, nil
+			}
+		}
+		username, _ := object["username"].(string)
+		password, _ := object["password"].(string)
+		if username == "" || password == "" {
+			return "", errors.New(ctx, errors.InvalidParameter, op, "json credential must contain a token or a username and password")
+		}
+		return basicAuth(username, password), nil
+
+	default:
+		return "", errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", cred))
+	}
+}
+
+func basicAuth(username, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package http provides a proxy handler for http targets. The handler
+// terminates HTTP/1.1 and HTTP/2 over cleartext from the client on the worker
+// and acts as a reverse proxy to the endpoint, adding an Authorization header
+// built from the injected credential provided by the controller to every
+// request, so the credential is never exposed to the client. Requests are
+// sent to the endpoint using HTTPS after verifying its certificate, unless the
+// target uses plain HTTP and explicitly allows sending credentials without
+// TLS.
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	stderrors "errors"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func init() {
+	err := proxy.RegisterHandler(proxy.HttpHandlerName, handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy dials the endpoint using the ProxyDialer. The provided protocol
+// context must be an HttpProtocolContext containing the session's endpoint,
+// the credential to inject and the TLS settings of the endpoint. If the
+// protocol context has no TLS settings, it must allow plaintext credentials.
+//
+// handleProxy returns a ProxyConnFn which serves the HTTP requests sent by the
+// client on the incoming conn and forwards them to the endpoint. It blocks
+// until the client closes the connection.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "http.HandleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+
+	httpCtx := &serverpb.HttpProtocolContext{}
+	if err := pc.UnmarshalTo(httpCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal http protocol context"))
+	}
+	upstream, err := upstreamUrl(controlCtx, httpCtx.GetEndpoint(), httpCtx.GetTls() != nil)
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	var tlsConfig *tls.Config
+	switch {
+	case httpCtx.GetTls() != nil:
+		tlsConfig, err = proxy.EndpointTlsConfig(httpCtx.GetEndpoint(), httpCtx.GetTls())
+		if err != nil {
+			return nil, errors.Wrap(controlCtx, err, op, errors.WithCode(errors.InvalidParameter))
+		}
+	case !httpCtx.GetAllowPlaintextCredentials():
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "refusing to inject credentials in requests sent without tls")
+	}
+	authorization, err := authorizationHeader(controlCtx, httpCtx.GetInjectedCredentials())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+
+	remoteConn, err := out.Dial(controlCtx)
+	if err != nil {
+		return nil, err
+	}
+	dialer := &upstreamDialer{out: out, conn: remoteConn}
+	transport := &http.Transport{
+		DialContext:           dialer.DialContext,
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	rp := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(upstream)
+			r.Out.Header.Set("Authorization", authorization)
+		},
+		Transport: transport,
+		ErrorHandler: func(w http.ResponseWriter, _ *http.Request, err error) {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error proxying request to endpoint", "connection_id", connId))
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	srv := &http.Server{
+		Handler:           h2c.NewHandler(rp, &http2.Server{}),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return dataCtx },
+	}
+
+	return func() {
+		defer func() {
+			dialer.close()
+			transport.CloseIdleConnections()
+		}()
+		err := srv.Serve(newConnListener(conn))
+		if err != nil && !stderrors.Is(err, net.ErrClosed) && !stderrors.Is(err, http.ErrServerClosed) {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error serving http requests", "connection_id", connId))
+		}
+	}, nil
+}
+
+// upstreamUrl returns the URL the requests are forwarded to for the provided
+// session endpoint, using HTTPS if useTls is true.
+func upstreamUrl(ctx context.Context, endpoint string, useTls bool) (*url.URL, error) {
+	const op = "http.upstreamUrl"
+	if endpoint == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "endpoint is empty")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse endpoint"))
+	}
+	if u.Host == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "endpoint is missing a host")
+	}
+	scheme := "http"
+	if useTls {
+		scheme = "https"
+	}
+	return &url.URL{Scheme: scheme, Host: u.Host}, nil
+}
+
+// upstreamDialer dials the connections to the endpoint. The connection dialed
+// when the handler is created is used first, since the worker reports its
+// address when the connection is established.
+type upstreamDialer struct {
+	out *proxy.ProxyDialer
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func (d *upstreamDialer) DialContext(ctx context.Context, _, _ string) (net.Conn, error) {
+	d.mu.Lock()
+	c := d.conn
+	d.conn = nil
+	d.mu.Unlock()
+	if c != nil {
+		return c, nil
+	}
+	return d.out.Dial(ctx)
+}
+
+// close closes the connection dialed when the handler was created if it was
+// never used.
+func (d *upstreamDialer) close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.conn != nil {
+		_ = d.conn.Close()
+		d.conn = nil
+	}
+}
+
+// connListener is a net.Listener which accepts a single connection. Once the
+// connection has been accepted, Accept blocks until the connection is closed.
+type connListener struct {
+	conn     net.Conn
+	accepted chan struct{}
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func newConnListener(conn net.Conn) *connListener {
+	l := &connListener{
+		conn:     conn,
+		accepted: make(chan struct{}, 1),
+		closed:   make(chan struct{}),
+	}
+	l.accepted <- struct{}{}
+	return l
+}
+
+func (l *connListener) Accept() (net.Conn, error) {
+	select {
+	case <-l.accepted:
+		return &listenerConn{Conn: l.conn, l: l}, nil
+	case <-l.closed:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *connListener) Close() error {
+	l.closeOnce.Do(func() { close(l.closed) })
+	return nil
+}
+
+func (l *connListener) Addr() net.Addr {
+	return l.conn.LocalAddr()
+}
+
+// listenerConn closes its listener when it is closed, which happens when the
+// http.Server is done with the connection or when a hijacked connection is
+// closed.
+type listenerConn struct {
+	net.Conn
+	l *connListener
+}
+
+func (c *listenerConn) Close() error {
+	err := c.Conn.Close()
+	_ = c.l.Close()
+	return err
+}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package http
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testEndpoint starts an http server which responds with the Authorization
+// header, host and path of the requests it receives. If useTls is true, the
+// server uses HTTPS and the PEM encoded certificate of the server is
+// returned.
+func testEndpoint(t *testing.T, useTls bool) (*url.URL, string) {
+	t.Helper()
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, "%s %s %s", r.Header.Get("Authorization"), r.Host, r.URL.Path)
+	}))
+	var caCert string
+	if useTls {
+		srv.StartTLS()
+		caCert = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+	} else {
+		srv.Start()
+	}
+	t.Cleanup(srv.Close)
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	return u, caCert
+}
+
+// testConnPair returns both ends of a tcp connection.
+func testConnPair(t *testing.T) (net.Conn, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	accepted := make(chan net.Conn)
+	go func() {
+		c, _ := l.Accept()
+		accepted <- c
+	}()
+	c1, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	c2 := <-accepted
+	require.NotNil(t, c2)
+	t.Cleanup(func() {
+		_ = c1.Close()
+		_ = c2.Close()
+	})
+	return c1, c2
+}
+
+// testProtocolContext returns an HttpProtocolContext using the provided tls
+// settings. If settings is nil, plaintext credentials are allowed.
+func testProtocolContext(t *testing.T, endpoint string, settings *serverpb.EndpointTls, creds ...*serverpb.Credential) *anypb.Any {
+	t.Helper()
+	pc, err := anypb.New(&serverpb.HttpProtocolContext{
+		InjectedCredentials:       creds,
+		Endpoint:                  endpoint,
+		Tls:                       settings,
+		AllowPlaintextCredentials: settings == nil,
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func usernamePasswordCredential(username, password string) *serverpb.Credential {
+	return &serverpb.Credential{
+		Credential: &serverpb.Credential_UsernamePassword{
+			UsernamePassword: &serverpb.UsernamePassword{Username: username, Password: password},
+		},
+	}
+}
+
+func jsonCredential(object string) *serverpb.Credential {
+	return &serverpb.Credential{
+		Credential: &serverpb.Credential_Json{
+			Json: &serverpb.Json{Object: []byte(object)},
+		},
+	}
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		cred     *serverpb.Credential
+		http2    bool
+		tls      bool
+		wantAuth string
+	}{
+		{
+			name:     "username password",
+			cred:     usernamePasswordCredential("user", "secret"),
+			wantAuth: "Basic dXNlcjpzZWNyZXQ=",
+		},
+		{
+			name:     "json token",
+			cred:     jsonCredential(`{"token":"abc123"}`),
+			wantAuth: "Bearer abc123",
+		},
+		{
+			name:     "json username password",
+			cred:     jsonCredential(`{"username":"user","password":"secret"}`),
+			wantAuth: "Basic dXNlcjpzZWNyZXQ=",
+		},
+		{
+			name:     "http2",
+			cred:     jsonCredential(`{"access_token":"abc123"}`),
+			http2:    true,
+			wantAuth: "Bearer abc123",
+		},
+		{
+			name:     "tls",
+			cred:     usernamePasswordCredential("user", "secret"),
+			tls:      true,
+			wantAuth: "Basic dXNlcjpzZWNyZXQ=",
+		},
+		{
+			name:     "tls http2",
+			cred:     jsonCredential(`{"token":"abc123"}`),
+			http2:    true,
+			tls:      true,
+			wantAuth: "Bearer abc123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			endpoint, caCert := testEndpoint(t, tt.tls)
+			dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+				return net.Dial("tcp", endpoint.Host)
+			})
+			require.NoError(err)
+			var settings *serverpb.EndpointTls
+			if tt.tls {
+				settings = &serverpb.EndpointTls{CaCert: caCert}
+			}
+			clientConn, workerConn := testConnPair(t)
+			pc := testProtocolContext(t, "http://"+endpoint.Host, settings, tt.cred)
+			fn, err := handleProxy(ctx, ctx, nil, workerConn, dialer, "conn_1234567890", pc, nil)
+			require.NoError(err)
+			require.NotNil(fn)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				fn()
+			}()
+
+			var transport http.RoundTripper = &http.Transport{
+				DialContext: func(context.Context, string, string) (net.Conn, error) { return clientConn, nil },
+			}
+			if tt.http2 {
+				transport = &http2.Transport{
+					AllowHTTP:      true,
+					DialTLSContext: func(context.Context, string, string, *tls.Config) (net.Conn, error) { return clientConn, nil },
+				}
+			}
+			client := &http.Client{Transport: transport}
+			for _, path := range []string{"/first", "/second"} {
+				req, err := http.NewRequest(http.MethodGet, "http://localhost:12345"+path, nil)
+				require.NoError(err)
+				req.Header.Set("Authorization", "Bearer client")
+				resp, err := client.Do(req)
+				require.NoError(err)
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(err)
+				require.NoError(resp.Body.Close())
+				assert.Equal(http.StatusOK, resp.StatusCode)
+				assert.Equal(fmt.Sprintf("%s %s %s", tt.wantAuth, endpoint.Host, path), string(body))
+				if tt.http2 {
+					assert.Equal(2, resp.ProtoMajor)
+				}
+			}
+
+			require.NoError(clientConn.Close())
+			<-done
+		})
+	}
+}
+
+func TestHandleProxy_UnverifiedEndpoint(t *testing.T) {
+	ctx := context.Background()
+	endpoint, caCert := testEndpoint(t, true)
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", endpoint.Host)
+	})
+	require.NoError(t, err)
+
+	assert, require := assert.New(t), require.New(t)
+	clientConn, workerConn := testConnPair(t)
+	pc := testProtocolContext(t, "http://"+endpoint.Host, &serverpb.EndpointTls{CaCert: caCert, ServerName: "db.invalid"}, usernamePasswordCredential("user", "secret"))
+	fn, err := handleProxy(ctx, ctx, nil, workerConn, dialer, "conn_1234567890", pc, nil)
+	require.NoError(err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	client := &http.Client{Transport: &http.Transport{
+		DialContext: func(context.Context, string, string) (net.Conn, error) { return clientConn, nil },
+	}}
+	resp, err := client.Get("http://localhost:12345/")
+	require.NoError(err)
+	require.NoError(resp.Body.Close())
+	assert.Equal(http.StatusBadGateway, resp.StatusCode)
+
+	require.NoError(clientConn.Close())
+	<-done
+}
+
+func TestHandleProxy_Validation(t *testing.T) {
+	ctx := context.Background()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return nil, assert.AnError
+	})
+	require.NoError(t, err)
+	_, workerConn := testConnPair(t)
+	cred := usernamePasswordCredential("user", "secret")
+	plaintext, err := anypb.New(&serverpb.HttpProtocolContext{
+		InjectedCredentials: []*serverpb.Credential{cred},
+		Endpoint:            "http://localhost",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		conn            net.Conn
+		dialer          *proxy.ProxyDialer
+		connId          string
+		pc              *anypb.Any
+		wantErrContains string
+	}{
+		{name: "missing conn", dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", nil, cred), wantErrContains: "conn is nil"},
+		{name: "missing dialer", conn: workerConn, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", nil, cred), wantErrContains: "proxy dialer is nil"},
+		{name: "missing connection id", conn: workerConn, dialer: dialer, pc: testProtocolContext(t, "http://localhost", nil, cred), wantErrContains: "connection id is empty"},
+		{name: "missing protocol context", conn: workerConn, dialer: dialer, connId: "conn_1234567890", wantErrContains: "protocol context is nil"},
+		{name: "missing endpoint", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "", nil, cred), wantErrContains: "endpoint is empty"},
+		{name: "missing credential", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", nil), wantErrContains: "no injected credentials provided"},
+		{name: "unsupported credential", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", nil, &serverpb.Credential{Credential: &serverpb.Credential_SshPrivateKey{SshPrivateKey: &serverpb.SshPrivateKey{Username: "user"}}}), wantErrContains: "unsupported credential"},
+		{name: "json without token", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", nil, jsonCredential(`{"username":"user"}`)), wantErrContains: "json credential must contain a token or a username and password"},
+		{name: "plaintext credentials", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: plaintext, wantErrContains: "refusing to inject credentials in requests sent without tls"},
+		{name: "invalid ca cert", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", &serverpb.EndpointTls{CaCert: "invalid"}, cred), wantErrContains: "ca cert does not contain a valid PEM encoded certificate"},
+		{name: "dial error", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, "http://localhost", nil, cred), wantErrContains: assert.AnError.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := handleProxy(ctx, ctx, nil, tt.conn, tt.dialer, tt.connId, tt.pc, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErrContains)
+			assert.Nil(t, fn)
+		})
+	}
+}
+
+func TestUpstreamUrl(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		endpoint string
+		useTls   bool
+		want     string
+	}{
+		{endpoint: "http://example.com:80", want: "http://example.com:80"},
+		{endpoint: "http://example.com:8080", want: "http://example.com:8080"},
+		{endpoint: "http://example.com:443", want: "http://example.com:443"},
+		{endpoint: "http://example.com:443", useTls: true, want: "https://example.com:443"},
+		{endpoint: "http://example.com:8443", useTls: true, want: "https://example.com:8443"},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s tls %t", tt.endpoint, tt.useTls), func(t *testing.T) {
+			got, err := upstreamUrl(ctx, tt.endpoint, tt.useTls)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
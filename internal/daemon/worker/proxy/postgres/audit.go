@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/event"
+)
+
+const (
+	simpleQuery   = "simple"
+	extendedQuery = "extended"
+)
+
+// queryAuditor emits an observation event for every query sent by the client.
+// Simple queries are audited when they are received. Queries of the extended
+// query protocol are audited when a portal bound to a prepared statement is
+// executed, so it tracks the prepared statements and portals of the
+// connection.
+type queryAuditor struct {
+	sessionId    string
+	connectionId string
+	userId       string
+	targetId     string
+	database     string
+	databaseUser string
+
+	// statements maps the name of prepared statements to their query.
+	statements map[string]statement
+	// portals maps the name of portals to the name of their prepared
+	// statement.
+	portals map[string]string
+}
+
+// observe inspects a message sent by the client and emits an observation
+// event if it runs a query. If truncated is true, body is only the beginning
+// of the message and the query it contains may be truncated.
+func (a *queryAuditor) observe(ctx context.Context, typ byte, body []byte, truncated bool) error {
+	switch typ {
+	case queryMsg:
+		q, _, err := readQuery(body, truncated)
+		if err != nil {
+			return fmt.Errorf("invalid query message: %w", err)
+		}
+		a.write(ctx, simpleQuery, q, truncated)
+
+	case parseMsg:
+		name, rest, err := readCString(body)
+		if err != nil {
+			return fmt.Errorf("invalid parse message: %w", err)
+		}
+		q, _, err := readQuery(rest, truncated)
+		if err != nil {
+			return fmt.Errorf("invalid parse message: %w", err)
+		}
+		a.statements[name] = statement{query: q, truncated: truncated && !bytes.Contains(rest, []byte{0})}
+
+	case bindMsg:
+		portal, rest, err := readCString(body)
+		if err != nil {
+			return fmt.Errorf("invalid bind message: %w", err)
+		}
+		statement, _, err := readCString(rest)
+		if err != nil {
+			return fmt.Errorf("invalid bind message: %w", err)
+		}
+		a.portals[portal] = statement
+
+	case executeMsg:
+		portal, _, err := readCString(body)
+		if err != nil {
+			return fmt.Errorf("invalid execute message: %w", err)
+		}
+		name, ok := a.portals[portal]
+		if !ok {
+			// The endpoint responds with an error for unknown portals.
+			return nil
+		}
+		stmt := a.statements[name]
+		a.write(ctx, extendedQuery, stmt.query, stmt.truncated)
+
+	case closeMsg:
+		if len(body) < 1 {
+			return fmt.Errorf("invalid close message")
+		}
+		name, _, err := readCString(body[1:])
+		if err != nil {
+			return fmt.Errorf("invalid close message: %w", err)
+		}
+		switch body[0] {
+		case 'S':
+			delete(a.statements, name)
+		case 'P':
+			delete(a.portals, name)
+		}
+	}
+	return nil
+}
+
+// statement is a prepared statement of the connection.
+type statement struct {
+	query     string
+	truncated bool
+}
+
+// readQuery reads the null terminated query from b. If truncated is true and
+// b does not contain the end of the query, all of b is returned as the query.
+func readQuery(b []byte, truncated bool) (string, []byte, error) {
+	if truncated && !bytes.Contains(b, []byte{0}) {
+		return string(b), nil, nil
+	}
+	return readCString(b)
+}
+
+func (a *queryAuditor) write(ctx context.Context, queryType, query string, truncated bool) {
+	const op = "postgres.(queryAuditor).write"
+	err := event.WriteObservation(ctx, op, event.WithHeader(
+		"session_id", a.sessionId,
+		"connection_id", a.connectionId,
+		"user_id", a.userId,
+		"target_id", a.targetId,
+		"database", a.database,
+		"database_user", a.databaseUser,
+		"query_type", queryType,
+		"query", query,
+		"query_truncated", truncated,
+	))
+	if err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error writing query observation", "connection_id", a.connectionId))
+	}
+}
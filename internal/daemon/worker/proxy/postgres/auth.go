@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const scramSha256 = "SCRAM-SHA-256"
+
+// endpointError is returned when the endpoint responds with an ErrorResponse
+// message during authentication. The message is forwarded to the client as is.
+type endpointError struct {
+	msg []byte
+}
+
+func (e *endpointError) Error() string {
+	return "endpoint responded with an error"
+}
+
+// authenticate authenticates to the endpoint with the provided credentials
+// after the startup message has been sent. It supports cleartext, MD5 and
+// SCRAM-SHA-256 password authentication. NegotiateProtocolVersion messages
+// received from the endpoint are forwarded to the client.
+func authenticate(endpoint io.ReadWriter, client io.Writer, username, password string) error {
+	var scram *scramClient
+	for {
+		typ, body, err := readMessage(endpoint, maxAuthMessageLength)
+		if err != nil {
+			return err
+		}
+		switch typ {
+		case errorResponseMsg:
+			return &endpointError{msg: encodeMessage(typ, body)}
+		case negotiateProtocolVersionMsg:
+			if err := writeMessage(client, typ, body); err != nil {
+				return err
+			}
+			continue
+		case authenticationMsg:
+		default:
+			return fmt.Errorf("unexpected message %q during authentication", typ)
+		}
+		if len(body) < 4 {
+			return fmt.Errorf("invalid authentication message")
+		}
+		code, data := binary.BigEndian.Uint32(body), body[4:]
+
+		switch code {
+		case authOk:
+			return nil
+
+		case authCleartextPassword:
+			var b bytes.Buffer
+			writeCString(&b, password)
+			if err := writeMessage(endpoint, passwordMsg, b.Bytes()); err != nil {
+				return err
+			}
+
+		case authMD5Password:
+			if len(data) != 4 {
+				return fmt.Errorf("invalid md5 salt")
+			}
+			var b bytes.Buffer
+			writeCString(&b, md5Password(username, password, data))
+			if err := writeMessage(endpoint, passwordMsg, b.Bytes()); err != nil {
+				return err
+			}
+
+		case authSASL:
+			if !supportsScram(data) {
+				return fmt.Errorf("endpoint does not support %s authentication", scramSha256)
+			}
+			scram, err = newScramClient(password)
+			if err != nil {
+				return err
+			}
+			first := scram.clientFirst()
+			var b bytes.Buffer
+			writeCString(&b, scramSha256)
+			b.Write(binary.BigEndian.AppendUint32(nil, uint32(len(first))))
+			b.WriteString(first)
+			if err := writeMessage(endpoint, passwordMsg, b.Bytes()); err != nil {
+				return err
+			}
+
+		case authSASLContinue:
+			if scram == nil {
+				return fmt.Errorf("unexpected SASL continue message")
+			}
+			final, err := scram.clientFinal(string(data))
+			if err != nil {
+				return err
+			}
+			if err := writeMessage(endpoint, passwordMsg, []byte(final)); err != nil {
+				return err
+			}
+
+		case authSASLFinal:
+			if scram == nil {
+				return fmt.Errorf("unexpected SASL final message")
+			}
+			if err := scram.verifyServerFinal(string(data)); err != nil {
+				return err
+			}
+
+		default:
+			return fmt.Errorf("unsupported authentication method %d", code)
+		}
+	}
+}
+
+// md5Password returns the response to an MD5 password authentication request.
+func md5Password(username, password string, salt []byte) string {
+	inner := md5.Sum([]byte(password + username))
+	outer := md5.Sum(append([]byte(hex.EncodeToString(inner[:])), salt...))
+	return "md5" + hex.EncodeToString(outer[:])
+}
+
+// supportsScram reports whether the list of SASL mechanisms sent by the
+// endpoint includes SCRAM-SHA-256.
+func supportsScram(mechanisms []byte) bool {
+	for len(mechanisms) > 0 && mechanisms[0] != 0 {
+		var m string
+		var err error
+		if m, mechanisms, err = readCString(mechanisms); err != nil {
+			return false
+		}
+		if m == scramSha256 {
+			return true
+		}
+	}
+	return false
+}
+
+// scramClient implements the client side of a SCRAM-SHA-256 exchange as
+// described in RFC 5802 and RFC 7677, without channel binding.
+type scramClient struct {
+	password    string
+	clientNonce string
+
+	clientFirstBare string
+	authMessage     string
+	saltedPassword  []byte
+}
+
+func newScramClient(password string) (*scramClient, error) {
+	nonce := make([]byte, 18)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	c := &scramClient{
+		password:    password,
+		clientNonce: base64.StdEncoding.EncodeToString(nonce),
+	}
+	// The username is ignored by the PostgreSQL server, which uses the one in
+	// the startup message.
+	c.clientFirstBare = "n=,r=" + c.clientNonce
+	return c, nil
+}
+
+// clientFirst returns the client-first-message.
+func (c *scramClient) clientFirst() string {
+	return "n,," + c.clientFirstBare
+}
+
+// clientFinal returns the client-final-message in response to the
+// server-first-message.
+func (c *scramClient) clientFinal(serverFirst string) (string, error) {
+	var nonce, salt string
+	var iterations int
+	for _, attr := range strings.Split(serverFirst, ",") {
+		k, v, ok := strings.Cut(attr, "=")
+		if !ok {
+			return "", fmt.Errorf("invalid SCRAM server-first-message")
+		}
+		switch k {
+		case "r":
+			nonce = v
+		case "s":
+			salt = v
+		case "i":
+			var err error
+			if iterations, err = strconv.Atoi(v); err != nil {
+				return "", fmt.Errorf("invalid SCRAM iteration count: %w", err)
+			}
+		}
+	}
+	switch {
+	case !strings.HasPrefix(nonce, c.clientNonce) || len(nonce) == len(c.clientNonce):
+		return "", fmt.Errorf("invalid SCRAM server nonce")
+	case iterations <= 0:
+		return "", fmt.Errorf("invalid SCRAM iteration count")
+	}
+	decodedSalt, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil {
+		return "", fmt.Errorf("invalid SCRAM salt: %w", err)
+	}
+
+	c.saltedPassword = pbkdf2.Key([]byte(c.password), decodedSalt, iterations, sha256.Size, sha256.New)
+	withoutProof := "c=biws,r=" + nonce
+	c.authMessage = c.clientFirstBare + "," + serverFirst + "," + withoutProof
+
+	clientKey := computeHmac(c.saltedPassword, "Client Key")
+	storedKey := sha256.Sum256(clientKey)
+	signature := computeHmac(storedKey[:], c.authMessage)
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ signature[i]
+	}
+	return withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof), nil
+}
+
+// verifyServerFinal verifies the server signature in the server-final-message.
+func (c *scramClient) verifyServerFinal(serverFinal string) error {
+	if e, ok := strings.CutPrefix(serverFinal, "e="); ok {
+		return fmt.Errorf("SCRAM authentication failed: %s", e)
+	}
+	v, ok := strings.CutPrefix(serverFinal, "v=")
+	if !ok {
+		return fmt.Errorf("invalid SCRAM server-final-message")
+	}
+	signature, err := base64.StdEncoding.DecodeString(v)
+	if err != nil {
+		return fmt.Errorf("invalid SCRAM server signature: %w", err)
+	}
+	serverKey := computeHmac(c.saltedPassword, "Server Key")
+	if !hmac.Equal(signature, computeHmac(serverKey, c.authMessage)) {
+		return fmt.Errorf("invalid SCRAM server signature")
+	}
+	return nil
+}
+
+func computeHmac(key []byte, msg string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(msg))
+	return h.Sum(nil)
+}
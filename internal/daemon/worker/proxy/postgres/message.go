@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	// protocolVersion is the version 3.0 of the PostgreSQL wire protocol,
+	// the only one supported by the proxy.
+	protocolVersion = 196608
+
+	sslRequestCode    = 80877103
+	gssEncRequestCode = 80877104
+	cancelRequestCode = 80877102
+
+	// maxStartupLength is the maximum length of a startup packet, the same
+	// limit used by the PostgreSQL server.
+	maxStartupLength = 10000
+	// maxAuthMessageLength is the maximum length of the messages received
+	// from the endpoint during authentication.
+	maxAuthMessageLength = 1 << 20
+)
+
+// Message types of the PostgreSQL wire protocol used by the proxy.
+const (
+	// Messages sent by the endpoint.
+	authenticationMsg           byte = 'R'
+	errorResponseMsg            byte = 'E'
+	negotiateProtocolVersionMsg byte = 'v'
+
+	// Messages sent by the client.
+	passwordMsg  byte = 'p'
+	queryMsg     byte = 'Q'
+	parseMsg     byte = 'P'
+	bindMsg      byte = 'B'
+	executeMsg   byte = 'E'
+	closeMsg     byte = 'C'
+	terminateMsg byte = 'X'
+)
+
+// Authentication request codes sent by the endpoint in an authentication
+// message.
+const (
+	authOk                = 0
+	authCleartextPassword = 3
+	authMD5Password       = 5
+	authSASL              = 10
+	authSASLContinue      = 11
+	authSASLFinal         = 12
+)
+
+// readStartup reads an untyped startup packet and returns its request code
+// and the remainder of its body.
+func readStartup(r io.Reader) (uint32, []byte, error) {
+	var header [8]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, nil, err
+	}
+	l := binary.BigEndian.Uint32(header[:4])
+	if l < 8 || l > maxStartupLength {
+		return 0, nil, fmt.Errorf("invalid startup packet length %d", l)
+	}
+	body := make([]byte, l-8)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return binary.BigEndian.Uint32(header[4:]), body, nil
+}
+
+// startupParam is a parameter of a startup message.
+type startupParam struct {
+	name  string
+	value string
+}
+
+// parseStartupParams parses the parameters of a startup message body.
+func parseStartupParams(body []byte) ([]startupParam, error) {
+	var params []startupParam
+	for len(body) > 0 && body[0] != 0 {
+		var p startupParam
+		var err error
+		if p.name, body, err = readCString(body); err != nil {
+			return nil, err
+		}
+		if p.value, body, err = readCString(body); err != nil {
+			return nil, err
+		}
+		params = append(params, p)
+	}
+	if len(body) != 1 {
+		return nil, fmt.Errorf("startup message parameters are not terminated")
+	}
+	return params, nil
+}
+
+// encodeStartup encodes a version 3.0 startup message with the provided
+// parameters.
+func encodeStartup(params []startupParam) []byte {
+	var b bytes.Buffer
+	for _, p := range params {
+		writeCString(&b, p.name)
+		writeCString(&b, p.value)
+	}
+	b.WriteByte(0)
+	return encodeStartupPacket(protocolVersion, b.Bytes())
+}
+
+// encodeStartupPacket encodes an untyped startup packet with the provided
+// request code and body.
+func encodeStartupPacket(code uint32, body []byte) []byte {
+	buf := make([]byte, 0, 8+len(body))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(body)+8))
+	buf = binary.BigEndian.AppendUint32(buf, code)
+	return append(buf, body...)
+}
+
+// readMessage reads a typed message and returns its type and body. An error
+// is returned if the body is longer than maxLength.
+func readMessage(r io.Reader, maxLength uint32) (byte, []byte, error) {
+	typ, l, err := readMessageHeader(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if l > maxLength {
+		return 0, nil, fmt.Errorf("message %q of length %d is too long", typ, l)
+	}
+	body := make([]byte, l)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return typ, body, nil
+}
+
+// readMessageHeader reads the header of a typed message and returns the type
+// and the length of the body that follows it.
+func readMessageHeader(r io.Reader) (byte, uint32, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, 0, err
+	}
+	l := binary.BigEndian.Uint32(header[1:])
+	if l < 4 {
+		return 0, 0, fmt.Errorf("invalid length %d for message %q", l, header[0])
+	}
+	return header[0], l - 4, nil
+}
+
+// encodeMessage encodes a typed message.
+func encodeMessage(typ byte, body []byte) []byte {
+	buf := make([]byte, 0, 5+len(body))
+	buf = append(buf, encodeMessageHeader(typ, uint32(len(body)))...)
+	return append(buf, body...)
+}
+
+// encodeMessageHeader encodes the header of a typed message with a body of
+// the provided length.
+func encodeMessageHeader(typ byte, length uint32) []byte {
+	return binary.BigEndian.AppendUint32([]byte{typ}, length+4)
+}
+
+// writeMessage writes a typed message to w.
+func writeMessage(w io.Writer, typ byte, body []byte) error {
+	_, err := w.Write(encodeMessage(typ, body))
+	return err
+}
+
+// readCString reads a null terminated string from b and returns it along with
+// the remainder of b.
+func readCString(b []byte) (string, []byte, error) {
+	i := bytes.IndexByte(b, 0)
+	if i < 0 {
+		return "", nil, fmt.Errorf("string is not null terminated")
+	}
+	return string(b[:i]), b[i+1:], nil
+}
+
+func writeCString(b *bytes.Buffer, s string) {
+	b.WriteString(s)
+	b.WriteByte(0)
+}
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package postgres provides a proxy handler for postgres targets. The handler
+// terminates the startup and authentication phases of the PostgreSQL wire
+// protocol on the worker and authenticates to the endpoint using the username
+// password credential provided by the controller, so the credential is never
+// exposed to the client. Every query sent by the client, using either the
+// simple or the extended query protocol, is emitted as an observation event.
+// The worker always connects to the endpoint using TLS and verifies the
+// certificate of the endpoint before authenticating.
+package postgres
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// maxAuditedMessageLength is the maximum number of bytes of a client message
+// which are read into memory to be inspected by the auditor. The remainder of
+// a longer message is streamed to the endpoint, and the query of its
+// observation event is truncated.
+const maxAuditedMessageLength = 64 << 10
+
+func init() {
+	err := proxy.RegisterHandler(proxy.PostgresHandlerName, handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy dials the endpoint using the ProxyDialer. The provided protocol
+// context must be a PostgresProtocolContext containing a username password
+// credential and the settings used to verify the TLS certificate of the
+// endpoint.
+//
+// handleProxy returns a ProxyConnFn which performs the startup of the
+// PostgreSQL protocol with the client on the incoming conn, authenticates to
+// the endpoint as the injected credential's user and then proxies the
+// connection, emitting an observation event for every query. It blocks until
+// either side closes the connection.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, _ proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "postgres.HandleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+
+	pgCtx := &serverpb.PostgresProtocolContext{}
+	if err := pc.UnmarshalTo(pgCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal postgres protocol context"))
+	}
+	cred, err := usernamePassword(controlCtx, pgCtx.GetInjectedCredentials())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	// The injected credential is only sent to an endpoint whose certificate
+	// has been verified.
+	tlsConfig, err := proxy.EndpointTlsConfig(pgCtx.GetEndpoint(), pgCtx.GetTls())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+
+	remoteConn, err := out.Dial(controlCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		defer func() {
+			_ = remoteConn.Close()
+			_ = conn.Close()
+		}()
+
+		client := bufio.NewReader(conn)
+		code, body, err := readClientStartup(client, conn)
+		if err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error reading startup message from client", "connection_id", connId))
+			return
+		}
+		if code == cancelRequestCode {
+			// Cancel requests are sent on a new connection and contain the
+			// secret key the endpoint provided for the connection to cancel,
+			// so they are forwarded as is.
+			if _, err := remoteConn.Write(encodeStartupPacket(code, body)); err != nil {
+				event.WriteError(dataCtx, op, err, event.WithInfoMsg("error forwarding cancel request", "connection_id", connId))
+			}
+			return
+		}
+		params, err := parseStartupParams(body)
+		if err != nil {
+			_, _ = conn.Write(errorResponse("08P01", "invalid startup message"))
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error parsing startup message from client", "connection_id", connId))
+			return
+		}
+
+		auditor := &queryAuditor{
+			sessionId:    pgCtx.GetSessionId(),
+			connectionId: connId,
+			userId:       pgCtx.GetUserId(),
+			targetId:     pgCtx.GetTargetId(),
+			databaseUser: cred.GetUsername(),
+			statements:   make(map[string]statement),
+			portals:      make(map[string]string),
+		}
+		params = setStartupParam(params, "user", cred.GetUsername())
+		for _, p := range params {
+			if p.name == "database" {
+				auditor.database = p.value
+			}
+		}
+		if auditor.database == "" {
+			// The endpoint defaults the database to the user name.
+			auditor.database = cred.GetUsername()
+		}
+
+		endpoint, err := negotiateTLS(remoteConn, tlsConfig)
+		if err != nil {
+			_, _ = conn.Write(errorResponse("08006", "unable to connect to endpoint"))
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error negotiating tls with endpoint", "connection_id", connId))
+			return
+		}
+		if _, err := endpoint.Write(encodeStartup(params)); err != nil {
+			_, _ = conn.Write(errorResponse("08006", "unable to connect to endpoint"))
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error sending startup message to endpoint", "connection_id", connId))
+			return
+		}
+		if err := authenticate(endpoint, conn, cred.GetUsername(), cred.GetPassword()); err != nil {
+			var epErr *endpointError
+			if stderrors.As(err, &epErr) {
+				_, _ = conn.Write(epErr.msg)
+			} else {
+				_, _ = conn.Write(errorResponse("28000", "unable to authenticate to endpoint"))
+			}
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error authenticating to endpoint", "connection_id", connId))
+			return
+		}
+		// The client is already authorized for the session, so it is only
+		// told that authentication succeeded. The rest of the startup
+		// messages are sent by the endpoint.
+		if err := writeMessage(conn, authenticationMsg, []byte{0, 0, 0, authOk}); err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error sending authentication ok to client", "connection_id", connId))
+			return
+		}
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_, _ = io.Copy(conn, endpoint)
+			_ = conn.Close()
+		}()
+		if err := relayClient(dataCtx, client, endpoint, auditor); err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error proxying client messages", "connection_id", connId))
+		}
+		_ = endpoint.Close()
+		<-done
+	}, nil
+}
+
+// usernamePassword returns the first username password credential.
+func usernamePassword(ctx context.Context, creds []*serverpb.Credential) (*serverpb.UsernamePassword, error) {
+	const op = "postgres.usernamePassword"
+	for _, c := range creds {
+		if up := c.GetUsernamePassword(); up != nil {
+			if up.GetUsername() == "" {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, "injected credential is missing a username")
+			}
+			return up, nil
+		}
+	}
+	return nil, errors.New(ctx, errors.InvalidParameter, op, "no username password credential provided")
+}
+
+// readClientStartup reads the startup packets sent by the client until it
+// receives either a startup message or a cancel request. Requests for SSL or
+// GSSAPI encryption are declined, since the connection between the client and
+// the worker is already encrypted.
+func readClientStartup(r io.Reader, w io.Writer) (uint32, []byte, error) {
+	for {
+		code, body, err := readStartup(r)
+		if err != nil {
+			return 0, nil, err
+		}
+		switch code {
+		case sslRequestCode, gssEncRequestCode:
+			if _, err := w.Write([]byte{'N'}); err != nil {
+				return 0, nil, err
+			}
+		case cancelRequestCode, protocolVersion:
+			return code, body, nil
+		default:
+			_, _ = w.Write(errorResponse("0A000", "unsupported frontend protocol"))
+			return 0, nil, fmt.Errorf("unsupported frontend protocol %d", code)
+		}
+	}
+}
+
+// setStartupParam sets the value of the named parameter, adding it if it is not
+// present.
+func setStartupParam(params []startupParam, name, value string) []startupParam {
+	for i := range params {
+		if params[i].name == name {
+			params[i].value = value
+			return params
+		}
+	}
+	return append(params, startupParam{name: name, value: value})
+}
+
+// negotiateTLS requests a TLS connection to the endpoint and verifies the
+// certificate of the endpoint using cfg, like the "verify-full" and
+// "verify-ca" SSL modes of libpq. An error is returned if the endpoint does
+// not support TLS, since the injected credential must never be sent over an
+// unverified connection.
+func negotiateTLS(conn net.Conn, cfg *tls.Config) (net.Conn, error) {
+	if _, err := conn.Write(encodeStartupPacket(sslRequestCode, nil)); err != nil {
+		return nil, err
+	}
+	var resp [1]byte
+	if _, err := io.ReadFull(conn, resp[:]); err != nil {
+		return nil, err
+	}
+	switch resp[0] {
+	case 'S':
+		tlsConn := tls.Client(conn, cfg)
+		if err := tlsConn.Handshake(); err != nil {
+			return nil, err
+		}
+		return tlsConn, nil
+	case 'N':
+		return nil, fmt.Errorf("endpoint does not support tls")
+	default:
+		return nil, fmt.Errorf("unexpected response %q to ssl request", resp[0])
+	}
+}
+
+// relayClient forwards the messages sent by the client to the endpoint until
+// the client terminates the connection. At most maxAuditedMessageLength bytes
+// of the messages which run queries are passed to the auditor, all other
+// messages and the remainder of long messages are streamed to the endpoint.
+func relayClient(ctx context.Context, client io.Reader, endpoint io.Writer, auditor *queryAuditor) error {
+	for {
+		typ, l, err := readMessageHeader(client)
+		switch {
+		case stderrors.Is(err, io.EOF), stderrors.Is(err, net.ErrClosed):
+			return nil
+		case err != nil:
+			return err
+		}
+
+		if _, err := endpoint.Write(encodeMessageHeader(typ, l)); err != nil {
+			return err
+		}
+		remaining := l
+		switch typ {
+		case queryMsg, parseMsg, bindMsg, executeMsg, closeMsg:
+			body := make([]byte, min(l, maxAuditedMessageLength))
+			if _, err := io.ReadFull(client, body); err != nil {
+				return err
+			}
+			if err := auditor.observe(ctx, typ, body, len(body) < int(l)); err != nil {
+				return err
+			}
+			if _, err := endpoint.Write(body); err != nil {
+				return err
+			}
+			remaining -= uint32(len(body))
+		}
+		if _, err := io.CopyN(endpoint, client, int64(remaining)); err != nil {
+			return err
+		}
+
+		if typ == terminateMsg {
+			return nil
+		}
+	}
+}
+
+// errorResponse encodes a fatal ErrorResponse message with the provided
+// SQLSTATE code and message.
+func errorResponse(code, msg string) []byte {
+	var b bytes.Buffer
+	for _, f := range []struct {
+		typ   byte
+		value string
+	}{
+		{'S', "FATAL"},
+		{'V', "FATAL"},
+		{'C', code},
+		{'M', msg},
+	} {
+		b.WriteByte(f.typ)
+		writeCString(&b, f.value)
+	}
+	b.WriteByte(0)
+	return encodeMessage(errorResponseMsg, b.Bytes())
+}
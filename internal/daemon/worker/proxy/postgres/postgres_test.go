@@ -0,0 +1,444 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/event"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/pbkdf2"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testMaxMessageLength is the maximum length of the messages read by the test
+// endpoint.
+const testMaxMessageLength = 1 << 20
+
+// testEndpointTls returns the PEM encoded certificate authority and the tls
+// configuration of a test endpoint, whose certificate is valid for 127.0.0.1
+// and db.example.com.
+func testEndpointTls(t *testing.T) (string, *tls.Config) {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDer)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "db.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"db.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	caPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDer})
+	return string(caPem), &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	}
+}
+
+// testEndpoint starts a minimal postgres server which authenticates the user
+// "user" with the provided password using the given authentication method.
+// It accepts ssl requests using serverTls, or declines them if it is nil.
+// It answers simple queries and sync messages and sends the startup
+// parameters it received on the returned channel.
+func testEndpoint(t *testing.T, method uint32, password string, serverTls *tls.Config) (net.Addr, <-chan []startupParam) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	startups := make(chan []startupParam, 10)
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer c.Close()
+				var body []byte
+				for {
+					var code uint32
+					code, body, err = readStartup(c)
+					if err != nil {
+						return
+					}
+					if code != sslRequestCode {
+						break
+					}
+					if serverTls == nil {
+						_, _ = c.Write([]byte{'N'})
+						continue
+					}
+					_, _ = c.Write([]byte{'S'})
+					tlsConn := tls.Server(c, serverTls)
+					if err := tlsConn.Handshake(); err != nil {
+						return
+					}
+					c = tlsConn
+				}
+				params, err := parseStartupParams(body)
+				if err != nil {
+					return
+				}
+				startups <- params
+				var user string
+				for _, p := range params {
+					if p.name == "user" {
+						user = p.value
+					}
+				}
+				if user != "user" || !testEndpointAuth(c, method, user, password) {
+					_, _ = c.Write(errorResponse("28P01", "password authentication failed"))
+					return
+				}
+				_ = writeMessage(c, authenticationMsg, []byte{0, 0, 0, authOk})
+				_ = writeMessage(c, 'Z', []byte{'I'})
+				for {
+					typ, body, err := readMessage(c, testMaxMessageLength)
+					if err != nil {
+						return
+					}
+					switch typ {
+					case queryMsg:
+						q, _, _ := readCString(body)
+						_ = writeMessage(c, 'C', append([]byte(strings.ToUpper(q)), 0))
+						_ = writeMessage(c, 'Z', []byte{'I'})
+					case 'S':
+						_ = writeMessage(c, 'Z', []byte{'I'})
+					case terminateMsg:
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return l.Addr(), startups
+}
+
+func testEndpointAuth(c net.Conn, method uint32, user, password string) bool {
+	request := func(code uint32, data []byte) {
+		_ = writeMessage(c, authenticationMsg, append(binary.BigEndian.AppendUint32(nil, code), data...))
+	}
+	response := func() []byte {
+		typ, body, err := readMessage(c, maxAuthMessageLength)
+		if err != nil || typ != passwordMsg {
+			return nil
+		}
+		return body
+	}
+	switch method {
+	case authCleartextPassword:
+		request(method, nil)
+		return bytes.Equal(response(), append([]byte(password), 0))
+
+	case authMD5Password:
+		salt := []byte{1, 2, 3, 4}
+		request(method, salt)
+		return bytes.Equal(response(), append([]byte(md5Password(user, password, salt)), 0))
+
+	case authSASL:
+		request(method, []byte(scramSha256+"\x00\x00"))
+		mechanism, rest, err := readCString(response())
+		if err != nil || mechanism != scramSha256 || len(rest) < 4 {
+			return false
+		}
+		clientFirstBare, ok := strings.CutPrefix(string(rest[4:]), "n,,")
+		if !ok {
+			return false
+		}
+		_, clientNonce, _ := strings.Cut(clientFirstBare, "r=")
+		salt := []byte("0123456789abcdef")
+		serverFirst := "r=" + clientNonce + "server,s=" + base64.StdEncoding.EncodeToString(salt) + ",i=4096"
+		request(authSASLContinue, []byte(serverFirst))
+
+		clientFinal := string(response())
+		withoutProof, proof, ok := strings.Cut(clientFinal, ",p=")
+		if !ok {
+			return false
+		}
+		decodedProof, err := base64.StdEncoding.DecodeString(proof)
+		if err != nil || len(decodedProof) != sha256.Size {
+			return false
+		}
+		saltedPassword := pbkdf2.Key([]byte(password), salt, 4096, sha256.Size, sha256.New)
+		authMessage := clientFirstBare + "," + serverFirst + "," + withoutProof
+		storedKey := sha256.Sum256(computeHmac(saltedPassword, "Client Key"))
+		signature := computeHmac(storedKey[:], authMessage)
+		clientKey := make([]byte, sha256.Size)
+		for i := range clientKey {
+			clientKey[i] = decodedProof[i] ^ signature[i]
+		}
+		if sha256.Sum256(clientKey) != storedKey {
+			return false
+		}
+		serverSignature := computeHmac(computeHmac(saltedPassword, "Server Key"), authMessage)
+		request(authSASLFinal, []byte("v="+base64.StdEncoding.EncodeToString(serverSignature)))
+		return true
+	}
+	return false
+}
+
+// testConnPair returns both ends of a tcp connection.
+func testConnPair(t *testing.T) (net.Conn, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	accepted := make(chan net.Conn)
+	go func() {
+		c, _ := l.Accept()
+		accepted <- c
+	}()
+	c1, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	c2 := <-accepted
+	require.NotNil(t, c2)
+	t.Cleanup(func() {
+		_ = c1.Close()
+		_ = c2.Close()
+	})
+	return c1, c2
+}
+
+func testProtocolContext(t *testing.T, settings *serverpb.EndpointTls, creds ...*serverpb.Credential) *anypb.Any {
+	t.Helper()
+	pc, err := anypb.New(&serverpb.PostgresProtocolContext{
+		InjectedCredentials: creds,
+		SessionId:           "s_1234567890",
+		UserId:              "u_1234567890",
+		TargetId:            "tpg_1234567890",
+		Endpoint:            "postgres://127.0.0.1:5432",
+		Tls:                 settings,
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func testCredential(username, password string) *serverpb.Credential {
+	return &serverpb.Credential{
+		Credential: &serverpb.Credential_UsernamePassword{
+			UsernamePassword: &serverpb.UsernamePassword{Username: username, Password: password},
+		},
+	}
+}
+
+// readUntilReady reads messages from the proxy until a ReadyForQuery message
+// and returns the types of the messages read.
+func readUntilReady(t *testing.T, r io.Reader) []byte {
+	t.Helper()
+	var types []byte
+	for {
+		typ, _, err := readMessage(r, maxAuthMessageLength)
+		require.NoError(t, err)
+		types = append(types, typ)
+		if typ == 'Z' || typ == errorResponseMsg {
+			return types
+		}
+	}
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+	c := event.TestEventerConfig(t, "TestHandleProxy", event.TestWithObservationSink(t))
+	testLock := &sync.Mutex{}
+	testLogger := hclog.New(&hclog.LoggerOptions{
+		Mutex: testLock,
+		Name:  "test",
+	})
+	require.NoError(t, event.InitSysEventer(testLogger, testLock, "TestHandleProxy", event.WithEventerConfig(&c.EventerConfig)))
+	t.Cleanup(func() { event.TestResetSystEventer(t) })
+
+	caCert, serverTls := testEndpointTls(t)
+	otherCaCert, _ := testEndpointTls(t)
+	tests := []struct {
+		name       string
+		method     uint32
+		password   string
+		settings   *serverpb.EndpointTls
+		noTls      bool
+		wantErr    bool
+		wantNoAuth bool
+	}{
+		{name: "cleartext", method: authCleartextPassword, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert}},
+		{name: "md5", method: authMD5Password, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert}},
+		{name: "scram", method: authSASL, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert}},
+		{name: "server name", method: authSASL, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert, ServerName: "db.example.com"}},
+		{name: "verify ca", method: authSASL, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert, ServerName: "other.example.com", SkipServerNameVerification: true}},
+		{name: "wrong password", method: authSASL, password: "wrong", settings: &serverpb.EndpointTls{CaCert: caCert}, wantErr: true},
+		{name: "wrong server name", method: authCleartextPassword, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert, ServerName: "other.example.com"}, wantErr: true, wantNoAuth: true},
+		{name: "unknown ca", method: authCleartextPassword, password: "secret", settings: &serverpb.EndpointTls{CaCert: otherCaCert}, wantErr: true, wantNoAuth: true},
+		{name: "unknown ca verify ca", method: authCleartextPassword, password: "secret", settings: &serverpb.EndpointTls{CaCert: otherCaCert, SkipServerNameVerification: true}, wantErr: true, wantNoAuth: true},
+		{name: "endpoint without tls", method: authCleartextPassword, password: "secret", settings: &serverpb.EndpointTls{CaCert: caCert}, noTls: true, wantErr: true, wantNoAuth: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			require.NoError(os.WriteFile(c.ObservationEvents.Name(), nil, 0o666))
+			endpointTls := serverTls
+			if tt.noTls {
+				endpointTls = nil
+			}
+			addr, startups := testEndpoint(t, tt.method, "secret", endpointTls)
+			dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+				return net.Dial("tcp", addr.String())
+			})
+			require.NoError(err)
+
+			clientConn, workerConn := testConnPair(t)
+			fn, err := handleProxy(ctx, ctx, nil, workerConn, dialer, "conn_1234567890", testProtocolContext(t, tt.settings, testCredential("user", tt.password)), nil)
+			require.NoError(err)
+			require.NotNil(fn)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				fn()
+			}()
+
+			client := bufio.NewReader(clientConn)
+			_, err = clientConn.Write(encodeStartupPacket(sslRequestCode, nil))
+			require.NoError(err)
+			resp, err := client.ReadByte()
+			require.NoError(err)
+			assert.Equal(byte('N'), resp)
+			_, err = clientConn.Write(encodeStartup([]startupParam{{"user", "anyone"}, {"database", "db"}}))
+			require.NoError(err)
+
+			got := readUntilReady(t, client)
+			if tt.wantNoAuth {
+				// The startup message and the credential are never sent to
+				// an unverified endpoint.
+				assert.Equal([]byte{errorResponseMsg}, got)
+				<-done
+				assert.Empty(startups)
+				return
+			}
+			assert.Equal([]startupParam{{"user", "user"}, {"database", "db"}}, <-startups)
+			if tt.wantErr {
+				assert.Equal([]byte{errorResponseMsg}, got)
+				<-done
+				return
+			}
+			assert.Equal([]byte{authenticationMsg, 'Z'}, got)
+
+			require.NoError(writeMessage(clientConn, queryMsg, []byte("select 1\x00")))
+			assert.Equal([]byte{'C', 'Z'}, readUntilReady(t, client))
+
+			var extended bytes.Buffer
+			extended.Write(encodeMessage(parseMsg, []byte("stmt\x00select $1\x00\x00\x00")))
+			extended.Write(encodeMessage(bindMsg, []byte("\x00stmt\x00\x00\x00\x00\x00\x00\x00")))
+			extended.Write(encodeMessage(executeMsg, []byte("\x00\x00\x00\x00\x00")))
+			extended.Write(encodeMessage('S', nil))
+			_, err = clientConn.Write(extended.Bytes())
+			require.NoError(err)
+			assert.Equal([]byte{'Z'}, readUntilReady(t, client))
+
+			// Long queries are streamed to the endpoint and truncated in the
+			// observation event.
+			long := "select '" + strings.Repeat("a", maxAuditedMessageLength) + "'"
+			require.NoError(writeMessage(clientConn, queryMsg, append([]byte(long), 0)))
+			typ, body, err := readMessage(client, testMaxMessageLength)
+			require.NoError(err)
+			assert.Equal(byte('C'), typ)
+			assert.Equal(strings.ToUpper(long)+"\x00", string(body))
+			assert.Equal([]byte{'Z'}, readUntilReady(t, client))
+
+			require.NoError(writeMessage(clientConn, terminateMsg, nil))
+			<-done
+
+			b, err := os.ReadFile(c.ObservationEvents.Name())
+			require.NoError(err)
+			events := string(b)
+			assert.Contains(events, `"query":"select 1"`)
+			assert.Contains(events, `"query_type":"simple"`)
+			assert.Contains(events, `"query":"select $1"`)
+			assert.Contains(events, `"query_type":"extended"`)
+			assert.Contains(events, `"session_id":"s_1234567890"`)
+			assert.Contains(events, `"database":"db"`)
+			assert.Contains(events, `"database_user":"user"`)
+			assert.Contains(events, `"query":"`+long[:maxAuditedMessageLength]+`"`)
+			assert.Contains(events, `"query_truncated":true`)
+		})
+	}
+}
+
+func TestHandleProxy_Validation(t *testing.T) {
+	ctx := context.Background()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return nil, assert.AnError
+	})
+	require.NoError(t, err)
+	_, workerConn := testConnPair(t)
+	caCert, _ := testEndpointTls(t)
+	settings := &serverpb.EndpointTls{CaCert: caCert}
+
+	tests := []struct {
+		name            string
+		conn            net.Conn
+		dialer          *proxy.ProxyDialer
+		connId          string
+		pc              *anypb.Any
+		wantErrContains string
+	}{
+		{name: "missing conn", dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, settings), wantErrContains: "conn is nil"},
+		{name: "missing dialer", conn: workerConn, connId: "conn_1234567890", pc: testProtocolContext(t, settings), wantErrContains: "proxy dialer is nil"},
+		{name: "missing connection id", conn: workerConn, dialer: dialer, pc: testProtocolContext(t, settings), wantErrContains: "connection id is empty"},
+		{name: "missing protocol context", conn: workerConn, dialer: dialer, connId: "conn_1234567890", wantErrContains: "protocol context is nil"},
+		{name: "missing credential", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, settings), wantErrContains: "no username password credential provided"},
+		{name: "missing username", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, settings, testCredential("", "secret")), wantErrContains: "injected credential is missing a username"},
+		{name: "missing tls settings", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, nil, testCredential("user", "secret")), wantErrContains: "missing endpoint tls settings"},
+		{name: "invalid ca cert", conn: workerConn, dialer: dialer, connId: "conn_1234567890", pc: testProtocolContext(t, &serverpb.EndpointTls{CaCert: "invalid"}, testCredential("user", "secret")), wantErrContains: "ca cert does not contain a valid PEM encoded certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := handleProxy(ctx, ctx, nil, tt.conn, tt.dialer, tt.connId, tt.pc, nil)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErrContains)
+			assert.Nil(t, fn)
+		})
+	}
+}
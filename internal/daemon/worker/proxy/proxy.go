@@ -9,12 +9,16 @@ import (
 	"net"
 	"sync"
 
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 var (
-	TcpHandlerName = "tcp"
+	TcpHandlerName      = "tcp"
+	SshHandlerName      = "ssh"
+	PostgresHandlerName = "postgres"
+	HttpHandlerName     = "http"
 
 	// handlers is the map of registered handlers
 	handlers *sync.Map = new(sync.Map)
@@ -28,7 +32,7 @@ var (
 	// GetHandler returns the handler registered for the provided worker and
 	// protocolContext. If a protocol cannot be determined or the protocol is
 	// not registered nil, ErrUnknownProtocol is returned.
-	GetHandler = tcpOnly
+	GetHandler = protocolContextHandler
 )
 
 // RecordingManager allows a handler for a protocol that supports recording.
@@ -56,9 +60,24 @@ func RegisterHandler(protocol string, handler Handler) error {
 	return nil
 }
 
-// tcpOnly returns only the TCP protocol.
-func tcpOnly(string, proto.Message) (Handler, error) {
-	handler, ok := handlers.Load(TcpHandlerName)
+// protocolContextHandler returns the handler for the protocol described by the
+// provided protocol context. Connections without a protocol context are
+// proxied as TCP.
+func protocolContextHandler(_ string, pc proto.Message) (Handler, error) {
+	protocol := TcpHandlerName
+	if a, ok := pc.(*anypb.Any); ok && a != nil {
+		switch {
+		case a.MessageIs((*serverpb.SshProtocolContext)(nil)):
+			protocol = SshHandlerName
+		case a.MessageIs((*serverpb.PostgresProtocolContext)(nil)):
+			protocol = PostgresHandlerName
+		case a.MessageIs((*serverpb.HttpProtocolContext)(nil)):
+			protocol = HttpHandlerName
+		default:
+			return nil, ErrUnknownProtocol
+		}
+	}
+	handler, ok := handlers.Load(protocol)
 	if !ok {
 		return nil, ErrUnknownProtocol
 	}
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"testing"
 
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -38,7 +39,7 @@ func TestRegisterHandler(t *testing.T) {
 	require.NoError(err)
 }
 
-func TestAlwaysTcpGetHandler(t *testing.T) {
+func TestProtocolContextGetHandler(t *testing.T) {
 	assert, require := assert.New(t), require.New(t)
 	fn := func(context.Context, context.Context, DecryptFn, net.Conn, *ProxyDialer, string, *anypb.Any, RecordingManager) (ProxyConnFn, error) {
 		return nil, nil
@@ -48,12 +49,51 @@ func TestAlwaysTcpGetHandler(t *testing.T) {
 		handlers = oldHandler
 	})
 	handlers = new(sync.Map)
-	_, err := tcpOnly("wid", nil)
+	_, err := protocolContextHandler("wid", nil)
 	assert.ErrorIs(err, ErrUnknownProtocol)
 
-	require.NoError(RegisterHandler("tcp", fn))
+	require.NoError(RegisterHandler(TcpHandlerName, fn))
 
-	handler, err := tcpOnly("wid", nil)
+	handler, err := protocolContextHandler("wid", nil)
 	require.NoError(err)
 	require.NotNil(handler)
+
+	handler, err = protocolContextHandler("wid", (*anypb.Any)(nil))
+	require.NoError(err)
+	require.NotNil(handler)
+
+	sshCtx, err := anypb.New(&serverpb.SshProtocolContext{})
+	require.NoError(err)
+	_, err = protocolContextHandler("wid", sshCtx)
+	assert.ErrorIs(err, ErrUnknownProtocol)
+
+	require.NoError(RegisterHandler(SshHandlerName, fn))
+	handler, err = protocolContextHandler("wid", sshCtx)
+	require.NoError(err)
+	require.NotNil(handler)
+
+	pgCtx, err := anypb.New(&serverpb.PostgresProtocolContext{})
+	require.NoError(err)
+	_, err = protocolContextHandler("wid", pgCtx)
+	assert.ErrorIs(err, ErrUnknownProtocol)
+
+	require.NoError(RegisterHandler(PostgresHandlerName, fn))
+	handler, err = protocolContextHandler("wid", pgCtx)
+	require.NoError(err)
+	require.NotNil(handler)
+
+	httpCtx, err := anypb.New(&serverpb.HttpProtocolContext{})
+	require.NoError(err)
+	_, err = protocolContextHandler("wid", httpCtx)
+	assert.ErrorIs(err, ErrUnknownProtocol)
+
+	require.NoError(RegisterHandler(HttpHandlerName, fn))
+	handler, err = protocolContextHandler("wid", httpCtx)
+	require.NoError(err)
+	require.NotNil(handler)
+
+	unknownCtx, err := anypb.New(&serverpb.Credential{})
+	require.NoError(err)
+	_, err = protocolContextHandler("wid", unknownCtx)
+	assert.ErrorIs(err, ErrUnknownProtocol)
 }
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	"github.com/hashicorp/boundary/internal/event"
+	"golang.org/x/crypto/ssh"
+)
+
+// handleChannels opens a matching channel on dst for every new channel
+// received from the other side of the proxy and bridges the two channels. dir
+// is the direction of the channels received, bsr.Inbound when they are opened
+// by the client. When rec is not nil every channel is recorded, and channels
+// that cannot be recorded are rejected. It returns once newChans is closed.
+func handleChannels(ctx context.Context, dst ssh.Conn, newChans <-chan ssh.NewChannel, dir bsr.Direction, rec *recording.ConnectionRecorder) {
+	const op = "ssh.handleChannels"
+	for nc := range newChans {
+		var chRec *recording.ChannelRecorder
+		if rec != nil {
+			var err error
+			chRec, err = rec.NewChannelRecorder(ctx, nc.ChannelType())
+			if err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error creating channel recorder", "channel_type", nc.ChannelType()))
+				if err := nc.Reject(ssh.ConnectionFailed, "unable to record channel"); err != nil {
+					event.WriteError(ctx, op, err, event.WithInfoMsg("error rejecting channel", "channel_type", nc.ChannelType()))
+				}
+				continue
+			}
+		}
+		dstCh, dstReqs, err := dst.OpenChannel(nc.ChannelType(), nc.ExtraData())
+		if err != nil {
+			reason, msg := ssh.ConnectionFailed, err.Error()
+			if openErr, ok := err.(*ssh.OpenChannelError); ok {
+				reason, msg = openErr.Reason, openErr.Message
+			}
+			if err := nc.Reject(reason, msg); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error rejecting channel", "channel_type", nc.ChannelType()))
+			}
+			closeChannelRecorder(ctx, chRec)
+			continue
+		}
+		srcCh, srcReqs, err := nc.Accept()
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error accepting channel", "channel_type", nc.ChannelType()))
+			_ = dstCh.Close()
+			closeChannelRecorder(ctx, chRec)
+			continue
+		}
+		go func() {
+			defer closeChannelRecorder(ctx, chRec)
+			if dir == bsr.Inbound {
+				bridgeChannels(ctx, srcCh, srcReqs, dstCh, dstReqs, chRec)
+				return
+			}
+			bridgeChannels(ctx, dstCh, dstReqs, srcCh, srcReqs, chRec)
+		}()
+	}
+}
+
+func closeChannelRecorder(ctx context.Context, rec *recording.ChannelRecorder) {
+	const op = "ssh.closeChannelRecorder"
+	if rec == nil {
+		return
+	}
+	if err := rec.Close(ctx); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error closing channel recorder"))
+	}
+}
+
+// bridgeChannels copies data and requests between the client and endpoint
+// channels until both of them are closed. A channel is closed once the other
+// channel has been closed and all of its data has been copied. When rec is not
+// nil all data and requests are recorded.
+func bridgeChannels(ctx context.Context, client ssh.Channel, clientReqs <-chan *ssh.Request, endpoint ssh.Channel, endpointReqs <-chan *ssh.Request, rec *recording.ChannelRecorder) {
+	var inbound, outbound io.Writer
+	var recordInbound, recordOutbound requestRecorder
+	if rec != nil {
+		inbound, outbound = rec.NewDataWriter(ctx, bsr.Inbound), rec.NewDataWriter(ctx, bsr.Outbound)
+		recordInbound = func(r *ssh.Request) error { return rec.RecordRequest(ctx, bsr.Inbound, r) }
+		recordOutbound = func(r *ssh.Request) error { return rec.RecordRequest(ctx, bsr.Outbound, r) }
+	}
+	toEndpoint := copyChannel(endpoint, client, inbound)
+	toClient := copyChannel(client, endpoint, outbound)
+
+	wg := new(sync.WaitGroup)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		forwardRequests(ctx, endpoint, clientReqs, recordInbound)
+		<-toEndpoint
+		_ = endpoint.Close()
+	}()
+	go func() {
+		defer wg.Done()
+		forwardRequests(ctx, client, endpointReqs, recordOutbound)
+		<-toClient
+		_ = client.Close()
+	}()
+	wg.Wait()
+}
+
+// copyChannel copies the data and extended data from src to dst and signals
+// EOF on dst once src has no more data. All data is also written to rec when
+// it is not nil. The returned channel is closed once the copy has finished.
+func copyChannel(dst, src ssh.Channel, rec io.Writer) <-chan struct{} {
+	var stdout, stderr io.Reader = src, src.Stderr()
+	if rec != nil {
+		// Data is recorded before it is forwarded, so a recording failure
+		// stops the copy.
+		stdout, stderr = io.TeeReader(stdout, rec), io.TeeReader(stderr, rec)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		wg := new(sync.WaitGroup)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = io.Copy(dst.Stderr(), stderr)
+		}()
+		_, _ = io.Copy(dst, stdout)
+		wg.Wait()
+		_ = dst.CloseWrite()
+	}()
+	return done
+}
+
+// requestRecorder records a request before it is forwarded.
+type requestRecorder func(*ssh.Request) error
+
+// forwardRequests sends every channel request to dst, replying with dst's
+// response when a reply is wanted. Requests are recorded with rec when it is
+// not nil. It returns once reqs is closed.
+func forwardRequests(ctx context.Context, dst ssh.Channel, reqs <-chan *ssh.Request, rec requestRecorder) {
+	const op = "ssh.forwardRequests"
+	for req := range reqs {
+		if rec != nil {
+			if err := rec(req); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error recording channel request", "request_type", req.Type))
+				if req.WantReply {
+					_ = req.Reply(false, nil)
+				}
+				continue
+			}
+		}
+		ok, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if req.WantReply {
+			_ = req.Reply(ok && err == nil, nil)
+		}
+	}
+}
+
+// forwardGlobalRequests sends every global request to dst, replying with dst's
+// response when a reply is wanted. Requests are recorded with rec when it is
+// not nil. It returns once reqs is closed.
+func forwardGlobalRequests(ctx context.Context, dst ssh.Conn, reqs <-chan *ssh.Request, rec requestRecorder) {
+	const op = "ssh.forwardGlobalRequests"
+	for req := range reqs {
+		if rec != nil {
+			if err := rec(req); err != nil {
+				event.WriteError(ctx, op, err, event.WithInfoMsg("error recording global request", "request_type", req.Type))
+				if req.WantReply {
+					_ = req.Reply(false, nil)
+				}
+				continue
+			}
+		}
+		ok, payload, err := dst.SendRequest(req.Type, req.WantReply, req.Payload)
+		if req.WantReply {
+			_ = req.Reply(ok && err == nil, payload)
+		}
+	}
+}
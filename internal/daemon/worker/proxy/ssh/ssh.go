@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package ssh provides a proxy handler for ssh targets. The handler terminates
+// the SSH protocol from the client on the worker and authenticates to the
+// endpoint using the injected application credentials provided by the
+// controller, so the credentials are never exposed to the client. When the
+// controller requests a session recording, the channels and requests of the
+// connection are recorded into a BSR by the worker's recording manager.
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func init() {
+	err := proxy.RegisterHandler(proxy.SshHandlerName, handleProxy)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// handleProxy dials the endpoint using the ProxyDialer and authenticates to it
+// using the injected credentials in the provided protocol context, which must
+// be a SshProtocolContext.
+//
+// handleProxy returns a ProxyConnFn which performs the SSH handshake with the
+// client on the incoming conn and proxies all channels and requests between
+// the client and the endpoint. It blocks until either side closes the
+// connection.
+//
+// The host key of the endpoint is verified against the known hosts in the
+// protocol context. No connection is made to the endpoint if no known hosts
+// are provided, unless host key verification was explicitly disabled for the
+// target.
+//
+// When the protocol context contains a session recording, the connection is
+// recorded using the provided RecordingManager. The connection is refused if
+// the worker is not able to record it.
+func handleProxy(controlCtx context.Context, dataCtx context.Context, _ proxy.DecryptFn, conn net.Conn, out *proxy.ProxyDialer, connId string, pc *anypb.Any, recManager proxy.RecordingManager) (proxy.ProxyConnFn, error) {
+	const op = "ssh.HandleProxy"
+	switch {
+	case conn == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "conn is nil")
+	case out == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "proxy dialer is nil")
+	case len(connId) == 0:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "connection id is empty")
+	case pc == nil:
+		return nil, errors.New(controlCtx, errors.InvalidParameter, op, "protocol context is nil")
+	}
+
+	sshCtx := &serverpb.SshProtocolContext{}
+	if err := pc.UnmarshalTo(sshCtx); err != nil {
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to unmarshal ssh protocol context"))
+	}
+	hostKeyCallback, err := newHostKeyCallback(controlCtx, sshCtx.GetKnownHosts(), sshCtx.GetInsecureSkipHostKeyVerification())
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	clientConfig, err := newClientConfig(controlCtx, sshCtx.GetInjectedCredentials(), hostKeyCallback)
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	serverConfig, err := newServerConfig(controlCtx)
+	if err != nil {
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+
+	var rec *recording.ConnectionRecorder
+	if sr := sshCtx.GetSessionRecording(); sr != nil {
+		rm, ok := recManager.(connectionRecorderManager)
+		if !ok {
+			return nil, errors.New(controlCtx, errors.Internal, op, "session recording is not supported by this worker")
+		}
+		rec, err = rm.NewConnectionRecorder(controlCtx, sr, connId)
+		if err != nil {
+			return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to record connection"))
+		}
+	}
+
+	remoteConn, err := out.Dial(controlCtx)
+	if err != nil {
+		closeConnectionRecorder(controlCtx, rec)
+		return nil, err
+	}
+	addr, err := endpointAddress(controlCtx, sshCtx.GetEndpoint())
+	if err != nil {
+		_ = remoteConn.Close()
+		closeConnectionRecorder(controlCtx, rec)
+		return nil, errors.Wrap(controlCtx, err, op)
+	}
+	if addr == "" {
+		addr = remoteConn.RemoteAddr().String()
+	}
+	upstream, upstreamChans, upstreamReqs, err := ssh.NewClientConn(remoteConn, addr, clientConfig)
+	if err != nil {
+		_ = remoteConn.Close()
+		closeConnectionRecorder(controlCtx, rec)
+		return nil, errors.Wrap(controlCtx, err, op, errors.WithMsg("unable to establish ssh connection to endpoint"))
+	}
+
+	return func() {
+		defer func() {
+			_ = upstream.Close()
+			_ = conn.Close()
+			closeConnectionRecorder(dataCtx, rec)
+		}()
+		downstream, downstreamChans, downstreamReqs, err := ssh.NewServerConn(conn, serverConfig)
+		if err != nil {
+			event.WriteError(dataCtx, op, err, event.WithInfoMsg("error performing ssh handshake with client", "connection_id", connId))
+			return
+		}
+		defer downstream.Close()
+
+		var recordInbound, recordOutbound requestRecorder
+		if rec != nil {
+			recordInbound = func(r *ssh.Request) error { return rec.RecordRequest(dataCtx, bsr.Inbound, r) }
+			recordOutbound = func(r *ssh.Request) error { return rec.RecordRequest(dataCtx, bsr.Outbound, r) }
+		}
+		go forwardGlobalRequests(dataCtx, upstream, downstreamReqs, recordInbound)
+		go forwardGlobalRequests(dataCtx, downstream, upstreamReqs, recordOutbound)
+		go handleChannels(dataCtx, upstream, downstreamChans, bsr.Inbound, rec)
+		go handleChannels(dataCtx, downstream, upstreamChans, bsr.Outbound, rec)
+
+		go func() {
+			_ = upstream.Wait()
+			_ = downstream.Close()
+		}()
+		_ = downstream.Wait()
+	}, nil
+}
+
+// connectionRecorderManager is implemented by the RecordingManager of workers
+// which are able to record ssh connections.
+type connectionRecorderManager interface {
+	NewConnectionRecorder(context.Context, *serverpb.SessionRecording, string) (*recording.ConnectionRecorder, error)
+}
+
+func closeConnectionRecorder(ctx context.Context, rec *recording.ConnectionRecorder) {
+	const op = "ssh.closeConnectionRecorder"
+	if rec == nil {
+		return
+	}
+	if err := rec.Close(ctx); err != nil {
+		event.WriteError(ctx, op, err, event.WithInfoMsg("error closing connection recorder", "connection_recording_id", rec.Id()))
+	}
+}
+
+// newHostKeyCallback returns the callback used to verify the host key of the
+// endpoint against the provided known hosts, which are in the OpenSSH
+// known_hosts format. If no known hosts are provided an error is returned
+// unless insecure is true, in which case the host key is not verified.
+func newHostKeyCallback(ctx context.Context, knownHosts string, insecure bool) (ssh.HostKeyCallback, error) {
+	const op = "ssh.newHostKeyCallback"
+	if knownHosts == "" {
+		if !insecure {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "no known hosts provided to verify the endpoint host key")
+		}
+		// Host key verification was explicitly disabled for the target.
+		return ssh.InsecureIgnoreHostKey(), nil //nolint:gosec
+	}
+
+	// knownhosts only reads known hosts from files.
+	f, err := os.CreateTemp("", "known_hosts")
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create known hosts file"))
+	}
+	defer func() {
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.WriteString(knownHosts); err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write known hosts file"))
+	}
+	if err := f.Close(); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to write known hosts file"))
+	}
+	cb, err := knownhosts.New(f.Name())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse known hosts"))
+	}
+	return cb, nil
+}
+
+// endpointAddress returns the host and port of the provided endpoint url, which
+// is the address the host key is verified for. The ssh default port is used if
+// the endpoint does not have one. An empty string is returned if no endpoint is
+// provided.
+func endpointAddress(ctx context.Context, endpoint string) (string, error) {
+	const op = "ssh.endpointAddress"
+	if endpoint == "" {
+		return "", nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse endpoint"))
+	}
+	if u.Hostname() == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "endpoint is missing a host")
+	}
+	port := u.Port()
+	if port == "" {
+		port = "22"
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
+
+// newClientConfig creates the ssh.ClientConfig used to authenticate to the
+// endpoint. Every credential is offered as an authentication method in the
+// order they are provided and all of them must be for the same user. The host
+// key of the endpoint is verified using the provided callback.
+func newClientConfig(ctx context.Context, creds []*serverpb.Credential, hostKeyCallback ssh.HostKeyCallback) (*ssh.ClientConfig, error) {
+	const op = "ssh.newClientConfig"
+	switch {
+	case len(creds) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no injected credentials provided")
+	case hostKeyCallback == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing host key callback")
+	}
+
+	var username string
+	var passwords []string
+	var signers []ssh.Signer
+	for _, c := range creds {
+		var u string
+		switch cred := c.GetCredential().(type) {
+		case *serverpb.Credential_UsernamePassword:
+			u = cred.UsernamePassword.GetUsername()
+			passwords = append(passwords, cred.UsernamePassword.GetPassword())
+
+		case *serverpb.Credential_SshPrivateKey:
+			u = cred.SshPrivateKey.GetUsername()
+			signer, err := parsePrivateKey(cred.SshPrivateKey.GetPrivateKey(), cred.SshPrivateKey.GetPrivateKeyPassphrase())
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse ssh private key"))
+			}
+			signers = append(signers, signer)
+
+		case *serverpb.Credential_SshCertificate:
+			u = cred.SshCertificate.GetUsername()
+			signer, err := parsePrivateKey(cred.SshCertificate.GetPrivateKey(), "")
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse ssh certificate private key"))
+			}
+			pub, _, _, _, err := ssh.ParseAuthorizedKey([]byte(cred.SshCertificate.GetCertificate()))
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to parse ssh certificate"))
+			}
+			cert, ok := pub.(*ssh.Certificate)
+			if !ok {
+				return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("ssh certificate is a %s key", pub.Type()))
+			}
+			certSigner, err := ssh.NewCertSigner(cert, signer)
+			if err != nil {
+				return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create ssh certificate signer"))
+			}
+			signers = append(signers, certSigner)
+
+		default:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unsupported credential %T", cred))
+		}
+		switch {
+		case u == "":
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "injected credential is missing a username")
+		case username == "":
+			username = u
+		case username != u:
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "injected credentials must all have the same username")
+		}
+	}
+
+	var auth []ssh.AuthMethod
+	if len(signers) > 0 {
+		auth = append(auth, ssh.PublicKeys(signers...))
+	}
+	if len(passwords) > 0 {
+		i := 0
+		auth = append(auth, ssh.RetryableAuthMethod(ssh.PasswordCallback(func() (string, error) {
+			p := passwords[i%len(passwords)]
+			i++
+			return p, nil
+		}), len(passwords)))
+	}
+
+	return &ssh.ClientConfig{
+		User:            username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, nil
+}
+
+func parsePrivateKey(key, passphrase string) (ssh.Signer, error) {
+	if passphrase != "" {
+		return ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(passphrase))
+	}
+	return ssh.ParsePrivateKey([]byte(key))
+}
+
+// newServerConfig creates the ssh.ServerConfig used for the SSH handshake with
+// the client. The client has already been authorized for the session so no
+// further client authentication is performed, and the host key is generated
+// for every connection.
+func newServerConfig(ctx context.Context) (*ssh.ServerConfig, error) {
+	const op = "ssh.newServerConfig"
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to generate host key"))
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create host key signer"))
+	}
+	cfg := &ssh.ServerConfig{
+		NoClientAuth: true,
+	}
+	cfg.AddHostKey(signer)
+	return cfg, nil
+}
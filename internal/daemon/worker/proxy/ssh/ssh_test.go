@@ -0,0 +1,525 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// testEndpoint starts an ssh server which accepts the provided password and
+// public key for the user "user" and responds to exec requests by echoing the
+// command. It returns the address and the host key of the server.
+func testEndpoint(t *testing.T, password string, pub ssh.PublicKey) (net.Addr, ssh.PublicKey) {
+	t.Helper()
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	require.NoError(t, err)
+
+	cfg := &ssh.ServerConfig{
+		PasswordCallback: func(c ssh.ConnMetadata, p []byte) (*ssh.Permissions, error) {
+			if c.User() == "user" && password != "" && string(p) == password {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+		PublicKeyCallback: func(c ssh.ConnMetadata, k ssh.PublicKey) (*ssh.Permissions, error) {
+			if c.User() == "user" && pub != nil && bytes.Equal(k.Marshal(), pub.Marshal()) {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+	}
+	cfg.AddHostKey(hostSigner)
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				_, chans, reqs, err := ssh.NewServerConn(c, cfg)
+				if err != nil {
+					return
+				}
+				go ssh.DiscardRequests(reqs)
+				for nc := range chans {
+					ch, chReqs, err := nc.Accept()
+					if err != nil {
+						continue
+					}
+					go func() {
+						for req := range chReqs {
+							if req.Type != "exec" {
+								_ = req.Reply(false, nil)
+								continue
+							}
+							_ = req.Reply(true, nil)
+							var cmd struct{ Command string }
+							_ = ssh.Unmarshal(req.Payload, &cmd)
+							_, _ = ch.Write([]byte("ran: " + cmd.Command))
+							_, _ = ch.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+							_ = ch.Close()
+						}
+					}()
+				}
+			}()
+		}
+	}()
+	return l.Addr(), hostSigner.PublicKey()
+}
+
+// testConnPair returns both ends of a tcp connection. A net.Pipe can't be used
+// since both sides of an ssh handshake write before reading.
+func testConnPair(t *testing.T) (net.Conn, net.Conn) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	accepted := make(chan net.Conn)
+	go func() {
+		c, _ := l.Accept()
+		accepted <- c
+	}()
+	c1, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	c2 := <-accepted
+	require.NotNil(t, c2)
+	t.Cleanup(func() {
+		_ = c1.Close()
+		_ = c2.Close()
+	})
+	return c1, c2
+}
+
+// testProtocolContext returns a protocol context with the provided credentials
+// which does not verify the host key of the endpoint.
+func testProtocolContext(t *testing.T, creds ...*serverpb.Credential) *anypb.Any {
+	t.Helper()
+	pc, err := anypb.New(&serverpb.SshProtocolContext{
+		InjectedCredentials:             creds,
+		InsecureSkipHostKeyVerification: true,
+	})
+	require.NoError(t, err)
+	return pc
+}
+
+func TestHandleProxy(t *testing.T) {
+	ctx := context.Background()
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(key, "")
+	require.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(block))
+
+	addr, hostKey := testEndpoint(t, "secret", sshPub)
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", addr.String())
+	})
+	require.NoError(t, err)
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherHostKey, err := ssh.NewPublicKey(otherPub)
+	require.NoError(t, err)
+
+	password := []*serverpb.Credential{{
+		Credential: &serverpb.Credential_UsernamePassword{
+			UsernamePassword: &serverpb.UsernamePassword{Username: "user", Password: "secret"},
+		},
+	}}
+	tests := []struct {
+		name       string
+		creds      []*serverpb.Credential
+		knownHosts string
+		insecure   bool
+		wantErr    bool
+	}{
+		{
+			name:       "password",
+			creds:      password,
+			knownHosts: knownhosts.Line([]string{addr.String()}, hostKey),
+		},
+		{
+			name: "private key",
+			creds: []*serverpb.Credential{{
+				Credential: &serverpb.Credential_SshPrivateKey{
+					SshPrivateKey: &serverpb.SshPrivateKey{Username: "user", PrivateKey: privateKey},
+				},
+			}},
+			knownHosts: knownhosts.Line([]string{addr.String()}, hostKey),
+		},
+		{
+			name: "wrong password",
+			creds: []*serverpb.Credential{{
+				Credential: &serverpb.Credential_UsernamePassword{
+					UsernamePassword: &serverpb.UsernamePassword{Username: "user", Password: "wrong"},
+				},
+			}},
+			knownHosts: knownhosts.Line([]string{addr.String()}, hostKey),
+			wantErr:    true,
+		},
+		{
+			name:       "unknown host key",
+			creds:      password,
+			knownHosts: knownhosts.Line([]string{addr.String()}, otherHostKey),
+			wantErr:    true,
+		},
+		{
+			name:       "unknown host",
+			creds:      password,
+			knownHosts: knownhosts.Line([]string{"example.com"}, hostKey),
+			wantErr:    true,
+		},
+		{
+			name:    "no known hosts",
+			creds:   password,
+			wantErr: true,
+		},
+		{
+			name:       "known hosts take precedence over insecure",
+			creds:      password,
+			knownHosts: knownhosts.Line([]string{addr.String()}, otherHostKey),
+			insecure:   true,
+			wantErr:    true,
+		},
+		{
+			name:     "insecure",
+			creds:    password,
+			insecure: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			pc, err := anypb.New(&serverpb.SshProtocolContext{
+				InjectedCredentials:             tt.creds,
+				Endpoint:                        "ssh://" + addr.String(),
+				KnownHosts:                      tt.knownHosts,
+				InsecureSkipHostKeyVerification: tt.insecure,
+			})
+			require.NoError(err)
+			clientConn, workerConn := testConnPair(t)
+			fn, err := handleProxy(ctx, ctx, nil, workerConn, dialer, "conn_1234567890", pc, nil)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Nil(fn)
+				return
+			}
+			require.NoError(err)
+			require.NotNil(fn)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				fn()
+			}()
+
+			c, chans, reqs, err := ssh.NewClientConn(clientConn, "localhost", &ssh.ClientConfig{
+				User:            "anyone",
+				HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+			})
+			require.NoError(err)
+			client := ssh.NewClient(c, chans, reqs)
+			sess, err := client.NewSession()
+			require.NoError(err)
+			out, err := sess.Output("whoami")
+			require.NoError(err)
+			assert.Equal("ran: whoami", string(out))
+			require.NoError(client.Close())
+			<-done
+		})
+	}
+}
+
+func TestHandleProxy_Recording(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	addr, hostKey := testEndpoint(t, "secret", nil)
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return net.Dial("tcp", addr.String())
+	})
+	require.NoError(err)
+
+	dir := t.TempDir()
+	fs, err := recording.NewLocalFS(ctx, dir)
+	require.NoError(err)
+	m, err := recording.NewManager(ctx, fs, bsrkms.TestWrapper(t), func() *bsr.Worker {
+		return &bsr.Worker{PublicId: "w_1234567890", Version: "0.0.1"}
+	})
+	require.NoError(err)
+
+	sr := &serverpb.SessionRecording{
+		SessionRecordingId: "sr_1234567890",
+		SessionId:          "s_1234567890",
+		Endpoint:           "ssh://" + addr.String(),
+		UserId:             "u_1234567890",
+		UserScopeId:        "global",
+		TargetId:           "tssh_1234567890",
+		TargetProjectId:    "p_1234567890",
+		TargetDefaultPort:  22,
+		Credentials: []*serverpb.SessionRecordingCredential{{
+			Id:                "credup_1234567890",
+			CredentialStoreId: "csst_1234567890",
+			SourceType:        "static",
+			CredentialType:    "username_password",
+			Purpose:           "injected_application",
+			Username:          "user",
+		}},
+	}
+	pc, err := anypb.New(&serverpb.SshProtocolContext{
+		InjectedCredentials: []*serverpb.Credential{{
+			Credential: &serverpb.Credential_UsernamePassword{
+				UsernamePassword: &serverpb.UsernamePassword{Username: "user", Password: "secret"},
+			},
+		}},
+		SessionRecording: sr,
+		Endpoint:         sr.GetEndpoint(),
+		KnownHosts:       knownhosts.Line([]string{addr.String()}, hostKey),
+	})
+	require.NoError(err)
+
+	// A worker without a recording manager refuses to proxy recorded sessions.
+	_, workerConn := testConnPair(t)
+	fn, err := handleProxy(ctx, ctx, nil, workerConn, dialer, "conn_1234567890", pc, nil)
+	require.Error(err)
+	assert.Contains(err.Error(), "session recording is not supported by this worker")
+	assert.Nil(fn)
+
+	clientConn, workerConn := testConnPair(t)
+	fn, err = handleProxy(ctx, ctx, nil, workerConn, dialer, "conn_1234567890", pc, m)
+	require.NoError(err)
+	require.NotNil(fn)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	c, chans, reqs, err := ssh.NewClientConn(clientConn, "localhost", &ssh.ClientConfig{
+		User:            "anyone",
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(err)
+	client := ssh.NewClient(c, chans, reqs)
+	sess, err := client.NewSession()
+	require.NoError(err)
+	out, err := sess.Output("whoami")
+	require.NoError(err)
+	assert.Equal("ran: whoami", string(out))
+	require.NoError(client.Close())
+	<-done
+
+	managed, err := m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Equal([]string{sr.GetSessionId()}, managed)
+	require.NoError(m.ReauthorizeAllExcept(ctx, []string{sr.GetSessionId()}))
+
+	_, err = os.Stat(filepath.Join(dir, sr.GetSessionRecordingId()+".bsr"))
+	assert.NoError(err)
+}
+
+func TestHandleProxy_Errors(t *testing.T) {
+	ctx := context.Background()
+	c, _ := net.Pipe()
+	dialer, err := proxy.NewProxyDialer(ctx, func(...proxy.Option) (net.Conn, error) {
+		return nil, assert.AnError
+	})
+	require.NoError(t, err)
+	validCreds := &serverpb.Credential{
+		Credential: &serverpb.Credential_UsernamePassword{
+			UsernamePassword: &serverpb.UsernamePassword{Username: "user", Password: "secret"},
+		},
+	}
+	wrongType, err := anypb.New(validCreds)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		conn        net.Conn
+		dialer      *proxy.ProxyDialer
+		connId      string
+		protocolCtx *anypb.Any
+	}{
+		{
+			name:        "nil connection",
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, validCreds),
+		},
+		{
+			name:        "nil dialer",
+			conn:        c,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, validCreds),
+		},
+		{
+			name:        "no connection id",
+			conn:        c,
+			dialer:      dialer,
+			protocolCtx: testProtocolContext(t, validCreds),
+		},
+		{
+			name:   "no protocol context",
+			conn:   c,
+			dialer: dialer,
+			connId: "someconnectionid",
+		},
+		{
+			name:        "wrong protocol context",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: wrongType,
+		},
+		{
+			name:        "no credentials",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t),
+		},
+		{
+			name:        "dial error",
+			conn:        c,
+			dialer:      dialer,
+			connId:      "someconnectionid",
+			protocolCtx: testProtocolContext(t, validCreds),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn, err := handleProxy(ctx, ctx, nil, tt.conn, tt.dialer, tt.connId, tt.protocolCtx, nil)
+			assert.Error(t, err)
+			assert.Nil(t, fn)
+		})
+	}
+}
+
+func TestNewClientConfig(t *testing.T) {
+	ctx := context.Background()
+	up := func(u string) *serverpb.Credential {
+		return &serverpb.Credential{
+			Credential: &serverpb.Credential_UsernamePassword{
+				UsernamePassword: &serverpb.UsernamePassword{Username: u, Password: "secret"},
+			},
+		}
+	}
+
+	hostKeyCallback := ssh.FixedHostKey(nil)
+
+	cfg, err := newClientConfig(ctx, []*serverpb.Credential{up("user"), up("user")}, hostKeyCallback)
+	require.NoError(t, err)
+	assert.Equal(t, "user", cfg.User)
+	assert.Len(t, cfg.Auth, 1)
+	assert.NotNil(t, cfg.HostKeyCallback)
+
+	_, err = newClientConfig(ctx, []*serverpb.Credential{up("user")}, nil)
+	assert.Error(t, err)
+
+	_, err = newClientConfig(ctx, []*serverpb.Credential{up("user"), up("other")}, hostKeyCallback)
+	assert.Error(t, err)
+
+	_, err = newClientConfig(ctx, []*serverpb.Credential{up("")}, hostKeyCallback)
+	assert.Error(t, err)
+
+	_, err = newClientConfig(ctx, []*serverpb.Credential{{
+		Credential: &serverpb.Credential_SshPrivateKey{
+			SshPrivateKey: &serverpb.SshPrivateKey{Username: "user", PrivateKey: "not a key"},
+		},
+	}}, hostKeyCallback)
+	assert.Error(t, err)
+}
+
+func TestNewHostKeyCallback(t *testing.T) {
+	ctx := context.Background()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	hostKey, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	remote := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 22}
+
+	_, err = newHostKeyCallback(ctx, "", false)
+	assert.Error(t, err)
+
+	cb, err := newHostKeyCallback(ctx, "", true)
+	require.NoError(t, err)
+	assert.NoError(t, cb("example.com:22", remote, hostKey))
+
+	_, err = newHostKeyCallback(ctx, "not a known hosts line", false)
+	assert.Error(t, err)
+
+	cb, err = newHostKeyCallback(ctx, knownhosts.Line([]string{"example.com"}, hostKey), false)
+	require.NoError(t, err)
+	assert.NoError(t, cb("example.com:22", remote, hostKey))
+	assert.Error(t, cb("example.com:2222", remote, hostKey))
+	assert.Error(t, cb("other.example.com:22", remote, hostKey))
+
+	caPub, caKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	caSigner, err := ssh.NewSignerFromKey(caKey)
+	require.NoError(t, err)
+	caPublicKey, err := ssh.NewPublicKey(caPub)
+	require.NoError(t, err)
+	cert := &ssh.Certificate{
+		Key:             hostKey,
+		CertType:        ssh.HostCert,
+		ValidPrincipals: []string{"host.example.com"},
+		ValidBefore:     ssh.CertTimeInfinity,
+	}
+	require.NoError(t, cert.SignCert(rand.Reader, caSigner))
+	cb, err = newHostKeyCallback(ctx, "@cert-authority *.example.com "+string(ssh.MarshalAuthorizedKey(caPublicKey)), false)
+	require.NoError(t, err)
+	assert.NoError(t, cb("host.example.com:22", remote, cert))
+	assert.Error(t, cb("host.example.com:22", remote, hostKey))
+}
+
+func TestEndpointAddress(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		endpoint string
+		want     string
+		wantErr  bool
+	}{
+		{endpoint: "", want: ""},
+		{endpoint: "ssh://example.com", want: "example.com:22"},
+		{endpoint: "ssh://example.com:2222", want: "example.com:2222"},
+		{endpoint: "ssh://[::1]:2222", want: "[::1]:2222"},
+		{endpoint: "ssh://", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			got, err := endpointAddress(ctx, tt.endpoint)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
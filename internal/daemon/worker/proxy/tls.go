@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+)
+
+// EndpointTlsConfig returns the tls.Config used to connect to the provided
+// endpoint. The certificate of the endpoint is verified using the certificate
+// authorities of settings, or the system certificate authorities if none are
+// provided. The name in the certificate is verified against the server name
+// of settings, or the host of the endpoint if none is provided, unless
+// settings skips the verification of the server name.
+func EndpointTlsConfig(endpoint string, settings *serverpb.EndpointTls) (*tls.Config, error) {
+	if settings == nil {
+		return nil, fmt.Errorf("missing endpoint tls settings")
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse endpoint: %w", err)
+	}
+	serverName := settings.GetServerName()
+	if serverName == "" {
+		serverName = u.Hostname()
+	}
+	if serverName == "" {
+		return nil, fmt.Errorf("endpoint %q has no host to verify", endpoint)
+	}
+
+	var roots *x509.CertPool
+	if settings.GetCaCert() != "" {
+		roots = x509.NewCertPool()
+		if !roots.AppendCertsFromPEM([]byte(settings.GetCaCert())) {
+			return nil, fmt.Errorf("ca cert does not contain a valid PEM encoded certificate")
+		}
+	}
+
+	cfg := &tls.Config{
+		ServerName: serverName,
+		RootCAs:    roots,
+		MinVersion: tls.VersionTLS12,
+	}
+	if settings.GetSkipServerNameVerification() {
+		// The standard verification always checks the name in the
+		// certificate, so the chain is verified by VerifyConnection instead.
+		cfg.InsecureSkipVerify = true //nolint:gosec
+		cfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) == 0 {
+				return fmt.Errorf("endpoint did not provide a certificate")
+			}
+			opts := x509.VerifyOptions{
+				Roots:         roots,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, c := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(c)
+			}
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		}
+	}
+	return cfg, nil
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package proxy
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointTlsConfig(t *testing.T) {
+	t.Parallel()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	caCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	tests := []struct {
+		name           string
+		endpoint       string
+		settings       *serverpb.EndpointTls
+		wantServerName string
+		wantRoots      bool
+		wantSkip       bool
+		wantErr        string
+	}{
+		{
+			name:    "nil settings",
+			wantErr: "missing endpoint tls settings",
+		},
+		{
+			name:     "missing host",
+			endpoint: "postgres://",
+			settings: &serverpb.EndpointTls{},
+			wantErr:  "has no host to verify",
+		},
+		{
+			name:     "invalid ca cert",
+			endpoint: "postgres://db.example.com:5432",
+			settings: &serverpb.EndpointTls{CaCert: "invalid"},
+			wantErr:  "ca cert does not contain a valid PEM encoded certificate",
+		},
+		{
+			name:           "system roots",
+			endpoint:       "postgres://db.example.com:5432",
+			settings:       &serverpb.EndpointTls{},
+			wantServerName: "db.example.com",
+		},
+		{
+			name:           "ca and server name",
+			endpoint:       "postgres://10.0.0.1:5432",
+			settings:       &serverpb.EndpointTls{CaCert: caCert, ServerName: "db.example.com"},
+			wantServerName: "db.example.com",
+			wantRoots:      true,
+		},
+		{
+			name:           "skip server name verification",
+			endpoint:       "postgres://10.0.0.1:5432",
+			settings:       &serverpb.EndpointTls{CaCert: caCert, SkipServerNameVerification: true},
+			wantServerName: "10.0.0.1",
+			wantRoots:      true,
+			wantSkip:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := EndpointTlsConfig(tt.endpoint, tt.settings)
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantServerName, got.ServerName)
+			assert.Equal(uint16(tls.VersionTLS12), got.MinVersion)
+			assert.Equal(tt.wantRoots, got.RootCAs != nil)
+			assert.Equal(tt.wantSkip, got.InsecureSkipVerify)
+			assert.Equal(tt.wantSkip, got.VerifyConnection != nil)
+		})
+	}
+}
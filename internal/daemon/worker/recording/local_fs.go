@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/storage"
+)
+
+const (
+	defaultContainerPerm = 0o700
+	defaultFilePerm      = 0o600
+)
+
+// LocalFS is a storage.FS that stores containers as directories on the local
+// filesystem of the worker.
+type LocalFS struct {
+	path string
+}
+
+var _ storage.FS = (*LocalFS)(nil)
+
+// NewLocalFS creates a LocalFS rooted at the provided path. The path is
+// created if it does not exist.
+func NewLocalFS(ctx context.Context, path string) (*LocalFS, error) {
+	const op = "recording.NewLocalFS"
+	if path == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing path")
+	}
+	if err := os.MkdirAll(path, defaultContainerPerm); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to create recording storage directory"))
+	}
+	return &LocalFS{path: path}, nil
+}
+
+// New creates the named root container. It is an error if the container
+// already exists.
+func (l *LocalFS) New(ctx context.Context, name string) (storage.Container, error) {
+	const op = "recording.(LocalFS).New"
+	path, err := joinPath(l.path, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := os.Mkdir(path, defaultContainerPerm); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &localContainer{path: path}, nil
+}
+
+// Open opens an existing root container.
+func (l *LocalFS) Open(ctx context.Context, name string) (storage.Container, error) {
+	const op = "recording.(LocalFS).Open"
+	path, err := joinPath(l.path, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if err := isDir(path); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &localContainer{path: path}, nil
+}
+
+// joinPath joins parent with name, making sure that the result is a direct
+// child of parent.
+func joinPath(parent, name string) (string, error) {
+	switch {
+	case name == "":
+		return "", stderrors.New("missing name")
+	case strings.ContainsAny(name, `/\`):
+		return "", stderrors.New("name contains path separator")
+	case name == "." || name == "..":
+		return "", stderrors.New("name must not be a relative path element")
+	}
+	return filepath.Join(parent, name), nil
+}
+
+func isDir(path string) error {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("%s is not a directory", path)
+	}
+	return nil
+}
+
+// localContainer is a storage.Container backed by a directory.
+type localContainer struct {
+	path string
+
+	l      sync.Mutex
+	closed bool
+}
+
+// Close closes the container, preventing new files or sub containers from
+// being created or opened.
+func (c *localContainer) Close() error {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.closed = true
+	return nil
+}
+
+// Create creates a new file in the container, truncating it if it exists.
+func (c *localContainer) Create(ctx context.Context, name string) (storage.File, error) {
+	return c.OpenFile(ctx, name, storage.WithCreateFile(), storage.WithFileAccessMode(storage.ReadWrite))
+}
+
+// OpenFile opens a file in the container. Supported options are
+// storage.WithCreateFile and storage.WithFileAccessMode.
+func (c *localContainer) OpenFile(ctx context.Context, name string, opt ...storage.Option) (storage.File, error) {
+	const op = "recording.(localContainer).OpenFile"
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.closed {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "container is closed")
+	}
+	path, err := joinPath(c.path, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	opts := storage.GetOpts(opt...)
+	if opts.WithCreateFile && opts.WithFileAccessMode == storage.ReadOnly {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "cannot create file in read-only mode")
+	}
+	var flag int
+	switch opts.WithFileAccessMode {
+	case storage.WriteOnly:
+		flag = os.O_WRONLY
+	case storage.ReadWrite:
+		flag = os.O_RDWR
+	default:
+		flag = os.O_RDONLY
+	}
+	if opts.WithCreateFile {
+		flag |= os.O_CREATE | os.O_TRUNC
+	} else if opts.WithFileAccessMode != storage.ReadOnly {
+		flag |= os.O_APPEND
+	}
+
+	f, err := os.OpenFile(path, flag, defaultFilePerm)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &localFile{File: f, mode: opts.WithFileAccessMode}, nil
+}
+
+// SubContainer creates or opens a container in this container. The container
+// is created if storage.WithCreateFile is provided.
+func (c *localContainer) SubContainer(ctx context.Context, name string, opt ...storage.Option) (storage.Container, error) {
+	const op = "recording.(localContainer).SubContainer"
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.closed {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "container is closed")
+	}
+	path, err := joinPath(c.path, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	opts := storage.GetOpts(opt...)
+	if opts.WithCreateFile {
+		if opts.WithFileAccessMode == storage.ReadOnly {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "cannot create container in read-only mode")
+		}
+		if err := os.Mkdir(path, defaultContainerPerm); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	} else if err := isDir(path); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &localContainer{path: path}, nil
+}
+
+// localFile is a storage.File backed by an os.File. Closing a localFile more
+// than once is not an error.
+type localFile struct {
+	*os.File
+	mode storage.AccessMode
+
+	l      sync.Mutex
+	closed bool
+}
+
+var _ storage.File = (*localFile)(nil)
+
+// Stat returns the FileInfo of the file.
+func (f *localFile) Stat() (fs.FileInfo, error) {
+	return f.File.Stat()
+}
+
+// Read reads from the file. It is an error to read from a write-only file.
+func (f *localFile) Read(b []byte) (int, error) {
+	if f.mode == storage.WriteOnly {
+		return 0, fmt.Errorf("%s is write-only", f.Name())
+	}
+	return f.File.Read(b)
+}
+
+// Write writes to the file. It is an error to write to a read-only file.
+func (f *localFile) Write(b []byte) (int, error) {
+	if f.mode == storage.ReadOnly {
+		return 0, fmt.Errorf("%s is read-only", f.Name())
+	}
+	return f.File.Write(b)
+}
+
+// WriteString writes a string to the file.
+func (f *localFile) WriteString(s string) (int, error) {
+	return f.Write([]byte(s))
+}
+
+// WriteAndClose writes b to the file and closes it.
+func (f *localFile) WriteAndClose(b []byte) (int, error) {
+	n, err := f.Write(b)
+	if err != nil {
+		_ = f.Close()
+		return n, err
+	}
+	return n, f.Close()
+}
+
+// Close closes the file.
+func (f *localFile) Close() error {
+	f.l.Lock()
+	defer f.l.Unlock()
+	if f.closed {
+		return nil
+	}
+	f.closed = true
+	return f.File.Close()
+}
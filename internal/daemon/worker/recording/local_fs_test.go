@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalFS(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	_, err := NewLocalFS(ctx, "")
+	assert.ErrorContains(err, "missing path")
+
+	fs, err := NewLocalFS(ctx, t.TempDir())
+	require.NoError(err)
+
+	for _, name := range []string{"", ".", "..", "a/b", `a\b`} {
+		_, err := fs.New(ctx, name)
+		assert.Error(err, "name %q", name)
+	}
+
+	c, err := fs.New(ctx, "container")
+	require.NoError(err)
+	_, err = fs.New(ctx, "container")
+	assert.Error(err)
+
+	sub, err := c.SubContainer(ctx, "sub", storage.WithCreateFile(), storage.WithFileAccessMode(storage.ReadWrite))
+	require.NoError(err)
+	f, err := sub.Create(ctx, "file")
+	require.NoError(err)
+	_, err = f.WriteString("hello ")
+	require.NoError(err)
+	_, err = f.WriteAndClose([]byte("world"))
+	require.NoError(err)
+	// Closing more than once is not an error
+	require.NoError(f.Close())
+	require.NoError(sub.Close())
+	require.NoError(c.Close())
+
+	_, err = c.SubContainer(ctx, "sub")
+	assert.ErrorContains(err, "container is closed")
+
+	c, err = fs.Open(ctx, "container")
+	require.NoError(err)
+	sub, err = c.SubContainer(ctx, "sub")
+	require.NoError(err)
+	f, err = sub.OpenFile(ctx, "file", storage.WithFileAccessMode(storage.ReadOnly))
+	require.NoError(err)
+	b, err := io.ReadAll(f)
+	require.NoError(err)
+	assert.Equal("hello world", string(b))
+	_, err = f.Write([]byte("read-only"))
+	assert.ErrorContains(err, "is read-only")
+	require.NoError(f.Close())
+
+	_, err = sub.OpenFile(ctx, "file", storage.WithCreateFile(), storage.WithFileAccessMode(storage.ReadOnly))
+	assert.ErrorContains(err, "cannot create file in read-only mode")
+	_, err = fs.Open(ctx, "missing")
+	assert.Error(err)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/daemon/worker/proxy"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/storage"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// Manager records the sessions proxied by a worker into BSRs in a storage.FS.
+// The recording of a session is created by its first recorded connection and
+// is closed and signed once the controller reports the session is no longer
+// active, or when the Manager is shut down.
+type Manager struct {
+	fs       storage.FS
+	wrapper  wrapping.Wrapper
+	workerFn func() *bsr.Worker
+
+	l        sync.Mutex
+	sessions map[string]*sessionRecorder
+	shutdown bool
+}
+
+var _ proxy.RecordingManager = (*Manager)(nil)
+
+// NewManager creates a Manager which writes recordings to fs. The wrapper is
+// used to create the keys that sign and encrypt each recording, and workerFn
+// provides the information about the worker stored with each recording.
+func NewManager(ctx context.Context, fs storage.FS, wrapper wrapping.Wrapper, workerFn func() *bsr.Worker) (*Manager, error) {
+	const op = "recording.NewManager"
+	switch {
+	case fs == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage fs")
+	case wrapper == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing wrapper")
+	case workerFn == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing worker function")
+	}
+	return &Manager{
+		fs:       fs,
+		wrapper:  wrapper,
+		workerFn: workerFn,
+		sessions: make(map[string]*sessionRecorder),
+	}, nil
+}
+
+// NewConnectionRecorder returns a recorder for a connection of the session
+// described by sr. The caller must close the returned recorder once the
+// connection is closed.
+func (m *Manager) NewConnectionRecorder(ctx context.Context, sr *serverpb.SessionRecording, connectionId string) (*ConnectionRecorder, error) {
+	const op = "recording.(Manager).NewConnectionRecorder"
+	switch {
+	case sr == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording")
+	case sr.GetSessionRecordingId() == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	case sr.GetSessionId() == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case connectionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing connection id")
+	}
+
+	m.l.Lock()
+	defer m.l.Unlock()
+	if m.shutdown {
+		return nil, errors.New(ctx, errors.Internal, op, "recording manager is shut down")
+	}
+	s, ok := m.sessions[sr.GetSessionId()]
+	if !ok {
+		var err error
+		s, err = newSessionRecorder(ctx, m.fs, m.wrapper, sr, m.workerFn())
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		m.sessions[sr.GetSessionId()] = s
+	}
+	c, err := s.newConnectionRecorder(ctx, connectionId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return c, nil
+}
+
+// ReauthorizeAllExcept closes the recordings of the provided sessions, which
+// are no longer active. All other recordings are kept open.
+func (m *Manager) ReauthorizeAllExcept(ctx context.Context, closedSessions []string) error {
+	const op = "recording.(Manager).ReauthorizeAllExcept"
+	var toClose []*sessionRecorder
+	m.l.Lock()
+	for _, id := range closedSessions {
+		if s, ok := m.sessions[id]; ok {
+			toClose = append(toClose, s)
+			delete(m.sessions, id)
+		}
+	}
+	m.l.Unlock()
+
+	var closeErr error
+	for _, s := range toClose {
+		if err := s.close(ctx); err != nil {
+			closeErr = stderrors.Join(closeErr, err)
+		}
+	}
+	if closeErr != nil {
+		return errors.Wrap(ctx, closeErr, op, errors.WithMsg("unable to close session recordings"))
+	}
+	return nil
+}
+
+// SessionsManaged returns the ids of the sessions which are being recorded.
+func (m *Manager) SessionsManaged(_ context.Context) ([]string, error) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	ids := make([]string, 0, len(m.sessions))
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// Shutdown closes all recordings. No new connections can be recorded once
+// Shutdown has been called.
+func (m *Manager) Shutdown(ctx context.Context) {
+	const op = "recording.(Manager).Shutdown"
+	m.l.Lock()
+	m.shutdown = true
+	sessions := m.sessions
+	m.sessions = make(map[string]*sessionRecorder)
+	m.l.Unlock()
+
+	for id, s := range sessions {
+		if err := s.close(ctx); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("error closing session recording", "session_id", id))
+		}
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	bsrssh "github.com/hashicorp/boundary/internal/bsr/ssh"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/storage"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+func testSessionRecording() *serverpb.SessionRecording {
+	return &serverpb.SessionRecording{
+		SessionRecordingId: "sr_1234567890",
+		SessionId:          "s_1234567890",
+		Endpoint:           "ssh://127.0.0.1:22",
+		UserId:             "u_1234567890",
+		UserScopeId:        "global",
+		TargetId:           "tssh_1234567890",
+		TargetProjectId:    "p_1234567890",
+		TargetDefaultPort:  22,
+		StorageBucketId:    "sb_1234567890",
+		Credentials: []*serverpb.SessionRecordingCredential{
+			{
+				Id:                "credup_1234567890",
+				CredentialStoreId: "csst_1234567890",
+				SourceType:        "static",
+				CredentialType:    "username_password",
+				Purpose:           "injected_application",
+				Username:          "user",
+			},
+		},
+	}
+}
+
+// testKeyUnwrapFn returns a KeyUnwrapCallbackFunc which unwraps the keys of a
+// recording using w.
+func testKeyUnwrapFn(t *testing.T, w wrapping.Wrapper) bsrkms.KeyUnwrapCallbackFunc {
+	t.Helper()
+	unwrap := func(k *wrapping.KeyInfo) (*wrapping.KeyInfo, error) {
+		blob := &wrapping.BlobInfo{}
+		if err := proto.Unmarshal(k.WrappedKey, blob); err != nil {
+			return nil, err
+		}
+		key, err := w.Decrypt(context.Background(), blob)
+		if err != nil {
+			return nil, err
+		}
+		return &wrapping.KeyInfo{
+			KeyId:       k.KeyId,
+			Key:         key,
+			KeyType:     k.KeyType,
+			KeyEncoding: k.KeyEncoding,
+			KeyPurposes: k.KeyPurposes,
+		}, nil
+	}
+	return func(wk bsrkms.WrappedKeys) (bsrkms.UnwrappedKeys, error) {
+		bsrKey, err := unwrap(wk.WrappedBsrKey)
+		if err != nil {
+			return bsrkms.UnwrappedKeys{}, err
+		}
+		privKey, err := unwrap(wk.WrappedPrivKey)
+		if err != nil {
+			return bsrkms.UnwrappedKeys{}, err
+		}
+		return bsrkms.UnwrappedKeys{BsrKey: bsrKey, PrivKey: privKey}, nil
+	}
+}
+
+func testWorker() *bsr.Worker {
+	return &bsr.Worker{PublicId: "w_1234567890", Version: "0.0.1"}
+}
+
+func TestNewManager(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewLocalFS(ctx, t.TempDir())
+	require.NoError(t, err)
+	w := bsrkms.TestWrapper(t)
+
+	tests := []struct {
+		name     string
+		fs       storage.FS
+		wrapper  wrapping.Wrapper
+		workerFn func() *bsr.Worker
+		wantErr  string
+	}{
+		{
+			name:     "valid",
+			fs:       fs,
+			wrapper:  w,
+			workerFn: testWorker,
+		},
+		{
+			name:     "missing fs",
+			wrapper:  w,
+			workerFn: testWorker,
+			wantErr:  "recording.NewManager: missing storage fs: parameter violation: error #100",
+		},
+		{
+			name:     "missing wrapper",
+			fs:       fs,
+			workerFn: testWorker,
+			wantErr:  "recording.NewManager: missing wrapper: parameter violation: error #100",
+		},
+		{
+			name:    "missing worker function",
+			fs:      fs,
+			wrapper: w,
+			wantErr: "recording.NewManager: missing worker function: parameter violation: error #100",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewManager(ctx, tt.fs, tt.wrapper, tt.workerFn)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			assert.NotNil(t, got)
+		})
+	}
+}
+
+func TestManager_Record(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	fs, err := NewLocalFS(ctx, t.TempDir())
+	require.NoError(err)
+	w := bsrkms.TestWrapper(t)
+	m, err := NewManager(ctx, fs, w, testWorker)
+	require.NoError(err)
+
+	sr := testSessionRecording()
+	conn, err := m.NewConnectionRecorder(ctx, sr, "sc_1234567890")
+	require.NoError(err)
+
+	_, err = m.NewConnectionRecorder(ctx, sr, "sc_1234567890")
+	assert.ErrorContains(err, "connection sc_1234567890 is already being recorded")
+
+	ids, err := m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Equal([]string{sr.GetSessionId()}, ids)
+
+	require.NoError(conn.RecordRequest(ctx, bsr.Inbound, &ssh.Request{Type: "keepalive@openssh.com", WantReply: true}))
+
+	ch, err := conn.NewChannelRecorder(ctx, "session")
+	require.NoError(err)
+	require.NoError(ch.RecordRequest(ctx, bsr.Inbound, &ssh.Request{
+		Type:    bsrssh.ExecRequestType,
+		Payload: ssh.Marshal(struct{ Command string }{"scp -t /tmp"}),
+	}))
+	_, err = io.WriteString(ch.NewDataWriter(ctx, bsr.Inbound), "input")
+	require.NoError(err)
+	_, err = io.WriteString(ch.NewDataWriter(ctx, bsr.Outbound), "output")
+	require.NoError(err)
+	require.NoError(ch.RecordRequest(ctx, bsr.Outbound, &ssh.Request{
+		Type:    bsrssh.ExitStatusRequestType,
+		Payload: ssh.Marshal(struct{ Status uint32 }{0}),
+	}))
+	require.NoError(conn.Close(ctx))
+	// Closing more than once is not an error
+	require.NoError(conn.Close(ctx))
+
+	_, err = ch.NewDataWriter(ctx, bsr.Inbound).Write([]byte("closed"))
+	assert.ErrorContains(err, "channel recording is closed")
+
+	// The recording is closed once the session is no longer active
+	require.NoError(m.ReauthorizeAllExcept(ctx, []string{"s_other"}))
+	ids, err = m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Len(ids, 1)
+	require.NoError(m.ReauthorizeAllExcept(ctx, []string{sr.GetSessionId()}))
+	ids, err = m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Empty(ids)
+
+	keyFn := testKeyUnwrapFn(t, w)
+	v, err := bsr.Validate(ctx, sr.GetSessionRecordingId(), fs, keyFn)
+	require.NoError(err)
+	assert.True(v.Valid)
+
+	s, err := bsr.OpenSession(ctx, sr.GetSessionRecordingId(), fs, keyFn)
+	require.NoError(err)
+	assert.Equal(sr.GetSessionId(), s.SessionMeta.PublicId)
+	assert.Equal(uint64(1), s.Summary.GetConnectionCount())
+
+	oc, err := s.OpenConnection(ctx, conn.Id())
+	require.NoError(err)
+	oCh, err := oc.OpenChannel(ctx, ch.summary.GetId())
+	require.NoError(err)
+	chSummary, ok := oCh.Summary.(*bsrssh.ChannelSummary)
+	require.True(ok)
+	assert.Equal(bsrssh.Exec, chSummary.SessionProgram)
+	assert.Equal(bsrssh.Scp, chSummary.ExecProgram)
+	assert.Equal(bsrssh.FileTransferUpload, chSummary.FileTransferDirection)
+	assert.Equal(uint64(len("input")), chSummary.GetBytesUp())
+	assert.Equal(uint64(len("output")), chSummary.GetBytesDown())
+
+	scanner, err := oCh.OpenMessageScanner(ctx, bsr.Outbound)
+	require.NoError(err)
+	var types []bsr.ChunkType
+	var data []byte
+	require.NoError(bsr.ChunkWalk(ctx, scanner, func(_ context.Context, c bsr.Chunk) error {
+		types = append(types, c.GetType())
+		if dc, ok := c.(*bsrssh.DataChunk); ok {
+			data = append(data, dc.Data...)
+		}
+		return nil
+	}))
+	assert.Equal([]bsr.ChunkType{bsr.ChunkHeader, bsrssh.DataChunkType, bsr.ChunkEnd}, types)
+	assert.Equal("output", string(data))
+
+	scanner, err = oCh.OpenRequestScanner(ctx, bsr.Inbound)
+	require.NoError(err)
+	types = nil
+	require.NoError(bsr.ChunkWalk(ctx, scanner, func(_ context.Context, c bsr.Chunk) error {
+		types = append(types, c.GetType())
+		return nil
+	}))
+	assert.Equal([]bsr.ChunkType{bsr.ChunkHeader, bsrssh.ExecReqChunkType, bsr.ChunkEnd}, types)
+}
+
+func TestManager_Shutdown(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+
+	fs, err := NewLocalFS(ctx, t.TempDir())
+	require.NoError(err)
+	w := bsrkms.TestWrapper(t)
+	m, err := NewManager(ctx, fs, w, testWorker)
+	require.NoError(err)
+
+	sr := testSessionRecording()
+	conn, err := m.NewConnectionRecorder(ctx, sr, "sc_1234567890")
+	require.NoError(err)
+	_, err = conn.NewChannelRecorder(ctx, "session")
+	require.NoError(err)
+
+	// Shutdown closes all open connection and channel recordings
+	m.Shutdown(ctx)
+	ids, err := m.SessionsManaged(ctx)
+	require.NoError(err)
+	assert.Empty(ids)
+
+	_, err = conn.NewChannelRecorder(ctx, "session")
+	assert.ErrorContains(err, "connection recording is closed")
+	_, err = m.NewConnectionRecorder(ctx, sr, "sc_0987654321")
+	assert.ErrorContains(err, "recording manager is shut down")
+
+	v, err := bsr.Validate(ctx, sr.GetSessionRecordingId(), fs, testKeyUnwrapFn(t, w))
+	require.NoError(err)
+	assert.True(v.Valid)
+}
+
+func TestManager_NewConnectionRecorder_Errors(t *testing.T) {
+	ctx := context.Background()
+	fs, err := NewLocalFS(ctx, t.TempDir())
+	require.NoError(t, err)
+	m, err := NewManager(ctx, fs, bsrkms.TestWrapper(t), testWorker)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		sr      func() *serverpb.SessionRecording
+		connId  string
+		wantErr string
+	}{
+		{
+			name:    "missing session recording",
+			sr:      func() *serverpb.SessionRecording { return nil },
+			connId:  "sc_1234567890",
+			wantErr: "missing session recording:",
+		},
+		{
+			name: "missing session recording id",
+			sr: func() *serverpb.SessionRecording {
+				sr := testSessionRecording()
+				sr.SessionRecordingId = ""
+				return sr
+			},
+			connId:  "sc_1234567890",
+			wantErr: "missing session recording id",
+		},
+		{
+			name: "missing session id",
+			sr: func() *serverpb.SessionRecording {
+				sr := testSessionRecording()
+				sr.SessionId = ""
+				return sr
+			},
+			connId:  "sc_1234567890",
+			wantErr: "missing session id",
+		},
+		{
+			name:    "missing connection id",
+			sr:      testSessionRecording,
+			wantErr: "missing connection id",
+		},
+		{
+			name: "missing credentials",
+			sr: func() *serverpb.SessionRecording {
+				sr := testSessionRecording()
+				sr.Credentials = nil
+				return sr
+			},
+			connId:  "sc_1234567890",
+			wantErr: "missing credential information",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.NewConnectionRecorder(ctx, tt.sr(), tt.connId)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Nil(t, got)
+		})
+	}
+}
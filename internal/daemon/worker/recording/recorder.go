@@ -0,0 +1,615 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	bsrssh "github.com/hashicorp/boundary/internal/bsr/ssh"
+	"github.com/hashicorp/boundary/internal/db"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/storage"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"golang.org/x/crypto/ssh"
+)
+
+// sessionRecorder writes the session container of a BSR.
+type sessionRecorder struct {
+	session   *bsr.Session
+	sessionId string
+	start     time.Time
+
+	l               sync.Mutex
+	connections     map[string]*ConnectionRecorder
+	connectionCount uint64
+	closed          bool
+}
+
+func newSessionRecorder(ctx context.Context, fs storage.FS, wrapper wrapping.Wrapper, sr *serverpb.SessionRecording, worker *bsr.Worker) (*sessionRecorder, error) {
+	const op = "recording.newSessionRecorder"
+	keys, err := bsrkms.CreateKeys(ctx, wrapper, sr.GetSessionId())
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to create bsr keys: %w", op, err)
+	}
+	s, err := bsr.NewSession(ctx,
+		&bsr.SessionRecordingMeta{
+			Id:       sr.GetSessionRecordingId(),
+			Protocol: bsrssh.Protocol,
+		},
+		sessionMeta(sr, worker),
+		fs,
+		keys,
+		bsr.WithSupportsMultiplex(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &sessionRecorder{
+		session:     s,
+		sessionId:   sr.GetSessionId(),
+		start:       time.Now(),
+		connections: make(map[string]*ConnectionRecorder),
+	}, nil
+}
+
+// sessionMeta creates the bsr.SessionMeta for the recorded session.
+func sessionMeta(sr *serverpb.SessionRecording, worker *bsr.Worker) *bsr.SessionMeta {
+	m := &bsr.SessionMeta{
+		PublicId: sr.GetSessionId(),
+		Endpoint: sr.GetEndpoint(),
+		User: &bsr.User{
+			PublicId: sr.GetUserId(),
+			Scope: bsr.Scope{
+				PublicId: sr.GetUserScopeId(),
+				Type:     scopeType(sr.GetUserScopeId()),
+			},
+		},
+		Target: &bsr.Target{
+			PublicId: sr.GetTargetId(),
+			Name:     sr.GetTargetName(),
+			Scope: bsr.Scope{
+				PublicId: sr.GetTargetProjectId(),
+				Type:     scopeType(sr.GetTargetProjectId()),
+			},
+			DefaultPort:            sr.GetTargetDefaultPort(),
+			EnableSessionRecording: true,
+			StorageBucketId:        sr.GetStorageBucketId(),
+		},
+		Worker: worker,
+	}
+	for _, c := range sr.GetCredentials() {
+		purposes := []string{c.GetPurpose()}
+		switch c.GetSourceType() {
+		case "static":
+			store := bsr.StaticCredentialStore{
+				PublicId:  c.GetCredentialStoreId(),
+				ProjectId: sr.GetTargetProjectId(),
+			}
+			switch c.GetCredentialType() {
+			case "ssh_private_key":
+				m.StaticSshPrivateKeyCredentials = append(m.StaticSshPrivateKeyCredentials, bsr.StaticSshPrivateKeyCredential{
+					PublicId:        c.GetId(),
+					Username:        c.GetUsername(),
+					Purposes:        purposes,
+					CredentialStore: store,
+				})
+			case "json":
+				m.StaticJSONCredentials = append(m.StaticJSONCredentials, bsr.StaticJsonCredential{
+					PublicId:        c.GetId(),
+					Purposes:        purposes,
+					CredentialStore: store,
+				})
+			default:
+				m.StaticUsernamePasswordCredentials = append(m.StaticUsernamePasswordCredentials, bsr.StaticUsernamePasswordCredential{
+					PublicId:        c.GetId(),
+					Username:        c.GetUsername(),
+					Purposes:        purposes,
+					CredentialStore: store,
+				})
+			}
+		default:
+			store := bsr.VaultCredentialStore{
+				PublicId:  c.GetCredentialStoreId(),
+				ProjectId: sr.GetTargetProjectId(),
+			}
+			switch c.GetSourceType() {
+			case "vault-ssh-certificate":
+				m.VaultSshCertificateLibraries = append(m.VaultSshCertificateLibraries, bsr.VaultSshCertificateLibrary{
+					PublicId:        c.GetId(),
+					Username:        c.GetUsername(),
+					CredentialType:  c.GetCredentialType(),
+					Purposes:        purposes,
+					CredentialStore: store,
+				})
+			default:
+				m.VaultGenericLibraries = append(m.VaultGenericLibraries, bsr.VaultGenericLibrary{
+					PublicId:        c.GetId(),
+					CredentialType:  c.GetCredentialType(),
+					Purposes:        purposes,
+					CredentialStore: store,
+				})
+			}
+		}
+	}
+	return m
+}
+
+func scopeType(id string) string {
+	switch {
+	case id == "global":
+		return "global"
+	case strings.HasPrefix(id, globals.OrgPrefix+"_"):
+		return "org"
+	case strings.HasPrefix(id, globals.ProjectPrefix+"_"):
+		return "project"
+	default:
+		return ""
+	}
+}
+
+func (s *sessionRecorder) newConnectionRecorder(ctx context.Context, connectionId string) (*ConnectionRecorder, error) {
+	const op = "recording.(sessionRecorder).newConnectionRecorder"
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.closed {
+		return nil, fmt.Errorf("%s: session recording is closed", op)
+	}
+	if _, ok := s.connections[connectionId]; ok {
+		return nil, fmt.Errorf("%s: connection %s is already being recorded", op, connectionId)
+	}
+	id, err := db.NewPublicId(ctx, globals.ConnectionRecordingPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	conn, err := s.session.NewConnection(ctx, &bsr.ConnectionRecordingMeta{Id: id})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	c := &ConnectionRecorder{
+		session:      s,
+		conn:         conn,
+		id:           id,
+		connectionId: connectionId,
+		start:        time.Now(),
+		channels:     make(map[string]*ChannelRecorder),
+	}
+	if c.requests, err = newChunkWriters(ctx, conn.NewRequestsWriter, s.sessionId); err != nil {
+		return nil, stderrors.Join(fmt.Errorf("%s: %w", op, err), conn.Close(ctx))
+	}
+	s.connections[connectionId] = c
+	s.connectionCount++
+	return c, nil
+}
+
+func (s *sessionRecorder) removeConnection(connectionId string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	delete(s.connections, connectionId)
+}
+
+// close closes all open connection recordings, writes the session summary
+// and closes the session container, which signs its checksums.
+func (s *sessionRecorder) close(ctx context.Context) error {
+	const op = "recording.(sessionRecorder).close"
+	s.l.Lock()
+	if s.closed {
+		s.l.Unlock()
+		return nil
+	}
+	s.closed = true
+	conns := make([]*ConnectionRecorder, 0, len(s.connections))
+	for _, c := range s.connections {
+		conns = append(conns, c)
+	}
+	s.l.Unlock()
+
+	var closeErr error
+	for _, c := range conns {
+		closeErr = stderrors.Join(closeErr, c.Close(ctx))
+	}
+	closeErr = stderrors.Join(closeErr, s.session.EncodeSummary(ctx, &bsr.BaseSessionSummary{
+		Id:              s.session.Meta.Id,
+		ConnectionCount: s.connectionCount,
+		StartTime:       s.start,
+		EndTime:         time.Now(),
+	}))
+	closeErr = stderrors.Join(closeErr, s.session.Close(ctx))
+	if closeErr != nil {
+		return fmt.Errorf("%s: %w", op, closeErr)
+	}
+	return nil
+}
+
+// ConnectionRecorder records a connection of a session into a connection
+// container of a BSR. Global requests are recorded by the ConnectionRecorder
+// and the data and requests of each channel by a ChannelRecorder.
+type ConnectionRecorder struct {
+	session      *sessionRecorder
+	conn         *bsr.Connection
+	id           string
+	connectionId string
+	start        time.Time
+	requests     map[bsr.Direction]*chunkWriter
+
+	l            sync.Mutex
+	channels     map[string]*ChannelRecorder
+	channelCount uint64
+	bytesUp      uint64
+	bytesDown    uint64
+	closed       bool
+}
+
+// Id returns the id of the connection recording.
+func (c *ConnectionRecorder) Id() string {
+	return c.id
+}
+
+// NewChannelRecorder returns a recorder for a new channel of the given type.
+// The caller must close the returned recorder once the channel is closed.
+func (c *ConnectionRecorder) NewChannelRecorder(ctx context.Context, channelType string) (*ChannelRecorder, error) {
+	const op = "recording.(ConnectionRecorder).NewChannelRecorder"
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.closed {
+		return nil, fmt.Errorf("%s: connection recording is closed", op)
+	}
+	id, err := bsr.NewChannelId()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ch, err := c.conn.NewChannel(ctx, &bsr.ChannelRecordingMeta{Id: id, Type: channelType})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	r := &ChannelRecorder{
+		conn: c,
+		ch:   ch,
+		summary: &bsrssh.ChannelSummary{
+			ChannelSummary: &bsr.BaseChannelSummary{
+				Id:                    id,
+				ConnectionRecordingId: c.id,
+				StartTime:             time.Now(),
+				ChannelType:           channelType,
+			},
+			SessionProgram:        bsrssh.NotApplicable,
+			ExecProgram:           bsrssh.ExecApplicationProgramNotApplicable,
+			FileTransferDirection: bsrssh.FileTransferNotApplicable,
+		},
+	}
+	if channelType == "session" {
+		r.summary.SessionProgram = bsrssh.None
+	}
+	if r.messages, err = newChunkWriters(ctx, ch.NewMessagesWriter, c.session.sessionId); err != nil {
+		return nil, stderrors.Join(fmt.Errorf("%s: %w", op, err), ch.Close(ctx))
+	}
+	if r.requests, err = newChunkWriters(ctx, ch.NewRequestsWriter, c.session.sessionId); err != nil {
+		return nil, stderrors.Join(fmt.Errorf("%s: %w", op, err), closeChunkWriters(ctx, r.messages), ch.Close(ctx))
+	}
+	c.channels[id] = r
+	c.channelCount++
+	return r, nil
+}
+
+// RecordRequest records a global request sent in the given direction.
+func (c *ConnectionRecorder) RecordRequest(ctx context.Context, dir bsr.Direction, r *ssh.Request) error {
+	const op = "recording.(ConnectionRecorder).RecordRequest"
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.closed {
+		return fmt.Errorf("%s: connection recording is closed", op)
+	}
+	if err := writeRequest(ctx, c.requests, dir, r); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (c *ConnectionRecorder) removeChannel(id string, bytesUp, bytesDown uint64) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	delete(c.channels, id)
+	c.bytesUp += bytesUp
+	c.bytesDown += bytesDown
+}
+
+// Close closes all open channel recordings, writes the connection summary
+// and closes the connection container. Calling Close more than once is not an
+// error.
+func (c *ConnectionRecorder) Close(ctx context.Context) error {
+	const op = "recording.(ConnectionRecorder).Close"
+	c.l.Lock()
+	if c.closed {
+		c.l.Unlock()
+		return nil
+	}
+	c.closed = true
+	chans := make([]*ChannelRecorder, 0, len(c.channels))
+	for _, ch := range c.channels {
+		chans = append(chans, ch)
+	}
+	c.l.Unlock()
+
+	var closeErr error
+	for _, ch := range chans {
+		closeErr = stderrors.Join(closeErr, ch.Close(ctx))
+	}
+
+	c.l.Lock()
+	closeErr = stderrors.Join(closeErr, closeChunkWriters(ctx, c.requests))
+	closeErr = stderrors.Join(closeErr, c.conn.EncodeSummary(ctx, &bsr.BaseConnectionSummary{
+		Id:           c.id,
+		ChannelCount: c.channelCount,
+		StartTime:    c.start,
+		EndTime:      time.Now(),
+		BytesUp:      c.bytesUp,
+		BytesDown:    c.bytesDown,
+	}))
+	c.l.Unlock()
+	closeErr = stderrors.Join(closeErr, c.conn.Close(ctx))
+	c.session.removeConnection(c.connectionId)
+	if closeErr != nil {
+		return fmt.Errorf("%s: %w", op, closeErr)
+	}
+	return nil
+}
+
+// ChannelRecorder records the data and requests of a channel into a channel
+// container of a BSR. Data and requests sent by the client are recorded as
+// bsr.Inbound and those sent by the endpoint as bsr.Outbound.
+type ChannelRecorder struct {
+	conn     *ConnectionRecorder
+	ch       *bsr.Channel
+	messages map[bsr.Direction]*chunkWriter
+	requests map[bsr.Direction]*chunkWriter
+
+	l       sync.Mutex
+	summary *bsrssh.ChannelSummary
+	closed  bool
+}
+
+// NewDataWriter returns an io.Writer which records everything written to it
+// as channel data sent in the given direction.
+func (c *ChannelRecorder) NewDataWriter(ctx context.Context, dir bsr.Direction) io.Writer {
+	return &dataWriter{ctx: ctx, ch: c, dir: dir}
+}
+
+type dataWriter struct {
+	ctx context.Context
+	ch  *ChannelRecorder
+	dir bsr.Direction
+}
+
+func (w *dataWriter) Write(b []byte) (int, error) {
+	if err := w.ch.recordData(w.ctx, w.dir, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (c *ChannelRecorder) recordData(ctx context.Context, dir bsr.Direction, b []byte) error {
+	const op = "recording.(ChannelRecorder).recordData"
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.closed {
+		return fmt.Errorf("%s: channel recording is closed", op)
+	}
+	w, ok := c.messages[dir]
+	if !ok {
+		return fmt.Errorf("%s: invalid direction: %w", op, bsr.ErrInvalidParameter)
+	}
+	for len(b) > 0 {
+		n := min(len(b), bsrssh.MaxPacketSize)
+		chunk, err := bsrssh.NewDataChunk(ctx, dir, bsr.NewTimestamp(time.Now()), b[:n])
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := w.write(ctx, chunk); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		switch dir {
+		case bsr.Inbound:
+			c.summary.ChannelSummary.BytesUp += uint64(n)
+		case bsr.Outbound:
+			c.summary.ChannelSummary.BytesDown += uint64(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// RecordRequest records a channel request sent in the given direction.
+func (c *ChannelRecorder) RecordRequest(ctx context.Context, dir bsr.Direction, r *ssh.Request) error {
+	const op = "recording.(ChannelRecorder).RecordRequest"
+	c.l.Lock()
+	defer c.l.Unlock()
+	if c.closed {
+		return fmt.Errorf("%s: channel recording is closed", op)
+	}
+	if err := writeRequest(ctx, c.requests, dir, r); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if dir == bsr.Inbound {
+		c.updateSessionProgram(r)
+	}
+	return nil
+}
+
+// updateSessionProgram updates the session program of the channel summary
+// based on a request sent by the client.
+func (c *ChannelRecorder) updateSessionProgram(r *ssh.Request) {
+	s := c.summary
+	if s.SessionProgram != bsrssh.None {
+		// Only one program can be started per session channel.
+		return
+	}
+	switch r.Type {
+	case bsrssh.ExecRequestType:
+		s.SessionProgram = bsrssh.Exec
+		s.ExecProgram = bsrssh.Unknown
+		var payload struct{ Command string }
+		if err := ssh.Unmarshal(r.Payload, &payload); err != nil {
+			return
+		}
+		args := strings.Fields(payload.Command)
+		if len(args) == 0 {
+			return
+		}
+		switch path.Base(args[0]) {
+		case "scp":
+			s.ExecProgram = bsrssh.Scp
+			for _, a := range args[1:] {
+				switch a {
+				case "-t":
+					s.FileTransferDirection = bsrssh.FileTransferUpload
+				case "-f":
+					s.FileTransferDirection = bsrssh.FileTransferDownload
+				}
+			}
+		case "rsync":
+			s.ExecProgram = bsrssh.Rsync
+			s.FileTransferDirection = bsrssh.FileTransferUpload
+			for _, a := range args[1:] {
+				if a == "--sender" {
+					s.FileTransferDirection = bsrssh.FileTransferDownload
+				}
+			}
+		}
+	case bsrssh.ShellRequestType:
+		s.SessionProgram = bsrssh.Shell
+	case bsrssh.SubsystemRequestType:
+		s.SessionProgram = bsrssh.Subsystem
+		var payload struct{ Name string }
+		if err := ssh.Unmarshal(r.Payload, &payload); err == nil {
+			s.SubsystemName = payload.Name
+		}
+	}
+}
+
+// Close writes the channel summary and closes the channel container. Calling
+// Close more than once is not an error.
+func (c *ChannelRecorder) Close(ctx context.Context) error {
+	const op = "recording.(ChannelRecorder).Close"
+	c.l.Lock()
+	if c.closed {
+		c.l.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.summary.ChannelSummary.EndTime = time.Now()
+
+	var closeErr error
+	closeErr = stderrors.Join(closeErr, closeChunkWriters(ctx, c.messages))
+	closeErr = stderrors.Join(closeErr, closeChunkWriters(ctx, c.requests))
+	closeErr = stderrors.Join(closeErr, c.ch.EncodeSummary(ctx, c.summary))
+	closeErr = stderrors.Join(closeErr, c.ch.Close(ctx))
+	up, down := c.summary.ChannelSummary.BytesUp, c.summary.ChannelSummary.BytesDown
+	c.l.Unlock()
+
+	c.conn.removeChannel(c.summary.GetId(), up, down)
+	if closeErr != nil {
+		return fmt.Errorf("%s: %w", op, closeErr)
+	}
+	return nil
+}
+
+// chunkWriter writes a BSR data file. The magic string and a header chunk are
+// written when it is created and an end chunk when it is closed.
+type chunkWriter struct {
+	w   storage.Writer
+	enc *bsr.ChunkEncoder
+	dir bsr.Direction
+}
+
+func newChunkWriter(ctx context.Context, w storage.Writer, dir bsr.Direction, sessionId string) (*chunkWriter, error) {
+	const op = "recording.newChunkWriter"
+	enc, err := bsr.NewChunkEncoder(ctx, w, bsr.NoCompression, bsr.NoEncryption)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := w.Write(bsr.Magic.Bytes()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	h, err := bsr.NewHeader(ctx, bsrssh.Protocol, dir, bsr.NewTimestamp(time.Now()), bsr.NoCompression, bsr.NoEncryption, sessionId)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := enc.Encode(ctx, h); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &chunkWriter{w: w, enc: enc, dir: dir}, nil
+}
+
+// newChunkWriters creates a chunkWriter for both directions using newFn to
+// create the underlying files.
+func newChunkWriters(ctx context.Context, newFn func(context.Context, bsr.Direction) (storage.Writer, error), sessionId string) (map[bsr.Direction]*chunkWriter, error) {
+	writers := make(map[bsr.Direction]*chunkWriter, 2)
+	for _, dir := range []bsr.Direction{bsr.Inbound, bsr.Outbound} {
+		w, err := newFn(ctx, dir)
+		if err != nil {
+			return nil, stderrors.Join(err, closeChunkWriters(ctx, writers))
+		}
+		cw, err := newChunkWriter(ctx, w, dir, sessionId)
+		if err != nil {
+			if c, ok := w.(io.Closer); ok {
+				err = stderrors.Join(err, c.Close())
+			}
+			return nil, stderrors.Join(err, closeChunkWriters(ctx, writers))
+		}
+		writers[dir] = cw
+	}
+	return writers, nil
+}
+
+func (c *chunkWriter) write(ctx context.Context, chunk bsr.Chunk) error {
+	_, err := c.enc.Encode(ctx, chunk)
+	return err
+}
+
+func (c *chunkWriter) close(ctx context.Context) error {
+	const op = "recording.(chunkWriter).close"
+	end, err := bsr.NewEnd(ctx, bsrssh.Protocol, c.dir, bsr.NewTimestamp(time.Now()))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	// Encoding the end chunk closes the underlying file.
+	if _, err := c.enc.Encode(ctx, end); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func closeChunkWriters(ctx context.Context, writers map[bsr.Direction]*chunkWriter) error {
+	var closeErr error
+	for _, w := range writers {
+		closeErr = stderrors.Join(closeErr, w.close(ctx))
+	}
+	return closeErr
+}
+
+// writeRequest records r as a request chunk in the writer for dir. Requests
+// which cannot be parsed are recorded as unknown requests.
+func writeRequest(ctx context.Context, writers map[bsr.Direction]*chunkWriter, dir bsr.Direction, r *ssh.Request) error {
+	w, ok := writers[dir]
+	if !ok {
+		return fmt.Errorf("invalid direction: %w", bsr.ErrInvalidParameter)
+	}
+	ts := bsr.NewTimestamp(time.Now())
+	chunk, err := bsrssh.NewRequest(ctx, dir, ts, r)
+	if err != nil {
+		if chunk, err = bsrssh.NewUnknownRequest(ctx, dir, ts, r); err != nil {
+			return err
+		}
+	}
+	return w.write(ctx, chunk)
+}
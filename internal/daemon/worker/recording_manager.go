@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	"github.com/hashicorp/boundary/version"
+)
+
+// recordingsDir is the directory within the worker's recording storage path
+// where session recordings are written.
+const recordingsDir = "recordings"
+
+func init() {
+	if recorderManagerFactory == nil {
+		recorderManagerFactory = newRecordingManager
+	}
+}
+
+// newRecordingManager creates a recording.Manager which writes session
+// recordings to the worker's recording storage path. No manager is created
+// when the worker has no recording storage path or no bsr kms configured.
+func newRecordingManager(w *Worker) (recorderManager, error) {
+	if w.conf.RawConfig.Worker.RecordingStoragePath == "" || w.conf.BsrKms == nil {
+		return nil, nil
+	}
+	ctx := context.Background()
+	fs, err := recording.NewLocalFS(ctx, filepath.Join(w.conf.RawConfig.Worker.RecordingStoragePath, recordingsDir))
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording storage: %w", err)
+	}
+	m, err := recording.NewManager(ctx, fs, w.conf.BsrKms, func() *bsr.Worker {
+		versionInfo := version.Get()
+		bw := &bsr.Worker{
+			Version: versionInfo.VersionNumber(),
+			Sha:     versionInfo.Revision,
+		}
+		if lastStatus := w.LastStatusSuccess(); lastStatus != nil {
+			bw.PublicId = lastStatus.GetWorkerId()
+		}
+		return bw
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating recording manager: %w", err)
+	}
+	return m, nil
+}
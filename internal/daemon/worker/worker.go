@@ -31,6 +31,8 @@ import (
 	pb "github.com/hashicorp/boundary/internal/gen/controller/servers"
 	pbs "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
 	wpbs "github.com/hashicorp/boundary/internal/gen/worker/servers/services"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
 	"github.com/hashicorp/boundary/internal/server"
 	"github.com/hashicorp/boundary/internal/storage"
 	boundary_plugin_assets "github.com/hashicorp/boundary/plugins/boundary"
@@ -325,6 +327,9 @@ func New(ctx context.Context, conf *Config) (*Worker, error) {
 				}
 				conf.ShutdownFuncs = append(conf.ShutdownFuncs, cleanup)
 				plgClients[pluginType] = client
+			case enabledPlugin == base.EnabledPluginFilesystem:
+				pluginType := strings.ToLower(enabledPlugin.String())
+				plgClients[pluginType] = loopback.NewWrappingPluginStorageClient(filesystem.NewFilesystemPlugin(conf.RawConfig.Plugins.FilesystemRootDir))
 			case enabledPlugin == base.EnabledPluginLoopback:
 				enableStorageLoopback = true
 			}
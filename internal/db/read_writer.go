@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/oplog/store"
+	"github.com/hashicorp/boundary/internal/tracing"
 	"github.com/hashicorp/go-dbw"
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 )
@@ -409,8 +410,10 @@ func (rw *Db) DeleteItems(ctx context.Context, deleteItems any, opt ...Option) (
 // you should ensure that any objects written to the db in your TxHandler are retryable, which
 // means that the object may be sent to the db several times (retried), so things like the primary key must
 // be reset before retry
-func (rw *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, handler TxHandler) (RetryInfo, error) {
+func (rw *Db) DoTx(ctx context.Context, retries uint, backOff Backoff, handler TxHandler) (_ RetryInfo, retErr error) {
 	const op = "db.DoTx"
+	ctx, span := tracing.Start(ctx, op)
+	defer func() { tracing.End(span, &retErr) }()
 	if rw.underlying == nil {
 		return RetryInfo{}, errors.New(ctx, errors.InvalidParameter, op, "missing underlying db")
 	}
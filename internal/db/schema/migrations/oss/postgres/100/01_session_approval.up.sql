@@ -0,0 +1,292 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- approval_required and approvers_filter are added to every target subtype.
+  -- approvers_filter is a go-bexpr filter evaluated against the user approving
+  -- a session of the target.
+  alter table target_tcp
+    add column approval_required boolean not null default false,
+    add column approvers_filter wt_bexprfilter;
+
+  alter table target_ssh
+    add column approval_required boolean not null default false,
+    add column approvers_filter wt_bexprfilter;
+
+  alter table target_postgres
+    add column approval_required boolean not null default false,
+    add column approvers_filter wt_bexprfilter;
+
+  alter table target_http
+    add column approval_required boolean not null default false,
+    add column approvers_filter wt_bexprfilter;
+
+  -- Replaces target_all_subtypes defined in oss/95/01_http_targets.up.sql.
+  -- The new columns are appended so the dependent whx_* views do not have to
+  -- be recreated.
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required,
+    approvers_filter
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required,
+    approvers_filter
+  from
+    target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'postgres' as type,
+    approval_required,
+    approvers_filter
+  from
+    target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'http' as type,
+    approval_required,
+    approvers_filter
+  from
+    target_http;
+
+  -- The approval settings of the target are copied to the session when it is
+  -- authorized, like the worker filters. approver_id is not a foreign key
+  -- since the session outlives the user who approved it.
+  alter table session
+    add column approval_required boolean not null default false,
+    add column approvers_filter wt_bexprfilter,
+    add column approver_id text,
+    add constraint approver_id_must_not_be_user_id
+      check(approver_id is null or approver_id <> user_id);
+
+  -- Replaces trigger from 87/01_session.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+    'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'correlation_id',
+    'approval_required', 'approvers_filter');
+
+  -- Add the awaiting-approval state, which is the first state of a session
+  -- that requires approval. An approved session moves to pending, otherwise it
+  -- is canceled or terminated once it expires.
+  alter table session_state_enm
+    drop constraint only_predefined_session_states_allowed,
+    add constraint only_predefined_session_states_allowed
+      check (
+        name in ('awaiting-approval', 'pending', 'active', 'canceling', 'terminated')
+      );
+
+  insert into session_state_enm (name)
+  values
+    ('awaiting-approval');
+
+  alter table session_valid_state
+    drop constraint prior_state_session_state_enm_fkey,
+    add constraint prior_state_session_state_enm_fkey
+      check (
+        prior_state in ('awaiting-approval', 'pending', 'active', 'canceling')
+      ),
+    drop constraint current_state_session_state_enm_fkey,
+    add constraint current_state_session_state_enm_fkey
+      check (
+        current_state in ('awaiting-approval', 'pending', 'active', 'canceling', 'terminated')
+      );
+
+  insert into session_valid_state (prior_state, current_state)
+  values
+    ('awaiting-approval', 'awaiting-approval'),
+    ('awaiting-approval', 'pending'),
+    ('awaiting-approval', 'canceling'),
+    ('awaiting-approval', 'terminated');
+
+  -- Replaces function from 0/50_session.up.sql
+  create or replace function insert_new_session_state() returns trigger
+  as $$
+  begin
+    if new.approval_required then
+      insert into session_state (session_id, state)
+      values
+        (new.public_id, 'awaiting-approval');
+    else
+      insert into session_state (session_id, state)
+      values
+        (new.public_id, 'pending');
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- Replaces function from 92/02_session_state_tstzrange.up.sql
+  create or replace function insert_session_state() returns trigger
+  as $$
+  declare
+      old_col_state text;
+  begin
+       update session_state
+          set active_time_range = tstzrange(lower(active_time_range), now(), '[)')
+        where session_id = new.session_id
+          and upper(active_time_range) is null
+    returning state
+         into old_col_state;
+
+    if not found then
+      if new.state = 'awaiting-approval' then
+        new.prior_state = 'awaiting-approval';
+      else
+        new.prior_state = 'pending';
+      end if;
+    else
+      new.prior_state = old_col_state;
+    end if;
+
+    new.active_time_range = tstzrange(now(), null, '[]');
+
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- Replaces function from 92/02_session_state_tstzrange.up.sql
+  -- The first state of a session is either pending or awaiting-approval. The
+  -- pending time of the session is updated when an awaiting session is
+  -- approved.
+  create or replace function wh_insert_session() returns trigger
+  as $$
+  declare
+    new_row wh_session_accumulating_fact%rowtype;
+  begin
+    with
+    pending_timestamp (date_dim_key, time_dim_key, ts) as (
+      select wh_date_key(lower(active_time_range)), wh_time_key(lower(active_time_range)), lower(active_time_range)
+        from session_state
+       where session_id = new.public_id
+         and state      in ('pending', 'awaiting-approval')
+    )
+    insert into wh_session_accumulating_fact (
+           session_id,
+           auth_token_id,
+           host_key,
+           user_key,
+           credential_group_key,
+           session_pending_date_key,
+           session_pending_time_key,
+           session_pending_time
+    )
+    select new.public_id,
+           new.auth_token_id,
+           'no host source', -- will be updated by wh_upsert_host
+           wh_upsert_user(new.auth_token_id),
+           'no credentials', -- will be updated by wh_upsert_credential_group
+           pending_timestamp.date_dim_key,
+           pending_timestamp.time_dim_key,
+           pending_timestamp.ts
+      from pending_timestamp
+      returning * into strict new_row;
+    return null;
+  end;
+  $$ language plpgsql;
+
+  -- Replaces function from 92/02_session_state_tstzrange.up.sql
+  create or replace function wh_insert_session_state() returns trigger
+  as $$
+  declare
+    date_col text;
+    time_col text;
+    ts_col text;
+    q text;
+    session_row wh_session_accumulating_fact%rowtype;
+  begin
+    if new.state = 'awaiting-approval' or
+       (new.state = 'pending' and new.prior_state = 'pending') then
+      -- The first state is handled by the wh_insert_session trigger. The
+      -- update statement in this trigger will fail for the first state because
+      -- the row for the session has not yet been inserted into the
+      -- wh_session_accumulating_fact table.
+      return null;
+    end if;
+
+    date_col = 'session_' || new.state || '_date_key';
+    time_col = 'session_' || new.state || '_time_key';
+    ts_col   = 'session_' || new.state || '_time';
+
+    q = format('   update wh_session_accumulating_fact
+                      set (%I, %I, %I) = (select wh_date_key(%L), wh_time_key(%L), %L::timestamptz)
+                    where session_id = %L
+                returning *',
+                date_col,       time_col,       ts_col,
+                lower(new.active_time_range), lower(new.active_time_range), lower(new.active_time_range),
+                new.session_id);
+    execute q into strict session_row;
+
+    return null;
+  end;
+  $$ language plpgsql;
+
+commit;
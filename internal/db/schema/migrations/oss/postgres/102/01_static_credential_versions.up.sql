@@ -0,0 +1,205 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- credential_version is the version of the secret held by a static
+  -- credential. Unlike the version column, it is only incremented when the
+  -- secret of the credential changes.
+  alter table credential_static_username_password_credential
+    add column credential_version wt_version,
+    add column rotation_period_seconds integer
+      constraint rotation_period_seconds_must_be_greater_than_0
+        check(rotation_period_seconds > 0);
+
+  alter table credential_static_ssh_private_key_credential
+    add column credential_version wt_version;
+
+  alter table credential_static_json_credential
+    add column credential_version wt_version;
+
+  create function update_credential_static_version_column() returns trigger
+  as $$
+  declare
+    col_name text;
+    new_value text;
+    old_value text;
+  begin
+    new.credential_version = old.credential_version;
+    foreach col_name in array tg_argv loop
+      execute format('SELECT $1.%I', col_name) into new_value using new;
+      execute format('SELECT $1.%I', col_name) into old_value using old;
+      if new_value is distinct from old_value then
+        new.credential_version = old.credential_version + 1;
+        exit;
+      end if;
+    end loop;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function update_credential_static_version_column() is
+    'update_credential_static_version_column is a before update trigger function for the static credential subtype tables. '
+    'It increments the credential_version column when one of the columns passed as an argument changes '
+    'and prevents the credential_version column from being set directly.';
+
+  create trigger update_credential_version_column before update on credential_static_username_password_credential
+    for each row execute procedure update_credential_static_version_column('username', 'password_hmac');
+  create trigger update_credential_version_column before update on credential_static_ssh_private_key_credential
+    for each row execute procedure update_credential_static_version_column('username', 'private_key_hmac', 'private_key_passphrase_hmac');
+  create trigger update_credential_version_column before update on credential_static_json_credential
+    for each row execute procedure update_credential_static_version_column('object_hmac');
+
+  create table credential_static_version (
+    credential_id wt_public_id not null
+      constraint credential_static_fkey
+        references credential_static (public_id)
+        on delete cascade
+        on update cascade,
+    credential_version bigint not null
+      constraint credential_version_must_be_greater_than_0
+        check(credential_version > 0),
+    username text,
+    password_encrypted bytea,
+    password_hmac bytea,
+    private_key_encrypted bytea,
+    private_key_hmac bytea,
+    private_key_passphrase_encrypted bytea,
+    private_key_passphrase_hmac bytea,
+    object_encrypted bytea,
+    object_hmac bytea,
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    create_time wt_timestamp,
+    primary key(credential_id, credential_version)
+  );
+  comment on table credential_static_version is
+    'credential_static_version is a table where each row contains the encrypted secret of a static credential '
+    'for one version of the credential. Rows are inserted by triggers on the static credential subtype tables.';
+
+  create trigger default_create_time_column before insert on credential_static_version
+    for each row execute procedure default_create_time();
+
+  -- The encrypted columns and key_id are not immutable so the rows can be
+  -- rewrapped when a data key version is destroyed.
+  create trigger immutable_columns before update on credential_static_version
+    for each row execute procedure immutable_columns('credential_id', 'credential_version', 'username',
+      'password_hmac', 'private_key_hmac', 'private_key_passphrase_hmac', 'object_hmac', 'create_time');
+
+  create function insert_credential_static_username_password_version() returns trigger
+  as $$
+  begin
+    insert into credential_static_version
+      (credential_id, credential_version, username, password_encrypted, password_hmac, key_id)
+    values
+      (new.public_id, new.credential_version, new.username, new.password_encrypted, new.password_hmac, new.key_id);
+    return null; -- result is ignored since this is an after trigger
+  end;
+  $$ language plpgsql;
+  comment on function insert_credential_static_username_password_version is
+    'insert_credential_static_username_password_version is an after trigger function '
+    'that records the secret of a new version of a username password credential.';
+
+  create function insert_credential_static_ssh_private_key_version() returns trigger
+  as $$
+  begin
+    insert into credential_static_version
+      (credential_id, credential_version, username,
+       private_key_encrypted, private_key_hmac,
+       private_key_passphrase_encrypted, private_key_passphrase_hmac, key_id)
+    values
+      (new.public_id, new.credential_version, new.username,
+       new.private_key_encrypted, new.private_key_hmac,
+       new.private_key_passphrase_encrypted, new.private_key_passphrase_hmac, new.key_id);
+    return null; -- result is ignored since this is an after trigger
+  end;
+  $$ language plpgsql;
+  comment on function insert_credential_static_ssh_private_key_version is
+    'insert_credential_static_ssh_private_key_version is an after trigger function '
+    'that records the secret of a new version of a ssh private key credential.';
+
+  create function insert_credential_static_json_version() returns trigger
+  as $$
+  begin
+    insert into credential_static_version
+      (credential_id, credential_version, object_encrypted, object_hmac, key_id)
+    values
+      (new.public_id, new.credential_version, new.object_encrypted, new.object_hmac, new.key_id);
+    return null; -- result is ignored since this is an after trigger
+  end;
+  $$ language plpgsql;
+  comment on function insert_credential_static_json_version is
+    'insert_credential_static_json_version is an after trigger function '
+    'that records the secret of a new version of a json credential.';
+
+  create trigger insert_credential_static_version after insert on credential_static_username_password_credential
+    for each row execute function insert_credential_static_username_password_version();
+  create trigger update_credential_static_version after update on credential_static_username_password_credential
+    for each row when (new.credential_version is distinct from old.credential_version)
+    execute function insert_credential_static_username_password_version();
+
+  create trigger insert_credential_static_version after insert on credential_static_ssh_private_key_credential
+    for each row execute function insert_credential_static_ssh_private_key_version();
+  create trigger update_credential_static_version after update on credential_static_ssh_private_key_credential
+    for each row when (new.credential_version is distinct from old.credential_version)
+    execute function insert_credential_static_ssh_private_key_version();
+
+  create trigger insert_credential_static_version after insert on credential_static_json_credential
+    for each row execute function insert_credential_static_json_version();
+  create trigger update_credential_static_version after update on credential_static_json_credential
+    for each row when (new.credential_version is distinct from old.credential_version)
+    execute function insert_credential_static_json_version();
+
+  -- Record the first version of the existing credentials.
+  insert into credential_static_version
+        (credential_id, credential_version, username, password_encrypted, password_hmac, key_id)
+  select public_id, credential_version, username, password_encrypted, password_hmac, key_id
+    from credential_static_username_password_credential;
+
+  insert into credential_static_version
+        (credential_id, credential_version, username,
+         private_key_encrypted, private_key_hmac,
+         private_key_passphrase_encrypted, private_key_passphrase_hmac, key_id)
+  select public_id, credential_version, username,
+         private_key_encrypted, private_key_hmac,
+         private_key_passphrase_encrypted, private_key_passphrase_hmac, key_id
+    from credential_static_ssh_private_key_credential;
+
+  insert into credential_static_version
+        (credential_id, credential_version, object_encrypted, object_hmac, key_id)
+  select public_id, credential_version, object_encrypted, object_hmac, key_id
+    from credential_static_json_credential;
+
+  -- credential_version is the version of the static credential a session
+  -- received. It is null for sessions created before credentials were
+  -- versioned.
+  alter table session_credential_static
+    add column credential_version bigint;
+
+  create function insert_session_credential_static_version() returns trigger
+  as $$
+  begin
+    select max(credential_version) into new.credential_version
+      from credential_static_version
+     where credential_id = new.credential_static_id;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function insert_session_credential_static_version is
+    'insert_session_credential_static_version is a before insert trigger function '
+    'that sets the credential_version of a session_credential_static row to the current version of the static credential.';
+
+  create trigger insert_session_credential_static_version before insert on session_credential_static
+    for each row execute function insert_session_credential_static_version();
+
+  -- Replaces the trigger defined in 40/01_credential.up.sql
+  drop trigger immutable_columns on session_credential_static;
+  create trigger immutable_columns before update on session_credential_static
+    for each row execute procedure immutable_columns('session_id', 'credential_static_id', 'credential_purpose', 'credential_version', 'create_time');
+
+  create index credential_static_version_key_id_idx
+    on credential_static_version (key_id);
+
+commit;
@@ -0,0 +1,116 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table credential_vault_auth_method_enm (
+    name text primary key
+      constraint only_predefined_auth_methods_allowed
+      check (
+        name in (
+          'approle',
+          'jwt'
+        )
+      )
+  );
+  comment on table credential_vault_auth_method_enm is
+    'credential_vault_auth_method_enm is an enumeration table for the Vault auth method a vault credential store uses to log in to Vault. '
+    'It contains rows for representing the AppRole and the JWT auth methods.';
+
+  insert into credential_vault_auth_method_enm (name)
+  values
+    ('approle'),
+    ('jwt');
+
+  create table credential_vault_store_auth_method (
+    store_id wt_public_id primary key
+      constraint credential_vault_store_fkey
+        references credential_vault_store (public_id)
+        on delete cascade
+        on update cascade,
+    method text not null
+      constraint credential_vault_auth_method_enm_fkey
+        references credential_vault_auth_method_enm (name)
+        on delete restrict
+        on update cascade,
+    mount_path text not null
+      constraint mount_path_must_not_be_empty
+        check(length(trim(mount_path)) > 0),
+    -- role is the role_id of the AppRole or the name of the JWT role
+    role text not null
+      constraint role_must_not_be_empty
+        check(length(trim(role)) > 0),
+    secret_id bytea -- encrypted AppRole secret_id
+      constraint secret_id_must_not_be_empty
+        check(length(secret_id) > 0),
+    secret_id_hmac bytea
+      constraint secret_id_hmac_must_not_be_empty
+        check(length(secret_id_hmac) > 0),
+    key_id text
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    constraint secret_id_required_for_approle_only
+      check(
+        (
+          method = 'approle'
+          and secret_id is not null
+          and secret_id_hmac is not null
+          and key_id is not null
+        )
+        or
+        (
+          method = 'jwt'
+          and secret_id is null
+          and secret_id_hmac is null
+          and key_id is null
+        )
+      )
+  );
+  comment on table credential_vault_store_auth_method is
+    'credential_vault_store_auth_method is a table where each row contains the Vault auth method a credential_vault_store uses to log in to Vault to obtain a Vault token. '
+    'A credential_vault_store can have 0 or 1 auth methods.';
+
+  create trigger immutable_columns before update on credential_vault_store_auth_method
+    for each row execute procedure immutable_columns('store_id');
+
+  -- Replaces view from 49/01_vault_credentials.up.sql
+  create or replace view credential_vault_store_list_lookup as
+  select store.public_id                   as public_id,
+         store.project_id                  as project_id,
+         store.name                        as name,
+         store.description                 as description,
+         store.create_time                 as create_time,
+         store.update_time                 as update_time,
+         store.delete_time                 as delete_time,
+         store.version                     as version,
+         store.vault_address               as vault_address,
+         store.namespace                   as namespace,
+         store.ca_cert                     as ca_cert,
+         store.tls_server_name             as tls_server_name,
+         store.tls_skip_verify             as tls_skip_verify,
+         store.worker_filter               as worker_filter,
+         token.token_hmac                  as token_hmac,
+         coalesce(token.status, 'expired') as token_status,
+         cert.certificate                  as client_cert,
+         cert.certificate_key_hmac         as client_cert_key_hmac,
+         auth.method                       as auth_method,
+         auth.mount_path                   as auth_mount_path,
+         auth.role                         as auth_role,
+         auth.secret_id_hmac               as auth_secret_id_hmac
+    from credential_vault_store store
+    left join credential_vault_token token
+      on store.public_id = token.store_id
+     and token.status = 'current'
+    left join credential_vault_client_certificate cert
+      on store.public_id = cert.store_id
+    left join credential_vault_store_auth_method auth
+      on store.public_id = auth.store_id
+   where store.delete_time is null;
+  comment on view credential_vault_store_list_lookup is
+    'credential_vault_store_list_lookup is a view where each row contains a credential store. '
+    'If the Vault token has expired this view will return an empty token_hmac and a token_status of ''expired'' '
+    'No encrypted data is returned. This view can be used to retrieve data which will be returned external to boundary.';
+
+commit;
@@ -0,0 +1,101 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table auth_password_method
+    add column totp_required boolean not null default false;
+  comment on column auth_password_method.totp_required is
+    'totp_required is true if every account of the auth method must provide a TOTP code to authenticate.';
+
+  -- Replaces view from 2/20_pass.up.sql
+  create or replace view auth_password_method_with_is_primary as
+  select
+    case when s.primary_auth_method_id is not null then
+      true
+    else false end
+    as is_primary_auth_method,
+    am.public_id,
+    am.scope_id,
+    am.password_conf_id,
+    am.name,
+    am.description,
+    am.create_time,
+    am.update_time,
+    am.version,
+    am.min_login_name_length,
+    am.min_password_length,
+    am.totp_required
+  from
+    auth_password_method am
+    left outer join iam_scope s on am.public_id = s.primary_auth_method_id;
+  comment on view auth_password_method_with_is_primary is
+    'password auth method with an is_primary_auth_method bool';
+
+  create table auth_password_totp (
+    account_id wt_public_id primary key
+      constraint auth_password_account_fkey
+        references auth_password_account (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    -- secret is the encrypted TOTP shared secret
+    secret bytea not null
+      constraint secret_must_not_be_empty
+        check(length(secret) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade,
+    -- last_used_counter is the time step counter of the last TOTP code used
+    -- to authenticate. A TOTP code can only be used once.
+    last_used_counter bigint not null default 0
+      constraint last_used_counter_must_not_be_negative
+        check(last_used_counter >= 0)
+  );
+  comment on table auth_password_totp is
+    'auth_password_totp is a table where each row contains the TOTP enrollment of an auth_password_account. '
+    'An auth_password_account can have 0 or 1 TOTP enrollments.';
+
+  create trigger default_create_time_column before insert on auth_password_totp
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_totp
+    for each row execute procedure immutable_columns('account_id', 'create_time');
+
+  create table auth_password_totp_recovery_code (
+    private_id wt_private_id primary key,
+    account_id wt_public_id not null
+      constraint auth_password_totp_fkey
+        references auth_password_totp (account_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    -- code is the encrypted recovery code
+    code bytea not null
+      constraint code_must_not_be_empty
+        check(length(code) > 0),
+    key_id kms_private_id not null
+      constraint kms_data_key_version_fkey
+        references kms_data_key_version (private_id)
+        on delete restrict
+        on update cascade
+  );
+  comment on table auth_password_totp_recovery_code is
+    'auth_password_totp_recovery_code is a table where each row contains a single use recovery code '
+    'which can be used instead of a TOTP code to authenticate an auth_password_account.';
+
+  create trigger default_create_time_column before insert on auth_password_totp_recovery_code
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on auth_password_totp_recovery_code
+    for each row execute procedure immutable_columns('private_id', 'account_id', 'create_time');
+
+  insert into oplog_ticket
+    (name, version)
+  values
+    ('auth_password_totp', 1),
+    ('auth_password_totp_recovery_code', 1);
+
+commit;
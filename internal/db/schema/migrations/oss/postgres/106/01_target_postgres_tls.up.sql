@@ -0,0 +1,20 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- The worker always connects to the endpoint of a postgres target using TLS
+  -- and verifies the certificate of the endpoint before sending the injected
+  -- credential. A null ssl_mode is the same as 'verify-full'.
+  alter table target_postgres
+    add column ssl_mode text null
+      constraint ssl_mode_must_be_valid
+        check(ssl_mode in ('verify-full', 'verify-ca')),
+    add column tls_ca_cert text null
+      constraint tls_ca_cert_must_not_be_empty
+        check(length(trim(tls_ca_cert)) > 0),
+    add column tls_server_name text null
+      constraint tls_server_name_must_not_be_empty
+        check(length(trim(tls_server_name)) > 0);
+
+commit;
@@ -0,0 +1,30 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- The worker sends the requests to the endpoint of an http target using the
+  -- scheme of the target, and verifies the certificate of the endpoint when
+  -- the scheme is 'https'. A null scheme is the same as 'https'. The worker
+  -- only injects credentials in requests sent using plain http when
+  -- allow_plaintext_credentials is true.
+  alter table target_http
+    add column scheme text null
+      constraint scheme_must_be_valid
+        check(scheme in ('https', 'http')),
+    add column tls_ca_cert text null
+      constraint tls_ca_cert_must_not_be_empty
+        check(length(trim(tls_ca_cert)) > 0),
+    add column tls_server_name text null
+      constraint tls_server_name_must_not_be_empty
+        check(length(trim(tls_server_name)) > 0),
+    add column allow_plaintext_credentials boolean null;
+
+  -- Existing targets used https only when their default port was 443. They
+  -- keep using plain http otherwise, but no longer receive credentials until
+  -- allow_plaintext_credentials is set.
+  update target_http
+     set scheme = 'http'
+   where default_port <> 443;
+
+commit;
@@ -0,0 +1,36 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table recording_search_index_failure (
+    recording_session_id wt_public_id primary key
+      constraint recording_session_fkey
+        references recording_session (public_id)
+        on delete cascade
+        on update cascade,
+    attempt_count integer not null
+      constraint attempt_count_must_be_positive
+        check(attempt_count > 0),
+    error_message text not null,
+    next_attempt_time timestamp with time zone not null,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table recording_search_index_failure is
+    'recording_search_index_failure contains an entry for every session recording whose lines could not be '
+    'extracted for the search index. The session recording is not indexed again before next_attempt_time.';
+
+  create trigger default_create_time_column before insert on recording_search_index_failure
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on recording_search_index_failure
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on recording_search_index_failure
+    for each row execute procedure immutable_columns('recording_session_id', 'create_time');
+
+  create index recording_search_index_failure_next_attempt_time_ix
+    on recording_search_index_failure (next_attempt_time);
+
+commit;
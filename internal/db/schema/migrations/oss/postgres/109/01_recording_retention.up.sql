@@ -0,0 +1,72 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- recording_session_retention is used by the storage policy enforcement job
+  -- to find the session recordings whose deletion date has passed. The
+  -- deletion date is computed from the current storage policy of the scope of
+  -- the storage bucket, so changes to the storage policy apply to the existing
+  -- session recordings. Without a storage policy, the stored delete_after of
+  -- the session recording is used. A session recording is never deleted
+  -- before its retain_until.
+  create view recording_session_retention as
+    select
+      rs.public_id as recording_session_id,
+      sb.scope_id,
+      rs.end_time,
+      rs.retain_until,
+      rs.delete_time,
+      case
+        when psp.public_id is null then rs.delete_after
+        when psp.delete_after_days > 0 then wt_add_days(psp.delete_after_days, rs.end_time)
+      end as delete_after,
+      coalesce(psp.legal_hold, false)
+        or rslh.recording_session_id is not null as held
+    from recording_session rs
+      join storage_plugin_storage_bucket sb
+        on sb.public_id = rs.storage_bucket_id
+      left join scope_policy_storage_policy spsp
+        on spsp.scope_id = sb.scope_id
+      left join policy_storage_policy psp
+        on psp.public_id = spsp.storage_policy_id
+      left join recording_session_legal_hold rslh
+        on rslh.recording_session_id = rs.public_id
+    where rs.end_time is not null;
+  comment on view recording_session_retention is
+    'recording_session_retention contains the retention of the ended session recordings, according to the '
+    'current storage policy of the scope of their storage bucket, and whether they are held against deletion.';
+
+  create table recording_retention_failure (
+    recording_session_id wt_public_id primary key
+      constraint recording_session_fkey
+        references recording_session (public_id)
+        on delete cascade
+        on update cascade,
+    attempt_count integer not null
+      constraint attempt_count_must_be_positive
+        check(attempt_count > 0),
+    error_message text not null,
+    next_attempt_time timestamp with time zone not null,
+    create_time wt_timestamp,
+    update_time wt_timestamp
+  );
+  comment on table recording_retention_failure is
+    'recording_retention_failure contains an entry for every session recording which the storage policy '
+    'enforcement job failed to delete. The session recording is not evaluated again before next_attempt_time.';
+
+  create trigger default_create_time_column before insert on recording_retention_failure
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on recording_retention_failure
+    for each row execute procedure update_time_column();
+
+  create trigger immutable_columns before update on recording_retention_failure
+    for each row execute procedure immutable_columns('recording_session_id', 'create_time');
+
+  -- Used to delete the retention reports which are older than the retention
+  -- period of the reports.
+  create index recording_retention_report_create_time_ix
+    on recording_retention_report (create_time);
+
+commit;
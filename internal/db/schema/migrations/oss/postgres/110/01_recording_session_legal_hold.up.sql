@@ -0,0 +1,32 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- Replaces function from 98/01_recording_session_legal_hold.up.sql
+  -- keep_legal_hold_retention is a trigger function that runs before update on
+  -- recording_session. The retention of a held session recording cannot be
+  -- changed, so an update changing it is rejected instead of silently leaving
+  -- the retention unchanged.
+  create or replace function keep_legal_hold_retention() returns trigger
+  as $$
+  begin
+    if (new.retain_for_days   is distinct from old.retain_for_days   or
+        new.delete_after_days is distinct from old.delete_after_days or
+        new.retain_until      is distinct from old.retain_until      or
+        new.delete_after      is distinct from old.delete_after      or
+        new.delete_time       is distinct from old.delete_time)
+       and exists (
+         select 1
+           from recording_session_legal_hold
+          where recording_session_id = new.public_id
+       ) then
+      raise exception 'retention of session recording % cannot be changed while under legal hold', new.public_id;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function keep_legal_hold_retention is
+    'keep_legal_hold_retention is a trigger function which rejects changes to the retention of a held session recording.';
+
+commit;
@@ -0,0 +1,141 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- approval_timeout_seconds limits how long a session of the target can await
+  -- approval. A null value means a session can await approval until it
+  -- expires.
+  alter table target_tcp
+    add column approval_timeout_seconds int
+      constraint approval_timeout_seconds_must_be_greater_than_0
+        check(approval_timeout_seconds > 0);
+
+  alter table target_ssh
+    add column approval_timeout_seconds int
+      constraint approval_timeout_seconds_must_be_greater_than_0
+        check(approval_timeout_seconds > 0);
+
+  alter table target_postgres
+    add column approval_timeout_seconds int
+      constraint approval_timeout_seconds_must_be_greater_than_0
+        check(approval_timeout_seconds > 0);
+
+  alter table target_http
+    add column approval_timeout_seconds int
+      constraint approval_timeout_seconds_must_be_greater_than_0
+        check(approval_timeout_seconds > 0);
+
+  -- Replaces target_all_subtypes defined in 100/01_session_approval.up.sql.
+  -- The new column is appended so the dependent whx_* views do not have to be
+  -- recreated.
+  create or replace view target_all_subtypes as
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'tcp' as type,
+    approval_required,
+    approvers_filter,
+    approval_timeout_seconds
+  from target_tcp
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    storage_bucket_id,
+    enable_session_recording,
+    'ssh' as type,
+    approval_required,
+    approvers_filter,
+    approval_timeout_seconds
+  from
+    target_ssh
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'postgres' as type,
+    approval_required,
+    approvers_filter,
+    approval_timeout_seconds
+  from
+    target_postgres
+  union
+  select
+    public_id,
+    project_id,
+    name,
+    description,
+    default_port,
+    session_max_seconds,
+    session_connection_limit,
+    version,
+    create_time,
+    update_time,
+    worker_filter,
+    egress_worker_filter,
+    ingress_worker_filter,
+    default_client_port,
+    null as storage_bucket_id,
+    false as enable_session_recording,
+    'http' as type,
+    approval_required,
+    approvers_filter,
+    approval_timeout_seconds
+  from
+    target_http;
+
+  -- approval_expiration_time is set from the approval timeout of the target
+  -- when the session is authorized. A session which is still awaiting approval
+  -- after this time is terminated.
+  alter table session
+    add column approval_expiration_time timestamp with time zone;
+
+  -- Replaces trigger from 100/01_session_approval.up.sql
+  drop trigger immutable_columns on session;
+  create trigger immutable_columns before update on session
+    for each row execute procedure immutable_columns('public_id', 'certificate', 'expiration_time', 'connection_limit',
+    'create_time', 'endpoint', 'worker_filter', 'egress_worker_filter', 'ingress_worker_filter', 'correlation_id',
+    'approval_required', 'approvers_filter', 'approval_expiration_time');
+
+commit;
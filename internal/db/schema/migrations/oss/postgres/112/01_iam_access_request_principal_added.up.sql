@@ -0,0 +1,75 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- principal_added is true while the user of an approved request is a
+  -- principal of the role because of the request. It is false if the user was
+  -- already a principal of the role when the request was approved, or was
+  -- removed from the role since, in which case the user is not removed from
+  -- the role when the request expires.
+  alter table iam_access_request
+    add column principal_added boolean not null default false;
+
+  -- Replaces the function defined in 99/01_iam_access_request.up.sql to only
+  -- allow principal_added to be set when the request is approved.
+  create or replace function iam_access_request_transition() returns trigger
+  as $$
+  begin
+    new.decision_time   = old.decision_time;
+    new.expiration_time = old.expiration_time;
+    new.revoke_time     = old.revoke_time;
+    if new.principal_added and not old.principal_added and
+       not (old.status = 'pending' and new.status = 'approved') then
+      raise exception 'principal_added of access request % can only be set when it is approved', old.public_id;
+    end if;
+    if new.status = old.status then
+      if new.approver_id is distinct from old.approver_id or
+         new.decision_comment is distinct from old.decision_comment then
+        raise exception 'access request % has already been decided', old.public_id;
+      end if;
+      return new;
+    end if;
+    case
+      when old.status = 'pending' and new.status in ('approved', 'denied') then
+        if new.approver_id is null then
+          raise exception 'access request % must be decided by an approver', old.public_id;
+        end if;
+        new.decision_time = now();
+        if new.status = 'approved' then
+          new.expiration_time = now() + make_interval(secs => new.duration_seconds);
+        end if;
+      when old.status = 'approved' and new.status = 'expired' then
+        new.revoke_time = now();
+      else
+        raise exception 'invalid access request status transition from % to %', old.status, new.status;
+    end case;
+    return new;
+  end;
+  $$ language plpgsql;
+
+  -- iam_user_role_access_request_principal_removed is a trigger function that
+  -- runs after delete on iam_user_role. It clears principal_added of the
+  -- approved requests of the removed user for the role, so that a user who is
+  -- removed from the role and added to it again directly is not removed when
+  -- the request expires.
+  create function iam_user_role_access_request_principal_removed() returns trigger
+  as $$
+  begin
+    update iam_access_request
+       set principal_added = false
+     where role_id = old.role_id
+       and user_id = old.principal_id
+       and status = 'approved'
+       and principal_added;
+    return null;
+  end;
+  $$ language plpgsql;
+  comment on function iam_user_role_access_request_principal_removed is
+    'iam_user_role_access_request_principal_removed is a trigger function which clears principal_added '
+    'of the approved access requests of a user removed from a role.';
+
+  create trigger iam_user_role_access_request_principal_removed after delete on iam_user_role
+    for each row execute procedure iam_user_role_access_request_principal_removed();
+
+commit;
@@ -0,0 +1,16 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- The worker verifies the host key of the endpoint of an ssh target against
+  -- known_hosts before injecting credentials. When known_hosts is null, the
+  -- worker refuses to connect to the endpoint unless
+  -- insecure_skip_host_key_verification is true.
+  alter table target_ssh
+    add column known_hosts text null
+      constraint known_hosts_must_not_be_empty
+        check(length(trim(known_hosts)) > 0),
+    add column insecure_skip_host_key_verification boolean null;
+
+commit;
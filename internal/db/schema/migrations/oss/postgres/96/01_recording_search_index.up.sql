@@ -0,0 +1,75 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table recording_search_index (
+    recording_session_id wt_public_id primary key
+      constraint recording_session_fkey
+        references recording_session (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp
+  );
+  comment on table recording_search_index is
+    'recording_search_index contains an entry for every session recording whose channels have been added '
+    'to the search index.';
+
+  create trigger default_create_time_column before insert on recording_search_index
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on recording_search_index
+    for each row execute procedure immutable_columns('recording_session_id', 'create_time');
+
+  create table recording_search_line_direction_enm (
+    name text primary key
+      constraint only_predefined_search_line_directions_allowed
+        check (
+          name in (
+            'stdin',
+            'stdout'
+          )
+        )
+  );
+  comment on table recording_search_line_direction_enm is
+    'recording_search_line_direction_enm is an enumeration table for the direction of the lines of a recorded channel.';
+
+  insert into recording_search_line_direction_enm (name)
+  values
+    ('stdin'),
+    ('stdout');
+
+  create trigger immutable_columns before update on recording_search_line_direction_enm
+    for each row execute procedure immutable_columns('name');
+
+  create table recording_search_line (
+    recording_channel_id wt_public_id not null
+      constraint recording_channel_fkey
+        references recording_channel (public_id)
+        on delete cascade
+        on update cascade,
+    line_number integer not null
+      constraint line_number_must_be_zero_or_positive
+        check(line_number >= 0),
+    line_time timestamp with time zone not null,
+    direction text not null
+      constraint recording_search_line_direction_enm_fkey
+        references recording_search_line_direction_enm (name)
+        on delete restrict
+        on update cascade,
+    content text not null,
+    content_tsv tsvector generated always as (to_tsvector('simple', content)) stored,
+    primary key (recording_channel_id, line_number)
+  );
+  comment on table recording_search_line is
+    'recording_search_line contains the lines of text extracted from a recorded channel. '
+    'Each line belongs to exactly one recording_channel.';
+
+  create trigger immutable_columns before update on recording_search_line
+    for each row execute procedure immutable_columns('recording_channel_id', 'line_number', 'line_time', 'direction', 'content');
+
+  create index recording_search_line_content_tsv_ix
+    on recording_search_line
+    using gin (content_tsv);
+
+commit;
@@ -0,0 +1,98 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  alter table policy_storage_policy add column legal_hold boolean not null default false;
+  comment on column policy_storage_policy.legal_hold is
+    'legal_hold prevents the deletion of the session recordings stored in the scope of the storage policy.';
+
+  create table recording_retention_report (
+    public_id wt_public_id primary key,
+    scope_id wt_scope_id not null
+      constraint iam_scope_fkey
+        references iam_scope (public_id)
+        on delete cascade
+        on update cascade,
+    create_time wt_timestamp,
+    recordings_evaluated integer not null
+      constraint recordings_evaluated_must_be_zero_or_positive
+        check(recordings_evaluated >= 0),
+    recordings_held integer not null
+      constraint recordings_held_must_be_zero_or_positive
+        check(recordings_held >= 0),
+    recordings_marked integer not null
+      constraint recordings_marked_must_be_zero_or_positive
+        check(recordings_marked >= 0),
+    recordings_deleted integer not null
+      constraint recordings_deleted_must_be_zero_or_positive
+        check(recordings_deleted >= 0),
+    recordings_failed integer not null
+      constraint recordings_failed_must_be_zero_or_positive
+        check(recordings_failed >= 0)
+  );
+  comment on table recording_retention_report is
+    'recording_retention_report contains the results of a run of the storage policy enforcement job '
+    'for the session recordings stored in the storage buckets of a scope.';
+
+  create trigger default_create_time_column before insert on recording_retention_report
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on recording_retention_report
+    for each row execute procedure immutable_columns('public_id', 'scope_id', 'create_time',
+        'recordings_evaluated', 'recordings_held', 'recordings_marked', 'recordings_deleted', 'recordings_failed');
+
+  create index recording_retention_report_scope_id_create_time_ix
+    on recording_retention_report (scope_id, create_time desc);
+
+  create table recording_retention_result_enm (
+    name text primary key
+      constraint only_predefined_retention_results_allowed
+        check (
+          name in (
+            'held',
+            'marked',
+            'deleted',
+            'failed'
+          )
+        )
+  );
+  comment on table recording_retention_result_enm is
+    'recording_retention_result_enm is an enumeration table for the result of the enforcement of a storage policy '
+    'on a session recording.';
+
+  insert into recording_retention_result_enm (name)
+  values
+    ('held'),
+    ('marked'),
+    ('deleted'),
+    ('failed');
+
+  create trigger immutable_columns before update on recording_retention_result_enm
+    for each row execute procedure immutable_columns('name');
+
+  -- recording_session_id is not a foreign key since the report outlives the
+  -- session recordings it deleted.
+  create table recording_retention_report_item (
+    report_id wt_public_id not null
+      constraint recording_retention_report_fkey
+        references recording_retention_report (public_id)
+        on delete cascade
+        on update cascade,
+    recording_session_id wt_public_id not null,
+    result text not null
+      constraint recording_retention_result_enm_fkey
+        references recording_retention_result_enm (name)
+        on delete restrict
+        on update cascade,
+    details text,
+    primary key (report_id, recording_session_id)
+  );
+  comment on table recording_retention_report_item is
+    'recording_retention_report_item contains the result of the enforcement of a storage policy on a session recording. '
+    'Each item belongs to exactly one recording_retention_report.';
+
+  create trigger immutable_columns before update on recording_retention_report_item
+    for each row execute procedure immutable_columns('report_id', 'recording_session_id', 'result', 'details');
+
+commit;
@@ -0,0 +1,124 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  -- user_id is not a foreign key since the hold outlives the user who placed
+  -- it.
+  create table recording_session_legal_hold (
+    recording_session_id wt_public_id primary key
+      constraint recording_session_fkey
+        references recording_session (public_id)
+        on delete cascade
+        on update cascade,
+    user_id wt_user_id,
+    reason text,
+    create_time wt_timestamp
+  );
+  comment on table recording_session_legal_hold is
+    'recording_session_legal_hold contains the session recordings which are held against deletion '
+    'and the user who placed the hold.';
+
+  create trigger default_create_time_column before insert on recording_session_legal_hold
+    for each row execute procedure default_create_time();
+
+  create trigger immutable_columns before update on recording_session_legal_hold
+    for each row execute procedure immutable_columns('recording_session_id', 'user_id', 'reason', 'create_time');
+
+  -- keep_legal_hold_retention is a trigger function that runs before update on
+  -- recording_session. The retention of a held session recording cannot be
+  -- changed, so re-applying a storage policy leaves it unchanged.
+  create function keep_legal_hold_retention() returns trigger
+  as $$
+  begin
+    if exists (
+      select 1
+        from recording_session_legal_hold
+       where recording_session_id = new.public_id
+    ) then
+      new.retain_for_days   = old.retain_for_days;
+      new.delete_after_days = old.delete_after_days;
+      new.retain_until      = old.retain_until;
+      new.delete_after      = old.delete_after;
+      new.delete_time       = old.delete_time;
+    end if;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function keep_legal_hold_retention is
+    'keep_legal_hold_retention is a trigger function which keeps the retention of a held session recording.';
+
+  create trigger keep_legal_hold_retention before update on recording_session
+    for each row execute procedure keep_legal_hold_retention();
+
+  create function prevent_legal_hold_delete() returns trigger
+  as $$
+  begin
+    if exists (
+      select 1
+        from recording_session_legal_hold
+       where recording_session_id = old.public_id
+    ) then
+      raise exception 'session recording % is under legal hold', old.public_id;
+    end if;
+    return old;
+  end;
+  $$ language plpgsql;
+  comment on function prevent_legal_hold_delete is
+    'prevent_legal_hold_delete is a trigger function which prevents the deletion of a held session recording.';
+
+  create trigger prevent_legal_hold_delete before delete on recording_session
+    for each row execute procedure prevent_legal_hold_delete();
+
+  -- Replaces view from 88/01_storage_bucket_credential.up.sql
+  drop view find_session_recordings_for_delete;
+  create view find_session_recordings_for_delete as
+    select
+      -- fields for session recordings
+      rs.public_id,
+      rs.storage_bucket_id,
+
+      -- fields for storage buckets. note this is ALL storage bucket fields
+      sb.scope_id    as storage_bucket_scope_id,
+      sb.name        as storage_bucket_name,
+      sb.description as storage_bucket_description,
+      sb.create_time as storage_bucket_create_time,
+      sb.update_time as storage_bucket_update_time,
+      sb.version     as storage_bucket_version,
+      sb.plugin_id,
+      sb.bucket_name,
+      sb.bucket_prefix,
+      sb.worker_filter,
+      sb.attributes,
+      sb.secrets_hmac,
+      sb.storage_bucket_credential_id,
+
+      -- fields for storage bucket secrets
+      sbcms.secrets_encrypted,
+      sbcms.key_id,
+
+      -- fields for storage bucket plugins
+      plg.scope_id    as plugin_scope_id,
+      plg.name        as plugin_name,
+      plg.description as plugin_description
+
+    from recording_session rs
+      left join storage_plugin_storage_bucket sb
+        on sb.public_id = rs.storage_bucket_id
+      left join storage_bucket_credential_managed_secret sbcms
+        on sbcms.storage_bucket_id = sb.public_id
+      left join plugin plg
+        on plg.public_id = sb.plugin_id
+    where (rs.delete_after < now() or rs.delete_time < now())
+      and not exists (
+            select 1
+              from recording_session_legal_hold rslh
+             where rslh.recording_session_id = rs.public_id
+          )
+    order by rs.delete_time desc, rs.delete_after desc;
+  comment on view find_session_recordings_for_delete is
+    'find_session_recordings_for_delete is used by the delete_session_recording job to find all '
+    'session recordings that need to be automatically deleted along with their storage buckets. '
+    'Session recordings under legal hold are skipped.';
+
+commit;
@@ -0,0 +1,137 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+begin;
+
+  create table iam_access_request_status_enm (
+    name text primary key
+      constraint only_predefined_access_request_statuses_allowed
+        check (
+          name in (
+            'pending',
+            'approved',
+            'denied',
+            'expired'
+          )
+        )
+  );
+  comment on table iam_access_request_status_enm is
+    'iam_access_request_status_enm is an enumeration table for the status of an access request.';
+
+  insert into iam_access_request_status_enm (name)
+  values
+    ('pending'),
+    ('approved'),
+    ('denied'),
+    ('expired');
+
+  create trigger immutable_columns before update on iam_access_request_status_enm
+    for each row execute procedure immutable_columns('name');
+
+  -- approver_id is not a foreign key since the request outlives the user who
+  -- decided it.
+  create table iam_access_request (
+    public_id wt_public_id primary key,
+    role_id wt_role_id
+      constraint iam_role_fkey
+        references iam_role (public_id)
+        on delete cascade
+        on update cascade,
+    user_id wt_user_id
+      constraint iam_user_fkey
+        references iam_user (public_id)
+        on delete cascade
+        on update cascade,
+    justification text not null
+      constraint justification_must_not_be_empty
+        check(length(trim(justification)) > 0),
+    duration_seconds integer not null
+      constraint duration_seconds_must_be_between_1_second_and_7_days
+        check(duration_seconds between 1 and 604800),
+    status text not null default 'pending'
+      constraint iam_access_request_status_enm_fkey
+        references iam_access_request_status_enm (name)
+        on delete restrict
+        on update cascade,
+    approver_id text,
+    decision_comment text,
+    create_time wt_timestamp,
+    update_time wt_timestamp,
+    decision_time timestamp with time zone,
+    expiration_time timestamp with time zone,
+    revoke_time timestamp with time zone,
+    version wt_version,
+    constraint approver_id_must_not_be_user_id
+      check(approver_id is null or approver_id <> user_id)
+  );
+  comment on table iam_access_request is
+    'iam_access_request contains the requests of users to be a principal of a role for a bounded duration. '
+    'The user is added to the role when the request is approved and removed when the duration has passed.';
+
+  -- A user can only have one open request for a role at a time.
+  create unique index iam_access_request_role_id_user_id_open_uq
+    on iam_access_request (role_id, user_id)
+    where status in ('pending', 'approved');
+
+  create index iam_access_request_role_id_create_time_ix
+    on iam_access_request (role_id, create_time desc);
+
+  create index iam_access_request_approved_expiration_time_ix
+    on iam_access_request (expiration_time)
+    where status = 'approved';
+
+  create trigger default_create_time_column before insert on iam_access_request
+    for each row execute procedure default_create_time();
+
+  create trigger update_time_column before update on iam_access_request
+    for each row execute procedure update_time_column();
+
+  create trigger update_version_column after update on iam_access_request
+    for each row execute procedure update_version_column();
+
+  create trigger immutable_columns before update on iam_access_request
+    for each row execute procedure immutable_columns('public_id', 'role_id', 'user_id',
+        'justification', 'duration_seconds', 'create_time');
+
+  -- iam_access_request_transition is a trigger function that runs before
+  -- update on iam_access_request. It only allows a pending request to be
+  -- approved or denied and an approved request to expire, and sets the times
+  -- of the transitions, which cannot be updated otherwise.
+  create function iam_access_request_transition() returns trigger
+  as $$
+  begin
+    new.decision_time   = old.decision_time;
+    new.expiration_time = old.expiration_time;
+    new.revoke_time     = old.revoke_time;
+    if new.status = old.status then
+      if new.approver_id is distinct from old.approver_id or
+         new.decision_comment is distinct from old.decision_comment then
+        raise exception 'access request % has already been decided', old.public_id;
+      end if;
+      return new;
+    end if;
+    case
+      when old.status = 'pending' and new.status in ('approved', 'denied') then
+        if new.approver_id is null then
+          raise exception 'access request % must be decided by an approver', old.public_id;
+        end if;
+        new.decision_time = now();
+        if new.status = 'approved' then
+          new.expiration_time = now() + make_interval(secs => new.duration_seconds);
+        end if;
+      when old.status = 'approved' and new.status = 'expired' then
+        new.revoke_time = now();
+      else
+        raise exception 'invalid access request status transition from % to %', old.status, new.status;
+    end case;
+    return new;
+  end;
+  $$ language plpgsql;
+  comment on function iam_access_request_transition is
+    'iam_access_request_transition is a trigger function which validates the status transitions of an access request '
+    'and sets their times.';
+
+  create trigger iam_access_request_transition before update on iam_access_request
+    for each row execute procedure iam_access_request_transition();
+
+commit;
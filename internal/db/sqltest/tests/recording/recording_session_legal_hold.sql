@@ -0,0 +1,82 @@
+-- Copyright (c) HashiCorp, Inc.
+-- SPDX-License-Identifier: BUSL-1.1
+
+-- recording_session_legal_hold tests the following triggers:
+--    keep_legal_hold_retention
+--    prevent_legal_hold_delete
+
+begin;
+  select plan(13);
+  select wtt_load('widgets', 'iam', 'kms', 'auth', 'hosts', 'targets', 'sessions');
+
+  select has_table('recording_session_legal_hold');
+  select has_trigger('recording_session', 'keep_legal_hold_retention');
+  select has_trigger('recording_session', 'prevent_legal_hold_delete');
+
+  -- the retention of a recording which is not held can be changed.
+  prepare update_retention as
+    update recording_session
+       set retain_for_days   = 10,
+           delete_after_days = 20
+     where public_id = 'sr1____clare';
+  select lives_ok('update_retention');
+
+  insert into recording_session_legal_hold
+    (recording_session_id, user_id,        reason)
+  values
+    ('sr1____clare',       'u______clare', 'litigation');
+
+  -- the retention of a held recording cannot be changed.
+  prepare update_held_retention as
+    update recording_session
+       set retain_for_days   = 1,
+           delete_after_days = 2
+     where public_id = 'sr1____clare';
+  select throws_ok('update_held_retention', 'P0001',
+                   'retention of session recording sr1____clare cannot be changed while under legal hold');
+
+  prepare update_held_retain_until as
+    update recording_session
+       set retain_until = now()
+     where public_id = 'sr1____clare';
+  select throws_ok('update_held_retain_until', 'P0001');
+
+  prepare update_held_delete_after as
+    update recording_session
+       set delete_after = now()
+     where public_id = 'sr1____clare';
+  select throws_ok('update_held_delete_after', 'P0001');
+
+  prepare update_held_delete_time as
+    update recording_session
+       set delete_time = now()
+     where public_id = 'sr1____clare';
+  select throws_ok('update_held_delete_time', 'P0001');
+
+  select is(retain_for_days, 10)
+    from recording_session
+   where public_id = 'sr1____clare';
+  select ok(delete_time is null)
+    from recording_session
+   where public_id = 'sr1____clare';
+
+  -- updates which leave the retention unchanged are allowed.
+  prepare update_held_unchanged as
+    update recording_session
+       set retain_for_days = 10
+     where public_id = 'sr1____clare';
+  select lives_ok('update_held_unchanged');
+
+  -- a held recording cannot be deleted.
+  prepare delete_held as
+    delete from recording_session
+     where public_id = 'sr1____clare';
+  select throws_ok('delete_held', 'P0001', 'session recording sr1____clare is under legal hold');
+
+  -- once the hold is removed the recording can be deleted.
+  delete from recording_session_legal_hold
+   where recording_session_id = 'sr1____clare';
+  select lives_ok('delete_held');
+
+  select * from finish();
+rollback;
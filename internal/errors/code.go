@@ -88,6 +88,14 @@ const (
 	// new passwords are equal.
 	PasswordsEqual Code = 203
 
+	// TotpCodeRequired is returned from Authenticate when the password is
+	// correct but the account requires a TOTP code and none was provided.
+	TotpCodeRequired Code = 204
+
+	// TotpEnrollmentRequired is returned from Authenticate when the auth
+	// method requires TOTP and the account is not enrolled in TOTP.
+	TotpEnrollmentRequired Code = 205
+
 	Encrypt Code = 300 // Encrypt represents an error occurred during the underlying encryption process
 	Decrypt Code = 301 // Decrypt represents an error occurred during the underlying decryption process
 	Encode  Code = 302 // Encode represents an error occurred during the underlying encoding/marshaling process
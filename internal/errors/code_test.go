@@ -165,6 +165,16 @@ func TestCode_Both_String_Info(t *testing.T) {
 			c:    PasswordsEqual,
 			want: PasswordsEqual,
 		},
+		{
+			name: "TotpCodeRequired",
+			c:    TotpCodeRequired,
+			want: TotpCodeRequired,
+		},
+		{
+			name: "TotpEnrollmentRequired",
+			c:    TotpEnrollmentRequired,
+			want: TotpEnrollmentRequired,
+		},
 		{
 			name: "Encrypt",
 			c:    Encrypt,
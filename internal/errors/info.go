@@ -123,6 +123,14 @@ var errorCodeInfo = map[Code]Info{
 		Message: "old and new password are equal",
 		Kind:    Password,
 	},
+	TotpCodeRequired: {
+		Message: "totp code required",
+		Kind:    Password,
+	},
+	TotpEnrollmentRequired: {
+		Message: "totp enrollment required",
+		Kind:    Password,
+	},
 	Encrypt: {
 		Message: "error occurred during encrypt",
 		Kind:    Encryption,
@@ -281,6 +281,8 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 	// we need to keep track of all the Sink filenames to ensure they aren't
 	// reused.
 	allSinkFilenames := map[string]bool{}
+	var httpSinks []*httpSink
+	var hashChainSinks []*hashChainFileSink
 
 	for _, s := range c.Sinks {
 		fmtId, fmtNode, err := newFmtFilterNode(serverName, *s, opt...)
@@ -288,6 +290,15 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		if err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
+		if s.Sampling != nil || s.RateLimit != nil {
+			// the sampling filter wraps the filter/format node, so only the
+			// events which pass the sink's filters are sampled and rate
+			// limited.
+			fmtNode, err = newSamplingFilter(s.Name, fmtNode, s.Sampling, s.RateLimit)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+		}
 		err = e.broker.RegisterNode(eventlogger.NodeID(fmtId), fmtNode)
 		if err != nil {
 			return nil, fmt.Errorf("%s: unable to register fmt/filter node: %w", op, err)
@@ -312,7 +323,7 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: duplicate file sink: %s %s: %w", op, fsc.Path, fsc.FileName, ErrInvalidParameter)
 			}
 			allSinkFilenames[fsc.Path+fsc.FileName] = true
-			sinkNode = &eventlogger.FileSink{
+			fileSink := &eventlogger.FileSink{
 				Format:      string(s.Format),
 				Path:        fsc.Path,
 				FileName:    fsc.FileName,
@@ -320,6 +331,16 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				MaxDuration: fsc.RotateDuration,
 				MaxFiles:    fsc.RotateMaxFiles,
 			}
+			sinkNode = fileSink
+			if fsc.HashChain {
+				hcs, err := newHashChainFileSink(context.Background(), fileSink, s.Format, fsc.CheckpointEvents, opts.withAuditWrapper)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", op, err)
+				}
+				e.auditWrapperNodes = append(e.auditWrapperNodes, hcs)
+				hashChainSinks = append(hashChainSinks, hcs)
+				sinkNode = hcs
+			}
 			id, err := NewId(fmt.Sprintf("file_%s_%s_", fsc.Path, fsc.FileName))
 			if err != nil {
 				return nil, fmt.Errorf("%s: %w", op, err)
@@ -336,6 +357,31 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 				return nil, fmt.Errorf("%s: %w", op, err)
 			}
 			sinkId = eventlogger.NodeID(id)
+		case HttpSink:
+			hs, err := newHttpSink(s.Format, s.HttpConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			// http sinks are flushed after the gated filters, which may
+			// send them queued events.
+			httpSinks = append(httpSinks, hs)
+			sinkNode = hs
+			id, err := NewId("http")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
+		case SyslogSink:
+			ss, err := newSyslogSink(s.Format, s.SyslogConfig)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkNode = ss
+			id, err := NewId("syslog")
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			sinkId = eventlogger.NodeID(id)
 		default:
 			return nil, fmt.Errorf("%s: unknown sink type %s", op, s.Type)
 		}
@@ -528,6 +574,13 @@ func NewEventer(log hclog.Logger, serializationLock *sync.Mutex, serverName stri
 		sysNodeIds = append(sysNodeIds, p.sinkId)
 	}
 
+	for _, hs := range httpSinks {
+		e.flushableNodes = append(e.flushableNodes, hs)
+	}
+	for _, hcs := range hashChainSinks {
+		e.flushableNodes = append(e.flushableNodes, hcs)
+	}
+
 	err := e.broker.SetSuccessThreshold(eventlogger.EventType(ObservationType), len(observationNodeIds))
 	if err != nil {
 		return nil, fmt.Errorf("%s: failed to set success threshold for observation events: %w", op, err)
@@ -634,6 +687,10 @@ func (e *Eventer) RotateAuditWrapper(ctx context.Context, newWrapper wrapping.Wr
 			w.Rotate(newWrapper)
 		case *encrypt.Filter:
 			w.Rotate(encrypt.WithWrapper(newWrapper))
+		case *hashChainFileSink:
+			if err := w.Rotate(ctx, newWrapper); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
 		default:
 			return fmt.Errorf("%s: unsupported node type (%s): %w", op, reflect.TypeOf(w), ErrInvalidParameter)
 		}
@@ -0,0 +1,591 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+const (
+	// HashChainEventRecord is the type of a record holding an audit event.
+	HashChainEventRecord = "event"
+	// HashChainCheckpointRecord is the type of a record which attests to the
+	// chain up to the previous record at its time.
+	HashChainCheckpointRecord = "checkpoint"
+
+	defaultCheckpointEvents = 100
+
+	// hashChainInfo separates the hmac key of the chain from the other keys
+	// derived from the audit key.
+	hashChainInfo = "boundary-audit-hash-chain"
+	// hashChainCheckpointInfo separates the key signing the checkpoints from
+	// the hmac key of the chain, so the hmac key alone cannot be used to
+	// forge a checkpoint.
+	hashChainCheckpointInfo = "boundary-audit-hash-chain-checkpoint"
+)
+
+// HashChainRecord is a line of a hash chained audit file. Each record holds
+// the hmac of the previous record and is authenticated by its own hmac, which
+// is derived from the KMS audit key identified by the KeyId. Removing, adding,
+// reordering or editing records breaks the chain.
+//
+// A checkpoint record also holds the number of records of the chain before it
+// and the hmac of the first record of the chain, and is signed with another
+// key derived from the audit key. Checkpoints are used to detect records
+// removed from the start or the end of the chain.
+type HashChainRecord struct {
+	Type      string          `json:"type"`
+	Seq       uint64          `json:"seq"`
+	KeyId     string          `json:"key_id"`
+	Prev      string          `json:"prev"`
+	Time      time.Time       `json:"time"`
+	Event     json.RawMessage `json:"event,omitempty"`
+	Count     uint64          `json:"count,omitempty"`
+	First     string          `json:"first,omitempty"`
+	Hmac      string          `json:"hmac"`
+	Signature string          `json:"signature,omitempty"`
+}
+
+// signedBytes returns the bytes covered by the hmac of the record.
+func (r *HashChainRecord) signedBytes() []byte {
+	var buf bytes.Buffer
+	buf.WriteString(r.Type)
+	buf.WriteByte('\n')
+	buf.WriteString(strconv.FormatUint(r.Seq, 10))
+	buf.WriteByte('\n')
+	buf.WriteString(r.KeyId)
+	buf.WriteByte('\n')
+	buf.WriteString(r.Prev)
+	buf.WriteByte('\n')
+	buf.WriteString(r.Time.UTC().Format(time.RFC3339Nano))
+	buf.WriteByte('\n')
+	buf.Write(r.Event)
+	if r.Type == HashChainCheckpointRecord {
+		buf.WriteByte('\n')
+		buf.WriteString(strconv.FormatUint(r.Count, 10))
+		buf.WriteByte('\n')
+		buf.WriteString(r.First)
+	}
+	return buf.Bytes()
+}
+
+func hashChainHmac(ctx context.Context, w wrapping.Wrapper, r *HashChainRecord) (string, error) {
+	sign, err := newSigner(ctx, w, nil, []byte(hashChainInfo))
+	if err != nil {
+		return "", err
+	}
+	return sign(ctx, r.signedBytes())
+}
+
+// hashChainSignature returns the signature of a checkpoint record, which
+// covers its hmac.
+func hashChainSignature(ctx context.Context, w wrapping.Wrapper, r *HashChainRecord) (string, error) {
+	sign, err := newSigner(ctx, w, nil, []byte(hashChainCheckpointInfo))
+	if err != nil {
+		return "", err
+	}
+	b := append(r.signedBytes(), '\n')
+	return sign(ctx, append(b, r.Hmac...))
+}
+
+// hashChainFileSink is an eventlogger sink which writes audit events to a
+// file sink as hash chained records. A checkpoint record is written after
+// every checkpointEvents event records and when the sink is flushed.
+type hashChainFileSink struct {
+	fileSink         *eventlogger.FileSink
+	format           string
+	checkpointEvents int
+
+	mu              sync.Mutex
+	wrapper         wrapping.Wrapper
+	keyId           string
+	seq             uint64
+	prev            string
+	first           string
+	sinceCheckpoint int
+}
+
+var (
+	_ eventlogger.Node = (*hashChainFileSink)(nil)
+	_ flushable        = (*hashChainFileSink)(nil)
+)
+
+// newHashChainFileSink creates a hash chain sink which writes to the file
+// sink. The chain resumes from the last record of the current file of the file
+// sink, if any, unless the first record of the chain cannot be found in the
+// files of the sink, in which case a new chain is started. Events cannot be
+// written until the sink has an audit wrapper, see Rotate.
+func newHashChainFileSink(ctx context.Context, fs *eventlogger.FileSink, format SinkFormat, checkpointEvents int, w wrapping.Wrapper) (*hashChainFileSink, error) {
+	const op = "event.newHashChainFileSink"
+	if fs == nil {
+		return nil, fmt.Errorf("%s: missing file sink: %w", op, ErrInvalidParameter)
+	}
+	s := &hashChainFileSink{
+		fileSink:         fs,
+		format:           string(format),
+		checkpointEvents: withDefault(checkpointEvents, defaultCheckpointEvents),
+	}
+	last, first, err := resumeHashChain(fs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to resume hash chain: %w", op, err)
+	}
+	if last != nil && first != "" {
+		s.seq = last.Seq
+		s.prev = last.Hmac
+		s.first = first
+	}
+	if w != nil {
+		if err := s.Rotate(ctx, w); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return s, nil
+}
+
+// Rotate sets the wrapper of the KMS audit key used to compute the hmacs of the
+// records.
+func (s *hashChainFileSink) Rotate(ctx context.Context, w wrapping.Wrapper) error {
+	const op = "event.(hashChainFileSink).Rotate"
+	if w == nil {
+		return fmt.Errorf("%s: missing wrapper: %w", op, ErrInvalidParameter)
+	}
+	keyId, err := w.KeyId(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: unable to get key id: %w", op, err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.wrapper = w
+	s.keyId = keyId
+	return nil
+}
+
+// Process writes the event as the next record of the chain.
+func (s *hashChainFileSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(hashChainFileSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled", op)
+	}
+	var compacted bytes.Buffer
+	if err := json.Compact(&compacted, val); err != nil {
+		return nil, fmt.Errorf("%s: unable to compact event: %w", op, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.write(ctx, HashChainEventRecord, compacted.Bytes()); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	s.sinceCheckpoint++
+	if s.sinceCheckpoint >= s.checkpointEvents {
+		if err := s.write(ctx, HashChainCheckpointRecord, nil); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		s.sinceCheckpoint = 0
+	}
+	// Sinks are leafs, so do not return the event, since nothing more can
+	// happen to it downstream.
+	return nil, nil
+}
+
+// FlushAll writes a checkpoint record if events were written since the last
+// one.
+func (s *hashChainFileSink) FlushAll(ctx context.Context) error {
+	const op = "event.(hashChainFileSink).FlushAll"
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.sinceCheckpoint == 0 {
+		return nil
+	}
+	if err := s.write(ctx, HashChainCheckpointRecord, nil); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.sinceCheckpoint = 0
+	return nil
+}
+
+// Reopen the underlying file sink.
+func (s *hashChainFileSink) Reopen() error {
+	return s.fileSink.Reopen()
+}
+
+// Type describes the type of the node as a Sink.
+func (s *hashChainFileSink) Type() eventlogger.NodeType { return eventlogger.NodeTypeSink }
+
+// write appends a record to the chain. s.mu must be held.
+func (s *hashChainFileSink) write(ctx context.Context, recordType string, event []byte) error {
+	if s.wrapper == nil {
+		return fmt.Errorf("missing audit wrapper: %w", ErrInvalidParameter)
+	}
+	r := &HashChainRecord{
+		Type:  recordType,
+		Seq:   s.seq + 1,
+		KeyId: s.keyId,
+		Prev:  s.prev,
+		Time:  time.Now().UTC(),
+		Event: event,
+	}
+	if recordType == HashChainCheckpointRecord {
+		r.Count = s.seq
+		r.First = s.first
+	}
+	var err error
+	if r.Hmac, err = hashChainHmac(ctx, s.wrapper, r); err != nil {
+		return fmt.Errorf("unable to compute hmac: %w", err)
+	}
+	if recordType == HashChainCheckpointRecord {
+		if r.Signature, err = hashChainSignature(ctx, s.wrapper, r); err != nil {
+			return fmt.Errorf("unable to sign checkpoint: %w", err)
+		}
+	}
+	line, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("unable to encode record: %w", err)
+	}
+	e := &eventlogger.Event{
+		Type:      eventlogger.EventType(AuditType),
+		CreatedAt: r.Time,
+	}
+	e.FormattedAs(s.fileSink.Format, append(line, '\n'))
+	if _, err := s.fileSink.Process(ctx, e); err != nil {
+		return err
+	}
+	s.seq = r.Seq
+	s.prev = r.Hmac
+	if s.first == "" {
+		s.first = r.Hmac
+	}
+	return nil
+}
+
+// resumeHashChain returns the last record of the current file of a file sink,
+// or nil if there is none, and the hmac of the first record of its chain. The
+// first hmac is taken from the last record if it is a checkpoint, otherwise
+// the files of the sink are searched for a checkpoint or for the first record
+// of the chain, newest first. It is empty if neither can be found.
+func resumeHashChain(fs *eventlogger.FileSink) (*HashChainRecord, string, error) {
+	files, err := hashChainFiles(fs)
+	if err != nil || len(files) == 0 {
+		return nil, "", err
+	}
+	line, err := lastLine(files[len(files)-1])
+	if err != nil || len(line) == 0 {
+		return nil, "", err
+	}
+	var last HashChainRecord
+	if err := json.Unmarshal(line, &last); err != nil {
+		return nil, "", fmt.Errorf("last line of %s is not a hash chain record: %w", filepath.Base(files[len(files)-1]), err)
+	}
+	if last.Type == HashChainCheckpointRecord {
+		return &last, last.First, nil
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		first, err := hashChainFirst(files[i])
+		if err != nil {
+			return nil, "", err
+		}
+		if first != "" {
+			return &last, first, nil
+		}
+	}
+	return &last, "", nil
+}
+
+// hashChainFiles returns the paths of the files of a file sink, oldest first.
+func hashChainFiles(fs *eventlogger.FileSink) ([]string, error) {
+	if fs.MaxBytes == 0 && fs.MaxDuration == 0 {
+		return []string{filepath.Join(fs.Path, fs.FileName)}, nil
+	}
+	// with rotation, file names have a creation timestamp, so the current
+	// file is the last one.
+	ext := filepath.Ext(fs.FileName)
+	if ext == "" {
+		ext = ".log"
+	}
+	pattern := fs.FileName[:len(fs.FileName)-len(filepath.Ext(fs.FileName))] + "-*" + ext
+	matches, err := filepath.Glob(filepath.Join(fs.Path, pattern))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(matches)
+	return matches, nil
+}
+
+// hashChainFirst returns the hmac of the first record of the chain of a file,
+// as found in the file's last checkpoint or first record of the chain. It is
+// empty if the file has neither, or does not exist.
+func hashChainFirst(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if stderrors.Is(err, os.ErrNotExist) {
+			return "", nil
+		}
+		return "", err
+	}
+	defer f.Close()
+	var first string
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	for sc.Scan() {
+		var r HashChainRecord
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			continue
+		}
+		switch {
+		case r.Type == HashChainCheckpointRecord:
+			first = r.First
+		case r.Seq == 1 && r.Prev == "":
+			first = r.Hmac
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return "", err
+	}
+	return first, nil
+}
+
+// lastLine returns the last non-empty line of a file, or nil if the file does
+// not exist or is empty.
+func lastLine(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if stderrors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	const chunk = 64 * 1024
+	end := fi.Size()
+	var tail []byte
+	for end > 0 {
+		start := max(end-chunk, 0)
+		buf := make([]byte, end-start)
+		if _, err := f.ReadAt(buf, start); err != nil && err != io.EOF {
+			return nil, err
+		}
+		tail = append(buf, tail...)
+		trimmed := bytes.TrimRight(tail, "\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+		end = start
+	}
+	return bytes.TrimRight(tail, "\n"), nil
+}
+
+// HashChainWrapperFn returns the wrapper of the KMS audit key with the
+// provided key id.
+type HashChainWrapperFn func(ctx context.Context, keyId string) (wrapping.Wrapper, error)
+
+// HashChainBreak is the first broken link found in a hash chain.
+type HashChainBreak struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Seq    uint64 `json:"seq,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// HashChainVerification is the result of verifying hash chained audit files.
+// Unattested is the number of records after the last checkpoint.
+type HashChainVerification struct {
+	Records        int             `json:"records"`
+	Events         int             `json:"events"`
+	Checkpoints    int             `json:"checkpoints"`
+	Unattested     int             `json:"unattested,omitempty"`
+	FirstSeq       uint64          `json:"first_seq,omitempty"`
+	LastSeq        uint64          `json:"last_seq,omitempty"`
+	LastCheckpoint *time.Time      `json:"last_checkpoint,omitempty"`
+	Break          *HashChainBreak `json:"break,omitempty"`
+}
+
+// HashChainVerifier verifies hash chained audit files. Files are verified as
+// one chain in the order of the calls to Verify, so the rotated files of a
+// sink can be verified together.
+//
+// The chain must start at its first record, and the checkpoints must attest
+// to it and to the number of records before them. With WithAllowPruned, the
+// first record verified is trusted to link to a previous record, since the
+// files before it may have been pruned, and the checkpoints must attest to the
+// same first record. Unless WithAllowUnattested is used, the chain must end
+// with a checkpoint, see Finish.
+type HashChainVerifier struct {
+	wrapperFn       HashChainWrapperFn
+	allowPruned     bool
+	allowUnattested bool
+	wrappers        map[string]wrapping.Wrapper
+	result          HashChainVerification
+	prev            *HashChainRecord
+	first           string
+	lastFile        string
+	lastLine        int
+}
+
+// NewHashChainVerifier creates a verifier which gets the wrappers of the audit
+// keys with the provided func.
+//
+// Supported options: WithAllowPruned, WithAllowUnattested
+func NewHashChainVerifier(wrapperFn HashChainWrapperFn, opt ...Option) (*HashChainVerifier, error) {
+	const op = "event.NewHashChainVerifier"
+	if wrapperFn == nil {
+		return nil, fmt.Errorf("%s: missing wrapper func: %w", op, ErrInvalidParameter)
+	}
+	opts := getOpts(opt...)
+	return &HashChainVerifier{
+		wrapperFn:       wrapperFn,
+		allowPruned:     opts.withAllowPruned,
+		allowUnattested: opts.withAllowUnattested,
+		wrappers:        map[string]wrapping.Wrapper{},
+	}, nil
+}
+
+// Verify the records read from r, which are identified by the file name in the
+// result. It returns false once a broken link has been found, which is
+// reported by Result. An error is returned if the records could not be read or
+// their hmacs could not be computed.
+func (v *HashChainVerifier) Verify(ctx context.Context, file string, r io.Reader) (bool, error) {
+	const op = "event.(HashChainVerifier).Verify"
+	if v.result.Break != nil {
+		return false, nil
+	}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+	line := 0
+	broken := func(seq uint64, reason string) (bool, error) {
+		v.result.Break = &HashChainBreak{File: file, Line: line, Seq: seq, Reason: reason}
+		return false, nil
+	}
+	for sc.Scan() {
+		line++
+		if len(bytes.TrimSpace(sc.Bytes())) == 0 {
+			continue
+		}
+		var rec HashChainRecord
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			return broken(0, "not a hash chain record")
+		}
+		switch rec.Type {
+		case HashChainEventRecord, HashChainCheckpointRecord:
+		default:
+			return broken(rec.Seq, fmt.Sprintf("unknown record type %q", rec.Type))
+		}
+		w, ok := v.wrappers[rec.KeyId]
+		if !ok {
+			var err error
+			w, err = v.wrapperFn(ctx, rec.KeyId)
+			if err != nil {
+				return false, fmt.Errorf("%s: unable to get audit wrapper for key %q: %w", op, rec.KeyId, err)
+			}
+			v.wrappers[rec.KeyId] = w
+		}
+		want, err := hashChainHmac(ctx, w, &rec)
+		if err != nil {
+			return false, fmt.Errorf("%s: unable to compute hmac: %w", op, err)
+		}
+		if !hmac.Equal([]byte(want), []byte(rec.Hmac)) {
+			return broken(rec.Seq, "record hmac does not match its content")
+		}
+		if v.prev != nil {
+			switch {
+			case rec.Seq != v.prev.Seq+1:
+				return broken(rec.Seq, fmt.Sprintf("expected record %d, records are missing or out of order", v.prev.Seq+1))
+			case rec.Prev != v.prev.Hmac:
+				return broken(rec.Seq, "record does not link to the previous record")
+			}
+		} else {
+			if rec.Seq != 1 || rec.Prev != "" {
+				if !v.allowPruned {
+					return broken(rec.Seq, fmt.Sprintf("expected record 1, the records before record %d are missing", rec.Seq))
+				}
+			} else {
+				v.first = rec.Hmac
+			}
+			v.result.FirstSeq = rec.Seq
+		}
+		if rec.Type == HashChainCheckpointRecord {
+			want, err := hashChainSignature(ctx, w, &rec)
+			if err != nil {
+				return false, fmt.Errorf("%s: unable to compute signature: %w", op, err)
+			}
+			if !hmac.Equal([]byte(want), []byte(rec.Signature)) {
+				return broken(rec.Seq, "checkpoint signature does not match its content")
+			}
+			if v.first == "" {
+				// the chain was pruned, so the first checkpoint is trusted
+				// to attest to the first record.
+				v.first = rec.First
+			}
+			switch {
+			case rec.First != v.first:
+				return broken(rec.Seq, "checkpoint does not attest to the first record of the chain")
+			case rec.Count != v.result.FirstSeq-1+uint64(v.result.Records):
+				return broken(rec.Seq, fmt.Sprintf("checkpoint attests to %d records, but %d precede it", rec.Count, v.result.FirstSeq-1+uint64(v.result.Records)))
+			}
+		}
+		v.prev = &rec
+		v.lastFile, v.lastLine = file, line
+		v.result.Records++
+		v.result.LastSeq = rec.Seq
+		switch rec.Type {
+		case HashChainEventRecord:
+			v.result.Events++
+			v.result.Unattested++
+		case HashChainCheckpointRecord:
+			v.result.Checkpoints++
+			v.result.Unattested = 0
+			t := rec.Time
+			v.result.LastCheckpoint = &t
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return false, fmt.Errorf("%s: unable to read %s: %w", op, file, err)
+	}
+	return true, nil
+}
+
+// Result returns the result of the verification of the files verified so far.
+func (v *HashChainVerifier) Result() *HashChainVerification {
+	r := v.result
+	return &r
+}
+
+// Finish returns the result of the verification once all the files have been
+// verified. Unless WithAllowUnattested was used, the chain is broken if records
+// follow its last checkpoint, since records could have been removed from its
+// end.
+func (v *HashChainVerifier) Finish() *HashChainVerification {
+	if v.result.Break == nil && v.result.Unattested > 0 && !v.allowUnattested {
+		v.result.Break = &HashChainBreak{
+			File:   v.lastFile,
+			Line:   v.lastLine,
+			Seq:    v.prev.Seq,
+			Reason: fmt.Sprintf("the last %d records are not attested by a checkpoint, records may be missing from the end of the chain", v.result.Unattested),
+		}
+	}
+	return v.Result()
+}
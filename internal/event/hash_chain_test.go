@@ -0,0 +1,400 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/eventlogger"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testHashChainSink(t *testing.T, dir string, checkpointEvents int, w wrapping.Wrapper) *hashChainFileSink {
+	t.Helper()
+	fs := &eventlogger.FileSink{
+		Format:   string(JSONSinkFormat),
+		Path:     dir,
+		FileName: "audit.log",
+	}
+	s, err := newHashChainFileSink(context.Background(), fs, JSONSinkFormat, checkpointEvents, w)
+	require.NoError(t, err)
+	return s
+}
+
+func testWriteAuditEvents(t *testing.T, s *hashChainFileSink, from, to int) {
+	t.Helper()
+	for i := from; i < to; i++ {
+		e := &eventlogger.Event{}
+		e.FormattedAs(string(JSONSinkFormat), []byte(fmt.Sprintf("{\"id\": \"%d\", \"type\": \"audit\"}\n", i)))
+		_, err := s.Process(context.Background(), e)
+		require.NoError(t, err)
+	}
+}
+
+func testWrapperFn(wrappers ...wrapping.Wrapper) HashChainWrapperFn {
+	return func(ctx context.Context, keyId string) (wrapping.Wrapper, error) {
+		for _, w := range wrappers {
+			if id, _ := w.KeyId(ctx); id == keyId {
+				return w, nil
+			}
+		}
+		return nil, fmt.Errorf("unknown key %s", keyId)
+	}
+}
+
+func testVerifyHashChain(t *testing.T, wrapperFn HashChainWrapperFn, opts []Option, files ...string) *HashChainVerification {
+	t.Helper()
+	v, err := NewHashChainVerifier(wrapperFn, opts...)
+	require.NoError(t, err)
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		require.NoError(t, err)
+		_, err = v.Verify(context.Background(), filepath.Base(f), bytes.NewReader(b))
+		require.NoError(t, err)
+	}
+	return v.Finish()
+}
+
+func testReadLines(t *testing.T, path string) []string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return strings.SplitAfter(strings.TrimSuffix(string(b), "\n"), "\n")
+}
+
+func Test_hashChainFileSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	w := testWrapper(t)
+	s := testHashChainSink(t, dir, 2, w)
+
+	testWriteAuditEvents(t, s, 0, 5)
+	require.NoError(s.FlushAll(ctx))
+	// nothing was written since the last checkpoint
+	require.NoError(s.FlushAll(ctx))
+
+	got := testVerifyHashChain(t, testWrapperFn(w), nil, filepath.Join(dir, "audit.log"))
+	assert.Nil(got.Break)
+	assert.Equal(8, got.Records)
+	assert.Equal(5, got.Events)
+	assert.Equal(3, got.Checkpoints)
+	assert.Zero(got.Unattested)
+	assert.Equal(uint64(1), got.FirstSeq)
+	assert.Equal(uint64(8), got.LastSeq)
+	assert.NotNil(got.LastCheckpoint)
+
+	lines := testReadLines(t, filepath.Join(dir, "audit.log"))
+	var first, last HashChainRecord
+	require.NoError(json.Unmarshal([]byte(lines[0]), &first))
+	require.NoError(json.Unmarshal([]byte(lines[len(lines)-1]), &last))
+	assert.Equal(HashChainCheckpointRecord, last.Type)
+	assert.Equal(uint64(7), last.Count)
+	assert.Equal(first.Hmac, last.First)
+	assert.NotEmpty(last.Signature)
+	assert.NotEqual(last.Hmac, last.Signature)
+
+	// the chain resumes from the last record of the file
+	s = testHashChainSink(t, dir, 2, w)
+	testWriteAuditEvents(t, s, 5, 6)
+	got = testVerifyHashChain(t, testWrapperFn(w), nil, filepath.Join(dir, "audit.log"))
+	require.NotNil(got.Break)
+	assert.Equal(9, got.Break.Line)
+	got = testVerifyHashChain(t, testWrapperFn(w), []Option{WithAllowUnattested()}, filepath.Join(dir, "audit.log"))
+	assert.Nil(got.Break)
+	assert.Equal(9, got.Records)
+	assert.Equal(1, got.Unattested)
+
+	// when the file does not end with a checkpoint, the first record of the
+	// chain is found in the checkpoints of the file
+	s = testHashChainSink(t, dir, 2, w)
+	testWriteAuditEvents(t, s, 6, 8)
+	got = testVerifyHashChain(t, testWrapperFn(w), nil, filepath.Join(dir, "audit.log"))
+	assert.Nil(got.Break)
+	assert.Equal(12, got.Records)
+}
+
+func Test_hashChainFileSink_missingWrapper(t *testing.T) {
+	t.Parallel()
+	s := testHashChainSink(t, t.TempDir(), 2, nil)
+	e := &eventlogger.Event{}
+	e.FormattedAs(string(JSONSinkFormat), []byte(`{"id":"1"}`))
+	_, err := s.Process(context.Background(), e)
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+}
+
+func Test_hashChainFileSink_rotate(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	w1, w2 := testWrapper(t), testWrapper(t)
+	s := testHashChainSink(t, dir, 10, w1)
+	testWriteAuditEvents(t, s, 0, 2)
+	require.NoError(s.Rotate(ctx, w2))
+	testWriteAuditEvents(t, s, 2, 4)
+	require.NoError(s.FlushAll(ctx))
+
+	got := testVerifyHashChain(t, testWrapperFn(w1, w2), nil, filepath.Join(dir, "audit.log"))
+	assert.Nil(got.Break)
+	assert.Equal(4, got.Events)
+
+	// the hmacs of the records of a key cannot be verified with another key
+	got = testVerifyHashChain(t, func(context.Context, string) (wrapping.Wrapper, error) { return w2, nil }, nil, filepath.Join(dir, "audit.log"))
+	require.NotNil(got.Break)
+	assert.Equal(1, got.Break.Line)
+}
+
+func TestHashChainVerifier_tampered(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	w := testWrapper(t)
+	s := testHashChainSink(t, dir, 100, w)
+	testWriteAuditEvents(t, s, 0, 5)
+	b, err := os.ReadFile(filepath.Join(dir, "audit.log"))
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSuffix(string(b), "\n"), "\n")
+	require.Len(t, lines, 5)
+
+	tests := []struct {
+		name       string
+		lines      []string
+		wantLine   int
+		wantReason string
+	}{
+		{
+			name:       "edited",
+			lines:      []string{lines[0], lines[1], strings.Replace(lines[2], `"id":"2"`, `"id":"9"`, 1), lines[3], lines[4]},
+			wantLine:   3,
+			wantReason: "record hmac does not match its content",
+		},
+		{
+			name:       "deleted",
+			lines:      []string{lines[0], lines[1], lines[3], lines[4]},
+			wantLine:   3,
+			wantReason: "expected record 3, records are missing or out of order",
+		},
+		{
+			name:       "reordered",
+			lines:      []string{lines[0], lines[2], lines[1], lines[3], lines[4]},
+			wantLine:   2,
+			wantReason: "expected record 2, records are missing or out of order",
+		},
+		{
+			name:       "inserted",
+			lines:      []string{lines[0], lines[1], "not a record\n", lines[2], lines[3], lines[4]},
+			wantLine:   3,
+			wantReason: "not a hash chain record",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			v, err := NewHashChainVerifier(testWrapperFn(w))
+			require.NoError(err)
+			ok, err := v.Verify(context.Background(), "audit.log", strings.NewReader(strings.Join(tt.lines, "")))
+			require.NoError(err)
+			assert.False(ok)
+			got := v.Result().Break
+			require.NotNil(got)
+			assert.Equal("audit.log", got.File)
+			assert.Equal(tt.wantLine, got.Line)
+			assert.Equal(tt.wantReason, got.Reason)
+		})
+	}
+}
+
+func TestHashChainVerifier_files(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	dir := t.TempDir()
+	w := testWrapper(t)
+	s := testHashChainSink(t, dir, 2, w)
+	testWriteAuditEvents(t, s, 0, 4)
+	lines := testReadLines(t, filepath.Join(dir, "audit.log"))
+	require.Len(lines, 6)
+	first, second := filepath.Join(dir, "audit-1.log"), filepath.Join(dir, "audit-2.log")
+	require.NoError(os.WriteFile(first, []byte(strings.Join(lines[:3], "")), 0o600))
+	require.NoError(os.WriteFile(second, []byte(strings.Join(lines[3:], "")), 0o600))
+
+	got := testVerifyHashChain(t, testWrapperFn(w), nil, first, second)
+	assert.Nil(got.Break)
+	assert.Equal(4, got.Events)
+
+	// the chain must start at its first record, unless the files before the
+	// first one verified have been pruned.
+	got = testVerifyHashChain(t, testWrapperFn(w), nil, second)
+	require.NotNil(got.Break)
+	assert.Equal(1, got.Break.Line)
+	assert.Equal("expected record 1, the records before record 4 are missing", got.Break.Reason)
+	got = testVerifyHashChain(t, testWrapperFn(w), []Option{WithAllowPruned()}, second)
+	assert.Nil(got.Break)
+	assert.Equal(uint64(4), got.FirstSeq)
+
+	got = testVerifyHashChain(t, testWrapperFn(w), nil, second, first)
+	require.NotNil(got.Break)
+	assert.Equal("audit-2.log", got.Break.File)
+	assert.Equal(1, got.Break.Line)
+}
+
+func TestHashChainVerifier_checkpoints(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	dir := t.TempDir()
+	w := testWrapper(t)
+	s := testHashChainSink(t, dir, 2, w)
+	testWriteAuditEvents(t, s, 0, 4)
+	lines := testReadLines(t, filepath.Join(dir, "audit.log"))
+	require.Len(t, lines, 6)
+
+	// resign returns the line of a checkpoint edited by fn, with a valid hmac
+	// and signature unless the edit is made after signing.
+	resign := func(line string, fn func(*HashChainRecord), afterSigning bool) string {
+		var r HashChainRecord
+		require.NoError(t, json.Unmarshal([]byte(line), &r))
+		if !afterSigning {
+			fn(&r)
+		}
+		var err error
+		r.Hmac, err = hashChainHmac(ctx, w, &r)
+		require.NoError(t, err)
+		r.Signature, err = hashChainSignature(ctx, w, &r)
+		require.NoError(t, err)
+		if afterSigning {
+			fn(&r)
+		}
+		b, err := json.Marshal(&r)
+		require.NoError(t, err)
+		return string(b) + "\n"
+	}
+
+	tests := []struct {
+		name       string
+		lines      []string
+		opts       []Option
+		wantLine   int
+		wantReason string
+	}{
+		{
+			name:  "intact",
+			lines: lines,
+		},
+		{
+			name:       "head-truncated",
+			lines:      lines[1:],
+			wantLine:   1,
+			wantReason: "expected record 1, the records before record 2 are missing",
+		},
+		{
+			name:       "tail-truncated",
+			lines:      lines[:5],
+			wantLine:   5,
+			wantReason: "the last 2 records are not attested by a checkpoint, records may be missing from the end of the chain",
+		},
+		{
+			name:  "tail-unattested-allowed",
+			lines: lines[:5],
+			opts:  []Option{WithAllowUnattested()},
+		},
+		{
+			name:       "checkpoint-not-signed",
+			lines:      []string{lines[0], lines[1], resign(lines[2], func(r *HashChainRecord) { r.Signature = "" }, true), lines[3], lines[4], lines[5]},
+			wantLine:   3,
+			wantReason: "checkpoint signature does not match its content",
+		},
+		{
+			name:       "checkpoint-signed-with-hmac-key",
+			lines:      []string{lines[0], lines[1], resign(lines[2], func(r *HashChainRecord) { r.Signature = r.Hmac }, true), lines[3], lines[4], lines[5]},
+			wantLine:   3,
+			wantReason: "checkpoint signature does not match its content",
+		},
+		{
+			name:       "checkpoint-count",
+			lines:      []string{lines[0], lines[1], resign(lines[2], func(r *HashChainRecord) { r.Count = 1 }, false), lines[3], lines[4], lines[5]},
+			wantLine:   3,
+			wantReason: "checkpoint attests to 1 records, but 2 precede it",
+		},
+		{
+			name:       "checkpoint-first",
+			lines:      []string{lines[0], lines[1], resign(lines[2], func(r *HashChainRecord) { r.First = "hmac-sha256:other" }, false), lines[3], lines[4], lines[5]},
+			wantLine:   3,
+			wantReason: "checkpoint does not attest to the first record of the chain",
+		},
+		{
+			name:       "pruned-checkpoint-first",
+			lines:      []string{lines[1], lines[2], lines[3], lines[4], resign(lines[5], func(r *HashChainRecord) { r.First = "hmac-sha256:other" }, false)},
+			opts:       []Option{WithAllowPruned()},
+			wantLine:   5,
+			wantReason: "checkpoint does not attest to the first record of the chain",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			v, err := NewHashChainVerifier(testWrapperFn(w), tt.opts...)
+			require.NoError(err)
+			_, err = v.Verify(ctx, "audit.log", strings.NewReader(strings.Join(tt.lines, "")))
+			require.NoError(err)
+			got := v.Finish().Break
+			if tt.wantReason == "" {
+				assert.Nil(got)
+				return
+			}
+			require.NotNil(got)
+			assert.Equal(tt.wantLine, got.Line)
+			assert.Equal(tt.wantReason, got.Reason)
+		})
+	}
+}
+
+func TestEventer_hashChainFileSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	dir := t.TempDir()
+	testLock := &sync.Mutex{}
+	c := EventerConfig{
+		AuditEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "audit",
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				EventTypes: []Type{AuditType},
+				FileConfig: &FileSinkTypeConfig{
+					Path:      dir,
+					FileName:  "audit.log",
+					HashChain: true,
+				},
+			},
+		},
+	}
+	// like the controller, the eventer is created without a wrapper, which is
+	// rotated in once the kms is available.
+	e, err := NewEventer(testLogger(t, testLock), testLock, "TestEventer_hashChainFileSink", c)
+	require.NoError(err)
+	w := testWrapper(t)
+	require.NoError(e.RotateAuditWrapper(ctx, w))
+
+	a, err := newAudit("TestEventer_hashChainFileSink", WithRequestInfo(TestRequestInfo(t)), WithAuth(testAuth(t)))
+	require.NoError(err)
+	require.NoError(e.writeAudit(ctx, a))
+	require.NoError(e.FlushNodes(ctx))
+
+	got := testVerifyHashChain(t, testWrapperFn(w), nil, filepath.Join(dir, "audit.log"))
+	assert.Nil(got.Break)
+	assert.Equal(1, got.Events)
+	assert.Equal(1, got.Checkpoints)
+}
@@ -0,0 +1,401 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	// httpSinkContentType is the content type of the CloudEvents batched
+	// content mode, a JSON array of events.
+	httpSinkContentType = "application/cloudevents-batch+json"
+
+	defaultHttpSinkBatchSize       = 100
+	defaultHttpSinkFlushInterval   = 5 * time.Second
+	defaultHttpSinkTimeout         = 10 * time.Second
+	defaultHttpSinkMaxRetries      = 3
+	defaultHttpSinkRetryBackoff    = time.Second
+	defaultHttpSinkMaxRetryBackoff = 30 * time.Second
+	defaultHttpSinkSpoolMaxBytes   = 64 * 1024 * 1024
+
+	// httpSinkQueueBatches is the number of batches which can be queued in
+	// memory before best effort events are dropped.
+	httpSinkQueueBatches = 10
+)
+
+// errHttpSinkClosed is returned when an event is sent to a closed http sink.
+var errHttpSinkClosed = stderrors.New("http sink is closed")
+
+// httpStatusError is returned when the endpoint of an http sink responds with
+// a status which is not a success.
+type httpStatusError struct {
+	code int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("unexpected response status %d", e.code)
+}
+
+// retryable returns true if a request which failed with the error may succeed
+// if retried: network errors, timeouts, throttling and server errors.
+func retryable(err error) bool {
+	var statusErr *httpStatusError
+	if !stderrors.As(err, &statusErr) {
+		return true
+	}
+	switch {
+	case statusErr.code == http.StatusRequestTimeout, statusErr.code == http.StatusTooManyRequests:
+		return true
+	case statusErr.code >= 500:
+		return true
+	default:
+		return false
+	}
+}
+
+type httpQueuedEvent struct {
+	payload []byte
+	// result receives the outcome of the delivery of the event. It is nil for
+	// best effort events.
+	result chan error
+}
+
+// httpSink is an eventlogger sink which POSTs batches of cloudevents-json
+// events to an http endpoint. Batches are sent when they are full or when the
+// flush interval has passed. Failed requests are retried with an exponential
+// backoff, and batches which cannot be delivered are written to the spool, if
+// one is configured, to be sent again later.
+//
+// The Process of an event with an Enforced delivery guarantee returns once the
+// event has been delivered or spooled, and returns an error if neither
+// succeeded. Best effort events are queued and Process returns immediately;
+// they are dropped when the queue is full or the batch could not be delivered
+// nor spooled.
+type httpSink struct {
+	format          string
+	url             string
+	headers         map[string]string
+	client          *http.Client
+	batchSize       int
+	maxRetries      int
+	retryBackoff    time.Duration
+	maxRetryBackoff time.Duration
+	guarantee       DeliveryGuarantee
+	spool           *httpSpool
+
+	mu      sync.Mutex
+	pending []*httpQueuedEvent
+	closed  bool
+
+	// flushMu serializes the flushes of the background loop and FlushAll.
+	flushMu sync.Mutex
+
+	dropped atomic.Uint64
+
+	flushCh chan struct{}
+	closeCh chan struct{}
+	doneCh  chan struct{}
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+var (
+	_ eventlogger.Node   = (*httpSink)(nil)
+	_ eventlogger.Closer = (*httpSink)(nil)
+	_ flushable          = (*httpSink)(nil)
+)
+
+// newHttpSink creates an http sink for the provided config and starts its
+// background flush loop, which runs until the sink is closed.
+func newHttpSink(format SinkFormat, c *HttpSinkTypeConfig) (*httpSink, error) {
+	const op = "event.newHttpSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing http config: %w", op, ErrInvalidParameter)
+	}
+	tlsConfig, err := newTlsConfig(c.TlsCaCert, c.TlsClientCert, c.TlsClientKey, c.TlsServerName, c.TlsSkipVerify)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	s := &httpSink{
+		format:          string(format),
+		url:             c.Url,
+		headers:         c.Headers,
+		client:          &http.Client{Transport: transport, Timeout: withDefault(c.Timeout, defaultHttpSinkTimeout)},
+		batchSize:       withDefault(c.BatchSize, defaultHttpSinkBatchSize),
+		maxRetries:      withDefault(c.MaxRetries, defaultHttpSinkMaxRetries),
+		retryBackoff:    withDefault(c.RetryBackoff, defaultHttpSinkRetryBackoff),
+		maxRetryBackoff: withDefault(c.MaxRetryBackoff, defaultHttpSinkMaxRetryBackoff),
+		guarantee:       c.DeliveryGuarantee,
+		flushCh:         make(chan struct{}, 1),
+		closeCh:         make(chan struct{}),
+		doneCh:          make(chan struct{}),
+	}
+	if c.MaxRetries < 0 {
+		s.maxRetries = 0
+	}
+	if c.SpoolPath != "" {
+		s.spool, err = newHttpSpool(c.SpoolPath, withDefault(c.SpoolMaxBytes, defaultHttpSinkSpoolMaxBytes))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	go s.run(withDefault(c.FlushInterval, defaultHttpSinkFlushInterval))
+	return s, nil
+}
+
+func withDefault[T int | int64 | time.Duration](v, d T) T {
+	if v <= 0 {
+		return d
+	}
+	return v
+}
+
+// Process queues the event to be sent in the next batch. Enforced events wait
+// for the outcome of the delivery of their batch.
+func (s *httpSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(httpSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled", op)
+	}
+	qe := &httpQueuedEvent{payload: bytes.Clone(bytes.TrimSpace(val))}
+	if s.guarantee == Enforced {
+		qe.result = make(chan error, 1)
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("%s: %w", op, errHttpSinkClosed)
+	}
+	if qe.result == nil && len(s.pending) >= s.batchSize*httpSinkQueueBatches {
+		s.mu.Unlock()
+		s.dropped.Add(1)
+		return nil, nil
+	}
+	s.pending = append(s.pending, qe)
+	full := len(s.pending) >= s.batchSize
+	s.mu.Unlock()
+
+	if full || qe.result != nil {
+		s.signalFlush()
+	}
+	if qe.result == nil {
+		// Sinks are leafs, so do not return the event, since nothing more can
+		// happen to it downstream.
+		return nil, nil
+	}
+	select {
+	case err := <-qe.result:
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		return nil, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+// Reopen is a no-op for http sinks.
+func (s *httpSink) Reopen() error { return nil }
+
+// Type describes the type of the node as a Sink.
+func (s *httpSink) Type() eventlogger.NodeType { return eventlogger.NodeTypeSink }
+
+// FlushAll sends the queued events. Batches which cannot be delivered are
+// spooled.
+func (s *httpSink) FlushAll(ctx context.Context) error {
+	s.flush(ctx)
+	return nil
+}
+
+// Close stops the background flush loop after sending the queued events. If
+// the context is done first, the pending deliveries are cancelled.
+func (s *httpSink) Close(ctx context.Context) error {
+	const op = "event.(httpSink).Close"
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.mu.Unlock()
+
+	close(s.closeCh)
+	select {
+	case <-s.doneCh:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		s.cancel()
+		<-s.doneCh
+		return fmt.Errorf("%s: %w", op, ctx.Err())
+	}
+}
+
+func (s *httpSink) signalFlush() {
+	select {
+	case s.flushCh <- struct{}{}:
+	default:
+	}
+}
+
+func (s *httpSink) run(flushInterval time.Duration) {
+	defer close(s.doneCh)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeCh:
+			s.flush(s.ctx)
+			return
+		case <-ticker.C:
+		case <-s.flushCh:
+		}
+		s.flush(s.ctx)
+	}
+}
+
+// flush sends the queued events in batches and then the spooled batches.
+func (s *httpSink) flush(ctx context.Context) {
+	s.flushMu.Lock()
+	defer s.flushMu.Unlock()
+	failed := false
+	for {
+		batch := s.take()
+		if len(batch) == 0 {
+			break
+		}
+		body := encodeBatch(batch)
+		err := s.deliver(ctx, body)
+		if err != nil {
+			failed = true
+		}
+		if err != nil && s.spool != nil {
+			if spoolErr := s.spool.write(body); spoolErr == nil {
+				err = nil
+			} else {
+				err = stderrors.Join(err, spoolErr)
+			}
+		}
+		for _, qe := range batch {
+			switch {
+			case qe.result != nil:
+				qe.result <- err
+			case err != nil:
+				s.dropped.Add(1)
+			}
+		}
+	}
+	// spooled batches are only sent again once the endpoint is reachable.
+	if s.spool != nil && !failed {
+		s.resendSpooled(ctx)
+	}
+}
+
+// take removes the next batch from the queue.
+func (s *httpSink) take() []*httpQueuedEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := min(len(s.pending), s.batchSize)
+	batch := s.pending[:n:n]
+	s.pending = slices.Clone(s.pending[n:])
+	return batch
+}
+
+// resendSpooled sends the spooled batches, the oldest first, and stops at the
+// first failure. Spooled batches are not retried with a backoff, they are
+// sent again on the next flush.
+func (s *httpSink) resendSpooled(ctx context.Context) {
+	names, err := s.spool.list()
+	if err != nil {
+		return
+	}
+	for _, name := range names {
+		body, err := s.spool.read(name)
+		if err != nil {
+			return
+		}
+		if err := s.post(ctx, body); err != nil {
+			return
+		}
+		if err := s.spool.remove(name); err != nil {
+			return
+		}
+	}
+}
+
+// deliver sends a batch, retrying with an exponential backoff.
+func (s *httpSink) deliver(ctx context.Context, body []byte) error {
+	backoff := s.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := s.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= s.maxRetries || !retryable(err) {
+			return err
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return stderrors.Join(err, ctx.Err())
+		}
+		backoff = min(backoff*2, s.maxRetryBackoff)
+	}
+}
+
+func (s *httpSink) post(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", httpSinkContentType)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &httpStatusError{code: resp.StatusCode}
+	}
+	return nil
+}
+
+// encodeBatch encodes the events of a batch as a JSON array.
+func encodeBatch(batch []*httpQueuedEvent) []byte {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, qe := range batch {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(qe.payload)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes()
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const httpSpoolExt = ".batch"
+
+// errHttpSpoolFull is returned when a batch would grow the spool over its
+// maximum size.
+var errHttpSpoolFull = stderrors.New("http sink spool is full")
+
+// httpSpool is a bounded directory of batches which could not be delivered by
+// an http sink. Each batch is a file named after a sequence number, so batches
+// are sent again in the order they were spooled. A spool which is full refuses
+// new batches rather than dropping spooled ones.
+type httpSpool struct {
+	dir      string
+	maxBytes int64
+
+	mu    sync.Mutex
+	size  int64
+	nextN uint64
+}
+
+// newHttpSpool opens the spool in dir, creating the directory if needed.
+// Batches spooled by a previous process are kept.
+func newHttpSpool(dir string, maxBytes int64) (*httpSpool, error) {
+	const op = "event.newHttpSpool"
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("%s: unable to create spool directory: %w", op, err)
+	}
+	s := &httpSpool{
+		dir:      dir,
+		maxBytes: maxBytes,
+	}
+	names, err := s.list()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, name := range names {
+		fi, err := os.Stat(filepath.Join(dir, name))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		s.size += fi.Size()
+		n, _ := strconv.ParseUint(strings.TrimSuffix(name, httpSpoolExt), 10, 64)
+		s.nextN = max(s.nextN, n+1)
+	}
+	return s, nil
+}
+
+// write adds a batch to the spool. The batch is written to a temporary file
+// which is renamed, so a partially written batch is never sent.
+func (s *httpSpool) write(body []byte) error {
+	const op = "event.(httpSpool).write"
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size+int64(len(body)) > s.maxBytes {
+		return fmt.Errorf("%s: %w", op, errHttpSpoolFull)
+	}
+	name := fmt.Sprintf("%020d%s", s.nextN, httpSpoolExt)
+	tmp, err := os.CreateTemp(s.dir, "tmp-")
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err = tmp.Write(body)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), filepath.Join(s.dir, name))
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.nextN++
+	s.size += int64(len(body))
+	return nil
+}
+
+// list returns the names of the spooled batches, the oldest first.
+func (s *httpSpool) list() ([]string, error) {
+	const op = "event.(httpSpool).list"
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && strings.HasSuffix(e.Name(), httpSpoolExt) {
+			names = append(names, e.Name())
+		}
+	}
+	// names are zero padded, so they sort in sequence order.
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *httpSpool) read(name string) ([]byte, error) {
+	const op = "event.(httpSpool).read"
+	body, err := os.ReadFile(filepath.Join(s.dir, name))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return body, nil
+}
+
+func (s *httpSpool) remove(name string) error {
+	const op = "event.(httpSpool).remove"
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fi, err := os.Stat(filepath.Join(s.dir, name))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := os.Remove(filepath.Join(s.dir, name)); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	s.size -= fi.Size()
+	return nil
+}
+
+// bytes returns the size of the spooled batches.
+func (s *httpSpool) bytes() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.size
+}
@@ -0,0 +1,314 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testHttpEndpoint records the batches POSTed to it and responds with the
+// status stored in its status field.
+type testHttpEndpoint struct {
+	*httptest.Server
+	status   atomic.Int32
+	attempts atomic.Int32
+
+	mu      sync.Mutex
+	batches [][]map[string]any
+	headers []http.Header
+}
+
+func newTestHttpEndpoint(t *testing.T) *testHttpEndpoint {
+	t.Helper()
+	te := &testHttpEndpoint{}
+	te.status.Store(http.StatusOK)
+	te.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		te.attempts.Add(1)
+		status := int(te.status.Load())
+		if status == http.StatusOK {
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			var batch []map[string]any
+			require.NoError(t, json.Unmarshal(body, &batch))
+			te.mu.Lock()
+			te.batches = append(te.batches, batch)
+			te.headers = append(te.headers, r.Header.Clone())
+			te.mu.Unlock()
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(te.Close)
+	return te
+}
+
+func (te *testHttpEndpoint) received() ([][]map[string]any, []http.Header) {
+	te.mu.Lock()
+	defer te.mu.Unlock()
+	return te.batches, te.headers
+}
+
+func testHttpEvent(t *testing.T, n int) *eventlogger.Event {
+	t.Helper()
+	e := &eventlogger.Event{}
+	e.FormattedAs(string(JSONSinkFormat), []byte(fmt.Sprintf("{\"n\":%d}\n", n)))
+	return e
+}
+
+func testHttpSink(t *testing.T, c *HttpSinkTypeConfig) *httpSink {
+	t.Helper()
+	s, err := newHttpSink(JSONSinkFormat, c)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = s.Close(context.Background()) })
+	return s
+}
+
+func Test_httpSink_batches(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	endpoint := newTestHttpEndpoint(t)
+	s := testHttpSink(t, &HttpSinkTypeConfig{
+		Url:           endpoint.URL,
+		Headers:       map[string]string{"Authorization": "Bearer token"},
+		BatchSize:     2,
+		FlushInterval: time.Hour,
+	})
+
+	for i := 0; i < 3; i++ {
+		got, err := s.Process(ctx, testHttpEvent(t, i))
+		require.NoError(err)
+		assert.Nil(got)
+	}
+	require.NoError(s.FlushAll(ctx))
+
+	batches, headers := endpoint.received()
+	require.Len(batches, 2)
+	assert.Equal([]map[string]any{{"n": float64(0)}, {"n": float64(1)}}, batches[0])
+	assert.Equal([]map[string]any{{"n": float64(2)}}, batches[1])
+	for _, h := range headers {
+		assert.Equal(httpSinkContentType, h.Get("Content-Type"))
+		assert.Equal("Bearer token", h.Get("Authorization"))
+	}
+}
+
+func Test_httpSink_retries(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		status       int
+		maxRetries   int
+		wantErr      bool
+		wantAttempts int32
+	}{
+		{
+			name:         "retryable",
+			status:       http.StatusServiceUnavailable,
+			maxRetries:   2,
+			wantErr:      true,
+			wantAttempts: 3,
+		},
+		{
+			name:         "throttled",
+			status:       http.StatusTooManyRequests,
+			maxRetries:   1,
+			wantErr:      true,
+			wantAttempts: 2,
+		},
+		{
+			name:         "not-retryable",
+			status:       http.StatusBadRequest,
+			maxRetries:   2,
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries-disabled",
+			status:       http.StatusServiceUnavailable,
+			maxRetries:   -1,
+			wantErr:      true,
+			wantAttempts: 1,
+		},
+		{
+			name:         "success",
+			status:       http.StatusOK,
+			maxRetries:   2,
+			wantAttempts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			endpoint := newTestHttpEndpoint(t)
+			endpoint.status.Store(int32(tt.status))
+			s := testHttpSink(t, &HttpSinkTypeConfig{
+				Url:               endpoint.URL,
+				MaxRetries:        tt.maxRetries,
+				RetryBackoff:      time.Millisecond,
+				FlushInterval:     time.Hour,
+				DeliveryGuarantee: Enforced,
+			})
+			_, err := s.Process(ctx, testHttpEvent(t, 1))
+			if tt.wantErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+			assert.Equal(tt.wantAttempts, endpoint.attempts.Load())
+		})
+	}
+}
+
+func Test_httpSink_spool(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	endpoint := newTestHttpEndpoint(t)
+	endpoint.status.Store(http.StatusInternalServerError)
+	spoolPath := t.TempDir()
+	s := testHttpSink(t, &HttpSinkTypeConfig{
+		Url:               endpoint.URL,
+		MaxRetries:        -1,
+		FlushInterval:     time.Hour,
+		SpoolPath:         spoolPath,
+		SpoolMaxBytes:     20,
+		DeliveryGuarantee: Enforced,
+	})
+
+	// the endpoint is down, so enforced events are delivered once spooled.
+	_, err := s.Process(ctx, testHttpEvent(t, 1))
+	require.NoError(err)
+	_, err = s.Process(ctx, testHttpEvent(t, 2))
+	require.NoError(err)
+	names, err := s.spool.list()
+	require.NoError(err)
+	assert.Len(names, 2)
+
+	// the spool is full.
+	_, err = s.Process(ctx, testHttpEvent(t, 3))
+	require.Error(err)
+	assert.ErrorIs(err, errHttpSpoolFull)
+
+	// batches spooled by a previous sink are kept.
+	reopened, err := newHttpSpool(spoolPath, 20)
+	require.NoError(err)
+	assert.Equal(s.spool.bytes(), reopened.bytes())
+	assert.Equal(int64(18), reopened.bytes())
+
+	// once the endpoint is up, the spooled batches are sent in order.
+	endpoint.status.Store(http.StatusOK)
+	_, err = s.Process(ctx, testHttpEvent(t, 4))
+	require.NoError(err)
+	require.NoError(s.FlushAll(ctx))
+	batches, _ := endpoint.received()
+	assert.Equal([][]map[string]any{
+		{{"n": float64(4)}},
+		{{"n": float64(1)}},
+		{{"n": float64(2)}},
+	}, batches)
+	names, err = s.spool.list()
+	require.NoError(err)
+	assert.Empty(names)
+	assert.Zero(s.spool.bytes())
+}
+
+func Test_httpSink_bestEffort(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	endpoint := newTestHttpEndpoint(t)
+	endpoint.status.Store(http.StatusInternalServerError)
+	s := testHttpSink(t, &HttpSinkTypeConfig{
+		Url:           endpoint.URL,
+		MaxRetries:    -1,
+		BatchSize:     1,
+		FlushInterval: time.Hour,
+	})
+
+	// best effort events are queued, so the failure is not returned.
+	_, err := s.Process(ctx, testHttpEvent(t, 1))
+	require.NoError(err)
+	require.NoError(s.FlushAll(ctx))
+	assert.Equal(uint64(1), s.dropped.Load())
+
+	require.NoError(s.Close(ctx))
+	_, err = s.Process(ctx, testHttpEvent(t, 2))
+	assert.ErrorIs(err, errHttpSinkClosed)
+}
+
+func Test_httpSink_close(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	endpoint := newTestHttpEndpoint(t)
+	s := testHttpSink(t, &HttpSinkTypeConfig{
+		Url:           endpoint.URL,
+		FlushInterval: time.Hour,
+	})
+	_, err := s.Process(ctx, testHttpEvent(t, 1))
+	require.NoError(err)
+
+	// queued events are sent on close.
+	require.NoError(s.Close(ctx))
+	batches, _ := endpoint.received()
+	assert.Equal([][]map[string]any{{{"n": float64(1)}}}, batches)
+	require.NoError(s.Close(ctx))
+}
+
+func TestEventer_httpSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	endpoint := newTestHttpEndpoint(t)
+	testLock := &sync.Mutex{}
+	c := EventerConfig{
+		SysEventsEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "http",
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				EventTypes: []Type{SystemType},
+				DenyFilters: []string{
+					`"/data/data/msg" == "denied"`,
+				},
+				HttpConfig: &HttpSinkTypeConfig{
+					Url:           endpoint.URL,
+					FlushInterval: time.Hour,
+				},
+			},
+		},
+	}
+	e, err := NewEventer(testLogger(t, testLock), testLock, "TestEventer_httpSink", c)
+	require.NoError(err)
+
+	for _, msg := range []string{"allowed", "denied"} {
+		require.NoError(e.writeSysEvent(ctx, &sysEvent{
+			Id:      "1",
+			Version: sysVersion,
+			Op:      "TestEventer_httpSink",
+			Data:    map[string]any{"msg": msg},
+		}))
+	}
+	require.NoError(e.FlushNodes(ctx))
+
+	batches, _ := endpoint.received()
+	require.Len(batches, 1)
+	require.Len(batches[0], 1)
+	assert.Equal("system", batches[0][0]["type"])
+	assert.Equal(map[string]any{"msg": "allowed"}, batches[0][0]["data"].(map[string]any)["data"])
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"github.com/hashicorp/boundary/globals"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	metricSubsystem = "event_sink"
+
+	labelSink   = "sink"
+	labelReason = "reason"
+
+	droppedSampled     = "sampled"
+	droppedRateLimited = "rate_limited"
+)
+
+// droppedEvents counts the events which were not sent to a sink because they
+// were sampled out or rate limited.
+var droppedEvents = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: globals.MetricNamespace,
+		Subsystem: metricSubsystem,
+		Name:      "dropped_events_total",
+		Help:      "Count of events which were not sent to a sink, by sink name and the reason they were dropped.",
+	},
+	[]string{labelSink, labelReason},
+)
+
+// InitializeMetrics registers the metrics of the eventer's sinks.
+func InitializeMetrics(r prometheus.Registerer) {
+	if r == nil {
+		return
+	}
+	r.MustRegister(droppedEvents)
+}
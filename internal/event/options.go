@@ -50,6 +50,8 @@ type options struct {
 	withNoGateLocking    bool
 	withTelemetry        bool
 	withCorrelationId    string
+	withAllowPruned      bool
+	withAllowUnattested  bool
 
 	// These options are related to the hclog adapter
 	withHclogLevel hclog.Level
@@ -244,3 +246,19 @@ func WithTelemetry() Option {
 		o.withTelemetry = true
 	}
 }
+
+// WithAllowPruned allows a hash chain to be verified from a record after its
+// first one, as when the oldest files of a sink have been removed.
+func WithAllowPruned() Option {
+	return func(o *options) {
+		o.withAllowPruned = true
+	}
+}
+
+// WithAllowUnattested allows a verified hash chain to end with records which
+// are not attested by a checkpoint, as in the file a sink is writing to.
+func WithAllowUnattested() Option {
+	return func(o *options) {
+		o.withAllowUnattested = true
+	}
+}
@@ -210,6 +210,20 @@ func Test_GetOpts(t *testing.T) {
 		opts := getOpts(withCorrelationId("12345"))
 		assert.Equal("12345", opts.withCorrelationId)
 	})
+	t.Run("WithAllowPruned", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAllowPruned())
+		testOpts := getDefaultOptions()
+		testOpts.withAllowPruned = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAllowUnattested", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts(WithAllowUnattested())
+		testOpts := getDefaultOptions()
+		testOpts.withAllowUnattested = true
+		assert.Equal(opts, testOpts)
+	})
 }
 
 // testWrapper initializes an AEAD wrapping.Wrapper for testing.  Note: this
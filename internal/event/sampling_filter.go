@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"golang.org/x/time/rate"
+)
+
+const defaultSamplingInterval = time.Minute
+
+// samplingFilter is an eventlogger node which samples and rate limits the
+// events sent to a sink. It wraps the sink's filter/format node, since a sink
+// must be preceded by a formatter, and samples the events returned by it, so
+// only the events which would be sent to the sink are counted. Dropped events
+// are counted by the boundary_event_sink_dropped_events_total metric.
+type samplingFilter struct {
+	next             eventlogger.Node
+	sinkName         string
+	percent          float64
+	firstPerInterval int
+	interval         time.Duration
+	limiter          *rate.Limiter
+
+	// randFloat and now are replaced in tests
+	randFloat func() float64
+	now       func() time.Time
+
+	mu          sync.Mutex
+	windowStart time.Time
+	pathCounts  map[string]int
+}
+
+var _ eventlogger.Node = (*samplingFilter)(nil)
+
+// newSamplingFilter creates a sampling filter wrapping the filter/format node
+// of a sink for its sampling and rate limit configs, either of which may be
+// nil.
+func newSamplingFilter(sinkName string, next eventlogger.Node, sc *SamplingConfig, rc *RateLimitConfig) (*samplingFilter, error) {
+	const op = "event.newSamplingFilter"
+	if next == nil {
+		return nil, fmt.Errorf("%s: missing filter/format node: %w", op, ErrInvalidParameter)
+	}
+	if sinkName == "" {
+		return nil, fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
+	}
+	if sc == nil && rc == nil {
+		return nil, fmt.Errorf("%s: missing sampling and rate limit config: %w", op, ErrInvalidParameter)
+	}
+	f := &samplingFilter{
+		next:       next,
+		sinkName:   sinkName,
+		randFloat:  rand.Float64,
+		now:        time.Now,
+		pathCounts: map[string]int{},
+	}
+	if sc != nil {
+		f.percent = sc.Percent
+		f.firstPerInterval = sc.FirstPerInterval
+		f.interval = withDefault(sc.Interval, defaultSamplingInterval)
+	}
+	if rc != nil {
+		burst := rc.Burst
+		if burst == 0 {
+			burst = int(math.Ceil(rc.EventsPerSecond))
+		}
+		f.limiter = rate.NewLimiter(rate.Limit(rc.EventsPerSecond), burst)
+	}
+	return f, nil
+}
+
+// Process returns the event processed by the wrapped node if it is kept, and
+// nil if it is filtered out or dropped.
+func (f *samplingFilter) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(samplingFilter).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	e, err := f.next.Process(ctx, e)
+	if err != nil || e == nil {
+		return e, err
+	}
+	if !f.sampled(e) {
+		droppedEvents.WithLabelValues(f.sinkName, droppedSampled).Inc()
+		return nil, nil
+	}
+	// events are rate limited once sampled, so sampled out events do not use
+	// up tokens.
+	if f.limiter != nil && !f.limiter.AllowN(f.now(), 1) {
+		droppedEvents.WithLabelValues(f.sinkName, droppedRateLimited).Inc()
+		return nil, nil
+	}
+	return e, nil
+}
+
+// sampled reports whether the event is kept by the sampling config.
+func (f *samplingFilter) sampled(e *eventlogger.Event) bool {
+	switch {
+	case f.percent > 0:
+		return f.randFloat()*100 < f.percent
+	case f.firstPerInterval > 0:
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		now := f.now()
+		if now.Sub(f.windowStart) >= f.interval {
+			f.windowStart = now
+			clear(f.pathCounts)
+		}
+		path := requestPath(e.Payload)
+		f.pathCounts[path]++
+		return f.pathCounts[path] <= f.firstPerInterval
+	default:
+		return true
+	}
+}
+
+// Reopen reopens the wrapped node.
+func (f *samplingFilter) Reopen() error { return f.next.Reopen() }
+
+// Type describes the type of the node as the type of the wrapped node.
+func (f *samplingFilter) Type() eventlogger.NodeType { return f.next.Type() }
+
+// requestPath returns the request path of an event's payload, which is empty
+// for events which are not emitted while handling a request.
+func requestPath(payload any) string {
+	var info *RequestInfo
+	switch p := payload.(type) {
+	case *audit:
+		info = p.RequestInfo
+	case *observation:
+		info = p.RequestInfo
+	case *err:
+		info = p.RequestInfo
+	case map[string]any:
+		// gated observations are composed into a map
+		info, _ = p[RequestInfoField].(*RequestInfo)
+	}
+	if info == nil {
+		return ""
+	}
+	return info.Path
+}
@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSamplingEvent(path string) *eventlogger.Event {
+	return &eventlogger.Event{
+		Payload: &observation{RequestInfo: &RequestInfo{Path: path}},
+	}
+}
+
+// testPassthroughNode is a filter/format node which returns every event.
+type testPassthroughNode struct{}
+
+func (testPassthroughNode) Process(_ context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	return e, nil
+}
+func (testPassthroughNode) Reopen() error { return nil }
+func (testPassthroughNode) Type() eventlogger.NodeType {
+	return eventlogger.NodeTypeFormatterFilter
+}
+
+func testDroppedEvents(sinkName, reason string) float64 {
+	return testutil.ToFloat64(droppedEvents.WithLabelValues(sinkName, reason))
+}
+
+func Test_newSamplingFilter(t *testing.T) {
+	t.Parallel()
+	t.Run("missing-node", func(t *testing.T) {
+		_, err := newSamplingFilter("sink", nil, &SamplingConfig{Percent: 1}, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("missing-sink-name", func(t *testing.T) {
+		_, err := newSamplingFilter("", testPassthroughNode{}, &SamplingConfig{Percent: 1}, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("missing-configs", func(t *testing.T) {
+		_, err := newSamplingFilter("sink", testPassthroughNode{}, nil, nil)
+		assert.ErrorIs(t, err, ErrInvalidParameter)
+	})
+	t.Run("defaults", func(t *testing.T) {
+		f, err := newSamplingFilter("sink", testPassthroughNode{}, &SamplingConfig{FirstPerInterval: 1}, &RateLimitConfig{EventsPerSecond: 2.5})
+		require.NoError(t, err)
+		assert.Equal(t, defaultSamplingInterval, f.interval)
+		assert.Equal(t, 3, f.limiter.Burst())
+	})
+}
+
+func Test_samplingFilter_percent(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	f, err := newSamplingFilter("Test_samplingFilter_percent", testPassthroughNode{}, &SamplingConfig{Percent: 25}, nil)
+	require.NoError(err)
+	rolls := []float64{0.1, 0.3, 0.249, 0.25}
+	f.randFloat = func() float64 {
+		r := rolls[0]
+		rolls = rolls[1:]
+		return r
+	}
+
+	var kept int
+	for i := 0; i < 4; i++ {
+		got, err := f.Process(ctx, testSamplingEvent("/v1/targets"))
+		require.NoError(err)
+		if got != nil {
+			kept++
+		}
+	}
+	assert.Equal(2, kept)
+	assert.Equal(float64(2), testDroppedEvents("Test_samplingFilter_percent", droppedSampled))
+}
+
+func Test_samplingFilter_firstPerInterval(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	f, err := newSamplingFilter("Test_samplingFilter_firstPerInterval", testPassthroughNode{}, &SamplingConfig{
+		FirstPerInterval: 2,
+		Interval:         time.Minute,
+	}, nil)
+	require.NoError(err)
+	now := time.Now()
+	f.now = func() time.Time { return now }
+
+	process := func(path string) bool {
+		got, err := f.Process(ctx, testSamplingEvent(path))
+		require.NoError(err)
+		return got != nil
+	}
+	assert.True(process("/v1/targets"))
+	assert.True(process("/v1/targets"))
+	assert.False(process("/v1/targets"))
+	// paths are counted separately
+	assert.True(process("/v1/sessions"))
+
+	// the counts are reset once the interval has passed
+	now = now.Add(time.Minute)
+	assert.True(process("/v1/targets"))
+	assert.Equal(float64(1), testDroppedEvents("Test_samplingFilter_firstPerInterval", droppedSampled))
+}
+
+func Test_samplingFilter_rateLimit(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	f, err := newSamplingFilter("Test_samplingFilter_rateLimit", testPassthroughNode{}, nil, &RateLimitConfig{
+		EventsPerSecond: 1,
+		Burst:           2,
+	})
+	require.NoError(err)
+	now := time.Now()
+	f.now = func() time.Time { return now }
+
+	process := func() bool {
+		got, err := f.Process(ctx, testSamplingEvent(""))
+		require.NoError(err)
+		return got != nil
+	}
+	assert.True(process())
+	assert.True(process())
+	assert.False(process())
+
+	// the bucket is refilled over time
+	now = now.Add(time.Second)
+	assert.True(process())
+	assert.False(process())
+	assert.Equal(float64(2), testDroppedEvents("Test_samplingFilter_rateLimit", droppedRateLimited))
+}
+
+func Test_requestPath(t *testing.T) {
+	t.Parallel()
+	info := &RequestInfo{Path: "/v1/targets"}
+	tests := []struct {
+		name    string
+		payload any
+		want    string
+	}{
+		{name: "audit", payload: &audit{RequestInfo: info}, want: "/v1/targets"},
+		{name: "observation", payload: &observation{RequestInfo: info}, want: "/v1/targets"},
+		{name: "error", payload: &err{RequestInfo: info}, want: "/v1/targets"},
+		{name: "gated-observation", payload: map[string]any{RequestInfoField: info}, want: "/v1/targets"},
+		{name: "missing-request-info", payload: &observation{}},
+		{name: "sys", payload: &sysEvent{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, requestPath(tt.payload))
+		})
+	}
+}
+
+func TestEventer_samplingFilter(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	buffer := new(bytes.Buffer)
+	testLock := &sync.Mutex{}
+	c := EventerConfig{
+		SysEventsEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "TestEventer_samplingFilter",
+				Type:       WriterSink,
+				Format:     JSONSinkFormat,
+				EventTypes: []Type{SystemType},
+				DenyFilters: []string{
+					`"/data/data/msg" == "denied"`,
+				},
+				WriterConfig: &WriterSinkTypeConfig{
+					Writer: buffer,
+				},
+				RateLimit: &RateLimitConfig{
+					EventsPerSecond: 0.001,
+					Burst:           1,
+				},
+			},
+		},
+	}
+	e, err := NewEventer(testLogger(t, testLock), testLock, "TestEventer_samplingFilter", c)
+	require.NoError(err)
+
+	// denied events are filtered before they are rate limited, so they do not
+	// use up the bucket.
+	for _, msg := range []string{"denied", "allowed", "limited"} {
+		require.NoError(e.writeSysEvent(ctx, &sysEvent{
+			Id:      "1",
+			Version: sysVersion,
+			Op:      "TestEventer_samplingFilter",
+			Data:    map[string]any{"msg": msg},
+		}))
+	}
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	require.Len(lines, 1)
+	assert.Contains(lines[0], `"msg":"allowed"`)
+	assert.Equal(float64(1), testDroppedEvents("TestEventer_samplingFilter", droppedRateLimited))
+}
@@ -6,6 +6,8 @@ package event
 import (
 	"fmt"
 	"io"
+	"net"
+	"net/url"
 	"slices"
 	"time"
 )
@@ -19,11 +21,15 @@ type SinkConfig struct {
 	AllowFilters   []string              `hcl:"allow_filters"`    // AllowFilters define a set predicates for including an event in the sink. If any filter matches, the event will be included. The filter should be in a format supported by hashicorp/go-bexpr.
 	DenyFilters    []string              `hcl:"deny_filters"`     // DenyFilters define a set predicates for excluding an event in the sink. If any filter matches, the event will be excluded. The filter should be in a format supported by hashicorp/go-bexpr.
 	Format         SinkFormat            `hcl:"format"`           // Format defines the format for the sink (JSONSinkFormat or TextSinkFormat).
-	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, or WriterSink).
+	Type           SinkType              `hcl:"type"`             // Type defines the type of sink (StderrSink, FileSink, WriterSink, HttpSink, or SyslogSink).
 	StderrConfig   *StderrSinkTypeConfig `hcl:"stderr"`           // StderrConfig defines parameters for a stderr output.
 	FileConfig     *FileSinkTypeConfig   `hcl:"file"`             // FileConfig defines parameters for a file output.
 	WriterConfig   *WriterSinkTypeConfig `hcl:"-"`                // WriterConfig defines parameters for an io.Writer output. This is not available via HCL.
+	HttpConfig     *HttpSinkTypeConfig   `hcl:"http"`             // HttpConfig defines parameters for an http output.
+	SyslogConfig   *SyslogSinkTypeConfig `hcl:"syslog"`           // SyslogConfig defines parameters for a syslog output.
 	AuditConfig    *AuditConfig          `hcl:"audit_config"`     // AuditConfig defines optional parameters for audit events (if EventTypes contains audit)
+	Sampling       *SamplingConfig       `hcl:"sampling"`         // Sampling defines optional sampling of the events sent to the sink.
+	RateLimit      *RateLimitConfig      `hcl:"rate_limit"`       // RateLimit defines an optional limit of the rate of events sent to the sink.
 }
 
 func (sc *SinkConfig) Validate() error {
@@ -45,6 +51,12 @@ func (sc *SinkConfig) Validate() error {
 	if sc.WriterConfig != nil {
 		foundSinkTypeConfigs++
 	}
+	if sc.HttpConfig != nil {
+		foundSinkTypeConfigs++
+	}
+	if sc.SyslogConfig != nil {
+		foundSinkTypeConfigs++
+	}
 	if foundSinkTypeConfigs > 1 {
 		return fmt.Errorf("%s: too many sink type config blocks: %w", op, ErrInvalidParameter)
 	}
@@ -65,6 +77,19 @@ func (sc *SinkConfig) Validate() error {
 		if sc.FileConfig.FileName == "" {
 			return fmt.Errorf("%s: missing file name: %w", op, ErrInvalidParameter)
 		}
+		if sc.FileConfig.HashChain {
+			// a chain only holds audit events, so that every line of the
+			// file is part of it.
+			if len(sc.EventTypes) != 1 || sc.EventTypes[0] != AuditType {
+				return fmt.Errorf("%s: hash chained file sink must only have the audit event type: %w", op, ErrInvalidParameter)
+			}
+			if sc.Format != JSONSinkFormat {
+				return fmt.Errorf("%s: hash chained file sink requires %s format: %w", op, JSONSinkFormat, ErrInvalidParameter)
+			}
+		}
+		if sc.FileConfig.CheckpointEvents < 0 {
+			return fmt.Errorf("%s: checkpoint events must not be negative: %w", op, ErrInvalidParameter)
+		}
 	case WriterSink:
 		if sc.WriterConfig == nil {
 			return fmt.Errorf(`%s: missing writer config: %w`, op, ErrInvalidParameter)
@@ -72,6 +97,30 @@ func (sc *SinkConfig) Validate() error {
 		if sc.WriterConfig.Writer == nil {
 			return fmt.Errorf("%s: missing writer: %w", op, ErrInvalidParameter)
 		}
+	case HttpSink:
+		if sc.HttpConfig == nil {
+			return fmt.Errorf(`%s: missing "http" block: %w`, op, ErrInvalidParameter)
+		}
+		// batches are sent using the CloudEvents batched content mode, which
+		// requires json events.
+		if sc.Format != JSONSinkFormat {
+			return fmt.Errorf("%s: http sink requires %s format: %w", op, JSONSinkFormat, ErrInvalidParameter)
+		}
+		if err := sc.HttpConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	case SyslogSink:
+		if sc.SyslogConfig == nil {
+			return fmt.Errorf(`%s: missing "syslog" block: %w`, op, ErrInvalidParameter)
+		}
+		// the structured data of a message is derived from the fields of the
+		// json event.
+		if sc.Format != JSONSinkFormat {
+			return fmt.Errorf("%s: syslog sink requires %s format: %w", op, JSONSinkFormat, ErrInvalidParameter)
+		}
+		if err := sc.SyslogConfig.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
 	}
 	if sc.Name == "" {
 		return fmt.Errorf("%s: missing sink name: %w", op, ErrInvalidParameter)
@@ -80,6 +129,17 @@ func (sc *SinkConfig) Validate() error {
 		return fmt.Errorf("%s: missing event types: %w", op, ErrInvalidParameter)
 	}
 
+	if sc.Sampling != nil {
+		if err := sc.Sampling.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	if sc.RateLimit != nil {
+		if err := sc.RateLimit.Validate(); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
 	// This checks if the telemetry event is specified in the sink but the observation event is not specified.
 	if !slices.Contains(sc.EventTypes, ObservationType) && slices.Contains(sc.EventTypes, TelemetryType) {
 		return fmt.Errorf("%s: telemetry event type requires observation event type to be specified: %w", op, ErrInvalidParameter)
@@ -108,12 +168,14 @@ type StderrSinkTypeConfig struct{}
 
 // FileSinkTypeConfig contains configuration structures for file sink types
 type FileSinkTypeConfig struct {
-	Path              string        `hcl:"path"             mapstructure:"path"`             // Path defines the file path for the sink
-	FileName          string        `hcl:"file_name"        mapstructure:"file_name"`        // FileName defines the file name for the sink
-	RotateBytes       int           `hcl:"rotate_bytes"     mapstructure:"rotate_bytes"`     // RotateBytes defines the number of bytes that should trigger rotation of a FileSink
-	RotateDuration    time.Duration `mapstructure:"rotate_duration"`                         // RotateDuration defines how often a FileSink should be rotated
-	RotateDurationHCL string        `hcl:"rotate_duration" json:"-"`                         // RotateDurationHCL defines hcl string version of RotateDuration
-	RotateMaxFiles    int           `hcl:"rotate_max_files" mapstructure:"rotate_max_files"` // RotateMaxFiles defines how may historical rotated files should be kept for a FileSink
+	Path              string        `hcl:"path"             mapstructure:"path"`               // Path defines the file path for the sink
+	FileName          string        `hcl:"file_name"        mapstructure:"file_name"`          // FileName defines the file name for the sink
+	RotateBytes       int           `hcl:"rotate_bytes"     mapstructure:"rotate_bytes"`       // RotateBytes defines the number of bytes that should trigger rotation of a FileSink
+	RotateDuration    time.Duration `mapstructure:"rotate_duration"`                           // RotateDuration defines how often a FileSink should be rotated
+	RotateDurationHCL string        `hcl:"rotate_duration" json:"-"`                           // RotateDurationHCL defines hcl string version of RotateDuration
+	RotateMaxFiles    int           `hcl:"rotate_max_files" mapstructure:"rotate_max_files"`   // RotateMaxFiles defines how may historical rotated files should be kept for a FileSink
+	HashChain         bool          `hcl:"hash_chain" mapstructure:"hash_chain"`               // HashChain defines if audit events are written as hash chained records, which can be verified with "boundary audit verify"
+	CheckpointEvents  int           `hcl:"checkpoint_events" mapstructure:"checkpoint_events"` // CheckpointEvents defines the number of hash chained events between checkpoint records
 }
 
 // WriterSinkTypeConfig contains configuration structures for writer sink types
@@ -121,6 +183,178 @@ type WriterSinkTypeConfig struct {
 	Writer io.Writer `hcl:"-" mapstructure:"-"` // The writer to write to
 }
 
+// HttpSinkTypeConfig contains configuration structures for http sink types
+type HttpSinkTypeConfig struct {
+	Url                string            `hcl:"url"               mapstructure:"url"`                 // Url defines the endpoint that batches of events are POSTed to
+	Headers            map[string]string `hcl:"headers"           mapstructure:"headers"`             // Headers defines additional headers sent with each request
+	BatchSize          int               `hcl:"batch_size"        mapstructure:"batch_size"`          // BatchSize defines the maximum number of events sent in one request
+	FlushInterval      time.Duration     `hcl:"-" mapstructure:"flush_interval"`                      // FlushInterval defines how often a partial batch is sent
+	FlushIntervalHCL   string            `hcl:"flush_interval" json:"-"`                              // FlushIntervalHCL defines hcl string version of FlushInterval
+	Timeout            time.Duration     `hcl:"-" mapstructure:"timeout"`                             // Timeout defines the timeout of each request
+	TimeoutHCL         string            `hcl:"timeout" json:"-"`                                     // TimeoutHCL defines hcl string version of Timeout
+	MaxRetries         int               `hcl:"max_retries"       mapstructure:"max_retries"`         // MaxRetries defines how many times a failed request is retried; a negative value disables retries
+	RetryBackoff       time.Duration     `hcl:"-" mapstructure:"retry_backoff"`                       // RetryBackoff defines the wait before the first retry, which doubles with each retry
+	RetryBackoffHCL    string            `hcl:"retry_backoff" json:"-"`                               // RetryBackoffHCL defines hcl string version of RetryBackoff
+	MaxRetryBackoff    time.Duration     `hcl:"-" mapstructure:"max_retry_backoff"`                   // MaxRetryBackoff defines the maximum wait between retries
+	MaxRetryBackoffHCL string            `hcl:"max_retry_backoff" json:"-"`                           // MaxRetryBackoffHCL defines hcl string version of MaxRetryBackoff
+	TlsCaCert          string            `hcl:"tls_ca_cert"       mapstructure:"tls_ca_cert"`         // TlsCaCert defines the path of a PEM file of CA certificates used to verify the endpoint
+	TlsClientCert      string            `hcl:"tls_client_cert"   mapstructure:"tls_client_cert"`     // TlsClientCert defines the path of a PEM client certificate
+	TlsClientKey       string            `hcl:"tls_client_key"    mapstructure:"tls_client_key"`      // TlsClientKey defines the path of the PEM key of the client certificate
+	TlsServerName      string            `hcl:"tls_server_name"   mapstructure:"tls_server_name"`     // TlsServerName defines the server name used to verify the endpoint
+	TlsSkipVerify      bool              `hcl:"tls_skip_verify"   mapstructure:"tls_skip_verify"`     // TlsSkipVerify disables the verification of the endpoint's certificate
+	SpoolPath          string            `hcl:"spool_path"        mapstructure:"spool_path"`          // SpoolPath defines a directory where batches which could not be delivered are kept and later sent again
+	SpoolMaxBytes      int64             `hcl:"spool_max_bytes"   mapstructure:"spool_max_bytes"`     // SpoolMaxBytes defines the maximum size of the spool
+	DeliveryGuarantee  DeliveryGuarantee `hcl:"delivery_guarantee" mapstructure:"delivery_guarantee"` // DeliveryGuarantee defines whether sending an event waits until it has been delivered or spooled (enforced) or not (best-effort)
+}
+
+// Validate an HttpSinkTypeConfig
+func (c *HttpSinkTypeConfig) Validate() error {
+	const op = "event.(HttpSinkTypeConfig).Validate"
+	if c.Url == "" {
+		return fmt.Errorf("%s: missing url: %w", op, ErrInvalidParameter)
+	}
+	u, err := url.Parse(c.Url)
+	if err != nil {
+		return fmt.Errorf("%s: invalid url: %w", op, ErrInvalidParameter)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%s: url scheme must be http or https: %w", op, ErrInvalidParameter)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%s: url is missing a host: %w", op, ErrInvalidParameter)
+	}
+	if (c.TlsClientCert == "") != (c.TlsClientKey == "") {
+		return fmt.Errorf("%s: tls client cert and key must both be set: %w", op, ErrInvalidParameter)
+	}
+	switch {
+	case c.BatchSize < 0:
+		return fmt.Errorf("%s: batch size must not be negative: %w", op, ErrInvalidParameter)
+	case c.FlushInterval < 0, c.Timeout < 0, c.RetryBackoff < 0, c.MaxRetryBackoff < 0:
+		return fmt.Errorf("%s: durations must not be negative: %w", op, ErrInvalidParameter)
+	case c.SpoolMaxBytes < 0:
+		return fmt.Errorf("%s: spool max bytes must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if err := c.DeliveryGuarantee.validate(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// SyslogNetwork defines the transport of a syslog sink
+type SyslogNetwork string
+
+const (
+	SyslogUdp SyslogNetwork = "udp" // SyslogUdp sends each message in a datagram
+	SyslogTcp SyslogNetwork = "tcp" // SyslogTcp sends octet-counted messages over tcp (RFC 6587)
+	SyslogTls SyslogNetwork = "tls" // SyslogTls sends octet-counted messages over tcp with tls (RFC 5425)
+)
+
+// SyslogSinkTypeConfig contains configuration structures for syslog sink types
+type SyslogSinkTypeConfig struct {
+	Network          SyslogNetwork `hcl:"network"            mapstructure:"network"`            // Network defines the transport (udp, tcp or tls), defaulting to udp
+	Address          string        `hcl:"address"            mapstructure:"address"`            // Address defines the host:port of the syslog server
+	Facility         string        `hcl:"facility"           mapstructure:"facility"`           // Facility defines the syslog facility keyword of the messages, defaulting to local0
+	AppName          string        `hcl:"app_name"           mapstructure:"app_name"`           // AppName defines the APP-NAME of the messages, defaulting to boundary
+	Hostname         string        `hcl:"hostname"           mapstructure:"hostname"`           // Hostname defines the HOSTNAME of the messages, defaulting to the host's name
+	StructuredDataId string        `hcl:"structured_data_id" mapstructure:"structured_data_id"` // StructuredDataId defines the SD-ID of the element holding the event's fields
+	Timeout          time.Duration `hcl:"-" mapstructure:"timeout"`                             // Timeout defines the timeout to connect and to write a message
+	TimeoutHCL       string        `hcl:"timeout" json:"-"`                                     // TimeoutHCL defines hcl string version of Timeout
+	TlsCaCert        string        `hcl:"tls_ca_cert"        mapstructure:"tls_ca_cert"`        // TlsCaCert defines the path of a PEM file of CA certificates used to verify the server
+	TlsClientCert    string        `hcl:"tls_client_cert"    mapstructure:"tls_client_cert"`    // TlsClientCert defines the path of a PEM client certificate
+	TlsClientKey     string        `hcl:"tls_client_key"     mapstructure:"tls_client_key"`     // TlsClientKey defines the path of the PEM key of the client certificate
+	TlsServerName    string        `hcl:"tls_server_name"    mapstructure:"tls_server_name"`    // TlsServerName defines the server name used to verify the server
+	TlsSkipVerify    bool          `hcl:"tls_skip_verify"    mapstructure:"tls_skip_verify"`    // TlsSkipVerify disables the verification of the server's certificate
+}
+
+// Validate a SyslogSinkTypeConfig
+func (c *SyslogSinkTypeConfig) Validate() error {
+	const op = "event.(SyslogSinkTypeConfig).Validate"
+	switch c.Network {
+	case "", SyslogUdp, SyslogTcp, SyslogTls:
+	default:
+		return fmt.Errorf("%s: %q is not a valid syslog network: %w", op, c.Network, ErrInvalidParameter)
+	}
+	if c.Address == "" {
+		return fmt.Errorf("%s: missing address: %w", op, ErrInvalidParameter)
+	}
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("%s: address must be host:port: %w", op, ErrInvalidParameter)
+	}
+	if c.Facility != "" {
+		if _, ok := syslogFacilities[c.Facility]; !ok {
+			return fmt.Errorf("%s: %q is not a valid syslog facility: %w", op, c.Facility, ErrInvalidParameter)
+		}
+	}
+	if err := validSyslogHeaderField(c.AppName, 48); err != nil {
+		return fmt.Errorf("%s: invalid app name: %w", op, err)
+	}
+	if err := validSyslogHeaderField(c.Hostname, 255); err != nil {
+		return fmt.Errorf("%s: invalid hostname: %w", op, err)
+	}
+	if err := validSyslogSdId(c.StructuredDataId); err != nil {
+		return fmt.Errorf("%s: invalid structured data id: %w", op, err)
+	}
+	if c.Timeout < 0 {
+		return fmt.Errorf("%s: timeout must not be negative: %w", op, ErrInvalidParameter)
+	}
+	if c.Network != SyslogTls && (c.TlsCaCert != "" || c.TlsClientCert != "" || c.TlsClientKey != "" || c.TlsServerName != "" || c.TlsSkipVerify) {
+		return fmt.Errorf("%s: tls settings require the tls network: %w", op, ErrInvalidParameter)
+	}
+	if (c.TlsClientCert == "") != (c.TlsClientKey == "") {
+		return fmt.Errorf("%s: tls client cert and key must both be set: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// SamplingConfig defines the sampling of the events sent to a sink. Either a
+// percentage of the events is kept, or the first events of each request path
+// in every interval.
+type SamplingConfig struct {
+	Percent          float64       `hcl:"percent"            mapstructure:"percent"`            // Percent defines the percentage of events which are kept
+	FirstPerInterval int           `hcl:"first_per_interval" mapstructure:"first_per_interval"` // FirstPerInterval defines the number of events of each request path which are kept in every interval
+	Interval         time.Duration `hcl:"-" mapstructure:"interval"`                            // Interval defines the interval of FirstPerInterval, defaulting to one minute
+	IntervalHCL      string        `hcl:"interval" json:"-"`                                    // IntervalHCL defines hcl string version of Interval
+}
+
+// Validate a SamplingConfig
+func (c *SamplingConfig) Validate() error {
+	const op = "event.(SamplingConfig).Validate"
+	switch {
+	case c.Percent != 0 && c.FirstPerInterval != 0:
+		return fmt.Errorf("%s: percent and first per interval are mutually exclusive: %w", op, ErrInvalidParameter)
+	case c.Percent == 0 && c.FirstPerInterval == 0:
+		return fmt.Errorf("%s: missing percent or first per interval: %w", op, ErrInvalidParameter)
+	case c.Percent < 0 || c.Percent > 100:
+		return fmt.Errorf("%s: percent must be greater than 0 and at most 100: %w", op, ErrInvalidParameter)
+	case c.FirstPerInterval < 0:
+		return fmt.Errorf("%s: first per interval must not be negative: %w", op, ErrInvalidParameter)
+	case c.Interval < 0:
+		return fmt.Errorf("%s: interval must not be negative: %w", op, ErrInvalidParameter)
+	case c.Interval != 0 && c.FirstPerInterval == 0:
+		return fmt.Errorf("%s: interval requires first per interval: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// RateLimitConfig defines a token bucket limiting the rate of events sent to
+// a sink.
+type RateLimitConfig struct {
+	EventsPerSecond float64 `hcl:"events_per_second" mapstructure:"events_per_second"` // EventsPerSecond defines the rate at which the bucket is refilled
+	Burst           int     `hcl:"burst"             mapstructure:"burst"`             // Burst defines the size of the bucket, defaulting to EventsPerSecond rounded up
+}
+
+// Validate a RateLimitConfig
+func (c *RateLimitConfig) Validate() error {
+	const op = "event.(RateLimitConfig).Validate"
+	switch {
+	case c.EventsPerSecond <= 0:
+		return fmt.Errorf("%s: events per second must be greater than 0: %w", op, ErrInvalidParameter)
+	case c.Burst < 0:
+		return fmt.Errorf("%s: burst must not be negative: %w", op, ErrInvalidParameter)
+	}
+	return nil
+}
+
 // FilterType defines a type for filters (allow or deny)
 type FilterType string
 
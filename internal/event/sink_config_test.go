@@ -5,6 +5,7 @@ package event
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -161,6 +162,36 @@ func TestSinkConfig_Validate(t *testing.T) {
 			wantErrIs:       ErrInvalidParameter,
 			wantErrContains: "missing file name",
 		},
+		{
+			name: "hash-chain-file-sink-with-every-type",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       FileSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					FileName:  "audit.log",
+					HashChain: true,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "hash chained file sink must only have the audit event type",
+		},
+		{
+			name: "hash-chain-file-sink-text-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				Format:     TextSinkFormat,
+				FileConfig: &FileSinkTypeConfig{
+					FileName:  "audit.log",
+					HashChain: true,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "hash chained file sink requires cloudevents-json format",
+		},
 		{
 			name: "type mismatch file type stderr config",
 			sc: SinkConfig{
@@ -233,6 +264,207 @@ func TestSinkConfig_Validate(t *testing.T) {
 				Format: JSONSinkFormat,
 			},
 		},
+		{
+			name: "http-sink-missing-http-config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "http" block`,
+		},
+		{
+			name: "http-sink-text-format",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     TextHclogSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{Url: "https://events.example.com"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "http sink requires cloudevents-json format",
+		},
+		{
+			name: "http-sink-missing-url",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing url",
+		},
+		{
+			name: "http-sink-invalid-scheme",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{Url: "ftp://events.example.com"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "url scheme must be http or https",
+		},
+		{
+			name: "http-sink-client-cert-without-key",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{
+					Url:           "https://events.example.com",
+					TlsClientCert: "client.pem",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls client cert and key must both be set",
+		},
+		{
+			name: "http-sink-invalid-delivery-guarantee",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				HttpConfig: &HttpSinkTypeConfig{
+					Url:               "https://events.example.com",
+					DeliveryGuarantee: "invalid",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "not a valid delivery guarantee",
+		},
+		{
+			name: "syslog-sink-missing-syslog-config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `missing "syslog" block`,
+		},
+		{
+			name: "syslog-sink-text-format",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       TextSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: "siem.example.com:514"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "syslog sink requires cloudevents-json format",
+		},
+		{
+			name: "syslog-sink-invalid-network",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network: "unix",
+					Address: "siem.example.com:514",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `"unix" is not a valid syslog network`,
+		},
+		{
+			name: "syslog-sink-missing-port",
+			sc: SinkConfig{
+				Name:         "sink-name",
+				EventTypes:   []Type{EveryType},
+				Type:         SyslogSink,
+				Format:       JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{Address: "siem.example.com"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "address must be host:port",
+		},
+		{
+			name: "syslog-sink-invalid-facility",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Address:  "siem.example.com:514",
+					Facility: "local8",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `"local8" is not a valid syslog facility`,
+		},
+		{
+			name: "syslog-sink-invalid-app-name",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Address: "siem.example.com:514",
+					AppName: "my app",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "invalid app name",
+		},
+		{
+			name: "syslog-sink-invalid-structured-data-id",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Address:          "siem.example.com:514",
+					StructuredDataId: "boundary=1",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "invalid structured data id",
+		},
+		{
+			name: "syslog-sink-tls-settings-without-tls",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network:   SyslogTcp,
+					Address:   "siem.example.com:514",
+					TlsCaCert: "ca.pem",
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "tls settings require the tls network",
+		},
+		{
+			name: "type mismatch http type file config",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				Format:     JSONSinkFormat,
+				FileConfig: &FileSinkTypeConfig{FileName: "tmp.file"},
+				HttpConfig: &HttpSinkTypeConfig{Url: "https://events.example.com"},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: `too many sink type config blocks`,
+		},
 		{
 			name: "valid",
 			sc: SinkConfig{
@@ -245,6 +477,131 @@ func TestSinkConfig_Validate(t *testing.T) {
 				Format: JSONSinkFormat,
 			},
 		},
+		{
+			name: "valid-http",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{EveryType},
+				Type:       HttpSink,
+				HttpConfig: &HttpSinkTypeConfig{
+					Url:               "https://events.example.com/boundary",
+					DeliveryGuarantee: Enforced,
+				},
+				Format: JSONSinkFormat,
+			},
+		},
+		{
+			name: "valid-hash-chain",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType},
+				Type:       FileSink,
+				FileConfig: &FileSinkTypeConfig{
+					FileName:         "audit.log",
+					HashChain:        true,
+					CheckpointEvents: 1000,
+				},
+				Format: JSONSinkFormat,
+			},
+		},
+		{
+			name: "sampling-percent-and-first-per-interval",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{ObservationType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+				Sampling: &SamplingConfig{
+					Percent:          10,
+					FirstPerInterval: 5,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "percent and first per interval are mutually exclusive",
+		},
+		{
+			name: "sampling-empty",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{ObservationType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+				Sampling:   &SamplingConfig{},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "missing percent or first per interval",
+		},
+		{
+			name: "sampling-percent-out-of-range",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{ObservationType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+				Sampling:   &SamplingConfig{Percent: 101},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "percent must be greater than 0 and at most 100",
+		},
+		{
+			name: "sampling-interval-without-first-per-interval",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{ObservationType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+				Sampling: &SamplingConfig{
+					Percent:  50,
+					Interval: time.Second,
+				},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "interval requires first per interval",
+		},
+		{
+			name: "rate-limit-missing-rate",
+			sc: SinkConfig{
+				Name:       "sink-name",
+				EventTypes: []Type{ObservationType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+				RateLimit:  &RateLimitConfig{Burst: 10},
+			},
+			wantErrIs:       ErrInvalidParameter,
+			wantErrContains: "events per second must be greater than 0",
+		},
+		{
+			name: "valid-sampling-and-rate-limit",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{ObservationType},
+				Type:       StderrSink,
+				Format:     JSONSinkFormat,
+				Sampling: &SamplingConfig{
+					FirstPerInterval: 10,
+					Interval:         time.Minute,
+				},
+				RateLimit: &RateLimitConfig{
+					EventsPerSecond: 100,
+					Burst:           200,
+				},
+			},
+		},
+		{
+			name: "valid-syslog",
+			sc: SinkConfig{
+				Name:       "valid",
+				EventTypes: []Type{AuditType, ObservationType, ErrorType, SystemType},
+				Type:       SyslogSink,
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Network:   SyslogTls,
+					Address:   "siem.example.com:6514",
+					Facility:  "local4",
+					TlsCaCert: "ca.pem",
+				},
+				Format: JSONSinkFormat,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -11,14 +11,16 @@ const (
 	StderrSink SinkType = "stderr" // StderrSink is written to stderr
 	FileSink   SinkType = "file"   // FileSink is written to a file
 	WriterSink SinkType = "writer" // WriterSink is written to an io.Writer
+	HttpSink   SinkType = "http"   // HttpSink is sent to an http endpoint
+	SyslogSink SinkType = "syslog" // SyslogSink is sent to a syslog server
 )
 
-type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, writer)
+type SinkType string // SinkType defines the type of sink in a config stanza (file, stderr, writer, http, syslog)
 
 func (t SinkType) Validate() error {
 	const op = "event.(SinkType).validate"
 	switch t {
-	case StderrSink, FileSink, WriterSink:
+	case StderrSink, FileSink, WriterSink, HttpSink, SyslogSink:
 		return nil
 	default:
 		return fmt.Errorf("%s: '%s' is not a valid sink type: %w", op, t, ErrInvalidParameter)
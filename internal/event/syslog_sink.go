@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+)
+
+const (
+	// defaultSyslogSdId is the SD-ID of the structured data element holding
+	// the fields of an event. 32473 is the example private enterprise number
+	// of RFC 5612, so the structured_data_id setting can be used to register
+	// the element under an organization's own enterprise number.
+	defaultSyslogSdId     = "boundary@32473"
+	defaultSyslogAppName  = "boundary"
+	defaultSyslogFacility = "local0"
+	defaultSyslogTimeout  = 10 * time.Second
+
+	// syslogNilValue is used for header fields which have no value.
+	syslogNilValue = "-"
+
+	syslogSeverityError         = 3
+	syslogSeverityNotice        = 5
+	syslogSeverityInformational = 6
+)
+
+// syslogFacilities maps the facility keywords to their RFC 5424 codes.
+var syslogFacilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+// validSyslogHeaderField checks that an optional header field is made of at
+// most maxLen printable US-ASCII characters.
+func validSyslogHeaderField(v string, maxLen int) error {
+	if len(v) > maxLen {
+		return fmt.Errorf("longer than %d characters: %w", maxLen, ErrInvalidParameter)
+	}
+	for _, c := range []byte(v) {
+		if c < 33 || c > 126 {
+			return fmt.Errorf("%q contains a character which is not printable US-ASCII: %w", v, ErrInvalidParameter)
+		}
+	}
+	return nil
+}
+
+// validSyslogSdId checks that an optional SD-ID is valid, which is a header
+// field of at most 32 characters without '=', ']' and '"'.
+func validSyslogSdId(v string) error {
+	if err := validSyslogHeaderField(v, 32); err != nil {
+		return err
+	}
+	if strings.ContainsAny(v, `=]"`) {
+		return fmt.Errorf("%q contains '=', ']' or '\"': %w", v, ErrInvalidParameter)
+	}
+	return nil
+}
+
+// syslogEvent is the part of a cloudevents-json event used to build a syslog
+// message.
+type syslogEvent struct {
+	Id              string          `json:"id"`
+	Source          string          `json:"source"`
+	SpecVersion     string          `json:"specversion"`
+	Type            string          `json:"type"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataContentType string          `json:"datacontentype,omitempty"`
+	DataSchema      string          `json:"dataschema,omitempty"`
+	Time            time.Time       `json:"time,omitempty"`
+	SerializedHmac  string          `json:"serialized_hmac,omitempty"`
+}
+
+// syslogSink is an eventlogger sink which sends events to a syslog server as
+// RFC 5424 messages. The fields of the cloudevent are held by a structured
+// data element and its data is the message. Messages are sent in a datagram
+// over udp, and are octet-counted over tcp and tls.
+type syslogSink struct {
+	format    string
+	network   SyslogNetwork
+	address   string
+	facility  int
+	hostname  string
+	appName   string
+	procId    string
+	sdId      string
+	timeout   time.Duration
+	tlsConfig *tls.Config
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+var (
+	_ eventlogger.Node   = (*syslogSink)(nil)
+	_ eventlogger.Closer = (*syslogSink)(nil)
+)
+
+// newSyslogSink creates a syslog sink for the provided config. The connection
+// to the server is made when the first event is sent.
+func newSyslogSink(format SinkFormat, c *SyslogSinkTypeConfig) (*syslogSink, error) {
+	const op = "event.newSyslogSink"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing syslog config: %w", op, ErrInvalidParameter)
+	}
+	s := &syslogSink{
+		format:   string(format),
+		network:  c.Network,
+		address:  c.Address,
+		facility: syslogFacilities[defaultSyslogFacility],
+		hostname: c.Hostname,
+		appName:  c.AppName,
+		procId:   strconv.Itoa(os.Getpid()),
+		sdId:     c.StructuredDataId,
+		timeout:  withDefault(c.Timeout, defaultSyslogTimeout),
+	}
+	if s.network == "" {
+		s.network = SyslogUdp
+	}
+	if c.Facility != "" {
+		s.facility = syslogFacilities[c.Facility]
+	}
+	if s.appName == "" {
+		s.appName = defaultSyslogAppName
+	}
+	if s.sdId == "" {
+		s.sdId = defaultSyslogSdId
+	}
+	if s.hostname == "" {
+		s.hostname = syslogNilValue
+		if h, err := os.Hostname(); err == nil && validSyslogHeaderField(h, 255) == nil && h != "" {
+			s.hostname = h
+		}
+	}
+	if s.network == SyslogTls {
+		var err error
+		s.tlsConfig, err = newTlsConfig(c.TlsCaCert, c.TlsClientCert, c.TlsClientKey, c.TlsServerName, c.TlsSkipVerify)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return s, nil
+}
+
+// Process sends the event to the syslog server. If the write fails, the sink
+// reconnects and sends the event again once.
+func (s *syslogSink) Process(ctx context.Context, e *eventlogger.Event) (*eventlogger.Event, error) {
+	const op = "event.(syslogSink).Process"
+	if e == nil {
+		return nil, fmt.Errorf("%s: missing event: %w", op, ErrInvalidParameter)
+	}
+	val, ok := e.Format(s.format)
+	if !ok {
+		return nil, fmt.Errorf("%s: event was not marshaled", op)
+	}
+	msg, err := s.message(e, val)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if s.network != SyslogUdp {
+		msg = append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.write(ctx, msg); err != nil {
+		s.closeConn()
+		if err := s.write(ctx, msg); err != nil {
+			s.closeConn()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	// Sinks are leafs, so do not return the event, since nothing more can
+	// happen to it downstream.
+	return nil, nil
+}
+
+// Reopen closes the connection to the syslog server, so the next event is
+// sent over a new connection.
+func (s *syslogSink) Reopen() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeConn()
+	return nil
+}
+
+// Type describes the type of the node as a Sink.
+func (s *syslogSink) Type() eventlogger.NodeType { return eventlogger.NodeTypeSink }
+
+// Close closes the connection to the syslog server.
+func (s *syslogSink) Close(_ context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closeConn()
+	return nil
+}
+
+// write sends a message, connecting to the server first if needed. s.mu must
+// be held.
+func (s *syslogSink) write(ctx context.Context, msg []byte) error {
+	if s.conn == nil {
+		d := &net.Dialer{Timeout: s.timeout}
+		var conn net.Conn
+		var err error
+		switch s.network {
+		case SyslogTls:
+			td := &tls.Dialer{NetDialer: d, Config: s.tlsConfig}
+			conn, err = td.DialContext(ctx, "tcp", s.address)
+		default:
+			conn, err = d.DialContext(ctx, string(s.network), s.address)
+		}
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+	_, err := s.conn.Write(msg)
+	return err
+}
+
+// closeConn closes the connection to the server. s.mu must be held.
+func (s *syslogSink) closeConn() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+	}
+}
+
+// message builds the RFC 5424 message of a cloudevents-json event.
+func (s *syslogSink) message(e *eventlogger.Event, val []byte) ([]byte, error) {
+	var ce syslogEvent
+	if err := json.Unmarshal(val, &ce); err != nil {
+		return nil, fmt.Errorf("unable to decode cloudevent: %w", err)
+	}
+	ts := ce.Time
+	if ts.IsZero() {
+		ts = e.CreatedAt
+	}
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	msgId := ce.Type
+	if msgId == "" || validSyslogHeaderField(msgId, 32) != nil {
+		msgId = syslogNilValue
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<%d>1 %s %s %s %s %s [%s",
+		s.facility*8+syslogSeverity(ce.Type),
+		ts.UTC().Format("2006-01-02T15:04:05.000000Z"),
+		s.hostname,
+		s.appName,
+		s.procId,
+		msgId,
+		s.sdId,
+	)
+	for _, p := range []struct{ name, value string }{
+		{"id", ce.Id},
+		{"source", ce.Source},
+		{"specversion", ce.SpecVersion},
+		{"type", ce.Type},
+		{"datacontenttype", ce.DataContentType},
+		{"dataschema", ce.DataSchema},
+		{"serialized_hmac", ce.SerializedHmac},
+	} {
+		if p.value == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, ` %s="%s"`, p.name, escapeSyslogParamValue(p.value))
+	}
+	buf.WriteByte(']')
+	if len(ce.Data) > 0 {
+		buf.WriteByte(' ')
+		if err := json.Compact(&buf, ce.Data); err != nil {
+			return nil, fmt.Errorf("unable to encode cloudevent data: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// syslogSeverity returns the severity of the messages of an event type.
+func syslogSeverity(t string) int {
+	switch Type(t) {
+	case ErrorType:
+		return syslogSeverityError
+	case AuditType:
+		return syslogSeverityNotice
+	default:
+		return syslogSeverityInformational
+	}
+}
+
+var syslogParamValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// escapeSyslogParamValue escapes the characters which must be escaped in a
+// PARAM-VALUE.
+func escapeSyslogParamValue(v string) string {
+	return syslogParamValueEscaper.Replace(v)
+}
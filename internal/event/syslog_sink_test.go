@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/eventlogger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSyslogEvent(t *testing.T, ce string) *eventlogger.Event {
+	t.Helper()
+	e := &eventlogger.Event{}
+	e.FormattedAs(string(JSONSinkFormat), []byte(ce))
+	return e
+}
+
+func Test_syslogSink_message(t *testing.T) {
+	t.Parallel()
+	s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{
+		Address:  "localhost:514",
+		Facility: "local4",
+		Hostname: "controller-1",
+	})
+	require.NoError(t, err)
+	s.procId = "42"
+
+	tests := []struct {
+		name string
+		ce   string
+		want string
+	}{
+		{
+			name: "audit",
+			ce:   `{"id":"abc","source":"https://hashicorp.com/boundary/c1","specversion":"1.0","type":"audit","data":{"id":"au_1", "auth": {"user_id":"u_1"}},"datacontentype":"application/cloudevents","time":"2024-01-02T03:04:05.123456789Z"}`,
+			want: `<165>1 2024-01-02T03:04:05.123456Z controller-1 boundary 42 audit [boundary@32473 id="abc" source="https://hashicorp.com/boundary/c1" specversion="1.0" type="audit" datacontenttype="application/cloudevents"] {"id":"au_1","auth":{"user_id":"u_1"}}`,
+		},
+		{
+			name: "error",
+			ce:   `{"id":"abc","source":"s","specversion":"1.0","type":"error","data":{"error":"failed"},"time":"2024-01-02T03:04:05Z"}`,
+			want: `<163>1 2024-01-02T03:04:05.000000Z controller-1 boundary 42 error [boundary@32473 id="abc" source="s" specversion="1.0" type="error"] {"error":"failed"}`,
+		},
+		{
+			name: "escaped-param-values",
+			ce:   `{"id":"a\"b]c\\d","source":"s","specversion":"1.0","type":"system","time":"2024-01-02T03:04:05Z"}`,
+			want: `<166>1 2024-01-02T03:04:05.000000Z controller-1 boundary 42 system [boundary@32473 id="a\"b\]c\\d" source="s" specversion="1.0" type="system"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.message(testSyslogEvent(t, tt.ce), []byte(tt.ce))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, string(got))
+		})
+	}
+}
+
+const testSyslogCloudEvent = `{"id":"1","source":"s","specversion":"1.0","type":"system","data":{"msg":"hello"}}`
+
+func Test_syslogSink_udp(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() { _ = pc.Close() })
+
+	s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{Address: pc.LocalAddr().String()})
+	require.NoError(err)
+	t.Cleanup(func() { _ = s.Close(ctx) })
+	_, err = s.Process(ctx, testSyslogEvent(t, testSyslogCloudEvent))
+	require.NoError(err)
+
+	buf := make([]byte, 2048)
+	require.NoError(pc.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	n, _, err := pc.ReadFrom(buf)
+	require.NoError(err)
+	msg := string(buf[:n])
+	assert.True(strings.HasPrefix(msg, "<134>1 "), msg)
+	assert.True(strings.HasSuffix(msg, `type="system"] {"msg":"hello"}`), msg)
+}
+
+// readOctetCounted reads an RFC 6587 octet-counted message.
+func readOctetCounted(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	l, err := r.ReadString(' ')
+	require.NoError(t, err)
+	n, err := strconv.Atoi(strings.TrimSpace(l))
+	require.NoError(t, err)
+	msg := make([]byte, n)
+	_, err = io.ReadFull(r, msg)
+	require.NoError(t, err)
+	return string(msg)
+}
+
+func Test_syslogSink_tcp(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{
+		Network: SyslogTcp,
+		Address: l.Addr().String(),
+	})
+	require.NoError(err)
+	t.Cleanup(func() { _ = s.Close(ctx) })
+
+	for i := 0; i < 2; i++ {
+		_, err = s.Process(ctx, testSyslogEvent(t, testSyslogCloudEvent))
+		require.NoError(err)
+	}
+	conn, err := l.Accept()
+	require.NoError(err)
+	r := bufio.NewReader(conn)
+	for i := 0; i < 2; i++ {
+		msg := readOctetCounted(t, r)
+		assert.True(strings.HasSuffix(msg, `type="system"] {"msg":"hello"}`), msg)
+	}
+
+	// the sink connects again after a reopen.
+	require.NoError(conn.Close())
+	require.NoError(s.Reopen())
+	_, err = s.Process(ctx, testSyslogEvent(t, testSyslogCloudEvent))
+	require.NoError(err)
+	conn, err = l.Accept()
+	require.NoError(err)
+	t.Cleanup(func() { _ = conn.Close() })
+	msg := readOctetCounted(t, bufio.NewReader(conn))
+	assert.True(strings.HasPrefix(msg, "<134>1 "), msg)
+}
+
+func Test_syslogSink_tls(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "syslog.test"},
+		DNSNames:     []string{"syslog.test"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(err)
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	l, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+	})
+	require.NoError(err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	s, err := newSyslogSink(JSONSinkFormat, &SyslogSinkTypeConfig{
+		Network:       SyslogTls,
+		Address:       l.Addr().String(),
+		TlsCaCert:     caFile,
+		TlsServerName: "syslog.test",
+	})
+	require.NoError(err)
+	t.Cleanup(func() { _ = s.Close(ctx) })
+
+	accepted := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			close(accepted)
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		l, err := r.ReadString(' ')
+		if err != nil {
+			close(accepted)
+			return
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(l))
+		msg := make([]byte, n)
+		_, _ = io.ReadFull(r, msg)
+		accepted <- string(msg)
+	}()
+	_, err = s.Process(ctx, testSyslogEvent(t, testSyslogCloudEvent))
+	require.NoError(err)
+	select {
+	case msg := <-accepted:
+		assert.True(strings.HasSuffix(msg, `type="system"] {"msg":"hello"}`), msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the syslog message")
+	}
+}
+
+func TestEventer_syslogSink(t *testing.T) {
+	t.Parallel()
+	assert, require := assert.New(t), require.New(t)
+	ctx := context.Background()
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(err)
+	t.Cleanup(func() { _ = pc.Close() })
+
+	testLock := &sync.Mutex{}
+	c := EventerConfig{
+		SysEventsEnabled: true,
+		Sinks: []*SinkConfig{
+			{
+				Name:       "syslog",
+				Type:       SyslogSink,
+				Format:     JSONSinkFormat,
+				EventTypes: []Type{SystemType},
+				SyslogConfig: &SyslogSinkTypeConfig{
+					Address:  pc.LocalAddr().String(),
+					Facility: "auth",
+				},
+			},
+		},
+	}
+	e, err := NewEventer(testLogger(t, testLock), testLock, "TestEventer_syslogSink", c)
+	require.NoError(err)
+	require.NoError(e.writeSysEvent(ctx, &sysEvent{
+		Id:      "1",
+		Version: sysVersion,
+		Op:      "TestEventer_syslogSink",
+		Data:    map[string]any{"msg": "hello"},
+	}))
+
+	buf := make([]byte, 2048)
+	require.NoError(pc.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	n, _, err := pc.ReadFrom(buf)
+	require.NoError(err)
+	msg := string(buf[:n])
+	assert.True(strings.HasPrefix(msg, "<38>1 "), msg)
+	assert.Contains(msg, `source="https://hashicorp.com/boundary/TestEventer_syslogSink"`)
+	assert.Contains(msg, `"data":{"msg":"hello"}`)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package event
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// newTlsConfig returns the tls config of a sink which connects to a remote
+// endpoint. The CA cert, client cert and client key are paths of PEM files and
+// are optional.
+func newTlsConfig(caCert, clientCert, clientKey, serverName string, skipVerify bool) (*tls.Config, error) {
+	const op = "event.newTlsConfig"
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         serverName,
+		InsecureSkipVerify: skipVerify,
+	}
+	if caCert != "" {
+		pem, err := os.ReadFile(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to read tls ca cert: %w", op, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("%s: no certificates found in tls ca cert %q: %w", op, caCert, ErrInvalidParameter)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if clientCert != "" || clientKey != "" {
+		cert, err := tls.LoadX509KeyPair(clientCert, clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to load tls client cert: %w", op, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
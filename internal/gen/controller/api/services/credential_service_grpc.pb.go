@@ -22,11 +22,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	CredentialService_GetCredential_FullMethodName    = "/controller.api.services.v1.CredentialService/GetCredential"
-	CredentialService_ListCredentials_FullMethodName  = "/controller.api.services.v1.CredentialService/ListCredentials"
-	CredentialService_CreateCredential_FullMethodName = "/controller.api.services.v1.CredentialService/CreateCredential"
-	CredentialService_UpdateCredential_FullMethodName = "/controller.api.services.v1.CredentialService/UpdateCredential"
-	CredentialService_DeleteCredential_FullMethodName = "/controller.api.services.v1.CredentialService/DeleteCredential"
+	CredentialService_GetCredential_FullMethodName      = "/controller.api.services.v1.CredentialService/GetCredential"
+	CredentialService_ListCredentials_FullMethodName    = "/controller.api.services.v1.CredentialService/ListCredentials"
+	CredentialService_CreateCredential_FullMethodName   = "/controller.api.services.v1.CredentialService/CreateCredential"
+	CredentialService_UpdateCredential_FullMethodName   = "/controller.api.services.v1.CredentialService/UpdateCredential"
+	CredentialService_DeleteCredential_FullMethodName   = "/controller.api.services.v1.CredentialService/DeleteCredential"
+	CredentialService_RotateCredential_FullMethodName   = "/controller.api.services.v1.CredentialService/RotateCredential"
+	CredentialService_RollbackCredential_FullMethodName = "/controller.api.services.v1.CredentialService/RollbackCredential"
 )
 
 // CredentialServiceClient is the client API for CredentialService service.
@@ -59,6 +61,21 @@ type CredentialServiceClient interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error)
+	// RotateCredential replaces the password of a username password Credential
+	// and records it as a new credential version. If a password is not provided,
+	// a random password is generated, which requires a credential rotation
+	// command to be configured on the controller. If a rotation command is
+	// configured, it is run to change the password on the host before the new
+	// credential version is committed. An error is returned if the Credential id
+	// is missing, malformed, references a non existing resource or a Credential
+	// which is not a username password Credential.
+	RotateCredential(ctx context.Context, in *RotateCredentialRequest, opts ...grpc.CallOption) (*RotateCredentialResponse, error)
+	// RollbackCredential restores the secret a Credential held at an earlier
+	// credential version. The restored secret is recorded as a new credential
+	// version. An error is returned if the Credential id is missing, malformed or
+	// references a non existing resource, or if the credential version is not
+	// earlier than the current credential version of the Credential.
+	RollbackCredential(ctx context.Context, in *RollbackCredentialRequest, opts ...grpc.CallOption) (*RollbackCredentialResponse, error)
 }
 
 type credentialServiceClient struct {
@@ -114,6 +131,24 @@ func (c *credentialServiceClient) DeleteCredential(ctx context.Context, in *Dele
 	return out, nil
 }
 
+func (c *credentialServiceClient) RotateCredential(ctx context.Context, in *RotateCredentialRequest, opts ...grpc.CallOption) (*RotateCredentialResponse, error) {
+	out := new(RotateCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_RotateCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *credentialServiceClient) RollbackCredential(ctx context.Context, in *RollbackCredentialRequest, opts ...grpc.CallOption) (*RollbackCredentialResponse, error) {
+	out := new(RollbackCredentialResponse)
+	err := c.cc.Invoke(ctx, CredentialService_RollbackCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CredentialServiceServer is the server API for CredentialService service.
 // All implementations must embed UnimplementedCredentialServiceServer
 // for forward compatibility
@@ -144,6 +179,21 @@ type CredentialServiceServer interface {
 	// DeleteCredential removes an Credential from Boundary. If the Credential id
 	// is malformed or not provided an error is returned.
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error)
+	// RotateCredential replaces the password of a username password Credential
+	// and records it as a new credential version. If a password is not provided,
+	// a random password is generated, which requires a credential rotation
+	// command to be configured on the controller. If a rotation command is
+	// configured, it is run to change the password on the host before the new
+	// credential version is committed. An error is returned if the Credential id
+	// is missing, malformed, references a non existing resource or a Credential
+	// which is not a username password Credential.
+	RotateCredential(context.Context, *RotateCredentialRequest) (*RotateCredentialResponse, error)
+	// RollbackCredential restores the secret a Credential held at an earlier
+	// credential version. The restored secret is recorded as a new credential
+	// version. An error is returned if the Credential id is missing, malformed or
+	// references a non existing resource, or if the credential version is not
+	// earlier than the current credential version of the Credential.
+	RollbackCredential(context.Context, *RollbackCredentialRequest) (*RollbackCredentialResponse, error)
 	mustEmbedUnimplementedCredentialServiceServer()
 }
 
@@ -166,6 +216,12 @@ func (UnimplementedCredentialServiceServer) UpdateCredential(context.Context, *U
 func (UnimplementedCredentialServiceServer) DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
 }
+func (UnimplementedCredentialServiceServer) RotateCredential(context.Context, *RotateCredentialRequest) (*RotateCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateCredential not implemented")
+}
+func (UnimplementedCredentialServiceServer) RollbackCredential(context.Context, *RollbackCredentialRequest) (*RollbackCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackCredential not implemented")
+}
 func (UnimplementedCredentialServiceServer) mustEmbedUnimplementedCredentialServiceServer() {}
 
 // UnsafeCredentialServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,6 +325,42 @@ func _CredentialService_DeleteCredential_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CredentialService_RotateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).RotateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_RotateCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).RotateCredential(ctx, req.(*RotateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CredentialService_RollbackCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CredentialServiceServer).RollbackCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CredentialService_RollbackCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CredentialServiceServer).RollbackCredential(ctx, req.(*RollbackCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CredentialService_ServiceDesc is the grpc.ServiceDesc for CredentialService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -296,6 +388,14 @@ var CredentialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCredential",
 			Handler:    _CredentialService_DeleteCredential_Handler,
 		},
+		{
+			MethodName: "RotateCredential",
+			Handler:    _CredentialService_RotateCredential_Handler,
+		},
+		{
+			MethodName: "RollbackCredential",
+			Handler:    _CredentialService_RollbackCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/credential_service.proto",
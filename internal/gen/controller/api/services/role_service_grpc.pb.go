@@ -22,20 +22,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RoleService_GetRole_FullMethodName               = "/controller.api.services.v1.RoleService/GetRole"
-	RoleService_ListRoles_FullMethodName             = "/controller.api.services.v1.RoleService/ListRoles"
-	RoleService_CreateRole_FullMethodName            = "/controller.api.services.v1.RoleService/CreateRole"
-	RoleService_UpdateRole_FullMethodName            = "/controller.api.services.v1.RoleService/UpdateRole"
-	RoleService_DeleteRole_FullMethodName            = "/controller.api.services.v1.RoleService/DeleteRole"
-	RoleService_AddRolePrincipals_FullMethodName     = "/controller.api.services.v1.RoleService/AddRolePrincipals"
-	RoleService_SetRolePrincipals_FullMethodName     = "/controller.api.services.v1.RoleService/SetRolePrincipals"
-	RoleService_RemoveRolePrincipals_FullMethodName  = "/controller.api.services.v1.RoleService/RemoveRolePrincipals"
-	RoleService_AddRoleGrants_FullMethodName         = "/controller.api.services.v1.RoleService/AddRoleGrants"
-	RoleService_SetRoleGrants_FullMethodName         = "/controller.api.services.v1.RoleService/SetRoleGrants"
-	RoleService_RemoveRoleGrants_FullMethodName      = "/controller.api.services.v1.RoleService/RemoveRoleGrants"
-	RoleService_AddRoleGrantScopes_FullMethodName    = "/controller.api.services.v1.RoleService/AddRoleGrantScopes"
-	RoleService_SetRoleGrantScopes_FullMethodName    = "/controller.api.services.v1.RoleService/SetRoleGrantScopes"
-	RoleService_RemoveRoleGrantScopes_FullMethodName = "/controller.api.services.v1.RoleService/RemoveRoleGrantScopes"
+	RoleService_GetRole_FullMethodName                  = "/controller.api.services.v1.RoleService/GetRole"
+	RoleService_ListRoles_FullMethodName                = "/controller.api.services.v1.RoleService/ListRoles"
+	RoleService_CreateRole_FullMethodName               = "/controller.api.services.v1.RoleService/CreateRole"
+	RoleService_UpdateRole_FullMethodName               = "/controller.api.services.v1.RoleService/UpdateRole"
+	RoleService_DeleteRole_FullMethodName               = "/controller.api.services.v1.RoleService/DeleteRole"
+	RoleService_AddRolePrincipals_FullMethodName        = "/controller.api.services.v1.RoleService/AddRolePrincipals"
+	RoleService_SetRolePrincipals_FullMethodName        = "/controller.api.services.v1.RoleService/SetRolePrincipals"
+	RoleService_RemoveRolePrincipals_FullMethodName     = "/controller.api.services.v1.RoleService/RemoveRolePrincipals"
+	RoleService_AddRoleGrants_FullMethodName            = "/controller.api.services.v1.RoleService/AddRoleGrants"
+	RoleService_ValidateRoleGrants_FullMethodName       = "/controller.api.services.v1.RoleService/ValidateRoleGrants"
+	RoleService_SetRoleGrants_FullMethodName            = "/controller.api.services.v1.RoleService/SetRoleGrants"
+	RoleService_RemoveRoleGrants_FullMethodName         = "/controller.api.services.v1.RoleService/RemoveRoleGrants"
+	RoleService_AddRoleGrantScopes_FullMethodName       = "/controller.api.services.v1.RoleService/AddRoleGrantScopes"
+	RoleService_SetRoleGrantScopes_FullMethodName       = "/controller.api.services.v1.RoleService/SetRoleGrantScopes"
+	RoleService_RemoveRoleGrantScopes_FullMethodName    = "/controller.api.services.v1.RoleService/RemoveRoleGrantScopes"
+	RoleService_RequestRoleAccess_FullMethodName        = "/controller.api.services.v1.RoleService/RequestRoleAccess"
+	RoleService_ListRoleAccessRequests_FullMethodName   = "/controller.api.services.v1.RoleService/ListRoleAccessRequests"
+	RoleService_ApproveRoleAccessRequest_FullMethodName = "/controller.api.services.v1.RoleService/ApproveRoleAccessRequest"
+	RoleService_DenyRoleAccessRequest_FullMethodName    = "/controller.api.services.v1.RoleService/DenyRoleAccessRequest"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -93,6 +98,12 @@ type RoleServiceClient interface {
 	// the Role id which the grants will be added to. An error is returned
 	// if the provided id is malformed or references a non-existing resource.
 	AddRoleGrants(ctx context.Context, in *AddRoleGrantsRequest, opts ...grpc.CallOption) (*AddRoleGrantsResponse, error)
+	// ValidateRoleGrants lints grants without adding them to a Role. Each grant
+	// is parsed for the grant scopes of the Role, and unknown resource IDs,
+	// actions that do not apply to the type of the grant, and grants that
+	// duplicate or overlap with other grants are reported. The response also
+	// includes what the grants would allow that the Role does not allow today.
+	ValidateRoleGrants(ctx context.Context, in *ValidateRoleGrantsRequest, opts ...grpc.CallOption) (*ValidateRoleGrantsResponse, error)
 	// SetRoleGrants sets the Role's grants. Any existing grants on the Role are
 	// deleted if they are not included in this request. The provided request must
 	// include the Role ID on which the grants will be set. If missing, malformed,
@@ -119,6 +130,22 @@ type RoleServiceClient interface {
 	// removed. If missing, malformed, or references a non-existing resource, an
 	// error is returned.
 	RemoveRoleGrantScopes(ctx context.Context, in *RemoveRoleGrantScopesRequest, opts ...grpc.CallOption) (*RemoveRoleGrantScopesResponse, error)
+	// RequestRoleAccess creates a pending request of the calling user to be a
+	// principal of a Role for a bounded duration. The user must not already be
+	// a principal of the Role, and can only have one pending or approved
+	// request for a Role at a time.
+	RequestRoleAccess(ctx context.Context, in *RequestRoleAccessRequest, opts ...grpc.CallOption) (*RequestRoleAccessResponse, error)
+	// ListRoleAccessRequests returns the access requests of a Role, most recent
+	// first.
+	ListRoleAccessRequests(ctx context.Context, in *ListRoleAccessRequestsRequest, opts ...grpc.CallOption) (*ListRoleAccessRequestsResponse, error)
+	// ApproveRoleAccessRequest approves a pending access request of a Role. The
+	// user who requested the access is a principal of the Role until the
+	// duration of the request has passed. Users cannot approve their own
+	// requests.
+	ApproveRoleAccessRequest(ctx context.Context, in *ApproveRoleAccessRequestRequest, opts ...grpc.CallOption) (*ApproveRoleAccessRequestResponse, error)
+	// DenyRoleAccessRequest denies a pending access request of a Role. Users
+	// cannot deny their own requests.
+	DenyRoleAccessRequest(ctx context.Context, in *DenyRoleAccessRequestRequest, opts ...grpc.CallOption) (*DenyRoleAccessRequestResponse, error)
 }
 
 type roleServiceClient struct {
@@ -210,6 +237,15 @@ func (c *roleServiceClient) AddRoleGrants(ctx context.Context, in *AddRoleGrants
 	return out, nil
 }
 
+func (c *roleServiceClient) ValidateRoleGrants(ctx context.Context, in *ValidateRoleGrantsRequest, opts ...grpc.CallOption) (*ValidateRoleGrantsResponse, error) {
+	out := new(ValidateRoleGrantsResponse)
+	err := c.cc.Invoke(ctx, RoleService_ValidateRoleGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleServiceClient) SetRoleGrants(ctx context.Context, in *SetRoleGrantsRequest, opts ...grpc.CallOption) (*SetRoleGrantsResponse, error) {
 	out := new(SetRoleGrantsResponse)
 	err := c.cc.Invoke(ctx, RoleService_SetRoleGrants_FullMethodName, in, out, opts...)
@@ -255,6 +291,42 @@ func (c *roleServiceClient) RemoveRoleGrantScopes(ctx context.Context, in *Remov
 	return out, nil
 }
 
+func (c *roleServiceClient) RequestRoleAccess(ctx context.Context, in *RequestRoleAccessRequest, opts ...grpc.CallOption) (*RequestRoleAccessResponse, error) {
+	out := new(RequestRoleAccessResponse)
+	err := c.cc.Invoke(ctx, RoleService_RequestRoleAccess_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ListRoleAccessRequests(ctx context.Context, in *ListRoleAccessRequestsRequest, opts ...grpc.CallOption) (*ListRoleAccessRequestsResponse, error) {
+	out := new(ListRoleAccessRequestsResponse)
+	err := c.cc.Invoke(ctx, RoleService_ListRoleAccessRequests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) ApproveRoleAccessRequest(ctx context.Context, in *ApproveRoleAccessRequestRequest, opts ...grpc.CallOption) (*ApproveRoleAccessRequestResponse, error) {
+	out := new(ApproveRoleAccessRequestResponse)
+	err := c.cc.Invoke(ctx, RoleService_ApproveRoleAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *roleServiceClient) DenyRoleAccessRequest(ctx context.Context, in *DenyRoleAccessRequestRequest, opts ...grpc.CallOption) (*DenyRoleAccessRequestResponse, error) {
+	out := new(DenyRoleAccessRequestResponse)
+	err := c.cc.Invoke(ctx, RoleService_DenyRoleAccessRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations must embed UnimplementedRoleServiceServer
 // for forward compatibility
@@ -310,6 +382,12 @@ type RoleServiceServer interface {
 	// the Role id which the grants will be added to. An error is returned
 	// if the provided id is malformed or references a non-existing resource.
 	AddRoleGrants(context.Context, *AddRoleGrantsRequest) (*AddRoleGrantsResponse, error)
+	// ValidateRoleGrants lints grants without adding them to a Role. Each grant
+	// is parsed for the grant scopes of the Role, and unknown resource IDs,
+	// actions that do not apply to the type of the grant, and grants that
+	// duplicate or overlap with other grants are reported. The response also
+	// includes what the grants would allow that the Role does not allow today.
+	ValidateRoleGrants(context.Context, *ValidateRoleGrantsRequest) (*ValidateRoleGrantsResponse, error)
 	// SetRoleGrants sets the Role's grants. Any existing grants on the Role are
 	// deleted if they are not included in this request. The provided request must
 	// include the Role ID on which the grants will be set. If missing, malformed,
@@ -336,6 +414,22 @@ type RoleServiceServer interface {
 	// removed. If missing, malformed, or references a non-existing resource, an
 	// error is returned.
 	RemoveRoleGrantScopes(context.Context, *RemoveRoleGrantScopesRequest) (*RemoveRoleGrantScopesResponse, error)
+	// RequestRoleAccess creates a pending request of the calling user to be a
+	// principal of a Role for a bounded duration. The user must not already be
+	// a principal of the Role, and can only have one pending or approved
+	// request for a Role at a time.
+	RequestRoleAccess(context.Context, *RequestRoleAccessRequest) (*RequestRoleAccessResponse, error)
+	// ListRoleAccessRequests returns the access requests of a Role, most recent
+	// first.
+	ListRoleAccessRequests(context.Context, *ListRoleAccessRequestsRequest) (*ListRoleAccessRequestsResponse, error)
+	// ApproveRoleAccessRequest approves a pending access request of a Role. The
+	// user who requested the access is a principal of the Role until the
+	// duration of the request has passed. Users cannot approve their own
+	// requests.
+	ApproveRoleAccessRequest(context.Context, *ApproveRoleAccessRequestRequest) (*ApproveRoleAccessRequestResponse, error)
+	// DenyRoleAccessRequest denies a pending access request of a Role. Users
+	// cannot deny their own requests.
+	DenyRoleAccessRequest(context.Context, *DenyRoleAccessRequestRequest) (*DenyRoleAccessRequestResponse, error)
 	mustEmbedUnimplementedRoleServiceServer()
 }
 
@@ -370,6 +464,9 @@ func (UnimplementedRoleServiceServer) RemoveRolePrincipals(context.Context, *Rem
 func (UnimplementedRoleServiceServer) AddRoleGrants(context.Context, *AddRoleGrantsRequest) (*AddRoleGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRoleGrants not implemented")
 }
+func (UnimplementedRoleServiceServer) ValidateRoleGrants(context.Context, *ValidateRoleGrantsRequest) (*ValidateRoleGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateRoleGrants not implemented")
+}
 func (UnimplementedRoleServiceServer) SetRoleGrants(context.Context, *SetRoleGrantsRequest) (*SetRoleGrantsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetRoleGrants not implemented")
 }
@@ -385,6 +482,18 @@ func (UnimplementedRoleServiceServer) SetRoleGrantScopes(context.Context, *SetRo
 func (UnimplementedRoleServiceServer) RemoveRoleGrantScopes(context.Context, *RemoveRoleGrantScopesRequest) (*RemoveRoleGrantScopesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveRoleGrantScopes not implemented")
 }
+func (UnimplementedRoleServiceServer) RequestRoleAccess(context.Context, *RequestRoleAccessRequest) (*RequestRoleAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRoleAccess not implemented")
+}
+func (UnimplementedRoleServiceServer) ListRoleAccessRequests(context.Context, *ListRoleAccessRequestsRequest) (*ListRoleAccessRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleAccessRequests not implemented")
+}
+func (UnimplementedRoleServiceServer) ApproveRoleAccessRequest(context.Context, *ApproveRoleAccessRequestRequest) (*ApproveRoleAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRoleAccessRequest not implemented")
+}
+func (UnimplementedRoleServiceServer) DenyRoleAccessRequest(context.Context, *DenyRoleAccessRequestRequest) (*DenyRoleAccessRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenyRoleAccessRequest not implemented")
+}
 func (UnimplementedRoleServiceServer) mustEmbedUnimplementedRoleServiceServer() {}
 
 // UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -560,6 +669,24 @@ func _RoleService_AddRoleGrants_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_ValidateRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRoleGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ValidateRoleGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ValidateRoleGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ValidateRoleGrants(ctx, req.(*ValidateRoleGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoleService_SetRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetRoleGrantsRequest)
 	if err := dec(in); err != nil {
@@ -650,6 +777,78 @@ func _RoleService_RemoveRoleGrantScopes_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_RequestRoleAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRoleAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).RequestRoleAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_RequestRoleAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).RequestRoleAccess(ctx, req.(*RequestRoleAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ListRoleAccessRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleAccessRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ListRoleAccessRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ListRoleAccessRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ListRoleAccessRequests(ctx, req.(*ListRoleAccessRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_ApproveRoleAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRoleAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ApproveRoleAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ApproveRoleAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ApproveRoleAccessRequest(ctx, req.(*ApproveRoleAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RoleService_DenyRoleAccessRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyRoleAccessRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).DenyRoleAccessRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_DenyRoleAccessRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).DenyRoleAccessRequest(ctx, req.(*DenyRoleAccessRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -693,6 +892,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddRoleGrants",
 			Handler:    _RoleService_AddRoleGrants_Handler,
 		},
+		{
+			MethodName: "ValidateRoleGrants",
+			Handler:    _RoleService_ValidateRoleGrants_Handler,
+		},
 		{
 			MethodName: "SetRoleGrants",
 			Handler:    _RoleService_SetRoleGrants_Handler,
@@ -713,6 +916,22 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveRoleGrantScopes",
 			Handler:    _RoleService_RemoveRoleGrantScopes_Handler,
 		},
+		{
+			MethodName: "RequestRoleAccess",
+			Handler:    _RoleService_RequestRoleAccess_Handler,
+		},
+		{
+			MethodName: "ListRoleAccessRequests",
+			Handler:    _RoleService_ListRoleAccessRequests_Handler,
+		},
+		{
+			MethodName: "ApproveRoleAccessRequest",
+			Handler:    _RoleService_ApproveRoleAccessRequest_Handler,
+		},
+		{
+			MethodName: "DenyRoleAccessRequest",
+			Handler:    _RoleService_DenyRoleAccessRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/role_service.proto",
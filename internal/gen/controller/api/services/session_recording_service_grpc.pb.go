@@ -23,11 +23,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SessionRecordingService_GetSessionRecording_FullMethodName    = "/controller.api.services.v1.SessionRecordingService/GetSessionRecording"
-	SessionRecordingService_ListSessionRecordings_FullMethodName  = "/controller.api.services.v1.SessionRecordingService/ListSessionRecordings"
-	SessionRecordingService_Download_FullMethodName               = "/controller.api.services.v1.SessionRecordingService/Download"
-	SessionRecordingService_ReApplyStoragePolicy_FullMethodName   = "/controller.api.services.v1.SessionRecordingService/ReApplyStoragePolicy"
-	SessionRecordingService_DeleteSessionRecording_FullMethodName = "/controller.api.services.v1.SessionRecordingService/DeleteSessionRecording"
+	SessionRecordingService_GetSessionRecording_FullMethodName                  = "/controller.api.services.v1.SessionRecordingService/GetSessionRecording"
+	SessionRecordingService_ListSessionRecordings_FullMethodName                = "/controller.api.services.v1.SessionRecordingService/ListSessionRecordings"
+	SessionRecordingService_Download_FullMethodName                             = "/controller.api.services.v1.SessionRecordingService/Download"
+	SessionRecordingService_ReApplyStoragePolicy_FullMethodName                 = "/controller.api.services.v1.SessionRecordingService/ReApplyStoragePolicy"
+	SessionRecordingService_SearchSessionRecordings_FullMethodName              = "/controller.api.services.v1.SessionRecordingService/SearchSessionRecordings"
+	SessionRecordingService_ListSessionRecordingRetentionReports_FullMethodName = "/controller.api.services.v1.SessionRecordingService/ListSessionRecordingRetentionReports"
+	SessionRecordingService_SetSessionRecordingLegalHold_FullMethodName         = "/controller.api.services.v1.SessionRecordingService/SetSessionRecordingLegalHold"
+	SessionRecordingService_RemoveSessionRecordingLegalHold_FullMethodName      = "/controller.api.services.v1.SessionRecordingService/RemoveSessionRecordingLegalHold"
+	SessionRecordingService_DeleteSessionRecording_FullMethodName               = "/controller.api.services.v1.SessionRecordingService/DeleteSessionRecording"
 )
 
 // SessionRecordingServiceClient is the client API for SessionRecordingService service.
@@ -53,6 +57,25 @@ type SessionRecordingServiceClient interface {
 	// must include the Session recording ID for the Session recording to be updated. If that ID
 	// is missing, malformed or reference a non existing resource, an error is returned.
 	ReApplyStoragePolicy(ctx context.Context, in *ReApplyStoragePolicyRequest, opts ...grpc.CallOption) (*ReApplyStoragePolicyResponse, error)
+	// SearchSessionRecordings returns the lines of the indexed Session recordings
+	// that match the provided query. The query supports quoted phrases, "or" and
+	// "-" to exclude a word. Matches are ordered by time descending (most recent
+	// first).
+	SearchSessionRecordings(ctx context.Context, in *SearchSessionRecordingsRequest, opts ...grpc.CallOption) (*SearchSessionRecordingsResponse, error)
+	// ListSessionRecordingRetentionReports returns the reports of the enforcement
+	// of the storage policies on the Session recordings of a scope, most recent
+	// first. A report is written for each scope with Session recordings whose
+	// deletion date has passed every time the storage policies are enforced.
+	ListSessionRecordingRetentionReports(ctx context.Context, in *ListSessionRecordingRetentionReportsRequest, opts ...grpc.CallOption) (*ListSessionRecordingRetentionReportsResponse, error)
+	// SetSessionRecordingLegalHold places a legal hold on a Session recording.
+	// A held Session recording is not deleted and its retention is not changed
+	// when storage policies are enforced or re-applied. The user who placed the
+	// hold is recorded. Placing a hold on a held Session recording is an error.
+	SetSessionRecordingLegalHold(ctx context.Context, in *SetSessionRecordingLegalHoldRequest, opts ...grpc.CallOption) (*SetSessionRecordingLegalHoldResponse, error)
+	// RemoveSessionRecordingLegalHold removes the legal hold of a Session
+	// recording. Removing the hold of a Session recording which is not held is
+	// an error.
+	RemoveSessionRecordingLegalHold(ctx context.Context, in *RemoveSessionRecordingLegalHoldRequest, opts ...grpc.CallOption) (*RemoveSessionRecordingLegalHoldResponse, error)
 	// DeleteSessionRecording removes a Session Recording from Boundary. If the Session Recording id
 	// is malformed or not provided an error is returned.
 	DeleteSessionRecording(ctx context.Context, in *DeleteSessionRecordingRequest, opts ...grpc.CallOption) (*DeleteSessionRecordingResponse, error)
@@ -125,6 +148,42 @@ func (c *sessionRecordingServiceClient) ReApplyStoragePolicy(ctx context.Context
 	return out, nil
 }
 
+func (c *sessionRecordingServiceClient) SearchSessionRecordings(ctx context.Context, in *SearchSessionRecordingsRequest, opts ...grpc.CallOption) (*SearchSessionRecordingsResponse, error) {
+	out := new(SearchSessionRecordingsResponse)
+	err := c.cc.Invoke(ctx, SessionRecordingService_SearchSessionRecordings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecordingServiceClient) ListSessionRecordingRetentionReports(ctx context.Context, in *ListSessionRecordingRetentionReportsRequest, opts ...grpc.CallOption) (*ListSessionRecordingRetentionReportsResponse, error) {
+	out := new(ListSessionRecordingRetentionReportsResponse)
+	err := c.cc.Invoke(ctx, SessionRecordingService_ListSessionRecordingRetentionReports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecordingServiceClient) SetSessionRecordingLegalHold(ctx context.Context, in *SetSessionRecordingLegalHoldRequest, opts ...grpc.CallOption) (*SetSessionRecordingLegalHoldResponse, error) {
+	out := new(SetSessionRecordingLegalHoldResponse)
+	err := c.cc.Invoke(ctx, SessionRecordingService_SetSessionRecordingLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionRecordingServiceClient) RemoveSessionRecordingLegalHold(ctx context.Context, in *RemoveSessionRecordingLegalHoldRequest, opts ...grpc.CallOption) (*RemoveSessionRecordingLegalHoldResponse, error) {
+	out := new(RemoveSessionRecordingLegalHoldResponse)
+	err := c.cc.Invoke(ctx, SessionRecordingService_RemoveSessionRecordingLegalHold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionRecordingServiceClient) DeleteSessionRecording(ctx context.Context, in *DeleteSessionRecordingRequest, opts ...grpc.CallOption) (*DeleteSessionRecordingResponse, error) {
 	out := new(DeleteSessionRecordingResponse)
 	err := c.cc.Invoke(ctx, SessionRecordingService_DeleteSessionRecording_FullMethodName, in, out, opts...)
@@ -157,6 +216,25 @@ type SessionRecordingServiceServer interface {
 	// must include the Session recording ID for the Session recording to be updated. If that ID
 	// is missing, malformed or reference a non existing resource, an error is returned.
 	ReApplyStoragePolicy(context.Context, *ReApplyStoragePolicyRequest) (*ReApplyStoragePolicyResponse, error)
+	// SearchSessionRecordings returns the lines of the indexed Session recordings
+	// that match the provided query. The query supports quoted phrases, "or" and
+	// "-" to exclude a word. Matches are ordered by time descending (most recent
+	// first).
+	SearchSessionRecordings(context.Context, *SearchSessionRecordingsRequest) (*SearchSessionRecordingsResponse, error)
+	// ListSessionRecordingRetentionReports returns the reports of the enforcement
+	// of the storage policies on the Session recordings of a scope, most recent
+	// first. A report is written for each scope with Session recordings whose
+	// deletion date has passed every time the storage policies are enforced.
+	ListSessionRecordingRetentionReports(context.Context, *ListSessionRecordingRetentionReportsRequest) (*ListSessionRecordingRetentionReportsResponse, error)
+	// SetSessionRecordingLegalHold places a legal hold on a Session recording.
+	// A held Session recording is not deleted and its retention is not changed
+	// when storage policies are enforced or re-applied. The user who placed the
+	// hold is recorded. Placing a hold on a held Session recording is an error.
+	SetSessionRecordingLegalHold(context.Context, *SetSessionRecordingLegalHoldRequest) (*SetSessionRecordingLegalHoldResponse, error)
+	// RemoveSessionRecordingLegalHold removes the legal hold of a Session
+	// recording. Removing the hold of a Session recording which is not held is
+	// an error.
+	RemoveSessionRecordingLegalHold(context.Context, *RemoveSessionRecordingLegalHoldRequest) (*RemoveSessionRecordingLegalHoldResponse, error)
 	// DeleteSessionRecording removes a Session Recording from Boundary. If the Session Recording id
 	// is malformed or not provided an error is returned.
 	DeleteSessionRecording(context.Context, *DeleteSessionRecordingRequest) (*DeleteSessionRecordingResponse, error)
@@ -179,6 +257,18 @@ func (UnimplementedSessionRecordingServiceServer) Download(*DownloadRequest, Ses
 func (UnimplementedSessionRecordingServiceServer) ReApplyStoragePolicy(context.Context, *ReApplyStoragePolicyRequest) (*ReApplyStoragePolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReApplyStoragePolicy not implemented")
 }
+func (UnimplementedSessionRecordingServiceServer) SearchSessionRecordings(context.Context, *SearchSessionRecordingsRequest) (*SearchSessionRecordingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSessionRecordings not implemented")
+}
+func (UnimplementedSessionRecordingServiceServer) ListSessionRecordingRetentionReports(context.Context, *ListSessionRecordingRetentionReportsRequest) (*ListSessionRecordingRetentionReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionRecordingRetentionReports not implemented")
+}
+func (UnimplementedSessionRecordingServiceServer) SetSessionRecordingLegalHold(context.Context, *SetSessionRecordingLegalHoldRequest) (*SetSessionRecordingLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSessionRecordingLegalHold not implemented")
+}
+func (UnimplementedSessionRecordingServiceServer) RemoveSessionRecordingLegalHold(context.Context, *RemoveSessionRecordingLegalHoldRequest) (*RemoveSessionRecordingLegalHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSessionRecordingLegalHold not implemented")
+}
 func (UnimplementedSessionRecordingServiceServer) DeleteSessionRecording(context.Context, *DeleteSessionRecordingRequest) (*DeleteSessionRecordingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessionRecording not implemented")
 }
@@ -271,6 +361,78 @@ func _SessionRecordingService_ReApplyStoragePolicy_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionRecordingService_SearchSessionRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSessionRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).SearchSessionRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecordingService_SearchSessionRecordings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).SearchSessionRecordings(ctx, req.(*SearchSessionRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecordingService_ListSessionRecordingRetentionReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionRecordingRetentionReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).ListSessionRecordingRetentionReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecordingService_ListSessionRecordingRetentionReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).ListSessionRecordingRetentionReports(ctx, req.(*ListSessionRecordingRetentionReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecordingService_SetSessionRecordingLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSessionRecordingLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).SetSessionRecordingLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecordingService_SetSessionRecordingLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).SetSessionRecordingLegalHold(ctx, req.(*SetSessionRecordingLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionRecordingService_RemoveSessionRecordingLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSessionRecordingLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionRecordingServiceServer).RemoveSessionRecordingLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionRecordingService_RemoveSessionRecordingLegalHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionRecordingServiceServer).RemoveSessionRecordingLegalHold(ctx, req.(*RemoveSessionRecordingLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionRecordingService_DeleteSessionRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteSessionRecordingRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +470,22 @@ var SessionRecordingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReApplyStoragePolicy",
 			Handler:    _SessionRecordingService_ReApplyStoragePolicy_Handler,
 		},
+		{
+			MethodName: "SearchSessionRecordings",
+			Handler:    _SessionRecordingService_SearchSessionRecordings_Handler,
+		},
+		{
+			MethodName: "ListSessionRecordingRetentionReports",
+			Handler:    _SessionRecordingService_ListSessionRecordingRetentionReports_Handler,
+		},
+		{
+			MethodName: "SetSessionRecordingLegalHold",
+			Handler:    _SessionRecordingService_SetSessionRecordingLegalHold_Handler,
+		},
+		{
+			MethodName: "RemoveSessionRecordingLegalHold",
+			Handler:    _SessionRecordingService_RemoveSessionRecordingLegalHold_Handler,
+		},
 		{
 			MethodName: "DeleteSessionRecording",
 			Handler:    _SessionRecordingService_DeleteSessionRecording_Handler,
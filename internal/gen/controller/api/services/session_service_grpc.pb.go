@@ -22,9 +22,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SessionService_GetSession_FullMethodName    = "/controller.api.services.v1.SessionService/GetSession"
-	SessionService_ListSessions_FullMethodName  = "/controller.api.services.v1.SessionService/ListSessions"
-	SessionService_CancelSession_FullMethodName = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_GetSession_FullMethodName     = "/controller.api.services.v1.SessionService/GetSession"
+	SessionService_ListSessions_FullMethodName   = "/controller.api.services.v1.SessionService/ListSessions"
+	SessionService_CancelSession_FullMethodName  = "/controller.api.services.v1.SessionService/CancelSession"
+	SessionService_ApproveSession_FullMethodName = "/controller.api.services.v1.SessionService/ApproveSession"
 )
 
 // SessionServiceClient is the client API for SessionService service.
@@ -45,6 +46,12 @@ type SessionServiceClient interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(ctx context.Context, in *CancelSessionRequest, opts ...grpc.CallOption) (*CancelSessionResponse, error)
+	// ApproveSession approves a Session which is awaiting approval because its
+	// Target requires it, after which the Session can be connected to. An error
+	// is returned if the Session is not awaiting approval, or if the requester
+	// is the User of the Session or does not match the approvers filter of the
+	// Target.
+	ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error)
 }
 
 type sessionServiceClient struct {
@@ -82,6 +89,15 @@ func (c *sessionServiceClient) CancelSession(ctx context.Context, in *CancelSess
 	return out, nil
 }
 
+func (c *sessionServiceClient) ApproveSession(ctx context.Context, in *ApproveSessionRequest, opts ...grpc.CallOption) (*ApproveSessionResponse, error) {
+	out := new(ApproveSessionResponse)
+	err := c.cc.Invoke(ctx, SessionService_ApproveSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionServiceServer is the server API for SessionService service.
 // All implementations must embed UnimplementedSessionServiceServer
 // for forward compatibility
@@ -100,6 +116,12 @@ type SessionServiceServer interface {
 	// is returned if the request attempts to cancel a Session that does
 	// not exist.
 	CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error)
+	// ApproveSession approves a Session which is awaiting approval because its
+	// Target requires it, after which the Session can be connected to. An error
+	// is returned if the Session is not awaiting approval, or if the requester
+	// is the User of the Session or does not match the approvers filter of the
+	// Target.
+	ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error)
 	mustEmbedUnimplementedSessionServiceServer()
 }
 
@@ -116,6 +138,9 @@ func (UnimplementedSessionServiceServer) ListSessions(context.Context, *ListSess
 func (UnimplementedSessionServiceServer) CancelSession(context.Context, *CancelSessionRequest) (*CancelSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelSession not implemented")
 }
+func (UnimplementedSessionServiceServer) ApproveSession(context.Context, *ApproveSessionRequest) (*ApproveSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveSession not implemented")
+}
 func (UnimplementedSessionServiceServer) mustEmbedUnimplementedSessionServiceServer() {}
 
 // UnsafeSessionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +208,24 @@ func _SessionService_CancelSession_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_ApproveSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ApproveSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SessionService_ApproveSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ApproveSession(ctx, req.(*ApproveSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SessionService_ServiceDesc is the grpc.ServiceDesc for SessionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +245,10 @@ var SessionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelSession",
 			Handler:    _SessionService_CancelSession_Handler,
 		},
+		{
+			MethodName: "ApproveSession",
+			Handler:    _SessionService_ApproveSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/session_service.proto",
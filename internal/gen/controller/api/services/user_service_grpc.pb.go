@@ -31,6 +31,7 @@ const (
 	UserService_SetUserAccounts_FullMethodName       = "/controller.api.services.v1.UserService/SetUserAccounts"
 	UserService_RemoveUserAccounts_FullMethodName    = "/controller.api.services.v1.UserService/RemoveUserAccounts"
 	UserService_ListResolvableAliases_FullMethodName = "/controller.api.services.v1.UserService/ListResolvableAliases"
+	UserService_ExplainPermission_FullMethodName     = "/controller.api.services.v1.UserService/ExplainPermission"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -89,6 +90,11 @@ type UserServiceClient interface {
 	// for which the provided user id has some permission.
 	// If missing or malformed an error is returned.
 	ListResolvableAliases(ctx context.Context, in *ListResolvableAliasesRequest, opts ...grpc.CallOption) (*ListResolvableAliasesResponse, error)
+	// ExplainPermission explains whether the provided User is allowed to perform
+	// an action on a resource, returning every Role, principal and grant of the
+	// User that was considered. If the User or the resource are missing or
+	// malformed an error is returned.
+	ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*ExplainPermissionResponse, error)
 }
 
 type userServiceClient struct {
@@ -180,6 +186,15 @@ func (c *userServiceClient) ListResolvableAliases(ctx context.Context, in *ListR
 	return out, nil
 }
 
+func (c *userServiceClient) ExplainPermission(ctx context.Context, in *ExplainPermissionRequest, opts ...grpc.CallOption) (*ExplainPermissionResponse, error) {
+	out := new(ExplainPermissionResponse)
+	err := c.cc.Invoke(ctx, UserService_ExplainPermission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -236,6 +251,11 @@ type UserServiceServer interface {
 	// for which the provided user id has some permission.
 	// If missing or malformed an error is returned.
 	ListResolvableAliases(context.Context, *ListResolvableAliasesRequest) (*ListResolvableAliasesResponse, error)
+	// ExplainPermission explains whether the provided User is allowed to perform
+	// an action on a resource, returning every Role, principal and grant of the
+	// User that was considered. If the User or the resource are missing or
+	// malformed an error is returned.
+	ExplainPermission(context.Context, *ExplainPermissionRequest) (*ExplainPermissionResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -270,6 +290,9 @@ func (UnimplementedUserServiceServer) RemoveUserAccounts(context.Context, *Remov
 func (UnimplementedUserServiceServer) ListResolvableAliases(context.Context, *ListResolvableAliasesRequest) (*ListResolvableAliasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResolvableAliases not implemented")
 }
+func (UnimplementedUserServiceServer) ExplainPermission(context.Context, *ExplainPermissionRequest) (*ExplainPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainPermission not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -445,6 +468,24 @@ func _UserService_ListResolvableAliases_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ExplainPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExplainPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExplainPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExplainPermission(ctx, req.(*ExplainPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -488,6 +529,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListResolvableAliases",
 			Handler:    _UserService_ListResolvableAliases_Handler,
 		},
+		{
+			MethodName: "ExplainPermission",
+			Handler:    _UserService_ExplainPermission_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "controller/api/services/v1/user_service.proto",
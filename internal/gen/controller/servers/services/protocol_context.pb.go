@@ -0,0 +1,867 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: controller/servers/services/v1/protocol_context.proto
+
+package services
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SshProtocolContext contains the information a worker needs to terminate
+// the SSH protocol for a connection to an ssh target.
+type SshProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The credentials the worker injects when authenticating to the endpoint.
+	// They are attempted in order.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty"`
+	// Set when the session is recorded. The worker writes the recording for
+	// the connection into the session recording identified here.
+	SessionRecording *SessionRecording `protobuf:"bytes,20,opt,name=session_recording,json=sessionRecording,proto3" json:"session_recording,omitempty"`
+	// The endpoint of the session. Its host is used to look up the host key of
+	// the endpoint in known_hosts.
+	Endpoint string `protobuf:"bytes,30,opt,name=endpoint,proto3" json:"endpoint,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The known_hosts entries used to verify the host key of the endpoint.
+	KnownHosts string `protobuf:"bytes,40,opt,name=known_hosts,json=knownHosts,proto3" json:"known_hosts,omitempty" class:"public"` // @gotags: `class:"public"`
+	// If true, the host key of the endpoint is not verified. Unless it is set,
+	// the worker refuses to connect to the endpoint when known_hosts is empty.
+	InsecureSkipHostKeyVerification bool `protobuf:"varint,50,opt,name=insecure_skip_host_key_verification,json=insecureSkipHostKeyVerification,proto3" json:"insecure_skip_host_key_verification,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *SshProtocolContext) Reset() {
+	*x = SshProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshProtocolContext) ProtoMessage() {}
+
+func (x *SshProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshProtocolContext.ProtoReflect.Descriptor instead.
+func (*SshProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SshProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *SshProtocolContext) GetSessionRecording() *SessionRecording {
+	if x != nil {
+		return x.SessionRecording
+	}
+	return nil
+}
+
+func (x *SshProtocolContext) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SshProtocolContext) GetKnownHosts() string {
+	if x != nil {
+		return x.KnownHosts
+	}
+	return ""
+}
+
+func (x *SshProtocolContext) GetInsecureSkipHostKeyVerification() bool {
+	if x != nil {
+		return x.InsecureSkipHostKeyVerification
+	}
+	return false
+}
+
+// PostgresProtocolContext contains the information a worker needs to terminate
+// the PostgreSQL wire protocol for a connection to a postgres target.
+type PostgresProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The username password credentials the worker uses to authenticate to the
+	// database. The first one is used.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty"`
+	// The id of the session, included in the audit events of each query.
+	SessionId string `protobuf:"bytes,20,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the user that authorized the session, included in the audit
+	// events of each query.
+	UserId string `protobuf:"bytes,30,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the target, included in the audit events of each query.
+	TargetId string `protobuf:"bytes,40,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The endpoint of the session. Its host is used to verify the certificate
+	// of the endpoint unless a server name is provided.
+	Endpoint string `protobuf:"bytes,50,opt,name=endpoint,proto3" json:"endpoint,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The settings used to verify the certificate of the endpoint. The worker
+	// always connects to the endpoint using TLS.
+	Tls *EndpointTls `protobuf:"bytes,60,opt,name=tls,proto3" json:"tls,omitempty"`
+}
+
+func (x *PostgresProtocolContext) Reset() {
+	*x = PostgresProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostgresProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostgresProtocolContext) ProtoMessage() {}
+
+func (x *PostgresProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostgresProtocolContext.ProtoReflect.Descriptor instead.
+func (*PostgresProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostgresProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *PostgresProtocolContext) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PostgresProtocolContext) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PostgresProtocolContext) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *PostgresProtocolContext) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *PostgresProtocolContext) GetTls() *EndpointTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+// EndpointTls contains the settings a worker uses to verify the TLS
+// certificate of an endpoint.
+type EndpointTls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The PEM encoded certificate authorities used to verify the certificate of
+	// the endpoint. If empty, the system certificate authorities are used.
+	CaCert string `protobuf:"bytes,10,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The name used to verify the certificate of the endpoint. If empty, the
+	// host of the endpoint is used.
+	ServerName string `protobuf:"bytes,20,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty" class:"public"` // @gotags: `class:"public"`
+	// If true, only the certificate chain of the endpoint is verified, not the
+	// name in the certificate.
+	SkipServerNameVerification bool `protobuf:"varint,30,opt,name=skip_server_name_verification,json=skipServerNameVerification,proto3" json:"skip_server_name_verification,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *EndpointTls) Reset() {
+	*x = EndpointTls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EndpointTls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EndpointTls) ProtoMessage() {}
+
+func (x *EndpointTls) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EndpointTls.ProtoReflect.Descriptor instead.
+func (*EndpointTls) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EndpointTls) GetCaCert() string {
+	if x != nil {
+		return x.CaCert
+	}
+	return ""
+}
+
+func (x *EndpointTls) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *EndpointTls) GetSkipServerNameVerification() bool {
+	if x != nil {
+		return x.SkipServerNameVerification
+	}
+	return false
+}
+
+// HttpProtocolContext contains the information a worker needs to proxy the
+// requests of a connection to an http target.
+type HttpProtocolContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The credentials the worker uses to authenticate the requests sent to the
+	// endpoint. The first one is used.
+	InjectedCredentials []*Credential `protobuf:"bytes,10,rep,name=injected_credentials,json=injectedCredentials,proto3" json:"injected_credentials,omitempty"`
+	// The endpoint of the session, used as the host of the requests sent to the
+	// endpoint.
+	Endpoint string `protobuf:"bytes,20,opt,name=endpoint,proto3" json:"endpoint,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The settings used to verify the certificate of the endpoint. If unset,
+	// requests are sent to the endpoint using plain HTTP.
+	Tls *EndpointTls `protobuf:"bytes,30,opt,name=tls,proto3" json:"tls,omitempty"`
+	// If true, the worker injects the credentials in requests sent to the
+	// endpoint using plain HTTP. Otherwise it refuses to proxy them.
+	AllowPlaintextCredentials bool `protobuf:"varint,40,opt,name=allow_plaintext_credentials,json=allowPlaintextCredentials,proto3" json:"allow_plaintext_credentials,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *HttpProtocolContext) Reset() {
+	*x = HttpProtocolContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpProtocolContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpProtocolContext) ProtoMessage() {}
+
+func (x *HttpProtocolContext) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpProtocolContext.ProtoReflect.Descriptor instead.
+func (*HttpProtocolContext) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *HttpProtocolContext) GetInjectedCredentials() []*Credential {
+	if x != nil {
+		return x.InjectedCredentials
+	}
+	return nil
+}
+
+func (x *HttpProtocolContext) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *HttpProtocolContext) GetTls() *EndpointTls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *HttpProtocolContext) GetAllowPlaintextCredentials() bool {
+	if x != nil {
+		return x.AllowPlaintextCredentials
+	}
+	return false
+}
+
+// SessionRecording contains the information a worker needs to write a BSR
+// for a session.
+type SessionRecording struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the session recording.
+	SessionRecordingId string `protobuf:"bytes,10,opt,name=session_recording_id,json=sessionRecordingId,proto3" json:"session_recording_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the session being recorded.
+	SessionId string `protobuf:"bytes,20,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The endpoint of the session.
+	Endpoint string `protobuf:"bytes,30,opt,name=endpoint,proto3" json:"endpoint,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the user that authorized the session.
+	UserId string `protobuf:"bytes,40,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the scope of the user.
+	UserScopeId string `protobuf:"bytes,50,opt,name=user_scope_id,json=userScopeId,proto3" json:"user_scope_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the target of the session.
+	TargetId string `protobuf:"bytes,60,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The name of the target of the session.
+	TargetName string `protobuf:"bytes,70,opt,name=target_name,json=targetName,proto3" json:"target_name,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the project of the target.
+	TargetProjectId string `protobuf:"bytes,80,opt,name=target_project_id,json=targetProjectId,proto3" json:"target_project_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The default port of the target.
+	TargetDefaultPort uint32 `protobuf:"varint,90,opt,name=target_default_port,json=targetDefaultPort,proto3" json:"target_default_port,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the storage bucket the recording is stored in.
+	StorageBucketId string `protobuf:"bytes,100,opt,name=storage_bucket_id,json=storageBucketId,proto3" json:"storage_bucket_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The credentials used for the session.
+	Credentials []*SessionRecordingCredential `protobuf:"bytes,110,rep,name=credentials,proto3" json:"credentials,omitempty"`
+}
+
+func (x *SessionRecording) Reset() {
+	*x = SessionRecording{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRecording) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRecording) ProtoMessage() {}
+
+func (x *SessionRecording) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRecording.ProtoReflect.Descriptor instead.
+func (*SessionRecording) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SessionRecording) GetSessionRecordingId() string {
+	if x != nil {
+		return x.SessionRecordingId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetUserScopeId() string {
+	if x != nil {
+		return x.UserScopeId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetTargetName() string {
+	if x != nil {
+		return x.TargetName
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetTargetProjectId() string {
+	if x != nil {
+		return x.TargetProjectId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetTargetDefaultPort() uint32 {
+	if x != nil {
+		return x.TargetDefaultPort
+	}
+	return 0
+}
+
+func (x *SessionRecording) GetStorageBucketId() string {
+	if x != nil {
+		return x.StorageBucketId
+	}
+	return ""
+}
+
+func (x *SessionRecording) GetCredentials() []*SessionRecordingCredential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+// SessionRecordingCredential describes a credential used for a recorded
+// session. It never contains the secret data of the credential.
+type SessionRecordingCredential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The id of the credential or credential library.
+	Id string `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The id of the credential store of the credential.
+	CredentialStoreId string `protobuf:"bytes,20,opt,name=credential_store_id,json=credentialStoreId,proto3" json:"credential_store_id,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The type of the credential source, e.g. "static" or "vault-generic".
+	SourceType string `protobuf:"bytes,30,opt,name=source_type,json=sourceType,proto3" json:"source_type,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The type of the credential, e.g. "username_password".
+	CredentialType string `protobuf:"bytes,40,opt,name=credential_type,json=credentialType,proto3" json:"credential_type,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The purpose of the credential, e.g. "injected_application".
+	Purpose string `protobuf:"bytes,50,opt,name=purpose,proto3" json:"purpose,omitempty" class:"public"` // @gotags: `class:"public"`
+	// The username of the credential, if it has one.
+	Username string `protobuf:"bytes,60,opt,name=username,proto3" json:"username,omitempty" class:"public"` // @gotags: `class:"public"`
+}
+
+func (x *SessionRecordingCredential) Reset() {
+	*x = SessionRecordingCredential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRecordingCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRecordingCredential) ProtoMessage() {}
+
+func (x *SessionRecordingCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_controller_servers_services_v1_protocol_context_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRecordingCredential.ProtoReflect.Descriptor instead.
+func (*SessionRecordingCredential) Descriptor() ([]byte, []int) {
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SessionRecordingCredential) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SessionRecordingCredential) GetCredentialStoreId() string {
+	if x != nil {
+		return x.CredentialStoreId
+	}
+	return ""
+}
+
+func (x *SessionRecordingCredential) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *SessionRecordingCredential) GetCredentialType() string {
+	if x != nil {
+		return x.CredentialType
+	}
+	return ""
+}
+
+func (x *SessionRecordingCredential) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *SessionRecordingCredential) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+var File_controller_servers_services_v1_protocol_context_proto protoreflect.FileDescriptor
+
+var file_controller_servers_services_v1_protocol_context_proto_rawDesc = []byte{
+	0x0a, 0x35, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdd, 0x02, 0x0a, 0x12, 0x53, 0x73, 0x68,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x69, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x5d,
+	0x0a, 0x11, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x23, 0x69, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x32, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1f, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x53, 0x6b, 0x69, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x65, 0x72, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa8, 0x02, 0x0a, 0x17, 0x50, 0x6f, 0x73,
+	0x74, 0x67, 0x72, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13,
+	0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x6c, 0x73, 0x52, 0x03,
+	0x74, 0x6c, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x0b, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x54, 0x6c, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a,
+	0x1d, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1a, 0x73, 0x6b, 0x69, 0x70, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x8f, 0x02, 0x0a, 0x13, 0x48, 0x74, 0x74, 0x70, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x5d, 0x0a, 0x14, 0x69, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x52, 0x13, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x54, 0x6c, 0x73, 0x52, 0x03, 0x74,
+	0x6c, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x6c, 0x61, 0x69,
+	0x6e, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x6c,
+	0x61, 0x69, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61,
+	0x6c, 0x73, 0x22, 0xe0, 0x03, 0x0a, 0x10, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a,
+	0x0d, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x49,
+	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x3c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x46, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x5c, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x42, 0x51, 0x5a, 0x4f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x61, 0x73, 0x68, 0x69, 0x63, 0x6f, 0x72, 0x70, 0x2f, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x3b, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_controller_servers_services_v1_protocol_context_proto_rawDescOnce sync.Once
+	file_controller_servers_services_v1_protocol_context_proto_rawDescData = file_controller_servers_services_v1_protocol_context_proto_rawDesc
+)
+
+func file_controller_servers_services_v1_protocol_context_proto_rawDescGZIP() []byte {
+	file_controller_servers_services_v1_protocol_context_proto_rawDescOnce.Do(func() {
+		file_controller_servers_services_v1_protocol_context_proto_rawDescData = protoimpl.X.CompressGZIP(file_controller_servers_services_v1_protocol_context_proto_rawDescData)
+	})
+	return file_controller_servers_services_v1_protocol_context_proto_rawDescData
+}
+
+var file_controller_servers_services_v1_protocol_context_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_controller_servers_services_v1_protocol_context_proto_goTypes = []any{
+	(*SshProtocolContext)(nil),         // 0: controller.servers.services.v1.SshProtocolContext
+	(*PostgresProtocolContext)(nil),    // 1: controller.servers.services.v1.PostgresProtocolContext
+	(*EndpointTls)(nil),                // 2: controller.servers.services.v1.EndpointTls
+	(*HttpProtocolContext)(nil),        // 3: controller.servers.services.v1.HttpProtocolContext
+	(*SessionRecording)(nil),           // 4: controller.servers.services.v1.SessionRecording
+	(*SessionRecordingCredential)(nil), // 5: controller.servers.services.v1.SessionRecordingCredential
+	(*Credential)(nil),                 // 6: controller.servers.services.v1.Credential
+}
+var file_controller_servers_services_v1_protocol_context_proto_depIdxs = []int32{
+	6, // 0: controller.servers.services.v1.SshProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	4, // 1: controller.servers.services.v1.SshProtocolContext.session_recording:type_name -> controller.servers.services.v1.SessionRecording
+	6, // 2: controller.servers.services.v1.PostgresProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	2, // 3: controller.servers.services.v1.PostgresProtocolContext.tls:type_name -> controller.servers.services.v1.EndpointTls
+	6, // 4: controller.servers.services.v1.HttpProtocolContext.injected_credentials:type_name -> controller.servers.services.v1.Credential
+	2, // 5: controller.servers.services.v1.HttpProtocolContext.tls:type_name -> controller.servers.services.v1.EndpointTls
+	5, // 6: controller.servers.services.v1.SessionRecording.credentials:type_name -> controller.servers.services.v1.SessionRecordingCredential
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_controller_servers_services_v1_protocol_context_proto_init() }
+func file_controller_servers_services_v1_protocol_context_proto_init() {
+	if File_controller_servers_services_v1_protocol_context_proto != nil {
+		return
+	}
+	file_controller_servers_services_v1_credential_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*SshProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*PostgresProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*EndpointTls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*HttpProtocolContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionRecording); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_controller_servers_services_v1_protocol_context_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionRecordingCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_controller_servers_services_v1_protocol_context_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_controller_servers_services_v1_protocol_context_proto_goTypes,
+		DependencyIndexes: file_controller_servers_services_v1_protocol_context_proto_depIdxs,
+		MessageInfos:      file_controller_servers_services_v1_protocol_context_proto_msgTypes,
+	}.Build()
+	File_controller_servers_services_v1_protocol_context_proto = out.File
+	file_controller_servers_services_v1_protocol_context_proto_rawDesc = nil
+	file_controller_servers_services_v1_protocol_context_proto_goTypes = nil
+	file_controller_servers_services_v1_protocol_context_proto_depIdxs = nil
+}
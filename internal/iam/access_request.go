@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam/store"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	accessRequestDefaultTable = "iam_access_request"
+
+	// MaxAccessRequestDuration is the longest duration a user can request to
+	// be a principal of a role for.
+	MaxAccessRequestDuration = 7 * 24 * time.Hour
+)
+
+// AccessRequestStatus is the status of an access request.
+type AccessRequestStatus string
+
+const (
+	// AccessRequestPending is the status of a request which has not been
+	// decided yet.
+	AccessRequestPending AccessRequestStatus = "pending"
+	// AccessRequestApproved is the status of a request which was approved. The
+	// user is a principal of the role until the request expires.
+	AccessRequestApproved AccessRequestStatus = "approved"
+	// AccessRequestDenied is the status of a request which was denied.
+	AccessRequestDenied AccessRequestStatus = "denied"
+	// AccessRequestExpired is the status of an approved request whose duration
+	// has passed. The user was removed from the role.
+	AccessRequestExpired AccessRequestStatus = "expired"
+)
+
+// AccessRequest is the request of a user to be a principal of a role for a
+// bounded duration. The user is added to the role when the request is
+// approved and removed from it when the duration has passed.
+type AccessRequest struct {
+	*store.AccessRequest
+	tableName string `gorm:"-"`
+}
+
+// ensure that AccessRequest implements the interfaces of: Cloneable and
+// db.VetForWriter
+var (
+	_ Cloneable       = (*AccessRequest)(nil)
+	_ db.VetForWriter = (*AccessRequest)(nil)
+)
+
+// NewAccessRequest creates a new in memory request of a user to be a principal
+// of a role for the provided duration. The duration is truncated to seconds
+// and must be between one second and MaxAccessRequestDuration. No options are
+// currently supported.
+func NewAccessRequest(ctx context.Context, roleId, userId, justification string, duration time.Duration, _ ...Option) (*AccessRequest, error) {
+	const op = "iam.NewAccessRequest"
+	switch {
+	case roleId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+	case userId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	case justification == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing justification")
+	case duration < time.Second:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "duration must be at least one second")
+	case duration > MaxAccessRequestDuration:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "duration must not be longer than 7 days")
+	}
+	return &AccessRequest{
+		AccessRequest: &store.AccessRequest{
+			RoleId:          roleId,
+			UserId:          userId,
+			Justification:   justification,
+			DurationSeconds: uint32(duration / time.Second),
+		},
+	}, nil
+}
+
+func allocAccessRequest() AccessRequest {
+	return AccessRequest{
+		AccessRequest: &store.AccessRequest{},
+	}
+}
+
+// Clone creates a clone of the AccessRequest.
+func (r *AccessRequest) Clone() any {
+	cp := proto.Clone(r.AccessRequest)
+	return &AccessRequest{
+		AccessRequest: cp.(*store.AccessRequest),
+	}
+}
+
+// Duration returns how long the user is a principal of the role once the
+// request is approved.
+func (r *AccessRequest) Duration() time.Duration {
+	return time.Duration(r.GetDurationSeconds()) * time.Second
+}
+
+// VetForWrite implements db.VetForWrite() interface for access requests.
+func (r *AccessRequest) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "iam.(AccessRequest).VetForWrite"
+	if r.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if opType == db.CreateOp {
+		if r.RoleId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+		}
+		if r.UserId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name for
+// access requests.
+func (r *AccessRequest) TableName() string {
+	if r.tableName != "" {
+		return r.tableName
+	}
+	return accessRequestDefaultTable
+}
+
+// SetTableName sets the table name for the resource.  If the caller attempts to
+// set the name to "" the name will be reset to the default name.
+func (r *AccessRequest) SetTableName(n string) {
+	switch n {
+	case "":
+		r.tableName = accessRequestDefaultTable
+	default:
+		r.tableName = n
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccessRequest(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		roleId        string
+		userId        string
+		justification string
+		duration      time.Duration
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *AccessRequest
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			args: args{roleId: "r_1234567890", userId: "u_1234567890", justification: "incident", duration: time.Hour},
+			want: &AccessRequest{AccessRequest: &store.AccessRequest{
+				RoleId:          "r_1234567890",
+				UserId:          "u_1234567890",
+				Justification:   "incident",
+				DurationSeconds: 3600,
+			}},
+		},
+		{
+			name: "truncated-duration",
+			args: args{roleId: "r_1234567890", userId: "u_1234567890", justification: "incident", duration: 1500 * time.Millisecond},
+			want: &AccessRequest{AccessRequest: &store.AccessRequest{
+				RoleId:          "r_1234567890",
+				UserId:          "u_1234567890",
+				Justification:   "incident",
+				DurationSeconds: 1,
+			}},
+		},
+		{
+			name: "max-duration",
+			args: args{roleId: "r_1234567890", userId: "u_1234567890", justification: "incident", duration: MaxAccessRequestDuration},
+			want: &AccessRequest{AccessRequest: &store.AccessRequest{
+				RoleId:          "r_1234567890",
+				UserId:          "u_1234567890",
+				Justification:   "incident",
+				DurationSeconds: 604800,
+			}},
+		},
+		{
+			name:    "missing-role-id",
+			args:    args{userId: "u_1234567890", justification: "incident", duration: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "missing-user-id",
+			args:    args{roleId: "r_1234567890", justification: "incident", duration: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "missing-justification",
+			args:    args{roleId: "r_1234567890", userId: "u_1234567890", duration: time.Hour},
+			wantErr: true,
+		},
+		{
+			name:    "duration-too-short",
+			args:    args{roleId: "r_1234567890", userId: "u_1234567890", justification: "incident", duration: time.Millisecond},
+			wantErr: true,
+		},
+		{
+			name:    "duration-too-long",
+			args:    args{roleId: "r_1234567890", userId: "u_1234567890", justification: "incident", duration: MaxAccessRequestDuration + time.Second},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := NewAccessRequest(context.Background(), tt.args.roleId, tt.args.userId, tt.args.justification, tt.args.duration)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+			assert.Equal(time.Duration(tt.want.DurationSeconds)*time.Second, got.Duration())
+		})
+	}
+}
@@ -16,6 +16,8 @@ import (
 const (
 	// RoleGrantPrefix is the prefix for role grants
 	RoleGrantPrefix = "rg"
+	// AccessRequestPrefix is the prefix for role access requests
+	AccessRequestPrefix = "ar"
 )
 
 func newRoleId(ctx context.Context) (string, error) {
@@ -42,6 +44,14 @@ func newGroupId(ctx context.Context) (string, error) {
 	return id, nil
 }
 
+func newAccessRequestId(ctx context.Context) (string, error) {
+	id, err := db.NewPublicId(ctx, AccessRequestPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, "iam.newAccessRequestId")
+	}
+	return id, nil
+}
+
 func newScopeId(ctx context.Context, scopeType scope.Type) (string, error) {
 	const op = "iam.newScopeId"
 	if scopeType == scope.Unknown {
@@ -32,6 +32,11 @@ func Test_PublicIds(t *testing.T) {
 		require.NoError(t, err)
 		assert.True(t, strings.HasPrefix(id, globals.GroupPrefix+"_"))
 	})
+	t.Run("access request", func(t *testing.T) {
+		id, err := newAccessRequestId(ctx)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(id, AccessRequestPrefix+"_"))
+	})
 	t.Run("oidc managed group", func(t *testing.T) {
 		assert.True(t, strings.HasPrefix("mgoidc_1234567890", globals.OidcManagedGroupPrefix+"_"))
 	})
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+const (
+	expireAccessRequestsJobName = "expire_access_requests"
+
+	// expireAccessRequestsBatchSize is the maximum number of access requests
+	// expired by a run of the job.
+	expireAccessRequestsBatchSize = 500
+
+	// expireAccessRequestsInterval is the interval between runs of the job,
+	// which bounds how long a user stays a principal of a role after its
+	// access request expired. The user is not granted the role in the
+	// meantime since GrantsForUser ignores expired access requests.
+	expireAccessRequestsInterval = time.Minute
+
+	// expireAccessRequestOperation is the operation of the audit events
+	// written when an access request expires.
+	expireAccessRequestOperation = "expire-access-request"
+)
+
+type expireAccessRequestsJob struct {
+	repo *iam.Repository
+
+	mu        sync.Mutex
+	total     int
+	completed int
+}
+
+// newExpireAccessRequestsJob creates a job which removes the users of approved
+// access requests whose duration has passed from their roles.
+func newExpireAccessRequestsJob(ctx context.Context, r db.Reader, w db.Writer, kmsRepo *kms.Kms) (*expireAccessRequestsJob, error) {
+	const op = "iamjob.newExpireAccessRequestsJob"
+	repo, err := iam.NewRepository(ctx, r, w, kmsRepo)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &expireAccessRequestsJob{
+		repo: repo,
+	}, nil
+}
+
+// Status reports the job’s current status.
+func (j *expireAccessRequestsJob) Status() scheduler.JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return scheduler.JobStatus{
+		Completed: j.completed,
+		Total:     j.total,
+	}
+}
+
+// Run expires a batch of access requests whose duration has passed and writes
+// an audit event for each of them. An access request which cannot be expired
+// is retried on the next run.
+// The context is used to notify the job that it should exit early.
+func (j *expireAccessRequestsJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "iamjob.(expireAccessRequestsJob).Run"
+	j.setStatus(0, 0)
+
+	requests, err := j.repo.ListExpiredAccessRequests(ctx, iam.WithLimit(expireAccessRequestsBatchSize))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.setStatus(0, len(requests))
+
+	for i, ar := range requests {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		expired, err := j.repo.ExpireAccessRequest(ctx, ar.GetPublicId())
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to expire access request", "access_request_id", ar.GetPublicId()))
+			continue
+		}
+		if err := event.WriteAudit(ctx, op, event.WithRequest(&event.Request{
+			Operation: expireAccessRequestOperation,
+			Details:   expired.AccessRequest,
+		})); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to write audit event", "access_request_id", ar.GetPublicId()))
+		}
+		j.setStatus(i+1, len(requests))
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+// The job runs again right away if the last run expired a full batch of access
+// requests, since more are likely waiting to be expired.
+func (j *expireAccessRequestsJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.total == expireAccessRequestsBatchSize {
+		return 0, nil
+	}
+	return expireAccessRequestsInterval, nil
+}
+
+// Name is the unique name of the job.
+func (j *expireAccessRequestsJob) Name() string { return expireAccessRequestsJobName }
+
+// Description is the human-readable description of the job.
+func (j *expireAccessRequestsJob) Description() string {
+	return "Removes the users of approved role access requests whose duration has passed from their roles"
+}
+
+func (j *expireAccessRequestsJob) setStatus(completed, total int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.completed = completed
+	j.total = total
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+)
+
+// RegisterJobs registers iam related jobs with the provided scheduler.
+func RegisterJobs(ctx context.Context, s *scheduler.Scheduler, r db.Reader, w db.Writer, kmsRepo *kms.Kms) error {
+	const op = "iamjob.RegisterJobs"
+	switch {
+	case s == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing scheduler")
+	case r == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing db.Reader")
+	case w == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing db.Writer")
+	case kmsRepo == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+
+	expireJob, err := newExpireAccessRequestsJob(ctx, r, w, kmsRepo)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := s.RegisterJob(ctx, expireJob); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RegisterJobs(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	s := scheduler.TestScheduler(t, conn, wrapper)
+
+	require.Error(t, RegisterJobs(ctx, nil, rw, rw, kmsCache))
+	require.Error(t, RegisterJobs(ctx, s, nil, rw, kmsCache))
+	require.Error(t, RegisterJobs(ctx, s, rw, nil, kmsCache))
+	require.Error(t, RegisterJobs(ctx, s, rw, rw, nil))
+	require.NoError(t, RegisterJobs(ctx, s, rw, rw, kmsCache))
+}
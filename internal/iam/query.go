@@ -150,10 +150,22 @@ const (
         from iam_group_role
        where principal_id in (select id from user_groups)
     ),
+    -- A user added to a role by an access request is no longer granted the
+    -- role once the request expired, even if the job removing the user from
+    -- the role has not run yet.
     user_roles (role_id) as (
       select role_id
         from iam_user_role
        where principal_id in (select id from users)
+         and not exists (
+               select 1
+                 from iam_access_request
+                where iam_access_request.role_id = iam_user_role.role_id
+                  and iam_access_request.user_id = iam_user_role.principal_id
+                  and iam_access_request.status = 'approved'
+                  and iam_access_request.principal_added
+                  and iam_access_request.expiration_time <= current_timestamp
+             )
     ),
     user_group_roles (role_id) as (
       select role_id
@@ -206,6 +218,112 @@ const (
         on grants.role_id = roles.role_id;
     `
 
+	// lookupResourceScopeQuery returns the scope, the parent of the scope and
+	// the pin of a resource given its ID. The scope of a scope is its parent,
+	// except for the global scope.
+	lookupResourceScopeQuery = `
+    with
+    resource (scope_id, pin) as (
+      select coalesce(parent_id, public_id), null
+        from iam_scope
+       where public_id = @id
+      union all
+      select scope_id, null
+        from iam_user
+       where public_id = @id
+      union all
+      select scope_id, null
+        from iam_group
+       where public_id = @id
+      union all
+      select scope_id, null
+        from iam_role
+       where public_id = @id
+      union all
+      select scope_id, null
+        from auth_method
+       where public_id = @id
+      union all
+      select scope_id, auth_method_id
+        from auth_account
+       where public_id = @id
+      union all
+      select auth_method.scope_id, auth_managed_group.auth_method_id
+        from auth_managed_group
+        join auth_method
+          on auth_method.public_id = auth_managed_group.auth_method_id
+       where auth_managed_group.public_id = @id
+      union all
+      select auth_account.scope_id, null
+        from auth_token
+        join auth_account
+          on auth_account.public_id = auth_token.auth_account_id
+       where auth_token.public_id = @id
+      union all
+      select scope_id, null
+        from alias
+       where public_id = @id
+      union all
+      select project_id, null
+        from target
+       where public_id = @id
+      union all
+      select project_id, null
+        from host_catalog
+       where public_id = @id
+      union all
+      select host_catalog.project_id, host_set.catalog_id
+        from host_set
+        join host_catalog
+          on host_catalog.public_id = host_set.catalog_id
+       where host_set.public_id = @id
+      union all
+      select host_catalog.project_id, host.catalog_id
+        from host
+        join host_catalog
+          on host_catalog.public_id = host.catalog_id
+       where host.public_id = @id
+      union all
+      select project_id, null
+        from credential_store
+       where public_id = @id
+      union all
+      select credential_store.project_id, credential_library.store_id
+        from credential_library
+        join credential_store
+          on credential_store.public_id = credential_library.store_id
+       where credential_library.public_id = @id
+      union all
+      select credential_store.project_id, credential_static.store_id
+        from credential_static
+        join credential_store
+          on credential_store.public_id = credential_static.store_id
+       where credential_static.public_id = @id
+      union all
+      select project_id, null
+        from session
+       where public_id = @id
+      union all
+      select scope_id, null
+        from storage_plugin_storage_bucket
+       where public_id = @id
+      union all
+      select scope_id, null
+        from server_worker
+       where public_id = @id
+      union all
+      select scope_id, null
+        from policy
+       where public_id = @id
+    )
+    select resource.scope_id as scope_id,
+           coalesce(iam_scope.parent_id, '') as parent_scope_id,
+           coalesce(resource.pin, '') as pin
+      from resource
+      join iam_scope
+        on iam_scope.public_id = resource.scope_id;
+    `
+
 	estimateCountRoles = `
 		select reltuples::bigint as estimate from pg_class where oid in ('iam_role'::regclass)
 	`
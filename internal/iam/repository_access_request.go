@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// CreateAccessRequest creates a pending request of a user to be a principal of
+// a role in the repository and returns the request. A user cannot request
+// access to a role it is already a principal of, and can only have one
+// pending or approved request for a role at a time. No options are currently
+// supported.
+func (r *Repository) CreateAccessRequest(ctx context.Context, req *AccessRequest, _ ...Option) (*AccessRequest, error) {
+	const op = "iam.(Repository).CreateAccessRequest"
+	switch {
+	case req == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request")
+	case req.AccessRequest == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing access request store")
+	case req.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case req.Status != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "status not empty")
+	}
+	id, err := newAccessRequestId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	c := req.Clone().(*AccessRequest)
+	c.PublicId = id
+
+	scope, oplogWrapper, err := r.accessRequestScope(ctx, c.RoleId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	metadata := newAccessRequestMetadata(c, scope, oplog.OpType_OP_TYPE_CREATE)
+
+	var newRequest *AccessRequest
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			member, err := isUserRole(ctx, reader, c.RoleId, c.UserId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if member {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("user %s is already a principal of role %s", c.UserId, c.RoleId))
+			}
+			newRequest = c.Clone().(*AccessRequest)
+			if err := w.Create(ctx, newRequest, db.WithOplog(oplogWrapper, metadata)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("user %s already has an open access request for role %s", c.UserId, c.RoleId))
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newRequest, nil
+}
+
+// LookupAccessRequest will look up an access request in the repository. If
+// the request is not found, it will return nil, nil. No options are currently
+// supported.
+func (r *Repository) LookupAccessRequest(ctx context.Context, withPublicId string, _ ...Option) (*AccessRequest, error) {
+	const op = "iam.(Repository).LookupAccessRequest"
+	if withPublicId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	req := allocAccessRequest()
+	req.PublicId = withPublicId
+	if err := r.reader.LookupByPublicId(ctx, &req); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", withPublicId)))
+	}
+	return &req, nil
+}
+
+// ListAccessRequests returns the access requests of a role, most recent
+// first. Supports the WithLimit option.
+func (r *Repository) ListAccessRequests(ctx context.Context, withRoleId string, opt ...Option) ([]*AccessRequest, error) {
+	const op = "iam.(Repository).ListAccessRequests"
+	if withRoleId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var requests []*AccessRequest
+	if err := r.reader.SearchWhere(ctx, &requests, "role_id = ?", []any{withRoleId}, db.WithLimit(limit), db.WithOrder("create_time desc, public_id")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return requests, nil
+}
+
+// ListExpiredAccessRequests returns the approved access requests whose
+// duration has passed, the oldest first. Supports the WithLimit option.
+func (r *Repository) ListExpiredAccessRequests(ctx context.Context, opt ...Option) ([]*AccessRequest, error) {
+	const op = "iam.(Repository).ListExpiredAccessRequests"
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	}
+	var requests []*AccessRequest
+	if err := r.reader.SearchWhere(ctx, &requests, "status = ? and expiration_time <= current_timestamp", []any{string(AccessRequestApproved)}, db.WithLimit(limit), db.WithOrder("expiration_time")); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return requests, nil
+}
+
+// ApproveAccessRequest approves a pending access request on behalf of the
+// approver and adds the user who requested it to the role until the request
+// expires, unless the user is already a principal of the role. The comment is
+// optional. Users cannot approve their own requests.
+// No options are currently supported.
+func (r *Repository) ApproveAccessRequest(ctx context.Context, requestId, approverId, comment string, _ ...Option) (*AccessRequest, error) {
+	const op = "iam.(Repository).ApproveAccessRequest"
+	ar, err := r.decideAccessRequest(ctx, requestId, approverId, comment, AccessRequestApproved)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ar, nil
+}
+
+// DenyAccessRequest denies a pending access request on behalf of the approver.
+// The comment is optional. Users cannot deny their own requests. No options
+// are currently supported.
+func (r *Repository) DenyAccessRequest(ctx context.Context, requestId, approverId, comment string, _ ...Option) (*AccessRequest, error) {
+	const op = "iam.(Repository).DenyAccessRequest"
+	ar, err := r.decideAccessRequest(ctx, requestId, approverId, comment, AccessRequestDenied)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ar, nil
+}
+
+// ExpireAccessRequest marks an approved access request as expired and removes
+// the user who requested it from the role if the user was added to the role by
+// the request. A user who was already a principal of the role when the request
+// was approved, or who was removed from the role while the request was
+// approved, is left as is. No options are currently supported.
+func (r *Repository) ExpireAccessRequest(ctx context.Context, requestId string, _ ...Option) (*AccessRequest, error) {
+	const op = "iam.(Repository).ExpireAccessRequest"
+	ar, err := r.transitionAccessRequest(ctx, requestId, AccessRequestApproved, func(ar *AccessRequest) ([]string, []string, error) {
+		ar.Status = string(AccessRequestExpired)
+		return []string{"Status"}, nil, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ar, nil
+}
+
+// decideAccessRequest transitions a pending access request to the approved or
+// denied status on behalf of the approver.
+func (r *Repository) decideAccessRequest(ctx context.Context, requestId, approverId, comment string, status AccessRequestStatus) (*AccessRequest, error) {
+	const op = "iam.(Repository).decideAccessRequest"
+	if approverId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+	return r.transitionAccessRequest(ctx, requestId, AccessRequestPending, func(ar *AccessRequest) ([]string, []string, error) {
+		if ar.UserId == approverId {
+			return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "users cannot decide their own access requests")
+		}
+		ar.Status = string(status)
+		ar.ApproverId = approverId
+		ar.DecisionComment = comment
+		var nullFields []string
+		fields := []string{"Status", "ApproverId"}
+		switch comment {
+		case "":
+			nullFields = append(nullFields, "DecisionComment")
+		default:
+			fields = append(fields, "DecisionComment")
+		}
+		return fields, nullFields, nil
+	})
+}
+
+// transitionAccessRequest updates an access request which has the from status
+// using the fields set by the update function. When the request is approved
+// the user is added to the role if it is not already a principal of it, and
+// when it expires the user is removed from the role if it was added by the
+// request. The update of the request and the change of the principals of the
+// role are written in the same oplog entry.
+func (r *Repository) transitionAccessRequest(ctx context.Context,
+	requestId string,
+	from AccessRequestStatus,
+	update func(*AccessRequest) (fieldMask []string, nullFields []string, err error),
+) (*AccessRequest, error) {
+	const op = "iam.(Repository).transitionAccessRequest"
+	if requestId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	current, err := r.LookupAccessRequest(ctx, requestId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("access request %s not found", requestId))
+	}
+	scope, oplogWrapper, err := r.accessRequestScope(ctx, current.RoleId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var updatedRequest *AccessRequest
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			ar := allocAccessRequest()
+			ar.PublicId = requestId
+			if err := reader.LookupByPublicId(ctx, &ar); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if AccessRequestStatus(ar.Status) != from {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("access request %s is %s", requestId, ar.Status))
+			}
+			fieldMask, nullFields, err := update(&ar)
+			if err != nil {
+				return err
+			}
+			var addUser, removeUser bool
+			switch AccessRequestStatus(ar.Status) {
+			case AccessRequestApproved:
+				// the user may have been added to the role directly since
+				// the request was created
+				member, err := isUserRole(ctx, reader, ar.RoleId, ar.UserId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+				addUser = !member
+				ar.PrincipalAdded = addUser
+				fieldMask = append(fieldMask, "PrincipalAdded")
+			case AccessRequestExpired:
+				// principal_added is cleared by the database when the user is
+				// removed from the role while the request is approved
+				removeUser = ar.PrincipalAdded
+			}
+			metadata := newAccessRequestMetadata(&ar, scope, oplog.OpType_OP_TYPE_UPDATE)
+			version := ar.Version
+
+			if !addUser && !removeUser {
+				rowsUpdated, err := w.Update(ctx, &ar, fieldMask, nullFields, db.WithOplog(oplogWrapper, metadata), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update access request"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated access request and %d rows updated", rowsUpdated))
+				}
+			} else {
+				userRole, err := NewUserRole(ctx, ar.RoleId, ar.UserId)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory user role"))
+				}
+				role := allocRole()
+				role.PublicId = ar.RoleId
+				if err := reader.LookupByPublicId(ctx, &role); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up role"))
+				}
+				roleTicket, err := w.GetTicket(ctx, &role)
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket for role"))
+				}
+				msgs := make([]*oplog.Message, 0, 3)
+
+				var requestOplogMsg oplog.Message
+				rowsUpdated, err := w.Update(ctx, &ar, fieldMask, nullFields, db.NewOplogMsg(&requestOplogMsg), db.WithVersion(&version))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update access request"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated access request and %d rows updated", rowsUpdated))
+				}
+				msgs = append(msgs, &requestOplogMsg)
+
+				// the version of the role is updated when its principals
+				// change, as it is by the principal role operations
+				roleVersion := role.Version
+				updatedRole := allocRole()
+				updatedRole.PublicId = role.PublicId
+				updatedRole.Version = roleVersion + 1
+				var roleOplogMsg oplog.Message
+				rowsUpdated, err = w.Update(ctx, &updatedRole, []string{"Version"}, nil, db.NewOplogMsg(&roleOplogMsg), db.WithVersion(&roleVersion))
+				if err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update role version"))
+				}
+				if rowsUpdated != 1 {
+					return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated role and %d rows updated", rowsUpdated))
+				}
+				msgs = append(msgs, &roleOplogMsg)
+
+				userOplogMsgs := make([]*oplog.Message, 0, 1)
+				switch {
+				case addUser:
+					metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_CREATE.String())
+					if err := w.CreateItems(ctx, []*UserRole{userRole}, db.NewOplogMsgs(&userOplogMsgs)); err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to add user role"))
+					}
+				case removeUser:
+					metadata["op-type"] = append(metadata["op-type"], oplog.OpType_OP_TYPE_DELETE.String())
+					rowsDeleted, err := w.DeleteItems(ctx, []*UserRole{userRole}, db.NewOplogMsgs(&userOplogMsgs))
+					if err != nil {
+						return errors.Wrap(ctx, err, op, errors.WithMsg("unable to delete user role"))
+					}
+					if rowsDeleted != 1 {
+						return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("deleted user role and %d rows deleted", rowsDeleted))
+					}
+				}
+				msgs = append(msgs, userOplogMsgs...)
+
+				if err := w.WriteOplogEntryWith(ctx, oplogWrapper, roleTicket, metadata, msgs); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+				}
+			}
+
+			updated := allocAccessRequest()
+			updated.PublicId = requestId
+			if err := reader.LookupByPublicId(ctx, &updated); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to read access request after update"))
+			}
+			updatedRequest = &updated
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(requestId))
+	}
+	return updatedRequest, nil
+}
+
+// accessRequestScope returns the scope of a role and the oplog wrapper of the
+// scope.
+func (r *Repository) accessRequestScope(ctx context.Context, roleId string) (*Scope, wrapping.Wrapper, error) {
+	const op = "iam.(Repository).accessRequestScope"
+	if roleId == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing role id")
+	}
+	role := allocRole()
+	role.PublicId = roleId
+	scope, err := role.GetScope(ctx, r.reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get role %s scope", roleId)))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scope.GetPublicId(), kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	return scope, oplogWrapper, nil
+}
+
+// isUserRole returns true if the user is a principal of the role.
+func isUserRole(ctx context.Context, reader db.Reader, roleId, userId string) (bool, error) {
+	const op = "iam.isUserRole"
+	userRole := allocUserRole()
+	if err := reader.LookupWhere(ctx, &userRole, "role_id = ? and principal_id = ?", []any{roleId, userId}); err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrap(ctx, err, op)
+	}
+	return true, nil
+}
+
+func newAccessRequestMetadata(ar *AccessRequest, scope *Scope, op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{ar.GetPublicId()},
+		"resource-type":      []string{"role access request"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{scope.GetPublicId()},
+		"scope-type":         []string{scope.GetType()},
+		"role-id":            []string{ar.GetRoleId()},
+		"user-id":            []string{ar.GetUserId()},
+	}
+}
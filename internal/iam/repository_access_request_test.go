@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AccessRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+	role := TestRole(t, conn, org.PublicId)
+	requester := TestUser(t, repo, org.PublicId)
+	approver := TestUser(t, repo, org.PublicId)
+
+	isPrincipal := func(t *testing.T, userId string) bool {
+		t.Helper()
+		prs, err := repo.ListPrincipalRoles(ctx, role.PublicId)
+		require.NoError(t, err)
+		for _, pr := range prs {
+			if pr.PrincipalId == userId {
+				return true
+			}
+		}
+		return false
+	}
+	newRequest := func(t *testing.T, userId string) *AccessRequest {
+		t.Helper()
+		ar, err := NewAccessRequest(ctx, role.PublicId, userId, "incident 1234", time.Hour)
+		require.NoError(t, err)
+		return ar
+	}
+
+	t.Run("approve-and-expire", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar, err := repo.CreateAccessRequest(ctx, newRequest(t, requester.PublicId))
+		require.NoError(err)
+		assert.Equal(string(AccessRequestPending), ar.Status)
+		require.NoError(db.TestVerifyOplog(t, rw, ar.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+		_, err = repo.CreateAccessRequest(ctx, newRequest(t, requester.PublicId))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.NotUnique), err))
+
+		_, err = repo.ApproveAccessRequest(ctx, ar.PublicId, requester.PublicId, "")
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		approved, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, "approved for the incident")
+		require.NoError(err)
+		assert.Equal(string(AccessRequestApproved), approved.Status)
+		assert.Equal(approver.PublicId, approved.ApproverId)
+		assert.Equal("approved for the incident", approved.DecisionComment)
+		require.NotNil(approved.DecisionTime)
+		require.NotNil(approved.ExpirationTime)
+		assert.Equal(time.Hour, approved.ExpirationTime.AsTime().Sub(approved.DecisionTime.AsTime()))
+		assert.True(approved.PrincipalAdded)
+		assert.True(isPrincipal(t, requester.PublicId))
+		require.NoError(db.TestVerifyOplog(t, rw, ar.PublicId, db.WithOperation(oplog.OpType_OP_TYPE_UPDATE), db.WithCreateNotBefore(10*time.Second)))
+
+		_, err = repo.DenyAccessRequest(ctx, ar.PublicId, approver.PublicId, "")
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+
+		expired, err := repo.ExpireAccessRequest(ctx, ar.PublicId)
+		require.NoError(err)
+		assert.Equal(string(AccessRequestExpired), expired.Status)
+		assert.NotNil(expired.RevokeTime)
+		assert.False(isPrincipal(t, requester.PublicId))
+
+		_, err = repo.ExpireAccessRequest(ctx, ar.PublicId)
+		require.Error(err)
+	})
+
+	t.Run("deny", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		ar, err := repo.CreateAccessRequest(ctx, newRequest(t, requester.PublicId))
+		require.NoError(err)
+
+		denied, err := repo.DenyAccessRequest(ctx, ar.PublicId, approver.PublicId, "")
+		require.NoError(err)
+		assert.Equal(string(AccessRequestDenied), denied.Status)
+		assert.NotNil(denied.DecisionTime)
+		assert.Nil(denied.ExpirationTime)
+		assert.False(isPrincipal(t, requester.PublicId))
+
+		_, err = repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, "")
+		require.Error(err)
+	})
+
+	t.Run("already-a-principal", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		TestUserRole(t, conn, role.PublicId, approver.PublicId)
+		_, err := repo.CreateAccessRequest(ctx, newRequest(t, approver.PublicId))
+		require.Error(err)
+		assert.True(errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListAccessRequests(ctx, role.PublicId)
+		require.NoError(err)
+		require.Len(got, 2)
+		assert.Equal(string(AccessRequestDenied), got[0].Status)
+		assert.Equal(string(AccessRequestExpired), got[1].Status)
+
+		got, err = repo.ListAccessRequests(ctx, role.PublicId, WithLimit(1))
+		require.NoError(err)
+		assert.Len(got, 1)
+
+		expired, err := repo.ListExpiredAccessRequests(ctx)
+		require.NoError(err)
+		assert.Empty(expired)
+	})
+
+	t.Run("approve-already-a-principal", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, org.PublicId)
+		ar, err := NewAccessRequest(ctx, role.PublicId, requester.PublicId, "incident 1234", time.Hour)
+		require.NoError(err)
+		ar, err = repo.CreateAccessRequest(ctx, ar)
+		require.NoError(err)
+
+		// the user is added to the role directly while the request is pending
+		TestUserRole(t, conn, role.PublicId, requester.PublicId)
+		approved, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, "")
+		require.NoError(err)
+		assert.Equal(string(AccessRequestApproved), approved.Status)
+		assert.False(approved.PrincipalAdded)
+
+		expired, err := repo.ExpireAccessRequest(ctx, ar.PublicId)
+		require.NoError(err)
+		assert.Equal(string(AccessRequestExpired), expired.Status)
+		ok, err := isUserRole(ctx, rw, role.PublicId, requester.PublicId)
+		require.NoError(err)
+		assert.True(ok)
+	})
+
+	t.Run("removed-while-approved", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, org.PublicId)
+		ar, err := NewAccessRequest(ctx, role.PublicId, requester.PublicId, "incident 1234", time.Hour)
+		require.NoError(err)
+		ar, err = repo.CreateAccessRequest(ctx, ar)
+		require.NoError(err)
+		approved, err := repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, "")
+		require.NoError(err)
+		assert.True(approved.PrincipalAdded)
+
+		// the user is removed from the role and added to it again directly
+		// while the request is approved
+		r, _, _, _, err := repo.LookupRole(ctx, role.PublicId)
+		require.NoError(err)
+		_, err = repo.DeletePrincipalRoles(ctx, role.PublicId, r.Version, []string{requester.PublicId})
+		require.NoError(err)
+		found, err := repo.LookupAccessRequest(ctx, ar.PublicId)
+		require.NoError(err)
+		assert.False(found.PrincipalAdded)
+		TestUserRole(t, conn, role.PublicId, requester.PublicId)
+
+		_, err = repo.ExpireAccessRequest(ctx, ar.PublicId)
+		require.NoError(err)
+		ok, err := isUserRole(ctx, rw, role.PublicId, requester.PublicId)
+		require.NoError(err)
+		assert.True(ok)
+	})
+
+	t.Run("expired-before-job-runs", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		role := TestRole(t, conn, org.PublicId)
+		TestRoleGrant(t, conn, role.PublicId, "ids=*;type=*;actions=read")
+		grantsRole := func(t *testing.T) bool {
+			t.Helper()
+			tuples, err := repo.GrantsForUser(ctx, requester.PublicId)
+			require.NoError(err)
+			for _, tuple := range tuples {
+				if tuple.RoleId == role.PublicId {
+					return true
+				}
+			}
+			return false
+		}
+		ar, err := NewAccessRequest(ctx, role.PublicId, requester.PublicId, "incident 1234", time.Second)
+		require.NoError(err)
+		ar, err = repo.CreateAccessRequest(ctx, ar)
+		require.NoError(err)
+		_, err = repo.ApproveAccessRequest(ctx, ar.PublicId, approver.PublicId, "")
+		require.NoError(err)
+		assert.True(grantsRole(t))
+
+		// the user is still a principal of the role until the request is
+		// expired by the job, but it is no longer granted the role
+		time.Sleep(1500 * time.Millisecond)
+		ok, err := isUserRole(ctx, rw, role.PublicId, requester.PublicId)
+		require.NoError(err)
+		assert.True(ok)
+		assert.False(grantsRole(t))
+
+		_, err = repo.ExpireAccessRequest(ctx, ar.PublicId)
+		require.NoError(err)
+		assert.False(grantsRole(t))
+	})
+
+	t.Run("not-found", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.LookupAccessRequest(ctx, "ar_1234567890")
+		require.NoError(err)
+		assert.Nil(got)
+
+		_, err = repo.ApproveAccessRequest(ctx, "ar_1234567890", approver.PublicId, "")
+		require.Error(err)
+		assert.True(errors.IsNotFoundError(err))
+	})
+}
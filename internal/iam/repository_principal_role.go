@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
 
@@ -464,6 +465,37 @@ func (r *Repository) ListPrincipalRoles(ctx context.Context, roleId string, opt
 	return principals, nil
 }
 
+// ListPrincipalRolesForUser returns the principal roles through which the
+// user is granted roles: the roles of the user itself, of the groups the user
+// is a member of and of the managed groups the accounts of the user are
+// members of. Like GrantsForUser, this includes the roles of the anonymous
+// user and, unless the user is the anonymous user, the roles of authenticated
+// users.
+func (r *Repository) ListPrincipalRolesForUser(ctx context.Context, userId string, _ ...Option) ([]*PrincipalRole, error) {
+	const op = "iam.(Repository).ListPrincipalRolesForUser"
+	if userId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	userIds := []string{globals.AnonymousUserId}
+	if userId != globals.AnonymousUserId {
+		userIds = append(userIds, globals.AnyAuthenticatedUserId, userId)
+	}
+	const where = `principal_id in @user_ids
+      or principal_id in (select group_id
+                            from iam_group_member_user
+                           where member_id in @user_ids)
+      or principal_id in (select managed_group_id
+                            from auth_managed_group_member_account
+                           where member_id in (select public_id
+                                                 from auth_account
+                                                where iam_user_id in @user_ids))`
+	var principalRoles []*PrincipalRole
+	if err := r.reader.SearchWhere(ctx, &principalRoles, where, []any{sql.Named("user_ids", userIds)}); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", userId)))
+	}
+	return principalRoles, nil
+}
+
 type PrincipalSet struct {
 	AddUserRoles            []*UserRole
 	AddGroupRoles           []*GroupRole
@@ -293,6 +293,58 @@ func TestRepository_ListPrincipalRoles(t *testing.T) {
 	}
 }
 
+func TestRepository_ListPrincipalRolesForUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, _ := TestScopes(t, repo)
+	user := TestUser(t, repo, org.GetPublicId())
+	otherUser := TestUser(t, repo, org.GetPublicId())
+
+	userRole := TestRole(t, conn, org.GetPublicId())
+	TestUserRole(t, conn, userRole.GetPublicId(), user.GetPublicId())
+	group := TestGroup(t, conn, org.GetPublicId())
+	TestGroupMember(t, conn, group.GetPublicId(), user.GetPublicId())
+	groupRole := TestRole(t, conn, org.GetPublicId())
+	TestGroupRole(t, conn, groupRole.GetPublicId(), group.GetPublicId())
+	authRole := TestRole(t, conn, org.GetPublicId())
+	TestUserRole(t, conn, authRole.GetPublicId(), globals.AnyAuthenticatedUserId)
+	otherRole := TestRole(t, conn, org.GetPublicId())
+	TestUserRole(t, conn, otherRole.GetPublicId(), otherUser.GetPublicId())
+
+	t.Run("missing-user-id", func(t *testing.T) {
+		_, err := repo.ListPrincipalRolesForUser(ctx, "")
+		require.Error(t, err)
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("user", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListPrincipalRolesForUser(ctx, user.GetPublicId())
+		require.NoError(err)
+		principals := map[string]string{}
+		for _, pr := range got {
+			if pr.GetRoleScopeId() == org.GetPublicId() {
+				principals[pr.GetRoleId()] = pr.GetPrincipalId()
+			}
+		}
+		assert.Equal(map[string]string{
+			userRole.GetPublicId():  user.GetPublicId(),
+			groupRole.GetPublicId(): group.GetPublicId(),
+			authRole.GetPublicId():  globals.AnyAuthenticatedUserId,
+		}, principals)
+	})
+	t.Run("anonymous", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := repo.ListPrincipalRolesForUser(ctx, globals.AnonymousUserId)
+		require.NoError(err)
+		for _, pr := range got {
+			assert.Equal(globals.AnonymousUserId, pr.GetPrincipalId())
+		}
+	})
+}
+
 func TestRepository_DeletePrincipalRoles(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
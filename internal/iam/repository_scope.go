@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/perms"
 	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/hashicorp/go-dbw"
@@ -330,7 +331,7 @@ func (r *Repository) CreateScope(ctx context.Context, s *Scope, userId string, o
 						}
 						grants = append(grants, roleGrant)
 
-						roleGrant, err = NewRoleGrant(ctx, defaultRolePublicId, "ids={{.Account.Id}};actions=read,change-password")
+						roleGrant, err = NewRoleGrant(ctx, defaultRolePublicId, "ids={{.Account.Id}};actions=read,change-password,enroll-totp,remove-totp")
 						if err != nil {
 							return errors.Wrap(ctx, err, op, errors.WithMsg("unable to create in memory role grant"))
 						}
@@ -478,6 +479,44 @@ func (r *Repository) LookupScope(ctx context.Context, withPublicId string, _ ...
 	return &scope, nil
 }
 
+// LookupResourceScope returns the resource with the provided ID along with the
+// scope it is in, the parent of that scope and its pin, if any, so that
+// permissions on it can be evaluated. If the resource is not found, it will
+// return nil, nil.
+func (r *Repository) LookupResourceScope(ctx context.Context, resourceId string, _ ...Option) (*perms.Resource, error) {
+	const op = "iam.(Repository).LookupResourceScope"
+	if resourceId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing resource id")
+	}
+	rows, err := r.reader.Query(ctx, lookupResourceScopeQuery, []any{sql.Named("id", resourceId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", resourceId)))
+	}
+	defer rows.Close()
+	var res *perms.Resource
+	for rows.Next() {
+		var result struct {
+			ScopeId       string
+			ParentScopeId string
+			Pin           string
+		}
+		if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		res = &perms.Resource{
+			Id:            resourceId,
+			Type:          globals.ResourceInfoFromPrefix(resourceId).Type,
+			ScopeId:       result.ScopeId,
+			ParentScopeId: result.ParentScopeId,
+			Pin:           result.Pin,
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return res, nil
+}
+
 // DeleteScope will delete a scope from the repository
 func (r *Repository) DeleteScope(ctx context.Context, withPublicId string, _ ...Option) (int, error) {
 	const op = "iam.(Repository).DeleteScope"
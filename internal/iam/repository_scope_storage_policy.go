@@ -5,10 +5,14 @@ package iam
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/types/scope"
 )
 
 // setScopeStoragePolicyId fetches the storage policy associated with the given
@@ -26,3 +30,149 @@ func setScopeStoragePolicyId(ctx context.Context, r db.Reader, scope *Scope) err
 	scope.StoragePolicyId = policy.GetStoragePolicyId()
 	return nil
 }
+
+// AttachScopeStoragePolicy attaches the storage policy with the provided id to
+// the scope with the provided id and returns the updated scope. The scope must
+// not already have a storage policy attached. The storage policy must belong
+// to the global scope or to the scope it is attached to. The version of the
+// scope must match scopeVersion and is incremented.
+func (r *Repository) AttachScopeStoragePolicy(ctx context.Context, scopeId, storagePolicyId string, scopeVersion uint32, _ ...Option) (*Scope, error) {
+	const op = "iam.(Repository).AttachScopeStoragePolicy"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case storagePolicyId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing storage policy id")
+	case scopeVersion == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+
+	rows, err := r.reader.Query(ctx, "select scope_id from policy_storage_policy where public_id = @public_id",
+		[]any{sql.Named("public_id", storagePolicyId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	var policyScopeId string
+	for rows.Next() {
+		if err := rows.Scan(&policyScopeId); err != nil {
+			_ = rows.Close()
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	switch policyScopeId {
+	case "":
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("storage policy %s not found", storagePolicyId))
+	case scope.Global.String(), scopeId:
+	default:
+		return nil, errors.New(ctx, errors.InvalidParameter, op,
+			fmt.Sprintf("storage policy %s of scope %s cannot be attached to scope %s", storagePolicyId, policyScopeId, scopeId))
+	}
+
+	attached := AllocScopePolicyStoragePolicy()
+	attached.ScopeId = scopeId
+	attached.StoragePolicyId = storagePolicyId
+	if err := r.updateScopeStoragePolicy(ctx, scopeId, scopeVersion, &attached, op); err != nil {
+		return nil, err
+	}
+	return r.LookupScope(ctx, scopeId)
+}
+
+// DetachScopeStoragePolicy detaches the storage policy attached to the scope
+// with the provided id and returns the updated scope. The version of the scope
+// must match scopeVersion and is incremented.
+func (r *Repository) DetachScopeStoragePolicy(ctx context.Context, scopeId string, scopeVersion uint32, _ ...Option) (*Scope, error) {
+	const op = "iam.(Repository).DetachScopeStoragePolicy"
+	switch {
+	case scopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	case scopeVersion == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing version")
+	}
+	if err := r.updateScopeStoragePolicy(ctx, scopeId, scopeVersion, nil, op); err != nil {
+		return nil, err
+	}
+	return r.LookupScope(ctx, scopeId)
+}
+
+// updateScopeStoragePolicy increments the version of the scope and replaces
+// its storage policy association with attach. If attach is nil, the current
+// association is deleted and it is an error if there is none. If attach is
+// not nil, it is an error if there already is an association.
+func (r *Repository) updateScopeStoragePolicy(ctx context.Context, scopeId string, scopeVersion uint32, attach *ScopePolicyStoragePolicy, op errors.Op) error {
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			current := AllocScope()
+			current.PublicId = scopeId
+			if err := setScopeStoragePolicyId(ctx, reader, &current); err != nil && !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op)
+			}
+			switch {
+			case attach != nil && current.StoragePolicyId != "":
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("scope %s already has storage policy %s attached", scopeId, current.StoragePolicyId))
+			case attach == nil && current.StoragePolicyId == "":
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("scope %s has no storage policy attached", scopeId))
+			}
+
+			scopeTicket, err := w.GetTicket(ctx, &current)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+			msgs := make([]*oplog.Message, 0, 2)
+			updatedScope := AllocScope()
+			updatedScope.PublicId = scopeId
+			updatedScope.Version = scopeVersion + 1
+			var scopeOplogMsg oplog.Message
+			rowsUpdated, err := w.Update(ctx, &updatedScope, []string{"Version"}, nil, db.NewOplogMsg(&scopeOplogMsg), db.WithVersion(&scopeVersion))
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to update scope version"))
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("scope %s doesn't exist or incorrect version provided", scopeId))
+			}
+			msgs = append(msgs, &scopeOplogMsg)
+
+			opType := oplog.OpType_OP_TYPE_CREATE
+			var policyOplogMsg oplog.Message
+			if attach != nil {
+				if err := w.Create(ctx, attach, db.NewOplogMsg(&policyOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to attach storage policy"))
+				}
+			} else {
+				opType = oplog.OpType_OP_TYPE_DELETE
+				detach := AllocScopePolicyStoragePolicy()
+				detach.ScopeId = scopeId
+				detach.StoragePolicyId = current.StoragePolicyId
+				if _, err := w.Delete(ctx, &detach, db.NewOplogMsg(&policyOplogMsg)); err != nil {
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to detach storage policy"))
+				}
+			}
+			msgs = append(msgs, &policyOplogMsg)
+
+			metadata := oplog.Metadata{
+				"op-type":            []string{opType.String()},
+				"scope-id":           []string{scopeId},
+				"resource-public-id": []string{scopeId},
+				"resource-type":      []string{"scope-policy-storage-policy"},
+			}
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, scopeTicket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
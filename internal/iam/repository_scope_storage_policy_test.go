@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package iam_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_AttachScopeStoragePolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, repo)
+	otherOrg, _ := iam.TestScopes(t, repo)
+
+	orgPolicy := storage.TestPolicy(t, conn, org.GetPublicId())
+	otherOrgPolicy := storage.TestPolicy(t, conn, otherOrg.GetPublicId())
+	globalPolicy := storage.TestPolicy(t, conn, "global")
+
+	t.Run("other-org-policy", func(t *testing.T) {
+		_, err := repo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), otherOrgPolicy.GetPublicId(), org.GetVersion())
+		assert.True(t, errors.Match(errors.T(errors.InvalidParameter), err))
+	})
+	t.Run("missing-policy", func(t *testing.T) {
+		_, err := repo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), "pst_1234567890", org.GetVersion())
+		assert.True(t, errors.Match(errors.T(errors.RecordNotFound), err))
+	})
+	t.Run("wrong-version", func(t *testing.T) {
+		_, err := repo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), orgPolicy.GetPublicId(), org.GetVersion()+1)
+		assert.True(t, errors.Match(errors.T(errors.VersionMismatch), err))
+	})
+
+	assert, require := assert.New(t), require.New(t)
+	scp, err := repo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), orgPolicy.GetPublicId(), org.GetVersion())
+	require.NoError(err)
+	assert.Equal(orgPolicy.GetPublicId(), scp.GetStoragePolicyId())
+	assert.Equal(org.GetVersion()+1, scp.GetVersion())
+
+	_, err = repo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), globalPolicy.GetPublicId(), scp.GetVersion())
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err), "attaching to a scope with a storage policy must fail")
+
+	scp, err = repo.DetachScopeStoragePolicy(ctx, org.GetPublicId(), scp.GetVersion())
+	require.NoError(err)
+	assert.Empty(scp.GetStoragePolicyId())
+	assert.Equal(org.GetVersion()+2, scp.GetVersion())
+
+	_, err = repo.DetachScopeStoragePolicy(ctx, org.GetPublicId(), scp.GetVersion())
+	assert.True(errors.Match(errors.T(errors.InvalidParameter), err), "detaching from a scope without a storage policy must fail")
+
+	// A global storage policy can be attached to any scope.
+	scp, err = repo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), globalPolicy.GetPublicId(), scp.GetVersion())
+	require.NoError(err)
+	assert.Equal(globalPolicy.GetPublicId(), scp.GetStoragePolicyId())
+}
@@ -13,6 +13,8 @@ import (
 	"github.com/hashicorp/boundary/internal/db/timestamp"
 	iam_store "github.com/hashicorp/boundary/internal/iam/store"
 	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/perms"
+	"github.com/hashicorp/boundary/internal/types/resource"
 	"github.com/hashicorp/boundary/internal/types/scope"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -232,6 +234,65 @@ func Test_Repository_Scope_Lookup(t *testing.T) {
 	})
 }
 
+func TestRepository_LookupResourceScope(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	repo := TestRepo(t, conn, wrapper)
+	org, proj := TestScopes(t, repo)
+	user := TestUser(t, repo, org.GetPublicId())
+	role := TestRole(t, conn, proj.GetPublicId())
+
+	tests := []struct {
+		name       string
+		resourceId string
+		want       *perms.Resource
+		wantErr    bool
+	}{
+		{
+			name:       "global",
+			resourceId: scope.Global.String(),
+			want:       &perms.Resource{Id: scope.Global.String(), Type: resource.Scope, ScopeId: scope.Global.String()},
+		},
+		{
+			name:       "project",
+			resourceId: proj.GetPublicId(),
+			want:       &perms.Resource{Id: proj.GetPublicId(), Type: resource.Scope, ScopeId: org.GetPublicId(), ParentScopeId: scope.Global.String()},
+		},
+		{
+			name:       "user",
+			resourceId: user.GetPublicId(),
+			want:       &perms.Resource{Id: user.GetPublicId(), Type: resource.User, ScopeId: org.GetPublicId(), ParentScopeId: scope.Global.String()},
+		},
+		{
+			name:       "role",
+			resourceId: role.GetPublicId(),
+			want:       &perms.Resource{Id: role.GetPublicId(), Type: resource.Role, ScopeId: proj.GetPublicId(), ParentScopeId: org.GetPublicId()},
+		},
+		{
+			name:       "not-found",
+			resourceId: "u_doesntexist",
+		},
+		{
+			name:    "missing-id",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.LookupResourceScope(ctx, tt.resourceId)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.want, got)
+		})
+	}
+}
+
 func Test_Repository_Scope_Delete(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -4,6 +4,7 @@
 package perms
 
 import (
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/boundary/globals"
@@ -36,6 +37,12 @@ type AclGrant struct {
 
 	// The set of output fields granted
 	OutputFields *OutputFields
+
+	// The conditions on the requests the grant applies to, if any
+	Conditions *Conditions
+
+	// Whether the grant denies its actions instead of allowing them
+	Deny bool
 }
 
 // Actions returns the actions as a slice from the internal map, along with the
@@ -51,6 +58,8 @@ func (ag AclGrant) Clone() AclGrant {
 		GrantScopeId:      ag.GrantScopeId,
 		Id:                ag.Id,
 		Type:              ag.Type,
+		Conditions:        ag.Conditions,
+		Deny:              ag.Deny,
 	}
 	if ag.ActionSet != nil {
 		ret.ActionSet = make(map[action.Type]bool, len(ag.ActionSet))
@@ -110,6 +119,27 @@ type Permission struct {
 	ResourceIds []string // Any specific resource ids that have been referred in the grant's `id` field, if applicable.
 	OnlySelf    bool     // The grant only allows actions against the user's own resources.
 	All         bool     // We got a wildcard in the grant string's `id` field.
+	Deny        bool     // The resources are denied rather than allowed; ResourceIds may end in a wildcard to match by prefix.
+}
+
+// likeEscaper escapes the characters with special meaning in SQL LIKE patterns
+// using the default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ResourceIdLikePatterns returns SQL LIKE patterns matching the resource ids of
+// the permission. Ids ending in a wildcard, as in permissions with Deny set,
+// match all ids with the preceding prefix.
+func (p Permission) ResourceIdLikePatterns() []string {
+	ret := make([]string, 0, len(p.ResourceIds))
+	for _, id := range p.ResourceIds {
+		prefix, wildcard := strings.CutSuffix(id, "*")
+		pattern := likeEscaper.Replace(prefix)
+		if wildcard {
+			pattern += "%"
+		}
+		ret = append(ret, pattern)
+	}
+	return ret
 }
 
 // UserPermissions is a set of Permissions for a User.
@@ -212,20 +242,21 @@ func aclGrantFromGrant(grant Grant, id string) AclGrant {
 		Type:              grant.typ,
 		ActionSet:         grant.actions,
 		OutputFields:      grant.OutputFields,
+		Conditions:        grant.conditions,
+		Deny:              grant.deny,
 	}
 }
 
 // Allowed determines if the grants for an ACL allow an action for a resource.
+// Grants with conditions which are not met by the request, as provided with
+// WithClientIp and WithTime, are ignored. A deny grant matching the action and
+// resource takes precedence over any grant allowing it.
 func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option) (results ACLResults) {
 	opts := getOpts(opt...)
+	now := opts.requestTime()
 
 	// First, get the grants within the specified scopes
-	grants := a.directScopeMap[r.ScopeId]
-	grants = append(grants, a.childrenScopeMap[r.ParentScopeId]...)
-	if r.ScopeId != scope.Global.String() {
-		// Descendants grants do not apply to global!
-		grants = append(grants, a.descendantsGrants...)
-	}
+	grants := a.scopeGrants(r)
 	results.directScopeMap = a.directScopeMap
 	results.childrenScopeMap = a.childrenScopeMap
 	results.descendantsGrants = a.descendantsGrants
@@ -235,8 +266,20 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 	if len(split) == 2 {
 		parentAction = action.Map[split[0]]
 	}
+	// Deny grants override any grants allowing the action, so check them
+	// first; no output fields are returned for a denied request
+	for _, grant := range grants {
+		if grant.Deny &&
+			grant.Conditions.met(opts.withClientIp, now) &&
+			grant.denies(r, aType, parentAction) {
+			return
+		}
+	}
 	// Now, go through and check whether grants match
 	for _, grant := range grants {
+		if grant.Deny || !grant.Conditions.met(opts.withClientIp, now) {
+			continue
+		}
 		var outputFieldsOnly bool
 		switch {
 		case len(grant.ActionSet) == 0:
@@ -376,14 +419,73 @@ func (a ACL) Allowed(r Resource, aType action.Type, userId string, opt ...Option
 	return
 }
 
+// denies reports whether a deny grant applies to the action on the resource.
+// It mirrors cases 2 through 5 of Allowed, except that an ID ending in a
+// wildcard matches all IDs with the preceding prefix.
+func (ag AclGrant) denies(r Resource, aType, parentAction action.Type) bool {
+	if !ag.ActionSet[aType] && !ag.ActionSet[parentAction] && !ag.ActionSet[action.All] {
+		return false
+	}
+	collectionAction := action.List.IsActionOrParent(aType) || action.Create.IsActionOrParent(aType)
+	switch {
+	case ag.Id == "*":
+		return ag.Type != resource.Unknown &&
+			(ag.Type == r.Type || ag.Type == resource.All)
+	case ag.Id == "":
+		return r.Id == "" &&
+			ag.Type == r.Type &&
+			ag.Type != resource.Unknown &&
+			resource.TopLevelType(r.Type) &&
+			collectionAction
+	case idMatches(ag.Id, r.Id) &&
+		(ag.Type == resource.Unknown || ag.Type == globals.ResourceInfoFromPrefix(ag.Id).Type) &&
+		!collectionAction:
+		return true
+	default:
+		return idMatches(ag.Id, r.Pin) &&
+			ag.Type != resource.Unknown &&
+			(ag.Type == r.Type || ag.Type == resource.All) &&
+			!resource.TopLevelType(r.Type)
+	}
+}
+
+// idMatches reports whether an ID matches the ID of a grant. A grant ID ending
+// in a wildcard, which only deny grants may contain, matches all IDs with the
+// preceding prefix.
+func idMatches(grantId, id string) bool {
+	if grantId == "" || id == "" {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(grantId, "*"); ok && prefix != "" {
+		return strings.HasPrefix(id, prefix)
+	}
+	return grantId == id
+}
+
+// scopeGrants returns the grants of the ACL whose grant scope covers the scope
+// of the resource.
+func (a ACL) scopeGrants(r Resource) []AclGrant {
+	// Copy the direct grants so that appending to them does not modify the
+	// ACL's map
+	grants := append([]AclGrant(nil), a.directScopeMap[r.ScopeId]...)
+	grants = append(grants, a.childrenScopeMap[r.ParentScopeId]...)
+	if r.ScopeId != scope.Global.String() {
+		// Descendants grants do not apply to global!
+		grants = append(grants, a.descendantsGrants...)
+	}
+	return grants
+}
+
 // ListResolvableAliasesPermissions builds a set of Permissions based on the
 // grants in the ACL. The permissions will only be created if there is at least
 // one grant of the provided resource type that includes at least one of the
 // provided actions in the action set. Note that unlike the ListPermissions
 // method, this method does not attempt to generate permissions for the
 // u_recovery user. To get the resolvable aliases for u_recovery, the user could
-// simply query all aliases with a destination id.
-func (a ACL) ListResolvableAliasesPermissions(requestedType resource.Type, actions action.ActionSet) []Permission {
+// simply query all aliases with a destination id. If any permissions are
+// created, a Permission with Deny set is added for each deny grant that denies
+// all of the granted actions; the resources it matches must be excluded.
+func (a ACL) ListResolvableAliasesPermissions(requestedType resource.Type, actions action.ActionSet, opt ...Option) []Permission {
 	perms := make([]Permission, 0, len(a.directScopeMap)+len(a.childrenScopeMap)+len(a.descendantsGrants))
 
 	childScopeMap := a.childrenScopeMap
@@ -399,12 +501,12 @@ func (a ACL) ListResolvableAliasesPermissions(requestedType resource.Type, actio
 		Action:       action.ListResolvableAliases,
 		OnlySelf:     true, // default to only self to be restrictive
 	}
-	if a.buildPermission(&scopes.ScopeInfo{}, requestedType, actions, true, &p) {
+	if _, ok := a.buildPermission(&scopes.ScopeInfo{}, requestedType, actions, true, &p, opt...); ok {
 		perms = append(perms, p)
 		// Shortcut here because this is all we need -- this will turn into all
 		// scopes. We only need to check for "global" in the direct map.
 		if _, ok := a.directScopeMap[scope.Global.String()]; !ok {
-			return perms
+			return append(perms, a.resolvableAliasesDenyPermissions(requestedType, actions, opt...)...)
 		}
 		childScopeMap = nil
 		scopeMap = map[string][]AclGrant{scope.Global.String(): a.directScopeMap[scope.Global.String()]}
@@ -426,7 +528,7 @@ func (a ACL) ListResolvableAliasesPermissions(requestedType resource.Type, actio
 		if scopeId != scope.Global.String() { // Must be an org then so global is parent
 			p.RoleParentScopeId = scope.Global.String()
 		}
-		if a.buildPermission(&scopes.ScopeInfo{ParentScopeId: scopeId}, requestedType, actions, false, &p) {
+		if _, ok := a.buildPermission(&scopes.ScopeInfo{ParentScopeId: scopeId}, requestedType, actions, false, &p, opt...); ok {
 			perms = append(perms, p)
 			childrenScopes[scopeId] = struct{}{}
 		}
@@ -479,10 +581,66 @@ func (a ACL) ListResolvableAliasesPermissions(requestedType resource.Type, actio
 			}
 		}
 
-		if a.buildPermission(&scopes.ScopeInfo{Id: grantScopeId}, requestedType, actions, false, &p) {
+		if _, ok := a.buildPermission(&scopes.ScopeInfo{Id: grantScopeId}, requestedType, actions, false, &p, opt...); ok {
 			perms = append(perms, p)
 		}
 	}
+	if len(perms) == 0 {
+		return perms
+	}
+	return append(perms, a.resolvableAliasesDenyPermissions(requestedType, actions, opt...)...)
+}
+
+// resolvableAliasesDenyPermissions builds a Permission with Deny set for each
+// deny grant in the ACL that denies all of the provided actions granted for the
+// resource type in any scope. Deny grants apply across all of the permissions
+// allowing resources, so the permissions use the grant scope ID of the deny
+// grant, which may be "children" or "descendants".
+func (a ACL) resolvableAliasesDenyPermissions(requestedType resource.Type, actions action.ActionSet, opt ...Option) []Permission {
+	opts := getOpts(opt...)
+	now := opts.requestTime()
+
+	grants := slices.Clone(a.descendantsGrants)
+	for _, g := range a.childrenScopeMap {
+		grants = append(grants, g...)
+	}
+	for _, g := range a.directScopeMap {
+		grants = append(grants, g...)
+	}
+
+	grantedActions := make(ActionSet)
+	for _, grant := range grants {
+		if !grant.Deny && grant.Conditions.met(opts.withClientIp, now) && grant.matchesType(requestedType) {
+			for act, ok := range grant.ActionSet {
+				grantedActions[act] = ok
+			}
+		}
+	}
+
+	var perms []Permission
+	for _, grant := range grants {
+		if !grant.Deny ||
+			grant.Id == "" ||
+			!grant.Conditions.met(opts.withClientIp, now) ||
+			!grant.matchesType(requestedType) ||
+			grantedActions.withoutDenied(grant.ActionSet, actions).hasAny(actions) {
+			continue
+		}
+		p := Permission{
+			RoleScopeId:       grant.RoleScopeId,
+			RoleParentScopeId: grant.RoleParentScopeId,
+			GrantScopeId:      grant.GrantScopeId,
+			Resource:          requestedType,
+			Action:            action.ListResolvableAliases,
+			Deny:              true,
+		}
+		if grant.Id == "*" {
+			p.All = true
+		} else {
+			p.ResourceIds = []string{grant.Id}
+		}
+		perms = append(perms, p)
+	}
 	return perms
 }
 
@@ -491,12 +649,18 @@ func (a ACL) ListResolvableAliasesPermissions(requestedType resource.Type, actio
 // There must be a grant for a given resource for one of the provided "id actions"
 // or for action.All in order for a Permission to be created for the scope.
 // The set of "id actions" is resource dependant, but will generally include all
-// actions that can be taken on an individual resource.
+// actions that can be taken on an individual resource. Grants with conditions
+// which are not met by the request are ignored, as in Allowed. Resources
+// matching a deny grant that denies every granted action are excluded: they are
+// removed from ResourceIds, or if All is set, returned in an additional
+// Permission for the scope with Deny set, whose ResourceIds the caller must
+// exclude.
 func (a ACL) ListPermissions(
 	requestedScopes map[string]*scopes.ScopeInfo,
 	requestedType resource.Type,
 	idActions action.ActionSet,
 	userId string,
+	opt ...Option,
 ) []Permission {
 	perms := make([]Permission, 0, len(requestedScopes))
 	for scopeId, scopeInfo := range requestedScopes {
@@ -524,8 +688,19 @@ func (a ACL) ListPermissions(
 			perms = append(perms, p)
 			continue
 		}
-		if a.buildPermission(scopeInfo, requestedType, idActions, false, &p) {
+		if deniedIds, ok := a.buildPermission(scopeInfo, requestedType, idActions, false, &p, opt...); ok {
 			perms = append(perms, p)
+			if len(deniedIds) > 0 {
+				perms = append(perms, Permission{
+					RoleScopeId:       p.RoleScopeId,
+					RoleParentScopeId: p.RoleParentScopeId,
+					GrantScopeId:      p.GrantScopeId,
+					Resource:          requestedType,
+					Action:            action.List,
+					ResourceIds:       deniedIds,
+					Deny:              true,
+				})
+			}
 		}
 	}
 	return perms
@@ -533,17 +708,22 @@ func (a ACL) ListPermissions(
 
 // buildPermission populates the provided permission with either the resource ids
 // or marking All to true if there are grants that have an action that match
-// one of the provided idActions for the provided type
+// one of the provided idActions for the provided type. Actions denied for all
+// resources of the type are not considered granted, and resource ids matching
+// deny grants that deny all of the granted actions are dropped. As such deny
+// grants cannot be expressed when All is set, they are returned so that the
+// caller can exclude the resources they match.
 func (a ACL) buildPermission(
 	scopeInfo *scopes.ScopeInfo,
 	requestedType resource.Type,
 	idActions action.ActionSet,
 	includeDescendants bool,
 	p *Permission,
-) bool {
+	opt ...Option,
+) (deniedIds []string, ok bool) {
 	// Get grants for a specific scope id from the source of truth.
 	if scopeInfo == nil {
-		return false
+		return nil, false
 	}
 	var grants []AclGrant
 	if scopeInfo.Id != "" {
@@ -557,30 +737,52 @@ func (a ACL) buildPermission(
 	if includeDescendants || (scopeInfo.Id != "" && scopeInfo.Id != scope.Global.String()) {
 		grants = append(grants, a.descendantsGrants...)
 	}
+	opts := getOpts(opt...)
+	now := opts.requestTime()
+
+	// Gather the deny grants first, along with the actions denied for all
+	// resources of the type
+	var denies []AclGrant
+	deniedActions := make(ActionSet)
 	for _, grant := range grants {
+		if !grant.Deny ||
+			!grant.Conditions.met(opts.withClientIp, now) ||
+			!grant.matchesType(requestedType) {
+			continue
+		}
+		denies = append(denies, grant)
+		if grant.Id == "*" {
+			for act := range grant.ActionSet {
+				deniedActions[act] = true
+			}
+		}
+	}
+
+	grantedActions := make(ActionSet)
+	for _, grant := range grants {
+		// The request doesn't meet the grant's conditions, ignore.
+		if grant.Deny || !grant.Conditions.met(opts.withClientIp, now) {
+			continue
+		}
 		// This grant doesn't match what we're looking for, ignore.
-		if grant.Type != requestedType && grant.Type != resource.All && globals.ResourceInfoFromPrefix(grant.Id).Type != requestedType {
+		if !grant.matchesType(requestedType) {
 			continue
 		}
 
 		// We found a grant that matches the requested resource type:
 		// Search to see if one or all actions in the action set have been granted.
-		found := false
-		if ok := grant.ActionSet[action.All]; ok {
-			found = true
-		} else {
-			for idA := range idActions {
-				if ok := grant.ActionSet[idA]; ok {
-					found = true
-					break
-				}
-			}
+		actionSet := grant.ActionSet
+		if len(deniedActions) > 0 {
+			actionSet = actionSet.withoutDenied(deniedActions, idActions)
 		}
-		if !found { // In this case, none of the requested actions were granted for the given scope id.
+		if !actionSet.hasAny(idActions) { // In this case, none of the requested actions were granted for the given scope id.
 			continue
 		}
+		for act := range actionSet {
+			grantedActions[act] = true
+		}
 
-		actions, _ := grant.Actions()
+		actions, _ := actionSet.Actions()
 		excludeList := make(action.ActionSet, len(actions))
 		for _, aa := range actions {
 			if aa != action.List {
@@ -599,5 +801,72 @@ func (a ACL) buildPermission(
 		}
 	}
 
-	return p.All || len(p.ResourceIds) > 0
+	// Resources matching a deny grant that denies every granted action are
+	// not visible at all
+	for _, grant := range denies {
+		if grant.Id != "" && grant.Id != "*" &&
+			!grantedActions.withoutDenied(grant.ActionSet, idActions).hasAny(idActions) {
+			deniedIds = append(deniedIds, grant.Id)
+		}
+	}
+	if len(deniedIds) > 0 && len(p.ResourceIds) > 0 {
+		p.ResourceIds = slices.DeleteFunc(p.ResourceIds, func(id string) bool {
+			return slices.ContainsFunc(deniedIds, func(deniedId string) bool {
+				return idMatches(deniedId, id)
+			})
+		})
+	}
+	if !p.All {
+		deniedIds = nil
+	}
+
+	return deniedIds, p.All || len(p.ResourceIds) > 0
+}
+
+// matchesType reports whether the grant applies to resources of the given type.
+func (ag AclGrant) matchesType(typ resource.Type) bool {
+	return ag.Type == typ || ag.Type == resource.All || globals.ResourceInfoFromPrefix(ag.Id).Type == typ
+}
+
+// hasAny reports whether the set contains any of the given actions, or the
+// wildcard action.
+func (a ActionSet) hasAny(actions action.ActionSet) bool {
+	if a[action.All] {
+		return true
+	}
+	for act := range actions {
+		if a[act] {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutDenied returns the actions in the set that are not denied, with
+// subactions denied along with their parent actions. A wildcard action in the
+// set is expanded to the given ID actions unless nothing is denied.
+func (a ActionSet) withoutDenied(denied ActionSet, idActions action.ActionSet) ActionSet {
+	if denied[action.All] {
+		return ActionSet{}
+	}
+	ret := make(ActionSet, len(a))
+	add := func(act action.Type) {
+		if !denied[act] && !denied[parentAction(act)] {
+			ret[act] = true
+		}
+	}
+	for act, ok := range a {
+		switch {
+		case !ok:
+		case act == action.All && len(denied) == 0:
+			ret[act] = true
+		case act == action.All:
+			for idAction := range idActions {
+				add(idAction)
+			}
+		default:
+			add(act)
+		}
+	}
+	return ret
 }
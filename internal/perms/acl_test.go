@@ -361,6 +361,136 @@ func Test_ACLAllowed(t *testing.T) {
 				{action: action.ReadSelf, authorized: true},
 			},
 		},
+		{
+			name:     "deny id prefix overrides wildcard allow",
+			resource: Resource{ParentScopeId: "o_a", ScopeId: "p_a", Id: "ttcp_prod1", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  "p_a",
+					grantScope: "p_a",
+					grants: []string{
+						"ids=*;type=target;actions=*;output_fields=*",
+						"deny=true;ids=ttcp_prod*;actions=authorize-session",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true, outputFields: []string{"*"}},
+				{action: action.AuthorizeSession},
+			},
+		},
+		{
+			name:     "deny id prefix does not match other ids",
+			resource: Resource{ParentScopeId: "o_a", ScopeId: "p_a", Id: "ttcp_dev1", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  "p_a",
+					grantScope: "p_a",
+					grants: []string{
+						"ids=*;type=target;actions=*",
+						"deny=true;ids=ttcp_prod*;actions=authorize-session",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.AuthorizeSession, authorized: true},
+			},
+		},
+		{
+			name:     "deny from descendants overrides direct allow",
+			resource: Resource{ParentScopeId: "o_a", ScopeId: "p_a", Id: "ttcp_1234567890", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants: []string{
+						"deny=true;ids=*;type=target;actions=delete",
+					},
+				},
+				{
+					roleScope:  "p_a",
+					grantScope: "p_a",
+					grants: []string{
+						"ids=ttcp_1234567890;actions=read,delete",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read, authorized: true},
+				{action: action.Delete},
+			},
+		},
+		{
+			name:     "deny parent action denies subactions",
+			resource: Resource{ParentScopeId: scope.Global.String(), ScopeId: "o_a", Id: "u_1234567890", Type: resource.User},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  "o_a",
+					grantScope: "o_a",
+					grants: []string{
+						"ids=*;type=*;actions=*",
+						"deny=true;ids=*;type=user;actions=read",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+				{action: action.ReadSelf},
+				{action: action.Update, authorized: true},
+			},
+		},
+		{
+			name:     "deny collection action",
+			resource: Resource{ParentScopeId: scope.Global.String(), ScopeId: "o_a", Type: resource.HostCatalog},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  "o_a",
+					grantScope: "o_a",
+					grants: []string{
+						"type=host-catalog;actions=create,list",
+						"deny=true;type=host-catalog;actions=create",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Create},
+				{action: action.List, authorized: true},
+			},
+		},
+		{
+			name:     "deny pin prefix",
+			resource: Resource{ParentScopeId: scope.Global.String(), ScopeId: "o_a", Id: "hst_1234567890", Pin: "hcst_prod1", Type: resource.Host},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  "o_a",
+					grantScope: "o_a",
+					grants: []string{
+						"ids=*;type=host;actions=read",
+						"deny=true;ids=hcst_prod*;type=host;actions=*",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.Read},
+			},
+		},
+		{
+			name:     "deny with unmet conditions is ignored",
+			resource: Resource{ParentScopeId: "o_a", ScopeId: "p_a", Id: "ttcp_prod1", Type: resource.Target},
+			scopeGrants: []scopeGrant{
+				{
+					roleScope:  "p_a",
+					grantScope: "p_a",
+					grants: []string{
+						"ids=*;type=target;actions=authorize-session",
+						"deny=true;ids=ttcp_prod*;actions=authorize-session;client_cidrs=10.0.0.0/8",
+					},
+				},
+			},
+			actionsAuthorized: []actionAuthorized{
+				{action: action.AuthorizeSession, authorized: true},
+			},
+		},
 		{
 			name:     "create worker with create",
 			resource: Resource{ScopeId: scope.Global.String(), Type: resource.Worker},
@@ -1094,6 +1224,79 @@ func TestACL_ListResolvableAliasesPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "deny in project excluded from descendants allow",
+			aclGrants: []scopeGrant{
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants:     []string{"ids=*;type=target;actions=*"},
+				},
+				{
+					roleScope:         "p_1",
+					roleParentScopeId: "o_1",
+					grantScope:        "p_1",
+					grants:            []string{"deny=true;ids=ttcp_prod*;actions=*"},
+				},
+			},
+			resourceType: resource.Target,
+			actionSet:    action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{
+				{
+					RoleScopeId:  scope.Global.String(),
+					GrantScopeId: globals.GrantScopeDescendants,
+					Resource:     resource.Target,
+					Action:       action.ListResolvableAliases,
+					All:          true,
+				},
+				{
+					RoleScopeId:       "p_1",
+					RoleParentScopeId: "o_1",
+					GrantScopeId:      "p_1",
+					Resource:          resource.Target,
+					Action:            action.ListResolvableAliases,
+					ResourceIds:       []string{"ttcp_prod*"},
+					Deny:              true,
+				},
+			},
+		},
+		{
+			name: "deny of some granted actions is not excluded",
+			aclGrants: []scopeGrant{
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants: []string{
+						"ids=*;type=target;actions=read,authorize-session",
+						"deny=true;ids=*;type=target;actions=authorize-session",
+					},
+				},
+			},
+			resourceType: resource.Target,
+			actionSet:    action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{
+				{
+					RoleScopeId:  scope.Global.String(),
+					GrantScopeId: globals.GrantScopeDescendants,
+					Resource:     resource.Target,
+					Action:       action.ListResolvableAliases,
+					All:          true,
+				},
+			},
+		},
+		{
+			name: "deny without allow",
+			aclGrants: []scopeGrant{
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants:     []string{"deny=true;ids=*;type=target;actions=*"},
+				},
+			},
+			resourceType:   resource.Target,
+			actionSet:      action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1786,6 +1989,133 @@ func TestACL_ListPermissions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "deny id prefix is excluded from wildcard allow",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "p_1",
+					grants: []string{
+						"ids=*;type=target;actions=read,authorize-session",
+						"deny=true;ids=ttcp_prod*;actions=*",
+					},
+				},
+			},
+			scopes:       map[string]*scopes.ScopeInfo{"p_1": {Id: "p_1", ParentScopeId: "o_1"}},
+			resourceType: resource.Target,
+			actionSet:    action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{
+				{
+					RoleScopeId:       "p_1",
+					RoleParentScopeId: "o_1",
+					GrantScopeId:      "p_1",
+					Resource:          resource.Target,
+					Action:            action.List,
+					All:               true,
+				},
+				{
+					RoleScopeId:       "p_1",
+					RoleParentScopeId: "o_1",
+					GrantScopeId:      "p_1",
+					Resource:          resource.Target,
+					Action:            action.List,
+					ResourceIds:       []string{"ttcp_prod*"},
+					Deny:              true,
+				},
+			},
+		},
+		{
+			name: "deny id prefix removes denied ids",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "p_1",
+					grants: []string{
+						"ids=ttcp_a1,ttcp_b1;actions=authorize-session",
+						"deny=true;ids=ttcp_a*;actions=authorize-session",
+					},
+				},
+			},
+			scopes:       map[string]*scopes.ScopeInfo{"p_1": {Id: "p_1", ParentScopeId: "o_1"}},
+			resourceType: resource.Target,
+			actionSet:    action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{
+				{
+					RoleScopeId:       "p_1",
+					RoleParentScopeId: "o_1",
+					GrantScopeId:      "p_1",
+					Resource:          resource.Target,
+					Action:            action.List,
+					ResourceIds:       []string{"ttcp_b1"},
+				},
+			},
+		},
+		{
+			name: "deny of some granted actions keeps ids",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "p_1",
+					grants: []string{
+						"ids=ttcp_a1,ttcp_b1;actions=read,authorize-session",
+						"deny=true;ids=ttcp_a*;actions=authorize-session",
+					},
+				},
+			},
+			scopes:       map[string]*scopes.ScopeInfo{"p_1": {Id: "p_1", ParentScopeId: "o_1"}},
+			resourceType: resource.Target,
+			actionSet:    action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{
+				{
+					RoleScopeId:       "p_1",
+					RoleParentScopeId: "o_1",
+					GrantScopeId:      "p_1",
+					Resource:          resource.Target,
+					Action:            action.List,
+					ResourceIds:       []string{"ttcp_a1", "ttcp_b1"},
+				},
+			},
+		},
+		{
+			name: "wildcard deny of all granted actions",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "p_1",
+					grants:     []string{"ids=*;type=target;actions=*"},
+				},
+				{
+					roleScope:  scope.Global.String(),
+					grantScope: globals.GrantScopeDescendants,
+					grants:     []string{"deny=true;ids=*;type=*;actions=read,authorize-session"},
+				},
+			},
+			scopes:         map[string]*scopes.ScopeInfo{"p_1": {Id: "p_1", ParentScopeId: "o_1"}},
+			resourceType:   resource.Target,
+			actionSet:      action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{},
+		},
+		{
+			name: "wildcard deny of some granted actions",
+			aclGrants: []scopeGrant{
+				{
+					grantScope: "p_1",
+					grants: []string{
+						"ids=*;type=target;actions=*",
+						"deny=true;ids=*;type=target;actions=authorize-session",
+					},
+				},
+			},
+			scopes:       map[string]*scopes.ScopeInfo{"p_1": {Id: "p_1", ParentScopeId: "o_1"}},
+			resourceType: resource.Target,
+			actionSet:    action.NewActionSet(action.Read, action.AuthorizeSession),
+			expPermissions: []Permission{
+				{
+					RoleScopeId:       "p_1",
+					RoleParentScopeId: "o_1",
+					GrantScopeId:      "p_1",
+					Resource:          resource.Target,
+					Action:            action.List,
+					All:               true,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1884,7 +2214,7 @@ func Test_AnonRestrictions(t *testing.T) {
 				if i == resource.Controller || i == resource.Worker {
 					continue
 				}
-				for j := action.Type(1); j <= action.ListResolvableAliases; j++ {
+				for j := action.Type(1); j <= action.RemoveTotp; j++ {
 					id := "foobar"
 					prefixes := globals.ResourcePrefixesFromType(resource.Type(i))
 					if len(prefixes) > 0 {
@@ -1932,3 +2262,55 @@ func Test_AnonRestrictions(t *testing.T) {
 		})
 	}
 }
+
+// FuzzAllowedDeny checks the precedence of deny grants: adding a deny grant
+// never authorizes more, and a deny grant always overrides a grant allowing the
+// same action on the same resource.
+func FuzzAllowedDeny(f *testing.F) {
+	ctx := context.Background()
+	tc := []struct {
+		allow, deny, id, pin, typ, act string
+	}{
+		{"ids=*;type=target;actions=*", "deny=true;ids=ttcp_prod*;actions=authorize-session", "ttcp_prod1", "", "target", "authorize-session"},
+		{"ids=*;type=target;actions=*", "deny=true;ids=ttcp_prod*;actions=authorize-session", "ttcp_dev1", "", "target", "authorize-session"},
+		{"ids=*;type=*;actions=*", "deny=true;ids=*;type=user;actions=read", "u_1234567890", "", "user", "read:self"},
+		{"type=host-catalog;actions=create,list", "deny=true;type=host-catalog;actions=create", "", "", "host-catalog", "create"},
+		{"ids=*;type=host;actions=read", "deny=true;ids=hcst_prod*;type=host;actions=*", "hst_1234567890", "hcst_prod1", "host", "read"},
+		{"ids=ttcp_1234567890;actions=read", "deny=true;ids=ttcp_1*;actions=read;client_cidrs=10.0.0.0/8", "ttcp_1234567890", "", "target", "read"},
+	}
+	for _, tc := range tc {
+		f.Add(tc.allow, tc.deny, tc.id, tc.pin, tc.typ, tc.act)
+	}
+
+	f.Fuzz(func(t *testing.T, allowGrant, denyGrant, id, pin, typ, act string) {
+		parse := func(grant string) (Grant, bool) {
+			g, err := Parse(ctx, GrantTuple{RoleScopeId: "o_1234567890", GrantScopeId: "o_1234567890", Grant: grant}, WithSkipFinalValidation(true))
+			return g, err == nil
+		}
+		allow, ok := parse(allowGrant)
+		if !ok || allow.Deny() {
+			return
+		}
+		deny, ok := parse(denyGrant)
+		if !ok || !deny.Deny() {
+			return
+		}
+		r := Resource{ScopeId: "o_1234567890", ParentScopeId: scope.Global.String(), Id: id, Pin: pin, Type: resource.Map[typ]}
+		aType := action.Map[act]
+		userId := "u_1234567890"
+
+		allowed := NewACL(allow).Allowed(r, aType, userId).Authorized
+		withDeny := NewACL(allow, deny).Allowed(r, aType, userId).Authorized
+		if withDeny && !allowed {
+			t.Errorf("adding deny grant %q to allow grant %q authorized %s on %#v", denyGrant, allowGrant, act, r)
+		}
+
+		// The deny grant, if it allowed its actions instead, allows a subset of
+		// what it denies
+		asAllow := deny.clone()
+		asAllow.deny = false
+		if withDeny && NewACL(*asAllow).Allowed(r, aType, userId).Authorized {
+			t.Errorf("deny grant %q did not override allow grant %q for %s on %#v", denyGrant, allowGrant, act, r)
+		}
+	})
+}
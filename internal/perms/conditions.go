@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+	// Grants are parsed by every controller, so timezones must not depend on
+	// the tz database of the host
+	_ "time/tzdata"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"golang.org/x/exp/slices"
+)
+
+// weekdays maps the names used in grant strings to weekdays
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// Conditions are optional restrictions on the requests a grant applies to. A
+// grant with conditions only applies to a request when all of them are met;
+// otherwise the grant is ignored as if the role did not have it.
+type Conditions struct {
+	// The CIDRs the client IP of the request must be within, if any
+	clientCidrs []netip.Prefix
+
+	// The weekdays of the request time, if any
+	days []time.Weekday
+
+	// The hours of the request time, if hasHours is set. The window starts at
+	// startHour and ends before endHour, and wraps around midnight if
+	// startHour is after endHour.
+	hasHours  bool
+	startHour int
+	endHour   int
+
+	// The location days and hours are evaluated in, which defaults to UTC
+	location *time.Location
+
+	// The time at which the grant expires, if any
+	expires time.Time
+}
+
+// ClientCidrs returns the CIDRs the client IP of a request must be within, if
+// any
+func (c *Conditions) ClientCidrs() []string {
+	if c == nil || len(c.clientCidrs) == 0 {
+		return nil
+	}
+	ret := make([]string, 0, len(c.clientCidrs))
+	for _, p := range c.clientCidrs {
+		ret = append(ret, p.String())
+	}
+	return ret
+}
+
+// Days returns the names of the weekdays of the window of the conditions, if
+// any
+func (c *Conditions) Days() []string {
+	if c == nil || len(c.days) == 0 {
+		return nil
+	}
+	ret := make([]string, 0, len(c.days))
+	for _, d := range c.days {
+		ret = append(ret, strings.ToLower(d.String()[:3]))
+	}
+	return ret
+}
+
+// Hours returns the hours of the window of the conditions in the
+// "<start>-<end>" format, if any
+func (c *Conditions) Hours() string {
+	if c == nil || !c.hasHours {
+		return ""
+	}
+	return fmt.Sprintf("%d-%d", c.startHour, c.endHour)
+}
+
+// Timezone returns the name of the timezone of the window of the conditions,
+// if one was provided
+func (c *Conditions) Timezone() string {
+	if c == nil || c.location == nil {
+		return ""
+	}
+	return c.location.String()
+}
+
+// Expires returns the time at which the grant expires, which is the zero time
+// if it does not expire
+func (c *Conditions) Expires() time.Time {
+	if c == nil {
+		return time.Time{}
+	}
+	return c.expires
+}
+
+// isEmpty returns true if none of the conditions are set
+func (c *Conditions) isEmpty() bool {
+	return c == nil ||
+		(len(c.clientCidrs) == 0 &&
+			len(c.days) == 0 &&
+			!c.hasHours &&
+			c.location == nil &&
+			c.expires.IsZero())
+}
+
+// canonicalSegments returns the segments of the canonical grant string for
+// the conditions
+func (c *Conditions) canonicalSegments() []string {
+	if c.isEmpty() {
+		return nil
+	}
+	var ret []string
+	if cidrs := c.ClientCidrs(); len(cidrs) > 0 {
+		ret = append(ret, fmt.Sprintf("client_cidrs=%s", strings.Join(cidrs, ",")))
+	}
+	if days := c.Days(); len(days) > 0 {
+		ret = append(ret, fmt.Sprintf("days=%s", strings.Join(days, ",")))
+	}
+	if hours := c.Hours(); hours != "" {
+		ret = append(ret, fmt.Sprintf("hours=%s", hours))
+	}
+	if tz := c.Timezone(); tz != "" {
+		ret = append(ret, fmt.Sprintf("timezone=%s", tz))
+	}
+	if !c.expires.IsZero() {
+		ret = append(ret, fmt.Sprintf("expires=%s", c.expires.Format(time.RFC3339)))
+	}
+	return ret
+}
+
+// marshalJSON adds the conditions to the JSON representation of a grant
+func (c *Conditions) marshalJSON(res map[string]any) {
+	if c.isEmpty() {
+		return
+	}
+	if cidrs := c.ClientCidrs(); len(cidrs) > 0 {
+		res["client_cidrs"] = cidrs
+	}
+	if days := c.Days(); len(days) > 0 {
+		res["days"] = days
+	}
+	if hours := c.Hours(); hours != "" {
+		res["hours"] = hours
+	}
+	if tz := c.Timezone(); tz != "" {
+		res["timezone"] = tz
+	}
+	if !c.expires.IsZero() {
+		res["expires"] = c.expires.Format(time.RFC3339)
+	}
+}
+
+func (c *Conditions) parseClientCidrs(ctx context.Context, cidrs []string) error {
+	const op = "perms.(Conditions).parseClientCidrs"
+	if len(cidrs) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "empty client cidrs provided")
+	}
+	c.clientCidrs = make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		p, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid client cidr %q", cidr))
+		}
+		c.clientCidrs = append(c.clientCidrs, p.Masked())
+	}
+	return nil
+}
+
+func (c *Conditions) parseDays(ctx context.Context, days []string) error {
+	const op = "perms.(Conditions).parseDays"
+	if len(days) == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "empty days provided")
+	}
+	c.days = make([]time.Weekday, 0, len(days))
+	for _, day := range days {
+		d, ok := weekdays[strings.ToLower(day)]
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown day %q", day))
+		}
+		if !slices.Contains(c.days, d) {
+			c.days = append(c.days, d)
+		}
+	}
+	slices.Sort(c.days)
+	return nil
+}
+
+func (c *Conditions) parseHours(ctx context.Context, hours string) error {
+	const op = "perms.(Conditions).parseHours"
+	start, end, ok := strings.Cut(hours, "-")
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hours %q not formatted as <start>-<end>", hours))
+	}
+	var err error
+	if c.startHour, err = strconv.Atoi(start); err != nil || c.startHour < 0 || c.startHour > 23 {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("start hour %q must be between 0 and 23", start))
+	}
+	if c.endHour, err = strconv.Atoi(end); err != nil || c.endHour < 0 || c.endHour > 24 {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("end hour %q must be between 0 and 24", end))
+	}
+	if c.startHour == c.endHour {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("hours %q start and end at the same hour", hours))
+	}
+	c.hasHours = true
+	return nil
+}
+
+func (c *Conditions) parseTimezone(ctx context.Context, timezone string) error {
+	const op = "perms.(Conditions).parseTimezone"
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" || timezone == "Local" {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown timezone %q", timezone))
+	}
+	c.location = loc
+	return nil
+}
+
+func (c *Conditions) parseExpires(ctx context.Context, expires string) error {
+	const op = "perms.(Conditions).parseExpires"
+	t, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("expires %q is not an RFC 3339 timestamp", expires))
+	}
+	c.expires = t.UTC()
+	return nil
+}
+
+// validate ensures the conditions that were parsed make sense together
+func (c *Conditions) validate(ctx context.Context) error {
+	const op = "perms.(Conditions).validate"
+	if c.location != nil && len(c.days) == 0 && !c.hasHours {
+		return errors.New(ctx, errors.InvalidParameter, op, "timezone provided without days or hours")
+	}
+	return nil
+}
+
+// met reports whether a request from the client IP at the time meets all of
+// the conditions. A client IP which cannot be parsed is not within any CIDR.
+func (c *Conditions) met(clientIp string, now time.Time) bool {
+	if c.isEmpty() {
+		return true
+	}
+	if !c.expires.IsZero() && !now.Before(c.expires) {
+		return false
+	}
+	if len(c.clientCidrs) > 0 {
+		addr, err := netip.ParseAddr(clientIp)
+		if err != nil {
+			return false
+		}
+		addr = addr.Unmap()
+		if !slices.ContainsFunc(c.clientCidrs, func(p netip.Prefix) bool { return p.Contains(addr) }) {
+			return false
+		}
+	}
+	loc := c.location
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	if len(c.days) > 0 && !slices.Contains(c.days, local.Weekday()) {
+		return false
+	}
+	if c.hasHours {
+		h := local.Hour()
+		switch {
+		case c.startHour < c.endHour:
+			if h < c.startHour || h >= c.endHour {
+				return false
+			}
+		default:
+			// The window wraps around midnight
+			if h < c.startHour && h >= c.endHour {
+				return false
+			}
+		}
+	}
+	return true
+}
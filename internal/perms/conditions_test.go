@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/scopes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseConditions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		input        string
+		wantCanon    string
+		wantJson     string
+		wantErr      string
+		wantCidrs    []string
+		wantDays     []string
+		wantHours    string
+		wantTimezone string
+		wantExpires  time.Time
+	}{
+		{
+			name:         "text",
+			input:        "ids=*;type=target;actions=read;client_cidrs=10.1.2.3/8,2001:db8::/32;days=fri,mon;hours=9-17;timezone=Europe/Berlin;expires=2030-01-02T03:04:05+01:00",
+			wantCanon:    "ids=*;type=target;actions=read;client_cidrs=10.0.0.0/8,2001:db8::/32;days=mon,fri;hours=9-17;timezone=Europe/Berlin;expires=2030-01-02T02:04:05Z",
+			wantJson:     `{"actions":["read"],"client_cidrs":["10.0.0.0/8","2001:db8::/32"],"days":["mon","fri"],"expires":"2030-01-02T02:04:05Z","hours":"9-17","ids":["*"],"timezone":"Europe/Berlin","type":"target"}`,
+			wantCidrs:    []string{"10.0.0.0/8", "2001:db8::/32"},
+			wantDays:     []string{"mon", "fri"},
+			wantHours:    "9-17",
+			wantTimezone: "Europe/Berlin",
+			wantExpires:  time.Date(2030, 1, 2, 2, 4, 5, 0, time.UTC),
+		},
+		{
+			name:      "json",
+			input:     `{"ids":["*"],"type":"target","actions":["read"],"client_cidrs":["192.168.0.0/16"],"days":["SAT","sun"],"hours":"22-6"}`,
+			wantCanon: "ids=*;type=target;actions=read;client_cidrs=192.168.0.0/16;days=sun,sat;hours=22-6",
+			wantCidrs: []string{"192.168.0.0/16"},
+			wantDays:  []string{"sun", "sat"},
+			wantHours: "22-6",
+		},
+		{
+			name:      "no-conditions",
+			input:     "ids=*;type=target;actions=read",
+			wantCanon: "ids=*;type=target;actions=read",
+		},
+		{
+			name:    "bad-cidr",
+			input:   "ids=*;type=target;actions=read;client_cidrs=10.0.0.0/33",
+			wantErr: `invalid client cidr "10.0.0.0/33"`,
+		},
+		{
+			name:    "empty-cidr",
+			input:   "ids=*;type=target;actions=read;client_cidrs=10.0.0.0/8,",
+			wantErr: `invalid client cidr ""`,
+		},
+		{
+			name:    "bad-day",
+			input:   "ids=*;type=target;actions=read;days=monday",
+			wantErr: `unknown day "monday"`,
+		},
+		{
+			name:    "bad-hours-format",
+			input:   "ids=*;type=target;actions=read;hours=9",
+			wantErr: `hours "9" not formatted as <start>-<end>`,
+		},
+		{
+			name:    "bad-start-hour",
+			input:   "ids=*;type=target;actions=read;hours=24-5",
+			wantErr: `start hour "24" must be between 0 and 23`,
+		},
+		{
+			name:    "bad-end-hour",
+			input:   "ids=*;type=target;actions=read;hours=9-25",
+			wantErr: `end hour "25" must be between 0 and 24`,
+		},
+		{
+			name:    "same-hours",
+			input:   "ids=*;type=target;actions=read;hours=9-9",
+			wantErr: `hours "9-9" start and end at the same hour`,
+		},
+		{
+			name:    "bad-timezone",
+			input:   "ids=*;type=target;actions=read;days=mon;timezone=Mars/Olympus_Mons",
+			wantErr: `unknown timezone "Mars/Olympus_Mons"`,
+		},
+		{
+			name:    "local-timezone",
+			input:   "ids=*;type=target;actions=read;days=mon;timezone=Local",
+			wantErr: `unknown timezone "Local"`,
+		},
+		{
+			name:    "timezone-without-window",
+			input:   "ids=*;type=target;actions=read;timezone=UTC",
+			wantErr: "timezone provided without days or hours",
+		},
+		{
+			name:    "bad-expires",
+			input:   "ids=*;type=target;actions=read;expires=2030-01-02",
+			wantErr: `expires "2030-01-02" is not an RFC 3339 timestamp`,
+		},
+		{
+			name:    "json-bad-days-type",
+			input:   `{"ids":["*"],"type":"target","actions":["read"],"days":"mon"}`,
+			wantErr: `unable to interpret "days" as array`,
+		},
+		{
+			name:    "json-bad-hours-type",
+			input:   `{"ids":["*"],"type":"target","actions":["read"],"hours":9}`,
+			wantErr: `unable to interpret "hours" as string`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			grant, err := Parse(ctx, GrantTuple{RoleScopeId: "o_scope", GrantScopeId: "o_scope", Grant: tt.input})
+			if tt.wantErr != "" {
+				require.Error(err)
+				assert.Contains(err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tt.wantCanon, grant.CanonicalString())
+			if tt.wantJson != "" {
+				b, err := grant.MarshalJSON()
+				require.NoError(err)
+				assert.JSONEq(tt.wantJson, string(b))
+			}
+
+			c := grant.Conditions()
+			if tt.wantCidrs == nil && tt.wantDays == nil && tt.wantHours == "" {
+				assert.Nil(c)
+				return
+			}
+			assert.Equal(tt.wantCidrs, c.ClientCidrs())
+			assert.Equal(tt.wantDays, c.Days())
+			assert.Equal(tt.wantHours, c.Hours())
+			assert.Equal(tt.wantTimezone, c.Timezone())
+			assert.Equal(tt.wantExpires, c.Expires())
+
+			// The canonical string parses to the same grant
+			reparsed, err := Parse(ctx, GrantTuple{RoleScopeId: "o_scope", GrantScopeId: "o_scope", Grant: grant.CanonicalString()})
+			require.NoError(err)
+			assert.Equal(grant.CanonicalString(), reparsed.CanonicalString())
+		})
+	}
+}
+
+func Test_ConditionsMet(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// A Monday
+	monday := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		grant    string
+		clientIp string
+		now      time.Time
+		want     bool
+	}{
+		{
+			name:     "cidr-match",
+			grant:    "client_cidrs=10.0.0.0/8",
+			clientIp: "10.1.2.3",
+			now:      monday,
+			want:     true,
+		},
+		{
+			name:     "cidr-match-second",
+			grant:    "client_cidrs=10.0.0.0/8,192.168.0.0/16",
+			clientIp: "192.168.1.1",
+			now:      monday,
+			want:     true,
+		},
+		{
+			name:     "cidr-match-mapped-v4",
+			grant:    "client_cidrs=10.0.0.0/8",
+			clientIp: "::ffff:10.1.2.3",
+			now:      monday,
+			want:     true,
+		},
+		{
+			name:     "cidr-mismatch",
+			grant:    "client_cidrs=10.0.0.0/8",
+			clientIp: "11.1.2.3",
+			now:      monday,
+		},
+		{
+			name:  "cidr-missing-client-ip",
+			grant: "client_cidrs=10.0.0.0/8",
+			now:   monday,
+		},
+		{
+			name:  "day-match",
+			grant: "days=mon,tue",
+			now:   monday.Add(12 * time.Hour),
+			want:  true,
+		},
+		{
+			name:  "day-mismatch",
+			grant: "days=tue",
+			now:   monday.Add(12 * time.Hour),
+		},
+		{
+			name:  "day-in-timezone",
+			grant: "days=sun;timezone=America/New_York",
+			// Monday 01:00 UTC is Sunday evening in New York
+			now:  monday.Add(time.Hour),
+			want: true,
+		},
+		{
+			name:  "hours-match-start",
+			grant: "hours=9-17",
+			now:   monday.Add(9 * time.Hour),
+			want:  true,
+		},
+		{
+			name:  "hours-mismatch-end",
+			grant: "hours=9-17",
+			now:   monday.Add(17 * time.Hour),
+		},
+		{
+			name:  "hours-until-midnight",
+			grant: "hours=20-24",
+			now:   monday.Add(23*time.Hour + 59*time.Minute),
+			want:  true,
+		},
+		{
+			name:  "hours-wrap-late",
+			grant: "hours=22-6",
+			now:   monday.Add(23 * time.Hour),
+			want:  true,
+		},
+		{
+			name:  "hours-wrap-early",
+			grant: "hours=22-6",
+			now:   monday.Add(5 * time.Hour),
+			want:  true,
+		},
+		{
+			name:  "hours-wrap-mismatch",
+			grant: "hours=22-6",
+			now:   monday.Add(12 * time.Hour),
+		},
+		{
+			name:  "hours-in-timezone",
+			grant: "hours=9-17;timezone=Asia/Tokyo",
+			// 01:00 UTC is 10:00 in Tokyo
+			now:  monday.Add(time.Hour),
+			want: true,
+		},
+		{
+			name:  "not-expired",
+			grant: "expires=2024-01-02T00:00:00Z",
+			now:   monday,
+			want:  true,
+		},
+		{
+			name:  "expired",
+			grant: "expires=2024-01-02T00:00:00Z",
+			now:   monday.Add(24 * time.Hour),
+		},
+		{
+			name:     "all-met",
+			grant:    "client_cidrs=10.0.0.0/8;days=mon;hours=9-17;expires=2024-01-02T00:00:00Z",
+			clientIp: "10.0.0.1",
+			now:      monday.Add(10 * time.Hour),
+			want:     true,
+		},
+		{
+			name:     "one-not-met",
+			grant:    "client_cidrs=10.0.0.0/8;days=mon;hours=9-17;expires=2024-01-02T00:00:00Z",
+			clientIp: "10.0.0.1",
+			now:      monday.Add(18 * time.Hour),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grant, err := Parse(ctx, GrantTuple{RoleScopeId: "o_scope", GrantScopeId: "o_scope", Grant: "ids=*;type=target;actions=read;" + tt.grant})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, grant.Conditions().met(tt.clientIp, tt.now))
+		})
+	}
+}
+
+func Test_ACLConditions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// A Monday
+	now := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	var grants []Grant
+	for _, g := range []string{
+		"ids=*;type=target;actions=read;client_cidrs=10.0.0.0/8",
+		"ids=*;type=target;actions=update;days=tue",
+		"ids=*;type=target;actions=delete;expires=2024-01-01T11:00:00Z",
+	} {
+		grant, err := Parse(ctx, GrantTuple{RoleScopeId: "o_1", GrantScopeId: "o_1", Grant: g})
+		require.NoError(t, err)
+		grants = append(grants, grant)
+	}
+	acl := NewACL(grants...)
+	r := Resource{ScopeId: "o_1", Id: "ttcp_1234567890", Type: resource.Target}
+
+	t.Run("allowed", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			act      action.Type
+			clientIp string
+			now      time.Time
+			want     bool
+		}{
+			{name: "read-in-cidr", act: action.Read, clientIp: "10.0.0.1", now: now, want: true},
+			{name: "read-out-of-cidr", act: action.Read, clientIp: "127.0.0.1", now: now},
+			{name: "update-wrong-day", act: action.Update, clientIp: "10.0.0.1", now: now},
+			{name: "update-right-day", act: action.Update, clientIp: "10.0.0.1", now: now.Add(24 * time.Hour), want: true},
+			{name: "delete-not-expired", act: action.Delete, now: now, want: true},
+			{name: "delete-expired", act: action.Delete, now: now.Add(time.Hour)},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				results := acl.Allowed(r, tt.act, "u_1234567890", WithClientIp(tt.clientIp), WithTime(tt.now))
+				assert.Equal(t, tt.want, results.Authorized)
+			})
+		}
+	})
+
+	t.Run("list-permissions", func(t *testing.T) {
+		assert := assert.New(t)
+		scopeInfo := map[string]*scopes.ScopeInfo{"o_1": {Id: "o_1", ParentScopeId: scope.Global.String()}}
+		perms := acl.ListPermissions(scopeInfo, resource.Target, action.NewActionSet(action.Read), "u_1234567890", WithClientIp("10.0.0.1"), WithTime(now))
+		require.Len(t, perms, 1)
+		assert.True(perms[0].All)
+
+		perms = acl.ListPermissions(scopeInfo, resource.Target, action.NewActionSet(action.Read), "u_1234567890", WithClientIp("127.0.0.1"), WithTime(now))
+		assert.Empty(perms)
+	})
+}
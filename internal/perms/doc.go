@@ -20,5 +20,19 @@ and of course a matching scope.
 
 This makes it actually quite simple to perform the ACL checking. Much of ACL
 construction is thus synthesizing something reasonable from a set of Grants.
+
+Grants may also deny actions, e.g. deny=true;ids=ttcp_prod*;actions=authorize-session.
+Deny grants use the same patterns, except that they cannot contain output
+fields and their IDs may end in a wildcard to match all IDs with the preceding
+prefix. The precedence rules are:
+
+* A deny grant whose grant scope covers the resource, whose conditions are met,
+and which matches the action (or its parent action) overrides every grant
+allowing the action, regardless of the scope of either grant's role.
+* A deny grant whose conditions are not met, including when the client IP is
+unknown, does not apply.
+* When listing, a resource is hidden only if the deny grants matching it deny
+every action allowed for its type in the scope; otherwise it is listed and the
+denied actions are not authorized on it.
 */
 package perms
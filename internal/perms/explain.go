@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+)
+
+// GrantExplanation describes how a single grant was evaluated when explaining
+// whether an action is allowed on a resource.
+type GrantExplanation struct {
+	GrantTuple
+
+	// The error parsing the grant, if any. A grant which cannot be parsed never
+	// applies.
+	ParseError string
+
+	// Whether the grant scope of the grant covers the scope of the resource
+	InScope bool
+
+	// Whether the request meets the conditions of the grant, if any
+	ConditionsMet bool
+
+	// Whether the grant on its own allows the action on the resource
+	Allowed bool
+	// Whether the grant is a deny grant which on its own denies the action on
+	// the resource, overriding any grants allowing it
+	Denied bool
+}
+
+// Explanation is the result of explaining whether a set of grants allows an
+// action on a resource.
+type Explanation struct {
+	// Whether the grants allow the action, as returned by ACL.Allowed
+	Allowed bool
+
+	// The explanation of each grant, in the order of the grant tuples
+	Grants []GrantExplanation
+}
+
+// Explain evaluates the grant tuples of a user for an action on a resource
+// like ACL.Allowed does, and additionally describes how each grant was
+// evaluated on its own. Grants are parsed as they are when authorizing a
+// request of the user. The options are those of Parse and Allowed.
+func Explain(ctx context.Context, tuples GrantTuples, r Resource, aType action.Type, userId string, opt ...Option) Explanation {
+	opts := getOpts(opt...)
+	// Evaluate all grants at the same time so that time conditions are
+	// consistent across them
+	opt = append(opt, WithTime(opts.requestTime()))
+
+	ret := Explanation{
+		Grants: make([]GrantExplanation, 0, len(tuples)),
+	}
+	parsedGrants := make([]Grant, 0, len(tuples))
+	for _, tuple := range tuples {
+		ge := GrantExplanation{GrantTuple: tuple}
+		grant, err := Parse(ctx, tuple, append(opt, WithUserId(userId), WithSkipFinalValidation(true))...)
+		if err != nil {
+			ge.ParseError = err.Error()
+			ret.Grants = append(ret.Grants, ge)
+			continue
+		}
+		parsedGrants = append(parsedGrants, grant)
+
+		acl := NewACL(grant)
+		ge.InScope = len(acl.scopeGrants(r)) > 0
+		ge.ConditionsMet = grant.conditions.met(opts.withClientIp, opts.requestTime())
+		ge.Allowed = acl.Allowed(r, aType, userId, opt...).Authorized
+		if grant.deny && ge.ConditionsMet {
+			for _, ag := range acl.scopeGrants(r) {
+				ge.Denied = ge.Denied || ag.denies(r, aType, parentAction(aType))
+			}
+		}
+		ret.Grants = append(ret.Grants, ge)
+	}
+	ret.Allowed = NewACL(parsedGrants...).Allowed(r, aType, userId, opt...).Authorized
+	return ret
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tuples := GrantTuples{
+		{
+			RoleId:       "r_read",
+			RoleScopeId:  "p_a",
+			GrantScopeId: "p_a",
+			Grant:        "ids=*;type=target;actions=read",
+		},
+		{
+			RoleId:       "r_other_scope",
+			RoleScopeId:  "p_b",
+			GrantScopeId: "p_b",
+			Grant:        "ids=*;type=target;actions=authorize-session",
+		},
+		{
+			RoleId:            "r_children",
+			RoleScopeId:       "o_a",
+			RoleParentScopeId: "global",
+			GrantScopeId:      "children",
+			Grant:             "ids=*;type=target;actions=authorize-session;client_cidrs=10.0.0.0/8",
+		},
+		{
+			RoleId:       "r_invalid",
+			RoleScopeId:  "p_a",
+			GrantScopeId: "p_a",
+			Grant:        "ids=*;type=target;actions=bogus",
+		},
+	}
+	r := Resource{
+		Id:            "ttcp_1234567890",
+		ScopeId:       "p_a",
+		ParentScopeId: "o_a",
+		Type:          resource.Target,
+	}
+
+	tests := []struct {
+		name        string
+		action      action.Type
+		opt         []Option
+		wantAllowed bool
+		want        []GrantExplanation
+	}{
+		{
+			name:        "read",
+			action:      action.Read,
+			wantAllowed: true,
+			want: []GrantExplanation{
+				{GrantTuple: tuples[0], InScope: true, ConditionsMet: true, Allowed: true},
+				{GrantTuple: tuples[1], ConditionsMet: true},
+				{GrantTuple: tuples[2], InScope: true},
+				{GrantTuple: tuples[3]},
+			},
+		},
+		{
+			name:   "authorize-session-condition-not-met",
+			action: action.AuthorizeSession,
+			opt:    []Option{WithClientIp("192.168.1.1")},
+			want: []GrantExplanation{
+				{GrantTuple: tuples[0], InScope: true, ConditionsMet: true},
+				{GrantTuple: tuples[1], ConditionsMet: true},
+				{GrantTuple: tuples[2], InScope: true},
+				{GrantTuple: tuples[3]},
+			},
+		},
+		{
+			name:        "authorize-session-condition-met",
+			action:      action.AuthorizeSession,
+			opt:         []Option{WithClientIp("10.1.2.3")},
+			wantAllowed: true,
+			want: []GrantExplanation{
+				{GrantTuple: tuples[0], InScope: true, ConditionsMet: true},
+				{GrantTuple: tuples[1], ConditionsMet: true},
+				{GrantTuple: tuples[2], InScope: true, ConditionsMet: true, Allowed: true},
+				{GrantTuple: tuples[3]},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got := Explain(ctx, tuples, r, tt.action, "u_1234567890", tt.opt...)
+			assert.Equal(tt.wantAllowed, got.Allowed)
+			require.Len(got.Grants, len(tt.want))
+			for i, want := range tt.want {
+				g := got.Grants[i]
+				if g.RoleId == "r_invalid" {
+					assert.NotEmpty(g.ParseError)
+					g.ParseError = ""
+				}
+				assert.Equal(want, g)
+			}
+		})
+	}
+}
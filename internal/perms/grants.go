@@ -11,6 +11,7 @@ import (
 	"hash"
 	"hash/fnv"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 
@@ -139,6 +140,12 @@ type Grant struct {
 	// The set of output fields granted
 	OutputFields *OutputFields
 
+	// The conditions on the requests the grant applies to, if any
+	conditions *Conditions
+
+	// Whether the grant denies its actions instead of allowing them
+	deny bool
+
 	// This is used as a temporary staging area before validating permissions to
 	// allow the same validation code across grant string formats
 	actionsBeingParsed []string
@@ -170,6 +177,17 @@ func (g Grant) Actions() ([]action.Type, []string) {
 	return g.actions.Actions()
 }
 
+// Conditions returns the conditions on the requests the grant applies to, or
+// nil if the grant applies to all requests
+func (g Grant) Conditions() *Conditions {
+	return g.conditions
+}
+
+// Deny returns whether the grant denies its actions instead of allowing them
+func (g Grant) Deny() bool {
+	return g.deny
+}
+
 // hasActionOrSubaction checks whether a grant's action set contains the given
 // action or contains an action that is a subaction of the passed-in parameter.
 // This is used for validation checking of parsed grants. N.B.: this is the
@@ -192,6 +210,8 @@ func (g Grant) clone() *Grant {
 		ids:               g.ids,
 		grantScopeId:      g.grantScopeId,
 		typ:               g.typ,
+		conditions:        g.conditions,
+		deny:              g.deny,
 	}
 	if g.ids != nil {
 		ret.ids = make([]string, len(g.ids))
@@ -218,6 +238,10 @@ func (g Grant) clone() *Grant {
 func (g Grant) CanonicalString() string {
 	var builder []string
 
+	if g.deny {
+		builder = append(builder, "deny=true")
+	}
+
 	if g.id != "" {
 		builder = append(builder, fmt.Sprintf("id=%s", g.id))
 	}
@@ -243,6 +267,8 @@ func (g Grant) CanonicalString() string {
 		builder = append(builder, fmt.Sprintf("output_fields=%s", strings.Join(outFields, ",")))
 	}
 
+	builder = append(builder, g.conditions.canonicalSegments()...)
+
 	return strings.Join(builder, ";")
 }
 
@@ -250,6 +276,9 @@ func (g Grant) CanonicalString() string {
 func (g Grant) MarshalJSON() ([]byte, error) {
 	const op = "perms.(Grant).MarshalJSON"
 	res := make(map[string]any, 4)
+	if g.deny {
+		res["deny"] = true
+	}
 	if g.id != "" {
 		res["id"] = g.id
 	}
@@ -270,6 +299,7 @@ func (g Grant) MarshalJSON() ([]byte, error) {
 	if outFields, hasSetFields := g.OutputFields.Fields(); hasSetFields {
 		res["output_fields"] = outFields
 	}
+	g.conditions.marshalJSON(res)
 	b, err := json.Marshal(res)
 	if err != nil {
 		return nil, fmt.Errorf("%s: error marshaling grant: %w", op, err)
@@ -286,6 +316,13 @@ func (g *Grant) unmarshalJSON(ctx context.Context, data []byte) error {
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return errors.Wrap(ctx, err, op, errors.WithCode(errors.Decode))
 	}
+	if rawDeny, ok := raw["deny"]; ok {
+		deny, ok := rawDeny.(bool)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as boolean", "deny"))
+		}
+		g.deny = deny
+	}
 	if rawId, ok := raw["id"]; ok {
 		id, ok := rawId.(string)
 		switch {
@@ -376,9 +413,66 @@ func (g *Grant) unmarshalJSON(ctx context.Context, data []byte) error {
 			g.OutputFields = g.OutputFields.AddFields(fields)
 		}
 	}
+	for _, k := range []string{"client_cidrs", "days"} {
+		rawValues, ok := raw[k]
+		if !ok {
+			continue
+		}
+		interfaceValues, ok := rawValues.([]any)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as array", k))
+		}
+		values := make([]string, 0, len(interfaceValues))
+		for _, v := range interfaceValues {
+			value, ok := v.(string)
+			if !ok {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %v in %s array as string", v, k))
+			}
+			values = append(values, value)
+		}
+		if err := g.parseCondition(ctx, k, values); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
+	for _, k := range []string{"hours", "timezone", "expires"} {
+		rawValue, ok := raw[k]
+		if !ok {
+			continue
+		}
+		value, ok := rawValue.(string)
+		if !ok {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q as string", k))
+		}
+		if err := g.parseCondition(ctx, k, []string{value}); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+	}
 	return nil
 }
 
+// parseCondition parses the values of a condition of the grant. The values of
+// the "hours", "timezone" and "expires" conditions must have a single element.
+func (g *Grant) parseCondition(ctx context.Context, key string, values []string) error {
+	const op = "perms.(Grant).parseCondition"
+	if g.conditions == nil {
+		g.conditions = &Conditions{}
+	}
+	switch key {
+	case "client_cidrs":
+		return g.conditions.parseClientCidrs(ctx, values)
+	case "days":
+		return g.conditions.parseDays(ctx, values)
+	case "hours":
+		return g.conditions.parseHours(ctx, values[0])
+	case "timezone":
+		return g.conditions.parseTimezone(ctx, values[0])
+	case "expires":
+		return g.conditions.parseExpires(ctx, values[0])
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unknown condition %q", key))
+	}
+}
+
 func (g *Grant) unmarshalText(ctx context.Context, grantString string) error {
 	const op = "perms.(Grant).unmarshalText"
 	segments := strings.Split(grantString, ";")
@@ -396,6 +490,13 @@ func (g *Grant) unmarshalText(ctx context.Context, grantString string) error {
 		}
 
 		switch kv[0] {
+		case "deny":
+			deny, err := strconv.ParseBool(kv[1])
+			if err != nil {
+				return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("unable to interpret %q value %q as boolean", "deny", kv[1]))
+			}
+			g.deny = deny
+
 		case "id":
 			g.id = kv[1]
 			if strings.Contains(g.id, ",") {
@@ -436,6 +537,16 @@ func (g *Grant) unmarshalText(ctx context.Context, grantString string) error {
 			default:
 				g.OutputFields = g.OutputFields.AddFields(strings.Split(kv[1], ","))
 			}
+
+		case "client_cidrs", "days":
+			if err := g.parseCondition(ctx, kv[0], strings.Split(kv[1], ",")); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+		case "hours", "timezone", "expires":
+			if err := g.parseCondition(ctx, kv[0], []string{kv[1]}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
 		}
 	}
 
@@ -486,6 +597,25 @@ func Parse(ctx context.Context, tuple GrantTuple, opt ...Option) (Grant, error)
 	if len(grant.ids) > 1 && slices.Contains(grant.ids, "*") {
 		return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q contains both wildcard and non-wildcard values in %q field", tuple.Grant, "ids"))
 	}
+	for _, id := range append([]string{grant.id}, grant.ids...) {
+		// IDs ending in a wildcard match all IDs with the preceding prefix,
+		// which is only supported for deny grants
+		switch {
+		case id == "*" || !strings.Contains(id, "*"):
+		case !grant.deny:
+			return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q contains an id prefix, which is only supported in deny grants", tuple.Grant))
+		case strings.Index(id, "*") != len(id)-1:
+			return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q contains an id with a wildcard that is not at the end", tuple.Grant))
+		}
+	}
+	if grant.deny {
+		if grant.OutputFields != nil {
+			return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q is a deny grant, which cannot contain output fields", tuple.Grant))
+		}
+		if len(grant.actionsBeingParsed) == 0 {
+			return Grant{}, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("input grant string %q is a deny grant, which must contain actions", tuple.Grant))
+		}
+	}
 
 	opts := getOpts(opt...)
 
@@ -564,6 +694,11 @@ func Parse(ctx context.Context, tuple GrantTuple, opt ...Option) (Grant, error)
 			if err := grant.parseAndValidateActions(ctx); err != nil {
 				return Grant{}, errors.Wrap(ctx, err, op)
 			}
+			if grant.conditions != nil {
+				if err := grant.conditions.validate(ctx); err != nil {
+					return Grant{}, errors.Wrap(ctx, err, op)
+				}
+			}
 		}
 
 		if !opts.withSkipFinalValidation {
@@ -651,9 +786,13 @@ func Parse(ctx context.Context, tuple GrantTuple, opt ...Option) (Grant, error)
 			if len(grant.actions) > 0 {
 				// Create a dummy resource and pass it through Allowed and
 				// ensure that we get allowed. We need to use the templated
-				// grant, if any, so we send in a clone with an updated ID.
+				// grant, if any, so we send in a clone with an updated ID. The
+				// conditions are dropped as they depend on the request.
 				grantForValidation := grant.clone()
 				grantForValidation.id = grantIds[i]
+				grantForValidation.conditions = nil
+				// Deny grants are validated as if they allowed their actions
+				grantForValidation.deny = false
 				acl := NewACL(*grantForValidation)
 				// For special scope names we aren't sure where the resource
 				// might be, so check possible scopes and see if any are valid
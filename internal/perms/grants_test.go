@@ -245,6 +245,19 @@ func Test_MarshalingAndCloning(t *testing.T) {
 			jsonOutput:      `{"actions":["create","read"],"ids":["baz","bop"],"output_fields":["ids","name","version"],"type":"group"}`,
 			canonicalString: `ids=baz,bop;type=group;actions=create,read;output_fields=ids,name,version`,
 		},
+		{
+			name: "deny",
+			input: Grant{
+				ids: []string{"baz*"},
+				actions: map[action.Type]bool{
+					action.Read: true,
+				},
+				actionsBeingParsed: []string{"read"},
+				deny:               true,
+			},
+			jsonOutput:      `{"actions":["read"],"deny":true,"ids":["baz*"]}`,
+			canonicalString: `deny=true;ids=baz*;actions=read`,
+		},
 	}
 
 	for _, test := range tests {
@@ -1000,6 +1013,58 @@ func Test_Parse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "deny with id prefix",
+			input: "deny=true;ids=ttcp_prod*;actions=authorize-session",
+			expected: Grant{
+				roleScopeId:  "o_scope",
+				grantScopeId: "o_scope",
+				ids:          []string{"ttcp_prod*"},
+				actions: map[action.Type]bool{
+					action.AuthorizeSession: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "deny json",
+			input: `{"deny": true, "ids": ["*"], "type": "target", "actions": ["delete"]}`,
+			expected: Grant{
+				roleScopeId:  "o_scope",
+				grantScopeId: "o_scope",
+				ids:          []string{"*"},
+				typ:          resource.Target,
+				actions: map[action.Type]bool{
+					action.Delete: true,
+				},
+				deny: true,
+			},
+		},
+		{
+			name:  "bad deny value",
+			input: "deny=maybe;ids=*;type=target;actions=delete",
+			err:   `perms.Parse: unable to parse grant string: perms.(Grant).unmarshalText: unable to interpret "deny" value "maybe" as boolean: parameter violation: error #100`,
+		},
+		{
+			name:  "id prefix in allow grant",
+			input: "ids=ttcp_prod*;actions=authorize-session",
+			err:   `perms.Parse: input grant string "ids=ttcp_prod*;actions=authorize-session" contains an id prefix, which is only supported in deny grants: parameter violation: error #100`,
+		},
+		{
+			name:  "deny with wildcard not at end of id",
+			input: "deny=true;ids=ttcp_*prod;actions=authorize-session",
+			err:   `perms.Parse: input grant string "deny=true;ids=ttcp_*prod;actions=authorize-session" contains an id with a wildcard that is not at the end: parameter violation: error #100`,
+		},
+		{
+			name:  "deny with output fields",
+			input: "deny=true;ids=*;type=target;actions=read;output_fields=id",
+			err:   `perms.Parse: input grant string "deny=true;ids=*;type=target;actions=read;output_fields=id" is a deny grant, which cannot contain output fields: parameter violation: error #100`,
+		},
+		{
+			name:  "deny without actions",
+			input: "deny=true;ids=*;type=target",
+			err:   `perms.Parse: input grant string "deny=true;ids=*;type=target" is a deny grant, which must contain actions: parameter violation: error #100`,
+		},
 	}
 
 	_, err := Parse(ctx, GrantTuple{RoleScopeId: "", GrantScopeId: "", Grant: ""})
@@ -1124,6 +1189,8 @@ func FuzzParse(f *testing.F) {
 		`{"id":"foobar","type":"host-catalog","actions":["create"]}`,
 		`{"ids":["foobar"],"type":"host-catalog","actions":["create"]}`,
 		`{"ids":["\""]}`,
+		"deny=true;ids=ttcp_prod*;actions=authorize-session",
+		`{"deny":true,"ids":["*"],"type":"target","actions":["delete"]}`,
 	}
 	for _, tc := range tc {
 		f.Add(tc)
@@ -3,6 +3,8 @@
 
 package perms
 
+import "time"
+
 // getOpts - iterate the inbound Options and return a struct
 func getOpts(opt ...Option) options {
 	opts := getDefaultOptions()
@@ -23,12 +25,22 @@ type options struct {
 	withAccountId                     string
 	withSkipFinalValidation           bool
 	withSkipAnonymousUserRestrictions bool
+	withClientIp                      string
+	withTime                          time.Time
 }
 
 func getDefaultOptions() options {
 	return options{}
 }
 
+// requestTime returns the time provided with WithTime, or the current time
+func (o options) requestTime() time.Time {
+	if o.withTime.IsZero() {
+		return time.Now()
+	}
+	return o.withTime
+}
+
 // WithUserId provides a user ID to be used for any templating in grant strings
 func WithUserId(userId string) Option {
 	return func(o *options) {
@@ -60,3 +72,19 @@ func WithSkipAnonymousUserRestrictions(with bool) Option {
 		o.withSkipAnonymousUserRestrictions = with
 	}
 }
+
+// WithClientIp provides the client IP of the request, which is checked against
+// the client CIDRs of grant conditions
+func WithClientIp(clientIp string) Option {
+	return func(o *options) {
+		o.withClientIp = clientIp
+	}
+}
+
+// WithTime provides the time of the request, which is checked against the
+// window and expiry of grant conditions. Defaults to the current time.
+func WithTime(t time.Time) Option {
+	return func(o *options) {
+		o.withTime = t
+	}
+}
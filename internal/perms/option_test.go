@@ -5,6 +5,7 @@ package perms
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -43,4 +44,21 @@ func Test_GetOpts(t *testing.T) {
 		opts = getOpts(WithSkipAnonymousUserRestrictions(true))
 		assert.True(opts.withSkipAnonymousUserRestrictions)
 	})
+	t.Run("with-client-ip", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.Empty(opts.withClientIp)
+		opts = getOpts(WithClientIp("10.0.0.1"))
+		assert.Equal("10.0.0.1", opts.withClientIp)
+	})
+	t.Run("with-time", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := getOpts()
+		assert.True(opts.withTime.IsZero())
+		assert.WithinDuration(time.Now(), opts.requestTime(), time.Minute)
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		opts = getOpts(WithTime(now))
+		assert.Equal(now, opts.withTime)
+		assert.Equal(now, opts.requestTime())
+	})
 }
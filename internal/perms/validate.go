@@ -0,0 +1,553 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/hashicorp/boundary/internal/types/scope"
+)
+
+// ValidationRole describes the role that grants are validated against.
+type ValidationRole struct {
+	// The ID of the role
+	Id string
+
+	// The scope ID of the role
+	ScopeId string
+
+	// The parent scope ID of the role, if any
+	ParentScopeId string
+
+	// The grant scope IDs of the role, which may include the special values
+	// "this", "children" and "descendants"
+	GrantScopeIds []string
+
+	// The current grants of the role
+	Grants []string
+}
+
+// ResourceLookupFunc returns the resource with the given ID, or nil if no such
+// resource exists.
+type ResourceLookupFunc func(ctx context.Context, id string) (*Resource, error)
+
+// GrantValidation describes the problems found with a grant proposed for a
+// role.
+type GrantValidation struct {
+	// The grant as provided
+	Grant string
+
+	// The canonical form of the grant, if it could be parsed
+	CanonicalGrant string
+
+	// The reason the grant would be rejected when added to the role, if any
+	Error string
+
+	// Problems with the grant that would not prevent adding it to the role
+	Warnings []string
+}
+
+// NewlyAllowedPermission is a set of actions that grants would allow on a
+// resource, or on all resources of a type, that the current grants of a role
+// do not allow.
+type NewlyAllowedPermission struct {
+	// The grant scope ID the permission applies to. The special value "this"
+	// is replaced with the scope ID of the role.
+	GrantScopeId string
+
+	// The type of the resources
+	Type resource.Type
+
+	// The ID of the resource, "*" for all resources of the type, or empty for
+	// the collection of the type
+	Id string
+
+	// The newly allowed actions
+	Actions []string
+}
+
+// GrantsValidation is the result of validating grants proposed for a role.
+type GrantsValidation struct {
+	// The validation of each grant, in the order provided
+	Grants []GrantValidation
+
+	// What the grants would allow that the role does not allow today
+	NewlyAllowed []NewlyAllowedPermission
+}
+
+// ValidateGrants lints grants proposed for a role without changing the role.
+// Each grant is parsed for every grant scope of the role as it would be when
+// added. Unknown resource IDs, resources outside of the grant scopes of the
+// role, actions that do not apply to the type of the grant, and grants that
+// duplicate or overlap with other grants are reported as warnings.
+// Additionally, the permissions that the grants would add to the role are
+// computed by comparing them against an ACL of the current grants of the
+// role. Current grants with conditions are evaluated for a request without a
+// client IP at the current time, while the conditions of the proposed grants
+// are ignored.
+func ValidateGrants(ctx context.Context, role ValidationRole, grants []string, lookup ResourceLookupFunc) (GrantsValidation, error) {
+	const op = "perms.ValidateGrants"
+	switch {
+	case role.ScopeId == "":
+		return GrantsValidation{}, errors.New(ctx, errors.InvalidParameter, op, "missing role scope id")
+	case lookup == nil:
+		return GrantsValidation{}, errors.New(ctx, errors.InvalidParameter, op, "missing resource lookup function")
+	}
+
+	// A role without grant scopes grants nothing, but the grants are still
+	// parsed as if they applied to the scope of the role
+	grantScopeIds := make([]string, 0, len(role.GrantScopeIds))
+	for _, gs := range role.GrantScopeIds {
+		if gs == globals.GrantScopeThis {
+			gs = role.ScopeId
+		}
+		grantScopeIds = append(grantScopeIds, gs)
+	}
+	noGrantScopes := len(grantScopeIds) == 0
+	if noGrantScopes {
+		grantScopeIds = append(grantScopeIds, role.ScopeId)
+	}
+	parse := func(grant string) ([]Grant, error) {
+		ret := make([]Grant, 0, len(grantScopeIds))
+		for _, gs := range grantScopeIds {
+			g, err := Parse(ctx, GrantTuple{
+				RoleId:            role.Id,
+				RoleScopeId:       role.ScopeId,
+				RoleParentScopeId: role.ParentScopeId,
+				GrantScopeId:      gs,
+				Grant:             grant,
+			})
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, g)
+		}
+		return ret, nil
+	}
+
+	resources := map[string]*Resource{}
+	lookupCached := func(id string) (*Resource, error) {
+		if r, ok := resources[id]; ok {
+			return r, nil
+		}
+		r, err := lookup(ctx, id)
+		if err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to look up resource %q", id)))
+		}
+		resources[id] = r
+		return r, nil
+	}
+
+	type namedGrant struct {
+		str   string
+		grant Grant
+	}
+	current := make([]namedGrant, 0, len(role.Grants))
+	var currentGrants []Grant
+	for _, cg := range role.Grants {
+		parsed, err := parse(cg)
+		if err != nil {
+			// Grants stored on a role have been validated when added, but
+			// don't fail the validation of new grants if one no longer parses
+			continue
+		}
+		current = append(current, namedGrant{str: cg, grant: parsed[0]})
+		currentGrants = append(currentGrants, parsed...)
+	}
+	currentAcl := NewACL(currentGrants...)
+
+	ret := GrantsValidation{
+		Grants: make([]GrantValidation, 0, len(grants)),
+	}
+	newlyAllowed := map[string]*NewlyAllowedPermission{}
+	var newlyAllowedKeys []string
+	var proposed []namedGrant
+	for _, g := range grants {
+		gv := GrantValidation{Grant: g}
+		parsed, err := parse(g)
+		if err != nil {
+			gv.Error = err.Error()
+			ret.Grants = append(ret.Grants, gv)
+			continue
+		}
+		grant := parsed[0]
+		gv.CanonicalGrant = grant.CanonicalString()
+		if msg := rejectedGrant(grant); msg != "" {
+			gv.Error = msg
+			ret.Grants = append(ret.Grants, gv)
+			continue
+		}
+		if noGrantScopes {
+			gv.Warnings = append(gv.Warnings, "the role has no grant scopes, so the grant does not apply to any scope")
+		}
+		gv.Warnings = append(gv.Warnings, actionWarnings(grant)...)
+
+		acl := NewACL(parsed...)
+		for _, id := range grant.allIds() {
+			// Wildcards and ID prefixes of deny grants match any number of
+			// resources, and templates are resolved per user
+			if strings.HasSuffix(id, "*") || strings.HasPrefix(id, "{{") {
+				continue
+			}
+			r, err := lookupCached(id)
+			if err != nil {
+				return GrantsValidation{}, err
+			}
+			switch {
+			case r == nil:
+				gv.Warnings = append(gv.Warnings, fmt.Sprintf("resource %q does not exist", id))
+			case !noGrantScopes && len(acl.scopeGrants(*r)) == 0:
+				gv.Warnings = append(gv.Warnings, fmt.Sprintf("resource %q is in scope %q, which is not covered by the grant scopes of the role", id, r.ScopeId))
+			}
+		}
+
+		for _, c := range current {
+			if msg := compareGrants(grant, c.grant); msg != "" {
+				gv.Warnings = append(gv.Warnings, fmt.Sprintf("%s existing grant %q", msg, c.str))
+			}
+		}
+		for _, p := range proposed {
+			if msg := compareGrants(grant, p.grant); msg != "" {
+				gv.Warnings = append(gv.Warnings, fmt.Sprintf("%s grant %q earlier in the request", msg, p.str))
+			}
+		}
+		proposed = append(proposed, namedGrant{str: g, grant: grant})
+		ret.Grants = append(ret.Grants, gv)
+
+		if noGrantScopes || grant.deny {
+			continue
+		}
+		// The conditions of the proposed grants are ignored so that what
+		// they allow when their conditions are met is reported. The current
+		// grants are included so that their deny grants apply.
+		unconditional := slices.Clone(currentGrants)
+		for _, pg := range parsed {
+			pg.conditions = nil
+			unconditional = append(unconditional, pg)
+		}
+		proposedAcl := NewACL(unconditional...)
+		for _, pg := range parsed {
+			ids := pg.allIds()
+			if len(ids) == 0 {
+				ids = []string{""}
+			}
+			for _, id := range ids {
+				candidates, err := validationResources(pg, id, lookupCached)
+				if err != nil {
+					return GrantsValidation{}, err
+				}
+				if len(candidates) == 0 {
+					continue
+				}
+				for _, act := range sortedActions(pg.actions) {
+					for _, r := range candidates {
+						if !proposedAcl.Allowed(r, act, globals.AnonymousUserId, WithSkipAnonymousUserRestrictions(true)).Authorized ||
+							currentAcl.Allowed(r, act, globals.AnonymousUserId, WithSkipAnonymousUserRestrictions(true)).Authorized {
+							continue
+						}
+						key := strings.Join([]string{pg.grantScopeId, pg.typ.String(), id}, ";")
+						na, ok := newlyAllowed[key]
+						if !ok {
+							typ := pg.typ
+							if typ == resource.Unknown {
+								typ = globals.ResourceInfoFromPrefix(id).Type
+							}
+							na = &NewlyAllowedPermission{GrantScopeId: pg.grantScopeId, Type: typ, Id: id}
+							newlyAllowed[key] = na
+							newlyAllowedKeys = append(newlyAllowedKeys, key)
+						}
+						if !slices.Contains(na.Actions, act.String()) {
+							na.Actions = append(na.Actions, act.String())
+						}
+						break
+					}
+				}
+			}
+		}
+	}
+
+	for _, key := range newlyAllowedKeys {
+		ret.NewlyAllowed = append(ret.NewlyAllowed, *newlyAllowed[key])
+	}
+	return ret, nil
+}
+
+// allIds returns the IDs of the grant, including the deprecated single ID
+func (g Grant) allIds() []string {
+	switch {
+	case len(g.ids) > 0:
+		return g.ids
+	case g.id != "":
+		return []string{g.id}
+	}
+	return nil
+}
+
+// effectiveType returns the type of the grant, or the type of its IDs if the
+// grant has no type. Parse ensures that all IDs of a grant have the same type.
+func (g Grant) effectiveType() resource.Type {
+	if ids := g.allIds(); g.typ == resource.Unknown && len(ids) > 0 {
+		return globals.ResourceInfoFromPrefix(ids[0]).Type
+	}
+	return g.typ
+}
+
+// covers returns whether the grant allows everything the other grant allows,
+// for any request the other grant applies to.
+func (g Grant) covers(o Grant) bool {
+	gIds, oIds := g.allIds(), o.allIds()
+	gAllIds := slices.Contains(gIds, "*")
+	gTyp, oTyp := g.effectiveType(), o.effectiveType()
+	switch {
+	case gTyp == oTyp:
+	case gTyp == resource.All && gAllIds:
+	case gTyp == resource.All && o.typ != resource.Unknown:
+	default:
+		return false
+	}
+	switch {
+	case gAllIds:
+	case len(gIds) == 0 || len(oIds) == 0:
+		if len(gIds) != len(oIds) {
+			return false
+		}
+	default:
+		for _, id := range oIds {
+			if !slices.ContainsFunc(gIds, func(gId string) bool { return idMatches(gId, id) }) {
+				return false
+			}
+		}
+	}
+	if !g.actions[action.All] {
+		for act := range o.actions {
+			if !g.actions[act] && !g.actions[parentAction(act)] {
+				return false
+			}
+		}
+	}
+	// Output fields don't apply to denied actions
+	if oFields, hasSetFields := o.OutputFields.Fields(); hasSetFields && !g.deny && !g.OutputFields.Has("*") {
+		for _, f := range oFields {
+			if !g.OutputFields.Has(f) {
+				return false
+			}
+		}
+	}
+	if !g.conditions.isEmpty() &&
+		strings.Join(g.conditions.canonicalSegments(), ";") != strings.Join(o.conditions.canonicalSegments(), ";") {
+		return false
+	}
+	return true
+}
+
+// overlaps returns whether the grant and the other grant allow some of the
+// same actions on some of the same resources.
+func (g Grant) overlaps(o Grant) bool {
+	if gTyp, oTyp := g.effectiveType(), o.effectiveType(); gTyp != oTyp && gTyp != resource.All && oTyp != resource.All {
+		return false
+	}
+	gIds, oIds := g.allIds(), o.allIds()
+	switch {
+	case slices.Contains(gIds, "*") || slices.Contains(oIds, "*"):
+	case len(gIds) == 0 && len(oIds) == 0:
+	default:
+		var found bool
+		for _, id := range oIds {
+			if slices.ContainsFunc(gIds, func(gId string) bool { return idMatches(gId, id) || idMatches(id, gId) }) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if g.actions[action.All] || o.actions[action.All] {
+		return len(g.actions) > 0 && len(o.actions) > 0
+	}
+	for act := range o.actions {
+		if g.actions[act] || g.actions[parentAction(act)] || g.hasActionOrSubaction(act) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareGrants describes how a grant relates to another grant of the role,
+// or returns an empty string if the grants are unrelated.
+func compareGrants(g, o Grant) string {
+	switch {
+	case g.deny == o.deny:
+	case g.deny && g.covers(o):
+		return "denies everything allowed by"
+	case g.deny && g.overlaps(o):
+		return "denies some of what is allowed by"
+	case o.covers(g):
+		return "is overridden by"
+	case o.overlaps(g):
+		return "is partially overridden by"
+	default:
+		return ""
+	}
+	switch {
+	case g.CanonicalString() == o.CanonicalString():
+		return "duplicates"
+	case o.covers(g):
+		return "is redundant with"
+	case g.covers(o):
+		return "supersedes"
+	case g.overlaps(o):
+		return "overlaps with"
+	}
+	return ""
+}
+
+// rejectedGrant returns why a parsed grant would be rejected when added to a
+// role, or an empty string if it would be accepted.
+func rejectedGrant(g Grant) string {
+	if g.id != "" {
+		return fmt.Sprintf("the %q field is no longer supported, use %q instead", "id", "ids")
+	}
+	_, actStrs := g.Actions()
+	slices.Sort(actStrs)
+	for _, actStr := range actStrs {
+		if depAct := action.DeprecatedMap[actStr]; depAct != action.Unknown {
+			return fmt.Sprintf("action %q has been deprecated and is not allowed to be set in grants, use %q instead", actStr, depAct.String())
+		}
+	}
+	return ""
+}
+
+// actionWarnings reports the actions of the grant that are not valid for the
+// type of resources it applies to. Types without registered actions are
+// skipped.
+func actionWarnings(g Grant) []string {
+	var typs []resource.Type
+	switch g.typ {
+	case resource.All:
+		return nil
+	case resource.Unknown:
+		for _, id := range g.allIds() {
+			if t := globals.ResourceInfoFromPrefix(id).Type; t != resource.Unknown && !slices.Contains(typs, t) {
+				typs = append(typs, t)
+			}
+		}
+	default:
+		typs = append(typs, g.typ)
+	}
+	var ret []string
+	for _, t := range typs {
+		valid, err := action.ActionSetForResource(t)
+		if err != nil {
+			continue
+		}
+		for _, act := range sortedActions(g.actions) {
+			if act == action.All || actionApplies(valid, act) {
+				continue
+			}
+			ret = append(ret, fmt.Sprintf("action %q does not apply to %s resources", act.String(), t.String()))
+		}
+	}
+	return ret
+}
+
+// actionApplies returns whether the action, its parent action or one of its
+// subactions is in the set.
+func actionApplies(valid action.ActionSet, act action.Type) bool {
+	if valid.HasAction(act) || valid.HasAction(parentAction(act)) {
+		return true
+	}
+	for v := range valid {
+		if act.IsActionOrParent(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// parentAction returns the parent of a subaction, or Unknown if the action is
+// not a subaction.
+func parentAction(act action.Type) action.Type {
+	if parent, _, ok := strings.Cut(act.String(), ":"); ok {
+		return action.Map[parent]
+	}
+	return action.Unknown
+}
+
+// sortedActions returns the actions of the set sorted by name
+func sortedActions(a ActionSet) []action.Type {
+	typs, _ := a.Actions()
+	slices.SortFunc(typs, func(x, y action.Type) int {
+		return strings.Compare(x.String(), y.String())
+	})
+	return typs
+}
+
+// validationResources returns the resources that stand for what a parsed
+// grant allows for one of its IDs when computing newly allowed permissions.
+// Wildcard and collection grants are represented by resources in example
+// scopes covered by the grant scope. Templated IDs depend on the user and are
+// skipped, as are IDs of resources which do not exist.
+func validationResources(g Grant, id string, lookup func(string) (*Resource, error)) ([]Resource, error) {
+	switch {
+	case strings.HasPrefix(id, "{{"):
+		return nil, nil
+	case id != "" && id != "*":
+		r, err := lookup(id)
+		if err != nil || r == nil {
+			return nil, err
+		}
+		if g.typ != resource.Unknown && g.typ != r.Type {
+			// The ID is the pin of the resources the grant applies to
+			return []Resource{{
+				ScopeId:       r.ScopeId,
+				ParentScopeId: r.ParentScopeId,
+				Type:          g.typ,
+				Pin:           id,
+			}}, nil
+		}
+		return []Resource{*r}, nil
+	}
+
+	var ret []Resource
+	switch g.grantScopeId {
+	case globals.GrantScopeDescendants:
+		ret = append(ret,
+			Resource{ScopeId: "o_1234567890", ParentScopeId: scope.Global.String()},
+			Resource{ScopeId: "p_1234567890", ParentScopeId: "o_1234567890"},
+		)
+	case globals.GrantScopeChildren:
+		childScopeId := "p_1234567890"
+		if g.roleScopeId == scope.Global.String() {
+			childScopeId = "o_1234567890"
+		}
+		ret = append(ret, Resource{ScopeId: childScopeId, ParentScopeId: g.roleScopeId})
+	default:
+		r := Resource{ScopeId: g.grantScopeId}
+		if g.grantScopeId != scope.Global.String() {
+			// The scope of a scope is its parent
+			s, err := lookup(g.grantScopeId)
+			if err != nil {
+				return nil, err
+			}
+			if s != nil {
+				r.ParentScopeId = s.ScopeId
+			}
+		}
+		ret = append(ret, r)
+	}
+	// The ID is left empty for wildcards so that current grants on the
+	// collection of the type match
+	for i := range ret {
+		ret[i].Type = g.typ
+	}
+	return ret, nil
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package perms
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/types/action"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// The handlers register the actions of resources; register the target
+	// actions for linting grants in these tests
+	action.RegisterResource(resource.Target,
+		action.NewActionSet(action.NoOp, action.Read, action.Update, action.Delete, action.AuthorizeSession),
+		action.NewActionSet(action.Create, action.List),
+	)
+}
+
+func TestValidateGrants(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resources := map[string]*Resource{
+		"p_a":        {Id: "p_a", Type: resource.Scope, ScopeId: "o_a"},
+		"ttcp_a":     {Id: "ttcp_a", Type: resource.Target, ScopeId: "p_a", ParentScopeId: "o_a"},
+		"ttcp_other": {Id: "ttcp_other", Type: resource.Target, ScopeId: "p_b", ParentScopeId: "o_a"},
+	}
+	lookup := func(_ context.Context, id string) (*Resource, error) {
+		return resources[id], nil
+	}
+	role := ValidationRole{
+		Id:            "r_1234567890",
+		ScopeId:       "p_a",
+		ParentScopeId: "o_a",
+		GrantScopeIds: []string{"this"},
+		Grants:        []string{"ids=*;type=target;actions=read"},
+	}
+
+	t.Run("missing-lookup", func(t *testing.T) {
+		_, err := ValidateGrants(ctx, role, []string{"ids=*;type=target;actions=read"}, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("lookup-error", func(t *testing.T) {
+		failingLookup := func(context.Context, string) (*Resource, error) {
+			return nil, fmt.Errorf("lookup failed")
+		}
+		_, err := ValidateGrants(ctx, role, []string{"ids=ttcp_a;actions=read"}, failingLookup)
+		require.Error(t, err)
+	})
+
+	t.Run("no-grant-scopes", func(t *testing.T) {
+		r := role
+		r.GrantScopeIds = nil
+		got, err := ValidateGrants(ctx, r, []string{"ids=*;type=target;actions=update"}, lookup)
+		require.NoError(t, err)
+		require.Len(t, got.Grants, 1)
+		assert.Equal(t, []string{"the role has no grant scopes, so the grant does not apply to any scope"}, got.Grants[0].Warnings)
+		assert.Empty(t, got.NewlyAllowed)
+	})
+
+	t.Run("lint", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		got, err := ValidateGrants(ctx, role, []string{
+			"ids=*;type=target;actions=bogus",
+			"id=ttcp_a;actions=read",
+			"ids=ttcp_a;actions=read",
+			"ids=ttcp_missing;actions=authorize-session",
+			"ids=ttcp_other;actions=authorize-session",
+			"ids=*;type=target;actions=read,update",
+			"ids=*;type=target;actions=update",
+			"ids=*;type=target;actions=add-grants",
+			"ids=*;type=target;actions=read",
+			"type=target;actions=list",
+		}, lookup)
+		require.NoError(err)
+		require.Len(got.Grants, 10)
+
+		assert.NotEmpty(got.Grants[0].Error)
+		assert.Empty(got.Grants[0].CanonicalGrant)
+
+		assert.Equal("id=ttcp_a;actions=read", got.Grants[1].CanonicalGrant)
+		assert.Equal(`the "id" field is no longer supported, use "ids" instead`, got.Grants[1].Error)
+
+		want := []GrantValidation{
+			{
+				Grant:          "ids=ttcp_a;actions=read",
+				CanonicalGrant: "ids=ttcp_a;actions=read",
+				Warnings:       []string{`is redundant with existing grant "ids=*;type=target;actions=read"`},
+			},
+			{
+				Grant:          "ids=ttcp_missing;actions=authorize-session",
+				CanonicalGrant: "ids=ttcp_missing;actions=authorize-session",
+				Warnings:       []string{`resource "ttcp_missing" does not exist`},
+			},
+			{
+				Grant:          "ids=ttcp_other;actions=authorize-session",
+				CanonicalGrant: "ids=ttcp_other;actions=authorize-session",
+				Warnings:       []string{`resource "ttcp_other" is in scope "p_b", which is not covered by the grant scopes of the role`},
+			},
+			{
+				Grant:          "ids=*;type=target;actions=read,update",
+				CanonicalGrant: "ids=*;type=target;actions=read,update",
+				Warnings: []string{
+					`supersedes existing grant "ids=*;type=target;actions=read"`,
+					`supersedes grant "ids=ttcp_a;actions=read" earlier in the request`,
+				},
+			},
+			{
+				Grant:          "ids=*;type=target;actions=update",
+				CanonicalGrant: "ids=*;type=target;actions=update",
+				Warnings:       []string{`is redundant with grant "ids=*;type=target;actions=read,update" earlier in the request`},
+			},
+			{
+				Grant:          "ids=*;type=target;actions=add-grants",
+				CanonicalGrant: "ids=*;type=target;actions=add-grants",
+				Warnings:       []string{`action "add-grants" does not apply to target resources`},
+			},
+			{
+				Grant:          "ids=*;type=target;actions=read",
+				CanonicalGrant: "ids=*;type=target;actions=read",
+				Warnings: []string{
+					`duplicates existing grant "ids=*;type=target;actions=read"`,
+					`supersedes grant "ids=ttcp_a;actions=read" earlier in the request`,
+					`is redundant with grant "ids=*;type=target;actions=read,update" earlier in the request`,
+				},
+			},
+			{
+				Grant:          "type=target;actions=list",
+				CanonicalGrant: "type=target;actions=list",
+			},
+		}
+		assert.Equal(want, got.Grants[2:])
+
+		assert.Equal([]NewlyAllowedPermission{
+			{GrantScopeId: "p_a", Type: resource.Target, Id: "*", Actions: []string{"update", "add-grants"}},
+			{GrantScopeId: "p_a", Type: resource.Target, Actions: []string{"list"}},
+		}, got.NewlyAllowed)
+	})
+
+	t.Run("children", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		r := role
+		r.ScopeId, r.ParentScopeId = "o_a", "global"
+		r.GrantScopeIds = []string{"this", "children"}
+		r.Grants = []string{"ids=*;type=target;actions=*"}
+		got, err := ValidateGrants(ctx, r, []string{
+			"ids=ttcp_a;actions=authorize-session;client_cidrs=10.0.0.0/8",
+			"ids=*;type=*;actions=read",
+		}, lookup)
+		require.NoError(err)
+		require.Len(got.Grants, 2)
+		assert.Equal([]string{`is redundant with existing grant "ids=*;type=target;actions=*"`}, got.Grants[0].Warnings)
+		assert.Equal([]string{`overlaps with existing grant "ids=*;type=target;actions=*"`}, got.Grants[1].Warnings)
+		assert.Equal([]NewlyAllowedPermission{
+			{GrantScopeId: "o_a", Type: resource.All, Id: "*", Actions: []string{"read"}},
+			{GrantScopeId: "children", Type: resource.All, Id: "*", Actions: []string{"read"}},
+		}, got.NewlyAllowed)
+	})
+}
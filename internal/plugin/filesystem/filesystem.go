@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package filesystem provides a storage plugin which stores the objects of a
+// storage bucket in a directory of the local filesystem, so that session
+// recordings can be stored without an external object store. The plugin is
+// built into Boundary and is served in-memory, it has no credentials.
+//
+// Storage buckets are confined to a root directory configured by the operator
+// of the server. The directory of a storage bucket is the bucket name within
+// the optional root_path attribute of the storage bucket, which is relative to
+// the root directory. Object keys are paths relative to the directory of the
+// storage bucket, after the bucket prefix. Paths which would resolve outside of
+// the root directory, through ".." elements or symbolic links, are rejected.
+package filesystem
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// PluginName is the name of the filesystem storage plugin.
+	PluginName = "filesystem"
+
+	// ConstRootPath is the storage bucket attribute holding the path, relative
+	// to the root directory of the plugin, of the directory in which the
+	// storage bucket directory is created.
+	ConstRootPath = "root_path"
+
+	// defaultStreamChunkSize is the size of the chunks sent by GetObject when
+	// the request does not set one.
+	defaultStreamChunkSize = 64 * 1024
+
+	// permissionCheckKey is the key of the object written, read and deleted
+	// to check the permissions of a storage bucket.
+	permissionCheckKey = ".boundary-permission-check"
+
+	dirMode  = 0o700
+	fileMode = 0o600
+)
+
+var _ plgpb.StoragePluginServiceServer = (*FilesystemPlugin)(nil)
+
+// FilesystemPlugin is a storage plugin which stores objects in a directory of
+// the local filesystem.
+//
+// It is thread-safe.
+type FilesystemPlugin struct {
+	plgpb.UnimplementedStoragePluginServiceServer
+
+	rootDir string
+}
+
+// NewFilesystemPlugin creates a new FilesystemPlugin which stores the
+// directories of storage buckets within rootDir. When rootDir is empty, every
+// request using a storage bucket fails.
+func NewFilesystemPlugin(rootDir string) *FilesystemPlugin {
+	return &FilesystemPlugin{rootDir: rootDir}
+}
+
+// OnCreateStorageBucket creates the directory of the storage bucket and checks
+// that objects can be written, read and deleted in it.
+func (p *FilesystemPlugin) OnCreateStorageBucket(ctx context.Context, req *plgpb.OnCreateStorageBucketRequest) (*plgpb.OnCreateStorageBucketResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).OnCreateStorageBucket"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	dir, err := p.bucketDir(req.GetBucket())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	if err := checkNoSecrets(req.GetBucket()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	if err := createBucketDir(req.GetBucket(), dir); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &plgpb.OnCreateStorageBucketResponse{
+		Persisted: &storagebuckets.StorageBucketPersisted{
+			Data: &structpb.Struct{Fields: make(map[string]*structpb.Value)},
+		},
+	}, nil
+}
+
+// OnUpdateStorageBucket creates the directory of the updated storage bucket
+// and checks that objects can be written, read and deleted in it. Objects
+// are not moved when the root path or bucket name of a storage bucket changes.
+func (p *FilesystemPlugin) OnUpdateStorageBucket(ctx context.Context, req *plgpb.OnUpdateStorageBucketRequest) (*plgpb.OnUpdateStorageBucketResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).OnUpdateStorageBucket"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	dir, err := p.bucketDir(req.GetNewBucket())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	if err := checkNoSecrets(req.GetNewBucket()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	if err := createBucketDir(req.GetNewBucket(), dir); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &plgpb.OnUpdateStorageBucketResponse{
+		Persisted: &storagebuckets.StorageBucketPersisted{
+			Data: &structpb.Struct{Fields: make(map[string]*structpb.Value)},
+		},
+	}, nil
+}
+
+// OnDeleteStorageBucket validates the storage bucket. The directory of the
+// storage bucket and its objects are left on the filesystem.
+func (p *FilesystemPlugin) OnDeleteStorageBucket(ctx context.Context, req *plgpb.OnDeleteStorageBucketRequest) (*plgpb.OnDeleteStorageBucketResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).OnDeleteStorageBucket"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	if _, err := p.bucketDir(req.GetBucket()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	return &plgpb.OnDeleteStorageBucketResponse{}, nil
+}
+
+// ValidatePermissions checks that objects can be written, read and deleted in
+// the directory of the storage bucket. The returned error contains the state of
+// each permission when a check fails.
+func (p *FilesystemPlugin) ValidatePermissions(ctx context.Context, req *plgpb.ValidatePermissionsRequest) (*plgpb.ValidatePermissionsResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).ValidatePermissions"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	dir, err := p.bucketDir(req.GetBucket())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	if err := checkPermissions(req.GetBucket(), dir); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &plgpb.ValidatePermissionsResponse{}, nil
+}
+
+// HeadObject returns the size and modification time of an object.
+func (p *FilesystemPlugin) HeadObject(ctx context.Context, req *plgpb.HeadObjectRequest) (*plgpb.HeadObjectResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).HeadObject"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	objPath, err := p.objectPath(req.GetBucket(), req.GetKey())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	info, err := os.Stat(objPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, status.Errorf(codes.NotFound, "%s: object %s not found", op, req.GetKey())
+	case err != nil:
+		return nil, statusFromError(op, err)
+	case info.IsDir():
+		return nil, status.Errorf(codes.NotFound, "%s: object %s not found", op, req.GetKey())
+	}
+	return &plgpb.HeadObjectResponse{
+		ContentLength: info.Size(),
+		LastModified:  timestamppb.New(info.ModTime()),
+	}, nil
+}
+
+// GetObject streams the content of an object in chunks of the requested size.
+func (p *FilesystemPlugin) GetObject(req *plgpb.GetObjectRequest, stream plgpb.StoragePluginService_GetObjectServer) error {
+	const op = "filesystem.(FilesystemPlugin).GetObject"
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	objPath, err := p.objectPath(req.GetBucket(), req.GetKey())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	f, err := os.Open(objPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return status.Errorf(codes.NotFound, "%s: object %s not found", op, req.GetKey())
+	case err != nil:
+		return statusFromError(op, err)
+	}
+	chunkSize := req.GetChunkSize()
+	if chunkSize == 0 {
+		chunkSize = defaultStreamChunkSize
+	}
+	// The plugin is served in-memory, where the client stream is only
+	// returned once GetObject returns, so the chunks are sent in the
+	// background.
+	go func() {
+		defer f.Close()
+		buf := make([]byte, chunkSize)
+		for {
+			n, err := f.Read(buf)
+			if n > 0 {
+				if err := stream.Send(&plgpb.GetObjectResponse{
+					FileChunk: append([]byte{}, buf[:n]...),
+				}); err != nil {
+					return
+				}
+			}
+			switch {
+			case errors.Is(err, io.EOF):
+				stream.SendMsg(io.EOF)
+				return
+			case err != nil:
+				stream.SendMsg(status.Errorf(codes.Internal, "%s: failed to read object data: %v", op, err))
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// PutObject copies the file at the path of the request to an object and
+// returns the SHA256 checksum of its content. The object is written to a
+// temporary file which is renamed once complete, so a partially written object
+// is never visible.
+func (p *FilesystemPlugin) PutObject(ctx context.Context, req *plgpb.PutObjectRequest) (*plgpb.PutObjectResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).PutObject"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	if req.GetPath() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: missing path", op)
+	}
+	objPath, err := p.objectPath(req.GetBucket(), req.GetKey())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	src, err := os.Open(req.GetPath())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: file check failed: %v", op, err)
+	}
+	defer src.Close()
+	info, err := src.Stat()
+	switch {
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "%s: file check failed: %v", op, err)
+	case info.IsDir():
+		return nil, status.Errorf(codes.InvalidArgument, "%s: path is a directory", op)
+	}
+
+	checksum, err := writeObject(objPath, src)
+	if err != nil {
+		return nil, statusFromError(op, err)
+	}
+	return &plgpb.PutObjectResponse{
+		ChecksumSha_256: checksum,
+	}, nil
+}
+
+// DeleteObjects deletes the objects whose keys start with the key prefix of
+// the request when recursive is set, or the object whose key is the key prefix
+// otherwise. Directories left empty are removed.
+func (p *FilesystemPlugin) DeleteObjects(ctx context.Context, req *plgpb.DeleteObjectsRequest) (*plgpb.DeleteObjectsResponse, error) {
+	const op = "filesystem.(FilesystemPlugin).DeleteObjects"
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: request is nil", op)
+	}
+	if req.GetKeyPrefix() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: missing key prefix", op)
+	}
+	dir, err := p.bucketDir(req.GetBucket())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+	}
+	prefix := bucketKey(req.GetBucket(), req.GetKeyPrefix())
+	if !req.GetRecursive() {
+		objPath, err := p.objectPath(req.GetBucket(), req.GetKeyPrefix())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s: %v", op, err)
+		}
+		var deleted uint32
+		switch err := os.Remove(objPath); {
+		case err == nil:
+			deleted++
+			removeEmptyDirs(dir, filepath.Dir(objPath))
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, statusFromError(op, err)
+		}
+		return &plgpb.DeleteObjectsResponse{ObjectsDeleted: deleted}, nil
+	}
+
+	var deleted uint32
+	var emptied []string
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		if !strings.HasPrefix(filepath.ToSlash(rel), prefix) {
+			return nil
+		}
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+		deleted++
+		emptied = append(emptied, filepath.Dir(p))
+		return nil
+	})
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil, status.Errorf(codes.NotFound, "%s: bucket not found", op)
+	case err != nil:
+		return nil, statusFromError(op, err)
+	}
+	for i := len(emptied) - 1; i >= 0; i-- {
+		removeEmptyDirs(dir, emptied[i])
+	}
+	return &plgpb.DeleteObjectsResponse{
+		ObjectsDeleted: deleted,
+	}, nil
+}
+
+// bucketDir returns the directory of a storage bucket.
+func (p *FilesystemPlugin) bucketDir(bucket *storagebuckets.StorageBucket) (string, error) {
+	rel, err := bucketPath(bucket)
+	if err != nil {
+		return "", err
+	}
+	return confinedPath(p.rootDir, rel)
+}
+
+// bucketPath returns the directory of a storage bucket relative to the root
+// directory of the plugin.
+func bucketPath(bucket *storagebuckets.StorageBucket) (string, error) {
+	switch {
+	case bucket == nil:
+		return "", errors.New("missing storage bucket")
+	case bucket.GetAttributes() == nil:
+		return "", errors.New("missing attributes")
+	case bucket.GetBucketName() == "":
+		return "", errors.New("missing bucket name")
+	case strings.ContainsAny(bucket.GetBucketName(), `/\`) || !filepath.IsLocal(bucket.GetBucketName()):
+		return "", fmt.Errorf("bucket name %q is not a valid directory name", bucket.GetBucketName())
+	}
+	rootPath := bucket.GetAttributes().GetFields()[ConstRootPath].GetStringValue()
+	if rootPath != "" && !filepath.IsLocal(rootPath) {
+		return "", fmt.Errorf("%s attribute %q must be a relative path within the root directory", ConstRootPath, rootPath)
+	}
+	return filepath.Join(rootPath, bucket.GetBucketName()), nil
+}
+
+// bucketKey returns the key of an object relative to the directory of a
+// storage bucket.
+func bucketKey(bucket *storagebuckets.StorageBucket, key string) string {
+	return strings.TrimPrefix(bucket.GetBucketPrefix()+key, "/")
+}
+
+// ObjectPath returns the path on the local filesystem of the object of a
+// storage bucket with the provided key. Paths resolving outside of the root
+// directory of the plugin are rejected.
+func (p *FilesystemPlugin) ObjectPath(bucket *storagebuckets.StorageBucket, key string) (string, error) {
+	return p.objectPath(bucket, key)
+}
+
+// objectPath returns the path of an object. Keys which would resolve outside
+// of the directory of the storage bucket are rejected.
+func (p *FilesystemPlugin) objectPath(bucket *storagebuckets.StorageBucket, key string) (string, error) {
+	dir, err := bucketPath(bucket)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", errors.New("missing object key")
+	}
+	rel := filepath.FromSlash(path.Clean(bucketKey(bucket, key)))
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("object key %q is not a valid path", key)
+	}
+	return confinedPath(p.rootDir, filepath.Join(dir, rel))
+}
+
+// confinedPath returns the path of rel within rootDir, with the symbolic links
+// of its existing elements resolved. It is an error if the path resolves
+// outside of rootDir.
+func confinedPath(rootDir, rel string) (string, error) {
+	if rootDir == "" {
+		return "", errors.New("no root directory is configured for the filesystem storage plugin")
+	}
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("path %q is not within the root directory", rel)
+	}
+	root, err := filepath.EvalSymlinks(rootDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve root directory: %w", err)
+	}
+	resolved := root
+	elems := strings.Split(rel, string(filepath.Separator))
+	for i, elem := range elems {
+		next := filepath.Join(resolved, elem)
+		info, err := os.Lstat(next)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// The remaining elements do not exist yet, so they cannot be
+			// symbolic links.
+			return filepath.Join(append([]string{resolved}, elems[i:]...)...), nil
+		case err != nil:
+			return "", err
+		case info.Mode()&fs.ModeSymlink != 0:
+			if next, err = filepath.EvalSymlinks(next); err != nil {
+				return "", fmt.Errorf("failed to resolve %q: %w", rel, err)
+			}
+			if !withinDir(root, next) {
+				return "", fmt.Errorf("path %q resolves outside of the root directory", rel)
+			}
+		}
+		resolved = next
+	}
+	return resolved, nil
+}
+
+// withinDir reports whether p is dir or a path within dir. Both paths must be
+// clean.
+func withinDir(dir, p string) bool {
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, string(filepath.Separator))+string(filepath.Separator))
+}
+
+// checkNoSecrets returns an error if secrets are set on a storage bucket,
+// since objects stored on the local filesystem need no credentials.
+func checkNoSecrets(bucket *storagebuckets.StorageBucket) error {
+	if len(bucket.GetSecrets().GetFields()) > 0 {
+		return errors.New("secrets are not supported, the filesystem storage plugin has no credentials")
+	}
+	return nil
+}
+
+// createBucketDir creates the directory of a storage bucket and checks its
+// permissions.
+func createBucketDir(bucket *storagebuckets.StorageBucket, dir string) error {
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		now := timestamppb.Now()
+		errPermission := &plgpb.Permission{State: plgpb.StateType_STATE_TYPE_ERROR, ErrorDetails: err.Error(), CheckedAt: now}
+		return errorWithCredentialState(codeFromError(err), fmt.Sprintf("failed to create bucket directory: %v", err), &plgpb.StorageBucketCredentialState{
+			State: &plgpb.Permissions{
+				Write:  errPermission,
+				Read:   errPermission,
+				Delete: errPermission,
+			},
+			Version: bucket.GetVersion(),
+		})
+	}
+	return checkPermissions(bucket, dir)
+}
+
+// checkPermissions writes, reads and deletes an object in the directory of a
+// storage bucket. When a check fails, the returned error contains the state of
+// each permission. A permission which could not be checked because an earlier
+// check failed has an unknown state.
+func checkPermissions(bucket *storagebuckets.StorageBucket, dir string) error {
+	now := timestamppb.Now()
+	p := filepath.Join(dir, permissionCheckKey)
+	result := func(err error) *plgpb.Permission {
+		if err != nil {
+			return &plgpb.Permission{State: plgpb.StateType_STATE_TYPE_ERROR, ErrorDetails: err.Error(), CheckedAt: now}
+		}
+		return &plgpb.Permission{State: plgpb.StateType_STATE_TYPE_OK, CheckedAt: now}
+	}
+	unknown := &plgpb.Permission{State: plgpb.StateType_STATE_TYPE_UNKNOWN, CheckedAt: now}
+	state := &plgpb.Permissions{Write: unknown, Read: unknown, Delete: unknown}
+
+	data := []byte(now.AsTime().Format(time.RFC3339Nano))
+	writeErr := os.WriteFile(p, data, fileMode)
+	state.Write = result(writeErr)
+	checkErr := writeErr
+	if writeErr == nil {
+		got, readErr := os.ReadFile(p)
+		if readErr == nil && string(got) != string(data) {
+			readErr = errors.New("read data does not match written data")
+		}
+		state.Read = result(readErr)
+		deleteErr := os.Remove(p)
+		state.Delete = result(deleteErr)
+		checkErr = errors.Join(readErr, deleteErr)
+	}
+	if checkErr != nil {
+		return errorWithCredentialState(codeFromError(checkErr), fmt.Sprintf("failed to validate permissions: %v", checkErr), &plgpb.StorageBucketCredentialState{
+			State:   state,
+			Version: bucket.GetVersion(),
+		})
+	}
+	return nil
+}
+
+// writeObject copies r to the file at objPath and returns the SHA256
+// checksum of the data. The temporary file is created with mode 0600.
+func writeObject(objPath string, r io.Reader) ([]byte, error) {
+	if err := os.MkdirAll(filepath.Dir(objPath), dirMode); err != nil {
+		return nil, err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(objPath), "."+filepath.Base(objPath)+".*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(tmp, hash), r); err != nil {
+		_ = tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), objPath); err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
+// removeEmptyDirs removes dir and its parents while they are empty, up to but
+// excluding the directory of the storage bucket.
+func removeEmptyDirs(bucketDir, dir string) {
+	for dir != bucketDir && withinDir(bucketDir, dir) {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+func codeFromError(err error) codes.Code {
+	switch {
+	case errors.Is(err, fs.ErrPermission):
+		return codes.PermissionDenied
+	case errors.Is(err, fs.ErrNotExist):
+		return codes.NotFound
+	default:
+		return codes.Internal
+	}
+}
+
+func statusFromError(op string, err error) error {
+	return status.Errorf(codeFromError(err), "%s: %v", op, err)
+}
+
+func errorWithCredentialState(errCode codes.Code, msg string, sbcState *plgpb.StorageBucketCredentialState) error {
+	st := status.New(errCode, msg)
+	stWithDetails, stErr := st.WithDetails(sbcState)
+	if stErr == nil {
+		st = stWithDetails
+	}
+	return st.Err()
+}
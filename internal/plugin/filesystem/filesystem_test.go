@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package filesystem
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/plugin/loopback"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func testBucket(t *testing.T, rootPath, name, prefix string) *storagebuckets.StorageBucket {
+	t.Helper()
+	attrs, err := structpb.NewStruct(map[string]any{ConstRootPath: rootPath})
+	require.NoError(t, err)
+	return &storagebuckets.StorageBucket{
+		BucketName:   name,
+		BucketPrefix: prefix,
+		Attributes:   attrs,
+		Version:      1,
+	}
+}
+
+func testFile(t *testing.T, data []byte) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "object")
+	require.NoError(t, os.WriteFile(p, data, 0o600))
+	return p
+}
+
+func TestFilesystemPlugin_StorageBucket(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	p := NewFilesystemPlugin(rootDir)
+
+	require.NoError(t, os.Mkdir(filepath.Join(rootDir, "recordings"), 0o700))
+	require.NoError(t, os.Symlink(filepath.Join(rootDir, "recordings"), filepath.Join(rootDir, "inside")))
+	require.NoError(t, os.Symlink(t.TempDir(), filepath.Join(rootDir, "outside")))
+
+	tests := []struct {
+		name     string
+		plugin   *FilesystemPlugin
+		bucket   *storagebuckets.StorageBucket
+		wantCode codes.Code
+	}{
+		{
+			name:   "valid",
+			bucket: testBucket(t, "recordings", "bucket", "prefix/"),
+		},
+		{
+			name:   "empty-root-path",
+			bucket: testBucket(t, "", "bucket", ""),
+		},
+		{
+			name:   "nested-root-path",
+			bucket: testBucket(t, "recordings/nested", "bucket", ""),
+		},
+		{
+			name:   "root-path-symlink-within-root-dir",
+			bucket: testBucket(t, "inside", "other", ""),
+		},
+		{
+			name:     "missing-bucket",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "no-root-dir",
+			plugin:   NewFilesystemPlugin(""),
+			bucket:   testBucket(t, "recordings", "bucket", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "absolute-root-path",
+			bucket:   testBucket(t, t.TempDir(), "bucket", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "root-path-outside-root-dir",
+			bucket:   testBucket(t, "recordings/../..", "bucket", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "root-path-symlink-outside-root-dir",
+			bucket:   testBucket(t, "outside", "bucket", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing-bucket-name",
+			bucket:   testBucket(t, "recordings", "", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "bucket-name-outside-root-path",
+			bucket:   testBucket(t, "recordings", "..", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "bucket-name-with-separator",
+			bucket:   testBucket(t, "recordings", "a/b", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "bucket-name-symlink-outside-root-dir",
+			bucket:   testBucket(t, "", "outside", ""),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "secrets",
+			bucket: func() *storagebuckets.StorageBucket {
+				b := testBucket(t, "recordings", "bucket", "")
+				b.Secrets, _ = structpb.NewStruct(map[string]any{"password": "secret"})
+				return b
+			}(),
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			p := p
+			if tc.plugin != nil {
+				p = tc.plugin
+			}
+			createResp, err := p.OnCreateStorageBucket(ctx, &plgpb.OnCreateStorageBucketRequest{Bucket: tc.bucket})
+			if tc.wantCode != codes.OK {
+				require.Error(err)
+				assert.Equal(tc.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(err)
+			assert.Empty(createResp.GetPersisted().GetData().AsMap())
+			dir := filepath.Join(rootDir, tc.bucket.GetAttributes().GetFields()[ConstRootPath].GetStringValue(), tc.bucket.GetBucketName())
+			assert.DirExists(dir)
+
+			_, err = p.OnUpdateStorageBucket(ctx, &plgpb.OnUpdateStorageBucketRequest{CurrentBucket: tc.bucket, NewBucket: tc.bucket})
+			require.NoError(err)
+			_, err = p.ValidatePermissions(ctx, &plgpb.ValidatePermissionsRequest{Bucket: tc.bucket})
+			require.NoError(err)
+			_, err = p.OnDeleteStorageBucket(ctx, &plgpb.OnDeleteStorageBucketRequest{Bucket: tc.bucket})
+			require.NoError(err)
+			assert.NoFileExists(filepath.Join(dir, permissionCheckKey))
+		})
+	}
+}
+
+func TestFilesystemPlugin_ValidatePermissions_CredentialState(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	rootDir := t.TempDir()
+	p := NewFilesystemPlugin(rootDir)
+
+	// A file where the bucket directory should be cannot be written to.
+	require.NoError(os.WriteFile(filepath.Join(rootDir, "bucket"), nil, 0o600))
+	bucket := testBucket(t, "", "bucket", "")
+
+	_, err := p.ValidatePermissions(ctx, &plgpb.ValidatePermissionsRequest{Bucket: bucket})
+	require.Error(err)
+	st, ok := status.FromError(errors.Unwrap(err))
+	require.True(ok)
+	require.Len(st.Details(), 1)
+	state, ok := st.Details()[0].(*plgpb.StorageBucketCredentialState)
+	require.True(ok)
+	assert.Equal(uint32(1), state.GetVersion())
+	assert.Equal(plgpb.StateType_STATE_TYPE_ERROR, state.GetState().GetWrite().GetState())
+	assert.NotEmpty(state.GetState().GetWrite().GetErrorDetails())
+	assert.NotNil(state.GetState().GetWrite().GetCheckedAt())
+	assert.Equal(plgpb.StateType_STATE_TYPE_UNKNOWN, state.GetState().GetRead().GetState())
+	assert.Equal(plgpb.StateType_STATE_TYPE_UNKNOWN, state.GetState().GetDelete().GetState())
+
+	_, err = p.OnCreateStorageBucket(ctx, &plgpb.OnCreateStorageBucketRequest{Bucket: bucket})
+	require.Error(err)
+	st, ok = status.FromError(errors.Unwrap(err))
+	require.True(ok)
+	require.Len(st.Details(), 1)
+}
+
+func TestFilesystemPlugin_Objects(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	rootDir := t.TempDir()
+	p := NewFilesystemPlugin(rootDir)
+	client := loopback.NewWrappingPluginStorageClient(p)
+	bucket := testBucket(t, "recordings", "bucket", "prefix/")
+	rootPath := filepath.Join(rootDir, "recordings")
+
+	data := []byte("session recording data")
+	wantChecksum := sha256.Sum256(data)
+	keys := []string{"sr_1/sr_1.zip", "sr_1/c_1/c_1.zip", "sr_2/sr_2.zip"}
+	for _, key := range keys {
+		resp, err := client.PutObject(ctx, &plgpb.PutObjectRequest{Bucket: bucket, Key: key, Path: testFile(t, data)})
+		require.NoError(err)
+		assert.Equal(wantChecksum[:], resp.GetChecksumSha_256())
+		assert.FileExists(filepath.Join(rootPath, "bucket", "prefix", filepath.FromSlash(key)))
+	}
+
+	head, err := client.HeadObject(ctx, &plgpb.HeadObjectRequest{Bucket: bucket, Key: keys[0]})
+	require.NoError(err)
+	assert.Equal(int64(len(data)), head.GetContentLength())
+	assert.NotNil(head.GetLastModified())
+
+	_, err = client.HeadObject(ctx, &plgpb.HeadObjectRequest{Bucket: bucket, Key: "sr_3/sr_3.zip"})
+	assert.Equal(codes.NotFound, status.Code(err))
+	_, err = client.HeadObject(ctx, &plgpb.HeadObjectRequest{Bucket: bucket, Key: "../../outside"})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = client.PutObject(ctx, &plgpb.PutObjectRequest{Bucket: bucket, Key: "../../outside", Path: testFile(t, data)})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	// Keys resolving outside of the root directory through a symbolic link are
+	// rejected.
+	outside := t.TempDir()
+	require.NoError(os.Symlink(outside, filepath.Join(rootPath, "bucket", "prefix", "link")))
+	_, err = client.PutObject(ctx, &plgpb.PutObjectRequest{Bucket: bucket, Key: "link/sr_3.zip", Path: testFile(t, data)})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	assert.NoFileExists(filepath.Join(outside, "sr_3.zip"))
+	_, err = client.HeadObject(ctx, &plgpb.HeadObjectRequest{Bucket: bucket, Key: "link/sr_3.zip"})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+	require.NoError(os.Remove(filepath.Join(rootPath, "bucket", "prefix", "link")))
+
+	stream, err := client.GetObject(ctx, &plgpb.GetObjectRequest{Bucket: bucket, Key: keys[0], ChunkSize: 5})
+	require.NoError(err)
+	var got []byte
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(err)
+		assert.LessOrEqual(len(resp.GetFileChunk()), 5)
+		got = append(got, resp.GetFileChunk()...)
+	}
+	assert.Equal(data, got)
+
+	_, err = client.GetObject(ctx, &plgpb.GetObjectRequest{Bucket: bucket, Key: "sr_3/sr_3.zip"})
+	assert.Equal(codes.NotFound, status.Code(err))
+
+	del, err := client.DeleteObjects(ctx, &plgpb.DeleteObjectsRequest{Bucket: bucket, KeyPrefix: keys[2]})
+	require.NoError(err)
+	assert.Equal(uint32(1), del.GetObjectsDeleted())
+	assert.NoDirExists(filepath.Join(rootPath, "bucket", "prefix", "sr_2"))
+
+	del, err = client.DeleteObjects(ctx, &plgpb.DeleteObjectsRequest{Bucket: bucket, KeyPrefix: "sr_1/", Recursive: true})
+	require.NoError(err)
+	assert.Equal(uint32(2), del.GetObjectsDeleted())
+	assert.NoDirExists(filepath.Join(rootPath, "bucket", "prefix", "sr_1"))
+	assert.DirExists(filepath.Join(rootPath, "bucket"))
+
+	del, err = client.DeleteObjects(ctx, &plgpb.DeleteObjectsRequest{Bucket: bucket, KeyPrefix: "sr_1/", Recursive: true})
+	require.NoError(err)
+	assert.Equal(uint32(0), del.GetObjectsDeleted())
+}
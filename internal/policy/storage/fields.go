@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+// These constants are the field names used in the storage policy field mask
+const (
+	nameField                       = "Name"
+	descriptionField                = "Description"
+	retainForDaysField              = "RetainForDays"
+	retainForDaysOverridableField   = "RetainForDaysOverridable"
+	deleteAfterDaysField            = "DeleteAfterDays"
+	deleteAfterDaysOverridableField = "DeleteAfterDaysOverridable"
+	legalHoldField                  = "LegalHold"
+)
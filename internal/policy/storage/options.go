@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+import (
+	"errors"
+
+	"github.com/hashicorp/boundary/internal/pagination"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) (options, error) {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		if err := o(&opts); err != nil {
+			return opts, err
+		}
+	}
+	return opts, nil
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options) error
+
+// options = how options are represented
+type options struct {
+	withName                       string
+	withDescription                string
+	withRetainForDays              int32
+	withRetainForDaysOverridable   bool
+	withDeleteAfterDays            int32
+	withDeleteAfterDaysOverridable bool
+	withLegalHold                  bool
+	withLimit                      int
+	withStartPageAfterItem         pagination.Item
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithName provides an option to provide a name.
+func WithName(name string) Option {
+	return func(o *options) error {
+		o.withName = name
+		return nil
+	}
+}
+
+// WithDescription provides an option to provide a description.
+func WithDescription(desc string) Option {
+	return func(o *options) error {
+		o.withDescription = desc
+		return nil
+	}
+}
+
+// WithRetainForDays provides an option to provide the number of days for
+// which session recordings are retained. InfiniteRetention retains them
+// forever.
+func WithRetainForDays(days int32) Option {
+	return func(o *options) error {
+		o.withRetainForDays = days
+		return nil
+	}
+}
+
+// WithRetainForDaysOverridable provides an option to allow the retention
+// period to be overridden.
+func WithRetainForDaysOverridable(overridable bool) Option {
+	return func(o *options) error {
+		o.withRetainForDaysOverridable = overridable
+		return nil
+	}
+}
+
+// WithDeleteAfterDays provides an option to provide the number of days after
+// which session recordings are deleted.
+func WithDeleteAfterDays(days int32) Option {
+	return func(o *options) error {
+		o.withDeleteAfterDays = days
+		return nil
+	}
+}
+
+// WithDeleteAfterDaysOverridable provides an option to allow the deletion
+// period to be overridden.
+func WithDeleteAfterDaysOverridable(overridable bool) Option {
+	return func(o *options) error {
+		o.withDeleteAfterDaysOverridable = overridable
+		return nil
+	}
+}
+
+// WithLegalHold provides an option to hold the session recordings of the
+// scopes of the storage policy against deletion.
+func WithLegalHold(hold bool) Option {
+	return func(o *options) error {
+		o.withLegalHold = hold
+		return nil
+	}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are
+// returned. If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) error {
+		o.withLimit = l
+		return nil
+	}
+}
+
+// WithStartPageAfterItem is used to paginate over the results.
+// The next page will start after the provided item.
+func WithStartPageAfterItem(item pagination.Item) Option {
+	return func(o *options) error {
+		if item == nil {
+			return errors.New("item cannot be nil")
+		}
+		o.withStartPageAfterItem = item
+		return nil
+	}
+}
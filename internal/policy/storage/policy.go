@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/policy"
+	"github.com/hashicorp/boundary/internal/policy/storage/store"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"google.golang.org/protobuf/proto"
+)
+
+// InfiniteRetention is the number of retain for days of a storage policy
+// which retains session recordings forever.
+const InfiniteRetention = -1
+
+var _ policy.Policy = (*Policy)(nil)
+
+// A Policy contains a storage policy. It is owned by a global or org scope
+// and codifies how long the session recordings of the scopes it is attached
+// to are kept.
+type Policy struct {
+	*store.Policy
+	tableName string `gorm:"-"`
+}
+
+// Clone creates a clone of the Policy.
+func (p *Policy) Clone() *Policy {
+	cp := proto.Clone(p.Policy)
+	return &Policy{
+		Policy: cp.(*store.Policy),
+	}
+}
+
+// allocPolicy is just easier/better than leaking the underlying type
+// bits to the repo, since the repo needs to alloc this type quite often.
+func allocPolicy() *Policy {
+	return &Policy{
+		Policy: &store.Policy{},
+	}
+}
+
+// NewPolicy generates a new in-memory storage policy. ScopeId must be
+// non-empty. Supports the options WithName, WithDescription,
+// WithRetainForDays, WithRetainForDaysOverridable, WithDeleteAfterDays,
+// WithDeleteAfterDaysOverridable and WithLegalHold.
+func NewPolicy(ctx context.Context, scopeId string, opt ...Option) (*Policy, error) {
+	const op = "storage.NewPolicy"
+	opts, err := getOpts(opt...)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &Policy{
+		Policy: &store.Policy{
+			ScopeId:                    scopeId,
+			Name:                       opts.withName,
+			Description:                opts.withDescription,
+			RetainForDays:              opts.withRetainForDays,
+			RetainForDaysOverridable:   opts.withRetainForDaysOverridable,
+			DeleteAfterDays:            opts.withDeleteAfterDays,
+			DeleteAfterDaysOverridable: opts.withDeleteAfterDaysOverridable,
+			LegalHold:                  opts.withLegalHold,
+		},
+	}, nil
+}
+
+// GetResourceType returns the resource type of the Policy
+func (p *Policy) GetResourceType() resource.Type {
+	return resource.Policy
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (p *Policy) TableName() string {
+	if p.tableName != "" {
+		return p.tableName
+	}
+	return "policy_storage_policy"
+}
+
+// SetTableName sets the table name.
+func (p *Policy) SetTableName(tableName string) {
+	p.tableName = tableName
+}
+
+type deletedPolicy struct {
+	PublicId   string `gorm:"primary_key"`
+	DeleteTime *timestamp.Timestamp
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (p *deletedPolicy) TableName() string {
+	return "policy_storage_policy_deleted"
+}
+
+func newPolicyMetadata(p *Policy, op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{p.GetPublicId()},
+		"resource-type":      []string{"storage policy"},
+		"op-type":            []string{op.String()},
+		"scope_id":           []string{p.GetScopeId()},
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+import (
+	"context"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/policy"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+func init() {
+	globals.RegisterPrefixToResourceInfo(globals.StoragePolicyPrefix, resource.Policy, policy.Domain, Subtype)
+}
+
+// Subtype is the subtype of storage policies.
+const (
+	Subtype = globals.Subtype("storage")
+)
+
+// newPolicyId creates a new id for a storage policy.
+func newPolicyId(ctx context.Context) (string, error) {
+	const op = "storage.newPolicyId"
+	id, err := db.NewPublicId(ctx, globals.StoragePolicyPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+const (
+	estimateCount = `
+select reltuples::bigint as estimate from pg_class where oid in ('policy_storage_policy'::regclass)
+`
+)
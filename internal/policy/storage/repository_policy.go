@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/go-dbw"
+)
+
+// maxDays is the maximum number of retain for and delete after days of a
+// storage policy.
+const maxDays = 36525
+
+// validateDays checks that the retain for and delete after days of p can be
+// stored.
+func validateDays(ctx context.Context, p *Policy, op errors.Op) error {
+	switch {
+	case p.RetainForDays < InfiniteRetention:
+		return errors.New(ctx, errors.InvalidParameter, op, "retain for days must be -1 or greater")
+	case p.DeleteAfterDays < 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "delete after days must not be negative")
+	case p.RetainForDays > maxDays || p.DeleteAfterDays > maxDays:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("retain for days and delete after days must not be greater than %d", maxDays))
+	case p.RetainForDays == 0 && p.DeleteAfterDays == 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "retain for days and delete after days cannot both be zero")
+	case p.RetainForDays == InfiniteRetention && p.DeleteAfterDays != 0:
+		return errors.New(ctx, errors.InvalidParameter, op, "delete after days must be zero when retain for days is infinite")
+	case p.DeleteAfterDays != 0 && p.DeleteAfterDays < p.RetainForDays:
+		return errors.New(ctx, errors.InvalidParameter, op, "delete after days must be greater than or equal to retain for days")
+	}
+	return nil
+}
+
+// CreatePolicy inserts Policy p into the repository and returns a new Policy
+// containing the policy's PublicId. p is not changed. p must contain a valid
+// ScopeId. p must not contain a PublicId. The PublicId is generated and
+// assigned by this method. opt is ignored.
+//
+// RetainForDays and DeleteAfterDays cannot both be zero. Name and Description
+// are optional. If Name is set, it must be unique within ScopeId.
+func (r *Repository) CreatePolicy(ctx context.Context, p *Policy, _ ...Option) (*Policy, error) {
+	const op = "storage.(Repository).CreatePolicy"
+	switch {
+	case p == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil Policy")
+	case p.Policy == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Policy")
+	case p.ScopeId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	case p.PublicId != "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	}
+	if err := validateDays(ctx, p, op); err != nil {
+		return nil, err
+	}
+	p = p.Clone()
+
+	id, err := newPolicyId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	p.PublicId = id
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, p.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	metadata := newPolicyMetadata(p, oplog.OpType_OP_TYPE_CREATE)
+
+	var newPolicy *Policy
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newPolicy = p.Clone()
+			if err := w.Create(ctx, newPolicy, db.WithOplog(oplogWrapper, metadata)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope %q, the name %q is already in use", p.ScopeId, p.Name)))
+		}
+		if strings.Contains(err.Error(), "invalid scope type for storage policy creation") {
+			return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter), errors.WithMsg(fmt.Sprintf("scope %q is not a global or org scope", p.ScopeId)))
+		}
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return newPolicy, nil
+}
+
+// UpdatePolicy updates the repository entry for p.PublicId with the values
+// in p for the fields listed in fieldMask. It returns a new Policy containing
+// the updated values and a count of the number of records updated. p is not
+// changed.
+func (r *Repository) UpdatePolicy(ctx context.Context, p *Policy, version uint32, fieldMask []string, _ ...Option) (*Policy, int, error) {
+	const op = "storage.(Repository).UpdatePolicy"
+	switch {
+	case p == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil Policy")
+	case p.Policy == nil:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded Policy")
+	case p.PublicId == "":
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case len(fieldMask) == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	case version == 0:
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	}
+
+	current, err := r.LookupPolicy(ctx, p.PublicId)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if current == nil {
+		return nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("storage policy %s not found", p.PublicId))
+	}
+
+	// merged is the storage policy as it will be stored, used to validate
+	// the combination of the updated and current days.
+	merged := current.Clone()
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold(nameField, f):
+		case strings.EqualFold(descriptionField, f):
+		case strings.EqualFold(retainForDaysField, f):
+			merged.RetainForDays = p.RetainForDays
+		case strings.EqualFold(retainForDaysOverridableField, f):
+		case strings.EqualFold(deleteAfterDaysField, f):
+			merged.DeleteAfterDays = p.DeleteAfterDays
+		case strings.EqualFold(deleteAfterDaysOverridableField, f):
+		case strings.EqualFold(legalHoldField, f):
+		default:
+			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	if err := validateDays(ctx, merged, op); err != nil {
+		return nil, db.NoRowsAffected, err
+	}
+
+	dbMask, nullFields := dbw.BuildUpdatePaths(
+		map[string]any{
+			nameField:                       p.Name,
+			descriptionField:                p.Description,
+			retainForDaysField:              p.RetainForDays,
+			retainForDaysOverridableField:   p.RetainForDaysOverridable,
+			deleteAfterDaysField:            p.DeleteAfterDays,
+			deleteAfterDaysOverridableField: p.DeleteAfterDaysOverridable,
+			legalHoldField:                  p.LegalHold,
+		},
+		fieldMask,
+		[]string{
+			retainForDaysField,
+			retainForDaysOverridableField,
+			deleteAfterDaysField,
+			deleteAfterDaysOverridableField,
+			legalHoldField,
+		},
+	)
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, current.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	p = p.Clone()
+	p.ScopeId = current.ScopeId
+
+	metadata := newPolicyMetadata(p, oplog.OpType_OP_TYPE_UPDATE)
+
+	var rowsUpdated int
+	var returnedPolicy *Policy
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedPolicy = p.Clone()
+			var err error
+			rowsUpdated, err = w.Update(
+				ctx,
+				returnedPolicy,
+				dbMask,
+				nullFields,
+				db.WithOplog(oplogWrapper, metadata),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been updated")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope %s, the name %q is already in use", p.ScopeId, p.Name)))
+		}
+		return nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	return returnedPolicy, rowsUpdated, nil
+}
+
+// LookupPolicy returns the Policy for id. Returns nil, nil if no Policy is
+// found for id.
+func (r *Repository) LookupPolicy(ctx context.Context, id string, _ ...Option) (*Policy, error) {
+	const op = "storage.(Repository).LookupPolicy"
+	if id == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	p := allocPolicy()
+	p.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, p); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	return p, nil
+}
+
+// DeletePolicy deletes id from the repository returning a count of the
+// number of records deleted. Deleting a storage policy detaches it from the
+// scopes it is attached to.
+func (r *Repository) DeletePolicy(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "storage.(Repository).DeletePolicy"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	p := allocPolicy()
+	p.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, p); err != nil {
+		if errors.IsNotFoundError(err) {
+			return db.NoRowsAffected, nil
+		}
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", id)))
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, p.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	metadata := newPolicyMetadata(p, oplog.OpType_OP_TYPE_DELETE)
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			var err error
+			rowsDeleted, err = w.Delete(ctx, p.Clone(), db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", p.PublicId)))
+	}
+	return rowsDeleted, nil
+}
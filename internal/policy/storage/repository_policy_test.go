@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestRepository_CreatePolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := storage.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		scopeId     string
+		opts        []storage.Option
+		wantErrCode errors.Code
+	}{
+		{
+			name:    "valid",
+			scopeId: org.GetPublicId(),
+			opts: []storage.Option{
+				storage.WithName("valid"),
+				storage.WithDescription("desc"),
+				storage.WithRetainForDays(7),
+				storage.WithDeleteAfterDays(30),
+				storage.WithLegalHold(true),
+			},
+		},
+		{
+			name:    "valid-global-infinite",
+			scopeId: "global",
+			opts:    []storage.Option{storage.WithRetainForDays(storage.InfiniteRetention)},
+		},
+		{
+			name:        "no-scope",
+			opts:        []storage.Option{storage.WithRetainForDays(1)},
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "project-scope",
+			scopeId:     prj.GetPublicId(),
+			opts:        []storage.Option{storage.WithRetainForDays(1)},
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "both-zero",
+			scopeId:     org.GetPublicId(),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "delete-before-retain",
+			scopeId:     org.GetPublicId(),
+			opts:        []storage.Option{storage.WithRetainForDays(10), storage.WithDeleteAfterDays(5)},
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "delete-with-infinite-retention",
+			scopeId:     org.GetPublicId(),
+			opts:        []storage.Option{storage.WithRetainForDays(storage.InfiniteRetention), storage.WithDeleteAfterDays(5)},
+			wantErrCode: errors.InvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			in, err := storage.NewPolicy(ctx, tt.scopeId, tt.opts...)
+			require.NoError(err)
+			got, err := repo.CreatePolicy(ctx, in)
+			if tt.wantErrCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "want err code: %q got: %q", tt.wantErrCode, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.NotEmpty(got.GetPublicId())
+			assert.Empty(in.GetPublicId())
+			assert.Equal(uint32(1), got.GetVersion())
+			assert.Equal(in.GetRetainForDays(), got.GetRetainForDays())
+			assert.Equal(in.GetDeleteAfterDays(), got.GetDeleteAfterDays())
+			assert.Equal(in.GetLegalHold(), got.GetLegalHold())
+
+			found, err := repo.LookupPolicy(ctx, got.GetPublicId())
+			require.NoError(err)
+			assert.Empty(cmp.Diff(got.Policy, found.Policy, protocmp.Transform()))
+		})
+	}
+
+	t.Run("duplicate-name", func(t *testing.T) {
+		in, err := storage.NewPolicy(ctx, org.GetPublicId(), storage.WithName("valid"), storage.WithRetainForDays(1))
+		require.NoError(t, err)
+		_, err = repo.CreatePolicy(ctx, in)
+		assert.True(t, errors.Match(errors.T(errors.NotUnique), err))
+	})
+}
+
+func TestRepository_UpdatePolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+
+	repo, err := storage.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		in          []storage.Option
+		mask        []string
+		wantErrCode errors.Code
+	}{
+		{
+			name: "name-and-legal-hold",
+			in:   []storage.Option{storage.WithName("updated"), storage.WithLegalHold(true)},
+			mask: []string{"Name", "LegalHold"},
+		},
+		{
+			name: "days",
+			in:   []storage.Option{storage.WithRetainForDays(2), storage.WithDeleteAfterDays(3)},
+			mask: []string{"RetainForDays", "DeleteAfterDays"},
+		},
+		{
+			name: "zero-retention-with-deletion",
+			in:   []storage.Option{storage.WithDeleteAfterDays(3)},
+			mask: []string{"RetainForDays", "DeleteAfterDays"},
+		},
+		{
+			name:        "zero-deletion-and-retention",
+			mask:        []string{"RetainForDays"},
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "deletion-before-current-retention",
+			in:          []storage.Option{storage.WithDeleteAfterDays(3)},
+			mask:        []string{"DeleteAfterDays"},
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "scope-id",
+			mask:        []string{"ScopeId"},
+			wantErrCode: errors.InvalidFieldMask,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			p := storage.TestPolicy(t, conn, org.GetPublicId(), storage.WithRetainForDays(5))
+			in, err := storage.NewPolicy(ctx, "", tt.in...)
+			require.NoError(err)
+			in.PublicId = p.GetPublicId()
+
+			got, n, err := repo.UpdatePolicy(ctx, in, p.GetVersion(), tt.mask)
+			if tt.wantErrCode != 0 {
+				assert.Truef(errors.Match(errors.T(tt.wantErrCode), err), "want err code: %q got: %q", tt.wantErrCode, err)
+				assert.Zero(n)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(1, n)
+			assert.Equal(p.GetVersion()+1, got.GetVersion())
+			assert.Equal(org.GetPublicId(), got.GetScopeId())
+
+			found, err := repo.LookupPolicy(ctx, p.GetPublicId())
+			require.NoError(err)
+			assert.Equal(in.GetName(), found.GetName())
+			assert.Equal(in.GetLegalHold(), found.GetLegalHold())
+			if tt.name != "name-and-legal-hold" {
+				assert.Equal(in.GetRetainForDays(), found.GetRetainForDays())
+				assert.Equal(in.GetDeleteAfterDays(), found.GetDeleteAfterDays())
+			}
+		})
+	}
+
+	t.Run("wrong-version", func(t *testing.T) {
+		p := storage.TestPolicy(t, conn, org.GetPublicId())
+		in, err := storage.NewPolicy(ctx, "", storage.WithName("wrong-version"))
+		require.NoError(t, err)
+		in.PublicId = p.GetPublicId()
+		_, n, err := repo.UpdatePolicy(ctx, in, p.GetVersion()+1, []string{"Name"})
+		require.NoError(t, err)
+		assert.Zero(t, n)
+	})
+}
+
+func TestRepository_DeletePolicy(t *testing.T) {
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	org, _ := iam.TestScopes(t, iamRepo)
+
+	repo, err := storage.NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	p := storage.TestPolicy(t, conn, org.GetPublicId())
+	_, err = iamRepo.AttachScopeStoragePolicy(ctx, org.GetPublicId(), p.GetPublicId(), org.GetVersion())
+	require.NoError(t, err)
+
+	n, err := repo.DeletePolicy(ctx, p.GetPublicId())
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	found, err := repo.LookupPolicy(ctx, p.GetPublicId())
+	require.NoError(t, err)
+	assert.Nil(t, found)
+
+	// Deleting the storage policy detaches it from the scope.
+	scp, err := iamRepo.LookupScope(ctx, org.GetPublicId())
+	require.NoError(t, err)
+	assert.Empty(t, scp.GetStoragePolicyId())
+
+	n, err = repo.DeletePolicy(ctx, p.GetPublicId())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+}
@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPolicy creates a storage policy in the provided scope. The storage
+// policy retains session recordings for 1 day unless WithRetainForDays or
+// WithDeleteAfterDays are provided.
+func TestPolicy(t testing.TB, conn *db.DB, scopeId string, opt ...Option) *Policy {
+	t.Helper()
+	ctx := context.Background()
+	opts, err := getOpts(opt...)
+	require.NoError(t, err)
+	if opts.withRetainForDays == 0 && opts.withDeleteAfterDays == 0 {
+		opt = append(opt, WithRetainForDays(1))
+	}
+
+	p, err := NewPolicy(ctx, scopeId, opt...)
+	require.NoError(t, err)
+	p.PublicId, err = newPolicyId(ctx)
+	require.NoError(t, err)
+	require.NoError(t, db.New(conn).Create(ctx, p))
+	return p
+}
@@ -15,6 +15,9 @@ import (
 
 const deleteSessionRecordingJobName = "delete_session_recording"
 
+// NewDeleteSessionRecordingJobFn creates the job which deletes the session
+// recordings returned by the find_session_recordings_for_delete view, which
+// excludes the session recordings under legal hold.
 var NewDeleteSessionRecordingJobFn = newDeleteSessionRecordingJob
 
 type deleteSessionRecordingJob struct{}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/recording/store"
+	"google.golang.org/protobuf/proto"
+)
+
+// A LegalHold prevents the deletion of a session recording and the change of
+// its retention. It records the user who placed the hold.
+type LegalHold struct {
+	*store.LegalHold
+	tableName string `gorm:"-"`
+}
+
+func allocLegalHold() *LegalHold {
+	return &LegalHold{
+		LegalHold: &store.LegalHold{},
+	}
+}
+
+// Clone creates a clone of the LegalHold.
+func (h *LegalHold) Clone() *LegalHold {
+	cp := proto.Clone(h.LegalHold)
+	return &LegalHold{
+		LegalHold: cp.(*store.LegalHold),
+	}
+}
+
+// TableName returns the table name.
+func (h *LegalHold) TableName() string {
+	if h.tableName != "" {
+		return h.tableName
+	}
+	return "recording_session_legal_hold"
+}
+
+// SetTableName sets the table name.
+func (h *LegalHold) SetTableName(n string) {
+	h.tableName = n
+}
+
+func newLegalHoldMetadata(h *LegalHold, scopeId, userId string, op oplog.OpType) oplog.Metadata {
+	return oplog.Metadata{
+		"resource-public-id": []string{h.GetRecordingSessionId()},
+		"resource-type":      []string{"session recording legal hold"},
+		"op-type":            []string{op.String()},
+		"scope-id":           []string{scopeId},
+		"user-id":            []string{userId},
+	}
+}
+
+// LookupScopeId returns the id of the scope of the storage bucket of a session
+// recording. It returns an error with the RecordNotFound code if the session
+// recording does not exist.
+func (r *Repository) LookupScopeId(ctx context.Context, sessionRecordingId string) (string, error) {
+	const op = "recording.(Repository).LookupScopeId"
+	if sessionRecordingId == "" {
+		return "", errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+	rows, err := r.reader.Query(ctx, lookupRecordingScopeIdQuery, []any{sql.Named("public_id", sessionRecordingId)})
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var scopeId string
+	for rows.Next() {
+		if err := rows.Scan(&scopeId); err != nil {
+			return "", errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	if scopeId == "" {
+		return "", errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session recording %s not found", sessionRecordingId))
+	}
+	return scopeId, nil
+}
+
+// LookupLegalHold returns the legal hold of a session recording, or nil if the
+// session recording is not held.
+func (r *Repository) LookupLegalHold(ctx context.Context, sessionRecordingId string) (*LegalHold, error) {
+	const op = "recording.(Repository).LookupLegalHold"
+	if sessionRecordingId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+	h := allocLegalHold()
+	h.RecordingSessionId = sessionRecordingId
+	if err := r.reader.LookupById(ctx, h); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return h, nil
+}
+
+// SetLegalHold places a legal hold on a session recording on behalf of the
+// provided user, who is recorded in the oplog with the hold. The reason is
+// optional. Placing a hold on a held session recording is an error.
+func (r *Repository) SetLegalHold(ctx context.Context, sessionRecordingId, userId, reason string) (*LegalHold, error) {
+	const op = "recording.(Repository).SetLegalHold"
+	switch {
+	case sessionRecordingId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	case userId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	scopeId, err := r.LookupScopeId(ctx, sessionRecordingId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	h := allocLegalHold()
+	h.RecordingSessionId = sessionRecordingId
+	h.UserId = userId
+	h.Reason = reason
+	metadata := newLegalHoldMetadata(h, scopeId, userId, oplog.OpType_OP_TYPE_CREATE)
+
+	var newHold *LegalHold
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			newHold = h.Clone()
+			if err := w.Create(ctx, newHold, db.WithOplog(oplogWrapper, metadata)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, errors.New(ctx, errors.NotUnique, op, fmt.Sprintf("session recording %s is already under legal hold", sessionRecordingId))
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return newHold, nil
+}
+
+// RemoveLegalHold removes the legal hold of a session recording on behalf of
+// the provided user, who is recorded in the oplog. It returns the number of
+// holds removed, which is 0 if the session recording was not held.
+func (r *Repository) RemoveLegalHold(ctx context.Context, sessionRecordingId, userId string) (int, error) {
+	const op = "recording.(Repository).RemoveLegalHold"
+	switch {
+	case sessionRecordingId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	case userId == "":
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing user id")
+	}
+	scopeId, err := r.LookupScopeId(ctx, sessionRecordingId)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	oplogWrapper, err := r.kms.GetWrapper(ctx, scopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	h := allocLegalHold()
+	h.RecordingSessionId = sessionRecordingId
+	metadata := newLegalHoldMetadata(h, scopeId, userId, oplog.OpType_OP_TYPE_DELETE)
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			dh := h.Clone()
+			rowsDeleted, err = w.Delete(ctx, dh, db.WithOplog(oplogWrapper, metadata))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 legal hold would have been removed")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return rowsDeleted, nil
+}
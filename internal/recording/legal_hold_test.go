@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SetLegalHold(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	recordingId, orgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	user := iam.TestUser(t, iamRepo, orgId)
+
+	tests := []struct {
+		name        string
+		recordingId string
+		userId      string
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "missing-recording-id",
+			userId:      user.GetPublicId(),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-user-id",
+			recordingId: recordingId,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "recording-not-found",
+			recordingId: "sr_1234567890",
+			userId:      user.GetPublicId(),
+			wantErrCode: errors.RecordNotFound,
+		},
+		{
+			name:        "valid",
+			recordingId: recordingId,
+			userId:      user.GetPublicId(),
+		},
+		{
+			name:        "already-held",
+			recordingId: recordingId,
+			userId:      user.GetPublicId(),
+			wantErrCode: errors.NotUnique,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := repo.SetLegalHold(ctx, tc.recordingId, tc.userId, "litigation")
+			if tc.wantErrCode != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantErrCode), err), "want err code: %q got: %q", tc.wantErrCode, err)
+				assert.Nil(got)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.recordingId, got.GetRecordingSessionId())
+			assert.Equal(tc.userId, got.GetUserId())
+			assert.Equal("litigation", got.GetReason())
+			assert.NotNil(got.GetCreateTime())
+			assert.NoError(db.TestVerifyOplog(t, rw, tc.recordingId, db.WithOperation(oplog.OpType_OP_TYPE_CREATE), db.WithCreateNotBefore(10*time.Second)))
+
+			found, err := repo.LookupLegalHold(ctx, tc.recordingId)
+			require.NoError(err)
+			assert.Equal(got.GetUserId(), found.GetUserId())
+		})
+	}
+}
+
+func TestRepository_RemoveLegalHold(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(t, err)
+
+	recordingId, orgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	user := iam.TestUser(t, iamRepo, orgId)
+	_, err = repo.SetLegalHold(ctx, recordingId, user.GetPublicId(), "")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		recordingId string
+		userId      string
+		wantRows    int
+		wantErrCode errors.Code
+	}{
+		{
+			name:        "missing-recording-id",
+			userId:      user.GetPublicId(),
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "missing-user-id",
+			recordingId: recordingId,
+			wantErrCode: errors.InvalidParameter,
+		},
+		{
+			name:        "recording-not-found",
+			recordingId: "sr_1234567890",
+			userId:      user.GetPublicId(),
+			wantErrCode: errors.RecordNotFound,
+		},
+		{
+			name:        "valid",
+			recordingId: recordingId,
+			userId:      user.GetPublicId(),
+			wantRows:    1,
+		},
+		{
+			name:        "not-held",
+			recordingId: recordingId,
+			userId:      user.GetPublicId(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			rows, err := repo.RemoveLegalHold(ctx, tc.recordingId, tc.userId)
+			if tc.wantErrCode != 0 {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tc.wantErrCode), err), "want err code: %q got: %q", tc.wantErrCode, err)
+				return
+			}
+			require.NoError(err)
+			assert.Equal(tc.wantRows, rows)
+			if tc.wantRows > 0 {
+				assert.NoError(db.TestVerifyOplog(t, rw, tc.recordingId, db.WithOperation(oplog.OpType_OP_TYPE_DELETE), db.WithCreateNotBefore(10*time.Second)))
+			}
+			found, err := repo.LookupLegalHold(ctx, tc.recordingId)
+			require.NoError(err)
+			assert.Nil(found)
+		})
+	}
+}
+
+func TestRepository_LegalHoldTriggers(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	recordingId, orgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	user := iam.TestUser(t, iamRepo, orgId)
+	_, err = repo.SetLegalHold(ctx, recordingId, user.GetPublicId(), "")
+	require.NoError(err)
+
+	const updateRetention = `
+update recording_session
+   set retain_for_days = 1
+ where public_id = @public_id;`
+	args := []any{sql.Named("public_id", recordingId)}
+
+	// The retention of a held recording cannot be changed, and the recording
+	// cannot be marked for deletion or deleted.
+	_, err = rw.Exec(ctx, updateRetention, args)
+	require.Error(err)
+	assert.Contains(err.Error(), "cannot be changed while under legal hold")
+	_, err = rw.Exec(ctx, markRecordingDeletedQuery, args)
+	require.Error(err)
+	assert.Contains(err.Error(), "cannot be changed while under legal hold")
+	_, err = rw.Exec(ctx, deleteRecordingQuery, args)
+	require.Error(err)
+	assert.Contains(err.Error(), "is under legal hold")
+
+	_, err = repo.RemoveLegalHold(ctx, recordingId, user.GetPublicId())
+	require.NoError(err)
+
+	n, err := rw.Exec(ctx, updateRetention, args)
+	require.NoError(err)
+	assert.Equal(1, n)
+	n, err = rw.Exec(ctx, deleteRecordingQuery, args)
+	require.NoError(err)
+	assert.Equal(1, n)
+}
+
+func TestFindSessionRecordingsForDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	freeId, _ := TestSessionRecording(t, conn, wrapper, iamRepo)
+	heldId, orgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	testEndRecording(t, rw, freeId)
+	testEndRecording(t, rw, heldId)
+	user := iam.TestUser(t, iamRepo, orgId)
+	_, err = repo.SetLegalHold(ctx, heldId, user.GetPublicId(), "litigation")
+	require.NoError(err)
+
+	findForDelete := func() []string {
+		rows, err := rw.Query(ctx, "select public_id from find_session_recordings_for_delete", nil)
+		require.NoError(err)
+		defer rows.Close()
+		var ids []string
+		for rows.Next() {
+			var id string
+			require.NoError(rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(rows.Err())
+		return ids
+	}
+	assert.Equal([]string{freeId}, findForDelete())
+
+	_, err = repo.RemoveLegalHold(ctx, heldId, user.GetPublicId())
+	require.NoError(err)
+	assert.ElementsMatch([]string{freeId, heldId}, findForDelete())
+}
@@ -0,0 +1,71 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"time"
+
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+)
+
+// getOpts - iterate the inbound Options and return a struct
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		o(&opts)
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withLimit                  int
+	withFilesystemRootDir      string
+	withBsrWrapper             wrapping.Wrapper
+	withStartPageAfterLineId   string
+	withStartPageAfterLineTime time.Time
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithLimit provides an option to provide a limit. Intentionally allowing
+// negative integers. If WithLimit < 0, then unlimited results are returned.
+// If WithLimit == 0, then default limits are used for results.
+func WithLimit(l int) Option {
+	return func(o *options) {
+		o.withLimit = l
+	}
+}
+
+// WithFilesystemRootDir provides an option to set the root directory of the
+// storage buckets of the built-in filesystem storage plugin, from which the
+// search index job reads session recordings.
+func WithFilesystemRootDir(dir string) Option {
+	return func(o *options) {
+		o.withFilesystemRootDir = dir
+	}
+}
+
+// WithBsrWrapper provides an option to set the wrapper used to unwrap the
+// keys of the session recordings read by the search index job.
+func WithBsrWrapper(w wrapping.Wrapper) Option {
+	return func(o *options) {
+		o.withBsrWrapper = w
+	}
+}
+
+// WithStartPageAfterLine provides an option to return the search matches
+// following the line with the provided line ID and time, as returned by the
+// LineId and LineTime of a SearchResult.
+func WithStartPageAfterLine(lineId string, lineTime time.Time) Option {
+	return func(o *options) {
+		o.withStartPageAfterLineId = lineId
+		o.withStartPageAfterLineTime = lineTime
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+const (
+	listRecordingsToIndexQuery = `
+  select rs.public_id
+    from recording_session rs
+   where rs.state = 'available'
+     and not exists (
+           select 1
+             from recording_search_index rsi
+            where rsi.recording_session_id = rs.public_id
+         )
+     and not exists (
+           select 1
+             from recording_search_index_failure rsif
+            where rsif.recording_session_id = rs.public_id
+              and rsif.next_attempt_time > now()
+         )
+order by rs.end_time asc
+   limit @limit;
+`
+
+	// recordIndexFailureQuery doubles the delay before the next attempt to
+	// index a session recording after each failure, up to a day.
+	recordIndexFailureQuery = `
+insert into recording_search_index_failure
+  (recording_session_id, attempt_count, error_message, next_attempt_time)
+values
+  (@recording_session_id, 1, @error_message, now() + interval '10 minutes')
+on conflict (recording_session_id) do update
+  set attempt_count     = recording_search_index_failure.attempt_count + 1,
+      error_message     = excluded.error_message,
+      next_attempt_time = now() + least(
+                            interval '10 minutes' * power(2, recording_search_index_failure.attempt_count),
+                            interval '1 day'
+                          );
+`
+
+	deleteIndexFailureQuery = `
+delete from recording_search_index_failure
+ where recording_session_id = @recording_session_id;
+`
+
+	lookupRecordingBucketQuery = `
+  select p.name                         as plugin_name,
+         sb.bucket_name                 as bucket_name,
+         coalesce(sb.bucket_prefix, '') as bucket_prefix,
+         sb.attributes                  as attributes
+    from recording_session rs
+    join storage_plugin_storage_bucket sb
+      on sb.public_id = rs.storage_bucket_id
+    join plugin p
+      on p.public_id = sb.plugin_id
+   where rs.public_id = @recording_session_id;
+`
+
+	listRecordingShellChannelsQuery = `
+  select rc.public_id   as connection_recording_id,
+         rch.public_id  as channel_recording_id
+    from recording_connection rc
+    join recording_channel rch
+      on rch.recording_connection_id = rc.public_id
+    join recording_channel_ssh_session_channel rsc
+      on rsc.recording_channel_id = rch.public_id
+   where rc.recording_session_id = @recording_session_id
+     and rsc.program in ('shell', 'exec')
+order by rc.start_time asc, rch.public_id asc;
+`
+
+	searchRecordingsQuery = `
+  select rs.public_id             as session_recording_id,
+         rc.public_id             as connection_recording_id,
+         rsl.recording_channel_id as channel_recording_id,
+         rsl.line_number          as line_number,
+         sb.scope_id              as scope_id,
+         rsl.line_time            as line_time,
+         rsl.direction            as direction,
+         rsl.content              as content
+    from recording_search_line rsl
+    join recording_channel rch
+      on rch.public_id = rsl.recording_channel_id
+    join recording_connection rc
+      on rc.public_id = rch.recording_connection_id
+    join recording_session rs
+      on rs.public_id = rc.recording_session_id
+    join storage_plugin_storage_bucket sb
+      on sb.public_id = rs.storage_bucket_id
+   where sb.scope_id in @scope_ids
+     and rsl.content_tsv @@ websearch_to_tsquery('simple', @query)
+order by rsl.line_time desc, rsl.recording_channel_id asc, rsl.line_number asc
+   limit @limit;
+`
+
+	searchRecordingsPageQuery = `
+  select rs.public_id             as session_recording_id,
+         rc.public_id             as connection_recording_id,
+         rsl.recording_channel_id as channel_recording_id,
+         rsl.line_number          as line_number,
+         sb.scope_id              as scope_id,
+         rsl.line_time            as line_time,
+         rsl.direction            as direction,
+         rsl.content              as content
+    from recording_search_line rsl
+    join recording_channel rch
+      on rch.public_id = rsl.recording_channel_id
+    join recording_connection rc
+      on rc.public_id = rch.recording_connection_id
+    join recording_session rs
+      on rs.public_id = rc.recording_session_id
+    join storage_plugin_storage_bucket sb
+      on sb.public_id = rs.storage_bucket_id
+   where sb.scope_id in @scope_ids
+     and rsl.content_tsv @@ websearch_to_tsquery('simple', @query)
+     and (
+           rsl.line_time < @last_line_time
+           or (
+                rsl.line_time = @last_line_time
+                and (rsl.recording_channel_id, rsl.line_number) > (@last_channel_recording_id, @last_line_number)
+              )
+         )
+order by rsl.line_time desc, rsl.recording_channel_id asc, rsl.line_number asc
+   limit @limit;
+`
+
+	listRecordingsToEnforceQuery = `
+  select rsr.recording_session_id      as session_recording_id,
+         rsr.scope_id                  as scope_id,
+         rsr.delete_time is not null   as marked
+    from recording_session_retention rsr
+   where not rsr.held
+     and (rsr.retain_until is null or rsr.retain_until <= now())
+     and (
+           (rsr.delete_time is null and rsr.delete_after <= now())
+           or (@include_marked and rsr.delete_time <= now())
+         )
+     and not exists (
+           select 1
+             from recording_retention_failure rrf
+            where rrf.recording_session_id = rsr.recording_session_id
+              and rrf.next_attempt_time > now()
+         )
+order by rsr.end_time asc, rsr.recording_session_id asc
+   limit @limit;
+`
+
+	countHeldRecordingsQuery = `
+  select rsr.scope_id as scope_id,
+         count(*)     as recordings_held
+    from recording_session_retention rsr
+   where rsr.held
+     and rsr.delete_time is null
+     and rsr.delete_after <= now()
+     and rsr.scope_id in @scope_ids
+group by rsr.scope_id;
+`
+
+	// recordRetentionFailureQuery doubles the delay before the next attempt
+	// to delete a session recording after each failure, up to a day.
+	recordRetentionFailureQuery = `
+insert into recording_retention_failure
+  (recording_session_id, attempt_count, error_message, next_attempt_time)
+values
+  (@recording_session_id, 1, @error_message, now() + interval '10 minutes')
+on conflict (recording_session_id) do update
+  set attempt_count     = recording_retention_failure.attempt_count + 1,
+      error_message     = excluded.error_message,
+      next_attempt_time = now() + least(
+                            interval '10 minutes' * power(2, recording_retention_failure.attempt_count),
+                            interval '1 day'
+                          );
+`
+
+	deleteRetentionReportsQuery = `
+  delete from recording_retention_report
+   where create_time < @before;
+`
+
+	markRecordingDeletedQuery = `
+  update recording_session
+     set delete_time = now()
+   where public_id = @public_id
+     and delete_time is null;
+`
+
+	deleteRecordingQuery = `
+  delete from recording_session
+   where public_id = @public_id;
+`
+
+	listRetentionReportsQuery = `
+  select public_id,
+         scope_id,
+         create_time,
+         recordings_evaluated,
+         recordings_held,
+         recordings_marked,
+         recordings_deleted,
+         recordings_failed
+    from recording_retention_report
+   where scope_id in @scope_ids
+order by create_time desc, public_id desc
+   limit @limit;
+`
+
+	lookupRecordingScopeIdQuery = `
+  select sb.scope_id
+    from recording_session rs
+    join storage_plugin_storage_bucket sb
+      on sb.public_id = rs.storage_bucket_id
+   where rs.public_id = @public_id;
+`
+)
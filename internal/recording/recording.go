@@ -14,13 +14,16 @@ import (
 	"github.com/hashicorp/boundary/internal/scheduler"
 )
 
-// RegisterJob registers the delete session recording job with the provided scheduler.
+// RegisterJob registers the delete session recording, the search index and the
+// storage policy enforcement jobs with the provided scheduler. The options are
+// passed to NewSearchIndexJobFn.
 func RegisterJob(ctx context.Context,
 	s *scheduler.Scheduler,
 	r db.Reader,
 	w db.Writer,
 	controllerExt globals.ControllerExtension,
 	kms kms.GetWrapperer,
+	opt ...Option,
 ) error {
 	const op = "storage.RegisterJob"
 	switch {
@@ -42,5 +45,21 @@ func RegisterJob(ctx context.Context,
 		return errors.Wrap(ctx, err, op)
 	}
 
+	siJob, err := NewSearchIndexJobFn(ctx, r, w, controllerExt, kms, opt...)
+	if err != nil {
+		return fmt.Errorf("error creating session recording search index job: %w", err)
+	}
+	if err := s.RegisterJob(ctx, siJob); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	spJob, err := NewStoragePolicyJobFn(ctx, r, w, controllerExt, kms)
+	if err != nil {
+		return fmt.Errorf("error creating storage policy enforcement job: %w", err)
+	}
+	if err := s.RegisterJob(ctx, spJob); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
 	return nil
 }
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// RetentionResult is the result of the enforcement of a storage policy on a
+// session recording.
+type RetentionResult string
+
+const (
+	// RetentionHeld is the result for a session recording which was not
+	// deleted because of a legal hold.
+	RetentionHeld RetentionResult = "held"
+	// RetentionMarked is the result for a session recording which was marked
+	// as deleted. Its BSR files are deleted from its storage bucket later.
+	RetentionMarked RetentionResult = "marked"
+	// RetentionDeleted is the result for a session recording whose BSR files
+	// were deleted from its storage bucket.
+	RetentionDeleted RetentionResult = "deleted"
+	// RetentionFailed is the result for a session recording which could not
+	// be marked as deleted or deleted.
+	RetentionFailed RetentionResult = "failed"
+)
+
+// RetentionReport is the report of the enforcement of the storage policies on
+// the session recordings stored in the storage buckets of a scope.
+type RetentionReport struct {
+	PublicId   string
+	ScopeId    string
+	CreateTime time.Time
+	// RecordingsEvaluated is the number of session recordings whose deletion
+	// date had passed.
+	RecordingsEvaluated int
+	RecordingsHeld      int
+	RecordingsMarked    int
+	RecordingsDeleted   int
+	RecordingsFailed    int
+	Items               []*RetentionReportItem
+}
+
+// RetentionReportItem is the result of the enforcement of a storage policy on
+// a session recording.
+type RetentionReportItem struct {
+	SessionRecordingId string
+	Result             RetentionResult
+	// Details is the reason the session recording was held or could not be
+	// deleted.
+	Details string
+}
+
+// addItem adds an item to the report and updates its counters.
+func (r *RetentionReport) addItem(item *RetentionReportItem) {
+	r.Items = append(r.Items, item)
+	switch item.Result {
+	case RetentionHeld:
+		r.RecordingsHeld++
+	case RetentionMarked:
+		r.RecordingsMarked++
+	case RetentionDeleted:
+		r.RecordingsDeleted++
+	case RetentionFailed:
+		r.RecordingsFailed++
+	}
+}
+
+// retentionReport is the storage representation of a RetentionReport.
+type retentionReport struct {
+	PublicId            string               `gorm:"primary_key"`
+	ScopeId             string               `gorm:"not_null"`
+	CreateTime          *timestamp.Timestamp `gorm:"default:current_timestamp"`
+	RecordingsEvaluated int
+	RecordingsHeld      int
+	RecordingsMarked    int
+	RecordingsDeleted   int
+	RecordingsFailed    int
+}
+
+// TableName returns the table name.
+func (*retentionReport) TableName() string { return "recording_retention_report" }
+
+// retentionReportItem is the storage representation of a RetentionReportItem.
+type retentionReportItem struct {
+	ReportId           string `gorm:"primary_key"`
+	RecordingSessionId string `gorm:"primary_key"`
+	Result             string
+	Details            string `gorm:"default:null"`
+}
+
+// TableName returns the table name.
+func (*retentionReportItem) TableName() string { return "recording_retention_report_item" }
+
+// retentionCandidate is a session recording whose deletion date has passed
+// and which is not held against deletion.
+type retentionCandidate struct {
+	SessionRecordingId string
+	// ScopeId is the scope of the storage bucket of the session recording.
+	ScopeId string
+	// Marked is set if the session recording has already been marked as
+	// deleted.
+	Marked bool
+}
+
+// heldCount is the number of held session recordings of a scope whose
+// deletion date has passed.
+type heldCount struct {
+	ScopeId        string
+	RecordingsHeld int
+}
+
+// ListRetentionReports returns the retention reports of the provided scopes,
+// most recent first. Supports the options:
+//   - WithLimit, which overrides the default limit of the repository.
+func (r *Repository) ListRetentionReports(ctx context.Context, scopeIds []string, opt ...Option) ([]*RetentionReport, error) {
+	const op = "recording.(Repository).ListRetentionReports"
+	if len(scopeIds) == 0 {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		limit = opts.withLimit
+	}
+
+	rows, err := r.reader.Query(ctx, listRetentionReportsQuery, []any{
+		sql.Named("scope_ids", scopeIds),
+		sql.Named("limit", queryLimit(limit)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var reports []*RetentionReport
+	byId := make(map[string]*RetentionReport)
+	for rows.Next() {
+		var rr retentionReport
+		if err := r.reader.ScanRows(ctx, rows, &rr); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		report := &RetentionReport{
+			PublicId:            rr.PublicId,
+			ScopeId:             rr.ScopeId,
+			CreateTime:          rr.CreateTime.AsTime(),
+			RecordingsEvaluated: rr.RecordingsEvaluated,
+			RecordingsHeld:      rr.RecordingsHeld,
+			RecordingsMarked:    rr.RecordingsMarked,
+			RecordingsDeleted:   rr.RecordingsDeleted,
+			RecordingsFailed:    rr.RecordingsFailed,
+		}
+		reports = append(reports, report)
+		byId[report.PublicId] = report
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(reports) == 0 {
+		return nil, nil
+	}
+
+	reportIds := make([]string, 0, len(reports))
+	for _, report := range reports {
+		reportIds = append(reportIds, report.PublicId)
+	}
+	var items []*retentionReportItem
+	if err := r.reader.SearchWhere(ctx, &items, "report_id in (?)", []any{reportIds}, db.WithOrder("report_id, recording_session_id"), db.WithLimit(-1)); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	for _, item := range items {
+		report, ok := byId[item.ReportId]
+		if !ok {
+			continue
+		}
+		report.Items = append(report.Items, &RetentionReportItem{
+			SessionRecordingId: item.RecordingSessionId,
+			Result:             RetentionResult(item.Result),
+			Details:            item.Details,
+		})
+	}
+	return reports, nil
+}
+
+// listRecordingsToEnforce returns the session recordings whose deletion date,
+// according to the current storage policy of their scope, has passed and which
+// have not been marked as deleted, the oldest first. If includeMarked is set,
+// the session recordings which have been marked as deleted are also returned.
+// Held session recordings, session recordings which must still be retained and
+// session recordings whose next attempt after a failure is not due are not
+// returned.
+func (r *Repository) listRecordingsToEnforce(ctx context.Context, includeMarked bool, opt ...Option) ([]*retentionCandidate, error) {
+	const op = "recording.(Repository).listRecordingsToEnforce"
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		limit = opts.withLimit
+	}
+
+	rows, err := r.reader.Query(ctx, listRecordingsToEnforceQuery, []any{
+		sql.Named("include_marked", includeMarked),
+		sql.Named("limit", queryLimit(limit)),
+	})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var candidates []*retentionCandidate
+	for rows.Next() {
+		var c retentionCandidate
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		candidates = append(candidates, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return candidates, nil
+}
+
+// countHeldRecordings returns the number of held session recordings whose
+// deletion date has passed, by scope, for the provided scopes.
+func (r *Repository) countHeldRecordings(ctx context.Context, scopeIds []string) (map[string]int, error) {
+	const op = "recording.(Repository).countHeldRecordings"
+	if len(scopeIds) == 0 {
+		return nil, nil
+	}
+
+	rows, err := r.reader.Query(ctx, countHeldRecordingsQuery, []any{sql.Named("scope_ids", scopeIds)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var c heldCount
+		if err := r.reader.ScanRows(ctx, rows, &c); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		counts[c.ScopeId] = c.RecordingsHeld
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return counts, nil
+}
+
+// recordRetentionFailure records that a session recording could not be
+// deleted, so that it is skipped by listRecordingsToEnforce until its next
+// attempt is due. The delay before the next attempt grows with each failure.
+func (r *Repository) recordRetentionFailure(ctx context.Context, sessionRecordingId string, retentionErr error) error {
+	const op = "recording.(Repository).recordRetentionFailure"
+	switch {
+	case sessionRecordingId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	case retentionErr == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing error")
+	}
+
+	if _, err := r.writer.Exec(ctx, recordRetentionFailureQuery, []any{
+		sql.Named("recording_session_id", sessionRecordingId),
+		sql.Named("error_message", retentionErr.Error()),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return nil
+}
+
+// deleteRetentionReports deletes the retention reports created before the
+// provided time, with their items, and returns the number of deleted reports.
+func (r *Repository) deleteRetentionReports(ctx context.Context, before time.Time) (int, error) {
+	const op = "recording.(Repository).deleteRetentionReports"
+	if before.IsZero() {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "missing time")
+	}
+	n, err := r.writer.Exec(ctx, deleteRetentionReportsQuery, []any{sql.Named("before", before)})
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	return n, nil
+}
+
+// markRecordingDeleted sets the delete time of a session recording, which
+// hides it from the session recordings API. A session recording which has
+// already been marked as deleted is left unchanged.
+func (r *Repository) markRecordingDeleted(ctx context.Context, sessionRecordingId string) error {
+	const op = "recording.(Repository).markRecordingDeleted"
+	if sessionRecordingId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+	if _, err := r.writer.Exec(ctx, markRecordingDeletedQuery, []any{sql.Named("public_id", sessionRecordingId)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return nil
+}
+
+// deleteRecording deletes a session recording with its connections, channels
+// and search index.
+func (r *Repository) deleteRecording(ctx context.Context, sessionRecordingId string) error {
+	const op = "recording.(Repository).deleteRecording"
+	if sessionRecordingId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+	if _, err := r.writer.Exec(ctx, deleteRecordingQuery, []any{sql.Named("public_id", sessionRecordingId)}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return nil
+}
+
+// createRetentionReports writes the retention reports with their items, in a
+// single transaction. The public id of each report is set.
+func (r *Repository) createRetentionReports(ctx context.Context, reports []*RetentionReport) error {
+	const op = "recording.(Repository).createRetentionReports"
+	if len(reports) == 0 {
+		return nil
+	}
+
+	rows := make([]*retentionReport, 0, len(reports))
+	var items []*retentionReportItem
+	for _, report := range reports {
+		id, err := db.NewPublicId(ctx, globals.RetentionReportPrefix)
+		if err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		report.PublicId = id
+		rows = append(rows, &retentionReport{
+			PublicId:            id,
+			ScopeId:             report.ScopeId,
+			RecordingsEvaluated: report.RecordingsEvaluated,
+			RecordingsHeld:      report.RecordingsHeld,
+			RecordingsMarked:    report.RecordingsMarked,
+			RecordingsDeleted:   report.RecordingsDeleted,
+			RecordingsFailed:    report.RecordingsFailed,
+		})
+		for _, item := range report.Items {
+			items = append(items, &retentionReportItem{
+				ReportId:           id,
+				RecordingSessionId: item.SessionRecordingId,
+				Result:             string(item.Result),
+				Details:            item.Details,
+			})
+		}
+	}
+
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if err := w.CreateItems(ctx, rows); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(items) > 0 {
+				if err := w.CreateItems(ctx, items); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
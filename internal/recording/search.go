@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+// The directions of the lines of a recorded channel. Stdin is the data sent
+// by the client and stdout the data sent by the endpoint.
+const (
+	StdinDirection  = "stdin"
+	StdoutDirection = "stdout"
+)
+
+// SearchResult is a line of an indexed channel recording which matches a
+// search query.
+type SearchResult struct {
+	SessionRecordingId    string
+	ConnectionRecordingId string
+	ChannelRecordingId    string
+	LineNumber            int
+	// ScopeId is the scope of the storage bucket of the session recording.
+	ScopeId   string
+	LineTime  time.Time
+	Direction string
+	Content   string
+}
+
+// LineId returns the ID of the matching line, which is unique among the
+// indexed lines.
+func (r *SearchResult) LineId() string {
+	return fmt.Sprintf("%s:%d", r.ChannelRecordingId, r.LineNumber)
+}
+
+// parseLineId returns the channel recording ID and the line number of the
+// provided line ID.
+func parseLineId(lineId string) (string, int, bool) {
+	i := strings.LastIndex(lineId, ":")
+	if i < 1 {
+		return "", 0, false
+	}
+	n, err := strconv.Atoi(lineId[i+1:])
+	if err != nil || n < 0 {
+		return "", 0, false
+	}
+	return lineId[:i], n, true
+}
+
+// searchLine is a line of text extracted from a recorded channel.
+type searchLine struct {
+	RecordingChannelId string `gorm:"primary_key"`
+	LineNumber         int    `gorm:"primary_key"`
+	LineTime           *timestamp.Timestamp
+	Direction          string
+	Content            string
+}
+
+// TableName returns the table name.
+func (*searchLine) TableName() string { return "recording_search_line" }
+
+// searchIndex marks a session recording as indexed.
+type searchIndex struct {
+	RecordingSessionId string               `gorm:"primary_key"`
+	CreateTime         *timestamp.Timestamp `gorm:"default:current_timestamp"`
+}
+
+// TableName returns the table name.
+func (*searchIndex) TableName() string { return "recording_search_index" }
+
+// recordingBucket is the storage bucket of a session recording.
+type recordingBucket struct {
+	PluginName   string
+	BucketName   string
+	BucketPrefix string
+	Attributes   []byte
+}
+
+// channelRecording identifies a recorded channel of a session recording.
+type channelRecording struct {
+	ConnectionRecordingId string
+	ChannelRecordingId    string
+}
+
+// Repository is the session recording search index, retention and legal hold
+// database repository.
+type Repository struct {
+	reader db.Reader
+	writer db.Writer
+	kms    kms.GetWrapperer
+
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
+}
+
+// NewRepository creates a new Repository. Supports the options:
+//   - WithLimit, which sets a default limit on results returned by repo operations.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms kms.GetWrapperer, opt ...Option) (*Repository, error) {
+	const op = "recording.NewRepository"
+	switch {
+	case util.IsNil(r):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db reader")
+	case util.IsNil(w):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing db writer")
+	case util.IsNil(kms):
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing kms")
+	}
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+	return &Repository{
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		defaultLimit: opts.withLimit,
+	}, nil
+}
+
+// Search returns the indexed lines of the session recordings in the provided
+// scopes which match the query, most recent first. The query supports the
+// web search syntax of PostgreSQL: quoted phrases, "or" and "-" to exclude a
+// word. Supports the options:
+//   - WithLimit, which overrides the default limit of the repository.
+//   - WithStartPageAfterLine, which returns the matches following the
+//     provided line.
+func (r *Repository) Search(ctx context.Context, scopeIds []string, query string, opt ...Option) ([]*SearchResult, error) {
+	const op = "recording.(Repository).Search"
+	switch {
+	case len(scopeIds) == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids")
+	case strings.TrimSpace(query) == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing query")
+	}
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		limit = opts.withLimit
+	}
+
+	q := searchRecordingsQuery
+	args := []any{
+		sql.Named("scope_ids", scopeIds),
+		sql.Named("query", query),
+		sql.Named("limit", queryLimit(limit)),
+	}
+	if opts.withStartPageAfterLineId != "" {
+		channelId, lineNumber, ok := parseLineId(opts.withStartPageAfterLineId)
+		if !ok {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid line id %q", opts.withStartPageAfterLineId))
+		}
+		if opts.withStartPageAfterLineTime.IsZero() {
+			return nil, errors.New(ctx, errors.InvalidParameter, op, "missing line time")
+		}
+		q = searchRecordingsPageQuery
+		args = append(args,
+			sql.Named("last_line_time", opts.withStartPageAfterLineTime),
+			sql.Named("last_channel_recording_id", channelId),
+			sql.Named("last_line_number", lineNumber),
+		)
+	}
+
+	rows, err := r.reader.Query(ctx, q, args)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var results []*SearchResult
+	for rows.Next() {
+		var result SearchResult
+		if err := r.reader.ScanRows(ctx, rows, &result); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return results, nil
+}
+
+// listRecordingsToIndex returns the ids of the available session recordings
+// which have not been indexed, the oldest first.
+func (r *Repository) listRecordingsToIndex(ctx context.Context, opt ...Option) ([]string, error) {
+	const op = "recording.(Repository).listRecordingsToIndex"
+	opts := getOpts(opt...)
+	limit := r.defaultLimit
+	if opts.withLimit != 0 {
+		limit = opts.withLimit
+	}
+
+	rows, err := r.reader.Query(ctx, listRecordingsToIndexQuery, []any{sql.Named("limit", queryLimit(limit))})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ids, nil
+}
+
+// listShellChannels returns the recorded ssh session channels of a session
+// recording which run a shell or a command. The other channels do not contain
+// text which can be searched.
+func (r *Repository) listShellChannels(ctx context.Context, sessionRecordingId string) ([]*channelRecording, error) {
+	const op = "recording.(Repository).listShellChannels"
+	if sessionRecordingId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+
+	rows, err := r.reader.Query(ctx, listRecordingShellChannelsQuery, []any{sql.Named("recording_session_id", sessionRecordingId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var channels []*channelRecording
+	for rows.Next() {
+		var ch channelRecording
+		if err := r.reader.ScanRows(ctx, rows, &ch); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+		channels = append(channels, &ch)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return channels, nil
+}
+
+// indexRecording adds the lines of the channels of a session recording to the
+// search index and marks the session recording as indexed, in a single
+// transaction.
+func (r *Repository) indexRecording(ctx context.Context, sessionRecordingId string, lines []*searchLine) error {
+	const op = "recording.(Repository).indexRecording"
+	if sessionRecordingId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			if len(lines) > 0 {
+				if err := w.CreateItems(ctx, lines); err != nil {
+					return errors.Wrap(ctx, err, op)
+				}
+			}
+			if err := w.Create(ctx, &searchIndex{RecordingSessionId: sessionRecordingId}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if _, err := w.Exec(ctx, deleteIndexFailureQuery, []any{sql.Named("recording_session_id", sessionRecordingId)}); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return nil
+}
+
+// recordIndexFailure records that the lines of a session recording could not
+// be extracted, so that it is skipped by listRecordingsToIndex until its next
+// attempt is due. The delay before the next attempt grows with each failure.
+func (r *Repository) recordIndexFailure(ctx context.Context, sessionRecordingId string, indexErr error) error {
+	const op = "recording.(Repository).recordIndexFailure"
+	switch {
+	case sessionRecordingId == "":
+		return errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	case indexErr == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing error")
+	}
+
+	if _, err := r.writer.Exec(ctx, recordIndexFailureQuery, []any{
+		sql.Named("recording_session_id", sessionRecordingId),
+		sql.Named("error_message", indexErr.Error()),
+	}); err != nil {
+		return errors.Wrap(ctx, err, op, errors.WithMsg(sessionRecordingId))
+	}
+	return nil
+}
+
+// lookupRecordingBucket returns the storage bucket of a session recording.
+func (r *Repository) lookupRecordingBucket(ctx context.Context, sessionRecordingId string) (*recordingBucket, error) {
+	const op = "recording.(Repository).lookupRecordingBucket"
+	if sessionRecordingId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session recording id")
+	}
+
+	rows, err := r.reader.Query(ctx, lookupRecordingBucketQuery, []any{sql.Named("recording_session_id", sessionRecordingId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+
+	var bucket *recordingBucket
+	for rows.Next() {
+		bucket = &recordingBucket{}
+		if err := r.reader.ScanRows(ctx, rows, bucket); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if bucket == nil {
+		return nil, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("session recording %s not found", sessionRecordingId))
+	}
+	return bucket, nil
+}
+
+// queryLimit returns the value of the limit parameter of a query. A null
+// limit returns all the rows.
+func queryLimit(limit int) any {
+	if limit < 0 {
+		return nil
+	}
+	return limit
+}
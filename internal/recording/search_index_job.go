@@ -0,0 +1,288 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"bufio"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	"github.com/hashicorp/boundary/internal/bsr/convert"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+const (
+	searchIndexJobName = "session_recording_search_index"
+
+	// searchIndexBatchSize is the maximum number of session recordings
+	// indexed by a run of the search index job.
+	searchIndexBatchSize = 100
+
+	// maxTranscriptLineSize is the maximum size of a line of a transcript.
+	// Channels with longer lines cannot be indexed.
+	maxTranscriptLineSize = 1024 * 1024
+)
+
+// escapeSequences matches the ANSI escape sequences used by terminals for
+// colors, cursor movements and window titles, which are removed from the
+// indexed lines.
+var escapeSequences = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(?:\x07|\x1b\\)|\x1b[@-Z\\-_]`)
+
+// RecordingSource provides access to the BSR files of session recordings.
+type RecordingSource interface {
+	// OpenSession opens the BSR of the provided session recording.
+	OpenSession(ctx context.Context, sessionRecordingId string) (*bsr.Session, error)
+
+	// CreateTemp creates a temporary file that is removed when closed.
+	CreateTemp(ctx context.Context, p string) (storage.TempFile, error)
+}
+
+// NewSearchIndexJobFn creates the job which adds session recordings to the
+// search index. Session recordings are read from the storage buckets of the
+// built-in filesystem storage plugin, within the root directory set by
+// WithFilesystemRootDir, and their keys are unwrapped with the wrapper set by
+// WithBsrWrapper. If either option is missing, the job does not index any
+// session recording.
+var NewSearchIndexJobFn = func(ctx context.Context,
+	r db.Reader,
+	w db.Writer,
+	_ globals.ControllerExtension,
+	kms kms.GetWrapperer,
+	opt ...Option,
+) (scheduler.Job, error) {
+	const op = "recording.NewSearchIndexJobFn"
+	opts := getOpts(opt...)
+	if opts.withFilesystemRootDir == "" || util.IsNil(opts.withBsrWrapper) {
+		return NewSearchIndexJob(ctx, r, w, kms, nil)
+	}
+	repo, err := NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	source, err := newFilesystemSource(ctx, repo, opts.withFilesystemRootDir, opts.withBsrWrapper)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return NewSearchIndexJob(ctx, r, w, kms, source)
+}
+
+type searchIndexJob struct {
+	repo   *Repository
+	source RecordingSource
+
+	mu        sync.Mutex
+	total     int
+	completed int
+}
+
+// NewSearchIndexJob creates a job which extracts the lines of text of the
+// available session recordings that have not been indexed, using the provided
+// RecordingSource, and adds them to the search index. If source is nil, the
+// job does nothing.
+func NewSearchIndexJob(ctx context.Context, r db.Reader, w db.Writer, kms kms.GetWrapperer, source RecordingSource) (scheduler.Job, error) {
+	const op = "recording.NewSearchIndexJob"
+	repo, err := NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &searchIndexJob{
+		repo:   repo,
+		source: source,
+	}, nil
+}
+
+// Status reports the job’s current status.
+func (j *searchIndexJob) Status() scheduler.JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return scheduler.JobStatus{
+		Completed: j.completed,
+		Total:     j.total,
+	}
+}
+
+// Run indexes a batch of session recordings. A session recording which cannot
+// be read is recorded as failed and skipped until its next attempt is due, so
+// it does not hold back the session recordings after it.
+// The context is used to notify the job that it should exit early.
+func (j *searchIndexJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "recording.(searchIndexJob).Run"
+	j.setStatus(0, 0)
+	if util.IsNil(j.source) {
+		return nil
+	}
+
+	ids, err := j.repo.listRecordingsToIndex(ctx, WithLimit(searchIndexBatchSize))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.setStatus(0, len(ids))
+
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		lines, err := j.extractLines(ctx, id)
+		if err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to extract the lines of the session recording", "session_recording_id", id))
+			if err := j.repo.recordIndexFailure(ctx, id, err); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			j.setStatus(i+1, len(ids))
+			continue
+		}
+		if err := j.repo.indexRecording(ctx, id, lines); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		j.setStatus(i+1, len(ids))
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+// The job runs again shortly if the last run indexed a full batch of session
+// recordings, since more are likely waiting to be indexed.
+func (j *searchIndexJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.total == searchIndexBatchSize {
+		return time.Minute, nil
+	}
+	return 10 * time.Minute, nil
+}
+
+// Name is the unique name of the job.
+func (j *searchIndexJob) Name() string { return searchIndexJobName }
+
+// Description is the human-readable description of the job.
+func (j *searchIndexJob) Description() string {
+	return "Adds the text of the commands and output of Session Recordings to the search index"
+}
+
+func (j *searchIndexJob) setStatus(completed, total int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.completed = completed
+	j.total = total
+}
+
+// extractLines returns the lines of the shell and exec channels of a session
+// recording. Recordings of protocols which cannot be converted to a transcript
+// have no lines.
+func (j *searchIndexJob) extractLines(ctx context.Context, sessionRecordingId string) ([]*searchLine, error) {
+	const op = "recording.(searchIndexJob).extractLines"
+	channels, err := j.repo.listShellChannels(ctx, sessionRecordingId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if len(channels) == 0 {
+		return nil, nil
+	}
+
+	session, err := j.source.OpenSession(ctx, sessionRecordingId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to open session recording"))
+	}
+	defer session.Close(ctx)
+
+	var lines []*searchLine
+	for _, ch := range channels {
+		chLines, err := j.extractChannelLines(ctx, session, ch)
+		switch {
+		case stderrors.Is(err, convert.ErrUnsupportedProtocol):
+			return nil, nil
+		case err != nil:
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to extract lines of channel recording %s", ch.ChannelRecordingId)))
+		}
+		lines = append(lines, chLines...)
+	}
+	return lines, nil
+}
+
+func (j *searchIndexJob) extractChannelLines(ctx context.Context, session *bsr.Session, ch *channelRecording) ([]*searchLine, error) {
+	tmp, err := j.source.CreateTemp(ctx, ch.ChannelRecordingId)
+	if err != nil {
+		return nil, err
+	}
+	r, err := convert.ToTranscript(ctx, session, tmp, ch.ConnectionRecordingId, convert.WithChannelId(ch.ChannelRecordingId))
+	if err != nil {
+		_ = tmp.Close()
+		return nil, err
+	}
+	defer r.Close()
+	return parseTranscript(ch.ChannelRecordingId, r)
+}
+
+// parseTranscript returns the non-blank lines of a transcript produced by
+// convert.ToTranscript. Each line of the transcript is made of the time the
+// line started, its direction padded to 6 characters and its content,
+// separated by spaces.
+func parseTranscript(channelRecordingId string, r io.Reader) ([]*searchLine, error) {
+	const op = "recording.parseTranscript"
+	var lines []*searchLine
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxTranscriptLineSize)
+	for scanner.Scan() {
+		ts, rest, ok := strings.Cut(scanner.Text(), " ")
+		if !ok || len(rest) < 7 {
+			return nil, fmt.Errorf("%s: malformed transcript line %d", op, len(lines)+1)
+		}
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		if err != nil {
+			return nil, fmt.Errorf("%s: malformed transcript line time: %w", op, err)
+		}
+		direction := strings.TrimSpace(rest[:6])
+		switch direction {
+		case StdinDirection, StdoutDirection:
+		default:
+			return nil, fmt.Errorf("%s: unknown transcript line direction %q", op, direction)
+		}
+		content := cleanLine(rest[7:])
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+		lines = append(lines, &searchLine{
+			RecordingChannelId: channelRecordingId,
+			LineNumber:         len(lines),
+			LineTime:           timestamp.New(t),
+			Direction:          direction,
+			Content:            content,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return lines, nil
+}
+
+// cleanLine removes the escape sequences and control characters from a line
+// of a transcript and replaces invalid UTF-8, so that it can be stored as text.
+func cleanLine(l string) string {
+	l = escapeSequences.ReplaceAllString(l, "")
+	l = strings.ToValidUTF8(l, "\uFFFD")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\t':
+			return r
+		case r < ' ', r == 0x7f:
+			return -1
+		}
+		return r
+	}, l)
+}
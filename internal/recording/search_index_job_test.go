@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseTranscript(t *testing.T) {
+	t.Parallel()
+	t1 := time.Date(2023, 1, 2, 3, 4, 5, 600, time.UTC)
+	t2 := t1.Add(time.Second)
+
+	tests := []struct {
+		name       string
+		transcript string
+		want       []*searchLine
+		wantErr    string
+	}{
+		{
+			name:       "empty",
+			transcript: "",
+		},
+		{
+			name: "lines",
+			transcript: t1.Format(time.RFC3339Nano) + " stdin  ls -la\n" +
+				t1.Format(time.RFC3339Nano) + " stdout \x1b[1;34mdir\x1b[0m\tfile\n" +
+				t2.Format(time.RFC3339Nano) + " stdout  \x1b[K \n" +
+				t2.Format(time.RFC3339Nano) + " stdin  exit\r\n",
+			want: []*searchLine{
+				{RecordingChannelId: "chr_1", LineNumber: 0, LineTime: timestamp.New(t1), Direction: StdinDirection, Content: "ls -la"},
+				{RecordingChannelId: "chr_1", LineNumber: 1, LineTime: timestamp.New(t1), Direction: StdoutDirection, Content: "dir\tfile"},
+				{RecordingChannelId: "chr_1", LineNumber: 2, LineTime: timestamp.New(t2), Direction: StdinDirection, Content: "exit"},
+			},
+		},
+		{
+			name:       "malformed-line",
+			transcript: t1.Format(time.RFC3339Nano) + "\n",
+			wantErr:    "malformed transcript line 1",
+		},
+		{
+			name:       "malformed-time",
+			transcript: "yesterday stdin  ls\n",
+			wantErr:    "malformed transcript line time",
+		},
+		{
+			name:       "unknown-direction",
+			transcript: t1.Format(time.RFC3339Nano) + " stderr ls\n",
+			wantErr:    `unknown transcript line direction "stderr"`,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseTranscript("chr_1", strings.NewReader(tc.transcript))
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_cleanLine(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		line string
+		want string
+	}{
+		{name: "plain", line: "echo hello", want: "echo hello"},
+		{name: "colors", line: "\x1b[01;32muser@host\x1b[00m:~$ ls", want: "user@host:~$ ls"},
+		{name: "window-title", line: "\x1b]0;user@host: ~\x07$ ls", want: "$ ls"},
+		{name: "control-characters", line: "ls\b\x00 -l\x7f\ta", want: "ls -l\ta"},
+		{name: "invalid-utf8", line: "a\xffb", want: "a�b"},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, cleanLine(tc.line))
+		})
+	}
+}
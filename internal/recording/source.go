@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	workerrecording "github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
+	"github.com/hashicorp/boundary/internal/storage"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// filesystemSource is a RecordingSource which reads the BSR files of session
+// recordings from the directories of the storage buckets of the built-in
+// filesystem storage plugin. Session recordings stored by other storage
+// plugins cannot be read.
+type filesystemSource struct {
+	lookupBucket func(context.Context, string) (*recordingBucket, error)
+	plugin       *filesystem.FilesystemPlugin
+	keyUnwrapFn  bsrkms.KeyUnwrapCallbackFunc
+}
+
+var _ RecordingSource = (*filesystemSource)(nil)
+
+// newFilesystemSource creates a filesystemSource reading the storage buckets
+// within rootDir and unwrapping the keys of session recordings with
+// bsrWrapper.
+func newFilesystemSource(ctx context.Context, repo *Repository, rootDir string, bsrWrapper wrapping.Wrapper) (*filesystemSource, error) {
+	const op = "recording.newFilesystemSource"
+	switch {
+	case repo == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing repository")
+	case rootDir == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing root directory")
+	case bsrWrapper == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing bsr wrapper")
+	}
+	return &filesystemSource{
+		lookupBucket: repo.lookupRecordingBucket,
+		plugin:       filesystem.NewFilesystemPlugin(rootDir),
+		keyUnwrapFn:  keyUnwrapFn(bsrWrapper),
+	}, nil
+}
+
+// OpenSession opens the BSR of the provided session recording from the
+// directory of its storage bucket.
+func (s *filesystemSource) OpenSession(ctx context.Context, sessionRecordingId string) (*bsr.Session, error) {
+	const op = "recording.(filesystemSource).OpenSession"
+	b, err := s.lookupBucket(ctx, sessionRecordingId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if b.PluginName != filesystem.PluginName {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("session recordings of %s storage buckets cannot be read", b.PluginName))
+	}
+	attrs := &structpb.Struct{}
+	if err := proto.Unmarshal(b.Attributes, attrs); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal storage bucket attributes"))
+	}
+	bucket := &storagebuckets.StorageBucket{
+		BucketName:   b.BucketName,
+		BucketPrefix: b.BucketPrefix,
+		Attributes:   attrs,
+	}
+	name := bsr.GetBsrFileName(sessionRecordingId)
+	p, err := s.plugin.ObjectPath(bucket, name)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if _, err := os.Stat(p); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to find session recording"))
+	}
+	fs, err := workerrecording.NewLocalFS(ctx, filepath.Dir(p))
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	session, err := bsr.OpenSession(ctx, sessionRecordingId, fs, s.keyUnwrapFn)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return session, nil
+}
+
+// CreateTemp creates a temporary file in the temporary directory of the
+// controller.
+func (s *filesystemSource) CreateTemp(ctx context.Context, p string) (storage.TempFile, error) {
+	const op = "recording.(filesystemSource).CreateTemp"
+	f, err := os.CreateTemp("", p+".*")
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &tempFile{File: f}, nil
+}
+
+// tempFile is a storage.TempFile which is removed when closed.
+type tempFile struct {
+	*os.File
+}
+
+var _ storage.TempFile = (*tempFile)(nil)
+
+// Close closes and removes the file.
+func (f *tempFile) Close() error {
+	return stderrors.Join(f.File.Close(), os.Remove(f.Name()))
+}
+
+// WriteAndClose writes b to the file and closes it. The file is removed.
+func (f *tempFile) WriteAndClose(b []byte) (int, error) {
+	n, err := f.Write(b)
+	return n, stderrors.Join(err, f.Close())
+}
+
+// keyUnwrapFn returns a KeyUnwrapCallbackFunc which unwraps the keys of a
+// session recording using the BSR wrapper.
+func keyUnwrapFn(w wrapping.Wrapper) bsrkms.KeyUnwrapCallbackFunc {
+	unwrap := func(k *wrapping.KeyInfo) (*wrapping.KeyInfo, error) {
+		if k == nil {
+			return nil, stderrors.New("missing wrapped key")
+		}
+		blob := &wrapping.BlobInfo{}
+		if err := proto.Unmarshal(k.WrappedKey, blob); err != nil {
+			return nil, err
+		}
+		key, err := w.Decrypt(context.Background(), blob)
+		if err != nil {
+			return nil, err
+		}
+		return &wrapping.KeyInfo{
+			KeyId:       k.KeyId,
+			Key:         key,
+			KeyType:     k.KeyType,
+			KeyEncoding: k.KeyEncoding,
+			KeyPurposes: k.KeyPurposes,
+		}, nil
+	}
+	return func(wk bsrkms.WrappedKeys) (bsrkms.UnwrappedKeys, error) {
+		bsrKey, err := unwrap(wk.WrappedBsrKey)
+		if err != nil {
+			return bsrkms.UnwrappedKeys{}, fmt.Errorf("unable to unwrap bsr key: %w", err)
+		}
+		privKey, err := unwrap(wk.WrappedPrivKey)
+		if err != nil {
+			return bsrkms.UnwrappedKeys{}, fmt.Errorf("unable to unwrap private key: %w", err)
+		}
+		return bsrkms.UnwrappedKeys{BsrKey: bsrKey, PrivKey: privKey}, nil
+	}
+}
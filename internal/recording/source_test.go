@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/bsr"
+	bsrkms "github.com/hashicorp/boundary/internal/bsr/kms"
+	bsrssh "github.com/hashicorp/boundary/internal/bsr/ssh"
+	workerrecording "github.com/hashicorp/boundary/internal/daemon/worker/recording"
+	serverpb "github.com/hashicorp/boundary/internal/gen/controller/servers/services"
+	"github.com/hashicorp/boundary/internal/plugin/filesystem"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// testRecordSession records a session with an exec channel in the directory
+// dir, using w to wrap the keys of the recording.
+func testRecordSession(t *testing.T, dir string, w wrapping.Wrapper) *serverpb.SessionRecording {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+
+	fs, err := workerrecording.NewLocalFS(ctx, dir)
+	require.NoError(err)
+	m, err := workerrecording.NewManager(ctx, fs, w, func() *bsr.Worker {
+		return &bsr.Worker{PublicId: "w_1234567890", Version: "0.0.1"}
+	})
+	require.NoError(err)
+
+	sr := &serverpb.SessionRecording{
+		SessionRecordingId: "sr_1234567890",
+		SessionId:          "s_1234567890",
+		Endpoint:           "ssh://127.0.0.1:22",
+		UserId:             "u_1234567890",
+		UserScopeId:        "global",
+		TargetId:           "tssh_1234567890",
+		TargetProjectId:    "p_1234567890",
+		TargetDefaultPort:  22,
+		StorageBucketId:    "sb_1234567890",
+		Credentials: []*serverpb.SessionRecordingCredential{
+			{
+				Id:                "credup_1234567890",
+				CredentialStoreId: "csst_1234567890",
+				SourceType:        "static",
+				CredentialType:    "username_password",
+				Purpose:           "injected_application",
+				Username:          "user",
+			},
+		},
+	}
+	conn, err := m.NewConnectionRecorder(ctx, sr, "sc_1234567890")
+	require.NoError(err)
+	ch, err := conn.NewChannelRecorder(ctx, "session")
+	require.NoError(err)
+	require.NoError(ch.RecordRequest(ctx, bsr.Inbound, &ssh.Request{
+		Type:    bsrssh.ExecRequestType,
+		Payload: ssh.Marshal(struct{ Command string }{"whoami"}),
+	}))
+	_, err = io.WriteString(ch.NewDataWriter(ctx, bsr.Outbound), "root\n")
+	require.NoError(err)
+	require.NoError(conn.Close(ctx))
+	require.NoError(m.ReauthorizeAllExcept(ctx, []string{sr.GetSessionId()}))
+	return sr
+}
+
+func TestFilesystemSource_OpenSession(t *testing.T) {
+	ctx := context.Background()
+	rootDir := t.TempDir()
+	w := bsrkms.TestWrapper(t)
+	sr := testRecordSession(t, filepath.Join(rootDir, "recordings", "bucket", "prefix"), w)
+
+	attrs, err := structpb.NewStruct(map[string]any{filesystem.ConstRootPath: "recordings"})
+	require.NoError(t, err)
+	attrBytes, err := proto.Marshal(attrs)
+	require.NoError(t, err)
+	bucket := &recordingBucket{
+		PluginName:   filesystem.PluginName,
+		BucketName:   "bucket",
+		BucketPrefix: "prefix/",
+		Attributes:   attrBytes,
+	}
+
+	tests := []struct {
+		name        string
+		id          string
+		bucket      *recordingBucket
+		wrapper     wrapping.Wrapper
+		wantErr     bool
+		wantErrText string
+	}{
+		{
+			name:    "valid",
+			id:      sr.GetSessionRecordingId(),
+			bucket:  bucket,
+			wrapper: w,
+		},
+		{
+			name: "other-plugin",
+			id:   sr.GetSessionRecordingId(),
+			bucket: &recordingBucket{
+				PluginName: "aws",
+				BucketName: "bucket",
+				Attributes: attrBytes,
+			},
+			wrapper:     w,
+			wantErr:     true,
+			wantErrText: "session recordings of aws storage buckets cannot be read",
+		},
+		{
+			name:        "missing-recording",
+			id:          "sr_0000000000",
+			bucket:      bucket,
+			wrapper:     w,
+			wantErr:     true,
+			wantErrText: "unable to find session recording",
+		},
+		{
+			name:    "wrong-wrapper",
+			id:      sr.GetSessionRecordingId(),
+			bucket:  bucket,
+			wrapper: bsrkms.TestWrapper(t),
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			s := &filesystemSource{
+				lookupBucket: func(context.Context, string) (*recordingBucket, error) { return tc.bucket, nil },
+				plugin:       filesystem.NewFilesystemPlugin(rootDir),
+				keyUnwrapFn:  keyUnwrapFn(tc.wrapper),
+			}
+			session, err := s.OpenSession(ctx, tc.id)
+			if tc.wantErr {
+				require.Error(err)
+				assert.ErrorContains(err, tc.wantErrText)
+				return
+			}
+			require.NoError(err)
+			defer session.Close(ctx)
+			assert.Equal(sr.GetSessionId(), session.SessionMeta.PublicId)
+			assert.Equal(uint64(1), session.Summary.GetConnectionCount())
+		})
+	}
+}
+
+func TestFilesystemSource_CreateTemp(t *testing.T) {
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	s := &filesystemSource{}
+
+	f, err := s.CreateTemp(ctx, "chr_1234567890")
+	require.NoError(err)
+	name := f.(*tempFile).Name()
+	assert.FileExists(name)
+
+	_, err = f.WriteString("transcript")
+	require.NoError(err)
+	_, err = f.Seek(0, io.SeekStart)
+	require.NoError(err)
+	got, err := io.ReadAll(f)
+	require.NoError(err)
+	assert.Equal("transcript", string(got))
+
+	require.NoError(f.Close())
+	_, err = os.Stat(name)
+	assert.ErrorIs(err, os.ErrNotExist)
+}
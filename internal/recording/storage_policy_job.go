@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/globals"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/scheduler"
+	"github.com/hashicorp/boundary/internal/util"
+)
+
+const (
+	storagePolicyJobName = "storage_policy_enforcement"
+
+	// storagePolicyBatchSize is the maximum number of session recordings
+	// evaluated by a run of the storage policy enforcement job.
+	storagePolicyBatchSize = 1000
+
+	// retentionReportMaxAge is the age after which retention reports are
+	// deleted.
+	retentionReportMaxAge = 90 * 24 * time.Hour
+)
+
+// RecordingDeleter deletes the BSR files of session recordings from their
+// storage buckets.
+type RecordingDeleter interface {
+	// DeleteRecording deletes the BSR files of the provided session recording.
+	// Deleting a session recording whose files have already been deleted is
+	// not an error.
+	DeleteRecording(ctx context.Context, sessionRecordingId string) error
+}
+
+// NewStoragePolicyJobFn creates the job which enforces the storage policies.
+// The BSR files of session recordings are stored in storage buckets, which
+// are not available in this build, so the default job has no RecordingDeleter
+// and only marks the expired session recordings as deleted.
+var NewStoragePolicyJobFn = func(ctx context.Context,
+	r db.Reader,
+	w db.Writer,
+	_ globals.ControllerExtension,
+	kms kms.GetWrapperer,
+) (scheduler.Job, error) {
+	return NewStoragePolicyJob(ctx, r, w, kms, nil)
+}
+
+type storagePolicyJob struct {
+	repo    *Repository
+	deleter RecordingDeleter
+
+	mu        sync.Mutex
+	total     int
+	completed int
+	// progressed is set if the last run marked or deleted a session
+	// recording.
+	progressed bool
+}
+
+// NewStoragePolicyJob creates a job which enforces the storage policies on the
+// session recordings whose deletion date, according to the current storage
+// policy of their scope, has passed. Session recordings with a legal hold, or
+// stored in a scope whose storage policy has a legal hold, are left untouched
+// and only counted in the reports. Other session recordings are marked as
+// deleted and, if deleter is not nil, their BSR files are deleted using the
+// provided RecordingDeleter before the session recordings themselves are
+// deleted. Each run which evaluates session recordings writes a retention
+// report per scope, and retention reports older than 90 days are deleted.
+func NewStoragePolicyJob(ctx context.Context, r db.Reader, w db.Writer, kms kms.GetWrapperer, deleter RecordingDeleter) (scheduler.Job, error) {
+	const op = "recording.NewStoragePolicyJob"
+	repo, err := NewRepository(ctx, r, w, kms)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return &storagePolicyJob{
+		repo:    repo,
+		deleter: deleter,
+	}, nil
+}
+
+// Status reports the job’s current status.
+func (j *storagePolicyJob) Status() scheduler.JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return scheduler.JobStatus{
+		Completed: j.completed,
+		Total:     j.total,
+	}
+}
+
+// Run enforces the storage policies on a batch of session recordings, writes
+// the retention reports and deletes the old retention reports. A session
+// recording which cannot be deleted is reported as failed and skipped until its
+// next attempt is due, with a delay growing with each failure.
+// The context is used to notify the job that it should exit early.
+func (j *storagePolicyJob) Run(ctx context.Context, _ time.Duration) error {
+	const op = "recording.(storagePolicyJob).Run"
+	j.setStatus(0, 0)
+	j.setProgressed(false)
+
+	withDeleter := !util.IsNil(j.deleter)
+	candidates, err := j.repo.listRecordingsToEnforce(ctx, withDeleter, WithLimit(storagePolicyBatchSize))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	j.setStatus(0, len(candidates))
+
+	var items []*RetentionReportItem
+	for i, c := range candidates {
+		if err := ctx.Err(); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		item := j.enforce(ctx, c)
+		if item.Result != RetentionFailed {
+			j.setProgressed(true)
+		}
+		items = append(items, item)
+		j.setStatus(i+1, len(candidates))
+	}
+
+	held, err := j.repo.countHeldRecordings(ctx, reportScopeIds(candidates))
+	if err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if err := j.repo.createRetentionReports(ctx, newRetentionReports(candidates, items, held)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	if _, err := j.repo.deleteRetentionReports(ctx, time.Now().Add(-retentionReportMaxAge)); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// NextRunIn returns the duration until the next job run should be scheduled.
+// The job runs again shortly if the last run evaluated a full batch of session
+// recordings and marked or deleted some of them, since more are likely waiting
+// to be evaluated. A full batch of failures waits for the next regular run.
+func (j *storagePolicyJob) NextRunIn(_ context.Context) (time.Duration, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if j.total == storagePolicyBatchSize && j.progressed {
+		return time.Minute, nil
+	}
+	return time.Hour, nil
+}
+
+// Name is the unique name of the job.
+func (j *storagePolicyJob) Name() string { return storagePolicyJobName }
+
+// Description is the human-readable description of the job.
+func (j *storagePolicyJob) Description() string {
+	return "Deletes the Session Recordings whose retention period has passed according to their Storage Policy"
+}
+
+func (j *storagePolicyJob) setStatus(completed, total int) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.completed = completed
+	j.total = total
+}
+
+func (j *storagePolicyJob) setProgressed(progressed bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.progressed = progressed
+}
+
+// enforce applies the storage policy to a session recording and returns the
+// result.
+func (j *storagePolicyJob) enforce(ctx context.Context, c *retentionCandidate) *RetentionReportItem {
+	const op = "recording.(storagePolicyJob).enforce"
+	item := &RetentionReportItem{SessionRecordingId: c.SessionRecordingId}
+	fail := func(err error, msg string) *RetentionReportItem {
+		event.WriteError(ctx, op, err, event.WithInfoMsg(msg, "session_recording_id", c.SessionRecordingId))
+		if err := j.repo.recordRetentionFailure(ctx, c.SessionRecordingId, err); err != nil {
+			event.WriteError(ctx, op, err, event.WithInfoMsg("unable to record the retention failure", "session_recording_id", c.SessionRecordingId))
+		}
+		item.Result = RetentionFailed
+		item.Details = err.Error()
+		return item
+	}
+
+	if !c.Marked {
+		if err := j.repo.markRecordingDeleted(ctx, c.SessionRecordingId); err != nil {
+			return fail(err, "unable to mark the session recording as deleted")
+		}
+	}
+	if util.IsNil(j.deleter) {
+		item.Result = RetentionMarked
+		return item
+	}
+
+	if err := j.deleter.DeleteRecording(ctx, c.SessionRecordingId); err != nil {
+		return fail(err, "unable to delete the session recording files")
+	}
+	if err := j.repo.deleteRecording(ctx, c.SessionRecordingId); err != nil {
+		return fail(err, "unable to delete the session recording")
+	}
+	item.Result = RetentionDeleted
+	return item
+}
+
+// newRetentionReports groups the results of the enforcement of the storage
+// policies by the scope of the storage buckets of the session recordings, and
+// adds the number of held session recordings of each scope. items must be in
+// the same order as candidates. Scopes without candidates have no report.
+func newRetentionReports(candidates []*retentionCandidate, items []*RetentionReportItem, held map[string]int) []*RetentionReport {
+	var reports []*RetentionReport
+	byScope := make(map[string]*RetentionReport)
+	for i, c := range candidates {
+		report, ok := byScope[c.ScopeId]
+		if !ok {
+			report = &RetentionReport{ScopeId: c.ScopeId}
+			byScope[c.ScopeId] = report
+			reports = append(reports, report)
+		}
+		report.RecordingsEvaluated++
+		report.addItem(items[i])
+	}
+	for _, report := range reports {
+		report.RecordingsHeld = held[report.ScopeId]
+		report.RecordingsEvaluated += report.RecordingsHeld
+	}
+	return reports
+}
+
+// reportScopeIds returns the distinct scopes of the candidates.
+func reportScopeIds(candidates []*retentionCandidate) []string {
+	var ids []string
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		if !seen[c.ScopeId] {
+			seen[c.ScopeId] = true
+			ids = append(ids, c.ScopeId)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/policy/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testEndRecording ends the session recording three days ago, retaining it
+// for a day and deleting it after two days, so its deletion date has passed.
+func testEndRecording(t *testing.T, rw *db.Db, sessionRecordingId string) {
+	t.Helper()
+	_, err := rw.Exec(context.Background(), `
+update recording_session
+   set start_time        = now() - interval '4 days',
+       end_time          = now() - interval '3 days',
+       retain_for_days   = 1,
+       delete_after_days = 2
+ where public_id = @public_id;`,
+		[]any{sql.Named("public_id", sessionRecordingId)})
+	require.NoError(t, err)
+}
+
+type testRecordingDeleter struct {
+	deleted []string
+}
+
+func (d *testRecordingDeleter) DeleteRecording(_ context.Context, sessionRecordingId string) error {
+	d.deleted = append(d.deleted, sessionRecordingId)
+	return nil
+}
+
+func Test_newRetentionReports(t *testing.T) {
+	t.Parallel()
+	candidates := []*retentionCandidate{
+		{SessionRecordingId: "sr_1", ScopeId: "o_1"},
+		{SessionRecordingId: "sr_2", ScopeId: "p_1"},
+		{SessionRecordingId: "sr_3", ScopeId: "o_1", Marked: true},
+		{SessionRecordingId: "sr_4", ScopeId: "o_1"},
+	}
+	items := []*RetentionReportItem{
+		{SessionRecordingId: "sr_1", Result: RetentionMarked},
+		{SessionRecordingId: "sr_2", Result: RetentionMarked},
+		{SessionRecordingId: "sr_3", Result: RetentionDeleted},
+		{SessionRecordingId: "sr_4", Result: RetentionFailed, Details: "unavailable"},
+	}
+	held := map[string]int{"o_1": 2, "o_2": 5}
+
+	got := newRetentionReports(candidates, items, held)
+	want := []*RetentionReport{
+		{
+			ScopeId:             "o_1",
+			RecordingsEvaluated: 5,
+			RecordingsHeld:      2,
+			RecordingsMarked:    1,
+			RecordingsDeleted:   1,
+			RecordingsFailed:    1,
+			Items:               []*RetentionReportItem{items[0], items[2], items[3]},
+		},
+		{
+			ScopeId:             "p_1",
+			RecordingsEvaluated: 1,
+			RecordingsMarked:    1,
+			Items:               []*RetentionReportItem{items[1]},
+		},
+	}
+	assert.Equal(t, want, got)
+	assert.Empty(t, newRetentionReports(nil, nil, held))
+	assert.Equal(t, []string{"o_1", "p_1"}, reportScopeIds(candidates))
+}
+
+func Test_storagePolicyJob_NextRunIn(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		total      int
+		progressed bool
+		want       time.Duration
+	}{
+		{
+			name:       "full-batch",
+			total:      storagePolicyBatchSize,
+			progressed: true,
+			want:       time.Minute,
+		},
+		{
+			name:  "full-batch-of-failures",
+			total: storagePolicyBatchSize,
+			want:  time.Hour,
+		},
+		{
+			name:       "partial-batch",
+			total:      10,
+			progressed: true,
+			want:       time.Hour,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			j := &storagePolicyJob{total: tc.total, progressed: tc.progressed}
+			got, err := j.NextRunIn(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func Test_storagePolicyJob_Run(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	kmsCache := kms.TestKms(t, conn, wrapper)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, kmsCache)
+	require.NoError(err)
+
+	// freeId is not held, heldId has a legal hold and policyHeldId is stored
+	// in an org whose storage policy has a legal hold.
+	freeId, freeOrgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	heldId, heldOrgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	policyHeldId, policyHeldOrgId := TestSessionRecording(t, conn, wrapper, iamRepo)
+	for _, id := range []string{freeId, heldId, policyHeldId} {
+		testEndRecording(t, rw, id)
+	}
+	user := iam.TestUser(t, iamRepo, heldOrgId)
+	_, err = repo.SetLegalHold(ctx, heldId, user.GetPublicId(), "litigation")
+	require.NoError(err)
+	p := storage.TestPolicy(t, conn, policyHeldOrgId, storage.WithRetainForDays(1), storage.WithDeleteAfterDays(2), storage.WithLegalHold(true))
+	org, err := iamRepo.LookupScope(ctx, policyHeldOrgId)
+	require.NoError(err)
+	_, err = iamRepo.AttachScopeStoragePolicy(ctx, policyHeldOrgId, p.GetPublicId(), org.GetVersion())
+	require.NoError(err)
+
+	candidates, err := repo.listRecordingsToEnforce(ctx, true)
+	require.NoError(err)
+	assert.Equal([]*retentionCandidate{{SessionRecordingId: freeId, ScopeId: freeOrgId}}, candidates)
+	held, err := repo.countHeldRecordings(ctx, []string{freeOrgId, heldOrgId, policyHeldOrgId})
+	require.NoError(err)
+	assert.Equal(map[string]int{heldOrgId: 1, policyHeldOrgId: 1}, held)
+
+	deleter := &testRecordingDeleter{}
+	job, err := NewStoragePolicyJob(ctx, rw, rw, kmsCache, deleter)
+	require.NoError(err)
+	require.NoError(job.Run(ctx, 0))
+	assert.Equal([]string{freeId}, deleter.deleted)
+
+	var remaining []string
+	rows, err := rw.Query(ctx, "select public_id from recording_session where delete_time is null order by public_id", nil)
+	require.NoError(err)
+	defer rows.Close()
+	for rows.Next() {
+		var id string
+		require.NoError(rows.Scan(&id))
+		remaining = append(remaining, id)
+	}
+	require.NoError(rows.Err())
+	assert.ElementsMatch([]string{heldId, policyHeldId}, remaining)
+
+	reports, err := repo.ListRetentionReports(ctx, []string{freeOrgId, heldOrgId, policyHeldOrgId})
+	require.NoError(err)
+	require.Len(reports, 1)
+	assert.Equal(freeOrgId, reports[0].ScopeId)
+	assert.Equal(1, reports[0].RecordingsDeleted)
+}
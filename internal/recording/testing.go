@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package recording
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/session"
+	"github.com/hashicorp/boundary/internal/target"
+	targetssh "github.com/hashicorp/boundary/internal/target/ssh"
+	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionRecording creates a session recording of a new session to an
+// ssh target. The recording is stored in a new storage bucket of the org of
+// the target. It returns the id of the session recording and of the org.
+func TestSessionRecording(t testing.TB, conn *db.DB, wrapper wrapping.Wrapper, iamRepo *iam.Repository) (string, string) {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	composedOf := session.TestSessionParams(t, conn, wrapper, iamRepo)
+	prj, err := iamRepo.LookupScope(ctx, composedOf.ProjectId)
+	require.NoError(err)
+	orgId := prj.GetParentId()
+
+	plg := plugin.TestPlugin(t, conn, "test storage plugin", plugin.WithHostFlag(false), plugin.WithStorageFlag(true))
+	bucketId, err := db.NewPublicId(ctx, globals.PluginStorageBucketPrefix)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, `
+insert into storage_plugin_storage_bucket
+  (public_id,  scope_id,  plugin_id,  bucket_name,  worker_filter, version)
+values
+  (@public_id, @scope_id, @plugin_id, @bucket_name, 'pki',         1);`,
+		[]any{
+			sql.Named("public_id", bucketId),
+			sql.Named("scope_id", orgId),
+			sql.Named("plugin_id", plg.GetPublicId()),
+			sql.Named("bucket_name", bucketId),
+		})
+	require.NoError(err)
+
+	tar := targetssh.TestTarget(ctx, t, conn, composedOf.ProjectId, bucketId,
+		target.WithHostSources([]string{composedOf.HostSetId}),
+		target.WithDefaultPort(22),
+		target.WithStorageBucketId(bucketId),
+		target.WithEnableSessionRecording(true),
+	)
+	composedOf.TargetId = tar.GetPublicId()
+	composedOf.Endpoint = "ssh://127.0.0.1:22"
+	sess := session.TestSession(t, conn, wrapper, composedOf)
+
+	id, err := db.NewPublicId(ctx, globals.SessionRecordingPrefix)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, `
+insert into recording_session
+  (public_id,  storage_bucket_id,  session_id)
+values
+  (@public_id, @storage_bucket_id, @session_id);`,
+		[]any{
+			sql.Named("public_id", id),
+			sql.Named("storage_bucket_id", bucketId),
+			sql.Named("session_id", sess.GetPublicId()),
+		})
+	require.NoError(err)
+	return id, orgId
+}
+
+// TestIndexedChannel creates a recorded connection and channel of the session
+// recording with the provided id and adds the provided lines of the channel to
+// the search index, as stdout lines one second apart from start. It returns
+// the id of the channel recording.
+func TestIndexedChannel(t testing.TB, conn *db.DB, sessionRecordingId string, start time.Time, lines ...string) string {
+	t.Helper()
+	ctx := context.Background()
+	require := require.New(t)
+	rw := db.New(conn)
+
+	rows, err := rw.Query(ctx, "select session_id from recording_session where public_id = @public_id", []any{sql.Named("public_id", sessionRecordingId)})
+	require.NoError(err)
+	var sessionId string
+	for rows.Next() {
+		require.NoError(rows.Scan(&sessionId))
+	}
+	require.NoError(rows.Err())
+	require.NotEmpty(sessionId)
+	sc := session.TestConnection(t, conn, sessionId, "127.0.0.1", 22, "127.0.0.1", 2222, "127.0.0.1")
+
+	connectionId, err := db.NewPublicId(ctx, globals.ConnectionRecordingPrefix)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, `
+insert into recording_connection
+  (public_id,  session_id,  session_connection_id,  recording_session_id)
+values
+  (@public_id, @session_id, @session_connection_id, @recording_session_id);`,
+		[]any{
+			sql.Named("public_id", connectionId),
+			sql.Named("session_id", sessionId),
+			sql.Named("session_connection_id", sc.GetPublicId()),
+			sql.Named("recording_session_id", sessionRecordingId),
+		})
+	require.NoError(err)
+
+	channelId, err := db.NewPublicId(ctx, globals.ChannelRecordingPrefix)
+	require.NoError(err)
+	_, err = rw.Exec(ctx, `
+insert into recording_channel
+  (public_id,  recording_connection_id)
+values
+  (@public_id, @recording_connection_id);`,
+		[]any{
+			sql.Named("public_id", channelId),
+			sql.Named("recording_connection_id", connectionId),
+		})
+	require.NoError(err)
+
+	for i, l := range lines {
+		require.NoError(rw.Create(ctx, &searchLine{
+			RecordingChannelId: channelId,
+			LineNumber:         i,
+			LineTime:           timestamp.New(start.Add(time.Duration(i) * time.Second)),
+			Direction:          StdoutDirection,
+			Content:            l,
+		}))
+	}
+	return channelId
+}
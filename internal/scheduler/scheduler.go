@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/event"
 	"github.com/hashicorp/boundary/internal/scheduler/job"
+	"github.com/hashicorp/boundary/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	ua "go.uber.org/atomic"
 )
 
@@ -258,7 +260,11 @@ func (s *Scheduler) runJob(ctx context.Context, wg *sync.WaitGroup, r *job.Run)
 	go func() {
 		defer rj.cancelCtx()
 		defer wg.Done()
+		jobContext, span := tracing.Start(jobContext, "scheduler.job.Run",
+			attribute.String("boundary.job.name", j.Name()),
+			attribute.String("boundary.job.run_id", r.PrivateId))
 		runErr := j.Run(jobContext, s.interruptThreshold)
+		tracing.End(span, &runErr)
 
 		var updateErr error
 		switch {
@@ -57,3 +57,12 @@ func newConnectionStateId(ctx context.Context) (string, error) {
 	}
 	return id, nil
 }
+
+func newSessionRecordingId(ctx context.Context) (string, error) {
+	const op = "session.newSessionRecordingId"
+	id, err := db.NewPublicId(ctx, globals.SessionRecordingPrefix)
+	if err != nil {
+		return "", errors.Wrap(ctx, err, op)
+	}
+	return id, nil
+}
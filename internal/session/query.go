@@ -217,6 +217,7 @@ from
 	//	* sessions that are expired and all their connections are closed.
 	// 	* sessions that are canceling and all their connections are closed
 	//  * sessions that have exhausted their connection limit and all their connections are closed.
+	//  * sessions that are awaiting approval past their approval expiration time.
 	termSessionsUpdate = `
 with canceling_session(session_id) as
 (
@@ -227,12 +228,31 @@ with canceling_session(session_id) as
 	where
 		ss.state = 'canceling' and
 		upper(ss.active_time_range) is null
+),
+awaiting_approval_session(session_id) as
+(
+	select
+		session_id
+	from
+		session_state ss
+	where
+		ss.state = 'awaiting-approval' and
+		upper(ss.active_time_range) is null
 )
 update session us
 	set termination_reason =
 	case
 		-- timed out sessions
 		when now() > us.expiration_time then 'timed out'
+		-- sessions not approved in time
+		when now() > us.approval_expiration_time and us.public_id in(
+			select
+				session_id
+			from
+				awaiting_approval_session aas
+			where
+				us.public_id = aas.session_id
+			) then 'timed out'
 		-- canceling sessions
 		when us.public_id in(
 			select
@@ -251,6 +271,18 @@ where
 	(
 		-- expired sessions...
 		now() > us.expiration_time or
+		-- sessions not approved in time...
+		(
+			now() > us.approval_expiration_time and
+			us.public_id in (
+				select
+					session_id
+				from
+					awaiting_approval_session aas
+				where
+					us.public_id = aas.session_id
+			)
+		) or
 		-- connection limit reached...
 		(
 			-- handle unlimited connections...
@@ -482,6 +514,113 @@ delete
 `
 )
 
+// queries for the tls settings of the endpoint of a session
+const (
+	sessionTargetTlsQuery = `
+select coalesce(t.ssl_mode, 'verify-full') as ssl_mode,
+       coalesce(t.tls_ca_cert, '')         as tls_ca_cert,
+       coalesce(t.tls_server_name, '')     as tls_server_name,
+       'tls'                               as scheme,
+       false                               as allow_plaintext_credentials
+  from session s
+  join target_postgres t
+    on t.public_id = s.target_id
+ where s.public_id = @session_id
+union all
+select 'verify-full'                                  as ssl_mode,
+       coalesce(t.tls_ca_cert, '')                    as tls_ca_cert,
+       coalesce(t.tls_server_name, '')                as tls_server_name,
+       coalesce(t.scheme, 'https')                    as scheme,
+       coalesce(t.allow_plaintext_credentials, false) as allow_plaintext_credentials
+  from session s
+  join target_http t
+    on t.public_id = s.target_id
+ where s.public_id = @session_id;
+`
+)
+
+// queries for the host key verification settings of the endpoint of a session
+const (
+	sessionTargetHostKeysQuery = `
+select coalesce(t.known_hosts, '')                         as known_hosts,
+       coalesce(t.insecure_skip_host_key_verification, false) as insecure_skip_host_key_verification
+  from session s
+  join target_ssh t
+    on t.public_id = s.target_id
+ where s.public_id = @session_id;
+`
+)
+
+// queries for the session recording created when a connection is authorized
+const (
+	sessionRecordingTargetQuery = `
+select t.name              as target_name,
+       t.default_port      as target_default_port,
+       t.storage_bucket_id as storage_bucket_id,
+       u.scope_id          as user_scope_id
+  from session s
+  join target_ssh t
+    on t.public_id = s.target_id
+  join iam_user u
+    on u.public_id = s.user_id
+ where s.public_id                = @session_id
+   and t.enable_session_recording = true
+   and t.storage_bucket_id is not null;
+`
+	insertSessionRecordingQuery = `
+insert into recording_session
+  (public_id, storage_bucket_id, session_id)
+values
+  (@public_id, @storage_bucket_id, @session_id)
+on conflict (session_id) do nothing;
+`
+	sessionRecordingIdQuery = `
+select public_id
+  from recording_session
+ where session_id = @session_id;
+`
+	sessionRecordingCredentialsQuery = `
+select scs.credential_static_id as id,
+       cs.store_id              as credential_store_id,
+       'static'                 as source_type,
+       case
+         when up.public_id is not null then 'username_password'
+         when pk.public_id is not null then 'ssh_private_key'
+         else 'json'
+       end                      as credential_type,
+       scs.credential_purpose   as purpose,
+       coalesce(up.username, pk.username, '') as username
+  from session_credential_static scs
+  join credential_static cs
+    on cs.public_id = scs.credential_static_id
+  left join credential_static_username_password_credential up
+    on up.public_id = scs.credential_static_id
+  left join credential_static_ssh_private_key_credential pk
+    on pk.public_id = scs.credential_static_id
+ where scs.session_id = @session_id
+ union all
+select scd.library_id         as id,
+       cl.store_id            as credential_store_id,
+       case
+         when vsc.public_id is not null then 'vault-ssh-certificate'
+         when sca.public_id is not null then 'ssh-ca-certificate'
+         else 'vault-generic'
+       end                    as source_type,
+       cl.credential_type     as credential_type,
+       scd.credential_purpose as purpose,
+       coalesce(vsc.username, sca.username, '') as username
+  from session_credential_dynamic scd
+  join credential_library cl
+    on cl.public_id = scd.library_id
+  left join credential_vault_ssh_cert_library vsc
+    on vsc.public_id = scd.library_id
+  left join credential_ssh_ca_cert_library sca
+    on sca.public_id = scd.library_id
+ where scd.session_id = @session_id
+ order by id, purpose;
+`
+)
+
 func batchInsertSessionCredentialDynamic(creds []*DynamicCredential) (string, []any, error) {
 	if len(creds) <= 0 {
 		return "", nil, fmt.Errorf("empty slice of DynamicCredential, cannot build query")
@@ -88,9 +88,18 @@ func (r *Repository) listPermissionWhereClauses() ([]string, []any) {
 		return where, args
 	}
 
+	// Resources denied in a scope are excluded from the resources allowed in
+	// the same scope
+	deniedPatterns := make(map[string][]string)
+	for _, p := range r.permissions.Permissions {
+		if p.Action == action.List && p.Deny {
+			deniedPatterns[p.GrantScopeId] = append(deniedPatterns[p.GrantScopeId], p.ResourceIdLikePatterns()...)
+		}
+	}
+
 	inClauseCnt := 0
 	for _, p := range r.permissions.Permissions {
-		if p.Action != action.List {
+		if p.Action != action.List || p.Deny {
 			continue
 		}
 
@@ -105,6 +114,16 @@ func (r *Repository) listPermissionWhereClauses() ([]string, []any) {
 			args = append(args, sql.Named(fmt.Sprintf("public_id_%d", inClauseCnt), "{"+strings.Join(p.ResourceIds, ",")+"}"))
 		}
 
+		if patterns := deniedPatterns[p.GrantScopeId]; len(patterns) > 0 {
+			likeClauses := make([]string, 0, len(patterns))
+			for i, pattern := range patterns {
+				name := fmt.Sprintf("denied_public_id_%d_%d", inClauseCnt, i+1)
+				likeClauses = append(likeClauses, "public_id like @"+name)
+				args = append(args, sql.Named(name, pattern))
+			}
+			clauses = append(clauses, fmt.Sprintf("not (%s)", strings.Join(likeClauses, " or ")))
+		}
+
 		if p.OnlySelf {
 			inClauseCnt++
 			clauses = append(clauses, fmt.Sprintf("user_id = @user_id_%d", inClauseCnt))
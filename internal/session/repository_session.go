@@ -167,7 +167,8 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 			}
 
 			var foundStates []*State
-			// trigger will create new "Pending" state
+			// trigger will create new "Pending" state, or "Awaiting Approval"
+			// when the session requires approval
 			if foundStates, err = fetchStates(ctx, read, returnedSession.PublicId); err != nil {
 				return errors.Wrap(ctx, err, op)
 			}
@@ -178,7 +179,11 @@ func (r *Repository) CreateSession(ctx context.Context, sessionWrapper wrapping.
 				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("%d states found for new session %s", len(foundStates), returnedSession.PublicId))
 			}
 			returnedSession.States = foundStates
-			if returnedSession.States[0].Status != StatusPending {
+			wantStatus := StatusPending
+			if newSession.ApprovalRequired {
+				wantStatus = StatusAwaitingApproval
+			}
+			if returnedSession.States[0].Status != wantStatus {
 				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("new session %s state is not valid: %s", returnedSession.PublicId, returnedSession.States[0].Status))
 			}
 			return nil
@@ -503,6 +508,93 @@ func (r *Repository) CancelSession(ctx context.Context, sessionId string, sessio
 	return s, nil
 }
 
+// ApproveSession approves a session which is awaiting approval by setting its
+// approver and moving it to the pending state, after which it can be
+// activated. The approver must not be the user of the session. Sessions which
+// are not approved are terminated once they expire, or once their approval
+// expiration time has passed, and can no longer be approved after that time.
+func (r *Repository) ApproveSession(ctx context.Context, sessionId string, sessionVersion uint32, approverId string, opt ...Option) (*Session, error) {
+	const op = "session.(Repository).ApproveSession"
+	switch {
+	case sessionId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	case sessionVersion == 0:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session version")
+	case approverId == "":
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing approver id")
+	}
+	opts := getOpts(opt...)
+
+	updatedSession := AllocSession()
+	var returnedStates []*State
+	_, err := r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			foundSession := AllocSession()
+			foundSession.PublicId = sessionId
+			if err := reader.LookupById(ctx, &foundSession); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for %s", sessionId)))
+			}
+			if foundSession.UserId == approverId {
+				return errors.New(ctx, errors.InvalidParameter, op, "a session cannot be approved by its own user")
+			}
+			states, err := fetchStates(ctx, reader, sessionId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(states) == 0 || states[0].Status != StatusAwaitingApproval {
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("session %s is not awaiting approval", sessionId))
+			}
+			if exp := foundSession.ApprovalExpirationTime; exp != nil && time.Now().After(exp.GetTimestamp().AsTime()) {
+				return errors.New(ctx, errors.InvalidSessionState, op, fmt.Sprintf("approval of session %s has timed out", sessionId))
+			}
+
+			updatedSession.PublicId = sessionId
+			updatedSession.ApproverId = approverId
+			updatedSession.Version = sessionVersion + 1
+			rowsUpdated, err := w.Update(ctx, &updatedSession, []string{"ApproverId", "Version"}, nil, db.WithVersion(&sessionVersion))
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated session and %d rows updated", rowsUpdated))
+			}
+			rowsAffected, err := w.Exec(ctx, updateSessionState, []any{
+				sql.Named("session_id", sessionId),
+				sql.Named("status", StatusPending.String()),
+			})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to update session %s state to %s", sessionId, StatusPending.String())))
+			}
+			if rowsAffected != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("updated session %s to state %s and %d rows inserted (should be 1)", sessionId, StatusPending.String(), rowsAffected))
+			}
+			returnedStates, err = fetchStates(ctx, reader, sessionId)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			hostSetHost, err := fetchHostSetHost(ctx, reader, sessionId)
+			if err != nil && !errors.IsNotFoundError(err) {
+				return errors.Wrap(ctx, err, op)
+			}
+			updatedSession.HostId = hostSetHost.HostId
+			updatedSession.HostSetId = hostSetHost.HostSetId
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	if err := decrypt(ctx, r.kms, &updatedSession); err != nil && !opts.withIgnoreDecryptionFailures {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	updatedSession.States = returnedStates
+	return &updatedSession, nil
+}
+
 // TerminateCompletedSessions will terminate sessions in the repo based on:
 //   - sessions that have exhausted their connection limit and all their connections are closed.
 //   - sessions that are expired and all their connections are closed.
@@ -880,7 +972,7 @@ func (r *Repository) CheckIfNotActive(ctx context.Context, reportedSessions []st
 			for _, s := range states {
 				delete(unrecognizedSessions, s.SessionId)
 				switch s.Status {
-				case StatusPending, StatusActive:
+				case StatusAwaitingApproval, StatusPending, StatusActive:
 					continue
 				case StatusCanceling, StatusTerminated:
 				default:
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// Recording is the recording of a session. It is created when the first
+// connection of a session to an ssh target with session recording enabled is
+// authorized, and contains the information a worker needs to write the BSR.
+type Recording struct {
+	PublicId          string
+	SessionId         string
+	StorageBucketId   string
+	UserScopeId       string
+	TargetName        string
+	TargetDefaultPort uint32
+	Credentials       []*RecordingCredential
+}
+
+// RecordingCredential describes a credential or credential library used by a
+// recorded session. It never contains secret data.
+type RecordingCredential struct {
+	Id                string
+	CredentialStoreId string
+	SourceType        string
+	CredentialType    string
+	Purpose           string
+	Username          string
+}
+
+// recordingTarget holds the recording settings of the target of a session.
+type recordingTarget struct {
+	TargetName        string
+	TargetDefaultPort sql.NullInt32
+	StorageBucketId   string
+	UserScopeId       string
+}
+
+// CreateRecording creates the recording of the session if the target of the
+// session has session recording enabled, and returns it. If the session
+// already has a recording, the existing recording is returned. If session
+// recording is not enabled for the target of the session, nil is returned.
+// All options are ignored.
+func (r *Repository) CreateRecording(ctx context.Context, sessionId string, _ ...Option) (*Recording, error) {
+	const op = "session.(Repository).CreateRecording"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	newId, err := newSessionRecordingId(ctx)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+
+	var ret *Recording
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(reader db.Reader, w db.Writer) error {
+			ret = nil
+			rows, err := reader.Query(ctx, sessionRecordingTargetQuery, []any{sql.Named("session_id", sessionId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up session target"))
+			}
+			var targets []recordingTarget
+			for rows.Next() {
+				var t recordingTarget
+				if err := reader.ScanRows(ctx, rows, &t); err != nil {
+					_ = rows.Close()
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan session target"))
+				}
+				targets = append(targets, t)
+			}
+			if err := rows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if len(targets) == 0 {
+				// Session recording is not enabled for the target.
+				return nil
+			}
+			t := targets[0]
+
+			if _, err := w.Exec(ctx, insertSessionRecordingQuery, []any{
+				sql.Named("public_id", newId),
+				sql.Named("storage_bucket_id", t.StorageBucketId),
+				sql.Named("session_id", sessionId),
+			}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to create recording of session %s", sessionId)))
+			}
+
+			rec := &Recording{
+				SessionId:         sessionId,
+				StorageBucketId:   t.StorageBucketId,
+				UserScopeId:       t.UserScopeId,
+				TargetName:        t.TargetName,
+				TargetDefaultPort: uint32(t.TargetDefaultPort.Int32),
+			}
+			idRows, err := reader.Query(ctx, sessionRecordingIdQuery, []any{sql.Named("session_id", sessionId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to look up session recording"))
+			}
+			for idRows.Next() {
+				if err := idRows.Scan(&rec.PublicId); err != nil {
+					_ = idRows.Close()
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan session recording id"))
+				}
+			}
+			if err := idRows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rec.PublicId == "" {
+				return errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("recording of session %s not found", sessionId))
+			}
+
+			credRows, err := reader.Query(ctx, sessionRecordingCredentialsQuery, []any{sql.Named("session_id", sessionId)})
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to list session credentials"))
+			}
+			for credRows.Next() {
+				var c RecordingCredential
+				if err := reader.ScanRows(ctx, credRows, &c); err != nil {
+					_ = credRows.Close()
+					return errors.Wrap(ctx, err, op, errors.WithMsg("unable to scan session credential"))
+				}
+				rec.Credentials = append(rec.Credentials, &c)
+			}
+			if err := credRows.Err(); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			ret = rec
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
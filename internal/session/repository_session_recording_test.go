@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateRecording(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	rw := db.New(conn)
+	kms := kms.TestKms(t, conn, wrapper)
+	ctx := context.Background()
+	repo, err := NewRepository(ctx, rw, rw, kms)
+	require.NoError(t, err)
+
+	t.Run("missing-session-id", func(t *testing.T) {
+		got, err := repo.CreateRecording(ctx, "")
+		assert.Truef(t, errors.Match(errors.T(errors.InvalidParameter), err), "unexpected error %v", err)
+		assert.Nil(t, got)
+	})
+	t.Run("recording-not-enabled", func(t *testing.T) {
+		// The test session is for a tcp target, which is never recorded.
+		s := TestSession(t, conn, wrapper, TestSessionParams(t, conn, wrapper, iamRepo))
+		got, err := repo.CreateRecording(ctx, s.PublicId)
+		require.NoError(t, err)
+		assert.Nil(t, got)
+	})
+}
@@ -712,6 +712,11 @@ func TestRepository_CreateSession(t *testing.T) {
 				assert.NotEmpty(cred.CredentialPurpose)
 			}
 
+			// The version of each static credential the session received is
+			// recorded by the database.
+			for _, cred := range s.StaticCredentials {
+				cred.CredentialVersion = 1
+			}
 			assert.Equal(s.StaticCredentials, foundSession.StaticCredentials)
 			for _, cred := range foundSession.StaticCredentials {
 				assert.NotEmpty(cred.CredentialStaticId)
@@ -1349,6 +1354,135 @@ func TestRepository_CancelSession(t *testing.T) {
 	}
 }
 
+func TestRepository_ApproveSession(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, testKms)
+	require.NoError(t, err)
+	org, _ := iam.TestScopes(t, iamRepo)
+	approver := iam.TestUser(t, iamRepo, org.PublicId)
+	setupFn := func(approvalRequired bool) *Session {
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.ApprovalRequired = approvalRequired
+		return TestSession(t, conn, wrapper, composedOf)
+	}
+	tests := []struct {
+		name           string
+		session        *Session
+		approverId     string
+		useSessionUser bool
+		wantErr        bool
+		wantIsError    errors.Code
+	}{
+		{
+			name:       "valid",
+			session:    setupFn(true),
+			approverId: approver.PublicId,
+		},
+		{
+			name:        "not-awaiting-approval",
+			session:     setupFn(false),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.InvalidSessionState,
+		},
+		{
+			name:           "approved-by-own-user",
+			session:        setupFn(true),
+			useSessionUser: true,
+			wantErr:        true,
+			wantIsError:    errors.InvalidParameter,
+		},
+		{
+			name:        "missing-approver-id",
+			session:     setupFn(true),
+			approverId:  "",
+			wantErr:     true,
+			wantIsError: errors.InvalidParameter,
+		},
+		{
+			name: "approval-timed-out",
+			session: func() *Session {
+				composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+				composedOf.ApprovalRequired = true
+				composedOf.ApprovalExpirationTime = &timestamp.Timestamp{Timestamp: timestamppb.New(time.Now().Add(-time.Minute))}
+				return TestSession(t, conn, wrapper, composedOf)
+			}(),
+			approverId:  approver.PublicId,
+			wantErr:     true,
+			wantIsError: errors.InvalidSessionState,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			approverId := tt.approverId
+			if tt.useSessionUser {
+				approverId = tt.session.UserId
+			}
+			s, err := repo.ApproveSession(ctx, tt.session.PublicId, tt.session.Version, approverId)
+			if tt.wantErr {
+				require.Error(err)
+				assert.Truef(errors.Match(errors.T(tt.wantIsError), err), "unexpected error %s", err.Error())
+				return
+			}
+			require.NoError(err)
+			require.NotNil(s)
+			assert.Equal(approverId, s.ApproverId)
+			require.NotEmpty(s.States)
+			assert.Equal(StatusPending, s.States[0].Status)
+			assert.Equal(StatusAwaitingApproval, s.States[1].Status)
+		})
+	}
+}
+
+func TestRepository_TerminateCompletedSessions_ApprovalTimeout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	assert, require := assert.New(t), require.New(t)
+	conn, _ := db.TestSetup(t, "postgres")
+	rw := db.New(conn)
+	wrapper := db.TestWrapper(t)
+	iamRepo := iam.TestRepo(t, conn, wrapper)
+	testKms := kms.TestKms(t, conn, wrapper)
+	repo, err := NewRepository(ctx, rw, rw, testKms)
+	require.NoError(err)
+
+	setupFn := func(approvalExpiresIn time.Duration) *Session {
+		composedOf := TestSessionParams(t, conn, wrapper, iamRepo)
+		composedOf.ApprovalRequired = true
+		composedOf.ApprovalExpirationTime = &timestamp.Timestamp{Timestamp: timestamppb.New(time.Now().Add(approvalExpiresIn))}
+		s := TestSession(t, conn, wrapper, composedOf)
+		require.Equal(StatusAwaitingApproval, s.States[0].Status)
+		return s
+	}
+	timedOut := setupFn(-time.Minute)
+	awaiting := setupFn(time.Hour)
+
+	_, err = repo.TerminateCompletedSessions(ctx)
+	require.NoError(err)
+
+	// The session which was not approved in time is terminated.
+	found, _, err := repo.LookupSession(ctx, timedOut.PublicId)
+	require.NoError(err)
+	assert.Equal(TimedOut.String(), found.TerminationReason)
+	require.NotEmpty(found.States)
+	assert.Equal(StatusTerminated, found.States[0].Status)
+	assert.Equal(StatusAwaitingApproval, found.States[1].Status)
+
+	// The session which can still be approved is not terminated.
+	found, _, err = repo.LookupSession(ctx, awaiting.PublicId)
+	require.NoError(err)
+	assert.Empty(found.TerminationReason)
+	require.NotEmpty(found.States)
+	assert.Equal(StatusAwaitingApproval, found.States[0].Status)
+}
+
 func TestRepository_CancelSessionViaFKNull(t *testing.T) {
 	t.Parallel()
 	conn, _ := db.TestSetup(t, "postgres")
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// TargetHostKeys contains the settings the worker uses to verify the host key
+// of the endpoint of an ssh session. KnownHosts is in the OpenSSH known_hosts
+// format.
+type TargetHostKeys struct {
+	KnownHosts                      string
+	InsecureSkipHostKeyVerification bool
+}
+
+// LookupTargetHostKeys returns the host key verification settings of the
+// target of the session. If the target of the session is not an ssh target,
+// nil is returned. All options are ignored.
+func (r *Repository) LookupTargetHostKeys(ctx context.Context, sessionId string, _ ...Option) (*TargetHostKeys, error) {
+	const op = "session.(Repository).LookupTargetHostKeys"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	rows, err := r.reader.Query(ctx, sessionTargetHostKeysQuery, []any{sql.Named("session_id", sessionId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ret *TargetHostKeys
+	for rows.Next() {
+		ret = &TargetHostKeys{}
+		if err := r.reader.ScanRows(ctx, rows, ret); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
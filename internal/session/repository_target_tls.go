@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package session
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/hashicorp/boundary/internal/errors"
+)
+
+// TargetTls contains the settings the worker uses to verify the TLS
+// certificate of the endpoint of a session. Scheme is "tls" for targets which
+// always use TLS, otherwise it is the scheme of the target.
+// AllowPlaintextCredentials reports whether credentials may be injected when
+// the endpoint is not connected to using TLS.
+type TargetTls struct {
+	SslMode                   string
+	TlsCaCert                 string
+	TlsServerName             string
+	Scheme                    string
+	AllowPlaintextCredentials bool
+}
+
+// LookupTargetTls returns the TLS settings of the target of the session. If
+// the target of the session does not have TLS settings, nil is returned.
+// All options are ignored.
+func (r *Repository) LookupTargetTls(ctx context.Context, sessionId string, _ ...Option) (*TargetTls, error) {
+	const op = "session.(Repository).LookupTargetTls"
+	if sessionId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing session id")
+	}
+	rows, err := r.reader.Query(ctx, sessionTargetTlsQuery, []any{sql.Named("session_id", sessionId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	var ret *TargetTls
+	for rows.Next() {
+		ret = &TargetTls{}
+		if err := r.reader.ScanRows(ctx, rows, ret); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return ret, nil
+}
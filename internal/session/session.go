@@ -58,6 +58,14 @@ type ComposedOf struct {
 	WorkerFilter        string
 	EgressWorkerFilter  string
 	IngressWorkerFilter string
+	// ApprovalRequired and ApproversFilter are the approval settings of the
+	// target when the session was created. A session which requires approval
+	// starts in the awaiting-approval state.
+	ApprovalRequired bool
+	ApproversFilter  string
+	// ApprovalExpirationTime is the time after which a session which has not
+	// been approved is terminated. ApprovalExpirationTime is optional.
+	ApprovalExpirationTime *timestamp.Timestamp
 	// DynamicCredentials are dynamic credentials that will be retrieved
 	// for the session. DynamicCredentials optional.
 	DynamicCredentials []*DynamicCredential
@@ -115,6 +123,15 @@ type Session struct {
 	EgressWorkerFilter  string `json:"-" gorm:"default:null"`
 	IngressWorkerFilter string `json:"-" gorm:"default:null"`
 
+	// Approval settings of the target
+	ApprovalRequired bool   `json:"approval_required,omitempty" gorm:"default:false"`
+	ApproversFilter  string `json:"-" gorm:"default:null"`
+	// ApprovalExpirationTime - after this time a session which has not been
+	// approved is terminated
+	ApprovalExpirationTime *timestamp.Timestamp `json:"approval_expiration_time,omitempty" gorm:"default:null"`
+	// ApproverId is the id of the user who approved the session
+	ApproverId string `json:"approver_id,omitempty" gorm:"default:null"`
+
 	// key_id is the ID of the key version used to encrypt any fields in this struct
 	KeyId string `json:"key_id,omitempty" gorm:"default:null"`
 
@@ -195,22 +212,25 @@ var (
 func New(ctx context.Context, c ComposedOf, _ ...Option) (*Session, error) {
 	const op = "session.New"
 	s := Session{
-		UserId:              c.UserId,
-		HostId:              c.HostId,
-		TargetId:            c.TargetId,
-		HostSetId:           c.HostSetId,
-		AuthTokenId:         c.AuthTokenId,
-		ProjectId:           c.ProjectId,
-		Endpoint:            c.Endpoint,
-		ExpirationTime:      c.ExpirationTime,
-		ConnectionLimit:     c.ConnectionLimit,
-		WorkerFilter:        c.WorkerFilter,
-		EgressWorkerFilter:  c.EgressWorkerFilter,
-		IngressWorkerFilter: c.IngressWorkerFilter,
-		DynamicCredentials:  c.DynamicCredentials,
-		StaticCredentials:   c.StaticCredentials,
-		ProtocolWorkerId:    c.ProtocolWorkerId,
-		CorrelationId:       c.CorrelationId,
+		UserId:                 c.UserId,
+		HostId:                 c.HostId,
+		TargetId:               c.TargetId,
+		HostSetId:              c.HostSetId,
+		AuthTokenId:            c.AuthTokenId,
+		ProjectId:              c.ProjectId,
+		Endpoint:               c.Endpoint,
+		ExpirationTime:         c.ExpirationTime,
+		ConnectionLimit:        c.ConnectionLimit,
+		WorkerFilter:           c.WorkerFilter,
+		EgressWorkerFilter:     c.EgressWorkerFilter,
+		IngressWorkerFilter:    c.IngressWorkerFilter,
+		ApprovalRequired:       c.ApprovalRequired,
+		ApproversFilter:        c.ApproversFilter,
+		ApprovalExpirationTime: c.ApprovalExpirationTime,
+		DynamicCredentials:     c.DynamicCredentials,
+		StaticCredentials:      c.StaticCredentials,
+		ProtocolWorkerId:       c.ProtocolWorkerId,
+		CorrelationId:          c.CorrelationId,
 	}
 	if err := s.validateNewSession(ctx); err != nil {
 		return nil, errors.Wrap(ctx, err, op)
@@ -240,6 +260,9 @@ func (s *Session) Clone() any {
 		WorkerFilter:        s.WorkerFilter,
 		EgressWorkerFilter:  s.EgressWorkerFilter,
 		IngressWorkerFilter: s.IngressWorkerFilter,
+		ApprovalRequired:    s.ApprovalRequired,
+		ApproversFilter:     s.ApproversFilter,
+		ApproverId:          s.ApproverId,
 		KeyId:               s.KeyId,
 		ProtocolWorkerId:    s.ProtocolWorkerId,
 		CorrelationId:       s.CorrelationId,
@@ -293,6 +316,14 @@ func (s *Session) Clone() any {
 			},
 		}
 	}
+	if s.ApprovalExpirationTime != nil {
+		clone.ApprovalExpirationTime = &timestamp.Timestamp{
+			Timestamp: &timestamppb.Timestamp{
+				Seconds: s.ApprovalExpirationTime.Timestamp.Seconds,
+				Nanos:   s.ApprovalExpirationTime.Timestamp.Nanos,
+			},
+		}
+	}
 	if s.CreateTime != nil {
 		clone.CreateTime = &timestamp.Timestamp{
 			Timestamp: &timestamppb.Timestamp{
@@ -364,6 +395,12 @@ func (s *Session) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType
 			return errors.New(ctx, errors.InvalidParameter, op, "dynamic credentials are immutable")
 		case contains(opts.WithFieldMaskPaths, "StaticCredentials"):
 			return errors.New(ctx, errors.InvalidParameter, op, "static credentials are immutable")
+		case contains(opts.WithFieldMaskPaths, "ApprovalRequired"):
+			return errors.New(ctx, errors.InvalidParameter, op, "approval required is immutable")
+		case contains(opts.WithFieldMaskPaths, "ApproversFilter"):
+			return errors.New(ctx, errors.InvalidParameter, op, "approvers filter is immutable")
+		case contains(opts.WithFieldMaskPaths, "ApprovalExpirationTime"):
+			return errors.New(ctx, errors.InvalidParameter, op, "approval expiration time is immutable")
 		case contains(opts.WithFieldMaskPaths, "ProtocolWorkerId"):
 			return errors.New(ctx, errors.InvalidParameter, op, "protocol worker id is immutable")
 		case contains(opts.WithFieldMaskPaths, "TerminationReason"):
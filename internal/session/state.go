@@ -22,10 +22,11 @@ const (
 type Status string
 
 const (
-	StatusPending    Status = "pending"
-	StatusActive     Status = "active"
-	StatusCanceling  Status = "canceling"
-	StatusTerminated Status = "terminated"
+	StatusAwaitingApproval Status = "awaiting-approval"
+	StatusPending          Status = "pending"
+	StatusActive           Status = "active"
+	StatusCanceling        Status = "canceling"
+	StatusTerminated       Status = "terminated"
 )
 
 // String representation of the state's status
@@ -36,6 +37,8 @@ func (s Status) String() string {
 // ProtoVal returns the enum value corresponding to the state
 func (s Status) ProtoVal() workerpbs.SESSIONSTATUS {
 	switch s {
+	case StatusAwaitingApproval:
+		return workerpbs.SESSIONSTATUS_SESSIONSTATUS_AWAITING_APPROVAL
 	case StatusPending:
 		return workerpbs.SESSIONSTATUS_SESSIONSTATUS_PENDING
 	case StatusActive:
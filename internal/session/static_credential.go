@@ -8,11 +8,14 @@ import (
 )
 
 // A StaticCredential represents the relationship between a session, a
-// credential and the purpose of the credential.
+// credential and the purpose of the credential. CredentialVersion is the
+// version of the credential the session received. It is set by the database
+// when the StaticCredential is created.
 type StaticCredential struct {
 	SessionId          string `json:"session_id,omitempty" gorm:"primary_key"`
 	CredentialPurpose  string `json:"credential_purpose,omitempty" gorm:"primary_key"`
 	CredentialStaticId string `json:"credential_id,omitempty" gorm:"default:null"`
+	CredentialVersion  uint32 `json:"credential_version,omitempty" gorm:"default:null"`
 
 	tableName string `gorm:"-"`
 }
@@ -31,6 +34,7 @@ func (c *StaticCredential) clone() *StaticCredential {
 		SessionId:          c.SessionId,
 		CredentialPurpose:  c.CredentialPurpose,
 		CredentialStaticId: c.CredentialStaticId,
+		CredentialVersion:  c.CredentialVersion,
 	}
 }
 
@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+const (
+	estimateCountStorageBuckets = `
+select reltuples::bigint as estimate from pg_class where oid in ('storage_plugin_storage_bucket'::regclass)
+`
+)
@@ -5,23 +5,34 @@ package plugin
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 )
 
+var pluginClientFactoryFn = pluginClientFactory
+
 type Repository struct {
 	reader    db.Reader
 	writer    db.Writer
 	kms       *kms.Kms
 	scheduler *scheduler.Scheduler
+
+	// plugins is a map from plugin resource id to storage plugin client.
+	plugins map[string]plgpb.StoragePluginServiceClient
+	// defaultLimit provides a default for limiting the number of results
+	// returned from the repo
+	defaultLimit int
 }
 
 // NewRepository creates a new Repository. The returned repository is not
-// safe for concurrent go routines to access it
-func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, scheduler *scheduler.Scheduler) (*Repository, error) {
+// safe for concurrent go routines to access it. WithLimit option is used as
+// a repo wide default limit applied to all ListX methods.
+func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms, scheduler *scheduler.Scheduler, plgm map[string]plgpb.StoragePluginServiceClient, opt ...Option) (*Repository, error) {
 	const op = "plugin.NewRepository"
 	switch {
 	case r == nil:
@@ -32,12 +43,36 @@ func NewRepository(ctx context.Context, r db.Reader, w db.Writer, kms *kms.Kms,
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil kms")
 	case scheduler == nil:
 		return nil, errors.New(ctx, errors.InvalidParameter, op, "scheduler")
+	case plgm == nil:
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "plgm")
+	}
+
+	opts := getOpts(opt...)
+	if opts.withLimit == 0 {
+		// zero signals the boundary defaults should be used.
+		opts.withLimit = db.DefaultLimit
+	}
+
+	plgs := make(map[string]plgpb.StoragePluginServiceClient, len(plgm))
+	for k, v := range plgm {
+		plgs[k] = v
 	}
 
 	return &Repository{
-		reader:    r,
-		writer:    w,
-		kms:       kms,
-		scheduler: scheduler,
+		reader:       r,
+		writer:       w,
+		kms:          kms,
+		scheduler:    scheduler,
+		plugins:      plgs,
+		defaultLimit: opts.withLimit,
 	}, nil
 }
+
+func pluginClientFactory(ctx context.Context, pluginId string, controllerClients map[string]plgpb.StoragePluginServiceClient) (plgpb.StoragePluginServiceClient, error) {
+	const op = "plugin.pluginClientFactory"
+	cl, ok := controllerClients[pluginId]
+	if !ok || cl == nil {
+		return nil, errors.New(ctx, errors.Internal, op, fmt.Sprintf("controller plugin %q not available", pluginId))
+	}
+	return cl, nil
+}
@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/event"
+	"github.com/hashicorp/boundary/internal/kms"
+	"github.com/hashicorp/boundary/internal/libs/patchstruct"
+	"github.com/hashicorp/boundary/internal/oplog"
+	plg "github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/storage/storagebucketcredential"
+	_ "github.com/hashicorp/boundary/internal/storage/storagebucketcredential/environmental"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/plugins"
+	"github.com/hashicorp/boundary/sdk/pbs/controller/api/resources/storagebuckets"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// CreateStorageBucket inserts sb into the repository and returns a new
+// StorageBucket containing the storage bucket's PublicId. sb must contain a
+// valid ScopeId, PluginId, BucketName and WorkerFilter. sb must not contain a
+// PublicId. The PublicId is generated and assigned by this method. opt is
+// ignored.
+//
+// sb.Name, sb.Description and sb.BucketPrefix are optional. If sb.Name is
+// set, it must be unique within sb.ScopeId. Secrets are not supported, the
+// storage bucket is created with an environmental storage bucket credential.
+//
+// The storage bucket is sent to OnCreateStorageBucket of its plugin before it
+// is committed. The creation is aborted if this call fails.
+func (r *Repository) CreateStorageBucket(ctx context.Context, sb *StorageBucket, _ ...Option) (*StorageBucket, *plg.Plugin, error) {
+	const op = "plugin.(Repository).CreateStorageBucket"
+	switch {
+	case sb == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil StorageBucket")
+	case sb.StorageBucket == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil embedded StorageBucket")
+	case sb.ScopeId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no scope id")
+	case sb.PublicId != "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "public id not empty")
+	case sb.PluginId == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no plugin id")
+	case sb.BucketName == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no bucket name")
+	case sb.WorkerFilter == "":
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no worker filter")
+	case sb.Attributes == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "nil attributes")
+	case len(sb.Secrets.GetFields()) > 0:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "secrets are not supported")
+	}
+	sb = sb.clone()
+	id, err := newStorageBucketId(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	sb.PublicId = id
+
+	// Use PatchBytes' functionality that does not add keys where the values
+	// are nil to the resulting struct since we do not want to store nil valued
+	// attributes.
+	sb.Attributes, err = patchstruct.PatchBytes([]byte{}, sb.Attributes)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	plg, err := r.getPlugin(ctx, sb.GetPluginId())
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	plgSb, err := toPluginStorageBucket(ctx, sb, plg)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	plgClient, err := pluginClientFactoryFn(ctx, sb.GetPluginId(), r.plugins)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, sb.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	// If the call to the plugin succeeded, we do not want to call it again if
+	// the transaction failed and is being retried.
+	var pluginCalledSuccessfully bool
+
+	var newStorageBucket *StorageBucket
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			msgs := make([]*oplog.Message, 0, 1)
+			ticket, err := w.GetTicket(ctx, sb)
+			if err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to get ticket"))
+			}
+
+			// The foreign key from the credential to the storage bucket is
+			// deferred, so the credential is created first.
+			sbc, err := r.createEnvironmentalCredential(ctx, w, id)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+
+			newStorageBucket = sb.clone()
+			newStorageBucket.StorageBucketCredentialId = sbc.GetPrivateId()
+			var sbOplogMsg oplog.Message
+			if err := w.Create(ctx, newStorageBucket, db.NewOplogMsg(&sbOplogMsg)); err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			msgs = append(msgs, &sbOplogMsg)
+
+			if !pluginCalledSuccessfully {
+				if _, err := plgClient.OnCreateStorageBucket(ctx, &plgpb.OnCreateStorageBucketRequest{Bucket: plgSb}); err != nil {
+					if status.Code(err) != codes.Unimplemented {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				pluginCalledSuccessfully = true
+			}
+
+			metadata := sb.oplog(oplog.OpType_OP_TYPE_CREATE)
+			if err := w.WriteOplogEntryWith(ctx, oplogWrapper, ticket, metadata, msgs); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to write oplog"))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope: %s: name %s already exists", sb.ScopeId, sb.Name)))
+		}
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in scope: %s", sb.ScopeId)))
+	}
+	return newStorageBucket, plg, nil
+}
+
+// UpdateStorageBucket updates the repository entry for sb.PublicId with the
+// values in sb for the fields listed in fieldMask. It returns a new
+// StorageBucket containing the updated values and a count of the number of
+// records updated. sb is not changed.
+//
+// sb must contain a valid PublicId. sb.Name, sb.Description, sb.WorkerFilter
+// and sb.Attributes can be updated. The worker filter cannot be cleared.
+//
+// An attribute of sb will be set to NULL in the database if the attribute in
+// sb is the zero value and it is included in fieldMask. Note that this does
+// not apply to sb.Attributes - a null sb.Attributes is a no-op for
+// modifications. Rather, if fields need to be reset, its field in
+// sb.Attributes should individually set to null.
+//
+// Updates are sent to OnUpdateStorageBucket with a full copy of both the
+// current storage bucket and the state of the new storage bucket. Update of
+// the record in the database is aborted if this call fails.
+func (r *Repository) UpdateStorageBucket(ctx context.Context, sb *StorageBucket, version uint32, fieldMask []string, _ ...Option) (*StorageBucket, *plg.Plugin, int, error) {
+	const op = "plugin.(Repository).UpdateStorageBucket"
+	switch {
+	case sb == nil:
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil StorageBucket")
+	case sb.StorageBucket == nil:
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "nil embedded StorageBucket")
+	case sb.PublicId == "":
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	case version == 0:
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no version")
+	case len(fieldMask) == 0:
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
+	}
+
+	currentStorageBucket, err := r.getStorageBucket(ctx, sb.PublicId)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("error looking up storage bucket with id %q", sb.PublicId)))
+	}
+	if currentStorageBucket == nil {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.RecordNotFound, op, fmt.Sprintf("storage bucket with id %q not found", sb.PublicId))
+	}
+	if currentStorageBucket.GetVersion() != version {
+		return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.VersionMismatch, op, fmt.Sprintf("storage bucket version mismatch, want=%d, got=%d", currentStorageBucket.GetVersion(), version))
+	}
+
+	newStorageBucket := currentStorageBucket.clone()
+	var updateAttributes bool
+	var dbMask, nullFields []string
+	for _, f := range fieldMask {
+		switch {
+		case strings.EqualFold("name", f) && sb.Name == "":
+			nullFields = append(nullFields, "name")
+			newStorageBucket.Name = sb.Name
+		case strings.EqualFold("name", f) && sb.Name != "":
+			dbMask = append(dbMask, "name")
+			newStorageBucket.Name = sb.Name
+		case strings.EqualFold("description", f) && sb.Description == "":
+			nullFields = append(nullFields, "description")
+			newStorageBucket.Description = sb.Description
+		case strings.EqualFold("description", f) && sb.Description != "":
+			dbMask = append(dbMask, "description")
+			newStorageBucket.Description = sb.Description
+		case strings.EqualFold("WorkerFilter", f) && sb.WorkerFilter == "":
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "worker filter cannot be empty")
+		case strings.EqualFold("WorkerFilter", f) && sb.WorkerFilter != "":
+			dbMask = append(dbMask, "WorkerFilter")
+			newStorageBucket.WorkerFilter = sb.WorkerFilter
+		case strings.EqualFold("attributes", strings.Split(f, ".")[0]):
+			// Flag attributes for updating. While multiple masks may be
+			// sent, we only need to do this once.
+			updateAttributes = true
+		case strings.EqualFold("secrets", strings.Split(f, ".")[0]):
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "secrets are not supported")
+		default:
+			return nil, nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
+		}
+	}
+	if updateAttributes {
+		dbMask = append(dbMask, "attributes")
+		newStorageBucket.Attributes, err = patchstruct.PatchBytes(newStorageBucket.Attributes, sb.Attributes)
+		if err != nil {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("error in storage bucket attribute JSON"))
+		}
+	}
+
+	// Fetch the plugin here so that if there's an integrity error, we don't
+	// call the plugin.
+	plg, err := r.getPlugin(ctx, currentStorageBucket.GetPluginId())
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	currPlgSb, err := toPluginStorageBucket(ctx, currentStorageBucket, plg)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	newPlgSb, err := toPluginStorageBucket(ctx, newStorageBucket, plg)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	plgClient, err := pluginClientFactoryFn(ctx, currentStorageBucket.GetPluginId(), r.plugins)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, currentStorageBucket.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+
+	var pluginCalledSuccessfully bool
+
+	var returnedStorageBucket *StorageBucket
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			returnedStorageBucket = newStorageBucket.clone()
+			storageBucketsUpdated, err := w.Update(
+				ctx,
+				returnedStorageBucket,
+				dbMask,
+				nullFields,
+				db.WithOplog(oplogWrapper, newStorageBucket.oplog(oplog.OpType_OP_TYPE_UPDATE)),
+				db.WithVersion(&version),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if storageBucketsUpdated != 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, fmt.Sprintf("expected 1 storage bucket to be updated, got %d", storageBucketsUpdated))
+			}
+
+			if !pluginCalledSuccessfully {
+				if _, err := plgClient.OnUpdateStorageBucket(ctx, &plgpb.OnUpdateStorageBucketRequest{
+					CurrentBucket: currPlgSb,
+					NewBucket:     newPlgSb,
+					Persisted:     &storagebuckets.StorageBucketPersisted{},
+				}); err != nil {
+					if status.Code(err) != codes.Unimplemented {
+						return errors.Wrap(ctx, err, op)
+					}
+				}
+				pluginCalledSuccessfully = true
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		if errors.IsUniqueError(err) {
+			return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s: name %s already exists", newStorageBucket.PublicId, newStorageBucket.Name)))
+		}
+		return nil, nil, db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("in %s", newStorageBucket.PublicId)))
+	}
+	return returnedStorageBucket, plg, 1, nil
+}
+
+// LookupStorageBucket returns the StorageBucket for id. Returns nil, nil, nil
+// if no StorageBucket is found for id.
+func (r *Repository) LookupStorageBucket(ctx context.Context, id string, _ ...Option) (*StorageBucket, *plg.Plugin, error) {
+	const op = "plugin.(Repository).LookupStorageBucket"
+	if id == "" {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+	sb, err := r.getStorageBucket(ctx, id)
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	if sb == nil {
+		return nil, nil, nil
+	}
+	plg, err := r.getPlugin(ctx, sb.GetPluginId())
+	if err != nil {
+		return nil, nil, errors.Wrap(ctx, err, op)
+	}
+	return sb, plg, nil
+}
+
+// DeleteStorageBucket deletes the storage bucket for the provided id from the
+// repository returning a count of the number of records deleted. The storage
+// bucket is sent to OnDeleteStorageBucket of its plugin first. All options
+// are ignored.
+func (r *Repository) DeleteStorageBucket(ctx context.Context, id string, _ ...Option) (int, error) {
+	const op = "plugin.(Repository).DeleteStorageBucket"
+	if id == "" {
+		return db.NoRowsAffected, errors.New(ctx, errors.InvalidParameter, op, "no public id")
+	}
+
+	sb, err := r.getStorageBucket(ctx, id)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if sb == nil {
+		return db.NoRowsAffected, nil
+	}
+
+	plg, err := r.getPlugin(ctx, sb.GetPluginId())
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	plgSb, err := toPluginStorageBucket(ctx, sb, plg)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	plgClient, err := pluginClientFactoryFn(ctx, sb.GetPluginId(), r.plugins)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op)
+	}
+	if _, err := plgClient.OnDeleteStorageBucket(ctx, &plgpb.OnDeleteStorageBucketRequest{
+		Bucket:    plgSb,
+		Persisted: &storagebuckets.StorageBucketPersisted{},
+	}); err != nil {
+		// Even if the plugin returns an error, we ignore it and proceed with
+		// deleting the storage bucket.
+		event.WriteError(ctx, op, err, event.WithInfoMsg("plugin deleting storage bucket", "storage plugin id", sb.GetPluginId()))
+	}
+
+	oplogWrapper, err := r.kms.GetWrapper(ctx, sb.ScopeId, kms.KeyPurposeOplog)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg("unable to get oplog wrapper"))
+	}
+	metadata := sb.oplog(oplog.OpType_OP_TYPE_DELETE)
+
+	var rowsDeleted int
+	_, err = r.writer.DoTx(
+		ctx,
+		db.StdRetryCnt,
+		db.ExpBackoff{},
+		func(_ db.Reader, w db.Writer) error {
+			deleteStorageBucket := sb.clone()
+			var err error
+			rowsDeleted, err = w.Delete(
+				ctx,
+				deleteStorageBucket,
+				db.WithOplog(oplogWrapper, metadata),
+			)
+			if err != nil {
+				return errors.Wrap(ctx, err, op)
+			}
+			if rowsDeleted > 1 {
+				return errors.New(ctx, errors.MultipleRecords, op, "more than 1 resource would have been deleted")
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return db.NoRowsAffected, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("delete failed for %s", sb.PublicId)))
+	}
+	return rowsDeleted, nil
+}
+
+// createEnvironmentalCredential creates the environmental storage bucket
+// credential of the storage bucket with the provided id. It must be called
+// within a transaction.
+func (r *Repository) createEnvironmentalCredential(ctx context.Context, w db.Writer, storageBucketId string) (storagebucketcredential.StorageBucketCredential, error) {
+	const op = "plugin.(Repository).createEnvironmentalCredential"
+	sbc, err := storagebucketcredential.New(ctx, storagebucketcredential.EnvironmentalSubtype, storageBucketId)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	rows, err := w.Query(ctx, sbc.CreateDBQuery(), []any{sql.Named("storage_bucket_id", storageBucketId)})
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := w.ScanRows(ctx, rows, sbc); err != nil {
+			return nil, errors.Wrap(ctx, err, op)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	if sbc.GetPrivateId() == "" {
+		return nil, errors.New(ctx, errors.NotSpecificIntegrity, op, "storage bucket credential was not created")
+	}
+	return sbc, nil
+}
+
+// getStorageBucket retrieves the *StorageBucket with the provided id. It
+// returns nil, nil if it is not found.
+func (r *Repository) getStorageBucket(ctx context.Context, id string) (*StorageBucket, error) {
+	const op = "plugin.(Repository).getStorageBucket"
+	sba := &storageBucketAgg{}
+	sba.PublicId = id
+	if err := r.reader.LookupByPublicId(ctx, sba); err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed for: %s", id)))
+	}
+	sb, err := sba.toStorageBucketAndSBC()
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op)
+	}
+	return sb, nil
+}
+
+func (r *Repository) getPlugin(ctx context.Context, plgId string) (*plg.Plugin, error) {
+	const op = "plugin.(Repository).getPlugin"
+	if plgId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "no plugin id")
+	}
+	plg := plg.NewPlugin()
+	plg.PublicId = plgId
+	if err := r.reader.LookupByPublicId(ctx, plg); err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("unable to get storage plugin with id %q", plgId)))
+	}
+	return plg, nil
+}
+
+// toPluginStorageBucket returns a storage bucket in the format expected by
+// the storage plugin system.
+func toPluginStorageBucket(ctx context.Context, in *StorageBucket, plg *plg.Plugin) (*storagebuckets.StorageBucket, error) {
+	const op = "plugin.toPluginStorageBucket"
+	if in == nil {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "nil storage bucket")
+	}
+	var name, description *wrapperspb.StringValue
+	if inName := in.GetName(); inName != "" {
+		name = wrapperspb.String(inName)
+	}
+	if inDescription := in.GetDescription(); inDescription != "" {
+		description = wrapperspb.String(inDescription)
+	}
+	sb := &storagebuckets.StorageBucket{
+		Id:                        in.GetPublicId(),
+		ScopeId:                   in.GetScopeId(),
+		Name:                      name,
+		Description:               description,
+		BucketName:                in.GetBucketName(),
+		BucketPrefix:              in.GetBucketPrefix(),
+		WorkerFilter:              in.GetWorkerFilter(),
+		PluginId:                  in.GetPluginId(),
+		Plugin:                    toPluginInfo(plg),
+		StorageBucketCredentialId: in.GetStorageBucketCredentialId(),
+		Version:                   in.GetVersion(),
+		Attributes:                &structpb.Struct{},
+	}
+	if in.GetAttributes() != nil {
+		if err := proto.Unmarshal(in.GetAttributes(), sb.Attributes); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg("unable to unmarshal attributes"))
+		}
+	}
+	return sb, nil
+}
+
+// toPluginInfo converts a Plugin object into PluginInfo.
+func toPluginInfo(plg *plg.Plugin) *plugins.PluginInfo {
+	if plg == nil {
+		return nil
+	}
+	return &plugins.PluginInfo{
+		Id:          plg.GetPublicId(),
+		Name:        plg.GetName(),
+		Description: plg.GetDescription(),
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	plg "github.com/hashicorp/boundary/internal/plugin"
+)
+
+// listStorageBuckets lists storage buckets in the given scopes and supports
+// the WithLimit and WithStartPageAfterItem options.
+func (r *Repository) listStorageBuckets(ctx context.Context, withScopeIds []string, opt ...Option) ([]*StorageBucket, []*plg.Plugin, time.Time, error) {
+	const op = "plugin.(Repository).listStorageBuckets"
+	if len(withScopeIds) == 0 {
+		return nil, nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+
+	limit := r.defaultLimit
+	switch {
+	case opts.withLimit > 0:
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	case opts.withLimit < 0:
+		return nil, nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "limit must be non-negative")
+	}
+
+	var args []any
+	whereClause := "scope_id in @scope_ids"
+	args = append(args, sql.Named("scope_ids", withScopeIds))
+
+	if opts.withStartPageAfterItem != nil {
+		whereClause = fmt.Sprintf("(create_time, public_id) < (@last_item_create_time, @last_item_id) and %s", whereClause)
+		args = append(args,
+			sql.Named("last_item_create_time", opts.withStartPageAfterItem.GetCreateTime()),
+			sql.Named("last_item_id", opts.withStartPageAfterItem.GetPublicId()),
+		)
+	}
+	dbOpts := []db.Option{db.WithLimit(limit), db.WithOrder("create_time desc, public_id desc")}
+	return r.queryStorageBuckets(ctx, whereClause, args, dbOpts...)
+}
+
+// listStorageBucketsRefresh lists storage buckets in the given scopes updated
+// after the provided time and supports the WithLimit and
+// WithStartPageAfterItem options.
+func (r *Repository) listStorageBucketsRefresh(ctx context.Context, updatedAfter time.Time, withScopeIds []string, opt ...Option) ([]*StorageBucket, []*plg.Plugin, time.Time, error) {
+	const op = "plugin.(Repository).listStorageBucketsRefresh"
+	switch {
+	case updatedAfter.IsZero():
+		return nil, nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing updated after time")
+	case len(withScopeIds) == 0:
+		return nil, nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "missing scope id")
+	}
+	opts := getOpts(opt...)
+
+	limit := r.defaultLimit
+	switch {
+	case opts.withLimit > 0:
+		// non-zero signals an override of the default limit for the repo.
+		limit = opts.withLimit
+	case opts.withLimit < 0:
+		return nil, nil, time.Time{}, errors.New(ctx, errors.InvalidParameter, op, "limit must be non-negative")
+	}
+
+	var args []any
+	whereClause := "update_time > @updated_after_time and scope_id in @scope_ids"
+	args = append(args,
+		sql.Named("updated_after_time", timestamp.New(updatedAfter)),
+		sql.Named("scope_ids", withScopeIds),
+	)
+	if opts.withStartPageAfterItem != nil {
+		whereClause = fmt.Sprintf("(update_time, public_id) < (@last_item_update_time, @last_item_id) and %s", whereClause)
+		args = append(args,
+			sql.Named("last_item_update_time", opts.withStartPageAfterItem.GetUpdateTime()),
+			sql.Named("last_item_id", opts.withStartPageAfterItem.GetPublicId()),
+		)
+	}
+	dbOpts := []db.Option{db.WithLimit(limit), db.WithOrder("update_time desc, public_id desc")}
+	return r.queryStorageBuckets(ctx, whereClause, args, dbOpts...)
+}
+
+func (r *Repository) queryStorageBuckets(ctx context.Context, whereClause string, args []any, opt ...db.Option) ([]*StorageBucket, []*plg.Plugin, time.Time, error) {
+	const op = "plugin.(Repository).queryStorageBuckets"
+
+	var ret []*StorageBucket
+	var plgs []*plg.Plugin
+	var transactionTimestamp time.Time
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(rd db.Reader, w db.Writer) error {
+		var inRet []*StorageBucket
+		if err := rd.SearchWhere(ctx, &inRet, whereClause, args, opt...); err != nil {
+			return errors.Wrap(ctx, err, op)
+		}
+		plgIds := make(map[string]struct{}, len(inRet))
+		for _, sb := range inRet {
+			plgIds[sb.GetPluginId()] = struct{}{}
+		}
+		var inPlgs []*plg.Plugin
+		if len(plgIds) > 0 {
+			ids := make([]string, 0, len(plgIds))
+			for id := range plgIds {
+				ids = append(ids, id)
+			}
+			if err := rd.SearchWhere(ctx, &inPlgs, "public_id in @plugin_ids", []any{sql.Named("plugin_ids", ids)}); err != nil {
+				return errors.Wrap(ctx, err, op, errors.WithMsg("unable to query plugins"))
+			}
+		}
+		ret = inRet
+		plgs = inPlgs
+		var err error
+		transactionTimestamp, err = rd.Now(ctx)
+		return err
+	}); err != nil {
+		return nil, nil, time.Time{}, err
+	}
+	return ret, plgs, transactionTimestamp, nil
+}
+
+// listDeletedIds lists the public IDs of any storage buckets deleted since
+// the timestamp provided.
+func (r *Repository) listDeletedIds(ctx context.Context, since time.Time) ([]string, time.Time, error) {
+	const op = "plugin.(Repository).listDeletedIds"
+	var deletedStorageBuckets []*deletedStorageBucket
+	var transactionTimestamp time.Time
+	if _, err := r.writer.DoTx(ctx, db.StdRetryCnt, db.ExpBackoff{}, func(r db.Reader, _ db.Writer) error {
+		if err := r.SearchWhere(ctx, &deletedStorageBuckets, "delete_time >= ?", []any{since}); err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to query deleted storage buckets"))
+		}
+		var err error
+		transactionTimestamp, err = r.Now(ctx)
+		if err != nil {
+			return errors.Wrap(ctx, err, op, errors.WithMsg("failed to get transaction timestamp"))
+		}
+		return nil
+	}); err != nil {
+		return nil, time.Time{}, err
+	}
+	var storageBucketIds []string
+	for _, sb := range deletedStorageBuckets {
+		storageBucketIds = append(storageBucketIds, sb.PublicId)
+	}
+	return storageBucketIds, transactionTimestamp, nil
+}
+
+// estimatedCount returns an estimate of the total number of items in the
+// storage bucket table.
+func (r *Repository) estimatedCount(ctx context.Context) (int, error) {
+	const op = "plugin.(Repository).estimatedCount"
+	rows, err := r.reader.Query(ctx, estimateCountStorageBuckets, nil)
+	if err != nil {
+		return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total storage buckets"))
+	}
+	var count int
+	for rows.Next() {
+		if err := r.reader.ScanRows(ctx, rows, &count); err != nil {
+			return 0, errors.Wrap(ctx, err, op, errors.WithMsg("failed to query total storage buckets"))
+		}
+	}
+	return count, nil
+}
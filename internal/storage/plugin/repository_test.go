@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/kms"
 	"github.com/hashicorp/boundary/internal/scheduler"
+	plgpb "github.com/hashicorp/boundary/sdk/pbs/plugin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -57,16 +58,29 @@ func TestRepository_NewRepository(t *testing.T) {
 			wantErrCode:     errors.InvalidParameter,
 		},
 		{
-			name: "valid-repository",
+			name: "no-plugins",
 			in: &Repository{
 				reader: rw,
 				writer: rw,
 				kms:    kmsCache,
 			},
+			wantErrContains: "plgm",
+			wantErrCode:     errors.InvalidParameter,
+		},
+		{
+			name: "valid-repository",
+			in: &Repository{
+				reader:  rw,
+				writer:  rw,
+				kms:     kmsCache,
+				plugins: map[string]plgpb.StoragePluginServiceClient{},
+			},
 			want: &Repository{
-				reader: rw,
-				writer: rw,
-				kms:    kmsCache,
+				reader:       rw,
+				writer:       rw,
+				kms:          kmsCache,
+				plugins:      map[string]plgpb.StoragePluginServiceClient{},
+				defaultLimit: db.DefaultLimit,
 			},
 		},
 	}
@@ -76,7 +90,7 @@ func TestRepository_NewRepository(t *testing.T) {
 			require, assert := require.New(t), assert.New(t)
 			ctx := context.Background()
 
-			repo, err := NewRepository(ctx, tt.in.reader, tt.in.writer, tt.in.kms, sche)
+			repo, err := NewRepository(ctx, tt.in.reader, tt.in.writer, tt.in.kms, sche, tt.in.plugins)
 			if tt.wantErrContains != "" {
 				require.ErrorContains(err, tt.wantErrContains)
 				return
@@ -86,6 +100,8 @@ func TestRepository_NewRepository(t *testing.T) {
 			assert.Equal(tt.want.reader, repo.reader)
 			assert.Equal(tt.want.writer, repo.writer)
 			assert.Equal(tt.want.kms, repo.kms)
+			assert.Equal(tt.want.plugins, repo.plugins)
+			assert.Equal(tt.want.defaultLimit, repo.defaultLimit)
 		})
 	}
 }
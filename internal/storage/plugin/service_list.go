@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/pagination"
+	ppagination "github.com/hashicorp/boundary/internal/pagination/plugin"
+	plg "github.com/hashicorp/boundary/internal/plugin"
+)
+
+// ListStorageBuckets lists up to page size storage buckets, filtering out
+// entries that do not pass the filter item function. It also returns a map
+// from plugin ID to plugin associated with the returned storage buckets. It
+// will automatically request more storage buckets from the database, at page
+// size chunks, to fill the page. It returns a new list token used to continue
+// pagination or refresh items. Storage buckets are ordered by create time
+// descending (most recently created first).
+func ListStorageBuckets(
+	ctx context.Context,
+	grantsHash []byte,
+	pageSize int,
+	filterItemFn ppagination.ListPluginsFilterFunc[*StorageBucket],
+	repo *Repository,
+	withScopeIds []string,
+) (*pagination.ListResponse[*StorageBucket], map[string]*plg.Plugin, error) {
+	const op = "plugin.ListStorageBuckets"
+
+	switch {
+	case len(grantsHash) == 0:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing grants hash")
+	case pageSize < 1:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "page size must be at least 1")
+	case filterItemFn == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing filter item callback")
+	case repo == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo")
+	case len(withScopeIds) == 0:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids")
+	}
+
+	listItemsFn := func(ctx context.Context, lastPageItem *StorageBucket, limit int) ([]*StorageBucket, []*plg.Plugin, time.Time, error) {
+		opts := []Option{
+			WithLimit(limit),
+		}
+		if lastPageItem != nil {
+			opts = append(opts, WithStartPageAfterItem(lastPageItem))
+		}
+		return repo.listStorageBuckets(ctx, withScopeIds, opts...)
+	}
+
+	return ppagination.ListPlugins(ctx, grantsHash, pageSize, filterItemFn, listItemsFn, repo.estimatedCount)
+}
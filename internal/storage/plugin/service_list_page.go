@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/listtoken"
+	"github.com/hashicorp/boundary/internal/pagination"
+	ppagination "github.com/hashicorp/boundary/internal/pagination/plugin"
+	plg "github.com/hashicorp/boundary/internal/plugin"
+	"github.com/hashicorp/boundary/internal/types/resource"
+)
+
+// ListStorageBucketsPage lists up to page size storage buckets, filtering out
+// entries that do not pass the filter item function. It also returns a map
+// from plugin ID to plugin associated with the returned storage buckets. It
+// will automatically request more storage buckets from the database, at page
+// size chunks, to fill the page. It will start its paging based on the
+// information in the token. It returns a new list token used to continue
+// pagination or refresh items. Storage buckets are ordered by create time
+// descending (most recently created first).
+func ListStorageBucketsPage(
+	ctx context.Context,
+	grantsHash []byte,
+	pageSize int,
+	filterItemFn ppagination.ListPluginsFilterFunc[*StorageBucket],
+	tok *listtoken.Token,
+	repo *Repository,
+	withScopeIds []string,
+) (*pagination.ListResponse[*StorageBucket], map[string]*plg.Plugin, error) {
+	const op = "plugin.ListStorageBucketsPage"
+
+	switch {
+	case len(grantsHash) == 0:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing grants hash")
+	case pageSize < 1:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "page size must be at least 1")
+	case filterItemFn == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing filter item callback")
+	case tok == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing token")
+	case repo == nil:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing repo")
+	case len(withScopeIds) == 0:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "missing scope ids")
+	case tok.ResourceType != resource.StorageBucket:
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a storage bucket resource type")
+	}
+	if _, ok := tok.Subtype.(*listtoken.PaginationToken); !ok {
+		return nil, nil, errors.New(ctx, errors.InvalidParameter, op, "token did not have a pagination token component")
+	}
+
+	listItemsFn := func(ctx context.Context, lastPageItem *StorageBucket, limit int) ([]*StorageBucket, []*plg.Plugin, time.Time, error) {
+		opts := []Option{
+			WithLimit(limit),
+		}
+		if lastPageItem != nil {
+			opts = append(opts, WithStartPageAfterItem(lastPageItem))
+		} else {
+			lastItem, err := tok.LastItem(ctx)
+			if err != nil {
+				return nil, nil, time.Time{}, err
+			}
+			opts = append(opts, WithStartPageAfterItem(lastItem))
+		}
+		return repo.listStorageBuckets(ctx, withScopeIds, opts...)
+	}
+
+	return ppagination.ListPluginsPage(ctx, grantsHash, pageSize, filterItemFn, listItemsFn, repo.estimatedCount, tok)
+}
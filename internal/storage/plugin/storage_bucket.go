@@ -4,7 +4,10 @@
 package plugin
 
 import (
+	"context"
+
 	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
 	"github.com/hashicorp/boundary/internal/oplog"
 	"github.com/hashicorp/boundary/internal/storage/plugin/store"
 	"github.com/hashicorp/boundary/internal/types/resource"
@@ -21,6 +24,34 @@ type StorageBucket struct {
 	Secrets *structpb.Struct `gorm:"-"`
 }
 
+// NewStorageBucket creates a new in memory StorageBucket assigned to scopeId
+// and pluginId. Name, description, bucket prefix, worker filter, attributes
+// and secrets are the only valid options. All other options are ignored.
+func NewStorageBucket(ctx context.Context, scopeId, pluginId, bucketName string, opt ...Option) (*StorageBucket, error) {
+	const op = "plugin.NewStorageBucket"
+	opts := getOpts(opt...)
+
+	attrs, err := proto.Marshal(opts.withAttributes)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, op, errors.WithCode(errors.InvalidParameter))
+	}
+
+	sb := &StorageBucket{
+		StorageBucket: &store.StorageBucket{
+			ScopeId:      scopeId,
+			PluginId:     pluginId,
+			Name:         opts.withName,
+			Description:  opts.withDescription,
+			BucketName:   bucketName,
+			BucketPrefix: opts.withBucketPrefix,
+			WorkerFilter: opts.withWorkerFilter,
+			Attributes:   attrs,
+		},
+		Secrets: opts.withSecrets,
+	}
+	return sb, nil
+}
+
 func allocStorageBucket() *StorageBucket {
 	return &StorageBucket{
 		StorageBucket: &store.StorageBucket{},
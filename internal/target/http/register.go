@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package http
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/target"
+)
+
+type targetHooks struct{}
+
+func init() {
+	target.Register(Subtype, targetHooks{}, TargetPrefix)
+}
+
+const (
+	// TargetPrefix is the prefix for public ids of an http.Target.
+	TargetPrefix = globals.HttpTargetPrefix
+)
+
+// Vet validates that the given target.Target is an http.Target and that it
+// has a Target store.
+func (h targetHooks) Vet(ctx context.Context, t target.Target) error {
+	const op = "http.vet"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an http.Target")
+	}
+
+	if tt == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	}
+
+	if tt.Target == nil {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+	if tt.GetDefaultPort() == 0 {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target default port")
+	}
+	if tt.GetDefaultPort() > math.MaxUint16 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid default port number")
+	}
+	if tt.GetDefaultClientPort() > math.MaxUint16 {
+		return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
+	}
+	if err := vetTls(ctx, tt); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+	return nil
+}
+
+// VetForUpdate validates that the given target.Target is an http.Target,
+// and that it has a Target store and that it isn't attempting to clear or
+// set to zero the default port.
+func (h targetHooks) VetForUpdate(ctx context.Context, t target.Target, paths []string) error {
+	const op = "http.vetForUpdate"
+
+	tt, ok := t.(*Target)
+	if !ok {
+		return errors.New(ctx, errors.InvalidParameter, op, "target is not an http.Target")
+	}
+
+	switch {
+	case tt == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target")
+	case tt.Target == nil:
+		return errors.New(ctx, errors.InvalidParameter, op, "missing target store")
+	}
+
+	for _, f := range paths {
+		if strings.EqualFold("defaultport", f) {
+			if tt.GetDefaultPort() == 0 {
+				return errors.New(ctx, errors.InvalidParameter, op, "clearing or setting default port to zero")
+			}
+			if tt.GetDefaultPort() > math.MaxUint16 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid default port number")
+			}
+		}
+		if strings.EqualFold("defaultclientport", f) {
+			if tt.GetDefaultClientPort() > math.MaxUint16 {
+				return errors.New(ctx, errors.InvalidParameter, op, "invalid default client port number")
+			}
+		}
+	}
+	if err := vetTls(ctx, tt); err != nil {
+		return errors.Wrap(ctx, err, op)
+	}
+
+	return nil
+}
+
+// vetTls validates the scheme and the certificate authorities of the target.
+func vetTls(ctx context.Context, t *Target) error {
+	const op = "http.vetTls"
+	switch t.GetScheme() {
+	case "", SchemeHttps, SchemeHttp:
+	default:
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("invalid scheme %q", t.GetScheme()))
+	}
+	if t.GetTlsCaCert() != "" {
+		if !x509.NewCertPool().AppendCertsFromPEM([]byte(t.GetTlsCaCert())) {
+			return errors.New(ctx, errors.InvalidParameter, op, "tls ca cert does not contain a valid PEM encoded certificate")
+		}
+	}
+	return nil
+}
+
+// VetCredentialSources checks that all the provided credential sources have a
+// CredentialPurpose of BrokeredPurpose or InjectedApplicationPurpose. Any other
+// CredentialPurpose will result in an error.
+func (h targetHooks) VetCredentialSources(ctx context.Context, libs []*target.CredentialLibrary, creds []*target.StaticCredential) error {
+	const op = "http.VetCredentialSources"
+
+	for _, c := range libs {
+		if !supportedPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("http.Target only supports credential purposes: %q and %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
+		}
+	}
+	for _, c := range creds {
+		if !supportedPurpose(c.GetCredentialPurpose()) {
+			return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("http.Target only supports credential purposes: %q and %q", credential.BrokeredPurpose, credential.InjectedApplicationPurpose))
+		}
+	}
+	return nil
+}
+
+func supportedPurpose(p string) bool {
+	switch credential.Purpose(p) {
+	case credential.BrokeredPurpose, credential.InjectedApplicationPurpose:
+		return true
+	default:
+		return false
+	}
+}
@@ -28,34 +28,44 @@ type Option func(*options)
 
 // options = how options are represented
 type options struct {
-	WithName                   string
-	WithDescription            string
-	WithDefaultPort            uint32
-	WithDefaultClientPort      uint32
-	WithLimit                  int
-	WithProjectId              string
-	WithProjectIds             []string
-	WithProjectName            string
-	WithUserId                 string
-	WithType                   globals.Subtype
-	WithHostSources            []string
-	WithCredentialLibraries    []*CredentialLibrary
-	WithStaticCredentials      []*StaticCredential
-	WithSessionMaxSeconds      uint32
-	WithSessionConnectionLimit int32
-	WithPermissions            []perms.Permission
-	WithPublicId               string
-	WithWorkerFilter           string
-	WithTestWorkerFilter       string
-	WithEgressWorkerFilter     string
-	WithIngressWorkerFilter    string
-	WithTargetIds              []string
-	WithAddress                string
-	WithStorageBucketId        string
-	WithEnableSessionRecording bool
-	WithNetResolver            intglobals.NetIpResolver
-	WithStartPageAfterItem     pagination.Item
-	withAliases                []*talias.Alias
+	WithName                            string
+	WithDescription                     string
+	WithDefaultPort                     uint32
+	WithDefaultClientPort               uint32
+	WithLimit                           int
+	WithProjectId                       string
+	WithProjectIds                      []string
+	WithProjectName                     string
+	WithUserId                          string
+	WithType                            globals.Subtype
+	WithHostSources                     []string
+	WithCredentialLibraries             []*CredentialLibrary
+	WithStaticCredentials               []*StaticCredential
+	WithSessionMaxSeconds               uint32
+	WithSessionConnectionLimit          int32
+	WithPermissions                     []perms.Permission
+	WithPublicId                        string
+	WithWorkerFilter                    string
+	WithTestWorkerFilter                string
+	WithEgressWorkerFilter              string
+	WithIngressWorkerFilter             string
+	WithTargetIds                       []string
+	WithAddress                         string
+	WithStorageBucketId                 string
+	WithEnableSessionRecording          bool
+	WithApprovalRequired                bool
+	WithApproversFilter                 string
+	WithApprovalTimeoutSeconds          uint32
+	WithSslMode                         string
+	WithTlsCaCert                       string
+	WithTlsServerName                   string
+	WithScheme                          string
+	WithAllowPlaintextCredentials       bool
+	WithKnownHosts                      string
+	WithInsecureSkipHostKeyVerification bool
+	WithNetResolver                     intglobals.NetIpResolver
+	WithStartPageAfterItem              pagination.Item
+	withAliases                         []*talias.Alias
 }
 
 func getDefaultOptions() options {
@@ -263,6 +273,86 @@ func WithStorageBucketId(id string) Option {
 	}
 }
 
+// WithApprovalRequired provides an option to require sessions of the target to
+// be approved before they can be connected to
+func WithApprovalRequired(required bool) Option {
+	return func(o *options) {
+		o.WithApprovalRequired = required
+	}
+}
+
+// WithApproversFilter provides an optional filter of the users who can approve
+// sessions of the target
+func WithApproversFilter(filter string) Option {
+	return func(o *options) {
+		o.WithApproversFilter = filter
+	}
+}
+
+// WithSslMode provides an option to set the SSL mode the worker uses to
+// connect to the endpoint of a target
+func WithSslMode(mode string) Option {
+	return func(o *options) {
+		o.WithSslMode = mode
+	}
+}
+
+// WithTlsCaCert provides an option to set the PEM encoded certificate
+// authorities used to verify the certificate of the endpoint of a target
+func WithTlsCaCert(pem string) Option {
+	return func(o *options) {
+		o.WithTlsCaCert = pem
+	}
+}
+
+// WithTlsServerName provides an option to set the name used to verify the
+// certificate of the endpoint of a target
+func WithTlsServerName(name string) Option {
+	return func(o *options) {
+		o.WithTlsServerName = name
+	}
+}
+
+// WithScheme provides an option to set the scheme the worker uses to send
+// requests to the endpoint of a target
+func WithScheme(scheme string) Option {
+	return func(o *options) {
+		o.WithScheme = scheme
+	}
+}
+
+// WithAllowPlaintextCredentials provides an option to allow the worker to
+// inject credentials in requests sent to the endpoint of a target without TLS
+func WithAllowPlaintextCredentials(allow bool) Option {
+	return func(o *options) {
+		o.WithAllowPlaintextCredentials = allow
+	}
+}
+
+// WithKnownHosts provides an option to set the known_hosts entries used to
+// verify the host key of the endpoint of a target
+func WithKnownHosts(knownHosts string) Option {
+	return func(o *options) {
+		o.WithKnownHosts = knownHosts
+	}
+}
+
+// WithInsecureSkipHostKeyVerification provides an option to allow the worker
+// to connect to the endpoint of a target without verifying its host key
+func WithInsecureSkipHostKeyVerification(skip bool) Option {
+	return func(o *options) {
+		o.WithInsecureSkipHostKeyVerification = skip
+	}
+}
+
+// WithApprovalTimeoutSeconds provides an option to limit how long sessions of
+// the target can await approval
+func WithApprovalTimeoutSeconds(seconds uint32) Option {
+	return func(o *options) {
+		o.WithApprovalTimeoutSeconds = seconds
+	}
+}
+
 // WithNetResolver provides an option to specify a custom DNS resolver
 func WithNetResolver(resolver intglobals.NetIpResolver) Option {
 	return func(o *options) {
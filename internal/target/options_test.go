@@ -166,6 +166,76 @@ func Test_GetOpts(t *testing.T) {
 		testOpts.WithIngressWorkerFilter = `"/foo" == "bar"`
 		assert.Equal(opts, testOpts)
 	})
+	t.Run("WithApprovalRequired", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithApprovalRequired(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithApprovalRequired = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithApproversFilter", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithApproversFilter(`"/user/id" == "u_1234567890"`))
+		testOpts := getDefaultOptions()
+		testOpts.WithApproversFilter = `"/user/id" == "u_1234567890"`
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithSslMode", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithSslMode("verify-ca"))
+		testOpts := getDefaultOptions()
+		testOpts.WithSslMode = "verify-ca"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTlsCaCert", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithTlsCaCert("pem"))
+		testOpts := getDefaultOptions()
+		testOpts.WithTlsCaCert = "pem"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithTlsServerName", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithTlsServerName("db.example.com"))
+		testOpts := getDefaultOptions()
+		testOpts.WithTlsServerName = "db.example.com"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithScheme", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithScheme("http"))
+		testOpts := getDefaultOptions()
+		testOpts.WithScheme = "http"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithAllowPlaintextCredentials", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithAllowPlaintextCredentials(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithAllowPlaintextCredentials = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithKnownHosts", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithKnownHosts("host ssh-ed25519 AAAA"))
+		testOpts := getDefaultOptions()
+		testOpts.WithKnownHosts = "host ssh-ed25519 AAAA"
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithInsecureSkipHostKeyVerification", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithInsecureSkipHostKeyVerification(true))
+		testOpts := getDefaultOptions()
+		testOpts.WithInsecureSkipHostKeyVerification = true
+		assert.Equal(opts, testOpts)
+	})
+	t.Run("WithApprovalTimeoutSeconds", func(t *testing.T) {
+		assert := assert.New(t)
+		opts := GetOpts(WithApprovalTimeoutSeconds(600))
+		testOpts := getDefaultOptions()
+		testOpts.WithApprovalTimeoutSeconds = 600
+		assert.Equal(opts, testOpts)
+	})
 	t.Run("WithPermissions", func(t *testing.T) {
 		assert := assert.New(t)
 		opts := GetOpts(WithPermissions([]perms.Permission{{GrantScopeId: "test1"}, {GrantScopeId: "test2"}}))
@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package postgres_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTarget_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		projectId string
+		opt       []target.Option
+		wantPort  uint32
+		wantErr   bool
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "empty-projectId",
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "default-port",
+			projectId: "p_1234567890",
+			wantPort:  postgres.DefaultPort,
+		},
+		{
+			name:      "with-port",
+			projectId: "p_1234567890",
+			opt:       []target.Option{target.WithDefaultPort(5433)},
+			wantPort:  5433,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, postgres.Subtype, tt.projectId, tt.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(postgres.Subtype, got.GetType())
+			assert.Equal(tt.wantPort, got.GetDefaultPort())
+		})
+	}
+}
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	assert, require := assert.New(t), require.New(t)
+	got, err := target.New(
+		ctx,
+		postgres.Subtype,
+		prj.PublicId,
+		target.WithName("valid-proj-id"),
+		target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+		target.WithSessionConnectionLimit(-1),
+	)
+	require.NoError(err)
+	id, err := db.NewPublicId(ctx, postgres.TargetPrefix)
+	require.NoError(err)
+	require.NoError(got.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).Create(ctx, got))
+
+	found, err := target.New(ctx, postgres.Subtype, prj.PublicId)
+	require.NoError(err)
+	require.NoError(found.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).LookupByPublicId(ctx, found))
+	assert.True(proto.Equal(got.(*postgres.Target).Target, found.(*postgres.Target).Target))
+}
+
+func TestTarget_Clone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	t.Run("valid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		tar := postgres.TestTarget(ctx, t, conn, proj.PublicId, "clone",
+			target.WithAddress("8.8.8.8"),
+		)
+		cp := tar.Clone()
+		assert.True(proto.Equal(cp.(*postgres.Target).Target, tar.(*postgres.Target).Target))
+		assert.Equal(tar.GetAddress(), cp.GetAddress())
+	})
+}
+
+func TestTable_SetTableName(t *testing.T) {
+	t.Parallel()
+	defaultTableName := "target_postgres"
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		setNameTo string
+		want      string
+	}{
+		{
+			name:      "new-name",
+			setNameTo: "new-name",
+			want:      "new-name",
+		},
+		{
+			name:      "reset to default",
+			setNameTo: "",
+			want:      defaultTableName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			def, _ := target.New(ctx, postgres.Subtype, "testScope")
+			require.Equal(defaultTableName, def.(*postgres.Target).TableName())
+			ss, _ := target.New(ctx, postgres.Subtype, "testScope")
+			s := ss.(*postgres.Target)
+			s.SetTableName(tt.setNameTo)
+			assert.Equal(tt.want, s.TableName())
+		})
+	}
+}
+
+func TestTarget_oplog(t *testing.T) {
+	ctx := context.Background()
+	id := postgres.TargetPrefix + "_1234567890"
+	tar, err := target.New(ctx, postgres.Subtype, id)
+	require.NoError(t, err)
+	require.NoError(t, tar.SetPublicId(ctx, id))
+	want := oplog.Metadata{
+		"resource-public-id": []string{id},
+		"resource-type":      []string{"postgres target"},
+		"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		"project-id":         []string{id},
+	}
+	assert.Equal(t, want, tar.Oplog(oplog.OpType_OP_TYPE_CREATE))
+}
+
+func TestTarget_SessionRecording(t *testing.T) {
+	ctx := context.Background()
+	tar, err := target.New(ctx, postgres.Subtype, "p_1234567890", target.WithEnableSessionRecording(true), target.WithStorageBucketId("sb_1234567890"))
+	require.NoError(t, err)
+	assert.False(t, tar.GetEnableSessionRecording())
+	assert.Empty(t, tar.GetStorageBucketId())
+}
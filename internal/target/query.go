@@ -60,7 +60,7 @@ select public_id, project_id from target
 `
 
 	estimateCountTargets = `
-select sum(reltuples::bigint) as estimate from pg_class where oid in ('target_tcp'::regclass, 'target_ssh'::regclass)
+select sum(reltuples::bigint) as estimate from pg_class where oid in ('target_tcp'::regclass, 'target_ssh'::regclass, 'target_postgres'::regclass, 'target_http'::regclass)
 `
 
 	listTargetsTemplate = `
@@ -81,6 +81,16 @@ ssh_targets as (
     from target_ssh
    where public_id in (select public_id from targets)
 ),
+postgres_targets as (
+  select *
+    from target_postgres
+   where public_id in (select public_id from targets)
+),
+http_targets as (
+  select *
+    from target_http
+   where public_id in (select public_id from targets)
+),
 final as (
   select public_id,
          project_id,
@@ -119,6 +129,44 @@ final as (
          enable_session_recording,
          'ssh' as type
     from ssh_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'postgres' as type
+    from postgres_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'http' as type
+    from http_targets
 )
   select *
     from final
@@ -144,6 +192,16 @@ ssh_targets as (
     from target_ssh
    where public_id in (select public_id from targets)
 ),
+postgres_targets as (
+  select *
+    from target_postgres
+   where public_id in (select public_id from targets)
+),
+http_targets as (
+  select *
+    from target_http
+   where public_id in (select public_id from targets)
+),
 final as (
   select public_id,
          project_id,
@@ -182,6 +240,44 @@ final as (
          enable_session_recording,
          'ssh' as type
     from ssh_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'postgres' as type
+    from postgres_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'http' as type
+    from http_targets
 )
   select *
     from final
@@ -207,6 +303,16 @@ ssh_targets as (
     from target_ssh
    where public_id in (select public_id from targets)
 ),
+postgres_targets as (
+  select *
+    from target_postgres
+   where public_id in (select public_id from targets)
+),
+http_targets as (
+  select *
+    from target_http
+   where public_id in (select public_id from targets)
+),
 final as (
   select public_id,
          project_id,
@@ -245,6 +351,44 @@ final as (
          enable_session_recording,
          'ssh' as type
     from ssh_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'postgres' as type
+    from postgres_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'http' as type
+    from http_targets
 )
   select *
     from final
@@ -271,6 +415,16 @@ ssh_targets as (
     from target_ssh
    where public_id in (select public_id from targets)
 ),
+postgres_targets as (
+  select *
+    from target_postgres
+   where public_id in (select public_id from targets)
+),
+http_targets as (
+  select *
+    from target_http
+   where public_id in (select public_id from targets)
+),
 final as (
   select public_id,
          project_id,
@@ -309,6 +463,44 @@ final as (
          enable_session_recording,
          'ssh' as type
     from ssh_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'postgres' as type
+    from postgres_targets
+   union
+  select public_id,
+         project_id,
+         name,
+         description,
+         default_port,
+         session_max_seconds,
+         session_connection_limit,
+         version,
+         create_time,
+         update_time,
+         worker_filter,
+         egress_worker_filter,
+         ingress_worker_filter,
+         default_client_port,
+         null as storage_bucket_id,
+         false as enable_session_recording,
+         'http' as type
+    from http_targets
 )
   select *
     from final
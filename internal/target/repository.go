@@ -183,6 +183,12 @@ func (r *Repository) LookupTarget(ctx context.Context, publicIdOrName string, op
 	if err != nil {
 		return nil, errors.Wrap(ctx, err, op)
 	}
+	if _, ok := subtype.(SubtypeFielder); ok {
+		// the fields specific to the subtype are not part of the target view
+		if err := r.reader.LookupByPublicId(ctx, subtype); err != nil {
+			return nil, errors.Wrap(ctx, err, op, errors.WithMsg(fmt.Sprintf("failed to look up subtype fields of %s", target.PublicId)))
+		}
+	}
 	subtype.SetHostSources(hostSources)
 	subtype.SetCredentialSources(credSources)
 	subtype.SetAliases(aliases)
@@ -379,9 +385,18 @@ func (r *Repository) listPermissionWhereClauses() ([]string, []any) {
 	var where []string
 	var args []any
 
+	// Resources denied in a scope are excluded from the resources allowed in
+	// the same scope
+	deniedPatterns := make(map[string][]string)
+	for _, p := range r.permissions {
+		if p.Action == action.List && p.Deny {
+			deniedPatterns[p.GrantScopeId] = append(deniedPatterns[p.GrantScopeId], p.ResourceIdLikePatterns()...)
+		}
+	}
+
 	inClauseCnt := 0
 	for _, p := range r.permissions {
-		if p.Action != action.List {
+		if p.Action != action.List || p.Deny {
 			continue
 		}
 		inClauseCnt++
@@ -395,6 +410,16 @@ func (r *Repository) listPermissionWhereClauses() ([]string, []any) {
 			args = append(args, sql.Named(fmt.Sprintf("public_id_%d", inClauseCnt), "{"+strings.Join(p.ResourceIds, ",")+"}"))
 		}
 
+		if patterns := deniedPatterns[p.GrantScopeId]; len(patterns) > 0 {
+			likeClauses := make([]string, 0, len(patterns))
+			for i, pattern := range patterns {
+				name := fmt.Sprintf("denied_public_id_%d_%d", inClauseCnt, i+1)
+				likeClauses = append(likeClauses, "public_id like @"+name)
+				args = append(args, sql.Named(name, pattern))
+			}
+			clauses = append(clauses, fmt.Sprintf("not (%s)", strings.Join(likeClauses, " or ")))
+		}
+
 		where = append(where, fmt.Sprintf("(%s)", strings.Join(clauses, " and ")))
 	}
 
@@ -655,6 +680,39 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		return nil, db.NoRowsAffected, err
 	}
 
+	updateFields := map[string]any{
+		"Name":                   target.GetName(),
+		"Description":            target.GetDescription(),
+		"DefaultPort":            target.GetDefaultPort(),
+		"DefaultClientPort":      target.GetDefaultClientPort(),
+		"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
+		"SessionConnectionLimit": target.GetSessionConnectionLimit(),
+		"WorkerFilter":           target.GetWorkerFilter(),
+		"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
+		"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
+		"Address":                target.GetAddress(),
+		"StorageBucketId":        target.GetStorageBucketId(),
+		"EnableSessionRecording": target.GetEnableSessionRecording(),
+		"ApprovalRequired":       target.GetApprovalRequired(),
+		"ApproversFilter":        target.GetApproversFilter(),
+		"ApprovalTimeoutSeconds": target.GetApprovalTimeoutSeconds(),
+	}
+	isSubtypeField := func(string) bool { return false }
+	if sf, ok := target.(SubtypeFielder); ok {
+		subtypeFields := sf.SubtypeFields()
+		for k, v := range subtypeFields {
+			updateFields[k] = v
+		}
+		isSubtypeField = func(f string) bool {
+			for k := range subtypeFields {
+				if strings.EqualFold(k, f) {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
 	for _, f := range fieldMaskPaths {
 		switch {
 		case strings.EqualFold("name", f):
@@ -669,6 +727,10 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 		case strings.EqualFold("address", f):
 		case strings.EqualFold("storagebucketid", f):
 		case strings.EqualFold("enablesessionrecording", f):
+		case strings.EqualFold("approvalrequired", f):
+		case strings.EqualFold("approversfilter", f):
+		case strings.EqualFold("approvaltimeoutseconds", f):
+		case isSubtypeField(f):
 		default:
 			return nil, db.NoRowsAffected, errors.New(ctx, errors.InvalidFieldMask, op, fmt.Sprintf("invalid field mask: %s", f))
 		}
@@ -676,22 +738,9 @@ func (r *Repository) UpdateTarget(ctx context.Context, target Target, version ui
 
 	var dbMask, nullFields []string
 	dbMask, nullFields = dbw.BuildUpdatePaths(
-		map[string]any{
-			"Name":                   target.GetName(),
-			"Description":            target.GetDescription(),
-			"DefaultPort":            target.GetDefaultPort(),
-			"DefaultClientPort":      target.GetDefaultClientPort(),
-			"SessionMaxSeconds":      target.GetSessionMaxSeconds(),
-			"SessionConnectionLimit": target.GetSessionConnectionLimit(),
-			"WorkerFilter":           target.GetWorkerFilter(),
-			"EgressWorkerFilter":     target.GetEgressWorkerFilter(),
-			"IngressWorkerFilter":    target.GetIngressWorkerFilter(),
-			"Address":                target.GetAddress(),
-			"StorageBucketId":        target.GetStorageBucketId(),
-			"EnableSessionRecording": target.GetEnableSessionRecording(),
-		},
+		updateFields,
 		fieldMaskPaths,
-		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording"},
+		[]string{"SessionMaxSeconds", "SessionConnectionLimit", "EnableSessionRecording", "ApprovalRequired"},
 	)
 	if len(dbMask) == 0 && len(nullFields) == 0 {
 		return nil, db.NoRowsAffected, errors.New(ctx, errors.EmptyFieldMask, op, "empty field mask")
@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+
+	"github.com/hashicorp/boundary/internal/credential"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestTargetHooks_VetCredentialSources(t *testing.T) {
+	ctx := context.Background()
+	lib := func(p credential.Purpose) *target.CredentialLibrary {
+		return &target.CredentialLibrary{CredentialLibrary: &store.CredentialLibrary{CredentialPurpose: string(p)}}
+	}
+	cred := func(p credential.Purpose) *target.StaticCredential {
+		return &target.StaticCredential{StaticCredential: &store.StaticCredential{CredentialPurpose: string(p)}}
+	}
+	tests := []struct {
+		name    string
+		libs    []*target.CredentialLibrary
+		creds   []*target.StaticCredential
+		wantErr bool
+	}{
+		{
+			name: "brokered",
+			libs: []*target.CredentialLibrary{lib(credential.BrokeredPurpose)},
+		},
+		{
+			name:  "injected application",
+			libs:  []*target.CredentialLibrary{lib(credential.InjectedApplicationPurpose)},
+			creds: []*target.StaticCredential{cred(credential.InjectedApplicationPurpose)},
+		},
+		{
+			name:    "unknown library purpose",
+			libs:    []*target.CredentialLibrary{lib("unknown")},
+			wantErr: true,
+		},
+		{
+			name:    "unknown static purpose",
+			creds:   []*target.StaticCredential{cred("unknown")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := targetHooks{}.VetCredentialSources(ctx, tt.libs, tt.creds)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestTargetHooks_Vet(t *testing.T) {
+	ctx := context.Background()
+	tar, err := targetHooks{}.NewTarget(ctx, "p_1234567890")
+	assert.NoError(t, err)
+	assert.NoError(t, targetHooks{}.Vet(ctx, tar))
+
+	tar.SetDefaultPort(0)
+	assert.Error(t, targetHooks{}.Vet(ctx, tar))
+
+	tar.SetDefaultPort(70000)
+	assert.Error(t, targetHooks{}.Vet(ctx, tar))
+}
+
+func TestTargetHooks_VetKnownHosts(t *testing.T) {
+	ctx := context.Background()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		knownHosts string
+		wantErr    bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:       "host-key",
+			knownHosts: knownhosts.Line([]string{"ssh.example.com"}, sshPub),
+		},
+		{
+			name:       "cert-authority",
+			knownHosts: "# host CA\n@cert-authority *.example.com " + string(ssh.MarshalAuthorizedKey(sshPub)),
+		},
+		{
+			name:       "invalid",
+			knownHosts: "ssh.example.com not-a-key",
+			wantErr:    true,
+		},
+		{
+			name:       "only-comments",
+			knownHosts: "# no entries\n",
+			wantErr:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tar, err := targetHooks{}.NewTarget(ctx, "p_1234567890", target.WithKnownHosts(tc.knownHosts))
+			require.NoError(t, err)
+			if tc.wantErr {
+				assert.Error(t, targetHooks{}.Vet(ctx, tar))
+				assert.Error(t, targetHooks{}.VetForUpdate(ctx, tar, []string{"KnownHosts"}))
+				return
+			}
+			assert.NoError(t, targetHooks{}.Vet(ctx, tar))
+			assert.NoError(t, targetHooks{}.VetForUpdate(ctx, tar, []string{"KnownHosts"}))
+		})
+	}
+}
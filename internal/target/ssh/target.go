@@ -0,0 +1,316 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package ssh provides a Target subtype for an SSH Target.
+// Importing this package will register it with the target package and
+// allow the target.Repository to support ssh.Targets.
+//
+// Unlike a tcp.Target, the worker terminates the SSH protocol for an
+// ssh.Target, which allows credentials with an injected_application purpose
+// to be used to authenticate to the endpoint without ever being returned to
+// the client.
+package ssh
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/boundary/globals"
+	talias "github.com/hashicorp/boundary/internal/alias/target"
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/db/timestamp"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh/store"
+	"github.com/hashicorp/boundary/internal/types/resource"
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultTableName = "target_ssh"
+	Subtype          = globals.Subtype("ssh")
+
+	// DefaultPort is the port used for an ssh.Target when no default port
+	// is provided.
+	DefaultPort = 22
+)
+
+// Target is a resources that represets a networked service
+// that can be accessed via SSH. It is a subtype of target.Target.
+type Target struct {
+	*store.Target
+	// Network address assigned to the Target.
+	Address           string                    `json:"address,omitempty" gorm:"-"`
+	tableName         string                    `gorm:"-"`
+	HostSource        []target.HostSource       `gorm:"-"`
+	CredentialSources []target.CredentialSource `gorm:"-"`
+	Aliases           []*talias.Alias           `gorm:"-"`
+}
+
+// Ensure Target implements interfaces
+var (
+	_ target.Target           = (*Target)(nil)
+	_ target.SubtypeFielder   = (*Target)(nil)
+	_ db.VetForWriter         = (*Target)(nil)
+	_ oplog.ReplayableMessage = (*Target)(nil)
+)
+
+// NewTarget creates a new in memory ssh target.  WithName, WithDescription,
+// WithDefaultPort, WithEnableSessionRecording, WithStorageBucketId,
+// WithKnownHosts and WithInsecureSkipHostKeyVerification options are
+// supported. If no default port is provided DefaultPort is used.
+func (h targetHooks) NewTarget(ctx context.Context, projectId string, opt ...target.Option) (target.Target, error) {
+	const op = "ssh.NewTarget"
+	opts := target.GetOpts(opt...)
+	if projectId == "" {
+		return nil, errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+	}
+	defaultPort := opts.WithDefaultPort
+	if defaultPort == 0 {
+		defaultPort = DefaultPort
+	}
+	t := &Target{
+		Target: &store.Target{
+			ProjectId:                       projectId,
+			Name:                            opts.WithName,
+			Description:                     opts.WithDescription,
+			DefaultPort:                     defaultPort,
+			DefaultClientPort:               opts.WithDefaultClientPort,
+			SessionConnectionLimit:          opts.WithSessionConnectionLimit,
+			SessionMaxSeconds:               opts.WithSessionMaxSeconds,
+			WorkerFilter:                    opts.WithWorkerFilter,
+			EgressWorkerFilter:              opts.WithEgressWorkerFilter,
+			IngressWorkerFilter:             opts.WithIngressWorkerFilter,
+			ApprovalRequired:                opts.WithApprovalRequired,
+			ApproversFilter:                 opts.WithApproversFilter,
+			ApprovalTimeoutSeconds:          opts.WithApprovalTimeoutSeconds,
+			EnableSessionRecording:          opts.WithEnableSessionRecording,
+			StorageBucketId:                 opts.WithStorageBucketId,
+			KnownHosts:                      opts.WithKnownHosts,
+			InsecureSkipHostKeyVerification: opts.WithInsecureSkipHostKeyVerification,
+		},
+		Address: opts.WithAddress,
+	}
+	return t, nil
+}
+
+// AllocTarget will allocate an ssh target
+func (h targetHooks) AllocTarget() target.Target {
+	return &Target{
+		Target: &store.Target{},
+	}
+}
+
+// Clone creates a clone of the Target
+func (t *Target) Clone() target.Target {
+	cp := proto.Clone(t.Target)
+	return &Target{
+		Target:            cp.(*store.Target),
+		Address:           t.Address,
+		HostSource:        t.HostSource,
+		CredentialSources: t.CredentialSources,
+		Aliases:           t.Aliases,
+	}
+}
+
+// VetForWrite implements db.VetForWrite() interface and validates the ssh target
+// before it's written.
+func (t *Target) VetForWrite(ctx context.Context, _ db.Reader, opType db.OpType, _ ...db.Option) error {
+	const op = "ssh.(Target).VetForWrite"
+	if t.PublicId == "" {
+		return errors.New(ctx, errors.InvalidParameter, op, "missing public id")
+	}
+	if opType == db.CreateOp {
+		if t.ProjectId == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing project id")
+		}
+		if t.Name == "" {
+			return errors.New(ctx, errors.InvalidParameter, op, "missing name")
+		}
+	}
+	return nil
+}
+
+// TableName returns the tablename to override the default gorm table name
+func (t *Target) TableName() string {
+	if t.tableName != "" {
+		return t.tableName
+	}
+	return defaultTableName
+}
+
+// SetTableName sets the tablename and satisfies the ReplayableMessage
+// interface. If the caller attempts to set the name to "" the name will be
+// reset to the default name.
+func (t *Target) SetTableName(n string) {
+	t.tableName = n
+}
+
+// Oplog provides the oplog.Metadata for recording operations taken on a Target.
+func (t *Target) Oplog(op oplog.OpType) oplog.Metadata {
+	metadata := oplog.Metadata{
+		"resource-public-id": []string{t.PublicId},
+		"resource-type":      []string{"ssh target"},
+		"op-type":            []string{op.String()},
+		"project-id":         []string{t.ProjectId},
+	}
+	return metadata
+}
+
+// SubtypeFields returns the host key verification settings of the target,
+// which can be updated in addition to the fields common to all targets.
+func (t *Target) SubtypeFields() map[string]any {
+	return map[string]any{
+		"KnownHosts":                      t.GetKnownHosts(),
+		"InsecureSkipHostKeyVerification": t.GetInsecureSkipHostKeyVerification(),
+	}
+}
+
+// ValidateKnownHosts returns an error if knownHosts is not in the OpenSSH
+// known_hosts format or does not contain any entry.
+func ValidateKnownHosts(knownHosts string) error {
+	in := []byte(knownHosts)
+	var entries int
+	for {
+		_, _, _, _, rest, err := ssh.ParseKnownHosts(in)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		entries++
+		in = rest
+	}
+	if entries == 0 {
+		return fmt.Errorf("no known hosts entries")
+	}
+	return nil
+}
+
+func (t *Target) GetType() globals.Subtype {
+	return Subtype
+}
+
+func (t *Target) GetAddress() string {
+	return t.Address
+}
+
+func (t *Target) GetAliases() []*talias.Alias {
+	return t.Aliases
+}
+
+func (t *Target) GetHostSources() []target.HostSource {
+	return t.HostSource
+}
+
+func (t *Target) GetCredentialSources() []target.CredentialSource {
+	return t.CredentialSources
+}
+
+func (t *Target) SetPublicId(ctx context.Context, publicId string) error {
+	const op = "ssh.(Target).SetPublicId"
+	if !strings.HasPrefix(publicId, TargetPrefix+"_") {
+		return errors.New(ctx, errors.InvalidParameter, op, fmt.Sprintf("passed-in public ID %q has wrong prefix, should be %q", publicId, TargetPrefix))
+	}
+
+	t.PublicId = publicId
+	return nil
+}
+
+func (t *Target) SetProjectId(projectId string) {
+	t.ProjectId = projectId
+}
+
+func (t *Target) SetName(name string) {
+	t.Name = name
+}
+
+func (t *Target) SetDescription(description string) {
+	t.Description = description
+}
+
+// GetResourceType returns the resource type of the Target
+func (t *Target) GetResourceType() resource.Type {
+	return resource.Target
+}
+
+func (t *Target) SetVersion(v uint32) {
+	t.Version = v
+}
+
+func (t *Target) SetDefaultPort(port uint32) {
+	t.DefaultPort = port
+}
+
+func (t *Target) SetDefaultClientPort(port uint32) {
+	t.DefaultClientPort = port
+}
+
+func (t *Target) SetCreateTime(ts *timestamp.Timestamp) {
+	t.CreateTime = ts
+}
+
+func (t *Target) SetUpdateTime(ts *timestamp.Timestamp) {
+	t.UpdateTime = ts
+}
+
+func (t *Target) SetSessionMaxSeconds(s uint32) {
+	t.SessionMaxSeconds = s
+}
+
+func (t *Target) SetSessionConnectionLimit(limit int32) {
+	t.SessionConnectionLimit = limit
+}
+
+func (t *Target) SetWorkerFilter(filter string) {
+	t.WorkerFilter = filter
+}
+
+func (t *Target) SetEgressWorkerFilter(filter string) {
+	t.EgressWorkerFilter = filter
+}
+
+func (t *Target) SetIngressWorkerFilter(filter string) {
+	t.IngressWorkerFilter = filter
+}
+
+func (t *Target) SetApprovalRequired(required bool) {
+	t.ApprovalRequired = required
+}
+
+func (t *Target) SetApproversFilter(filter string) {
+	t.ApproversFilter = filter
+}
+
+func (t *Target) SetApprovalTimeoutSeconds(seconds uint32) {
+	t.ApprovalTimeoutSeconds = seconds
+}
+
+func (t *Target) SetAddress(address string) {
+	t.Address = address
+}
+
+func (t *Target) SetAliases(aliases []*talias.Alias) {
+	t.Aliases = aliases
+}
+
+func (t *Target) SetHostSources(sources []target.HostSource) {
+	t.HostSource = sources
+}
+
+func (t *Target) SetCredentialSources(sources []target.CredentialSource) {
+	t.CredentialSources = sources
+}
+
+func (t *Target) SetEnableSessionRecording(enable bool) {
+	t.EnableSessionRecording = enable
+}
+
+func (t *Target) SetStorageBucketId(id string) {
+	t.StorageBucketId = id
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package ssh_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/boundary/internal/db"
+	"github.com/hashicorp/boundary/internal/errors"
+	"github.com/hashicorp/boundary/internal/iam"
+	"github.com/hashicorp/boundary/internal/oplog"
+	"github.com/hashicorp/boundary/internal/target"
+	"github.com/hashicorp/boundary/internal/target/ssh"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestTarget_New(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		projectId string
+		opt       []target.Option
+		wantPort  uint32
+		wantErr   bool
+		wantIsErr errors.Code
+	}{
+		{
+			name:      "empty-projectId",
+			wantErr:   true,
+			wantIsErr: errors.InvalidParameter,
+		},
+		{
+			name:      "default-port",
+			projectId: "p_1234567890",
+			wantPort:  ssh.DefaultPort,
+		},
+		{
+			name:      "with-port",
+			projectId: "p_1234567890",
+			opt:       []target.Option{target.WithDefaultPort(2222)},
+			wantPort:  2222,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			got, err := target.New(ctx, ssh.Subtype, tt.projectId, tt.opt...)
+			if tt.wantErr {
+				require.Error(err)
+				assert.True(errors.Match(errors.T(tt.wantIsErr), err))
+				return
+			}
+			require.NoError(err)
+			assert.Equal(ssh.Subtype, got.GetType())
+			assert.Equal(tt.wantPort, got.GetDefaultPort())
+		})
+	}
+}
+
+func TestTarget_Create(t *testing.T) {
+	t.Parallel()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	_, prj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+	ctx := context.Background()
+
+	assert, require := assert.New(t), require.New(t)
+	got, err := target.New(
+		ctx,
+		ssh.Subtype,
+		prj.PublicId,
+		target.WithName("valid-proj-id"),
+		target.WithSessionMaxSeconds(uint32((8 * time.Hour).Seconds())),
+		target.WithSessionConnectionLimit(-1),
+	)
+	require.NoError(err)
+	id, err := db.NewPublicId(ctx, ssh.TargetPrefix)
+	require.NoError(err)
+	require.NoError(got.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).Create(ctx, got))
+
+	found, err := target.New(ctx, ssh.Subtype, prj.PublicId)
+	require.NoError(err)
+	require.NoError(found.SetPublicId(ctx, id))
+	require.NoError(db.New(conn).LookupByPublicId(ctx, found))
+	assert.True(proto.Equal(got.(*ssh.Target).Target, found.(*ssh.Target).Target))
+}
+
+func TestTarget_Clone(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	conn, _ := db.TestSetup(t, "postgres")
+	wrapper := db.TestWrapper(t)
+	t.Run("valid", func(t *testing.T) {
+		assert := assert.New(t)
+		_, proj := iam.TestScopes(t, iam.TestRepo(t, conn, wrapper))
+		tar := ssh.TestTarget(ctx, t, conn, proj.PublicId, ssh.TestTargetName(t, proj.PublicId),
+			target.WithAddress("8.8.8.8"),
+		)
+		cp := tar.Clone()
+		assert.True(proto.Equal(cp.(*ssh.Target).Target, tar.(*ssh.Target).Target))
+		assert.Equal(tar.GetAddress(), cp.GetAddress())
+	})
+}
+
+func TestTable_SetTableName(t *testing.T) {
+	t.Parallel()
+	defaultTableName := ssh.DefaultTableName
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		setNameTo string
+		want      string
+	}{
+		{
+			name:      "new-name",
+			setNameTo: "new-name",
+			want:      "new-name",
+		},
+		{
+			name:      "reset to default",
+			setNameTo: "",
+			want:      defaultTableName,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert, require := assert.New(t), require.New(t)
+			def, _ := target.New(ctx, ssh.Subtype, "testScope")
+			require.Equal(defaultTableName, def.(*ssh.Target).TableName())
+			ss, _ := target.New(ctx, ssh.Subtype, "testScope")
+			s := ss.(*ssh.Target)
+			s.SetTableName(tt.setNameTo)
+			assert.Equal(tt.want, s.TableName())
+		})
+	}
+}
+
+func TestTarget_oplog(t *testing.T) {
+	ctx := context.Background()
+	id := ssh.TestId(t)
+	tar, err := target.New(ctx, ssh.Subtype, id)
+	require.NoError(t, err)
+	require.NoError(t, tar.SetPublicId(ctx, id))
+	want := oplog.Metadata{
+		"resource-public-id": []string{id},
+		"resource-type":      []string{"ssh target"},
+		"op-type":            []string{oplog.OpType_OP_TYPE_CREATE.String()},
+		"project-id":         []string{id},
+	}
+	assert.Equal(t, want, tar.Oplog(oplog.OpType_OP_TYPE_CREATE))
+}
+
+func TestTarget_SessionRecording(t *testing.T) {
+	ctx := context.Background()
+	tar, err := target.New(ctx, ssh.Subtype, "p_1234567890", target.WithEnableSessionRecording(true), target.WithStorageBucketId("sb_1234567890"))
+	require.NoError(t, err)
+	assert.True(t, tar.GetEnableSessionRecording())
+	assert.Equal(t, "sb_1234567890", tar.GetStorageBucketId())
+
+	tar.SetEnableSessionRecording(false)
+	tar.SetStorageBucketId("")
+	assert.False(t, tar.GetEnableSessionRecording())
+	assert.Empty(t, tar.GetStorageBucketId())
+}
+
+func TestTarget_HostKeyVerification(t *testing.T) {
+	ctx := context.Background()
+	knownHosts := "example.com ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl"
+	tar, err := target.New(ctx, ssh.Subtype, "p_1234567890", target.WithKnownHosts(knownHosts), target.WithInsecureSkipHostKeyVerification(true))
+	require.NoError(t, err)
+	st, ok := tar.(*ssh.Target)
+	require.True(t, ok)
+	assert.Equal(t, knownHosts, st.GetKnownHosts())
+	assert.True(t, st.GetInsecureSkipHostKeyVerification())
+	assert.Equal(t, map[string]any{
+		"KnownHosts":                      knownHosts,
+		"InsecureSkipHostKeyVerification": true,
+	}, st.SubtypeFields())
+
+	assert.NoError(t, ssh.ValidateKnownHosts(knownHosts))
+	assert.Error(t, ssh.ValidateKnownHosts(""))
+	assert.Error(t, ssh.ValidateKnownHosts("example.com not-a-key"))
+}
@@ -42,6 +42,9 @@ type Target interface {
 	GetCredentialSources() []CredentialSource
 	GetStorageBucketId() string
 	GetEnableSessionRecording() bool
+	GetApprovalRequired() bool
+	GetApproversFilter() string
+	GetApprovalTimeoutSeconds() uint32
 	Clone() Target
 	SetPublicId(context.Context, string) error
 	SetProjectId(string)
@@ -63,9 +66,21 @@ type Target interface {
 	SetCredentialSources([]CredentialSource)
 	SetStorageBucketId(string)
 	SetEnableSessionRecording(bool)
+	SetApprovalRequired(bool)
+	SetApproversFilter(string)
+	SetApprovalTimeoutSeconds(uint32)
 	Oplog(op oplog.OpType) oplog.Metadata
 }
 
+// SubtypeFielder is implemented by the targets of subtypes which have fields
+// specific to their subtype. The fields can be updated with UpdateTarget in
+// addition to the fields common to all targets.
+type SubtypeFielder interface {
+	// SubtypeFields returns the values of the subtype specific fields of the
+	// target keyed by their field name.
+	SubtypeFields() map[string]any
+}
+
 const (
 	targetsViewDefaultTable = "target_all_subtypes"
 )
@@ -191,6 +206,9 @@ func (t *targetView) targetSubtype(ctx context.Context, address string) (Target,
 	tt.SetCredentialSources(t.CredentialSources)
 	tt.SetEnableSessionRecording(t.EnableSessionRecording)
 	tt.SetStorageBucketId(t.StorageBucketId)
+	tt.SetApprovalRequired(t.ApprovalRequired)
+	tt.SetApproversFilter(t.ApproversFilter)
+	tt.SetApprovalTimeoutSeconds(t.ApprovalTimeoutSeconds)
 	return tt, nil
 }
 
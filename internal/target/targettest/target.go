@@ -233,6 +233,18 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetApprovalRequired(required bool) {
+	t.ApprovalRequired = required
+}
+
+func (t *Target) SetApproversFilter(filter string) {
+	t.ApproversFilter = filter
+}
+
+func (t *Target) SetApprovalTimeoutSeconds(seconds uint32) {
+	t.ApprovalTimeoutSeconds = seconds
+}
+
 func (t *Target) SetAddress(a string) {
 	t.Address = a
 }
@@ -338,6 +350,9 @@ func New(ctx context.Context, projectId string, opt ...target.Option) (target.Ta
 			WorkerFilter:           opts.WithWorkerFilter,
 			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
 			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ApprovalRequired:       opts.WithApprovalRequired,
+			ApproversFilter:        opts.WithApproversFilter,
+			ApprovalTimeoutSeconds: opts.WithApprovalTimeoutSeconds,
 		},
 		Address: opts.WithAddress,
 	}
@@ -67,6 +67,9 @@ func (h targetHooks) NewTarget(ctx context.Context, projectId string, opt ...tar
 			WorkerFilter:           opts.WithWorkerFilter,
 			EgressWorkerFilter:     opts.WithEgressWorkerFilter,
 			IngressWorkerFilter:    opts.WithIngressWorkerFilter,
+			ApprovalRequired:       opts.WithApprovalRequired,
+			ApproversFilter:        opts.WithApproversFilter,
+			ApprovalTimeoutSeconds: opts.WithApprovalTimeoutSeconds,
 		},
 		Address: opts.WithAddress,
 	}
@@ -231,6 +234,18 @@ func (t *Target) SetIngressWorkerFilter(filter string) {
 	t.IngressWorkerFilter = filter
 }
 
+func (t *Target) SetApprovalRequired(required bool) {
+	t.ApprovalRequired = required
+}
+
+func (t *Target) SetApproversFilter(filter string) {
+	t.ApproversFilter = filter
+}
+
+func (t *Target) SetApprovalTimeoutSeconds(seconds uint32) {
+	t.ApprovalTimeoutSeconds = seconds
+}
+
 func (t *Target) SetAddress(address string) {
 	t.Address = address
 }
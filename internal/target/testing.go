@@ -8,12 +8,64 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/boundary/globals"
 	"github.com/hashicorp/boundary/internal/credential"
 	"github.com/hashicorp/boundary/internal/db"
 	"github.com/hashicorp/boundary/internal/target/store"
 	"github.com/stretchr/testify/require"
 )
 
+// TestTarget creates a target of the registered subtype in the database along
+// with its address, host sources and credential sources from opt. Subtype
+// packages use it to provide their TestTarget helpers.
+func TestTarget(ctx context.Context, t testing.TB, conn *db.DB, subtype globals.Subtype, projectId, name string, opt ...Option) Target {
+	t.Helper()
+	opt = append(opt, WithName(name))
+	opts := GetOpts(opt...)
+	require := require.New(t)
+	rw := db.New(conn)
+	prefix, ok := subtypeRegistry.idPrefix(subtype)
+	require.True(ok, "subtype %q is not registered", subtype)
+	tar, err := New(ctx, subtype, projectId, opt...)
+	require.NoError(err)
+	id, err := db.NewPublicId(ctx, prefix)
+	require.NoError(err)
+	require.NoError(tar.SetPublicId(ctx, id))
+	require.NoError(rw.Create(ctx, tar))
+
+	if opts.WithAddress != "" {
+		address, err := NewAddress(ctx, tar.GetPublicId(), opts.WithAddress)
+		require.NoError(err)
+		require.NoError(rw.Create(ctx, address))
+	}
+	if len(opts.WithHostSources) > 0 {
+		newHostSets := make([]*TargetHostSet, 0, len(opts.WithHostSources))
+		for _, s := range opts.WithHostSources {
+			hostSet, err := NewTargetHostSet(ctx, tar.GetPublicId(), s)
+			require.NoError(err)
+			newHostSets = append(newHostSets, hostSet)
+		}
+		require.NoError(rw.CreateItems(ctx, newHostSets))
+	}
+	if len(opts.WithCredentialLibraries) > 0 {
+		newCredLibs := make([]*CredentialLibrary, 0, len(opts.WithCredentialLibraries))
+		for _, cl := range opts.WithCredentialLibraries {
+			cl.TargetId = tar.GetPublicId()
+			newCredLibs = append(newCredLibs, cl)
+		}
+		require.NoError(rw.CreateItems(ctx, newCredLibs))
+	}
+	if len(opts.WithStaticCredentials) > 0 {
+		newCreds := make([]*StaticCredential, 0, len(opts.WithStaticCredentials))
+		for _, c := range opts.WithStaticCredentials {
+			c.TargetId = tar.GetPublicId()
+			newCreds = append(newCreds, c)
+		}
+		require.NoError(rw.CreateItems(ctx, newCreds))
+	}
+	return tar
+}
+
 // TestNewCredentialLibrary creates a new in memory CredentialLibrary
 // representing the relationship between targetId and credentialLibraryId with
 // the given purpose.
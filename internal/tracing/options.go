@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tracing
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// getOpts - iterate the inbound Options and return a struct.
+func getOpts(opt ...Option) options {
+	opts := getDefaultOptions()
+	for _, o := range opt {
+		if o != nil {
+			o(&opts)
+		}
+	}
+	return opts
+}
+
+// Option - how Options are passed as arguments.
+type Option func(*options)
+
+// options = how options are represented
+type options struct {
+	withServiceInstanceId string
+	withExporter          sdktrace.SpanExporter
+}
+
+func getDefaultOptions() options {
+	return options{}
+}
+
+// WithServiceInstanceId provides an optional service instance id, which
+// identifies the server of the exported spans.
+func WithServiceInstanceId(id string) Option {
+	return func(o *options) {
+		o.withServiceInstanceId = id
+	}
+}
+
+// WithExporter provides an optional span exporter, which replaces the OTLP
+// exporter.
+func WithExporter(e sdktrace.SpanExporter) Option {
+	return func(o *options) {
+		o.withExporter = e
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+// Package tracing provides OpenTelemetry tracing for Boundary's controllers
+// and workers. Spans are exported with OTLP over gRPC to a collector, and the
+// trace context is propagated with the W3C traceparent header across HTTP
+// requests and gRPC calls, including the cluster RPCs between controllers and
+// workers.
+//
+// Until Setup is called, the global tracer provider is a no-op, so spans
+// started with Start cost almost nothing when tracing is not configured.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/boundary/version"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/stats"
+)
+
+const (
+	// instrumentationName is the name of the tracer of Boundary's spans.
+	instrumentationName = "github.com/hashicorp/boundary"
+
+	// DefaultEndpoint is the endpoint of a collector running on the host.
+	DefaultEndpoint = "localhost:4317"
+
+	// DefaultServiceName is the service name of the exported spans.
+	DefaultServiceName = "boundary"
+)
+
+// Config defines the configuration of the export of spans to a collector.
+type Config struct {
+	Endpoint    string            `hcl:"endpoint"`     // Endpoint defines the host:port of the OTLP gRPC collector, defaulting to localhost:4317.
+	Insecure    bool              `hcl:"insecure"`     // Insecure disables TLS for the connection to the collector.
+	Headers     map[string]string `hcl:"headers"`      // Headers defines additional headers sent to the collector.
+	ServiceName string            `hcl:"service_name"` // ServiceName defines the service name of the spans, defaulting to boundary.
+	SampleRatio *float64          `hcl:"sample_ratio"` // SampleRatio defines the ratio of traces which are sampled, defaulting to 1. Spans with a sampled parent are always sampled.
+}
+
+// Validate a Config
+func (c *Config) Validate() error {
+	const op = "tracing.(Config).Validate"
+	if c.SampleRatio != nil && (*c.SampleRatio < 0 || *c.SampleRatio > 1) {
+		return fmt.Errorf("%s: sample ratio must be between 0 and 1", op)
+	}
+	return nil
+}
+
+// Setup sets the global tracer provider to one which exports spans to the
+// collector of the config, and the global propagator to W3C trace context
+// and baggage. The returned function flushes the spans which have not been
+// exported yet and shuts down the provider. Supported options are
+// WithServiceInstanceId and WithExporter.
+func Setup(ctx context.Context, c *Config, opt ...Option) (func(context.Context) error, error) {
+	const op = "tracing.Setup"
+	if c == nil {
+		return nil, fmt.Errorf("%s: missing config", op)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	opts := getOpts(opt...)
+
+	exporter := opts.withExporter
+	if exporter == nil {
+		endpoint := c.Endpoint
+		if endpoint == "" {
+			endpoint = DefaultEndpoint
+		}
+		exporterOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+		if c.Insecure {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithInsecure())
+		}
+		if len(c.Headers) > 0 {
+			exporterOpts = append(exporterOpts, otlptracegrpc.WithHeaders(c.Headers))
+		}
+		// the exporter connects lazily, so a collector which is not up yet
+		// does not prevent the server from starting.
+		var err error
+		exporter, err = otlptracegrpc.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("%s: unable to create otlp exporter: %w", op, err)
+		}
+	}
+
+	serviceName := c.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	attrs := []attribute.KeyValue{
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version.Get().VersionNumber()),
+	}
+	if opts.withServiceInstanceId != "" {
+		attrs = append(attrs, semconv.ServiceInstanceID(opts.withServiceInstanceId))
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, attrs...))
+	if err != nil {
+		return nil, fmt.Errorf("%s: unable to create resource: %w", op, err)
+	}
+
+	ratio := 1.0
+	if c.SampleRatio != nil {
+		ratio = *c.SampleRatio
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// Start starts a span with Boundary's tracer, which is a child of the span in
+// the context if there is one.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End records the error, if any, and ends the span. It is meant to be
+// deferred with the address of a named error return.
+func End(span trace.Span, err *error) {
+	if err != nil && *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
+
+// GrpcServerHandler returns a gRPC stats handler which creates a span for
+// every call received by a server, as a child of the caller's span.
+func GrpcServerHandler() stats.Handler {
+	return otelgrpc.NewServerHandler()
+}
+
+// GrpcClientHandler returns a gRPC stats handler which creates a span for
+// every call made by a client, and propagates it to the server.
+func GrpcClientHandler() stats.Handler {
+	return otelgrpc.NewClientHandler()
+}
+
+// HttpHandler wraps an http.Handler so a span is created for every request,
+// as a child of the span of the request's traceparent header if it has one.
+func HttpHandler(h http.Handler, operation string) http.Handler {
+	return otelhttp.NewHandler(h, operation, otelhttp.WithSpanNameFormatter(func(operation string, r *http.Request) string {
+		return operation + " " + r.Method
+	}))
+}
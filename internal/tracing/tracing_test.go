@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: BUSL-1.1
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func testRatio(r float64) *float64 { return &r }
+
+// testExporter keeps the exported spans once it is shut down, so they can be
+// inspected after the provider has flushed them.
+type testExporter struct {
+	*tracetest.InMemoryExporter
+}
+
+func (testExporter) Shutdown(context.Context) error { return nil }
+
+// testSetup sets up tracing with an in memory exporter, and restores the
+// global tracer provider and propagator at the end of the test.
+func testSetup(t *testing.T, c *Config, opt ...Option) (*tracetest.InMemoryExporter, func(context.Context) error) {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	shutdown, err := Setup(context.Background(), c, append(opt, WithExporter(testExporter{exporter}))...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+	return exporter, shutdown
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *Config
+		wantErr bool
+	}{
+		{name: "empty", c: &Config{}},
+		{name: "zero-ratio", c: &Config{SampleRatio: testRatio(0)}},
+		{name: "one-ratio", c: &Config{SampleRatio: testRatio(1)}},
+		{name: "negative-ratio", c: &Config{SampleRatio: testRatio(-0.1)}, wantErr: true},
+		{name: "ratio-above-one", c: &Config{SampleRatio: testRatio(1.1)}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.c.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("missing-config", func(t *testing.T) {
+		_, err := Setup(context.Background(), nil)
+		assert.Error(t, err)
+	})
+	t.Run("invalid-config", func(t *testing.T) {
+		_, err := Setup(context.Background(), &Config{SampleRatio: testRatio(2)})
+		assert.Error(t, err)
+	})
+	t.Run("resource", func(t *testing.T) {
+		assert, require := assert.New(t), require.New(t)
+		exporter, shutdown := testSetup(t, &Config{ServiceName: "test-service"}, WithServiceInstanceId("test-instance"))
+
+		_, span := Start(context.Background(), "test-span", attribute.String("test.key", "value"))
+		span.End()
+		require.NoError(shutdown(context.Background()))
+
+		spans := exporter.GetSpans()
+		require.Len(spans, 1)
+		assert.Equal("test-span", spans[0].Name)
+		assert.Contains(spans[0].Attributes, attribute.String("test.key", "value"))
+		attrs := spans[0].Resource.Attributes()
+		assert.Contains(attrs, semconv.ServiceName("test-service"))
+		assert.Contains(attrs, semconv.ServiceInstanceID("test-instance"))
+	})
+	t.Run("never-sampled", func(t *testing.T) {
+		require := require.New(t)
+		exporter, shutdown := testSetup(t, &Config{SampleRatio: testRatio(0)})
+
+		_, span := Start(context.Background(), "test-span")
+		span.End()
+		require.NoError(shutdown(context.Background()))
+		require.Empty(exporter.GetSpans())
+	})
+}
+
+func TestEnd(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	exporter, shutdown := testSetup(t, &Config{})
+
+	_, span := Start(context.Background(), "succeeded")
+	var err error
+	End(span, &err)
+	_, span = Start(context.Background(), "failed")
+	err = errors.New("test error")
+	End(span, &err)
+	_, span = Start(context.Background(), "no-error")
+	End(span, nil)
+	require.NoError(shutdown(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(spans, 3)
+	assert.Equal(codes.Unset, spans[0].Status.Code)
+	assert.Equal(codes.Error, spans[1].Status.Code)
+	assert.Equal("test error", spans[1].Status.Description)
+	require.Len(spans[1].Events, 1)
+	assert.Equal("exception", spans[1].Events[0].Name)
+	assert.Equal(codes.Unset, spans[2].Status.Code)
+}
+
+func TestHttpHandler(t *testing.T) {
+	assert, require := assert.New(t), require.New(t)
+	exporter, shutdown := testSetup(t, &Config{})
+
+	var handlerSpan trace.SpanContext
+	h := HttpHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handlerSpan = trace.SpanContextFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	}), "api")
+
+	// the span of the request is a child of the span of its traceparent header
+	ctx, parent := Start(context.Background(), "client")
+	r := httptest.NewRequest(http.MethodGet, "/v1/targets", nil)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(r.Header))
+	h.ServeHTTP(httptest.NewRecorder(), r)
+	parent.End()
+	require.NoError(shutdown(context.Background()))
+
+	assert.Equal(parent.SpanContext().TraceID(), handlerSpan.TraceID())
+	spans := exporter.GetSpans()
+	require.Len(spans, 2)
+	assert.Equal("api GET", spans[0].Name)
+	assert.Equal(parent.SpanContext().SpanID(), spans[0].Parent.SpanID())
+}
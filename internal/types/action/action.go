@@ -76,6 +76,20 @@ const (
 	RemoveGrantScopes                  Type = 62
 	MonthlyActiveUsers                 Type = 63
 	ListResolvableAliases              Type = 64
+	Search                             Type = 65
+	ListRetentionReports               Type = 66
+	SetLegalHold                       Type = 67
+	RemoveLegalHold                    Type = 68
+	RequestAccess                      Type = 69
+	ListAccessRequests                 Type = 70
+	ApproveRequests                    Type = 71
+	Approve                            Type = 72
+	Explain                            Type = 73
+	ValidateGrants                     Type = 74
+	Rotate                             Type = 75
+	Rollback                           Type = 76
+	EnrollTotp                         Type = 77
+	RemoveTotp                         Type = 78
 
 	// When adding new actions, be sure to update:
 	//
@@ -148,6 +162,20 @@ var Map = map[string]Type{
 	RemoveGrantScopes.String():                  RemoveGrantScopes,
 	MonthlyActiveUsers.String():                 MonthlyActiveUsers,
 	ListResolvableAliases.String():              ListResolvableAliases,
+	Search.String():                             Search,
+	ListRetentionReports.String():               ListRetentionReports,
+	SetLegalHold.String():                       SetLegalHold,
+	RemoveLegalHold.String():                    RemoveLegalHold,
+	RequestAccess.String():                      RequestAccess,
+	ListAccessRequests.String():                 ListAccessRequests,
+	ApproveRequests.String():                    ApproveRequests,
+	Approve.String():                            Approve,
+	Explain.String():                            Explain,
+	ValidateGrants.String():                     ValidateGrants,
+	Rotate.String():                             Rotate,
+	Rollback.String():                           Rollback,
+	EnrollTotp.String():                         EnrollTotp,
+	RemoveTotp.String():                         RemoveTotp,
 }
 
 var DeprecatedMap = map[string]Type{
@@ -226,6 +254,20 @@ func (a Type) String() string {
 		"remove-grant-scopes",
 		"monthly-active-users",
 		"list-resolvable-aliases",
+		"search",
+		"list-retention-reports",
+		"set-legal-hold",
+		"remove-legal-hold",
+		"request-access",
+		"list-access-requests",
+		"approve-requests",
+		"approve",
+		"explain",
+		"validate-grants",
+		"rotate",
+		"rollback",
+		"enroll-totp",
+		"remove-totp",
 	}[a]
 }
 
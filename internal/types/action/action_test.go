@@ -170,6 +170,62 @@ func TestAction(t *testing.T) {
 			action: ListResolvableAliases,
 			want:   "list-resolvable-aliases",
 		},
+		{
+			action: Search,
+			want:   "search",
+		},
+		{
+			action: ListRetentionReports,
+			want:   "list-retention-reports",
+		},
+		{
+			action: SetLegalHold,
+			want:   "set-legal-hold",
+		},
+		{
+			action: RemoveLegalHold,
+			want:   "remove-legal-hold",
+		},
+		{
+			action: RequestAccess,
+			want:   "request-access",
+		},
+		{
+			action: ListAccessRequests,
+			want:   "list-access-requests",
+		},
+		{
+			action: ApproveRequests,
+			want:   "approve-requests",
+		},
+		{
+			action: Approve,
+			want:   "approve",
+		},
+		{
+			action: Explain,
+			want:   "explain",
+		},
+		{
+			action: ValidateGrants,
+			want:   "validate-grants",
+		},
+		{
+			action: Rotate,
+			want:   "rotate",
+		},
+		{
+			action: Rollback,
+			want:   "rollback",
+		},
+		{
+			action: EnrollTotp,
+			want:   "enroll-totp",
+		},
+		{
+			action: RemoveTotp,
+			want:   "remove-totp",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.want, func(t *testing.T) {
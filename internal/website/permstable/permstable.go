@@ -390,6 +390,8 @@ var resources = map[resource.Type]info{
 		actionDescOverrides: map[action.Type]string{
 			action.SetPassword:    "Set a password on an account, without requiring the current password",
 			action.ChangePassword: "Change a password on an account given the current password",
+			action.EnrollTotp:     "Enroll an account in TOTP, replacing any existing enrollment",
+			action.RemoveTotp:     "Remove the TOTP enrollment of an account",
 		},
 	},
 	resource.Alias: {
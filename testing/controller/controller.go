@@ -13,6 +13,9 @@ import (
 	wrapping "github.com/hashicorp/go-kms-wrapping/v2"
 
 	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/tcp"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/ssh"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/postgres"
+	_ "github.com/hashicorp/boundary/internal/daemon/controller/handlers/targets/http"
 )
 
 type option struct {